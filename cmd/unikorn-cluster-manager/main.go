@@ -17,11 +17,16 @@ limitations under the License.
 package main
 
 import (
+	"github.com/spf13/pflag"
+
 	"github.com/eschercloudai/unikorn/pkg/managers/cluster"
 
 	"github.com/eschercloudai/unikorn-core/pkg/manager"
 )
 
 func main() {
-	manager.Run(&cluster.Factory{})
+	factory := &cluster.Factory{}
+	factory.AddFlags(pflag.CommandLine)
+
+	manager.Run(factory)
 }
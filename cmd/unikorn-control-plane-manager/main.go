@@ -17,11 +17,16 @@ limitations under the License.
 package main
 
 import (
+	"github.com/spf13/pflag"
+
 	"github.com/eschercloudai/unikorn/pkg/managers/controlplane"
 
 	"github.com/eschercloudai/unikorn-core/pkg/manager"
 )
 
 func main() {
-	manager.Run(&controlplane.Factory{})
+	factory := &controlplane.Factory{}
+	factory.AddFlags(pflag.CommandLine)
+
+	manager.Run(factory)
 }
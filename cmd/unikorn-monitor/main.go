@@ -26,6 +26,7 @@ import (
 	"github.com/spf13/pflag"
 
 	unikornscheme "github.com/eschercloudai/unikorn/generated/clientset/unikorn/scheme"
+	"github.com/eschercloudai/unikorn/pkg/logging"
 	"github.com/eschercloudai/unikorn/pkg/monitor"
 
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
@@ -40,6 +41,9 @@ func main() {
 	zapOptions := &zap.Options{}
 	zapOptions.BindFlags(flag.CommandLine)
 
+	redactionOptions := &logging.Options{}
+	redactionOptions.AddFlags(pflag.CommandLine)
+
 	// Initialize components with flags, then parse them.
 	monitorOptions := &monitor.Options{}
 	monitorOptions.AddFlags(pflag.CommandLine)
@@ -48,7 +52,7 @@ func main() {
 	pflag.Parse()
 
 	// Get logging going first, log sinks will expect JSON formatted output for everything.
-	log.SetLogger(zap.New(zap.UseFlagOptions(zapOptions)))
+	log.SetLogger(logging.NewRedactor(redactionOptions).Logger(zap.New(zap.UseFlagOptions(zapOptions))))
 
 	logger := log.Log.WithName(constants.Application)
 
@@ -77,5 +81,7 @@ func main() {
 		return
 	}
 
-	monitor.Run(ctx, client, monitorOptions)
+	if err := monitor.Run(ctx, client, monitorOptions); err != nil {
+		logger.Error(err, "monitor failed")
+	}
 }
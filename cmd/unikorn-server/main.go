@@ -34,9 +34,28 @@ import (
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
 	"github.com/eschercloudai/unikorn-core/pkg/constants"
 
+	"k8s.io/client-go/rest"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// newReader returns a client that reads directly from the API server, rather
+// than from a cache.
+func newReader() (client.Reader, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	scheme, err := coreclient.NewScheme(unikornscheme.AddToScheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(config, client.Options{Scheme: scheme})
+}
+
 // start is the entry point to server.
 func start() {
 	s := &server.Server{}
@@ -70,13 +89,29 @@ func start() {
 		return
 	}
 
-	server, err := s.GetServer(client)
+	reader, err := newReader()
+	if err != nil {
+		logger.Error(err, "failed to create reader")
+
+		return
+	}
+
+	server, err := s.GetServer(client, reader)
 	if err != nil {
 		logger.Error(err, "failed to setup Handler")
 
 		return
 	}
 
+	metricsServer := s.GetMetricsServer()
+	if metricsServer != nil {
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error(err, "unexpected metrics server error")
+			}
+		}()
+	}
+
 	// Register a signal handler to trigger a graceful shutdown.
 	stop := make(chan os.Signal, 1)
 
@@ -95,6 +130,12 @@ func start() {
 		if err := server.Shutdown(ctx); err != nil {
 			logger.Error(err, "server shutdown error")
 		}
+
+		if metricsServer != nil {
+			if err := metricsServer.Shutdown(ctx); err != nil {
+				logger.Error(err, "metrics server shutdown error")
+			}
+		}
 	}()
 
 	if err := server.ListenAndServe(); err != nil {
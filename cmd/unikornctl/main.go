@@ -17,23 +17,18 @@ limitations under the License.
 package main
 
 import (
-	"os"
-
 	unikornscheme "github.com/eschercloudai/unikorn/generated/clientset/unikorn/scheme"
 	"github.com/eschercloudai/unikorn/pkg/cmd"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
 
 	"k8s.io/client-go/kubernetes/scheme"
 )
 
 func main() {
-	// Imbue the Kubernetes client library with knowledge of our types.
-	if err := unikornscheme.AddToScheme(scheme.Scheme); err != nil {
-		panic(err)
-	}
+	r := runtime.New()
 
-	c := cmd.Generate()
+	// Imbue the Kubernetes client library with knowledge of our types.
+	r.Exit(unikornscheme.AddToScheme(scheme.Scheme))
 
-	if err := c.Execute(); err != nil {
-		os.Exit(1)
-	}
+	r.Execute(cmd.Generate())
 }
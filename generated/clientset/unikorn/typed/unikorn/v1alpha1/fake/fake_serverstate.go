@@ -0,0 +1,129 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeServerStates implements ServerStateInterface
+type FakeServerStates struct {
+	Fake *FakeUnikornV1alpha1
+	ns   string
+}
+
+var serverstatesResource = v1alpha1.SchemeGroupVersion.WithResource("serverstates")
+
+var serverstatesKind = v1alpha1.SchemeGroupVersion.WithKind("ServerState")
+
+// Get takes name of the serverState, and returns the corresponding serverState object, and an error if there is any.
+func (c *FakeServerStates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ServerState, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(serverstatesResource, c.ns, name), &v1alpha1.ServerState{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServerState), err
+}
+
+// List takes label and field selectors, and returns the list of ServerStates that match those selectors.
+func (c *FakeServerStates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ServerStateList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(serverstatesResource, serverstatesKind, c.ns, opts), &v1alpha1.ServerStateList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ServerStateList{ListMeta: obj.(*v1alpha1.ServerStateList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ServerStateList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested serverStates.
+func (c *FakeServerStates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(serverstatesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a serverState and creates it.  Returns the server's representation of the serverState, and an error, if there is any.
+func (c *FakeServerStates) Create(ctx context.Context, serverState *v1alpha1.ServerState, opts v1.CreateOptions) (result *v1alpha1.ServerState, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(serverstatesResource, c.ns, serverState), &v1alpha1.ServerState{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServerState), err
+}
+
+// Update takes the representation of a serverState and updates it. Returns the server's representation of the serverState, and an error, if there is any.
+func (c *FakeServerStates) Update(ctx context.Context, serverState *v1alpha1.ServerState, opts v1.UpdateOptions) (result *v1alpha1.ServerState, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(serverstatesResource, c.ns, serverState), &v1alpha1.ServerState{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServerState), err
+}
+
+// Delete takes name of the serverState and deletes it. Returns an error if one occurs.
+func (c *FakeServerStates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(serverstatesResource, c.ns, name, opts), &v1alpha1.ServerState{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeServerStates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(serverstatesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ServerStateList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched serverState.
+func (c *FakeServerStates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ServerState, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(serverstatesResource, c.ns, name, pt, data, subresources...), &v1alpha1.ServerState{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServerState), err
+}
@@ -28,6 +28,10 @@ type FakeUnikornV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeUnikornV1alpha1) ClusterTemplates() v1alpha1.ClusterTemplateInterface {
+	return &FakeClusterTemplates{c}
+}
+
 func (c *FakeUnikornV1alpha1) ControlPlanes(namespace string) v1alpha1.ControlPlaneInterface {
 	return &FakeControlPlanes{c, namespace}
 }
@@ -44,10 +48,22 @@ func (c *FakeUnikornV1alpha1) KubernetesClusterApplicationBundles() v1alpha1.Kub
 	return &FakeKubernetesClusterApplicationBundles{c}
 }
 
+func (c *FakeUnikornV1alpha1) PlatformChangelogs() v1alpha1.PlatformChangelogInterface {
+	return &FakePlatformChangelogs{c}
+}
+
 func (c *FakeUnikornV1alpha1) Projects() v1alpha1.ProjectInterface {
 	return &FakeProjects{c}
 }
 
+func (c *FakeUnikornV1alpha1) ProjectRoleBindings(namespace string) v1alpha1.ProjectRoleBindingInterface {
+	return &FakeProjectRoleBindings{c, namespace}
+}
+
+func (c *FakeUnikornV1alpha1) ServerStates(namespace string) v1alpha1.ServerStateInterface {
+	return &FakeServerStates{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeUnikornV1alpha1) RESTClient() rest.Interface {
@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1alpha1
 
+type ClusterTemplateExpansion interface{}
+
 type ControlPlaneExpansion interface{}
 
 type ControlPlaneApplicationBundleExpansion interface{}
@@ -26,4 +28,10 @@ type KubernetesClusterExpansion interface{}
 
 type KubernetesClusterApplicationBundleExpansion interface{}
 
+type PlatformChangelogExpansion interface{}
+
 type ProjectExpansion interface{}
+
+type ProjectRoleBindingExpansion interface{}
+
+type ServerStateExpansion interface{}
@@ -0,0 +1,168 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	scheme "github.com/eschercloudai/unikorn/generated/clientset/unikorn/scheme"
+	v1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// PlatformChangelogsGetter has a method to return a PlatformChangelogInterface.
+// A group's client should implement this interface.
+type PlatformChangelogsGetter interface {
+	PlatformChangelogs() PlatformChangelogInterface
+}
+
+// PlatformChangelogInterface has methods to work with PlatformChangelog resources.
+type PlatformChangelogInterface interface {
+	Create(ctx context.Context, platformChangelog *v1alpha1.PlatformChangelog, opts v1.CreateOptions) (*v1alpha1.PlatformChangelog, error)
+	Update(ctx context.Context, platformChangelog *v1alpha1.PlatformChangelog, opts v1.UpdateOptions) (*v1alpha1.PlatformChangelog, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.PlatformChangelog, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.PlatformChangelogList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PlatformChangelog, err error)
+	PlatformChangelogExpansion
+}
+
+// platformChangelogs implements PlatformChangelogInterface
+type platformChangelogs struct {
+	client rest.Interface
+}
+
+// newPlatformChangelogs returns a PlatformChangelogs
+func newPlatformChangelogs(c *UnikornV1alpha1Client) *platformChangelogs {
+	return &platformChangelogs{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the platformChangelog, and returns the corresponding platformChangelog object, and an error if there is any.
+func (c *platformChangelogs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.PlatformChangelog, err error) {
+	result = &v1alpha1.PlatformChangelog{}
+	err = c.client.Get().
+		Resource("platformchangelogs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of PlatformChangelogs that match those selectors.
+func (c *platformChangelogs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.PlatformChangelogList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.PlatformChangelogList{}
+	err = c.client.Get().
+		Resource("platformchangelogs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested platformChangelogs.
+func (c *platformChangelogs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("platformchangelogs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a platformChangelog and creates it.  Returns the server's representation of the platformChangelog, and an error, if there is any.
+func (c *platformChangelogs) Create(ctx context.Context, platformChangelog *v1alpha1.PlatformChangelog, opts v1.CreateOptions) (result *v1alpha1.PlatformChangelog, err error) {
+	result = &v1alpha1.PlatformChangelog{}
+	err = c.client.Post().
+		Resource("platformchangelogs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(platformChangelog).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a platformChangelog and updates it. Returns the server's representation of the platformChangelog, and an error, if there is any.
+func (c *platformChangelogs) Update(ctx context.Context, platformChangelog *v1alpha1.PlatformChangelog, opts v1.UpdateOptions) (result *v1alpha1.PlatformChangelog, err error) {
+	result = &v1alpha1.PlatformChangelog{}
+	err = c.client.Put().
+		Resource("platformchangelogs").
+		Name(platformChangelog.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(platformChangelog).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the platformChangelog and deletes it. Returns an error if one occurs.
+func (c *platformChangelogs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("platformchangelogs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *platformChangelogs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("platformchangelogs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched platformChangelog.
+func (c *platformChangelogs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PlatformChangelog, err error) {
+	result = &v1alpha1.PlatformChangelog{}
+	err = c.client.Patch(pt).
+		Resource("platformchangelogs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
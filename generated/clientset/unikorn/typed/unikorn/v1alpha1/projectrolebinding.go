@@ -0,0 +1,178 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	scheme "github.com/eschercloudai/unikorn/generated/clientset/unikorn/scheme"
+	v1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ProjectRoleBindingsGetter has a method to return a ProjectRoleBindingInterface.
+// A group's client should implement this interface.
+type ProjectRoleBindingsGetter interface {
+	ProjectRoleBindings(namespace string) ProjectRoleBindingInterface
+}
+
+// ProjectRoleBindingInterface has methods to work with ProjectRoleBinding resources.
+type ProjectRoleBindingInterface interface {
+	Create(ctx context.Context, projectRoleBinding *v1alpha1.ProjectRoleBinding, opts v1.CreateOptions) (*v1alpha1.ProjectRoleBinding, error)
+	Update(ctx context.Context, projectRoleBinding *v1alpha1.ProjectRoleBinding, opts v1.UpdateOptions) (*v1alpha1.ProjectRoleBinding, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ProjectRoleBinding, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ProjectRoleBindingList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ProjectRoleBinding, err error)
+	ProjectRoleBindingExpansion
+}
+
+// projectRoleBindings implements ProjectRoleBindingInterface
+type projectRoleBindings struct {
+	client rest.Interface
+	ns     string
+}
+
+// newProjectRoleBindings returns a ProjectRoleBindings
+func newProjectRoleBindings(c *UnikornV1alpha1Client, namespace string) *projectRoleBindings {
+	return &projectRoleBindings{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the projectRoleBinding, and returns the corresponding projectRoleBinding object, and an error if there is any.
+func (c *projectRoleBindings) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ProjectRoleBinding, err error) {
+	result = &v1alpha1.ProjectRoleBinding{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("projectrolebindings").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ProjectRoleBindings that match those selectors.
+func (c *projectRoleBindings) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ProjectRoleBindingList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ProjectRoleBindingList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("projectrolebindings").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested projectRoleBindings.
+func (c *projectRoleBindings) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("projectrolebindings").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a projectRoleBinding and creates it.  Returns the server's representation of the projectRoleBinding, and an error, if there is any.
+func (c *projectRoleBindings) Create(ctx context.Context, projectRoleBinding *v1alpha1.ProjectRoleBinding, opts v1.CreateOptions) (result *v1alpha1.ProjectRoleBinding, err error) {
+	result = &v1alpha1.ProjectRoleBinding{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("projectrolebindings").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(projectRoleBinding).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a projectRoleBinding and updates it. Returns the server's representation of the projectRoleBinding, and an error, if there is any.
+func (c *projectRoleBindings) Update(ctx context.Context, projectRoleBinding *v1alpha1.ProjectRoleBinding, opts v1.UpdateOptions) (result *v1alpha1.ProjectRoleBinding, err error) {
+	result = &v1alpha1.ProjectRoleBinding{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("projectrolebindings").
+		Name(projectRoleBinding.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(projectRoleBinding).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the projectRoleBinding and deletes it. Returns an error if one occurs.
+func (c *projectRoleBindings) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("projectrolebindings").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *projectRoleBindings) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("projectrolebindings").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched projectRoleBinding.
+func (c *projectRoleBindings) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ProjectRoleBinding, err error) {
+	result = &v1alpha1.ProjectRoleBinding{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("projectrolebindings").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	scheme "github.com/eschercloudai/unikorn/generated/clientset/unikorn/scheme"
+	v1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ServerStatesGetter has a method to return a ServerStateInterface.
+// A group's client should implement this interface.
+type ServerStatesGetter interface {
+	ServerStates(namespace string) ServerStateInterface
+}
+
+// ServerStateInterface has methods to work with ServerState resources.
+type ServerStateInterface interface {
+	Create(ctx context.Context, serverState *v1alpha1.ServerState, opts v1.CreateOptions) (*v1alpha1.ServerState, error)
+	Update(ctx context.Context, serverState *v1alpha1.ServerState, opts v1.UpdateOptions) (*v1alpha1.ServerState, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ServerState, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ServerStateList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ServerState, err error)
+	ServerStateExpansion
+}
+
+// serverStates implements ServerStateInterface
+type serverStates struct {
+	client rest.Interface
+	ns     string
+}
+
+// newServerStates returns a ServerStates
+func newServerStates(c *UnikornV1alpha1Client, namespace string) *serverStates {
+	return &serverStates{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the serverState, and returns the corresponding serverState object, and an error if there is any.
+func (c *serverStates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ServerState, err error) {
+	result = &v1alpha1.ServerState{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("serverstates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ServerStates that match those selectors.
+func (c *serverStates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ServerStateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ServerStateList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("serverstates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested serverStates.
+func (c *serverStates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("serverstates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a serverState and creates it.  Returns the server's representation of the serverState, and an error, if there is any.
+func (c *serverStates) Create(ctx context.Context, serverState *v1alpha1.ServerState, opts v1.CreateOptions) (result *v1alpha1.ServerState, err error) {
+	result = &v1alpha1.ServerState{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("serverstates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(serverState).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a serverState and updates it. Returns the server's representation of the serverState, and an error, if there is any.
+func (c *serverStates) Update(ctx context.Context, serverState *v1alpha1.ServerState, opts v1.UpdateOptions) (result *v1alpha1.ServerState, err error) {
+	result = &v1alpha1.ServerState{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("serverstates").
+		Name(serverState.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(serverState).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the serverState and deletes it. Returns an error if one occurs.
+func (c *serverStates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("serverstates").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *serverStates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("serverstates").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched serverState.
+func (c *serverStates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ServerState, err error) {
+	result = &v1alpha1.ServerState{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("serverstates").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
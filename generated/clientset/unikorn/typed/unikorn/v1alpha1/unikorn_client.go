@@ -28,11 +28,15 @@ import (
 
 type UnikornV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ClusterTemplatesGetter
 	ControlPlanesGetter
 	ControlPlaneApplicationBundlesGetter
 	KubernetesClustersGetter
 	KubernetesClusterApplicationBundlesGetter
+	PlatformChangelogsGetter
 	ProjectsGetter
+	ProjectRoleBindingsGetter
+	ServerStatesGetter
 }
 
 // UnikornV1alpha1Client is used to interact with features provided by the unikorn.eschercloud.ai group.
@@ -40,6 +44,10 @@ type UnikornV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *UnikornV1alpha1Client) ClusterTemplates() ClusterTemplateInterface {
+	return newClusterTemplates(c)
+}
+
 func (c *UnikornV1alpha1Client) ControlPlanes(namespace string) ControlPlaneInterface {
 	return newControlPlanes(c, namespace)
 }
@@ -56,10 +64,22 @@ func (c *UnikornV1alpha1Client) KubernetesClusterApplicationBundles() Kubernetes
 	return newKubernetesClusterApplicationBundles(c)
 }
 
+func (c *UnikornV1alpha1Client) PlatformChangelogs() PlatformChangelogInterface {
+	return newPlatformChangelogs(c)
+}
+
 func (c *UnikornV1alpha1Client) Projects() ProjectInterface {
 	return newProjects(c)
 }
 
+func (c *UnikornV1alpha1Client) ProjectRoleBindings(namespace string) ProjectRoleBindingInterface {
+	return newProjectRoleBindings(c, namespace)
+}
+
+func (c *UnikornV1alpha1Client) ServerStates(namespace string) ServerStateInterface {
+	return newServerStates(c, namespace)
+}
+
 // NewForConfig creates a new UnikornV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
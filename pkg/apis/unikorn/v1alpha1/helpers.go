@@ -20,14 +20,20 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"time"
 
+	unikornconstants "github.com/eschercloudai/unikorn/pkg/constants"
+
 	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn-core/pkg/constants"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/version"
 )
 
 var (
@@ -42,6 +48,13 @@ var (
 	// ErrApplicationLookup is raised when the named application is not
 	// present in an application bundle bundle.
 	ErrApplicationLookup = errors.New("failed to lookup an application")
+
+	// ErrTimeZone is raised when a time zone cannot be loaded.
+	ErrTimeZone = errors.New("invalid time zone")
+
+	// ErrKubernetesVersion is raised when a Kubernetes version cannot be parsed
+	// or is incompatible with an application bundle.
+	ErrKubernetesVersion = errors.New("incompatible kubernetes version")
 )
 
 // IPv4AddressSliceFromIPSlice is a simple converter from Go types
@@ -56,6 +69,23 @@ func IPv4AddressSliceFromIPSlice(in []net.IP) []IPv4Address {
 	return out
 }
 
+// IPAddressSliceFromIPSlice is a simple converter from Go types
+// to API types.
+func IPAddressSliceFromIPSlice(in []net.IP) []IPAddress {
+	out := make([]IPAddress, len(in))
+
+	for i, ip := range in {
+		out[i] = IPAddress{IP: ip}
+	}
+
+	return out
+}
+
+// IsIPv6 returns true if the prefix is an IPv6 one.
+func (p *IPPrefix) IsIPv6() bool {
+	return p.IP.To4() == nil
+}
+
 // Paused implements the ReconcilePauser interface.
 func (c *Project) Paused() bool {
 	return c.Spec.Pause
@@ -95,6 +125,36 @@ func (c *Project) ResourceLabels() (labels.Set, error) {
 	return labels, nil
 }
 
+// PropagatedLabels returns the labels that are inherited by resources belonging
+// to the project, reserved labels are omitted.
+func (c *Project) PropagatedLabels() map[string]string {
+	if c.Spec.LabelPropagation == nil {
+		return nil
+	}
+
+	result := map[string]string{}
+
+	for key, value := range c.Spec.LabelPropagation.Labels {
+		if strings.HasPrefix(key, unikornconstants.LabelDomain+"/") {
+			continue
+		}
+
+		result[key] = value
+	}
+
+	return result
+}
+
+// LabelConflictPolicy returns what to do when a propagated label is already
+// set on a resource.
+func (c *Project) LabelConflictPolicy() LabelConflictPolicy {
+	if c.Spec.LabelPropagation == nil || c.Spec.LabelPropagation.ConflictPolicy == nil {
+		return LabelConflictPolicyPreserve
+	}
+
+	return *c.Spec.LabelPropagation.ConflictPolicy
+}
+
 // StatusConditionRead scans the status conditions for an existing condition whose type
 // matches.
 func (c *ControlPlane) StatusConditionRead(t coreunikornv1.ConditionType) (*coreunikornv1.Condition, error) {
@@ -182,6 +242,31 @@ func (c *KubernetesCluster) AutoscalingEnabled() bool {
 	return c.Spec.Features != nil && c.Spec.Features.Autoscaling != nil && *c.Spec.Features.Autoscaling
 }
 
+// WarmMachines returns the number of spare machines to keep booted for the pool.
+// These are only provided by the cluster autoscaler.
+func (p *KubernetesWorkloadPoolSpec) WarmMachines() int {
+	if p.Autoscaling == nil || p.Autoscaling.WarmMachines == nil {
+		return 0
+	}
+
+	return *p.Autoscaling.WarmMachines
+}
+
+// WarmPoolsEnabled indicates whether any workload pool requires spare machines.
+func (c *KubernetesCluster) WarmPoolsEnabled() bool {
+	if !c.AutoscalingEnabled() || c.Spec.WorkloadPools == nil {
+		return false
+	}
+
+	for i := range c.Spec.WorkloadPools.Pools {
+		if c.Spec.WorkloadPools.Pools[i].WarmMachines() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IngressEnabled indicates whether an ingress controller is required.
 func (c *KubernetesCluster) IngressEnabled() bool {
 	return c.Spec.Features != nil && c.Spec.Features.Ingress != nil && *c.Spec.Features.Ingress
@@ -212,6 +297,202 @@ func (c *KubernetesCluster) NvidiaOperatorEnabled() bool {
 	return c.Spec.Features != nil && c.Spec.Features.NvidiaOperator != nil && *c.Spec.Features.NvidiaOperator
 }
 
+// NodeFirewallEnabled indicates whether node traffic is restricted to the allow list.
+func (c *KubernetesCluster) NodeFirewallEnabled() bool {
+	return c.Spec.Features != nil && c.Spec.Features.NodeFirewall != nil && *c.Spec.Features.NodeFirewall
+}
+
+// EffectiveDirection returns the direction of a node allow list rule, taking
+// into account the default.
+func (r *NodeAllowListRule) EffectiveDirection() NodeAllowListDirection {
+	if r.Direction == nil {
+		return NodeAllowListDirectionIngress
+	}
+
+	return *r.Direction
+}
+
+// PrivateAPIEnabled indicates whether the API is only accessible on the node network.
+func (c *KubernetesCluster) PrivateAPIEnabled() bool {
+	return c.Spec.API != nil && c.Spec.API.Private != nil && *c.Spec.API.Private
+}
+
+// APILoadBalancer returns the API load balancer configuration, if any.
+func (c *KubernetesCluster) APILoadBalancer() *LoadBalancerSpec {
+	if c.Spec.API == nil {
+		return nil
+	}
+
+	return c.Spec.API.LoadBalancer
+}
+
+// PodNetworks returns the pod network prefixes, primary first.
+func (c *KubernetesCluster) PodNetworks() []IPPrefix {
+	result := []IPPrefix{*c.Spec.Network.PodNetwork}
+
+	if c.Spec.Network.SecondaryPodNetwork != nil {
+		result = append(result, *c.Spec.Network.SecondaryPodNetwork)
+	}
+
+	return result
+}
+
+// ServiceNetworks returns the service network prefixes, primary first.
+func (c *KubernetesCluster) ServiceNetworks() []IPPrefix {
+	result := []IPPrefix{*c.Spec.Network.ServiceNetwork}
+
+	if c.Spec.Network.SecondaryServiceNetwork != nil {
+		result = append(result, *c.Spec.Network.SecondaryServiceNetwork)
+	}
+
+	return result
+}
+
+// DualStack indicates whether pods and services have both IPv4 and IPv6 addresses.
+func (c *KubernetesCluster) DualStack() bool {
+	return c.Spec.Network.SecondaryPodNetwork != nil
+}
+
+// IPv4Enabled indicates whether pods have IPv4 addresses.
+func (c *KubernetesCluster) IPv4Enabled() bool {
+	return c.DualStack() || !c.Spec.Network.PodNetwork.IsIPv6()
+}
+
+// IPv6Enabled indicates whether pods have IPv6 addresses.
+func (c *KubernetesCluster) IPv6Enabled() bool {
+	return c.DualStack() || c.Spec.Network.PodNetwork.IsIPv6()
+}
+
+// Provider returns the backend that provisions the cluster.
+func (c *KubernetesCluster) Provider() KubernetesClusterProvider {
+	if c.Spec.Provider == nil {
+		return KubernetesClusterProviderCAPO
+	}
+
+	return *c.Spec.Provider
+}
+
+// NetworkPlugin returns the CNI that provides pod networking.
+func (c *KubernetesCluster) NetworkPlugin() NetworkPlugin {
+	if c.Spec.Network == nil || c.Spec.Network.Plugin == nil {
+		return NetworkPluginCilium
+	}
+
+	return *c.Spec.Network.Plugin
+}
+
+// CiliumEnabled indicates whether to install Cilium.
+func (c *KubernetesCluster) CiliumEnabled() bool {
+	return c.NetworkPlugin() == NetworkPluginCilium
+}
+
+// CalicoEnabled indicates whether to install Calico.
+func (c *KubernetesCluster) CalicoEnabled() bool {
+	return c.NetworkPlugin() == NetworkPluginCalico
+}
+
+// MetricsFederationEnabled indicates whether to forward metrics to the platform.
+func (c *KubernetesCluster) MetricsFederationEnabled() bool {
+	return c.PrometheusEnabled() && c.Spec.Features.MetricsFederation != nil && *c.Spec.Features.MetricsFederation
+}
+
+// BackupEnabled indicates whether to install Velero.
+func (c *KubernetesCluster) BackupEnabled() bool {
+	return c.Spec.Features != nil && c.Spec.Features.Backup != nil && *c.Spec.Features.Backup
+}
+
+// CostAllocationEnabled indicates whether to label nodes and pods for cost allocation.
+func (c *KubernetesCluster) CostAllocationEnabled() bool {
+	return c.Spec.Features != nil && c.Spec.Features.CostAllocation != nil && *c.Spec.Features.CostAllocation
+}
+
+// Hibernated indicates whether the cluster has been hibernated.
+func (c *KubernetesCluster) Hibernated() bool {
+	_, ok := c.Annotations[unikornconstants.HibernationAnnotation]
+
+	return ok
+}
+
+// HibernationConditionWrite records whether the cluster has been reconciled while
+// hibernated.  The transition time is only updated when the status changes.
+func (c *KubernetesCluster) HibernationConditionWrite(hibernated bool) {
+	condition := metav1.Condition{
+		Type:               KubernetesClusterConditionHibernated,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: c.Generation,
+		Reason:             KubernetesClusterConditionReasonRunning,
+		Message:            "Workload pools are scaled to their requested sizes",
+	}
+
+	if hibernated {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = KubernetesClusterConditionReasonHibernated
+		condition.Message = "Workload pools are scaled to zero"
+	}
+
+	var conditions []metav1.Condition
+
+	if c.Status.Hibernation != nil {
+		conditions = append(conditions, *c.Status.Hibernation)
+	}
+
+	meta.SetStatusCondition(&conditions, condition)
+
+	c.Status.Hibernation = &conditions[0]
+}
+
+// ControlPlaneUpgrading returns true when the control plane has yet to be
+// deployed at its requested Kubernetes version.
+func (c *KubernetesCluster) ControlPlaneUpgrading() bool {
+	return c.Status.ControlPlane != nil && c.Status.ControlPlane.Version != *c.Spec.ControlPlane.Version
+}
+
+// DeployedWorkloadPool returns what was last deployed to the named workload pool,
+// or nil if it's yet to be deployed.
+func (c *KubernetesCluster) DeployedWorkloadPool(name string) *MachineVersionStatus {
+	for i := range c.Status.WorkloadPools {
+		if c.Status.WorkloadPools[i].Name == name {
+			return &c.Status.WorkloadPools[i].MachineVersionStatus
+		}
+	}
+
+	return nil
+}
+
+// WorkloadPoolsUpgrading returns true when any workload pool has yet to be
+// deployed at its requested Kubernetes version.
+func (c *KubernetesCluster) WorkloadPoolsUpgrading() bool {
+	for _, pool := range c.Spec.WorkloadPools.Pools {
+		if deployed := c.DeployedWorkloadPool(pool.Name); deployed != nil && deployed.Version != *pool.Version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StageTiming returns the timing recorded for the named provisioning stage,
+// or nil if it has yet to be provisioned.
+func (c *KubernetesCluster) StageTiming(name string) *ProvisioningStageTiming {
+	for i := range c.Status.StageTimings {
+		if c.Status.StageTimings[i].Name == name {
+			return &c.Status.StageTimings[i]
+		}
+	}
+
+	return nil
+}
+
+// Duration returns how long the stage took to provision, or false if it
+// has yet to complete.
+func (t *ProvisioningStageTiming) Duration() (time.Duration, bool) {
+	if t.CompletionTime == nil {
+		return 0, false
+	}
+
+	return t.CompletionTime.Sub(t.StartTime.Time), true
+}
+
 func CompareControlPlane(a, b ControlPlane) int {
 	return strings.Compare(a.Name, b.Name)
 }
@@ -291,6 +572,60 @@ func (l KubernetesClusterApplicationBundleList) Upgradable() *KubernetesClusterA
 	return result
 }
 
+// stability orders channels from most to least stable.
+func (c ApplicationBundleChannel) stability() int {
+	switch c {
+	case ApplicationBundleChannelRapid:
+		return 1
+	case ApplicationBundleChannelPreview:
+		return 2
+	}
+
+	return 0
+}
+
+// Includes returns whether a subscriber to this channel may be upgraded to a
+// bundle published to the other.  Channels are cumulative, so rapid includes
+// stable, and preview includes both.
+func (c ApplicationBundleChannel) Includes(other ApplicationBundleChannel) bool {
+	return other.stability() <= c.stability()
+}
+
+// GetChannel returns the channel the bundle is published to.
+func (s ApplicationBundleSpec) GetChannel() ApplicationBundleChannel {
+	if s.Channel == nil {
+		return ApplicationBundleChannelStable
+	}
+
+	return *s.Channel
+}
+
+// InChannel returns a new list of bundles that are available to subscribers
+// of the channel.
+func (l ControlPlaneApplicationBundleList) InChannel(channel ApplicationBundleChannel) *ControlPlaneApplicationBundleList {
+	result := &ControlPlaneApplicationBundleList{}
+
+	for _, bundle := range l.Items {
+		if channel.Includes(bundle.Spec.GetChannel()) {
+			result.Items = append(result.Items, bundle)
+		}
+	}
+
+	return result
+}
+
+func (l KubernetesClusterApplicationBundleList) InChannel(channel ApplicationBundleChannel) *KubernetesClusterApplicationBundleList {
+	result := &KubernetesClusterApplicationBundleList{}
+
+	for _, bundle := range l.Items {
+		if channel.Includes(bundle.Spec.GetChannel()) {
+			result.Items = append(result.Items, bundle)
+		}
+	}
+
+	return result
+}
+
 func (s ApplicationBundleSpec) GetApplication(name string) (*coreunikornv1.ApplicationReference, error) {
 	for i := range s.Applications {
 		if *s.Applications[i].Name == name {
@@ -301,6 +636,94 @@ func (s ApplicationBundleSpec) GetApplication(name string) (*coreunikornv1.Appli
 	return nil, fmt.Errorf("%w: %s", ErrApplicationLookup, name)
 }
 
+// ValidateKubernetesVersion checks the Kubernetes version is within the range
+// supported by the application bundle.
+func (s KubernetesClusterApplicationBundleSpec) ValidateKubernetesVersion(v SemanticVersion) error {
+	if s.Kubernetes == nil {
+		return nil
+	}
+
+	actual, err := version.ParseSemantic(string(v))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrKubernetesVersion, err.Error())
+	}
+
+	if s.Kubernetes.MinimumVersion != nil {
+		minimum, err := version.ParseSemantic(string(*s.Kubernetes.MinimumVersion))
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrKubernetesVersion, err.Error())
+		}
+
+		if actual.LessThan(minimum) {
+			return fmt.Errorf("%w: %s is older than %s", ErrKubernetesVersion, v, *s.Kubernetes.MinimumVersion)
+		}
+	}
+
+	if s.Kubernetes.MaximumVersion != nil {
+		maximum, err := version.ParseSemantic(string(*s.Kubernetes.MaximumVersion))
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrKubernetesVersion, err.Error())
+		}
+
+		if maximum.LessThan(actual) {
+			return fmt.Errorf("%w: %s is newer than %s", ErrKubernetesVersion, v, *s.Kubernetes.MaximumVersion)
+		}
+	}
+
+	return nil
+}
+
+// Location returns the time zone that upgrade windows are defined in.
+// This defaults to UTC when not specified.  The empty string and "Local" are
+// rejected, as they would resolve to whatever time zone the monitor runs in.
+func (s ApplicationBundleAutoUpgradeSpec) Location() (*time.Location, error) {
+	if s.TimeZone == nil {
+		return time.UTC, nil
+	}
+
+	if *s.TimeZone == "" || *s.TimeZone == "Local" {
+		return nil, fmt.Errorf("%w: time zone must be named", ErrTimeZone)
+	}
+
+	location, err := time.LoadLocation(*s.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrTimeZone, err.Error())
+	}
+
+	return location, nil
+}
+
+// GetChannel returns the channel the resource is subscribed to, this may
+// be called on a nil object, as resources without automatic upgrades still
+// receive forced upgrades from the stable channel.
+func (s *ApplicationBundleAutoUpgradeSpec) GetChannel() ApplicationBundleChannel {
+	if s == nil || s.Channel == nil {
+		return ApplicationBundleChannelStable
+	}
+
+	return *s.Channel
+}
+
+// Active returns true if the freeze is set and has not expired.
+func (s *UpgradeFreezeSpec) Active(now time.Time) bool {
+	return s != nil && now.Before(s.Until.Time)
+}
+
+// GetTimeout returns the time to wait for an approval decision.
+func (s UpgradeApprovalSpec) GetTimeout() time.Duration {
+	if s.Timeout == nil {
+		return 24 * time.Hour
+	}
+
+	return s.Timeout.Duration
+}
+
+// AllowByDefault returns whether an upgrade should proceed when no decision
+// has been made.
+func (s UpgradeApprovalSpec) AllowByDefault() bool {
+	return s.DefaultPolicy == UpgradeApprovalPolicyAllow
+}
+
 // Weekdays returns the days of the week that are set in the spec.
 func (s ApplicationBundleAutoUpgradeWeekDaySpec) Weekdays() []time.Weekday {
 	var result []time.Weekday
@@ -335,3 +758,37 @@ func (s ApplicationBundleAutoUpgradeWeekDaySpec) Weekdays() []time.Weekday {
 
 	return result
 }
+
+// Subscribed returns whether the webhook wants to be notified of the event.
+func (s NotificationWebhookSpec) Subscribed(event NotificationEvent) bool {
+	if len(s.Events) == 0 {
+		return true
+	}
+
+	return slices.Contains(s.Events, event)
+}
+
+// Slack returns whether the webhook expects a Slack compatible payload.
+func (s NotificationWebhookSpec) Slack() bool {
+	return s.Format == NotificationWebhookFormatSlack
+}
+
+// ApplicationBundleName returns the application bundle the control plane is
+// provisioned with.
+func (c *ControlPlane) ApplicationBundleName() string {
+	if c.Spec.ApplicationBundle == nil {
+		return ""
+	}
+
+	return *c.Spec.ApplicationBundle
+}
+
+// ApplicationBundleName returns the application bundle the cluster is
+// provisioned with.
+func (c *KubernetesCluster) ApplicationBundleName() string {
+	if c.Spec.ApplicationBundle == nil {
+		return ""
+	}
+
+	return *c.Spec.ApplicationBundle
+}
@@ -53,6 +53,10 @@ const (
 	KubernetesClusterApplicationBundleKind = "KubernetesClusterApplicationBundle"
 	// KubernetesClusterApplicationBundleResource is the API endpoint for bundles of applications.
 	KubernetesClusterApplicationBundleResource = "kubernetesclusterapplicationbundles"
+	// ClusterTemplateKind is the API kind for a cluster template.
+	ClusterTemplateKind = "ClusterTemplate"
+	// ClusterTemplateResource is the API endpoint for cluster templates.
+	ClusterTemplateResource = "clustertemplates"
 )
 
 var (
@@ -76,6 +80,10 @@ func init() {
 	SchemeBuilder.Register(&KubernetesCluster{}, &KubernetesClusterList{})
 	SchemeBuilder.Register(&ControlPlaneApplicationBundle{}, &ControlPlaneApplicationBundleList{})
 	SchemeBuilder.Register(&KubernetesClusterApplicationBundle{}, &KubernetesClusterApplicationBundleList{})
+	SchemeBuilder.Register(&ClusterTemplate{}, &ClusterTemplateList{})
+	SchemeBuilder.Register(&ServerState{}, &ServerStateList{})
+	SchemeBuilder.Register(&ProjectRoleBinding{}, &ProjectRoleBindingList{})
+	SchemeBuilder.Register(&PlatformChangelog{}, &PlatformChangelogList{})
 }
 
 // Resource maps a resource type to a group resource.
@@ -151,6 +151,115 @@ func (IPv4Prefix) OpenAPISchemaFormat() string {
 	return ""
 }
 
+// IPAddress is an IPv4 or IPv6 address.
+// +kubebuilder:validation:Type=string
+type IPAddress struct {
+	net.IP
+}
+
+// Ensure the type implements json.Unmarshaler.
+var _ = json.Unmarshaler(&IPAddress{})
+
+func (a *IPAddress) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(str)
+	if ip == nil {
+		return ErrJSONUnmarshal
+	}
+
+	a.IP = ip
+
+	return nil
+}
+
+// Ensure the type implements value.UnstructuredConverter.
+var _ = value.UnstructuredConverter(&IPAddress{})
+
+func (a IPAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.IP.String())
+}
+
+func (a IPAddress) ToUnstructured() interface{} {
+	return a.IP.String()
+}
+
+func (IPAddress) OpenAPISchemaType() []string {
+	return []string{"string"}
+}
+
+func (IPAddress) OpenAPISchemaFormat() string {
+	return ""
+}
+
+// IPPrefix is an IPv4 or IPv6 prefix in CIDR notation.
+// +kubebuilder:validation:Type=string
+// +kubebuilder:validation:Format=cidr
+type IPPrefix struct {
+	net.IPNet
+}
+
+// DeepCopyInto implements the interface deepcopy-gen is totally unable to
+// do by itself.
+func (p *IPPrefix) DeepCopyInto(out *IPPrefix) {
+	if p.IPNet.IP != nil {
+		in, out := &p.IPNet.IP, &out.IPNet.IP
+		*out = make(net.IP, len(*in))
+		copy(*out, *in)
+	}
+
+	if p.IPNet.Mask != nil {
+		in, out := &p.IPNet.Mask, &out.IPNet.Mask
+		*out = make(net.IPMask, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// Ensure the type implements json.Unmarshaler.
+var _ = json.Unmarshaler(&IPPrefix{})
+
+func (p *IPPrefix) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+
+	_, network, err := net.ParseCIDR(str)
+	if err != nil {
+		return ErrJSONUnmarshal
+	}
+
+	if network == nil {
+		return ErrJSONUnmarshal
+	}
+
+	p.IPNet = *network
+
+	return nil
+}
+
+// Ensure the type implements value.UnstructuredConverter.
+var _ = value.UnstructuredConverter(&IPPrefix{})
+
+func (p IPPrefix) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.IPNet.String())
+}
+
+func (p IPPrefix) ToUnstructured() interface{} {
+	return p.IPNet.String()
+}
+
+func (IPPrefix) OpenAPISchemaType() []string {
+	return []string{"string"}
+}
+
+func (IPPrefix) OpenAPISchemaFormat() string {
+	return ""
+}
+
 // ProjectList is a typed list of projects.
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type ProjectList struct {
@@ -180,6 +289,184 @@ type Project struct {
 type ProjectSpec struct {
 	// Pause, if true, will inhibit reconciliation.
 	Pause bool `json:"pause,omitempty"`
+	// UpgradeApproval, when specified, requires automatic upgrades of
+	// resources in this project to be approved before they are performed.
+	UpgradeApproval *UpgradeApprovalSpec `json:"upgradeApproval,omitempty"`
+	// Offboarding, when set, means the project is being torn down.  No new
+	// control planes or clusters may be created.
+	Offboarding *ProjectOffboardingSpec `json:"offboarding,omitempty"`
+	// Notifications are webhooks that are called when control planes and
+	// clusters in this project change state.
+	Notifications []NotificationWebhookSpec `json:"notifications,omitempty"`
+	// LabelPropagation defines labels, for example cost centres, that are
+	// inherited by namespaces and resources belonging to this project.
+	LabelPropagation *LabelPropagationSpec `json:"labelPropagation,omitempty"`
+}
+
+// LabelConflictPolicy defines how to handle a label that already exists on a
+// resource, but was not propagated from the project.
+// +kubebuilder:validation:Enum=Preserve;Overwrite
+type LabelConflictPolicy string
+
+const (
+	// LabelConflictPolicyPreserve leaves the existing label alone.
+	LabelConflictPolicyPreserve LabelConflictPolicy = "Preserve"
+
+	// LabelConflictPolicyOverwrite replaces the existing label with the
+	// project's.
+	LabelConflictPolicyOverwrite LabelConflictPolicy = "Overwrite"
+)
+
+type LabelPropagationSpec struct {
+	// Labels are applied to the project and control plane namespaces, and to
+	// control plane and cluster resources.  Changes are propagated, and labels
+	// removed from here are removed from those resources.  Labels in the
+	// unikorn.eschercloud.ai domain are reserved and ignored.
+	Labels map[string]string `json:"labels,omitempty"`
+	// ConflictPolicy defines what to do when a resource already has a label
+	// that was not propagated from the project, defaulting to Preserve.
+	ConflictPolicy *LabelConflictPolicy `json:"conflictPolicy,omitempty"`
+}
+
+// ProjectOffboardingStage defines a stage of offboarding, resources are
+// deleted in dependency order, with each stage being explicitly confirmed.
+// +kubebuilder:validation:Enum=Clusters;ControlPlanes;Project
+type ProjectOffboardingStage string
+
+const (
+	// ProjectOffboardingStageClusters deletes all clusters.
+	ProjectOffboardingStageClusters ProjectOffboardingStage = "Clusters"
+
+	// ProjectOffboardingStageControlPlanes revokes credentials and deletes
+	// all control planes.
+	ProjectOffboardingStageControlPlanes ProjectOffboardingStage = "ControlPlanes"
+
+	// ProjectOffboardingStageProject deletes the project.
+	ProjectOffboardingStageProject ProjectOffboardingStage = "Project"
+)
+
+// ProjectOffboardingSpec records the progress of offboarding.
+type ProjectOffboardingSpec struct {
+	// RequestedAt is when offboarding was requested.
+	RequestedAt metav1.Time `json:"requestedAt"`
+	// Stage is the last stage that was confirmed, if not set nothing
+	// has been deleted yet.
+	Stage *ProjectOffboardingStage `json:"stage,omitempty"`
+	// Usage is recorded when cluster deletion is confirmed, so it is
+	// available for the final usage report.
+	Usage []ProjectOffboardingClusterUsage `json:"usage,omitempty"`
+}
+
+// ProjectOffboardingClusterUsage records how long a cluster ran for, and
+// what it ran on.
+type ProjectOffboardingClusterUsage struct {
+	// ControlPlane is the control plane the cluster belonged to.
+	ControlPlane string `json:"controlPlane"`
+	// Name is the cluster name.
+	Name string `json:"name"`
+	// Start is when the cluster was created.
+	Start metav1.Time `json:"start"`
+	// End is when the cluster was deleted.
+	End metav1.Time `json:"end"`
+	// Machines are the machines the cluster was running when deleted.
+	Machines []ProjectOffboardingMachineUsage `json:"machines,omitempty"`
+}
+
+// ProjectOffboardingMachineUsage records a set of machines.
+type ProjectOffboardingMachineUsage struct {
+	// Pool is the pool name, the control plane is called control-plane.
+	Pool string `json:"pool"`
+	// Flavor is the machine flavor.
+	Flavor string `json:"flavor"`
+	// Replicas is the number of machines.
+	Replicas int `json:"replicas"`
+}
+
+// UpgradeApprovalPolicy defines what happens when no approval decision
+// has been made.
+// +kubebuilder:validation:Enum=Allow;Deny
+type UpgradeApprovalPolicy string
+
+const (
+	// UpgradeApprovalPolicyAllow allows upgrades to proceed.
+	UpgradeApprovalPolicyAllow UpgradeApprovalPolicy = "Allow"
+
+	// UpgradeApprovalPolicyDeny prevents upgrades from proceeding.
+	UpgradeApprovalPolicyDeny UpgradeApprovalPolicy = "Deny"
+)
+
+// UpgradeApprovalSpec defines how upgrades are approved.
+type UpgradeApprovalSpec struct {
+	// Webhook, when specified, is called when an upgrade window is entered
+	// and decides whether the upgrade can proceed.  When not specified,
+	// upgrades must be manually approved via the API.
+	Webhook *UpgradeApprovalWebhookSpec `json:"webhook,omitempty"`
+	// Timeout defines how long to wait for a decision, from when approval
+	// is first requested, before applying the default policy.
+	// +kubebuilder:default="24h"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// DefaultPolicy is applied when no decision has been made within the
+	// timeout, for example the webhook is unavailable.
+	// +kubebuilder:default=Deny
+	DefaultPolicy UpgradeApprovalPolicy `json:"defaultPolicy,omitempty"`
+}
+
+// UpgradeApprovalWebhookSpec defines an external approval service.
+type UpgradeApprovalWebhookSpec struct {
+	// URL is the endpoint upgrade requests are POSTed to.  A 2XX response
+	// approves the upgrade, a 403 denies it, anything else is treated as
+	// no decision having been made.
+	URL string `json:"url"`
+}
+
+// NotificationEvent is a lifecycle event that may be notified.
+// +kubebuilder:validation:Enum=Provisioned;Degraded;UpgradeStarted;UpgradeFailed;Deleted
+type NotificationEvent string
+
+const (
+	// NotificationEventProvisioned is raised when a resource has been
+	// successfully provisioned, or upgraded.
+	NotificationEventProvisioned NotificationEvent = "Provisioned"
+
+	// NotificationEventDegraded is raised when a resource fails to
+	// provision.
+	NotificationEventDegraded NotificationEvent = "Degraded"
+
+	// NotificationEventUpgradeStarted is raised when a resource starts
+	// upgrading to a new application bundle.
+	NotificationEventUpgradeStarted NotificationEvent = "UpgradeStarted"
+
+	// NotificationEventUpgradeFailed is raised when a resource fails to
+	// provision during an upgrade.
+	NotificationEventUpgradeFailed NotificationEvent = "UpgradeFailed"
+
+	// NotificationEventDeleted is raised when a resource has been deleted.
+	NotificationEventDeleted NotificationEvent = "Deleted"
+)
+
+// NotificationWebhookFormat defines the payload sent to a webhook.
+// +kubebuilder:validation:Enum=Generic;Slack
+type NotificationWebhookFormat string
+
+const (
+	// NotificationWebhookFormatGeneric sends a structured JSON document.
+	NotificationWebhookFormatGeneric NotificationWebhookFormat = "Generic"
+
+	// NotificationWebhookFormatSlack sends a message compatible with
+	// Slack incoming webhooks.
+	NotificationWebhookFormatSlack NotificationWebhookFormat = "Slack"
+)
+
+// NotificationWebhookSpec defines an external notification service.
+type NotificationWebhookSpec struct {
+	// URL is the endpoint notifications are POSTed to.
+	URL string `json:"url"`
+	// Format defines the payload that is sent.
+	// +kubebuilder:default=Generic
+	Format NotificationWebhookFormat `json:"format,omitempty"`
+	// Events, when specified, limits the events that are notified,
+	// otherwise all events are.
+	Events []NotificationEvent `json:"events,omitempty"`
 }
 
 // ProjectStatus defines the status of the project.
@@ -209,6 +496,7 @@ type ControlPlaneList struct {
 // +kubebuilder:printcolumn:name="bundle",type="string",JSONPath=".spec.applicationBundle"
 // +kubebuilder:printcolumn:name="namespace",type="string",JSONPath=".status.namespace"
 // +kubebuilder:printcolumn:name="status",type="string",JSONPath=".status.conditions[?(@.type==\"Available\")].reason"
+// +kubebuilder:printcolumn:name="upgrade",type="string",JSONPath=".status.upgrade.target",priority=1
 // +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
 type ControlPlane struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -234,6 +522,35 @@ type ControlPlaneSpec struct {
 	// (Mon-Fri) and before working hours (00:00-07:00 UTC).  When any property is set
 	// the platform will follow the rules for the upgrade method.
 	ApplicationBundleAutoUpgrade *ApplicationBundleAutoUpgradeSpec `json:"applicationBundleAutoUpgrade,omitempty"`
+	// Resources defines the resources allocated to the control plane.  Changing
+	// this will perform a rolling resize of the control plane.  When not set the
+	// defaults defined by the application bundle are used.
+	Resources *ControlPlaneResourcesSpec `json:"resources,omitempty"`
+}
+
+// ControlPlaneResourceClass defines a predefined control plane size.
+// +kubebuilder:validation:Enum=small;medium;large
+type ControlPlaneResourceClass string
+
+const (
+	// ControlPlaneResourceClassSmall is suitable for a handful of clusters.
+	ControlPlaneResourceClassSmall ControlPlaneResourceClass = "small"
+
+	// ControlPlaneResourceClassMedium is suitable for tens of clusters.
+	ControlPlaneResourceClassMedium ControlPlaneResourceClass = "medium"
+
+	// ControlPlaneResourceClassLarge is suitable for large numbers of clusters.
+	ControlPlaneResourceClassLarge ControlPlaneResourceClass = "large"
+)
+
+// ControlPlaneResourcesSpec defines the resources allocated to a control plane.
+type ControlPlaneResourcesSpec struct {
+	// Class selects a predefined resource allocation.
+	Class *ControlPlaneResourceClass `json:"class,omitempty"`
+	// CPU explicitly sets the CPU request, overriding that of the class.
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+	// Memory explicitly sets the memory request, overriding that of the class.
+	Memory *resource.Quantity `json:"memory,omitempty"`
 }
 
 // ControlPlaneStatus defines the status of the project.
@@ -243,6 +560,26 @@ type ControlPlaneStatus struct {
 
 	// Current service state of a control plane.
 	Conditions []coreunikornv1.Condition `json:"conditions,omitempty"`
+
+	// Upgrade, when set, describes a pending automatic application bundle
+	// upgrade.
+	Upgrade *ApplicationBundleUpgradeStatus `json:"upgrade,omitempty"`
+
+	// Components records the versions of applications that make up the
+	// control plane.
+	Components []ControlPlaneComponentStatus `json:"components,omitempty"`
+}
+
+// ControlPlaneComponentStatus records the version of a control plane component.
+type ControlPlaneComponentStatus struct {
+	// Name is the name of the component e.g. cert-manager.
+	Name string `json:"name"`
+	// Version is the version requested by the application bundle.
+	Version string `json:"version"`
+	// DeployedVersion is the version that was last successfully deployed.
+	// This will differ from the requested version while an upgrade is in
+	// progress, and will be unset until the component is first deployed.
+	DeployedVersion string `json:"deployedVersion,omitempty"`
 }
 
 // MachineGeneric contains common things across all pool types, including
@@ -266,11 +603,37 @@ type MachineGeneric struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:default=3
 	Replicas *int `json:"replicas,omitempty"`
-	// ServerGroupID sets the server group of the control plane in
-	// order to maintain anti-affinity rules.
+	// ServerGroupID sets the server group of the machines in
+	// order to maintain affinity or anti-affinity rules.
 	ServerGroupID *string `json:"serverGroupId,omitempty"`
+	// ServerGroupPolicy is the scheduling policy requested for the
+	// server group.  When not set the platform default is used.
+	ServerGroupPolicy *ServerGroupPolicy `json:"serverGroupPolicy,omitempty"`
 }
 
+// ServerGroupPolicy defines how machines in a server group are scheduled
+// with respect to one another.
+// +kubebuilder:validation:Enum=affinity;anti-affinity;soft-affinity;soft-anti-affinity
+type ServerGroupPolicy string
+
+const (
+	// ServerGroupPolicyAffinity requires machines to be scheduled on the
+	// same hypervisor.
+	ServerGroupPolicyAffinity ServerGroupPolicy = "affinity"
+
+	// ServerGroupPolicyAntiAffinity requires machines to be scheduled on
+	// different hypervisors.
+	ServerGroupPolicyAntiAffinity ServerGroupPolicy = "anti-affinity"
+
+	// ServerGroupPolicySoftAffinity prefers machines to be scheduled on the
+	// same hypervisor.
+	ServerGroupPolicySoftAffinity ServerGroupPolicy = "soft-affinity"
+
+	// ServerGroupPolicySoftAntiAffinity prefers machines to be scheduled on
+	// different hypervisors.
+	ServerGroupPolicySoftAntiAffinity ServerGroupPolicy = "soft-anti-affinity"
+)
+
 // File is a file that can be deployed to a cluster node on creation.
 type File struct {
 	// Path is the absolute path to create the file in.
@@ -281,6 +644,8 @@ type File struct {
 
 // MachineGenericAutoscaling defines generic autoscaling configuration.
 // +kubebuilder:validation:XValidation:message="maximumReplicas must be greater than minimumReplicas",rule=(self.maximumReplicas > self.minimumReplicas)
+// +kubebuilder:validation:XValidation:message="warmMachines must not be greater than maximumReplicas",rule=(!has(self.warmMachines) || self.warmMachines <= self.maximumReplicas)
+// +kubebuilder:validation:XValidation:message="warmMachines requires scheduler hints",rule=(!has(self.warmMachines) || has(self.scheduler))
 type MachineGenericAutoscaling struct {
 	// MinimumReplicas defines the minimum number of replicas that
 	// this pool can be scaled down to.
@@ -295,6 +660,11 @@ type MachineGenericAutoscaling struct {
 	// the autoscaler as it cannot derive CPU/memory constraints from
 	// the machine flavor.
 	Scheduler *MachineGenericAutoscalingScheduler `json:"scheduler,omitempty"`
+	// WarmMachines is the number of spare machines to keep booted, with
+	// any pre-pulled images, so workloads can be scheduled without waiting
+	// for a machine to be created.  Spares are billed like any other machine.
+	// +kubebuilder:validation:Minimum=0
+	WarmMachines *int `json:"warmMachines,omitempty"`
 }
 
 // MachineGenericAutoscalingScheduler defines generic autoscaling scheduling
@@ -336,11 +706,120 @@ type KubernetesWorkloadPoolSpec struct {
 	// Files are a set of files that can be installed onto the node
 	// on initialisation/join.
 	Files []File `json:"files,omitempty"`
+	// Taints is the set of node taints to apply to the pool on
+	// initialisation/join, so pods are repelled before any daemon sets
+	// get a chance to run.
+	Taints []Taint `json:"taints,omitempty"`
+	// PreKubeadmCommands are run on the node before it joins the cluster
+	// e.g. to configure package mirrors or install drivers.
+	PreKubeadmCommands []string `json:"preKubeadmCommands,omitempty"`
+	// PostKubeadmCommands are run on the node after it has joined the cluster.
+	PostKubeadmCommands []string `json:"postKubeadmCommands,omitempty"`
+	// PrePullImages are container images that are pulled on node creation,
+	// before the node joins the cluster, so large images are available as
+	// soon as pods are scheduled.
+	PrePullImages []string `json:"prePullImages,omitempty"`
 	// Autoscaling contains optional sclaing limits and scheduling
 	// hints for autoscaling.
 	Autoscaling *MachineGenericAutoscaling `json:"autoscaling,omitempty"`
+	// SSHKeyName overrides the cluster's SSH key for this pool.  When not
+	// specified the cluster's SSH key is inherited, when set to the empty
+	// string no SSH key will be provisioned.
+	SSHKeyName *string `json:"sshKeyName,omitempty"`
+	// NodeConfiguration contains optional node tuning options that
+	// are applied to the kubelet on initialisation/join.
+	NodeConfiguration *NodeConfiguration `json:"nodeConfiguration,omitempty"`
+}
+
+// TaintEffect defines what happens to pods that don't tolerate a taint.
+// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+type TaintEffect string
+
+const (
+	// TaintEffectNoSchedule prevents new pods from being scheduled.
+	TaintEffectNoSchedule TaintEffect = "NoSchedule"
+
+	// TaintEffectPreferNoSchedule avoids scheduling new pods if possible.
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+
+	// TaintEffectNoExecute evicts running pods, and prevents new pods from
+	// being scheduled.
+	TaintEffectNoExecute TaintEffect = "NoExecute"
+)
+
+// Taint is a node taint.
+type Taint struct {
+	// Key is the taint key.
+	Key string `json:"key"`
+	// Value is the optional taint value.
+	Value string `json:"value,omitempty"`
+	// Effect is the taint effect.
+	Effect TaintEffect `json:"effect"`
+}
+
+// NodeConfiguration is a constrained set of node tuning options.  This is
+// deliberately limited to an allow-list of options that are known to be safe
+// rather than exposing the full kubelet configuration.
+type NodeConfiguration struct {
+	// MaxPods is the maximum number of pods that can run on a node.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=250
+	MaxPods *int `json:"maxPods,omitempty"`
+	// SystemReserved reserves resources for operating system daemons.
+	SystemReserved *ReservedResources `json:"systemReserved,omitempty"`
+	// KubeReserved reserves resources for Kubernetes daemons e.g. the
+	// kubelet and container runtime.
+	KubeReserved *ReservedResources `json:"kubeReserved,omitempty"`
+}
+
+// ReservedResources defines resources that are withheld from pod scheduling.
+type ReservedResources struct {
+	// CPU is the amount of CPU to reserve.
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+	// Memory is the amount of memory to reserve.
+	Memory *resource.Quantity `json:"memory,omitempty"`
+	// EphemeralStorage is the amount of ephemeral storage to reserve.
+	EphemeralStorage *resource.Quantity `json:"ephemeralStorage,omitempty"`
 }
 
+// KubeProxyMode defines how kube-proxy routes service traffic.
+// +kubebuilder:validation:Enum=iptables;ipvs
+type KubeProxyMode string
+
+const (
+	// KubeProxyModeIPTables uses iptables rules.
+	KubeProxyModeIPTables KubeProxyMode = "iptables"
+
+	// KubeProxyModeIPVS uses IPVS virtual servers.
+	KubeProxyModeIPVS KubeProxyMode = "ipvs"
+)
+
+// NetworkPlugin defines the CNI that provides pod networking.
+// +kubebuilder:validation:Enum=cilium;calico;none
+type NetworkPlugin string
+
+const (
+	// NetworkPluginCilium installs Cilium.
+	NetworkPluginCilium NetworkPlugin = "cilium"
+
+	// NetworkPluginCalico installs Calico.
+	NetworkPluginCalico NetworkPlugin = "calico"
+
+	// NetworkPluginNone installs nothing, the user is expected to bring
+	// their own CNI.  The cluster will not become healthy until they do.
+	NetworkPluginNone NetworkPlugin = "none"
+)
+
+// KubernetesClusterProvider defines the backend that provisions a cluster.
+// +kubebuilder:validation:Enum=capo
+type KubernetesClusterProvider string
+
+const (
+	// KubernetesClusterProviderCAPO provisions clusters on OpenStack with
+	// Cluster API.
+	KubernetesClusterProviderCAPO KubernetesClusterProvider = "capo"
+)
+
 // KubernetesClusterList is a typed list of kubernetes clusters.
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type KubernetesClusterList struct {
@@ -350,9 +829,8 @@ type KubernetesClusterList struct {
 }
 
 // KubernetesCluster is an object representing a Kubernetes cluster.
-// For now, this is a monolith for simplicity.  In future it may reference
-// a provider specific implementation e.g. if CAPI goes out of favour for
-// some other new starlet.
+// The provider selects the backend that provisions it, which is currently
+// always Cluster API on OpenStack.
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:scope=Namespaced,categories=unikorn
@@ -363,6 +841,8 @@ type KubernetesClusterList struct {
 // +kubebuilder:printcolumn:name="flavor",type="string",JSONPath=".spec.controlPlane.flavor"
 // +kubebuilder:printcolumn:name="replicas",type="string",JSONPath=".spec.controlPlane.replicas"
 // +kubebuilder:printcolumn:name="status",type="string",JSONPath=".status.conditions[?(@.type==\"Available\")].reason"
+// +kubebuilder:printcolumn:name="hibernated",type="string",JSONPath=".status.hibernation.status",priority=1
+// +kubebuilder:printcolumn:name="upgrade",type="string",JSONPath=".status.upgrade.target",priority=1
 // +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
 type KubernetesCluster struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -378,6 +858,9 @@ type KubernetesClusterSpec struct {
 	// Timeout is the maximum time to attempt to provision a cluster before aborting.
 	// +kubebuilder:default="20m"
 	Timeout *metav1.Duration `json:"timeout"`
+	// Provider selects the backend that provisions the cluster.  This cannot
+	// be changed once the cluster has been created.  Defaults to capo.
+	Provider *KubernetesClusterProvider `json:"provider,omitempty"`
 	// Openstack defines global Openstack related configuration.
 	Openstack *KubernetesClusterOpenstackSpec `json:"openstack"`
 	// Network defines the Kubernetes networking.
@@ -399,6 +882,78 @@ type KubernetesClusterSpec struct {
 	// (Mon-Fri) and before working hours (00:00-07:00 UTC).  When any property is set
 	// the platform will follow the rules for the upgrade method.
 	ApplicationBundleAutoUpgrade *ApplicationBundleAutoUpgradeSpec `json:"applicationBundleAutoUpgrade,omitempty"`
+	// UpgradeFreeze, when set, inhibits all automatic upgrades, including those
+	// forced by a bundle being end of life, until it expires.  This allows upgrades
+	// to be blocked during change embargo periods.
+	UpgradeFreeze *UpgradeFreezeSpec `json:"upgradeFreeze,omitempty"`
+	// SSHCertificateAuthority, when set, configures workload pool nodes to trust
+	// SSH certificates signed by the cluster's certificate authority.  The private
+	// key is held by the platform, and used to issue short-lived certificates.
+	SSHCertificateAuthority *SSHCertificateAuthoritySpec `json:"sshCertificateAuthority,omitempty"`
+	// NodeAllowList defines external traffic that is allowed to reach workload
+	// pool nodes e.g. for NodePort and LoadBalancer services, and optionally
+	// restricts the traffic allowed to leave them.  This is only enforced when
+	// the node firewall feature is enabled.
+	NodeAllowList []NodeAllowListRule `json:"nodeAllowList,omitempty"`
+}
+
+// NodeAllowListProtocol defines the IP protocol of a node allow list rule.
+// +kubebuilder:validation:Enum=tcp;udp
+type NodeAllowListProtocol string
+
+const (
+	// NodeAllowListProtocolTCP allows TCP traffic.
+	NodeAllowListProtocolTCP NodeAllowListProtocol = "tcp"
+
+	// NodeAllowListProtocolUDP allows UDP traffic.
+	NodeAllowListProtocolUDP NodeAllowListProtocol = "udp"
+)
+
+// NodeAllowListDirection defines the direction of traffic a node allow list rule
+// applies to.
+// +kubebuilder:validation:Enum=ingress;egress
+type NodeAllowListDirection string
+
+const (
+	// NodeAllowListDirectionIngress allows traffic to reach nodes.
+	NodeAllowListDirectionIngress NodeAllowListDirection = "ingress"
+
+	// NodeAllowListDirectionEgress allows traffic to leave nodes.  When any
+	// egress rule is defined, the default rule that allows all egress traffic
+	// is removed.
+	NodeAllowListDirectionEgress NodeAllowListDirection = "egress"
+)
+
+type NodeAllowListRule struct {
+	// Direction is the direction of traffic to allow, defaulting to ingress.
+	Direction *NodeAllowListDirection `json:"direction,omitempty"`
+	// Protocol is the IP protocol to allow.
+	Protocol NodeAllowListProtocol `json:"protocol"`
+	// Port is the port to allow, or the start of a port range.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int `json:"port"`
+	// PortMax, if set, is the end of the port range.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	PortMax *int `json:"portMax,omitempty"`
+	// Prefixes is the list of IPv4 and IPv6 prefixes that are allowed access,
+	// or for egress rules, that may be accessed.
+	// +kubebuilder:validation:MinItems=1
+	Prefixes []IPPrefix `json:"prefixes"`
+}
+
+type UpgradeFreezeSpec struct {
+	// Until is the time the freeze expires.
+	Until metav1.Time `json:"until"`
+	// Reason is a human readable reason for the freeze.
+	Reason *string `json:"reason,omitempty"`
+}
+
+type SSHCertificateAuthoritySpec struct {
+	// PublicKey is the certificate authority's public key in authorized_keys
+	// format.
+	PublicKey string `json:"publicKey"`
 }
 
 type KubernetesClusterOpenstackSpec struct {
@@ -422,36 +977,107 @@ type KubernetesClusterOpenstackSpec struct {
 	VolumeFailureDomain *string `json:"volumeFailureDomain,omitempty"`
 	// ExternalNetworkID is the Openstack external network ID.
 	ExternalNetworkID *string `json:"externalNetworkId"`
+	// Network, if set, references an existing network that the cluster will
+	// be provisioned on, rather than creating one.  This cannot be changed
+	// once the cluster has been created.
+	Network *KubernetesClusterOpenstackNetworkSpec `json:"network,omitempty"`
+	// LoadBalancer selects how load balancers are implemented by default
+	// for services of type LoadBalancer.
+	LoadBalancer *LoadBalancerSpec `json:"loadBalancer,omitempty"`
+}
+
+// LoadBalancerProvider selects the Octavia driver that implements a load balancer.
+// +kubebuilder:validation:Enum=amphora;ovn
+type LoadBalancerProvider string
+
+const (
+	// LoadBalancerProviderAmphora implements a load balancer with a dedicated
+	// virtual machine.
+	LoadBalancerProviderAmphora LoadBalancerProvider = "amphora"
+
+	// LoadBalancerProviderOVN implements a load balancer in the virtual network,
+	// this is cheaper, but only supports layer 4 and the source IP port algorithm.
+	LoadBalancerProviderOVN LoadBalancerProvider = "ovn"
+)
+
+// LoadBalancerSpec selects how an Octavia load balancer is implemented.
+type LoadBalancerSpec struct {
+	// Provider is the Octavia provider, defaulting to the cloud's default
+	// if not specified.
+	Provider *LoadBalancerProvider `json:"provider,omitempty"`
+	// FlavorID is the Octavia flavor ID, flavors are provider specific.
+	FlavorID *string `json:"flavorId,omitempty"`
+}
+
+// KubernetesClusterOpenstackNetworkSpec references existing Neutron resources.
+type KubernetesClusterOpenstackNetworkSpec struct {
+	// NetworkID is the ID of the network to attach nodes to.
+	NetworkID *string `json:"networkId"`
+	// SubnetID is the ID of the subnet, within the network, that nodes
+	// are allocated addresses from.  The node prefix must match the subnet's.
+	SubnetID *string `json:"subnetId"`
+	// RouterID is the ID of the router that connects the subnet to the
+	// external network.  This is only required where the router cannot
+	// be discovered from the subnet.
+	RouterID *string `json:"routerId,omitempty"`
 }
 
 type KubernetesClusterAPISpec struct {
 	// SubjectAlternativeNames is a list of X.509 SANs to add to the API
 	// certificate.
 	SubjectAlternativeNames []string `json:"subjectAlternativeNames,omitempty"`
-	// AllowedPrefixes is a list of all IPv4 prefixes that are allowed to access
-	// the API.
-	AllowedPrefixes []IPv4Prefix `json:"allowedPrefixes,omitempty"`
+	// CertificateReissueTime, when set, requests that API server certificates
+	// are reissued with the current SANs.  Control plane machines created before
+	// this time are replaced with a rolling update.
+	CertificateReissueTime *metav1.Time `json:"certificateReissueTime,omitempty"`
+	// AllowedPrefixes is a list of all IPv4 and IPv6 prefixes that are allowed
+	// to access the API.
+	AllowedPrefixes []IPPrefix `json:"allowedPrefixes,omitempty"`
+	// Private, when true, does not allocate a floating IP for the API
+	// load balancer, so it's only accessible on the node network.  This is
+	// mutually exclusive with allowed prefixes.
+	Private *bool `json:"private,omitempty"`
+	// LoadBalancer selects how the API load balancer is implemented.
+	// This cannot be changed once the cluster has been created.
+	LoadBalancer *LoadBalancerSpec `json:"loadBalancer,omitempty"`
 }
 
 type KubernetesClusterNetworkSpec struct {
-	// NodeNetwork is the IPv4 prefix for the node network.
-	NodeNetwork *IPv4Prefix `json:"nodeNetwork"`
-	// PodNetwork is the IPv4 prefix for the pod network.
-	PodNetwork *IPv4Prefix `json:"podNetwork"`
-	// ServiceNetwork is the IPv4 prefix for the service network.
-	ServiceNetwork *IPv4Prefix `json:"serviceNetwork"`
+	// NodeNetwork is the IPv4 or IPv6 prefix for the node network.
+	NodeNetwork *IPPrefix `json:"nodeNetwork"`
+	// PodNetwork is the primary prefix for the pod network.
+	PodNetwork *IPPrefix `json:"podNetwork"`
+	// ServiceNetwork is the primary prefix for the service network, this
+	// must be of the same address family as the pod network.
+	ServiceNetwork *IPPrefix `json:"serviceNetwork"`
+	// SecondaryPodNetwork enables dual-stack networking when set, and must
+	// be of the opposite address family to the pod network.
+	SecondaryPodNetwork *IPPrefix `json:"secondaryPodNetwork,omitempty"`
+	// SecondaryServiceNetwork is required for dual-stack networking, and must
+	// be of the same address family as the secondary pod network.
+	SecondaryServiceNetwork *IPPrefix `json:"secondaryServiceNetwork,omitempty"`
 	// DNSNameservers sets the DNS nameservers for pods.
 	// At present due to some technical challenges, this must contain
 	// only one DNS server.
 	// +listType=set
 	// +kubebuilder:validation:MinItems=1
-	DNSNameservers []IPv4Address `json:"dnsNameservers"`
+	DNSNameservers []IPAddress `json:"dnsNameservers"`
+	// KubeProxyMode selects the kube-proxy mode.  As kube-proxy is
+	// configured once per cluster, this applies to all nodes.  Defaults
+	// to iptables.
+	KubeProxyMode *KubeProxyMode `json:"kubeProxyMode,omitempty"`
+	// Plugin selects the CNI that provides pod networking.  This cannot be
+	// changed once the cluster has been created.  Defaults to cilium.
+	Plugin *NetworkPlugin `json:"plugin,omitempty"`
 }
 
 type KubernetesClusterFeaturesSpec struct {
 	// Autoscaling, if true, provisions a cluster autoscaler
 	// and allows workload pools to specify autoscaling configuration.
 	Autoscaling *bool `json:"autoscaling,omitempty"`
+	// AutoscalingConfiguration tunes the cluster autoscaler's behaviour.
+	// This is only valid when autoscaling is enabled.
+	AutoscalingConfiguration *ClusterAutoscalerSpec `json:"autoscalingConfiguration,omitempty"`
 	// Ingress, if true, provisions an Nginx ingress controller.
 	Ingress *bool `json:"ingress,omitempty"`
 	// CertManager, if true, provisions cert-manager.
@@ -463,9 +1089,75 @@ type KubernetesClusterFeaturesSpec struct {
 	FileStorage *bool `json:"fileStorage,omitempty"`
 	// Prometheus, if true, installs the Prometheus Operator.
 	Prometheus *bool `json:"prometheus,omitempty"`
+	// MetricsFederation, if true, provisions a Prometheus server that forwards
+	// metrics to the platform's central Prometheus, labelled with the project,
+	// control plane and cluster.  This is only valid when Prometheus is enabled.
+	MetricsFederation *bool `json:"metricsFederation,omitempty"`
 	// NvidiaOperator, if false do not install the Nvidia Operator, otherwise
 	// install if GPU flavors are detected
 	NvidiaOperator *bool `json:"nvidiaOperator,omitempty"`
+	// NodeFirewall, if true, denies external traffic to workload pool nodes
+	// unless explicitly allowed by the cluster's node allow list.
+	NodeFirewall *bool `json:"nodeFirewall,omitempty"`
+	// Backup, if true, provisions Velero to periodically back up cluster
+	// resources and persistent volumes to platform provided object storage.
+	Backup *bool `json:"backup,omitempty"`
+	// BackupConfiguration defines when backups are taken and how long they
+	// are kept for.  This is only valid when backup is enabled.
+	BackupConfiguration *BackupSpec `json:"backupConfiguration,omitempty"`
+	// CostAllocation, if true, labels all nodes and pods with the project,
+	// control plane and cluster they belong to, and nodes additionally with
+	// their workload pool, so cost monitoring tools can attribute spend.
+	// Pods are labelled by an admission policy installed with Kyverno.
+	CostAllocation *bool `json:"costAllocation,omitempty"`
+}
+
+type BackupSpec struct {
+	// Schedule is a cron expression that defines when backups are taken,
+	// evaluated in UTC.
+	Schedule *string `json:"schedule,omitempty"`
+	// Retention is how long backups are kept before being deleted.
+	Retention *metav1.Duration `json:"retention,omitempty"`
+}
+
+// ClusterAutoscalerExpander defines how the cluster autoscaler chooses which
+// workload pool to scale up.
+// +kubebuilder:validation:Enum=random;most-pods;least-waste;least-nodes
+type ClusterAutoscalerExpander string
+
+const (
+	// ClusterAutoscalerExpanderRandom picks a workload pool at random.
+	ClusterAutoscalerExpanderRandom ClusterAutoscalerExpander = "random"
+
+	// ClusterAutoscalerExpanderMostPods picks the workload pool that can
+	// schedule the most pods.
+	ClusterAutoscalerExpanderMostPods ClusterAutoscalerExpander = "most-pods"
+
+	// ClusterAutoscalerExpanderLeastWaste picks the workload pool that will
+	// have the least idle CPU and memory after scaling.
+	ClusterAutoscalerExpanderLeastWaste ClusterAutoscalerExpander = "least-waste"
+
+	// ClusterAutoscalerExpanderLeastNodes picks the workload pool that needs
+	// the fewest new nodes.
+	ClusterAutoscalerExpanderLeastNodes ClusterAutoscalerExpander = "least-nodes"
+)
+
+// ClusterAutoscalerSpec tunes the cluster autoscaler.  Where a value is not
+// specified, the application defaults are used.
+type ClusterAutoscalerSpec struct {
+	// ScaleDownDelayAfterAdd is how long after a scale up that scale down
+	// evaluation resumes.
+	ScaleDownDelayAfterAdd *metav1.Duration `json:"scaleDownDelayAfterAdd,omitempty"`
+	// ScaleDownUnneededTime is how long a node must be unneeded before it
+	// is eligible for scale down.
+	ScaleDownUnneededTime *metav1.Duration `json:"scaleDownUnneededTime,omitempty"`
+	// ScaleDownUtilizationThreshold is the percentage of a node's resources
+	// that must be requested, below which it is considered for scale down.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	ScaleDownUtilizationThreshold *int `json:"scaleDownUtilizationThreshold,omitempty"`
+	// Expander selects how workload pools are chosen when scaling up.
+	Expander *ClusterAutoscalerExpander `json:"expander,omitempty"`
 }
 
 type KubernetesClusterControlPlaneSpec struct {
@@ -483,6 +1175,22 @@ type KubernetesClusterWorkloadPoolsSpec struct {
 	Pools []KubernetesClusterWorkloadPoolsPoolSpec `json:"pools,omitempty"`
 }
 
+const (
+	// KubernetesClusterConditionHibernated is true once the cluster has been
+	// reconciled while hibernated i.e. the workload pools have been scaled to
+	// zero, and false once it has been reconciled while resumed.
+	KubernetesClusterConditionHibernated = "Hibernated"
+
+	// KubernetesClusterConditionReasonHibernated is used for the Hibernated
+	// condition when the workload pools have been scaled to zero.
+	KubernetesClusterConditionReasonHibernated = "Hibernated"
+
+	// KubernetesClusterConditionReasonRunning is used for the Hibernated
+	// condition when the workload pools have been scaled to their requested
+	// sizes.
+	KubernetesClusterConditionReasonRunning = "Running"
+)
+
 // KubernetesClusterStatus defines the observed state of the Kubernetes cluster.
 type KubernetesClusterStatus struct {
 	// Namespace defines the namespace a cluster resides in.
@@ -490,6 +1198,210 @@ type KubernetesClusterStatus struct {
 
 	// Current service state of a Kubernetes cluster.
 	Conditions []coreunikornv1.Condition `json:"conditions,omitempty"`
+
+	// Upgrade, when set, describes a pending automatic application bundle
+	// upgrade.
+	Upgrade *ApplicationBundleUpgradeStatus `json:"upgrade,omitempty"`
+
+	// Hibernation is the Hibernated condition, set by the provisioner.  It's
+	// kept apart from the other conditions, as their types and reasons are
+	// fixed by unikorn-core.
+	Hibernation *metav1.Condition `json:"hibernation,omitempty"`
+
+	// ControlPlane records the Kubernetes version and image last successfully
+	// deployed to the control plane.
+	ControlPlane *MachineVersionStatus `json:"controlPlane,omitempty"`
+
+	// WorkloadPools records the Kubernetes version and image last successfully
+	// deployed to each workload pool.  While the control plane is being upgraded
+	// workload pools are held at these, as kubelets must never be newer than
+	// the API server.
+	WorkloadPools []WorkloadPoolVersionStatus `json:"workloadPools,omitempty"`
+
+	// StageTimings records when each provisioning stage started and completed
+	// for the current generation of the cluster.
+	StageTimings []ProvisioningStageTiming `json:"stageTimings,omitempty"`
+
+	// Applications records the applications last successfully applied to
+	// continuous delivery, so unchanged ones can be skipped.
+	Applications []ApplicationSyncStatus `json:"applications,omitempty"`
+
+	// APICertificate records the progress of the last API server certificate
+	// reissue.
+	APICertificate *APICertificateStatus `json:"apiCertificate,omitempty"`
+
+	// MachineVerification records the outcome of periodically cross-checking
+	// OpenStack servers against cluster API machines.
+	MachineVerification *MachineVerificationStatus `json:"machineVerification,omitempty"`
+}
+
+// MachineVerificationStatus records whether OpenStack and cluster API agree
+// about the machines that make up a cluster.
+type MachineVerificationStatus struct {
+	// Degraded is true when discrepancies have been found.
+	Degraded bool `json:"degraded"`
+	// LastTransitionTime is when the discrepancies last changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// Discrepancies lists what was found to be inconsistent.
+	Discrepancies []MachineDiscrepancy `json:"discrepancies,omitempty"`
+}
+
+// MachineDiscrepancyKind defines how OpenStack and cluster API disagree.
+// +kubebuilder:validation:Enum=StrayServer;MissingServer
+type MachineDiscrepancyKind string
+
+const (
+	// MachineDiscrepancyKindStrayServer is a server that belongs to the
+	// cluster, but has no corresponding machine.
+	MachineDiscrepancyKindStrayServer MachineDiscrepancyKind = "StrayServer"
+
+	// MachineDiscrepancyKindMissingServer is a machine whose server no
+	// longer exists.
+	MachineDiscrepancyKindMissingServer MachineDiscrepancyKind = "MissingServer"
+)
+
+// MachineDiscrepancy is an inconsistency between OpenStack and cluster API.
+type MachineDiscrepancy struct {
+	// Kind defines the type of discrepancy.
+	Kind MachineDiscrepancyKind `json:"kind"`
+	// Machine is the cluster API machine name, if one exists.
+	Machine string `json:"machine,omitempty"`
+	// ServerID is the OpenStack server ID, if known.
+	ServerID string `json:"serverId,omitempty"`
+	// ServerName is the OpenStack server name, if one exists.
+	ServerName string `json:"serverName,omitempty"`
+}
+
+// APICertificateStatus records the progress of an API server certificate reissue.
+type APICertificateStatus struct {
+	// ReissueTime is the reissue request this status relates to.
+	ReissueTime metav1.Time `json:"reissueTime"`
+	// Replicas is the number of control plane machines.
+	Replicas int `json:"replicas"`
+	// UpdatedReplicas is the number of control plane machines that have been
+	// replaced, and therefore have reissued certificates.
+	UpdatedReplicas int `json:"updatedReplicas"`
+	// CompletionTime is set once all control plane machines have been replaced.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// MachineVersionStatus records what is deployed to a set of machines.
+type MachineVersionStatus struct {
+	// Version is the Kubernetes version.
+	Version SemanticVersion `json:"version"`
+	// Image is the OpenStack Glance image.
+	Image string `json:"image"`
+}
+
+// WorkloadPoolVersionStatus records what is deployed to a workload pool.
+type WorkloadPoolVersionStatus struct {
+	// Name is the workload pool name.
+	Name string `json:"name"`
+
+	MachineVersionStatus `json:",inline"`
+}
+
+// ApplicationSyncStatus records what was last applied for an application.
+type ApplicationSyncStatus struct {
+	// Name is the application name.
+	Name string `json:"name"`
+	// Hash is a digest of the rendered application, including the chart
+	// version and values.
+	Hash string `json:"hash"`
+	// SyncTime is when the application was last applied.
+	SyncTime metav1.Time `json:"syncTime"`
+}
+
+// ProvisioningStageTiming records how long a provisioning stage took.
+type ProvisioningStageTiming struct {
+	// Name is the provisioning stage name.
+	Name string `json:"name"`
+	// Generation is the resource generation the stage was provisioned for.
+	Generation int64 `json:"generation"`
+	// StartTime is when the stage was first provisioned.
+	StartTime metav1.Time `json:"startTime"`
+	// CompletionTime is when the stage was first provisioned successfully.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// ClusterTemplateList defines a list of cluster templates.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTemplate `json:"items"`
+}
+
+// ClusterTemplate is an operator published preset for Kubernetes clusters e.g.
+// "GPU training small" or "HA production".  Clients use these to pre-populate
+// cluster creation requests, and the server will use them to provide defaults
+// for anything omitted from a creation request that references the template.
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster,categories=unikorn
+// +kubebuilder:printcolumn:name="description",type="string",JSONPath=".spec.description"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+type ClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ClusterTemplateSpec `json:"spec"`
+}
+
+// ClusterTemplateSpec defines the default values to apply to a cluster.
+type ClusterTemplateSpec struct {
+	// Description is a verbose description of the template and what
+	// it's intended to be used for.
+	Description *string `json:"description,omitempty"`
+	// ApplicationBundle is the default application bundle to use.
+	ApplicationBundle *string `json:"applicationBundle,omitempty"`
+	// ControlPlane defines the default control plane machines.
+	ControlPlane *ClusterTemplateMachineSpec `json:"controlPlane,omitempty"`
+	// WorkloadPools defines the default workload pools.  These are used
+	// when none are requested, otherwise they provide defaults for requested
+	// pools with the same name.
+	WorkloadPools []ClusterTemplateWorkloadPoolSpec `json:"workloadPools,omitempty"`
+	// Features defines the default set of add on features.
+	Features *KubernetesClusterFeaturesSpec `json:"features,omitempty"`
+}
+
+// ClusterTemplateMachineSpec defines the default values to apply to a pool
+// of machines.  Images and versions are omitted as they are tied to the
+// application bundle.
+type ClusterTemplateMachineSpec struct {
+	// Flavor is the OpenStack Nova flavor to deploy with.
+	Flavor *string `json:"flavor,omitempty"`
+	// DiskSize is the persistent root disk size to deploy with.
+	DiskSize *resource.Quantity `json:"diskSize,omitempty"`
+	// Replicas is the initial pool size to deploy.
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int `json:"replicas,omitempty"`
+}
+
+// ClusterTemplateWorkloadPoolSpec defines the default values to apply to
+// a workload pool.
+type ClusterTemplateWorkloadPoolSpec struct {
+	ClusterTemplateMachineSpec `json:",inline"`
+	// Name is the name of the pool.
+	Name string `json:"name"`
+	// Labels is the set of node labels to apply to the pool on
+	// initialisation/join.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Autoscaling contains optional scaling limits.
+	Autoscaling *ClusterTemplateAutoscalingSpec `json:"autoscaling,omitempty"`
+}
+
+// ClusterTemplateAutoscalingSpec defines default autoscaling limits.
+// +kubebuilder:validation:XValidation:message="maximumReplicas must be greater than minimumReplicas",rule=(self.maximumReplicas > self.minimumReplicas)
+type ClusterTemplateAutoscalingSpec struct {
+	// MinimumReplicas defines the minimum number of replicas that
+	// this pool can be scaled down to.
+	// +kubebuilder:validation:Minimum=0
+	MinimumReplicas int `json:"minimumReplicas"`
+	// MaximumReplicas defines the maximum numer of replicas that
+	// this pool can be scaled up to.
+	// +kubebuilder:validation:Minimum=1
+	MaximumReplicas int `json:"maximumReplicas"`
 }
 
 // ControlPlaneApplicationBundleList defines a list of application bundles.
@@ -540,12 +1452,32 @@ type KubernetesClusterApplicationBundleList struct {
 // +kubebuilder:printcolumn:name="version",type="string",JSONPath=".spec.version"
 // +kubebuilder:printcolumn:name="preview",type="string",JSONPath=".spec.preview"
 // +kubebuilder:printcolumn:name="end of life",type="string",JSONPath=".spec.endOfLife"
+// +kubebuilder:printcolumn:name="minimum kubernetes",type="string",JSONPath=".spec.kubernetes.minimumVersion",priority=1
+// +kubebuilder:printcolumn:name="maximum kubernetes",type="string",JSONPath=".spec.kubernetes.maximumVersion",priority=1
 // +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
 type KubernetesClusterApplicationBundle struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	Spec              ApplicationBundleSpec   `json:"spec"`
-	Status            ApplicationBundleStatus `json:"status,omitempty"`
+	Spec              KubernetesClusterApplicationBundleSpec `json:"spec"`
+	Status            ApplicationBundleStatus                `json:"status,omitempty"`
+}
+
+// KubernetesClusterApplicationBundleSpec defines the requested resource state.
+type KubernetesClusterApplicationBundleSpec struct {
+	ApplicationBundleSpec `json:",inline"`
+	// Kubernetes defines the range of Kubernetes versions the bundle is
+	// compatible with.  When not specified, all versions are considered
+	// to be compatible.
+	Kubernetes *ApplicationBundleKubernetesSpec `json:"kubernetes,omitempty"`
+}
+
+type ApplicationBundleKubernetesSpec struct {
+	// MinimumVersion is the oldest Kubernetes version, inclusive, that the
+	// bundle supports.
+	MinimumVersion *SemanticVersion `json:"minimumVersion,omitempty"`
+	// MaximumVersion is the newest Kubernetes version, inclusive, that the
+	// bundle supports.
+	MaximumVersion *SemanticVersion `json:"maximumVersion,omitempty"`
 }
 
 // ApplicationBundleSpec defines the requested resource state.
@@ -555,14 +1487,52 @@ type ApplicationBundleSpec struct {
 	// Preview indicates that this bundle is a preview and should not be
 	// used by default.
 	Preview *bool `json:"preview,omitempty"`
+	// Channel is the release channel the bundle is published to.  When
+	// not specified the bundle is considered stable.
+	Channel *ApplicationBundleChannel `json:"channel,omitempty"`
 	// EndOfLife marks when this bundle should not be advertised any more
 	// by Unikorn server.  It also provides a hint that users should upgrade
 	// ahead of the deadline, or that a forced upgrade should be triggered.
 	EndOfLife *metav1.Time `json:"endOfLife,omitempty"`
 	// Applications is a list of application references for the bundle.
 	Applications []ApplicationNamedReference `json:"applications,omitempty"`
+	// Display contains optional human readable metadata used when presenting
+	// the bundle in a user interface.
+	Display *ApplicationBundleDisplaySpec `json:"display,omitempty"`
+}
+
+// ApplicationBundleDisplaySpec defines human readable bundle metadata.
+type ApplicationBundleDisplaySpec struct {
+	// Description is a short, single line summary of the bundle.
+	// +kubebuilder:validation:MaxLength=256
+	Description *string `json:"description,omitempty"`
+	// Category groups related bundles together in a catalog e.g. "networking".
+	Category *string `json:"category,omitempty"`
+	// IconURL is a link to an icon for the bundle.
+	IconURL *string `json:"iconUrl,omitempty"`
+	// DocumentationURL is a link to documentation for the bundle e.g. release
+	// notes.
+	DocumentationURL *string `json:"documentationUrl,omitempty"`
 }
 
+// ApplicationBundleChannel defines a release channel for application bundles.
+// +kubebuilder:validation:Enum=stable;rapid;preview
+type ApplicationBundleChannel string
+
+const (
+	// ApplicationBundleChannelStable contains bundles that are considered
+	// production ready.
+	ApplicationBundleChannelStable ApplicationBundleChannel = "stable"
+
+	// ApplicationBundleChannelRapid contains bundles that are released
+	// earlier than stable, in order to get the latest features.
+	ApplicationBundleChannelRapid ApplicationBundleChannel = "rapid"
+
+	// ApplicationBundleChannelPreview contains bundles that are released
+	// for early testing.
+	ApplicationBundleChannelPreview ApplicationBundleChannel = "preview"
+)
+
 type ApplicationNamedReference struct {
 	// Name is the name of the application.  This must match what is encoded into
 	// Unikorn's application management engine.
@@ -579,6 +1549,28 @@ type ApplicationBundleAutoUpgradeSpec struct {
 	// slot within the specified time windows in order to load balance and
 	// mitigate against defects.
 	WeekDay *ApplicationBundleAutoUpgradeWeekDaySpec `json:"weekday,omitempty"`
+	// TimeZone is an IANA time zone name e.g. Europe/London, that upgrade
+	// windows are expressed in.  When not specified, windows are in UTC.
+	// Using a time zone, rather than a fixed offset, means upgrade windows
+	// follow any daylight saving changes.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:message="timeZone must be a named time zone",rule=(self != 'Local')
+	TimeZone *string `json:"timeZone,omitempty"`
+	// Channel is the release channel to subscribe to.  Resources will be
+	// upgraded to the newest bundle in that channel, or any more stable one.
+	// When not specified, the stable channel is used.
+	Channel *ApplicationBundleChannel `json:"channel,omitempty"`
+}
+
+// ApplicationBundleUpgradeStatus is maintained by the monitor and records
+// when a resource is scheduled to be automatically upgraded, and to what.
+type ApplicationBundleUpgradeStatus struct {
+	// Target is the application bundle that will be upgraded to.
+	Target string `json:"target"`
+	// NextUpgradeAt is the start of the next upgrade window.
+	NextUpgradeAt metav1.Time `json:"nextUpgradeAt"`
+	// ApprovalRequestedAt is set when the upgrade is awaiting approval.
+	ApprovalRequestedAt *metav1.Time `json:"approvalRequestedAt,omitempty"`
 }
 
 type ApplicationBundleAutoUpgradeWeekDaySpec struct {
@@ -599,7 +1591,8 @@ type ApplicationBundleAutoUpgradeWeekDaySpec struct {
 }
 
 type ApplicationBundleAutoUpgradeWindowSpec struct {
-	// Start is the upgrade window start hour in UTC.  Upgrades will be
+	// Start is the upgrade window start hour, in the time zone defined
+	// by the upgrade specification, or UTC if not set.  Upgrades will be
 	// deterministically scheduled between start and end to balance load
 	// across the platform.  Windows can span days, so start=22 and end=07
 	// will start at 22:00 on the selected day, and end 07:00 the following
@@ -607,8 +1600,137 @@ type ApplicationBundleAutoUpgradeWindowSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=23
 	Start int `json:"start"`
-	// End is the upgrade window end hour in UTC.
+	// End is the upgrade window end hour.
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=23
 	End int `json:"end"`
 }
+
+// ServerStateList defines a list of server state entries.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ServerStateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServerState `json:"items"`
+}
+
+// ServerState is a piece of state shared between server replicas e.g. a debug
+// capture.  Keys are arbitrary, so the resource name is derived from a hash of
+// the bucket and key, with the originals being recorded in the specification.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced,categories=unikorn
+// +kubebuilder:printcolumn:name="bucket",type="string",JSONPath=".spec.bucket"
+// +kubebuilder:printcolumn:name="key",type="string",JSONPath=".spec.key"
+// +kubebuilder:printcolumn:name="expiry",type="string",JSONPath=".spec.expiryTime"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+type ServerState struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ServerStateSpec `json:"spec"`
+}
+
+// ServerStateSpec defines a key/value pair.
+type ServerStateSpec struct {
+	// Bucket groups related keys together.
+	Bucket string `json:"bucket"`
+	// Key uniquely identifies the value within the bucket.
+	Key string `json:"key"`
+	// Value is the opaque state.
+	Value []byte `json:"value,omitempty"`
+	// ExpiryTime, when set, is when the state is no longer valid.
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+}
+
+// ProjectRoleBindingList defines a list of project role bindings.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ProjectRoleBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectRoleBinding `json:"items"`
+}
+
+// ProjectRoleBinding grants a project role to users based on attributes of
+// their identity, for example OIDC group membership.  Bindings live in the
+// project namespace, and are evaluated when a token is scoped to the project.
+// This allows access to be managed declaratively from the identity system,
+// rather than by granting roles to individual users.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced,categories=unikorn
+// +kubebuilder:printcolumn:name="role",type="string",JSONPath=".spec.role"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+type ProjectRoleBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ProjectRoleBindingSpec `json:"spec"`
+}
+
+// ProjectRoleBindingSpec defines who is granted a project role.
+type ProjectRoleBindingSpec struct {
+	// Role is the project role that is granted.
+	// +kubebuilder:validation:Enum=admin;editor;reader
+	Role string `json:"role"`
+	// Subjects are the identity attributes that are granted the role, a
+	// user matching any subject is granted the role.
+	// +kubebuilder:validation:MinItems=1
+	Subjects []ProjectRoleBindingSubject `json:"subjects"`
+}
+
+// ProjectRoleBindingSubjectKind defines how a subject is matched.
+// +kubebuilder:validation:Enum=OIDCGroup;KeystoneRole
+type ProjectRoleBindingSubjectKind string
+
+const (
+	// ProjectRoleBindingSubjectOIDCGroup matches a group in the OIDC ID token
+	// groups claim.
+	ProjectRoleBindingSubjectOIDCGroup ProjectRoleBindingSubjectKind = "OIDCGroup"
+
+	// ProjectRoleBindingSubjectKeystoneRole matches a Keystone role the user
+	// has on the project.
+	ProjectRoleBindingSubjectKeystoneRole ProjectRoleBindingSubjectKind = "KeystoneRole"
+)
+
+// ProjectRoleBindingSubject identifies a set of users.
+type ProjectRoleBindingSubject struct {
+	// Kind defines how the subject is matched.
+	Kind ProjectRoleBindingSubjectKind `json:"kind"`
+	// Name is the OIDC group or Keystone role name.
+	Name string `json:"name"`
+}
+
+// PlatformChangelogList defines a list of platform changelog entries.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type PlatformChangelogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PlatformChangelog `json:"items"`
+}
+
+// PlatformChangelog describes what changed in a release of the platform
+// itself, so clients can tell users what's new after an upgrade.  There is
+// one resource per release, and they are installed along with the platform.
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Cluster,categories=unikorn
+// +kubebuilder:printcolumn:name="version",type="string",JSONPath=".spec.version"
+// +kubebuilder:printcolumn:name="date",type="string",JSONPath=".spec.date"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+type PlatformChangelog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              PlatformChangelogSpec `json:"spec"`
+}
+
+// PlatformChangelogSpec defines the changes in a release.
+type PlatformChangelogSpec struct {
+	// Version is the platform release version.
+	Version string `json:"version"`
+	// Date is when the version was released.
+	Date metav1.Time `json:"date"`
+	// Highlights are notable new features and improvements.
+	Highlights []string `json:"highlights,omitempty"`
+	// BreakingChanges are changes that may require action by users.
+	BreakingChanges []string `json:"breakingChanges,omitempty"`
+}
@@ -17,10 +17,14 @@ limitations under the License.
 package v1alpha1_test
 
 import (
+	"errors"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -29,6 +33,12 @@ const (
 
 	// Expect IP prefixes to be marshalled as strings in dotted quad CIDR format.
 	testPrefixMarshaled = `"192.168.0.0/16"`
+
+	// Expect IPv6 addresses to be marshalled as strings in canonical format.
+	testIPv6AddressMarshaled = `"fd00::1"`
+
+	// Expect IPv6 prefixes to be marshalled as strings in canonical CIDR format.
+	testIPv6PrefixMarshaled = `"fd00::/64"`
 )
 
 var (
@@ -42,6 +52,17 @@ var (
 		IP:   net.IPv4(192, 168, 0, 0),
 		Mask: net.IPv4Mask(255, 255, 0, 0),
 	}
+
+	// Expect IPv6 addresses to be unmarshalled as an IPv6 address.
+	//nolint:gochecknoglobals
+	testIPv6AddressUnmarshaled = net.ParseIP("fd00::1")
+
+	// Expect IPv6 prefixes to be unmarshalled as an IPv6 network.
+	//nolint:gochecknoglobals
+	testIPv6PrefixUnmarshaled = net.IPNet{
+		IP:   net.ParseIP("fd00::"),
+		Mask: net.CIDRMask(64, 128),
+	}
 )
 
 func TestIPv4AddressUnmarshal(t *testing.T) {
@@ -105,3 +126,137 @@ func TestIPv4PrefixMarshal(t *testing.T) {
 		t.Fatal("prefix mismatch")
 	}
 }
+
+func TestIPAddressUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	input := []byte(testIPv6AddressMarshaled)
+
+	output := &v1alpha1.IPAddress{}
+
+	if err := output.UnmarshalJSON(input); err != nil {
+		t.Fatal(err)
+	}
+
+	if !output.IP.Equal(testIPv6AddressUnmarshaled) {
+		t.Fatal("address mismatch")
+	}
+}
+
+func TestIPAddressMarshal(t *testing.T) {
+	t.Parallel()
+
+	input := &v1alpha1.IPAddress{IP: testIPv6AddressUnmarshaled}
+
+	output, err := input.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(output) != testIPv6AddressMarshaled {
+		t.Fatal("address mismatch")
+	}
+}
+
+func TestIPPrefixUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	input := []byte(testIPv6PrefixMarshaled)
+
+	output := &v1alpha1.IPPrefix{}
+
+	if err := output.UnmarshalJSON(input); err != nil {
+		t.Fatal(err)
+	}
+
+	if output.String() != testIPv6PrefixUnmarshaled.String() {
+		t.Fatal("prefix mismatch")
+	}
+
+	if !output.IsIPv6() {
+		t.Fatal("address family mismatch")
+	}
+}
+
+func TestIPPrefixMarshal(t *testing.T) {
+	t.Parallel()
+
+	input := &v1alpha1.IPPrefix{IPNet: testIPv6PrefixUnmarshaled}
+
+	output, err := input.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(output) != testIPv6PrefixMarshaled {
+		t.Fatal("prefix mismatch")
+	}
+}
+
+func TestHibernationConditionWrite(t *testing.T) {
+	t.Parallel()
+
+	cluster := &v1alpha1.KubernetesCluster{}
+
+	cluster.HibernationConditionWrite(true)
+
+	condition := cluster.Status.Hibernation
+	if condition == nil {
+		t.Fatal("condition not set")
+	}
+
+	if condition.Status != metav1.ConditionTrue || condition.Reason != v1alpha1.KubernetesClusterConditionReasonHibernated {
+		t.Fatal("condition mismatch")
+	}
+
+	transitionTime := metav1.NewTime(condition.LastTransitionTime.Add(-time.Hour))
+
+	condition.LastTransitionTime = transitionTime
+
+	cluster.HibernationConditionWrite(true)
+
+	if !cluster.Status.Hibernation.LastTransitionTime.Equal(&transitionTime) {
+		t.Fatal("transition time updated without a transition")
+	}
+
+	cluster.HibernationConditionWrite(false)
+
+	condition = cluster.Status.Hibernation
+
+	if condition.Status != metav1.ConditionFalse || condition.Reason != v1alpha1.KubernetesClusterConditionReasonRunning {
+		t.Fatal("condition mismatch")
+	}
+
+	if condition.LastTransitionTime.Equal(&transitionTime) {
+		t.Fatal("transition time not updated")
+	}
+}
+
+// TestLocation tests named time zones are loaded, and time zones that would
+// resolve to the monitor's own time zone are rejected.
+func TestLocation(t *testing.T) {
+	t.Parallel()
+
+	spec := v1alpha1.ApplicationBundleAutoUpgradeSpec{}
+
+	location, err := spec.Location()
+	if err != nil || location != time.UTC {
+		t.Fatal("expected UTC by default", err)
+	}
+
+	timeZone := "Europe/London"
+	spec.TimeZone = &timeZone
+
+	location, err = spec.Location()
+	if err != nil || location.String() != timeZone {
+		t.Fatal("expected named time zone", err)
+	}
+
+	for _, timeZone := range []string{"", "Local", "Narnia/Cair_Paravel"} {
+		spec.TimeZone = &timeZone
+
+		if _, err := spec.Location(); !errors.Is(err, v1alpha1.ErrTimeZone) {
+			t.Fatal("expected time zone to be rejected", timeZone)
+		}
+	}
+}
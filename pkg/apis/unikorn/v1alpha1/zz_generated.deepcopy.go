@@ -29,6 +29,27 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APICertificateStatus) DeepCopyInto(out *APICertificateStatus) {
+	*out = *in
+	in.ReissueTime.DeepCopyInto(&out.ReissueTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APICertificateStatus.
+func (in *APICertificateStatus) DeepCopy() *APICertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(APICertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationBundleAutoUpgradeSpec) DeepCopyInto(out *ApplicationBundleAutoUpgradeSpec) {
 	*out = *in
@@ -37,6 +58,16 @@ func (in *ApplicationBundleAutoUpgradeSpec) DeepCopyInto(out *ApplicationBundleA
 		*out = new(ApplicationBundleAutoUpgradeWeekDaySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(ApplicationBundleChannel)
+		**out = **in
+	}
 	return
 }
 
@@ -117,6 +148,68 @@ func (in *ApplicationBundleAutoUpgradeWindowSpec) DeepCopy() *ApplicationBundleA
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationBundleDisplaySpec) DeepCopyInto(out *ApplicationBundleDisplaySpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Category != nil {
+		in, out := &in.Category, &out.Category
+		*out = new(string)
+		**out = **in
+	}
+	if in.IconURL != nil {
+		in, out := &in.IconURL, &out.IconURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.DocumentationURL != nil {
+		in, out := &in.DocumentationURL, &out.DocumentationURL
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationBundleDisplaySpec.
+func (in *ApplicationBundleDisplaySpec) DeepCopy() *ApplicationBundleDisplaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationBundleDisplaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationBundleKubernetesSpec) DeepCopyInto(out *ApplicationBundleKubernetesSpec) {
+	*out = *in
+	if in.MinimumVersion != nil {
+		in, out := &in.MinimumVersion, &out.MinimumVersion
+		*out = new(SemanticVersion)
+		**out = **in
+	}
+	if in.MaximumVersion != nil {
+		in, out := &in.MaximumVersion, &out.MaximumVersion
+		*out = new(SemanticVersion)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationBundleKubernetesSpec.
+func (in *ApplicationBundleKubernetesSpec) DeepCopy() *ApplicationBundleKubernetesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationBundleKubernetesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationBundleSpec) DeepCopyInto(out *ApplicationBundleSpec) {
 	*out = *in
@@ -130,6 +223,11 @@ func (in *ApplicationBundleSpec) DeepCopyInto(out *ApplicationBundleSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(ApplicationBundleChannel)
+		**out = **in
+	}
 	if in.EndOfLife != nil {
 		in, out := &in.EndOfLife, &out.EndOfLife
 		*out = (*in).DeepCopy()
@@ -141,6 +239,11 @@ func (in *ApplicationBundleSpec) DeepCopyInto(out *ApplicationBundleSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Display != nil {
+		in, out := &in.Display, &out.Display
+		*out = new(ApplicationBundleDisplaySpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -170,6 +273,27 @@ func (in *ApplicationBundleStatus) DeepCopy() *ApplicationBundleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationBundleUpgradeStatus) DeepCopyInto(out *ApplicationBundleUpgradeStatus) {
+	*out = *in
+	in.NextUpgradeAt.DeepCopyInto(&out.NextUpgradeAt)
+	if in.ApprovalRequestedAt != nil {
+		in, out := &in.ApprovalRequestedAt, &out.ApprovalRequestedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationBundleUpgradeStatus.
+func (in *ApplicationBundleUpgradeStatus) DeepCopy() *ApplicationBundleUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationBundleUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationNamedReference) DeepCopyInto(out *ApplicationNamedReference) {
 	*out = *in
@@ -197,55 +321,105 @@ func (in *ApplicationNamedReference) DeepCopy() *ApplicationNamedReference {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
+func (in *ApplicationSyncStatus) DeepCopyInto(out *ApplicationSyncStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.SyncTime.DeepCopyInto(&out.SyncTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlane.
-func (in *ControlPlane) DeepCopy() *ControlPlane {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSyncStatus.
+func (in *ApplicationSyncStatus) DeepCopy() *ApplicationSyncStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlane)
+	out := new(ApplicationSyncStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ControlPlane) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
+	*out = *in
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
 	}
-	return nil
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
+func (in *BackupSpec) DeepCopy() *BackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneApplicationBundle) DeepCopyInto(out *ControlPlaneApplicationBundle) {
+func (in *ClusterAutoscalerSpec) DeepCopyInto(out *ClusterAutoscalerSpec) {
+	*out = *in
+	if in.ScaleDownDelayAfterAdd != nil {
+		in, out := &in.ScaleDownDelayAfterAdd, &out.ScaleDownDelayAfterAdd
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ScaleDownUnneededTime != nil {
+		in, out := &in.ScaleDownUnneededTime, &out.ScaleDownUnneededTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ScaleDownUtilizationThreshold != nil {
+		in, out := &in.ScaleDownUtilizationThreshold, &out.ScaleDownUtilizationThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.Expander != nil {
+		in, out := &in.Expander, &out.Expander
+		*out = new(ClusterAutoscalerExpander)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerSpec.
+func (in *ClusterAutoscalerSpec) DeepCopy() *ClusterAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplate) DeepCopyInto(out *ClusterTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneApplicationBundle.
-func (in *ControlPlaneApplicationBundle) DeepCopy() *ControlPlaneApplicationBundle {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplate.
+func (in *ClusterTemplate) DeepCopy() *ClusterTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneApplicationBundle)
+	out := new(ClusterTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ControlPlaneApplicationBundle) DeepCopyObject() runtime.Object {
+func (in *ClusterTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -253,46 +427,29 @@ func (in *ControlPlaneApplicationBundle) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneApplicationBundleList) DeepCopyInto(out *ControlPlaneApplicationBundleList) {
+func (in *ClusterTemplateAutoscalingSpec) DeepCopyInto(out *ClusterTemplateAutoscalingSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ControlPlaneApplicationBundle, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneApplicationBundleList.
-func (in *ControlPlaneApplicationBundleList) DeepCopy() *ControlPlaneApplicationBundleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateAutoscalingSpec.
+func (in *ClusterTemplateAutoscalingSpec) DeepCopy() *ClusterTemplateAutoscalingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneApplicationBundleList)
+	out := new(ClusterTemplateAutoscalingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ControlPlaneApplicationBundleList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneList) DeepCopyInto(out *ControlPlaneList) {
+func (in *ClusterTemplateList) DeepCopyInto(out *ClusterTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ControlPlane, len(*in))
+		*out = make([]ClusterTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -300,18 +457,18 @@ func (in *ControlPlaneList) DeepCopyInto(out *ControlPlaneList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneList.
-func (in *ControlPlaneList) DeepCopy() *ControlPlaneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateList.
+func (in *ClusterTemplateList) DeepCopy() *ClusterTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneList)
+	out := new(ClusterTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ControlPlaneList) DeepCopyObject() runtime.Object {
+func (in *ClusterTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -319,194 +476,1495 @@ func (in *ControlPlaneList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneSpec) DeepCopyInto(out *ControlPlaneSpec) {
+func (in *ClusterTemplateMachineSpec) DeepCopyInto(out *ClusterTemplateMachineSpec) {
 	*out = *in
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(v1.Duration)
-		**out = **in
-	}
-	if in.ApplicationBundle != nil {
-		in, out := &in.ApplicationBundle, &out.ApplicationBundle
+	if in.Flavor != nil {
+		in, out := &in.Flavor, &out.Flavor
 		*out = new(string)
 		**out = **in
 	}
-	if in.ApplicationBundleAutoUpgrade != nil {
-		in, out := &in.ApplicationBundleAutoUpgrade, &out.ApplicationBundleAutoUpgrade
-		*out = new(ApplicationBundleAutoUpgradeSpec)
-		(*in).DeepCopyInto(*out)
+	if in.DiskSize != nil {
+		in, out := &in.DiskSize, &out.DiskSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneSpec.
-func (in *ControlPlaneSpec) DeepCopy() *ControlPlaneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateMachineSpec.
+func (in *ClusterTemplateMachineSpec) DeepCopy() *ClusterTemplateMachineSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneSpec)
+	out := new(ClusterTemplateMachineSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControlPlaneStatus) DeepCopyInto(out *ControlPlaneStatus) {
+func (in *ClusterTemplateSpec) DeepCopyInto(out *ClusterTemplateSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]unikornv1alpha1.Condition, len(*in))
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplicationBundle != nil {
+		in, out := &in.ApplicationBundle, &out.ApplicationBundle
+		*out = new(string)
+		**out = **in
+	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(ClusterTemplateMachineSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadPools != nil {
+		in, out := &in.WorkloadPools, &out.WorkloadPools
+		*out = make([]ClusterTemplateWorkloadPoolSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = new(KubernetesClusterFeaturesSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneStatus.
-func (in *ControlPlaneStatus) DeepCopy() *ControlPlaneStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateSpec.
+func (in *ClusterTemplateSpec) DeepCopy() *ClusterTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ControlPlaneStatus)
+	out := new(ClusterTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *File) DeepCopyInto(out *File) {
+func (in *ClusterTemplateWorkloadPoolSpec) DeepCopyInto(out *ClusterTemplateWorkloadPoolSpec) {
 	*out = *in
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = new(string)
-		**out = **in
+	in.ClusterTemplateMachineSpec.DeepCopyInto(&out.ClusterTemplateMachineSpec)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.Content != nil {
-		in, out := &in.Content, &out.Content
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(ClusterTemplateAutoscalingSpec)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new File.
-func (in *File) DeepCopy() *File {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateWorkloadPoolSpec.
+func (in *ClusterTemplateWorkloadPoolSpec) DeepCopy() *ClusterTemplateWorkloadPoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(File)
+	out := new(ClusterTemplateWorkloadPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPv4Address) DeepCopyInto(out *IPv4Address) {
+func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
 	*out = *in
-	if in.IP != nil {
-		in, out := &in.IP, &out.IP
-		*out = make(net.IP, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4Address.
-func (in *IPv4Address) DeepCopy() *IPv4Address {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlane.
+func (in *ControlPlane) DeepCopy() *ControlPlane {
 	if in == nil {
 		return nil
 	}
-	out := new(IPv4Address)
+	out := new(ControlPlane)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4Prefix.
-func (in *IPv4Prefix) DeepCopy() *IPv4Prefix {
-	if in == nil {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControlPlane) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneApplicationBundle) DeepCopyInto(out *ControlPlaneApplicationBundle) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneApplicationBundle.
+func (in *ControlPlaneApplicationBundle) DeepCopy() *ControlPlaneApplicationBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneApplicationBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControlPlaneApplicationBundle) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneApplicationBundleList) DeepCopyInto(out *ControlPlaneApplicationBundleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ControlPlaneApplicationBundle, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneApplicationBundleList.
+func (in *ControlPlaneApplicationBundleList) DeepCopy() *ControlPlaneApplicationBundleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneApplicationBundleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControlPlaneApplicationBundleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneComponentStatus) DeepCopyInto(out *ControlPlaneComponentStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneComponentStatus.
+func (in *ControlPlaneComponentStatus) DeepCopy() *ControlPlaneComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneList) DeepCopyInto(out *ControlPlaneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ControlPlane, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneList.
+func (in *ControlPlaneList) DeepCopy() *ControlPlaneList {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControlPlaneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneResourcesSpec) DeepCopyInto(out *ControlPlaneResourcesSpec) {
+	*out = *in
+	if in.Class != nil {
+		in, out := &in.Class, &out.Class
+		*out = new(ControlPlaneResourceClass)
+		**out = **in
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneResourcesSpec.
+func (in *ControlPlaneResourcesSpec) DeepCopy() *ControlPlaneResourcesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneResourcesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneSpec) DeepCopyInto(out *ControlPlaneSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ApplicationBundle != nil {
+		in, out := &in.ApplicationBundle, &out.ApplicationBundle
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplicationBundleAutoUpgrade != nil {
+		in, out := &in.ApplicationBundleAutoUpgrade, &out.ApplicationBundleAutoUpgrade
+		*out = new(ApplicationBundleAutoUpgradeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ControlPlaneResourcesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneSpec.
+func (in *ControlPlaneSpec) DeepCopy() *ControlPlaneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneStatus) DeepCopyInto(out *ControlPlaneStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]unikornv1alpha1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(ApplicationBundleUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ControlPlaneComponentStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneStatus.
+func (in *ControlPlaneStatus) DeepCopy() *ControlPlaneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *File) DeepCopyInto(out *File) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new File.
+func (in *File) DeepCopy() *File {
+	if in == nil {
+		return nil
+	}
+	out := new(File)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddress) DeepCopyInto(out *IPAddress) {
+	*out = *in
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = make(net.IP, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddress.
+func (in *IPAddress) DeepCopy() *IPAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPrefix.
+func (in *IPPrefix) DeepCopy() *IPPrefix {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPrefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv4Address) DeepCopyInto(out *IPv4Address) {
+	*out = *in
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = make(net.IP, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4Address.
+func (in *IPv4Address) DeepCopy() *IPv4Address {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv4Address)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv4Prefix.
+func (in *IPv4Prefix) DeepCopy() *IPv4Prefix {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv4Prefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesCluster) DeepCopyInto(out *KubernetesCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesCluster.
+func (in *KubernetesCluster) DeepCopy() *KubernetesCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterAPISpec) DeepCopyInto(out *KubernetesClusterAPISpec) {
+	*out = *in
+	if in.SubjectAlternativeNames != nil {
+		in, out := &in.SubjectAlternativeNames, &out.SubjectAlternativeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CertificateReissueTime != nil {
+		in, out := &in.CertificateReissueTime, &out.CertificateReissueTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AllowedPrefixes != nil {
+		in, out := &in.AllowedPrefixes, &out.AllowedPrefixes
+		*out = make([]IPPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Private != nil {
+		in, out := &in.Private, &out.Private
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterAPISpec.
+func (in *KubernetesClusterAPISpec) DeepCopy() *KubernetesClusterAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterApplicationBundle) DeepCopyInto(out *KubernetesClusterApplicationBundle) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterApplicationBundle.
+func (in *KubernetesClusterApplicationBundle) DeepCopy() *KubernetesClusterApplicationBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterApplicationBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesClusterApplicationBundle) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterApplicationBundleList) DeepCopyInto(out *KubernetesClusterApplicationBundleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubernetesClusterApplicationBundle, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterApplicationBundleList.
+func (in *KubernetesClusterApplicationBundleList) DeepCopy() *KubernetesClusterApplicationBundleList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterApplicationBundleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesClusterApplicationBundleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterApplicationBundleSpec) DeepCopyInto(out *KubernetesClusterApplicationBundleSpec) {
+	*out = *in
+	in.ApplicationBundleSpec.DeepCopyInto(&out.ApplicationBundleSpec)
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(ApplicationBundleKubernetesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterApplicationBundleSpec.
+func (in *KubernetesClusterApplicationBundleSpec) DeepCopy() *KubernetesClusterApplicationBundleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterApplicationBundleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterControlPlaneSpec) DeepCopyInto(out *KubernetesClusterControlPlaneSpec) {
+	*out = *in
+	in.MachineGeneric.DeepCopyInto(&out.MachineGeneric)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterControlPlaneSpec.
+func (in *KubernetesClusterControlPlaneSpec) DeepCopy() *KubernetesClusterControlPlaneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterControlPlaneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterFeaturesSpec) DeepCopyInto(out *KubernetesClusterFeaturesSpec) {
+	*out = *in
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoscalingConfiguration != nil {
+		in, out := &in.AutoscalingConfiguration, &out.AutoscalingConfiguration
+		*out = new(ClusterAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KubernetesDashboard != nil {
+		in, out := &in.KubernetesDashboard, &out.KubernetesDashboard
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FileStorage != nil {
+		in, out := &in.FileStorage, &out.FileStorage
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MetricsFederation != nil {
+		in, out := &in.MetricsFederation, &out.MetricsFederation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NvidiaOperator != nil {
+		in, out := &in.NvidiaOperator, &out.NvidiaOperator
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NodeFirewall != nil {
+		in, out := &in.NodeFirewall, &out.NodeFirewall
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BackupConfiguration != nil {
+		in, out := &in.BackupConfiguration, &out.BackupConfiguration
+		*out = new(BackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CostAllocation != nil {
+		in, out := &in.CostAllocation, &out.CostAllocation
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterFeaturesSpec.
+func (in *KubernetesClusterFeaturesSpec) DeepCopy() *KubernetesClusterFeaturesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterFeaturesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterList) DeepCopyInto(out *KubernetesClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubernetesCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterList.
+func (in *KubernetesClusterList) DeepCopy() *KubernetesClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterNetworkSpec) DeepCopyInto(out *KubernetesClusterNetworkSpec) {
+	*out = *in
+	if in.NodeNetwork != nil {
+		in, out := &in.NodeNetwork, &out.NodeNetwork
+		*out = (*in).DeepCopy()
+	}
+	if in.PodNetwork != nil {
+		in, out := &in.PodNetwork, &out.PodNetwork
+		*out = (*in).DeepCopy()
+	}
+	if in.ServiceNetwork != nil {
+		in, out := &in.ServiceNetwork, &out.ServiceNetwork
+		*out = (*in).DeepCopy()
+	}
+	if in.SecondaryPodNetwork != nil {
+		in, out := &in.SecondaryPodNetwork, &out.SecondaryPodNetwork
+		*out = (*in).DeepCopy()
+	}
+	if in.SecondaryServiceNetwork != nil {
+		in, out := &in.SecondaryServiceNetwork, &out.SecondaryServiceNetwork
+		*out = (*in).DeepCopy()
+	}
+	if in.DNSNameservers != nil {
+		in, out := &in.DNSNameservers, &out.DNSNameservers
+		*out = make([]IPAddress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KubeProxyMode != nil {
+		in, out := &in.KubeProxyMode, &out.KubeProxyMode
+		*out = new(KubeProxyMode)
+		**out = **in
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(NetworkPlugin)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterNetworkSpec.
+func (in *KubernetesClusterNetworkSpec) DeepCopy() *KubernetesClusterNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterOpenstackNetworkSpec) DeepCopyInto(out *KubernetesClusterOpenstackNetworkSpec) {
+	*out = *in
+	if in.NetworkID != nil {
+		in, out := &in.NetworkID, &out.NetworkID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouterID != nil {
+		in, out := &in.RouterID, &out.RouterID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterOpenstackNetworkSpec.
+func (in *KubernetesClusterOpenstackNetworkSpec) DeepCopy() *KubernetesClusterOpenstackNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterOpenstackNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterOpenstackSpec) DeepCopyInto(out *KubernetesClusterOpenstackSpec) {
+	*out = *in
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = new([]byte)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]byte, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.CloudConfig != nil {
+		in, out := &in.CloudConfig, &out.CloudConfig
+		*out = new([]byte)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]byte, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.Cloud != nil {
+		in, out := &in.Cloud, &out.Cloud
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSHKeyName != nil {
+		in, out := &in.SSHKeyName, &out.SSHKeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureDomain != nil {
+		in, out := &in.FailureDomain, &out.FailureDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeFailureDomain != nil {
+		in, out := &in.VolumeFailureDomain, &out.VolumeFailureDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExternalNetworkID != nil {
+		in, out := &in.ExternalNetworkID, &out.ExternalNetworkID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(KubernetesClusterOpenstackNetworkSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterOpenstackSpec.
+func (in *KubernetesClusterOpenstackSpec) DeepCopy() *KubernetesClusterOpenstackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterOpenstackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterSpec) DeepCopyInto(out *KubernetesClusterSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(KubernetesClusterProvider)
+		**out = **in
+	}
+	if in.Openstack != nil {
+		in, out := &in.Openstack, &out.Openstack
+		*out = new(KubernetesClusterOpenstackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(KubernetesClusterNetworkSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.API != nil {
+		in, out := &in.API, &out.API
+		*out = new(KubernetesClusterAPISpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(KubernetesClusterControlPlaneSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadPools != nil {
+		in, out := &in.WorkloadPools, &out.WorkloadPools
+		*out = new(KubernetesClusterWorkloadPoolsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = new(KubernetesClusterFeaturesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplicationBundle != nil {
+		in, out := &in.ApplicationBundle, &out.ApplicationBundle
+		*out = new(string)
+		**out = **in
+	}
+	if in.ApplicationBundleAutoUpgrade != nil {
+		in, out := &in.ApplicationBundleAutoUpgrade, &out.ApplicationBundleAutoUpgrade
+		*out = new(ApplicationBundleAutoUpgradeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeFreeze != nil {
+		in, out := &in.UpgradeFreeze, &out.UpgradeFreeze
+		*out = new(UpgradeFreezeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHCertificateAuthority != nil {
+		in, out := &in.SSHCertificateAuthority, &out.SSHCertificateAuthority
+		*out = new(SSHCertificateAuthoritySpec)
+		**out = **in
+	}
+	if in.NodeAllowList != nil {
+		in, out := &in.NodeAllowList, &out.NodeAllowList
+		*out = make([]NodeAllowListRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterSpec.
+func (in *KubernetesClusterSpec) DeepCopy() *KubernetesClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterStatus) DeepCopyInto(out *KubernetesClusterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]unikornv1alpha1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(ApplicationBundleUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(v1.Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(MachineVersionStatus)
+		**out = **in
+	}
+	if in.WorkloadPools != nil {
+		in, out := &in.WorkloadPools, &out.WorkloadPools
+		*out = make([]WorkloadPoolVersionStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.StageTimings != nil {
+		in, out := &in.StageTimings, &out.StageTimings
+		*out = make([]ProvisioningStageTiming, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]ApplicationSyncStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.APICertificate != nil {
+		in, out := &in.APICertificate, &out.APICertificate
+		*out = new(APICertificateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineVerification != nil {
+		in, out := &in.MachineVerification, &out.MachineVerification
+		*out = new(MachineVerificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterStatus.
+func (in *KubernetesClusterStatus) DeepCopy() *KubernetesClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterWorkloadPoolsPoolSpec) DeepCopyInto(out *KubernetesClusterWorkloadPoolsPoolSpec) {
+	*out = *in
+	in.KubernetesWorkloadPoolSpec.DeepCopyInto(&out.KubernetesWorkloadPoolSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterWorkloadPoolsPoolSpec.
+func (in *KubernetesClusterWorkloadPoolsPoolSpec) DeepCopy() *KubernetesClusterWorkloadPoolsPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterWorkloadPoolsPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesClusterWorkloadPoolsSpec) DeepCopyInto(out *KubernetesClusterWorkloadPoolsSpec) {
+	*out = *in
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]KubernetesClusterWorkloadPoolsPoolSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterWorkloadPoolsSpec.
+func (in *KubernetesClusterWorkloadPoolsSpec) DeepCopy() *KubernetesClusterWorkloadPoolsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesClusterWorkloadPoolsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesWorkloadPoolSpec) DeepCopyInto(out *KubernetesWorkloadPoolSpec) {
+	*out = *in
+	in.MachineGeneric.DeepCopyInto(&out.MachineGeneric)
+	if in.FailureDomain != nil {
+		in, out := &in.FailureDomain, &out.FailureDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]File, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]Taint, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreKubeadmCommands != nil {
+		in, out := &in.PreKubeadmCommands, &out.PreKubeadmCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostKubeadmCommands != nil {
+		in, out := &in.PostKubeadmCommands, &out.PostKubeadmCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrePullImages != nil {
+		in, out := &in.PrePullImages, &out.PrePullImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(MachineGenericAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHKeyName != nil {
+		in, out := &in.SSHKeyName, &out.SSHKeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeConfiguration != nil {
+		in, out := &in.NodeConfiguration, &out.NodeConfiguration
+		*out = new(NodeConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesWorkloadPoolSpec.
+func (in *KubernetesWorkloadPoolSpec) DeepCopy() *KubernetesWorkloadPoolSpec {
+	if in == nil {
 		return nil
 	}
-	out := new(IPv4Prefix)
+	out := new(KubernetesWorkloadPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesCluster) DeepCopyInto(out *KubernetesCluster) {
+func (in *LabelPropagationSpec) DeepCopyInto(out *LabelPropagationSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConflictPolicy != nil {
+		in, out := &in.ConflictPolicy, &out.ConflictPolicy
+		*out = new(LabelConflictPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelPropagationSpec.
+func (in *LabelPropagationSpec) DeepCopy() *LabelPropagationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelPropagationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+	*out = *in
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(LoadBalancerProvider)
+		**out = **in
+	}
+	if in.FlavorID != nil {
+		in, out := &in.FlavorID, &out.FlavorID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDiscrepancy) DeepCopyInto(out *MachineDiscrepancy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDiscrepancy.
+func (in *MachineDiscrepancy) DeepCopy() *MachineDiscrepancy {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDiscrepancy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineGeneric) DeepCopyInto(out *MachineGeneric) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(SemanticVersion)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Flavor != nil {
+		in, out := &in.Flavor, &out.Flavor
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiskSize != nil {
+		in, out := &in.DiskSize, &out.DiskSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.VolumeFailureDomain != nil {
+		in, out := &in.VolumeFailureDomain, &out.VolumeFailureDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int)
+		**out = **in
+	}
+	if in.ServerGroupID != nil {
+		in, out := &in.ServerGroupID, &out.ServerGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerGroupPolicy != nil {
+		in, out := &in.ServerGroupPolicy, &out.ServerGroupPolicy
+		*out = new(ServerGroupPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGeneric.
+func (in *MachineGeneric) DeepCopy() *MachineGeneric {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineGeneric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineGenericAutoscaling) DeepCopyInto(out *MachineGenericAutoscaling) {
+	*out = *in
+	if in.MinimumReplicas != nil {
+		in, out := &in.MinimumReplicas, &out.MinimumReplicas
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaximumReplicas != nil {
+		in, out := &in.MaximumReplicas, &out.MaximumReplicas
+		*out = new(int)
+		**out = **in
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(MachineGenericAutoscalingScheduler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WarmMachines != nil {
+		in, out := &in.WarmMachines, &out.WarmMachines
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGenericAutoscaling.
+func (in *MachineGenericAutoscaling) DeepCopy() *MachineGenericAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineGenericAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineGenericAutoscalingScheduler) DeepCopyInto(out *MachineGenericAutoscalingScheduler) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(int)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(MachineGenericAutoscalingSchedulerGPU)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGenericAutoscalingScheduler.
+func (in *MachineGenericAutoscalingScheduler) DeepCopy() *MachineGenericAutoscalingScheduler {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineGenericAutoscalingScheduler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineGenericAutoscalingSchedulerGPU) DeepCopyInto(out *MachineGenericAutoscalingSchedulerGPU) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGenericAutoscalingSchedulerGPU.
+func (in *MachineGenericAutoscalingSchedulerGPU) DeepCopy() *MachineGenericAutoscalingSchedulerGPU {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineGenericAutoscalingSchedulerGPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineVerificationStatus) DeepCopyInto(out *MachineVerificationStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.Discrepancies != nil {
+		in, out := &in.Discrepancies, &out.Discrepancies
+		*out = make([]MachineDiscrepancy, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineVerificationStatus.
+func (in *MachineVerificationStatus) DeepCopy() *MachineVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineVersionStatus) DeepCopyInto(out *MachineVersionStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineVersionStatus.
+func (in *MachineVersionStatus) DeepCopy() *MachineVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAllowListRule) DeepCopyInto(out *NodeAllowListRule) {
+	*out = *in
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(NodeAllowListDirection)
+		**out = **in
+	}
+	if in.PortMax != nil {
+		in, out := &in.PortMax, &out.PortMax
+		*out = new(int)
+		**out = **in
+	}
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]IPPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAllowListRule.
+func (in *NodeAllowListRule) DeepCopy() *NodeAllowListRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAllowListRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConfiguration) DeepCopyInto(out *NodeConfiguration) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int)
+		**out = **in
+	}
+	if in.SystemReserved != nil {
+		in, out := &in.SystemReserved, &out.SystemReserved
+		*out = new(ReservedResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeReserved != nil {
+		in, out := &in.KubeReserved, &out.KubeReserved
+		*out = new(ReservedResources)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesCluster.
-func (in *KubernetesCluster) DeepCopy() *KubernetesCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConfiguration.
+func (in *NodeConfiguration) DeepCopy() *NodeConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesCluster)
+	out := new(NodeConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesCluster) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterAPISpec) DeepCopyInto(out *KubernetesClusterAPISpec) {
+func (in *NotificationWebhookSpec) DeepCopyInto(out *NotificationWebhookSpec) {
 	*out = *in
-	if in.SubjectAlternativeNames != nil {
-		in, out := &in.SubjectAlternativeNames, &out.SubjectAlternativeNames
-		*out = make([]string, len(*in))
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEvent, len(*in))
 		copy(*out, *in)
 	}
-	if in.AllowedPrefixes != nil {
-		in, out := &in.AllowedPrefixes, &out.AllowedPrefixes
-		*out = make([]IPv4Prefix, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterAPISpec.
-func (in *KubernetesClusterAPISpec) DeepCopy() *KubernetesClusterAPISpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationWebhookSpec.
+func (in *NotificationWebhookSpec) DeepCopy() *NotificationWebhookSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterAPISpec)
+	out := new(NotificationWebhookSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterApplicationBundle) DeepCopyInto(out *KubernetesClusterApplicationBundle) {
+func (in *PlatformChangelog) DeepCopyInto(out *PlatformChangelog) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterApplicationBundle.
-func (in *KubernetesClusterApplicationBundle) DeepCopy() *KubernetesClusterApplicationBundle {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformChangelog.
+func (in *PlatformChangelog) DeepCopy() *PlatformChangelog {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterApplicationBundle)
+	out := new(PlatformChangelog)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesClusterApplicationBundle) DeepCopyObject() runtime.Object {
+func (in *PlatformChangelog) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -514,13 +1972,13 @@ func (in *KubernetesClusterApplicationBundle) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterApplicationBundleList) DeepCopyInto(out *KubernetesClusterApplicationBundleList) {
+func (in *PlatformChangelogList) DeepCopyInto(out *PlatformChangelogList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KubernetesClusterApplicationBundle, len(*in))
+		*out = make([]PlatformChangelog, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -528,18 +1986,18 @@ func (in *KubernetesClusterApplicationBundleList) DeepCopyInto(out *KubernetesCl
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterApplicationBundleList.
-func (in *KubernetesClusterApplicationBundleList) DeepCopy() *KubernetesClusterApplicationBundleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformChangelogList.
+func (in *PlatformChangelogList) DeepCopy() *PlatformChangelogList {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterApplicationBundleList)
+	out := new(PlatformChangelogList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesClusterApplicationBundleList) DeepCopyObject() runtime.Object {
+func (in *PlatformChangelogList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -547,81 +2005,68 @@ func (in *KubernetesClusterApplicationBundleList) DeepCopyObject() runtime.Objec
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterControlPlaneSpec) DeepCopyInto(out *KubernetesClusterControlPlaneSpec) {
+func (in *PlatformChangelogSpec) DeepCopyInto(out *PlatformChangelogSpec) {
 	*out = *in
-	in.MachineGeneric.DeepCopyInto(&out.MachineGeneric)
+	in.Date.DeepCopyInto(&out.Date)
+	if in.Highlights != nil {
+		in, out := &in.Highlights, &out.Highlights
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BreakingChanges != nil {
+		in, out := &in.BreakingChanges, &out.BreakingChanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterControlPlaneSpec.
-func (in *KubernetesClusterControlPlaneSpec) DeepCopy() *KubernetesClusterControlPlaneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformChangelogSpec.
+func (in *PlatformChangelogSpec) DeepCopy() *PlatformChangelogSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterControlPlaneSpec)
+	out := new(PlatformChangelogSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterFeaturesSpec) DeepCopyInto(out *KubernetesClusterFeaturesSpec) {
+func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
-	if in.Autoscaling != nil {
-		in, out := &in.Autoscaling, &out.Autoscaling
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CertManager != nil {
-		in, out := &in.CertManager, &out.CertManager
-		*out = new(bool)
-		**out = **in
-	}
-	if in.KubernetesDashboard != nil {
-		in, out := &in.KubernetesDashboard, &out.KubernetesDashboard
-		*out = new(bool)
-		**out = **in
-	}
-	if in.FileStorage != nil {
-		in, out := &in.FileStorage, &out.FileStorage
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Prometheus != nil {
-		in, out := &in.Prometheus, &out.Prometheus
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NvidiaOperator != nil {
-		in, out := &in.NvidiaOperator, &out.NvidiaOperator
-		*out = new(bool)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterFeaturesSpec.
-func (in *KubernetesClusterFeaturesSpec) DeepCopy() *KubernetesClusterFeaturesSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterFeaturesSpec)
+	out := new(Project)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterList) DeepCopyInto(out *KubernetesClusterList) {
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KubernetesCluster, len(*in))
+		*out = make([]Project, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -629,18 +2074,18 @@ func (in *KubernetesClusterList) DeepCopyInto(out *KubernetesClusterList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterList.
-func (in *KubernetesClusterList) DeepCopy() *KubernetesClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterList)
+	out := new(ProjectList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KubernetesClusterList) DeepCopyObject() runtime.Object {
+func (in *ProjectList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -648,166 +2093,108 @@ func (in *KubernetesClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterNetworkSpec) DeepCopyInto(out *KubernetesClusterNetworkSpec) {
+func (in *ProjectOffboardingClusterUsage) DeepCopyInto(out *ProjectOffboardingClusterUsage) {
 	*out = *in
-	if in.NodeNetwork != nil {
-		in, out := &in.NodeNetwork, &out.NodeNetwork
-		*out = (*in).DeepCopy()
-	}
-	if in.PodNetwork != nil {
-		in, out := &in.PodNetwork, &out.PodNetwork
-		*out = (*in).DeepCopy()
-	}
-	if in.ServiceNetwork != nil {
-		in, out := &in.ServiceNetwork, &out.ServiceNetwork
-		*out = (*in).DeepCopy()
-	}
-	if in.DNSNameservers != nil {
-		in, out := &in.DNSNameservers, &out.DNSNameservers
-		*out = make([]IPv4Address, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = make([]ProjectOffboardingMachineUsage, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterNetworkSpec.
-func (in *KubernetesClusterNetworkSpec) DeepCopy() *KubernetesClusterNetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectOffboardingClusterUsage.
+func (in *ProjectOffboardingClusterUsage) DeepCopy() *ProjectOffboardingClusterUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterNetworkSpec)
+	out := new(ProjectOffboardingClusterUsage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterOpenstackSpec) DeepCopyInto(out *KubernetesClusterOpenstackSpec) {
+func (in *ProjectOffboardingMachineUsage) DeepCopyInto(out *ProjectOffboardingMachineUsage) {
 	*out = *in
-	if in.CACert != nil {
-		in, out := &in.CACert, &out.CACert
-		*out = new([]byte)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]byte, len(*in))
-			copy(*out, *in)
-		}
-	}
-	if in.CloudConfig != nil {
-		in, out := &in.CloudConfig, &out.CloudConfig
-		*out = new([]byte)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]byte, len(*in))
-			copy(*out, *in)
-		}
-	}
-	if in.Cloud != nil {
-		in, out := &in.Cloud, &out.Cloud
-		*out = new(string)
-		**out = **in
-	}
-	if in.SSHKeyName != nil {
-		in, out := &in.SSHKeyName, &out.SSHKeyName
-		*out = new(string)
-		**out = **in
-	}
-	if in.FailureDomain != nil {
-		in, out := &in.FailureDomain, &out.FailureDomain
-		*out = new(string)
-		**out = **in
-	}
-	if in.VolumeFailureDomain != nil {
-		in, out := &in.VolumeFailureDomain, &out.VolumeFailureDomain
-		*out = new(string)
-		**out = **in
-	}
-	if in.ExternalNetworkID != nil {
-		in, out := &in.ExternalNetworkID, &out.ExternalNetworkID
-		*out = new(string)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterOpenstackSpec.
-func (in *KubernetesClusterOpenstackSpec) DeepCopy() *KubernetesClusterOpenstackSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectOffboardingMachineUsage.
+func (in *ProjectOffboardingMachineUsage) DeepCopy() *ProjectOffboardingMachineUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterOpenstackSpec)
+	out := new(ProjectOffboardingMachineUsage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterSpec) DeepCopyInto(out *KubernetesClusterSpec) {
+func (in *ProjectOffboardingSpec) DeepCopyInto(out *ProjectOffboardingSpec) {
 	*out = *in
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(v1.Duration)
-		**out = **in
-	}
-	if in.Openstack != nil {
-		in, out := &in.Openstack, &out.Openstack
-		*out = new(KubernetesClusterOpenstackSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(KubernetesClusterNetworkSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.API != nil {
-		in, out := &in.API, &out.API
-		*out = new(KubernetesClusterAPISpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ControlPlane != nil {
-		in, out := &in.ControlPlane, &out.ControlPlane
-		*out = new(KubernetesClusterControlPlaneSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.WorkloadPools != nil {
-		in, out := &in.WorkloadPools, &out.WorkloadPools
-		*out = new(KubernetesClusterWorkloadPoolsSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Features != nil {
-		in, out := &in.Features, &out.Features
-		*out = new(KubernetesClusterFeaturesSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ApplicationBundle != nil {
-		in, out := &in.ApplicationBundle, &out.ApplicationBundle
-		*out = new(string)
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	if in.Stage != nil {
+		in, out := &in.Stage, &out.Stage
+		*out = new(ProjectOffboardingStage)
 		**out = **in
 	}
-	if in.ApplicationBundleAutoUpgrade != nil {
-		in, out := &in.ApplicationBundleAutoUpgrade, &out.ApplicationBundleAutoUpgrade
-		*out = new(ApplicationBundleAutoUpgradeSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make([]ProjectOffboardingClusterUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterSpec.
-func (in *KubernetesClusterSpec) DeepCopy() *KubernetesClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectOffboardingSpec.
+func (in *ProjectOffboardingSpec) DeepCopy() *ProjectOffboardingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectOffboardingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRoleBinding) DeepCopyInto(out *ProjectRoleBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRoleBinding.
+func (in *ProjectRoleBinding) DeepCopy() *ProjectRoleBinding {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterSpec)
+	out := new(ProjectRoleBinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectRoleBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterStatus) DeepCopyInto(out *KubernetesClusterStatus) {
+func (in *ProjectRoleBindingList) DeepCopyInto(out *ProjectRoleBindingList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]unikornv1alpha1.Condition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectRoleBinding, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -815,258 +2202,211 @@ func (in *KubernetesClusterStatus) DeepCopyInto(out *KubernetesClusterStatus) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterStatus.
-func (in *KubernetesClusterStatus) DeepCopy() *KubernetesClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRoleBindingList.
+func (in *ProjectRoleBindingList) DeepCopy() *ProjectRoleBindingList {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterStatus)
+	out := new(ProjectRoleBindingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectRoleBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterWorkloadPoolsPoolSpec) DeepCopyInto(out *KubernetesClusterWorkloadPoolsPoolSpec) {
+func (in *ProjectRoleBindingSpec) DeepCopyInto(out *ProjectRoleBindingSpec) {
 	*out = *in
-	in.KubernetesWorkloadPoolSpec.DeepCopyInto(&out.KubernetesWorkloadPoolSpec)
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]ProjectRoleBindingSubject, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterWorkloadPoolsPoolSpec.
-func (in *KubernetesClusterWorkloadPoolsPoolSpec) DeepCopy() *KubernetesClusterWorkloadPoolsPoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRoleBindingSpec.
+func (in *ProjectRoleBindingSpec) DeepCopy() *ProjectRoleBindingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterWorkloadPoolsPoolSpec)
+	out := new(ProjectRoleBindingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesClusterWorkloadPoolsSpec) DeepCopyInto(out *KubernetesClusterWorkloadPoolsSpec) {
+func (in *ProjectRoleBindingSubject) DeepCopyInto(out *ProjectRoleBindingSubject) {
 	*out = *in
-	if in.Pools != nil {
-		in, out := &in.Pools, &out.Pools
-		*out = make([]KubernetesClusterWorkloadPoolsPoolSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesClusterWorkloadPoolsSpec.
-func (in *KubernetesClusterWorkloadPoolsSpec) DeepCopy() *KubernetesClusterWorkloadPoolsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRoleBindingSubject.
+func (in *ProjectRoleBindingSubject) DeepCopy() *ProjectRoleBindingSubject {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesClusterWorkloadPoolsSpec)
+	out := new(ProjectRoleBindingSubject)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesWorkloadPoolSpec) DeepCopyInto(out *KubernetesWorkloadPoolSpec) {
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
-	in.MachineGeneric.DeepCopyInto(&out.MachineGeneric)
-	if in.FailureDomain != nil {
-		in, out := &in.FailureDomain, &out.FailureDomain
-		*out = new(string)
-		**out = **in
+	if in.UpgradeApproval != nil {
+		in, out := &in.UpgradeApproval, &out.UpgradeApproval
+		*out = new(UpgradeApprovalSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Offboarding != nil {
+		in, out := &in.Offboarding, &out.Offboarding
+		*out = new(ProjectOffboardingSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Files != nil {
-		in, out := &in.Files, &out.Files
-		*out = make([]File, len(*in))
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationWebhookSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Autoscaling != nil {
-		in, out := &in.Autoscaling, &out.Autoscaling
-		*out = new(MachineGenericAutoscaling)
+	if in.LabelPropagation != nil {
+		in, out := &in.LabelPropagation, &out.LabelPropagation
+		*out = new(LabelPropagationSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesWorkloadPoolSpec.
-func (in *KubernetesWorkloadPoolSpec) DeepCopy() *KubernetesWorkloadPoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesWorkloadPoolSpec)
+	out := new(ProjectSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineGeneric) DeepCopyInto(out *MachineGeneric) {
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
-		*out = new(SemanticVersion)
-		**out = **in
-	}
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(string)
-		**out = **in
-	}
-	if in.Flavor != nil {
-		in, out := &in.Flavor, &out.Flavor
-		*out = new(string)
-		**out = **in
-	}
-	if in.DiskSize != nil {
-		in, out := &in.DiskSize, &out.DiskSize
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.VolumeFailureDomain != nil {
-		in, out := &in.VolumeFailureDomain, &out.VolumeFailureDomain
-		*out = new(string)
-		**out = **in
-	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int)
-		**out = **in
-	}
-	if in.ServerGroupID != nil {
-		in, out := &in.ServerGroupID, &out.ServerGroupID
-		*out = new(string)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]unikornv1alpha1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGeneric.
-func (in *MachineGeneric) DeepCopy() *MachineGeneric {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineGeneric)
+	out := new(ProjectStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineGenericAutoscaling) DeepCopyInto(out *MachineGenericAutoscaling) {
+func (in *ProvisioningStageTiming) DeepCopyInto(out *ProvisioningStageTiming) {
 	*out = *in
-	if in.MinimumReplicas != nil {
-		in, out := &in.MinimumReplicas, &out.MinimumReplicas
-		*out = new(int)
-		**out = **in
-	}
-	if in.MaximumReplicas != nil {
-		in, out := &in.MaximumReplicas, &out.MaximumReplicas
-		*out = new(int)
-		**out = **in
-	}
-	if in.Scheduler != nil {
-		in, out := &in.Scheduler, &out.Scheduler
-		*out = new(MachineGenericAutoscalingScheduler)
-		(*in).DeepCopyInto(*out)
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGenericAutoscaling.
-func (in *MachineGenericAutoscaling) DeepCopy() *MachineGenericAutoscaling {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningStageTiming.
+func (in *ProvisioningStageTiming) DeepCopy() *ProvisioningStageTiming {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineGenericAutoscaling)
+	out := new(ProvisioningStageTiming)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineGenericAutoscalingScheduler) DeepCopyInto(out *MachineGenericAutoscalingScheduler) {
+func (in *ReservedResources) DeepCopyInto(out *ReservedResources) {
 	*out = *in
 	if in.CPU != nil {
 		in, out := &in.CPU, &out.CPU
-		*out = new(int)
-		**out = **in
+		x := (*in).DeepCopy()
+		*out = &x
 	}
 	if in.Memory != nil {
 		in, out := &in.Memory, &out.Memory
 		x := (*in).DeepCopy()
 		*out = &x
 	}
-	if in.GPU != nil {
-		in, out := &in.GPU, &out.GPU
-		*out = new(MachineGenericAutoscalingSchedulerGPU)
-		(*in).DeepCopyInto(*out)
+	if in.EphemeralStorage != nil {
+		in, out := &in.EphemeralStorage, &out.EphemeralStorage
+		x := (*in).DeepCopy()
+		*out = &x
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGenericAutoscalingScheduler.
-func (in *MachineGenericAutoscalingScheduler) DeepCopy() *MachineGenericAutoscalingScheduler {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedResources.
+func (in *ReservedResources) DeepCopy() *ReservedResources {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineGenericAutoscalingScheduler)
+	out := new(ReservedResources)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineGenericAutoscalingSchedulerGPU) DeepCopyInto(out *MachineGenericAutoscalingSchedulerGPU) {
+func (in *SSHCertificateAuthoritySpec) DeepCopyInto(out *SSHCertificateAuthoritySpec) {
 	*out = *in
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
-		**out = **in
-	}
-	if in.Count != nil {
-		in, out := &in.Count, &out.Count
-		*out = new(int)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGenericAutoscalingSchedulerGPU.
-func (in *MachineGenericAutoscalingSchedulerGPU) DeepCopy() *MachineGenericAutoscalingSchedulerGPU {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateAuthoritySpec.
+func (in *SSHCertificateAuthoritySpec) DeepCopy() *SSHCertificateAuthoritySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineGenericAutoscalingSchedulerGPU)
+	out := new(SSHCertificateAuthoritySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *ServerState) DeepCopyInto(out *ServerState) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerState.
+func (in *ServerState) DeepCopy() *ServerState {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(ServerState)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
+func (in *ServerState) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1074,13 +2414,13 @@ func (in *Project) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *ServerStateList) DeepCopyInto(out *ServerStateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
+		*out = make([]ServerState, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1088,18 +2428,18 @@ func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerStateList.
+func (in *ServerStateList) DeepCopy() *ServerStateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectList)
+	out := new(ServerStateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
+func (in *ServerStateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1107,40 +2447,123 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *ServerStateSpec) DeepCopyInto(out *ServerStateSpec) {
 	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiryTime != nil {
+		in, out := &in.ExpiryTime, &out.ExpiryTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerStateSpec.
+func (in *ServerStateSpec) DeepCopy() *ServerStateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(ServerStateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *Taint) DeepCopyInto(out *Taint) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]unikornv1alpha1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Taint.
+func (in *Taint) DeepCopy() *Taint {
+	if in == nil {
+		return nil
+	}
+	out := new(Taint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeApprovalSpec) DeepCopyInto(out *UpgradeApprovalSpec) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(UpgradeApprovalWebhookSpec)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeApprovalSpec.
+func (in *UpgradeApprovalSpec) DeepCopy() *UpgradeApprovalSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(UpgradeApprovalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeApprovalWebhookSpec) DeepCopyInto(out *UpgradeApprovalWebhookSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeApprovalWebhookSpec.
+func (in *UpgradeApprovalWebhookSpec) DeepCopy() *UpgradeApprovalWebhookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeApprovalWebhookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeFreezeSpec) DeepCopyInto(out *UpgradeFreezeSpec) {
+	*out = *in
+	in.Until.DeepCopyInto(&out.Until)
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeFreezeSpec.
+func (in *UpgradeFreezeSpec) DeepCopy() *UpgradeFreezeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeFreezeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadPoolVersionStatus) DeepCopyInto(out *WorkloadPoolVersionStatus) {
+	*out = *in
+	out.MachineVersionStatus = in.MachineVersionStatus
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadPoolVersionStatus.
+func (in *WorkloadPoolVersionStatus) DeepCopy() *WorkloadPoolVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadPoolVersionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -39,6 +39,22 @@ var (
 		"cl",
 	}
 
+	// ControlPlaneApplicationBundle defines cobra aliases for "control-plane-bundle" commands.
+	//nolint:gochecknoglobals
+	ControlPlaneApplicationBundle = []string{
+		"control-plane-bundles",
+		"controlplanebundles",
+		"cpb",
+	}
+
+	// KubernetesClusterApplicationBundle defines cobra aliases for "cluster-bundle" commands.
+	//nolint:gochecknoglobals
+	KubernetesClusterApplicationBundle = []string{
+		"cluster-bundles",
+		"clusterbundles",
+		"clb",
+	}
+
 	// WorkloadPool defines cobra aliases for "workload-pool" commands.
 	//nolint:gochecknoglobals
 	WorkloadPool = []string{
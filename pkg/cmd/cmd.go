@@ -21,7 +21,9 @@ import (
 
 	"github.com/eschercloudai/unikorn/pkg/cmd/create"
 	"github.com/eschercloudai/unikorn/pkg/cmd/delete"
+	"github.com/eschercloudai/unikorn/pkg/cmd/describe"
 	"github.com/eschercloudai/unikorn/pkg/cmd/get"
+	"github.com/eschercloudai/unikorn/pkg/cmd/simulate"
 	"github.com/eschercloudai/unikorn/pkg/constants"
 
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -62,6 +64,8 @@ func newRootCommand() *cobra.Command {
 		create.NewCreateCommand(f),
 		delete.NewDeleteCommand(f),
 		get.NewGetCommand(f),
+		describe.NewDescribeCommand(f),
+		simulate.NewSimulateCommand(f),
 	}
 
 	cmd.AddCommand(commands...)
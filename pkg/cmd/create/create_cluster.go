@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 
 	"github.com/gophercloud/utils/openstack/clientconfig"
 	"github.com/spf13/cobra"
@@ -28,10 +29,13 @@ import (
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn/pkg/cmd/aliases"
 	"github.com/eschercloudai/unikorn/pkg/cmd/errors"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
 	"github.com/eschercloudai/unikorn/pkg/cmd/util"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util/api"
 	"github.com/eschercloudai/unikorn/pkg/cmd/util/completion"
 	"github.com/eschercloudai/unikorn/pkg/cmd/util/flags"
 	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
@@ -85,6 +89,10 @@ type createClusterOptions struct {
 	// will default to that, otherwise it's a required parameter.
 	cloud string
 
+	// cloudConfig is set during completion, and contains the credentials
+	// used to authenticate with the server API.
+	cloudConfig *clientconfig.Cloud
+
 	// clouds is set during completion, and is a filtered version containing
 	// only the specified cloud.
 	clouds []byte
@@ -150,6 +158,20 @@ type createClusterOptions struct {
 	// allowedPrefixes allows the Kubernetes API firewall.
 	allowedPrefixes flags.IPNetSliceFlag
 
+	// filename, when set, is a server API manifest that completely describes
+	// the cluster.  Clusters created in this way are submitted via the server
+	// API, rather than directly to Kubernetes.
+	filename string
+
+	// server is the Unikorn server endpoint used when creating from a manifest.
+	server string
+
+	// template, when set, is a cluster template to default the manifest from.
+	template string
+
+	// manifest is set during completion when creating from a manifest.
+	manifest *generated.KubernetesCluster
+
 	// client gives access to our custom resources.
 	client unikorn.Interface
 }
@@ -190,20 +212,51 @@ func (o *createClusterOptions) addFlags(f cmdutil.Factory, cmd *cobra.Command) {
 	// Feature enablement.
 	cmd.Flags().BoolVar(&o.autoscaling, "enable-autoscaling", false, "Enables cluster auto-scaling. To function, you must configure autoscaling on individual workload pools.")
 	cmd.Flags().BoolVar(&o.ingress, "enable-ingress", false, "Enables an ingress controller.")
+
+	// Declarative options.
+	cmd.Flags().StringVarP(&o.filename, "filename", "f", "", "Server API cluster manifest, in YAML or JSON format, that describes the cluster.")
+	cmd.Flags().StringVar(&o.server, "server", "", "Unikorn server endpoint (e.g. https://kubernetes.eschercloud.com), required when creating from a manifest.")
+	cmd.Flags().StringVar(&o.template, "template", "", "Cluster template used to default any optional values omitted from the manifest.")
+}
+
+// manifestOptionalFlags are required flags that are instead provided by a manifest
+// when creating from one.
+//
+//nolint:gochecknoglobals
+var manifestOptionalFlags = []string{
+	"project",
+	"application-bundle",
+	"version",
+	"external-network",
+	"flavor",
+	"image",
+	"compute-availability-zone",
+	"volume-availability-zone",
 }
 
 // complete fills in any options not does automatically by flag parsing.
 func (o *createClusterOptions) complete(f cmdutil.Factory, args []string) error {
-	config, err := f.ToRESTConfig()
-	if err != nil {
+	if err := o.completeOpenstackConfig(); err != nil {
 		return err
 	}
 
-	if o.client, err = unikorn.NewForConfig(config); err != nil {
+	// When creating from a manifest, the name is defined by the manifest.
+	if o.filename != "" {
+		if len(args) != 0 {
+			return errors.ErrIncorrectArgumentNum
+		}
+
+		o.manifest = &generated.KubernetesCluster{}
+
+		return api.LoadManifest(o.filename, "kubernetesCluster", o.manifest)
+	}
+
+	config, err := f.ToRESTConfig()
+	if err != nil {
 		return err
 	}
 
-	if err := o.completeOpenstackConfig(); err != nil {
+	if o.client, err = unikorn.NewForConfig(config); err != nil {
 		return err
 	}
 
@@ -243,6 +296,7 @@ func (o *createClusterOptions) completeOpenstackConfig() error {
 	}
 
 	o.clouds = filteredCloudsYaml
+	o.cloudConfig = &cloud
 
 	return nil
 }
@@ -250,20 +304,59 @@ func (o *createClusterOptions) completeOpenstackConfig() error {
 // validate validates any tainted input not handled by complete() or flags
 // processing.
 func (o *createClusterOptions) validate() error {
+	if o.manifest != nil && o.server == "" {
+		return fmt.Errorf("%w: --server must be specified when creating from a manifest", errors.ErrInvalidFlag)
+	}
+
+	if o.manifest == nil && o.template != "" {
+		return fmt.Errorf("%w: --template is only valid when creating from a manifest", errors.ErrInvalidFlag)
+	}
+
+	return nil
+}
+
+// runManifest creates the cluster from a manifest via the server API.
+func (o *createClusterOptions) runManifest() error {
+	client, err := api.NewClient(context.TODO(), o.server, o.cloudConfig)
+	if err != nil {
+		return err
+	}
+
+	params := &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}
+
+	if o.template != "" {
+		params.Template = &o.template
+	}
+
+	response, err := client.PostApiV1ControlplanesControlPlaneNameClustersWithResponse(context.TODO(), o.controlPlaneFlags.ControlPlane, params, *o.manifest)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() != http.StatusAccepted {
+		return api.ResponseError(response.HTTPResponse, response.Body)
+	}
+
+	fmt.Printf("%s.%s/%s created\n", unikornv1.KubernetesClusterResource, unikornv1.GroupName, o.manifest.Name)
+
 	return nil
 }
 
 // run executes the command.
 func (o *createClusterOptions) run() error {
+	if o.manifest != nil {
+		return o.runManifest()
+	}
+
 	namespace, err := o.controlPlaneFlags.GetControlPlaneNamespace(context.TODO(), o.client)
 	if err != nil {
 		return err
 	}
 
-	allowedPrefixes := make([]unikornv1.IPv4Prefix, len(o.allowedPrefixes.IPNetworks))
+	allowedPrefixes := make([]unikornv1.IPPrefix, len(o.allowedPrefixes.IPNetworks))
 
 	for i, prefix := range o.allowedPrefixes.IPNetworks {
-		allowedPrefixes[i] = unikornv1.IPv4Prefix{
+		allowedPrefixes[i] = unikornv1.IPPrefix{
 			IPNet: prefix,
 		}
 	}
@@ -291,10 +384,10 @@ func (o *createClusterOptions) run() error {
 				ExternalNetworkID:   &o.externalNetworkID,
 			},
 			Network: &unikornv1.KubernetesClusterNetworkSpec{
-				NodeNetwork:    &unikornv1.IPv4Prefix{IPNet: o.nodeNetwork},
-				PodNetwork:     &unikornv1.IPv4Prefix{IPNet: o.podNetwork},
-				ServiceNetwork: &unikornv1.IPv4Prefix{IPNet: o.serviceNetwork},
-				DNSNameservers: unikornv1.IPv4AddressSliceFromIPSlice(o.dnsNameservers),
+				NodeNetwork:    &unikornv1.IPPrefix{IPNet: o.nodeNetwork},
+				PodNetwork:     &unikornv1.IPPrefix{IPNet: o.podNetwork},
+				ServiceNetwork: &unikornv1.IPPrefix{IPNet: o.serviceNetwork},
+				DNSNameservers: unikornv1.IPAddressSliceFromIPSlice(o.dnsNameservers),
 			},
 			API: &unikornv1.KubernetesClusterAPISpec{
 				SubjectAlternativeNames: o.SANs,
@@ -339,12 +432,27 @@ var (
 	cloud and user account to provision with.  Only the selected cloud will be
 	passed to CAPI for security reasons.  It's also recommended that you use
 	the shell completion for --cloud first, as that'll allow further completion
-	functions to poll OpenStack to get images, flavors etc.`)
+	functions to poll OpenStack to get images, flavors etc.
+
+	Alternatively a cluster can be created declaratively from a manifest with
+	the --filename parameter.  The manifest uses the server API cluster schema,
+	not the custom resource, and is validated locally before being submitted
+	to the server API specified with the --server parameter.  Authentication
+	uses the credentials and project defined by the --cloud parameter.  Only
+	the --control-plane parameter is required in addition to these.  The
+	--template parameter selects a cluster template that provides defaults for
+	any optional values omitted from the manifest.`)
 
 	//nolint:gochecknoglobals
 	createClusterExamples = util.TemplatedExample(`
         # Create a Kubernetes cluster
-        {{.Application}} create cluster --project foo --control-plane bar --cloud nl1-simon --external-network c9d130bc-301d-45c0-9328-a6964af65579 --flavor c.small --version v1.24.7 --image ubuntu-2004-kube-v1.24.7 --compute-availability-zone nova --volume-availability-zone cinder baz`)
+        {{.Application}} create cluster --project foo --control-plane bar --cloud nl1-simon --external-network c9d130bc-301d-45c0-9328-a6964af65579 --flavor c.small --version v1.24.7 --image ubuntu-2004-kube-v1.24.7 --compute-availability-zone nova --volume-availability-zone cinder baz
+
+        # Create a Kubernetes cluster from a manifest
+        {{.Application}} create cluster --control-plane bar --cloud nl1-simon --server https://kubernetes.eschercloud.com -f cluster.yaml
+
+        # Create a Kubernetes cluster from a manifest, defaulting from a cluster template
+        {{.Application}} create cluster --control-plane bar --cloud nl1-simon --server https://kubernetes.eschercloud.com --template gpu-training-small -f cluster.yaml`)
 )
 
 // newCreateClusterCommand creates a command that is able to provison a new Kubernetes
@@ -358,10 +466,23 @@ func newCreateClusterCommand(f cmdutil.Factory) *cobra.Command {
 		Long:    createClusterLong,
 		Example: createClusterExamples,
 		Aliases: aliases.Cluster,
-		Run: func(cmd *cobra.Command, args []string) {
-			util.AssertNilError(o.complete(f, args))
-			util.AssertNilError(o.validate())
-			util.AssertNilError(o.run())
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if o.filename != "" {
+				return flags.MarkOptional(cmd, manifestOptionalFlags...)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(f, args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run()
 		},
 	}
 
@@ -26,6 +26,7 @@ import (
 	unikornv1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn/pkg/cmd/aliases"
 	"github.com/eschercloudai/unikorn/pkg/cmd/errors"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
 	"github.com/eschercloudai/unikorn/pkg/cmd/util"
 	"github.com/eschercloudai/unikorn/pkg/cmd/util/flags"
 
@@ -148,10 +149,16 @@ func newDeleteClusterCommand(f cmdutil.Factory) *cobra.Command {
 		Example:           deleteClusterExamples,
 		Aliases:           aliases.Cluster,
 		ValidArgsFunction: o.controlPlaneFlags.CompleteCluster(f),
-		Run: func(cmd *cobra.Command, args []string) {
-			util.AssertNilError(o.complete(f, args))
-			util.AssertNilError(o.validate())
-			util.AssertNilError(o.run())
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(f, args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run()
 		},
 	}
 
@@ -0,0 +1,136 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eschercloudai/unikorn/pkg/cmd/errors"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util/flags"
+
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/describe"
+)
+
+// NewDescribeCommand returns a command that can show detailed information
+// about resources.
+func NewDescribeCommand(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Show details of Unikorn resources",
+		Long:  "Show details of Unikorn resources",
+	}
+
+	commands := []*cobra.Command{
+		newDescribeProjectCommand(f),
+		newDescribeControlPlaneCommand(f),
+		newDescribeClusterCommand(f),
+		newDescribeControlPlaneApplicationBundleCommand(f),
+		newDescribeKubernetesClusterApplicationBundleCommand(f),
+	}
+
+	cmd.AddCommand(commands...)
+
+	return cmd
+}
+
+// describeFlags are common to all describe commands.
+type describeFlags struct {
+	// selectorFlags allow resources to be filtered by label.
+	selectorFlags flags.SelectorFlags
+
+	// showEvents defines whether to display events related to the resource.
+	showEvents bool
+}
+
+// addFlags registers describe flags with the specified cobra command.
+func (o *describeFlags) addFlags(f cmdutil.Factory, cmd *cobra.Command) {
+	o.selectorFlags.AddFlags(f, cmd)
+
+	cmd.Flags().BoolVar(&o.showEvents, "show-events", true, "If true, display events related to the described object.")
+}
+
+// validateNames checks any explicitly named resources are valid.
+func validateNames(names []string) error {
+	for _, name := range names {
+		if len(name) == 0 {
+			return fmt.Errorf(`%w: "%s"`, errors.ErrInvalidName, name)
+		}
+	}
+
+	return nil
+}
+
+// describeResources is a common describe function.  Much like the get commands
+// we use the "kubectl describe" library, the resource type is implicit so is
+// prepended to the list of names.
+func (o *describeFlags) describeResources(f cmdutil.Factory, namespace, resource string, names []string) error {
+	args := []string{resource}
+	args = append(args, names...)
+
+	r := f.NewBuilder().
+		Unstructured().
+		NamespaceParam(namespace).
+		LabelSelectorParam(o.selectorFlags.Selector).
+		ResourceTypeOrNameArgs(true, args...).
+		ContinueOnError().
+		Flatten().
+		Do()
+
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	infos, err := r.Infos()
+	if err != nil {
+		return err
+	}
+
+	if len(infos) == 0 {
+		fmt.Println("no resources found")
+
+		return nil
+	}
+
+	settings := describe.DescriberSettings{
+		ShowEvents: o.showEvents,
+		ChunkSize:  cmdutil.DefaultChunkSize,
+	}
+
+	for i, info := range infos {
+		describer, err := describe.DescriberFn(f, info.ResourceMapping())
+		if err != nil {
+			return err
+		}
+
+		s, err := describer.Describe(info.Namespace, info.Name, settings)
+		if err != nil {
+			return err
+		}
+
+		if i != 0 {
+			fmt.Fprintln(os.Stdout)
+		}
+
+		fmt.Fprint(os.Stdout, s)
+	}
+
+	return nil
+}
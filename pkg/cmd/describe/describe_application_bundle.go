@@ -0,0 +1,130 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"github.com/spf13/cobra"
+
+	unikornv1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/cmd/aliases"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util/flags"
+
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// describeApplicationBundleOptions defines a set of options that are required to
+// describe application bundles.  Bundles are cluster scoped, so this is shared
+// between control plane and Kubernetes cluster bundles.
+type describeApplicationBundleOptions struct {
+	// resource is the bundle resource type to describe.
+	resource string
+
+	// names is an explicit set of resource names to describe.
+	names []string
+
+	// describeFlags are common describe options.
+	describeFlags describeFlags
+}
+
+// addFlags registers describe application bundle options flags with the specified cobra command.
+func (o *describeApplicationBundleOptions) addFlags(f cmdutil.Factory, cmd *cobra.Command) {
+	o.describeFlags.addFlags(f, cmd)
+}
+
+// complete fills in any options not does automatically by flag parsing.
+func (o *describeApplicationBundleOptions) complete(args []string) error {
+	if len(args) != 0 {
+		o.names = util.UniqueString(args)
+	}
+
+	return nil
+}
+
+// validate validates any tainted input not handled by complete() or flags
+// processing.
+func (o *describeApplicationBundleOptions) validate() error {
+	return validateNames(o.names)
+}
+
+// run executes the command.
+func (o *describeApplicationBundleOptions) run(f cmdutil.Factory) error {
+	return o.describeFlags.describeResources(f, "", o.resource, o.names)
+}
+
+// newDescribeControlPlaneApplicationBundleCommand returns a command that is able to
+// describe control plane application bundles.
+func newDescribeControlPlaneApplicationBundleCommand(f cmdutil.Factory) *cobra.Command {
+	o := &describeApplicationBundleOptions{
+		resource: unikornv1alpha1.ControlPlaneApplicationBundleResource,
+	}
+
+	cmd := &cobra.Command{
+		Use:               "control-plane-bundle",
+		Short:             "Describe control plane application bundles",
+		Long:              "Describe control plane application bundles",
+		Aliases:           aliases.ControlPlaneApplicationBundle,
+		ValidArgsFunction: flags.CompleteControlPlaneApplicationBundle(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run(f)
+		},
+	}
+
+	o.addFlags(f, cmd)
+
+	return cmd
+}
+
+// newDescribeKubernetesClusterApplicationBundleCommand returns a command that is able to
+// describe Kubernetes cluster application bundles.
+func newDescribeKubernetesClusterApplicationBundleCommand(f cmdutil.Factory) *cobra.Command {
+	o := &describeApplicationBundleOptions{
+		resource: unikornv1alpha1.KubernetesClusterApplicationBundleResource,
+	}
+
+	cmd := &cobra.Command{
+		Use:               "cluster-bundle",
+		Short:             "Describe Kubernetes cluster application bundles",
+		Long:              "Describe Kubernetes cluster application bundles",
+		Aliases:           aliases.KubernetesClusterApplicationBundle,
+		ValidArgsFunction: flags.CompleteKubernetesClusterApplicationBundle(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run(f)
+		},
+	}
+
+	o.addFlags(f, cmd)
+
+	return cmd
+}
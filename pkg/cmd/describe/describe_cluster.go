@@ -0,0 +1,122 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eschercloudai/unikorn/generated/clientset/unikorn"
+	unikornv1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/cmd/aliases"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util/flags"
+
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+type describeClusterOptions struct {
+	// controlPlaneFlags define control plane scoping.
+	controlPlaneFlags flags.ControlPlaneFlags
+
+	// names is an explicit set of resource names to describe.
+	names []string
+
+	// describeFlags are common describe options.
+	describeFlags describeFlags
+
+	// client gives access to our custom resources.
+	client unikorn.Interface
+}
+
+// addFlags registers describe cluster options flags with the specified cobra command.
+func (o *describeClusterOptions) addFlags(f cmdutil.Factory, cmd *cobra.Command) {
+	o.controlPlaneFlags.AddFlags(f, cmd)
+	o.describeFlags.addFlags(f, cmd)
+}
+
+// complete fills in any options not does automatically by flag parsing.
+func (o *describeClusterOptions) complete(f cmdutil.Factory, args []string) error {
+	config, err := f.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+
+	if o.client, err = unikorn.NewForConfig(config); err != nil {
+		return err
+	}
+
+	if len(args) != 0 {
+		o.names = util.UniqueString(args)
+	}
+
+	return nil
+}
+
+// validate validates any tainted input not handled by complete() or flags
+// processing.
+func (o *describeClusterOptions) validate() error {
+	return validateNames(o.names)
+}
+
+// run executes the command.
+func (o *describeClusterOptions) run(f cmdutil.Factory) error {
+	namespace, err := o.controlPlaneFlags.GetControlPlaneNamespace(context.TODO(), o.client)
+	if err != nil {
+		return err
+	}
+
+	return o.describeFlags.describeResources(f, namespace, unikornv1alpha1.KubernetesClusterResource, o.names)
+}
+
+var (
+	//nolint:gochecknoglobals
+	describeClusterExamples = util.TemplatedExample(`
+	# Describe Kubernetes cluster baz in control plane bar, in project foo.
+	{{.Application}} describe cluster --project foo --control-plane bar baz`)
+)
+
+// newDescribeClusterCommand returns a command that is able to describe Kubernetes clusters.
+func newDescribeClusterCommand(f cmdutil.Factory) *cobra.Command {
+	o := &describeClusterOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "cluster",
+		Short:             "Describe Kubernetes clusters",
+		Long:              "Describe Kubernetes clusters",
+		Example:           describeClusterExamples,
+		Aliases:           aliases.Cluster,
+		ValidArgsFunction: o.controlPlaneFlags.CompleteCluster(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(f, args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run(f)
+		},
+	}
+
+	o.addFlags(f, cmd)
+
+	return cmd
+}
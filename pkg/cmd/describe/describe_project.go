@@ -0,0 +1,101 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"github.com/spf13/cobra"
+
+	unikornv1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/cmd/aliases"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util"
+
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/completion"
+)
+
+type describeProjectOptions struct {
+	// names is an explicit set of resource names to describe.
+	names []string
+
+	// describeFlags are common describe options.
+	describeFlags describeFlags
+}
+
+// addFlags registers describe project options flags with the specified cobra command.
+func (o *describeProjectOptions) addFlags(f cmdutil.Factory, cmd *cobra.Command) {
+	o.describeFlags.addFlags(f, cmd)
+}
+
+// complete fills in any options not does automatically by flag parsing.
+func (o *describeProjectOptions) complete(args []string) error {
+	if len(args) != 0 {
+		o.names = util.UniqueString(args)
+	}
+
+	return nil
+}
+
+// validate validates any tainted input not handled by complete() or flags
+// processing.
+func (o *describeProjectOptions) validate() error {
+	return validateNames(o.names)
+}
+
+// run executes the command.
+func (o *describeProjectOptions) run(f cmdutil.Factory) error {
+	return o.describeFlags.describeResources(f, "", unikornv1alpha1.ProjectResource, o.names)
+}
+
+var (
+	//nolint:gochecknoglobals
+	describeProjectExamples = util.TemplatedExample(`
+	# Describe all projects.
+	{{.Application}} describe project
+
+	# Describe a single project named my-project-name.
+	{{.Application}} describe project my-project-name`)
+)
+
+// newDescribeProjectCommand returns a command that is able to describe projects.
+func newDescribeProjectCommand(f cmdutil.Factory) *cobra.Command {
+	o := &describeProjectOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "project",
+		Short:             "Describe projects",
+		Long:              "Describe projects",
+		Example:           describeProjectExamples,
+		Aliases:           aliases.Project,
+		ValidArgsFunction: completion.ResourceNameCompletionFunc(f, unikornv1alpha1.ProjectResource),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run(f)
+		},
+	}
+
+	o.addFlags(f, cmd)
+
+	return cmd
+}
@@ -41,4 +41,15 @@ var (
 	// ErrProjectNamespaceUndefined is raised when you try to provision a control
 	// plane against a project that hasn't fully provisioned yet.
 	ErrProjectNamespaceUndefined = errors.New("project namespace is not set")
+
+	// ErrInvalidFlag is raised when a flag value is not supported.
+	ErrInvalidFlag = errors.New("invalid flag specified")
+
+	// ErrInvalidManifest is raised when a manifest file cannot be parsed or
+	// fails schema validation.
+	ErrInvalidManifest = errors.New("invalid manifest")
+
+	// ErrAPIResponse is raised when the server API returns an unexpected
+	// status code.
+	ErrAPIResponse = errors.New("unexpected API response")
 )
@@ -97,8 +97,9 @@ func (o *getPrintFlags) toPrinter() (printers.ResourcePrinter, error) {
 
 // humanReadableOutput indicates whether the output is human readable (server formatted
 // as a table using additional printer columns), or machine readable (e.g. JSON, YAML).
+// Wide output is also a table, just with any lower priority columns included.
 func (o *getPrintFlags) humanReadableOutput() bool {
-	return len(o.outputFormat) == 0
+	return len(o.outputFormat) == 0 || o.outputFormat == "wide"
 }
 
 // transformRequests requests the Kubernetes API return a formatted table when
@@ -37,6 +37,8 @@ func NewGetCommand(f cmdutil.Factory) *cobra.Command {
 		newGetProjectCommand(f),
 		newGetControlPlaneCommand(f),
 		newGetClusterCommand(f),
+		newGetControlPlaneApplicationBundleCommand(f),
+		newGetKubernetesClusterApplicationBundleCommand(f),
 		kubeconfig.NewGetKubeconfigCommand(f),
 	}
 
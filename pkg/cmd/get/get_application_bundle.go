@@ -0,0 +1,200 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	unikornv1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/cmd/aliases"
+	"github.com/eschercloudai/unikorn/pkg/cmd/errors"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util/flags"
+
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// getApplicationBundleOptions defines a set of options that are required to get
+// application bundles.  Bundles are cluster scoped, so this is shared between
+// control plane and Kubernetes cluster bundles.
+type getApplicationBundleOptions struct {
+	// resource is the bundle resource type to get.
+	resource string
+
+	// names is an explicit set of resource names to get.
+	names []string
+
+	// selectorFlags allow resources to be filtered by label.
+	selectorFlags flags.SelectorFlags
+
+	// getPrintFlags is a generic and reduced set of printing options.
+	getPrintFlags *getPrintFlags
+
+	// f is the factory used to create clients.
+	f cmdutil.Factory
+}
+
+// newGetApplicationBundleOptions returns a correctly initialized set of options.
+func newGetApplicationBundleOptions(resource string) *getApplicationBundleOptions {
+	return &getApplicationBundleOptions{
+		resource:      resource,
+		getPrintFlags: newGetPrintFlags(),
+	}
+}
+
+// addFlags registers get application bundle options flags with the specified cobra command.
+func (o *getApplicationBundleOptions) addFlags(f cmdutil.Factory, cmd *cobra.Command) {
+	o.selectorFlags.AddFlags(f, cmd)
+	o.getPrintFlags.addFlags(cmd)
+}
+
+// complete fills in any options not does automatically by flag parsing.
+func (o *getApplicationBundleOptions) complete(f cmdutil.Factory, args []string) error {
+	o.f = f
+
+	if len(args) != 0 {
+		o.names = util.UniqueString(args)
+	}
+
+	return nil
+}
+
+// validate validates any tainted input not handled by complete() or flags
+// processing.
+func (o *getApplicationBundleOptions) validate() error {
+	for _, name := range o.names {
+		if len(name) == 0 {
+			return fmt.Errorf(`%w: "%s"`, errors.ErrInvalidName, name)
+		}
+	}
+
+	return nil
+}
+
+// run executes the command.
+func (o *getApplicationBundleOptions) run() error {
+	// We are using the "kubectl get" library to retrieve resources.  That command
+	// is generic, it accepts a kind and name(s), or a list of type/name tuples.
+	// In our case, the type is implicit, so we need to prepend it to keep things
+	// working as they should.
+	args := []string{o.resource}
+	args = append(args, o.names...)
+
+	r := o.f.NewBuilder().
+		Unstructured().
+		LabelSelectorParam(o.selectorFlags.Selector).
+		ResourceTypeOrNameArgs(true, args...).
+		ContinueOnError().
+		Latest().
+		Flatten().
+		TransformRequests(o.getPrintFlags.transformRequests).
+		Do()
+
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	if err := o.getPrintFlags.printResult(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	//nolint:gochecknoglobals
+	getControlPlaneApplicationBundleExamples = util.TemplatedExample(`
+	# List all control plane application bundles.
+	{{.Application}} get control-plane-bundle
+
+	# List all control plane application bundles, including additional information.
+	{{.Application}} get control-plane-bundle -o wide`)
+
+	//nolint:gochecknoglobals
+	getKubernetesClusterApplicationBundleExamples = util.TemplatedExample(`
+	# List all Kubernetes cluster application bundles.
+	{{.Application}} get cluster-bundle
+
+	# List all Kubernetes cluster application bundles, including supported Kubernetes versions.
+	{{.Application}} get cluster-bundle -o wide
+
+	# Get a single Kubernetes cluster application bundle formatted in YAML.
+	{{.Application}} get cluster-bundle kubernetes-cluster-1.0.0 -o yaml`)
+)
+
+// newGetControlPlaneApplicationBundleCommand returns a command that is able to get or
+// list control plane application bundles.
+func newGetControlPlaneApplicationBundleCommand(f cmdutil.Factory) *cobra.Command {
+	o := newGetApplicationBundleOptions(unikornv1alpha1.ControlPlaneApplicationBundleResource)
+
+	cmd := &cobra.Command{
+		Use:               "control-plane-bundle",
+		Short:             "Get or list control plane application bundles",
+		Long:              "Get or list control plane application bundles",
+		Example:           getControlPlaneApplicationBundleExamples,
+		Aliases:           aliases.ControlPlaneApplicationBundle,
+		ValidArgsFunction: flags.CompleteControlPlaneApplicationBundle(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(f, args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run()
+		},
+	}
+
+	o.addFlags(f, cmd)
+
+	return cmd
+}
+
+// newGetKubernetesClusterApplicationBundleCommand returns a command that is able to get or
+// list Kubernetes cluster application bundles.
+func newGetKubernetesClusterApplicationBundleCommand(f cmdutil.Factory) *cobra.Command {
+	o := newGetApplicationBundleOptions(unikornv1alpha1.KubernetesClusterApplicationBundleResource)
+
+	cmd := &cobra.Command{
+		Use:               "cluster-bundle",
+		Short:             "Get or list Kubernetes cluster application bundles",
+		Long:              "Get or list Kubernetes cluster application bundles",
+		Example:           getKubernetesClusterApplicationBundleExamples,
+		Aliases:           aliases.KubernetesClusterApplicationBundle,
+		ValidArgsFunction: flags.CompleteKubernetesClusterApplicationBundle(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(f, args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run()
+		},
+	}
+
+	o.addFlags(f, cmd)
+
+	return cmd
+}
@@ -39,6 +39,9 @@ type getClusterOptions struct {
 	// names is an explicit set of resource names to get.
 	names []string
 
+	// selectorFlags allow resources to be filtered by label.
+	selectorFlags flags.SelectorFlags
+
 	// getPrintFlags is a generic and reduced set of printing options.
 	getPrintFlags *getPrintFlags
 
@@ -58,6 +61,7 @@ func newGetClusterOptions() *getClusterOptions {
 // addFlags registers get cluster options flags with the specified cobra command.
 func (o *getClusterOptions) addFlags(cmd *cobra.Command, f cmdutil.Factory) {
 	o.controlPlaneFlags.AddFlags(f, cmd)
+	o.selectorFlags.AddFlags(f, cmd)
 	o.getPrintFlags.addFlags(cmd)
 }
 
@@ -98,6 +102,7 @@ func (o *getClusterOptions) run() error {
 	r := o.f.NewBuilder().
 		Unstructured().
 		NamespaceParam(namespace).
+		LabelSelectorParam(o.selectorFlags.Selector).
 		ResourceTypeOrNameArgs(true, args...).
 		ContinueOnError().
 		Latest().
@@ -135,9 +140,12 @@ func newGetClusterCommand(f cmdutil.Factory) *cobra.Command {
 		Example:           getClusterExamples,
 		Aliases:           aliases.Cluster,
 		ValidArgsFunction: o.controlPlaneFlags.CompleteCluster(f),
-		Run: func(cmd *cobra.Command, args []string) {
-			util.AssertNilError(o.complete(f, args))
-			util.AssertNilError(o.run())
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(f, args); err != nil {
+				return err
+			}
+
+			return o.run()
 		},
 	}
 
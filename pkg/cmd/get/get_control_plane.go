@@ -26,6 +26,7 @@ import (
 	unikornv1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn/pkg/cmd/aliases"
 	"github.com/eschercloudai/unikorn/pkg/cmd/errors"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
 	"github.com/eschercloudai/unikorn/pkg/cmd/util"
 	"github.com/eschercloudai/unikorn/pkg/cmd/util/flags"
 
@@ -39,6 +40,9 @@ type getControlPlaneOptions struct {
 	// name allows explicit filtering of control plane namespaces.
 	names []string
 
+	// selectorFlags allow resources to be filtered by label.
+	selectorFlags flags.SelectorFlags
+
 	// getPrintFlags is a generic and reduced set of printing options.
 	getPrintFlags *getPrintFlags
 
@@ -59,6 +63,7 @@ func newGetControlPlaneOptions() *getControlPlaneOptions {
 // addFlags registers create cluster options flags with the specified cobra command.
 func (o *getControlPlaneOptions) addFlags(f cmdutil.Factory, cmd *cobra.Command) {
 	o.projectFlags.AddFlags(f, cmd)
+	o.selectorFlags.AddFlags(f, cmd)
 	o.getPrintFlags.addFlags(cmd)
 }
 
@@ -117,6 +122,7 @@ func (o *getControlPlaneOptions) run() error {
 	r := o.f.NewBuilder().
 		Unstructured().
 		NamespaceParam(namespace).
+		LabelSelectorParam(o.selectorFlags.Selector).
 		ResourceTypeOrNameArgs(true, args...).
 		ContinueOnError().
 		Latest().
@@ -146,10 +152,16 @@ func newGetControlPlaneCommand(f cmdutil.Factory) *cobra.Command {
 		Long:              "Get or list Cluster API control planes",
 		Aliases:           aliases.ControlPlane,
 		ValidArgsFunction: o.projectFlags.CompleteControlPlane(f),
-		Run: func(cmd *cobra.Command, args []string) {
-			util.AssertNilError(o.complete(f, args))
-			util.AssertNilError(o.validate())
-			util.AssertNilError(o.run())
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(f, args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run()
 		},
 	}
 
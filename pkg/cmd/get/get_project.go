@@ -24,7 +24,9 @@ import (
 	unikornv1alpha1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn/pkg/cmd/aliases"
 	"github.com/eschercloudai/unikorn/pkg/cmd/errors"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
 	"github.com/eschercloudai/unikorn/pkg/cmd/util"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util/flags"
 
 	"k8s.io/client-go/kubernetes"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
@@ -35,6 +37,9 @@ type getProjectOptions struct {
 	// name allows explicit filtering of control plane namespaces.
 	names []string
 
+	// selectorFlags allow resources to be filtered by label.
+	selectorFlags flags.SelectorFlags
+
 	// getPrintFlags is a generic and reduced set of printing options.
 	getPrintFlags *getPrintFlags
 
@@ -52,7 +57,8 @@ func newGetProjectOptions() *getProjectOptions {
 	}
 }
 
-func (o *getProjectOptions) addFlags(cmd *cobra.Command) {
+func (o *getProjectOptions) addFlags(f cmdutil.Factory, cmd *cobra.Command) {
+	o.selectorFlags.AddFlags(f, cmd)
 	o.getPrintFlags.addFlags(cmd)
 }
 
@@ -98,6 +104,7 @@ func (o *getProjectOptions) run() error {
 
 	r := o.f.NewBuilder().
 		Unstructured().
+		LabelSelectorParam(o.selectorFlags.Selector).
 		ResourceTypeOrNameArgs(true, args...).
 		ContinueOnError().
 		Latest().
@@ -144,14 +151,20 @@ func newGetProjectCommand(f cmdutil.Factory) *cobra.Command {
 		Example:           getProjectExample,
 		Aliases:           aliases.Project,
 		ValidArgsFunction: completion.ResourceNameCompletionFunc(f, unikornv1alpha1.ProjectResource),
-		Run: func(cmd *cobra.Command, args []string) {
-			util.AssertNilError(o.complete(f, args))
-			util.AssertNilError(o.validate())
-			util.AssertNilError(o.run())
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(f, args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run()
 		},
 	}
 
-	o.addFlags(cmd)
+	o.addFlags(f, cmd)
 
 	return cmd
 }
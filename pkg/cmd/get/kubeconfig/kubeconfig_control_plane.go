@@ -24,7 +24,7 @@ import (
 
 	"github.com/eschercloudai/unikorn/generated/clientset/unikorn"
 	"github.com/eschercloudai/unikorn/pkg/cmd/aliases"
-	"github.com/eschercloudai/unikorn/pkg/cmd/util"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
 	"github.com/eschercloudai/unikorn/pkg/cmd/util/flags"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/vcluster"
 
@@ -102,10 +102,16 @@ func newGetKubeconfigControlPlane(f cmdutil.Factory) *cobra.Command {
 		Short:   "Get a control plane Kubernetes config",
 		Long:    "Get a control plane Kubernetes config",
 		Aliases: aliases.ControlPlane,
-		Run: func(cmd *cobra.Command, args []string) {
-			util.AssertNilError(o.complete(f, args))
-			util.AssertNilError(o.validate())
-			util.AssertNilError(o.run())
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(f, args); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run()
 		},
 	}
 
@@ -0,0 +1,236 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// ExitSuccess is returned when everything worked.
+	ExitSuccess = 0
+
+	// ExitFailure is returned for generic runtime errors.
+	ExitFailure = 1
+
+	// ExitUsage is returned when the command was invoked incorrectly e.g.
+	// with missing or malformed flags.
+	ExitUsage = 2
+)
+
+// Error associates an exit code with an error.
+type Error struct {
+	// code is the process exit code.
+	code int
+	// err is the wrapped error.
+	err error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap allows the use of errors.Is and errors.As.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// WithExitCode wraps an error so that the process exits with the
+// provided code.
+func WithExitCode(err error, code int) error {
+	if err == nil {
+		return nil
+	}
+
+	return &Error{
+		code: code,
+		err:  err,
+	}
+}
+
+// UsageError marks an error as being caused by incorrect usage.
+func UsageError(err error) error {
+	return WithExitCode(err, ExitUsage)
+}
+
+// ExitCode returns the exit code associated with an error.  Errors that have
+// not been explicitly wrapped are treated as generic failures.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+
+	var e *Error
+
+	if errors.As(err, &e) {
+		return e.code
+	}
+
+	return ExitFailure
+}
+
+// Runtime provides consistent output and error handling for command line
+// tools, so they can be safely embedded in scripts.
+type Runtime struct {
+	// json, when set, emits a JSON object per line rather than human
+	// readable text.
+	json bool
+	// stdout is where informational output is written.
+	stdout io.Writer
+	// stderr is where errors are written.
+	stderr io.Writer
+	// exit terminates the process.
+	exit func(int)
+}
+
+// New returns a runtime that writes to the standard streams.
+func New() *Runtime {
+	return NewWithWriters(os.Stdout, os.Stderr)
+}
+
+// NewWithWriters returns a runtime that writes to the provided streams.
+func NewWithWriters(stdout, stderr io.Writer) *Runtime {
+	return &Runtime{
+		stdout: stdout,
+		stderr: stderr,
+		exit:   os.Exit,
+	}
+}
+
+// AddFlags registers runtime flags with the provided flag set.
+func (r *Runtime) AddFlags(f *pflag.FlagSet) {
+	f.BoolVar(&r.json, "json", false, "Emit structured JSON output, one object per line.")
+}
+
+// record is the structured output format.
+type record struct {
+	Level    string         `json:"level"`
+	Message  string         `json:"message"`
+	Fields   map[string]any `json:"fields,omitempty"`
+	ExitCode *int           `json:"exitCode,omitempty"`
+}
+
+// fields converts a list of alternating keys and values into a map.
+func fields(keysAndValues []any) map[string]any {
+	if len(keysAndValues) == 0 {
+		return nil
+	}
+
+	result := map[string]any{}
+
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key := fmt.Sprint(keysAndValues[i])
+
+		var value any
+
+		if i+1 < len(keysAndValues) {
+			value = keysAndValues[i+1]
+		}
+
+		// Things like IP addresses and durations are far more useful
+		// rendered as their string form in JSON.
+		if s, ok := value.(fmt.Stringer); ok {
+			value = s.String()
+		}
+
+		result[key] = value
+	}
+
+	return result
+}
+
+// write emits a record in the selected format.
+func (r *Runtime) write(w io.Writer, rec *record, keysAndValues []any) {
+	if r.json {
+		data, err := json.Marshal(rec)
+		if err != nil {
+			data = []byte(fmt.Sprintf(`{"level":"error","message":%q}`, err.Error()))
+		}
+
+		fmt.Fprintln(w, string(data))
+
+		return
+	}
+
+	parts := []string{
+		rec.Message,
+	}
+
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key := fmt.Sprint(keysAndValues[i])
+
+		parts = append(parts, fmt.Sprintf("%s=%v", key, rec.Fields[key]))
+	}
+
+	if rec.Level != "info" {
+		parts[0] = rec.Level + ": " + parts[0]
+	}
+
+	fmt.Fprintln(w, strings.Join(parts, " "))
+}
+
+// Info emits an informational message, with optional alternating key value
+// pairs that provide additional context.
+func (r *Runtime) Info(message string, keysAndValues ...any) {
+	r.write(r.stdout, &record{Level: "info", Message: message, Fields: fields(keysAndValues)}, keysAndValues)
+}
+
+// Warning emits a non-fatal problem, with optional alternating key value
+// pairs that provide additional context.
+func (r *Runtime) Warning(message string, keysAndValues ...any) {
+	r.write(r.stderr, &record{Level: "warning", Message: message, Fields: fields(keysAndValues)}, keysAndValues)
+}
+
+// Exit reports the error, if any, and terminates the process with the
+// associated exit code.  It does nothing when the error is nil, so that
+// deferred functions still run on success.
+func (r *Runtime) Exit(err error) {
+	if err == nil {
+		return
+	}
+
+	code := ExitCode(err)
+
+	r.write(r.stderr, &record{Level: "error", Message: err.Error(), ExitCode: &code}, nil)
+
+	r.exit(code)
+}
+
+// Execute runs a cobra command under the runtime.  Cobra's own error and
+// usage reporting is silenced so that all errors are reported consistently,
+// and flag parsing errors are reported as usage errors.
+func (r *Runtime) Execute(cmd *cobra.Command) {
+	r.AddFlags(cmd.PersistentFlags())
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return UsageError(err)
+	})
+
+	r.Exit(cmd.Execute())
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+)
+
+var errTest = errors.New("test error")
+
+// TestExitCode tests exit codes are propagated through wrapped errors.
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, runtime.ExitSuccess, runtime.ExitCode(nil))
+	assert.Equal(t, runtime.ExitFailure, runtime.ExitCode(errTest))
+	assert.Equal(t, runtime.ExitUsage, runtime.ExitCode(runtime.UsageError(errTest)))
+	assert.Equal(t, runtime.ExitUsage, runtime.ExitCode(fmt.Errorf("context: %w", runtime.UsageError(errTest))))
+	assert.Equal(t, 42, runtime.ExitCode(runtime.WithExitCode(errTest, 42)))
+	assert.ErrorIs(t, runtime.UsageError(errTest), errTest)
+	assert.NoError(t, runtime.WithExitCode(nil, runtime.ExitUsage))
+}
+
+// TestInfoHuman tests human readable output.
+func TestInfoHuman(t *testing.T) {
+	t.Parallel()
+
+	stdout := &bytes.Buffer{}
+
+	r := runtime.NewWithWriters(stdout, &bytes.Buffer{})
+	r.Info("using address", "address", net.IPv4(192, 168, 0, 1))
+
+	assert.Equal(t, "using address address=192.168.0.1\n", stdout.String())
+}
+
+// TestInfoJSON tests structured output is enabled by the --json flag.
+func TestInfoJSON(t *testing.T) {
+	t.Parallel()
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	r := runtime.NewWithWriters(stdout, stderr)
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	r.AddFlags(flags)
+
+	assert.NoError(t, flags.Parse([]string{"--json"}))
+
+	r.Info("using address", "address", net.IPv4(192, 168, 0, 1))
+	r.Warning("something odd", "count", 2)
+
+	var info map[string]any
+
+	assert.NoError(t, json.Unmarshal(stdout.Bytes(), &info))
+	assert.Equal(t, "info", info["level"])
+	assert.Equal(t, "using address", info["message"])
+	assert.Equal(t, map[string]any{"address": "192.168.0.1"}, info["fields"])
+
+	var warning map[string]any
+
+	assert.NoError(t, json.Unmarshal(stderr.Bytes(), &warning))
+	assert.Equal(t, "warning", warning["level"])
+	assert.Equal(t, map[string]any{"count": float64(2)}, warning["fields"])
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"github.com/spf13/cobra"
+
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// NewSimulateCommand creates a command that allows the automated behaviour of
+// the platform to be previewed, without modifying anything.
+func NewSimulateCommand(f cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate Unikorn automation.",
+		Long:  "Simulate Unikorn automation.",
+	}
+
+	commands := []*cobra.Command{
+		newSimulateUpgradesCommand(f),
+	}
+
+	cmd.AddCommand(commands...)
+
+	return cmd
+}
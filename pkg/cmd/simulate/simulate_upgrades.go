@@ -0,0 +1,198 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eschercloudai/unikorn/pkg/cmd/errors"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util"
+	"github.com/eschercloudai/unikorn/pkg/monitor"
+	upgradeutil "github.com/eschercloudai/unikorn/pkg/monitor/upgrade/util"
+
+	"k8s.io/client-go/kubernetes/scheme"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// outputFormatJSON prints the calendar as JSON.
+	outputFormatJSON = "json"
+
+	// outputFormatYAML prints the calendar as YAML.
+	outputFormatYAML = "yaml"
+)
+
+type simulateUpgradesOptions struct {
+	// outputFormat selects formatting e.g. json, yaml, or human readable by default.
+	outputFormat string
+
+	// freeze is a platform-wide upgrade freeze to simulate.
+	freeze upgradeutil.Freeze
+
+	// client gives access to our custom resources.
+	client client.Client
+}
+
+// addFlags registers simulate upgrades options flags with the specified cobra command.
+func (o *simulateUpgradesOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.outputFormat, "output", "o", "", fmt.Sprintf("Output format. One of (%s)", strings.Join([]string{outputFormatJSON, outputFormatYAML}, ", ")))
+
+	o.freeze.AddFlags(cmd.Flags())
+}
+
+// complete fills in any options not does automatically by flag parsing.
+func (o *simulateUpgradesOptions) complete(f cmdutil.Factory) error {
+	config, err := f.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+
+	if o.client, err = client.New(config, client.Options{Scheme: scheme.Scheme}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validate validates any tainted input not handled by complete() or flags
+// processing.
+func (o *simulateUpgradesOptions) validate() error {
+	switch o.outputFormat {
+	case "", outputFormatJSON, outputFormatYAML:
+		return nil
+	}
+
+	return fmt.Errorf("%w: unsupported output format %s", errors.ErrInvalidFlag, o.outputFormat)
+}
+
+// formatTime prints a time in a human readable form.
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Format(time.RFC1123)
+}
+
+// printTable prints the calendar in a human readable form.
+func printTable(plans []*upgradeutil.Plan) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "KIND\tPROJECT\tCONTROL PLANE\tCLUSTER\tCHANNEL\tACTION\tFROM\tTO\tSTART\tEND\tNOTES")
+
+	for _, plan := range plans {
+		notes := plan.Reason
+
+		if plan.Forced {
+			notes = "forced, bundle end of life"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", plan.Kind, plan.Project, plan.ControlPlane, plan.Cluster, plan.Channel, plan.Action, plan.From, plan.To, formatTime(plan.Start), formatTime(plan.End), notes)
+	}
+
+	return w.Flush()
+}
+
+// run executes the command.
+func (o *simulateUpgradesOptions) run() error {
+	plans, err := monitor.Simulate(context.TODO(), o.client, &o.freeze)
+	if err != nil {
+		return err
+	}
+
+	switch o.outputFormat {
+	case outputFormatJSON:
+		data, err := json.MarshalIndent(plans, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(data))
+	case outputFormatYAML:
+		data, err := yaml.Marshal(plans)
+		if err != nil {
+			return err
+		}
+
+		fmt.Print(string(data))
+	default:
+		if err := printTable(plans); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var (
+	//nolint:gochecknoglobals
+	simulateUpgradesLong = templates.LongDesc(`
+	Simulate automatic upgrades.
+
+	This runs the same scheduling algorithm as the monitor against the current
+	state of all control planes and clusters, and returns the planned upgrade
+	calendar.  Nothing is modified, and upgrade approval webhooks are not called,
+	so the results can be safely shared with change advisory boards.`)
+
+	//nolint:gochecknoglobals
+	simulateUpgradesExample = util.TemplatedExample(`
+        # Show the upgrade calendar.
+        {{.Application}} simulate upgrades
+
+        # Show the upgrade calendar as JSON.
+        {{.Application}} simulate upgrades -o json`)
+)
+
+// newSimulateUpgradesCommand creates a command that previews automatic upgrades.
+func newSimulateUpgradesCommand(f cmdutil.Factory) *cobra.Command {
+	o := &simulateUpgradesOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "upgrades",
+		Short:   "Simulate automatic upgrades.",
+		Long:    simulateUpgradesLong,
+		Example: simulateUpgradesExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(f); err != nil {
+				return err
+			}
+
+			if err := o.validate(); err != nil {
+				return runtime.UsageError(err)
+			}
+
+			return o.run()
+		},
+	}
+
+	o.addFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gophercloud/utils/openstack/clientconfig"
+	"golang.org/x/oauth2"
+
+	"github.com/eschercloudai/unikorn/pkg/cmd/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+
+	"sigs.k8s.io/yaml"
+)
+
+// bearerTokenInjector allows a generic client to inject a bearer token for authn/authz.
+func bearerTokenInjector(token string) generated.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		return nil
+	}
+}
+
+// ResponseError extracts a useful error from a failed API response.
+func ResponseError(response *http.Response, body []byte) error {
+	var serverErr generated.Oauth2Error
+
+	if err := json.Unmarshal(body, &serverErr); err != nil || serverErr.Error == "" {
+		return fmt.Errorf("%w: status code %d", errors.ErrAPIResponse, response.StatusCode)
+	}
+
+	return fmt.Errorf("%w: status code %d: %s: %s", errors.ErrAPIResponse, response.StatusCode, serverErr.Error, serverErr.ErrorDescription)
+}
+
+// NewClient returns a Unikorn server API client.  The user's credentials from
+// clouds.yaml are used to perform an OAuth2 password grant, and the resulting
+// access token is then scoped to the cloud's project.
+func NewClient(ctx context.Context, server string, cloud *clientconfig.Cloud) (*generated.ClientWithResponses, error) {
+	if cloud.AuthInfo == nil || cloud.AuthInfo.Username == "" || cloud.AuthInfo.Password == "" || cloud.AuthInfo.ProjectID == "" {
+		return nil, fmt.Errorf("%w: cloud must define a username, password and project ID", errors.ErrInvalidFlag)
+	}
+
+	config := &oauth2.Config{
+		Endpoint: oauth2.Endpoint{
+			TokenURL: server + "/api/v1/auth/oauth2/tokens",
+		},
+	}
+
+	token, err := config.PasswordCredentialsToken(ctx, cloud.AuthInfo.Username, cloud.AuthInfo.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	unscoped, err := generated.NewClientWithResponses(server, generated.WithRequestEditorFn(bearerTokenInjector(token.AccessToken)))
+	if err != nil {
+		return nil, err
+	}
+
+	scope := generated.TokenScope{
+		Project: generated.TokenScopeProject{
+			Id: cloud.AuthInfo.ProjectID,
+		},
+	}
+
+	response, err := unscoped.PostApiV1AuthTokensTokenWithResponse(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() != http.StatusCreated {
+		return nil, ResponseError(response.HTTPResponse, response.Body)
+	}
+
+	return generated.NewClientWithResponses(server, generated.WithRequestEditorFn(bearerTokenInjector(response.JSON201.AccessToken)))
+}
+
+// getSchema looks up the named schema from the embedded OpenAPI specification.
+func getSchema(name string) (*openapi3.Schema, error) {
+	spec, err := generated.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	schema, ok := spec.Components.Schemas[name]
+	if !ok || schema.Value == nil {
+		return nil, fmt.Errorf("%w: schema %s not defined", errors.ErrNotFound, name)
+	}
+
+	return schema.Value, nil
+}
+
+// LoadManifest reads a YAML or JSON manifest from the given path, validates it
+// against the named schema in the embedded OpenAPI specification, then unmarshals
+// it into the provided object.
+func LoadManifest(path, schemaName string, out interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errors.ErrInvalidPath, err.Error())
+	}
+
+	// YAML is a superset of JSON, so this handles both.
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errors.ErrInvalidManifest, err.Error())
+	}
+
+	var value interface{}
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("%w: %s", errors.ErrInvalidManifest, err.Error())
+	}
+
+	schema, err := getSchema(schemaName)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.VisitJSON(value, openapi3.MultiErrors()); err != nil {
+		return fmt.Errorf("%w: %s", errors.ErrInvalidManifest, err.Error())
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(out); err != nil {
+		return fmt.Errorf("%w: %s", errors.ErrInvalidManifest, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cmderrors "github.com/eschercloudai/unikorn/pkg/cmd/errors"
+	"github.com/eschercloudai/unikorn/pkg/cmd/util/api"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+)
+
+const (
+	validManifest = `name: foo
+applicationBundle:
+  name: kubernetes-cluster-1.0.0
+  version: 1.0.0
+openstack:
+  computeAvailabilityZone: nova
+  volumeAvailabilityZone: nova
+  externalNetworkID: c9d130bc-301d-45c0-9328-a6964af65579
+network:
+  nodePrefix: 192.168.0.0/16
+  servicePrefix: 172.16.0.0/12
+  podPrefix: 10.0.0.0/8
+  dnsNameservers:
+  - 8.8.8.8
+controlPlane:
+  version: v1.28.0
+  replicas: 3
+  imageName: ubuntu-24.04-lts
+  flavorName: g.2.standard
+workloadPools:
+- name: default
+  machine:
+    version: v1.28.0
+    replicas: 3
+    imageName: ubuntu-24.04-lts
+    flavorName: g.2.standard
+`
+
+	// invalidManifest is missing required fields, and has the wrong type
+	// for replicas.
+	invalidManifest = `name: foo
+controlPlane:
+  replicas: three
+`
+)
+
+func writeManifest(t *testing.T, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "cluster.yaml")
+
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+// TestLoadManifest tests a valid manifest is loaded.
+func TestLoadManifest(t *testing.T) {
+	t.Parallel()
+
+	var cluster generated.KubernetesCluster
+
+	if err := api.LoadManifest(writeManifest(t, validManifest), "kubernetesCluster", &cluster); err != nil {
+		t.Fatal(err)
+	}
+
+	if cluster.Name != "foo" || cluster.ControlPlane.Replicas != 3 || len(cluster.WorkloadPools) != 1 {
+		t.Fatal("manifest not loaded correctly", cluster)
+	}
+}
+
+// TestLoadManifestInvalid tests schema validation rejects an invalid manifest.
+func TestLoadManifestInvalid(t *testing.T) {
+	t.Parallel()
+
+	var cluster generated.KubernetesCluster
+
+	if err := api.LoadManifest(writeManifest(t, invalidManifest), "kubernetesCluster", &cluster); !errors.Is(err, cmderrors.ErrInvalidManifest) {
+		t.Fatal("expected invalid manifest error", err)
+	}
+}
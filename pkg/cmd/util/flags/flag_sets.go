@@ -271,3 +271,14 @@ type DeleteFlags struct {
 func (o *DeleteFlags) AddFlags(_ cmdutil.Factory, cmd *cobra.Command) {
 	cmd.Flags().BoolVar(&o.All, "all", false, "Select all resources that match the query.")
 }
+
+// SelectorFlags define common resource selection options.
+type SelectorFlags struct {
+	// Selector is a label selector used to filter resources.
+	Selector string
+}
+
+// AddFlags adds the flags to a cobra command.
+func (o *SelectorFlags) AddFlags(_ cmdutil.Factory, cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.Selector, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2).")
+}
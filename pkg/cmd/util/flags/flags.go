@@ -184,3 +184,17 @@ func RequiredStringVarWithCompletion(cmd *cobra.Command, p *string, name, value,
 		panic(err)
 	}
 }
+
+// MarkOptional removes the required annotation from the named flags.  This allows
+// a command to support different modes of operation, where a flag may be required
+// in one mode but not another.  This must be called before cobra validates required
+// flags e.g. in a PreRun hook.
+func MarkOptional(cmd *cobra.Command, names ...string) error {
+	for _, name := range names {
+		if err := cmd.Flags().SetAnnotation(name, cobra.BashCompOneRequiredFlag, []string{"false"}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
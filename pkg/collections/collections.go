@@ -0,0 +1,104 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package collections provides typed helpers for common operations on slices
+// and maps, that would otherwise be hand rolled with subtly different semantics.
+package collections
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SortedKeys returns the keys of a map in ascending order.
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// MapValues returns the values of a map, ordered by their keys, so the
+// result is deterministic.
+func MapValues[K cmp.Ordered, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+
+	for _, key := range SortedKeys(m) {
+		values = append(values, m[key])
+	}
+
+	return values
+}
+
+// Filter returns a new slice containing only the elements for which the
+// predicate returns true, preserving order.
+func Filter[T any](in []T, predicate func(T) bool) []T {
+	out := make([]T, 0, len(in))
+
+	for _, item := range in {
+		if predicate(item) {
+			out = append(out, item)
+		}
+	}
+
+	return out
+}
+
+// Unique returns a new slice with duplicate elements removed.  The first
+// occurrence of each element is retained, preserving order.
+func Unique[T comparable](in []T) []T {
+	seen := make(map[T]bool, len(in))
+
+	out := make([]T, 0, len(in))
+
+	for _, item := range in {
+		if seen[item] {
+			continue
+		}
+
+		seen[item] = true
+
+		out = append(out, item)
+	}
+
+	return out
+}
+
+// Chunk splits a slice into consecutive chunks of at most size elements.
+// The chunks alias the input slice.  This will panic if size is not positive.
+func Chunk[T any](in []T, size int) [][]T {
+	if size <= 0 {
+		panic("collections: chunk size must be positive")
+	}
+
+	out := make([][]T, 0, (len(in)+size-1)/size)
+
+	for len(in) > size {
+		out = append(out, in[:size:size])
+		in = in[size:]
+	}
+
+	if len(in) > 0 {
+		out = append(out, in)
+	}
+
+	return out
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collections_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eschercloudai/unikorn/pkg/collections"
+)
+
+// TestSortedKeys tests map keys are returned in order.
+func TestSortedKeys(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]int{
+		"c": 3,
+		"a": 1,
+		"b": 2,
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, collections.SortedKeys(m))
+	assert.Empty(t, collections.SortedKeys(map[string]int{}))
+}
+
+// TestMapValues tests map values are returned in key order.
+func TestMapValues(t *testing.T) {
+	t.Parallel()
+
+	m := map[int]string{
+		3: "c",
+		1: "a",
+		2: "b",
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, collections.MapValues(m))
+	assert.Empty(t, collections.MapValues(map[int]string(nil)))
+}
+
+// TestFilter tests filtering preserves order and doesn't modify the input.
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	in := []string{"foo", "bar", "baz", "qux"}
+
+	out := collections.Filter(in, func(s string) bool {
+		return strings.HasPrefix(s, "b")
+	})
+
+	assert.Equal(t, []string{"bar", "baz"}, out)
+	assert.Equal(t, []string{"foo", "bar", "baz", "qux"}, in)
+	assert.Empty(t, collections.Filter(in, func(string) bool { return false }))
+}
+
+// TestUnique tests duplicates are removed, retaining the first occurrence.
+func TestUnique(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{3, 1, 2}, collections.Unique([]int{3, 1, 3, 2, 1}))
+	assert.Empty(t, collections.Unique([]int(nil)))
+}
+
+// TestChunk tests slices are split into chunks of the correct size.
+func TestChunk(t *testing.T) {
+	t.Parallel()
+
+	in := []int{1, 2, 3, 4, 5}
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, collections.Chunk(in, 2))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, collections.Chunk(in, 5))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, collections.Chunk(in, 10))
+	assert.Empty(t, collections.Chunk([]int{}, 2))
+}
+
+// TestChunkIsolation tests appending to a chunk doesn't clobber the next one.
+func TestChunkIsolation(t *testing.T) {
+	t.Parallel()
+
+	in := []int{1, 2, 3, 4}
+
+	chunks := collections.Chunk(in, 2)
+
+	_ = append(chunks[0], 9)
+
+	assert.Equal(t, []int{3, 4}, chunks[1])
+}
+
+// TestChunkInvalidSize tests an invalid chunk size panics.
+func TestChunkInvalidSize(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() {
+		collections.Chunk([]int{1}, 0)
+	})
+}
@@ -54,6 +54,10 @@ const (
 	// This is the default version in the Makefile.
 	DeveloperVersion = "0.0.0"
 
+	// LabelDomain is the domain of all labels and annotations that are owned
+	// by this application.
+	LabelDomain = "unikorn.eschercloud.ai"
+
 	// VersionLabel is a label applied to resources so we know the application
 	// version that was used to create them (and thus what metadata is valid
 	// for them).  Metadata may be upgraded to a later version for any resource.
@@ -87,6 +91,10 @@ const (
 	// to a specific cluster.
 	KubernetesClusterLabel = "unikorn.eschercloud.ai/cluster"
 
+	// WorkloadPoolLabel is applied to nodes to indicate which workload pool
+	// they belong to.
+	WorkloadPoolLabel = "unikorn.eschercloud.ai/pool"
+
 	// ApplicationLabel is applied to ArgoCD applications to differentiate
 	// between them.
 	ApplicationLabel = "unikorn.eschercloud.ai/application"
@@ -102,6 +110,52 @@ const (
 	// manually restarting services based on a Deployment/DaemonSet changing.
 	ConfigurationHashAnnotation = "unikorn.eschercloud.ai/config-hash"
 
+	// UpgradeApprovalAnnotation is set to the target application bundle name
+	// when an upgrade has been manually approved.
+	UpgradeApprovalAnnotation = "unikorn.eschercloud.ai/upgrade-approved"
+
+	// HibernationAnnotation is set when a cluster is hibernated, it records
+	// the workload pool topology so it can be restored on resumption.
+	HibernationAnnotation = "unikorn.eschercloud.ai/hibernated-topology"
+
+	// NotificationStateAnnotation records the last lifecycle event that was
+	// notified for a resource, so notifications are only raised on transitions.
+	NotificationStateAnnotation = "unikorn.eschercloud.ai/notification-state"
+
+	// PropagatedLabelsAnnotation records the labels that were propagated from
+	// the project, so they can be updated or removed when the project changes.
+	PropagatedLabelsAnnotation = "unikorn.eschercloud.ai/propagated-labels"
+
+	// MigrationAnnotation is set to the target project while a control plane
+	// and its clusters are moved between projects.  Only resources paused by
+	// the move are annotated, so they can be resumed once it's complete.
+	MigrationAnnotation = "unikorn.eschercloud.ai/migrating-to"
+
+	// ApplicationCategoryAnnotation may be set on a HelmApplication to group
+	// it with related applications in a catalog.  HelmApplication is defined
+	// by unikorn-core, so display metadata it lacks is provided this way.
+	ApplicationCategoryAnnotation = "unikorn.eschercloud.ai/category"
+
+	// ApplicationSummaryAnnotation may be set on a HelmApplication to provide
+	// a short, single line description for display in a catalog.
+	ApplicationSummaryAnnotation = "unikorn.eschercloud.ai/summary"
+
+	// EnvironmentAnnotation is set on a control plane created by a preview
+	// environment, it records what the environment created so it can be
+	// deleted as a single operation.
+	EnvironmentAnnotation = "unikorn.eschercloud.ai/environment"
+
+	// MonitorMemberLabel marks leases used to track live monitor replicas.
+	MonitorMemberLabel = "unikorn.eschercloud.ai/monitor-member"
+
+	// MonitorShardLabel pins a resource to the named monitor replica, overriding
+	// the default consistent hash assignment.
+	MonitorShardLabel = "unikorn.eschercloud.ai/monitor-shard"
+
+	// ServerStateBucketLabel is applied to server state so all keys in a
+	// bucket can be listed.
+	ServerStateBucketLabel = "unikorn.eschercloud.ai/server-state-bucket"
+
 	// Finalizer is applied to resources that need to be deleted manually
 	// and do other complex logic.
 	Finalizer = "unikorn"
@@ -0,0 +1,139 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package document provides a simplified, ordered representation of an
+// OpenAPI document that is easy to render as human readable documentation.
+package document
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Content is the body of a request or response.
+type Content struct {
+	Type    string
+	Example interface{}
+	Schema  *openapi3.Schema
+}
+
+// Parameter is a path or query parameter.
+type Parameter struct {
+	Name        string
+	Description string
+}
+
+type ParameterList []*Parameter
+
+// RequestBody describes what an operation accepts.
+type RequestBody struct {
+	Required    bool
+	Description string
+	Content     *Content
+}
+
+// Response describes what an operation returns for a status code.
+type Response struct {
+	Status      string
+	Description string
+	Content     *Content
+}
+
+type ResponseList []*Response
+
+// SchemeRequirement is a security scheme, and the scopes within it, that
+// must be satisfied.
+type SchemeRequirement struct {
+	Scheme string
+	Scopes []string
+}
+
+// SecurityRequirement is a set of scheme requirements that must all be
+// satisfied to perform an operation.
+type SecurityRequirement []*SchemeRequirement
+
+// SecurityRequirementList is a set of alternative security requirements,
+// any one of which will allow an operation.  An empty list means no
+// authentication is required.
+type SecurityRequirementList []SecurityRequirement
+
+// Operation is a HTTP method on a path.
+type Operation struct {
+	Method      string
+	Description string
+	RequestBody *RequestBody
+	Responses   ResponseList
+	Security    SecurityRequirementList
+}
+
+type OperationList []*Operation
+
+// Path is an API endpoint.
+type Path struct {
+	GroupID     string
+	Path        string
+	Description string
+	Parameters  ParameterList
+	Operations  OperationList
+}
+
+type PathList []*Path
+
+// Group is a set of related paths.
+type Group struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Paths       PathList
+}
+
+type GroupList []*Group
+
+// OAuth2Flow describes how to acquire an OAuth2 token.
+type OAuth2Flow struct {
+	Name             string
+	AuthorizationURL string
+	TokenURL         string
+}
+
+type OAuth2FlowList []*OAuth2Flow
+
+// Scope is a permission that a token may be granted.
+type Scope struct {
+	Name        string
+	Description string
+}
+
+type ScopeList []*Scope
+
+// SecurityScheme describes how clients authenticate with the API.
+type SecurityScheme struct {
+	Name        string
+	Type        string
+	Description string
+	Flows       OAuth2FlowList
+	Scopes      ScopeList
+}
+
+type SecuritySchemeList []*SecurityScheme
+
+// Document is the top level API description.
+type Document struct {
+	Name            string
+	Description     string
+	Version         string
+	SecuritySchemes SecuritySchemeList
+	Groups          GroupList
+}
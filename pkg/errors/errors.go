@@ -0,0 +1,208 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package errors defines failure classes that are shared by the server and
+// the managers.  Components classify errors by wrapping one of the class
+// sentinels, either with the typed Error, or with fmt.Errorf and %w, and
+// consumers map the class to a HTTP response or resource condition without
+// needing to know where the error originated.
+package errors
+
+import (
+	"errors"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+var (
+	// ErrNotFound is raised when a resource does not exist.
+	ErrNotFound = errors.New("resource not found")
+
+	// ErrConflict is raised when a resource already exists, or is in a
+	// state that prevents the operation.
+	ErrConflict = errors.New("resource conflict")
+
+	// ErrQuota is raised when an operation would exceed a quota.
+	ErrQuota = errors.New("quota exceeded")
+
+	// ErrInvalid is raised when a request or configuration is invalid,
+	// retrying will not help until it is corrected.
+	ErrInvalid = errors.New("invalid request")
+
+	// ErrForbidden is raised when the caller is not permitted to perform
+	// the operation.
+	ErrForbidden = errors.New("operation forbidden")
+
+	// ErrUnavailable is raised when a dependency cannot service the
+	// operation at present, but may succeed if retried later.
+	ErrUnavailable = errors.New("temporarily unavailable")
+)
+
+// classes are checked in order by Class.  Where an error wraps more than one,
+// the more specific classes must come first e.g. quota errors are often
+// reported by providers as conflicts.
+//
+//nolint:gochecknoglobals
+var classes = []error{
+	ErrQuota,
+	ErrNotFound,
+	ErrConflict,
+	ErrInvalid,
+	ErrForbidden,
+	ErrUnavailable,
+}
+
+// Error is a classified error.  The description is safe to return to end
+// users, whereas any wrapped error is only for logging, as it may leak
+// internal details.
+type Error struct {
+	// class is one of the class sentinels.
+	class error
+
+	// description is a user facing description of the error.
+	description string
+
+	// err is the underlying error, if any.
+	err error
+}
+
+// New returns a new error of the given class.
+func New(class error, description string) *Error {
+	return &Error{
+		class:       class,
+		description: description,
+	}
+}
+
+// NotFound returns a new not found error.
+func NotFound(description string) *Error {
+	return New(ErrNotFound, description)
+}
+
+// Conflict returns a new conflict error.
+func Conflict(description string) *Error {
+	return New(ErrConflict, description)
+}
+
+// Quota returns a new quota error.
+func Quota(description string) *Error {
+	return New(ErrQuota, description)
+}
+
+// Invalid returns a new invalid error.
+func Invalid(description string) *Error {
+	return New(ErrInvalid, description)
+}
+
+// Forbidden returns a new forbidden error.
+func Forbidden(description string) *Error {
+	return New(ErrForbidden, description)
+}
+
+// Unavailable returns a new unavailable error.
+func Unavailable(description string) *Error {
+	return New(ErrUnavailable, description)
+}
+
+// WithError augments the error with the underlying cause.  This must not be
+// called on package level sentinel errors.
+func (e *Error) WithError(err error) *Error {
+	e.err = err
+
+	return e
+}
+
+// Description returns the user facing description.
+func (e *Error) Description() string {
+	return e.description
+}
+
+// Error implements the error interface.  The class is not included, as it's
+// implied by the description, and is exposed via Class.
+func (e *Error) Error() string {
+	message := e.description
+
+	if message == "" {
+		message = e.class.Error()
+	}
+
+	if e.err != nil {
+		message += ": " + e.err.Error()
+	}
+
+	return message
+}
+
+// Unwrap implements Go 1.20 errors, so both the class and any underlying
+// cause can be matched.
+func (e *Error) Unwrap() []error {
+	if e.err == nil {
+		return []error{e.class}
+	}
+
+	return []error{e.class, e.err}
+}
+
+// Class returns the class of the error, or nil if it is unclassified.
+func Class(err error) error {
+	for _, class := range classes {
+		if errors.Is(err, class) {
+			return class
+		}
+	}
+
+	return nil
+}
+
+// Description returns the user facing description of a classified error,
+// falling back to the class description when there is no typed error in
+// the chain.
+func Description(err error) string {
+	var typed *Error
+
+	if errors.As(err, &typed) && typed.description != "" {
+		return typed.description
+	}
+
+	if class := Class(err); class != nil {
+		return class.Error()
+	}
+
+	return ""
+}
+
+// FromKubernetes classifies a Kubernetes API error, returning the original
+// error if it's not one that has a class.
+func FromKubernetes(err error) error {
+	var class error
+
+	switch {
+	case kerrors.IsNotFound(err):
+		class = ErrNotFound
+	case kerrors.IsAlreadyExists(err), kerrors.IsConflict(err):
+		class = ErrConflict
+	case kerrors.IsInvalid(err), kerrors.IsBadRequest(err):
+		class = ErrInvalid
+	case kerrors.IsForbidden(err):
+		class = ErrForbidden
+	case kerrors.IsServerTimeout(err), kerrors.IsTimeout(err), kerrors.IsTooManyRequests(err), kerrors.IsServiceUnavailable(err):
+		class = ErrUnavailable
+	default:
+		return err
+	}
+
+	return New(class, "").WithError(err)
+}
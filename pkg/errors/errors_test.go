@@ -0,0 +1,178 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	errCause = errors.New("boom")
+
+	errSentinel = unikornerrors.NotFound("widget not found")
+)
+
+// TestClass tests errors are classified however they are wrapped.
+func TestClass(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		err   error
+		class error
+	}{
+		{
+			name:  "Typed",
+			err:   unikornerrors.Conflict("widget exists"),
+			class: unikornerrors.ErrConflict,
+		},
+		{
+			name:  "Sentinel",
+			err:   fmt.Errorf("%w: foo", errSentinel),
+			class: unikornerrors.ErrNotFound,
+		},
+		{
+			name:  "Wrapped",
+			err:   fmt.Errorf("%w: no more widgets", unikornerrors.ErrQuota),
+			class: unikornerrors.ErrQuota,
+		},
+		{
+			name:  "QuotaConflict",
+			err:   unikornerrors.Conflict("widget conflict").WithError(unikornerrors.Quota("widget quota")),
+			class: unikornerrors.ErrQuota,
+		},
+		{
+			name: "Unclassified",
+			err:  errCause,
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if class := unikornerrors.Class(test.err); !errors.Is(class, test.class) {
+				t.Fatalf("expected class %v, got %v", test.class, class)
+			}
+		})
+	}
+}
+
+// TestError tests the error message and unwrapping of typed errors.
+func TestError(t *testing.T) {
+	t.Parallel()
+
+	err := unikornerrors.Unavailable("widget not ready").WithError(errCause)
+
+	if err.Error() != "widget not ready: boom" {
+		t.Fatal("unexpected message", err.Error())
+	}
+
+	if !errors.Is(err, errCause) {
+		t.Fatal("expected cause to be wrapped")
+	}
+
+	if !errors.Is(err, unikornerrors.ErrUnavailable) {
+		t.Fatal("expected class to be wrapped")
+	}
+
+	if unikornerrors.New(unikornerrors.ErrInvalid, "").Error() != unikornerrors.ErrInvalid.Error() {
+		t.Fatal("expected class message")
+	}
+}
+
+// TestDescription tests user facing descriptions don't leak the cause.
+func TestDescription(t *testing.T) {
+	t.Parallel()
+
+	if description := unikornerrors.Description(unikornerrors.Forbidden("no widgets for you").WithError(errCause)); description != "no widgets for you" {
+		t.Fatal("unexpected description", description)
+	}
+
+	if description := unikornerrors.Description(fmt.Errorf("%w: %w", unikornerrors.ErrNotFound, errCause)); description != unikornerrors.ErrNotFound.Error() {
+		t.Fatal("unexpected description", description)
+	}
+
+	if description := unikornerrors.Description(errCause); description != "" {
+		t.Fatal("unexpected description", description)
+	}
+}
+
+// TestFromKubernetes tests Kubernetes API errors are classified.
+func TestFromKubernetes(t *testing.T) {
+	t.Parallel()
+
+	resource := schema.GroupResource{Group: "unikorn.eschercloud.ai", Resource: "widgets"}
+
+	tests := []struct {
+		name  string
+		err   error
+		class error
+	}{
+		{
+			name:  "NotFound",
+			err:   kerrors.NewNotFound(resource, "foo"),
+			class: unikornerrors.ErrNotFound,
+		},
+		{
+			name:  "AlreadyExists",
+			err:   kerrors.NewAlreadyExists(resource, "foo"),
+			class: unikornerrors.ErrConflict,
+		},
+		{
+			name:  "Forbidden",
+			err:   kerrors.NewForbidden(resource, "foo", errCause),
+			class: unikornerrors.ErrForbidden,
+		},
+		{
+			name:  "TooManyRequests",
+			err:   kerrors.NewTooManyRequests("slow down", 1),
+			class: unikornerrors.ErrUnavailable,
+		},
+		{
+			name: "Internal",
+			err:  kerrors.NewInternalError(errCause),
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := unikornerrors.FromKubernetes(test.err)
+
+			if class := unikornerrors.Class(err); !errors.Is(class, test.class) {
+				t.Fatalf("expected class %v, got %v", test.class, class)
+			}
+
+			if !errors.Is(err, test.err) {
+				t.Fatal("expected Kubernetes error to be preserved")
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package logging provides logging utilities common to all components.
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Redacted replaces any sensitive value.
+const Redacted = "[REDACTED]"
+
+// defaultFields are log keys whose values are always redacted.  Keys are
+// normalized before comparison, and match if they contain any of these, so
+// "token" will match "accessToken" and "X-Auth-Token".
+//
+//nolint:gochecknoglobals
+var defaultFields = []string{
+	"token",
+	"secret",
+	"password",
+	"privatekey",
+	"authorization",
+	"cloudconfig",
+	"kubeconfig",
+}
+
+// defaultPatterns are always redacted from log messages, string values and
+// errors.
+//
+//nolint:gochecknoglobals
+var defaultPatterns = []*regexp.Regexp{
+	// JSON web tokens, and encrypted tokens as issued by the server.
+	regexp.MustCompile(`eyJ[A-Za-z0-9_-]*(?:\.[A-Za-z0-9_-]*){2,4}`),
+	// Keystone fernet tokens.
+	regexp.MustCompile(`gAAAAA[A-Za-z0-9_-]{20,}=*`),
+	// HTTP bearer authorization.
+	regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/=-]+`),
+	// Secrets embedded in YAML or JSON e.g. application credential secrets
+	// in cloud configs.
+	regexp.MustCompile(`(?i)"?[a-z_-]*(?:secret|password|token)"?\s*[:=]\s*"?[^\s",}]+"?`),
+}
+
+// Options allows redaction to be extended beyond the defaults.
+type Options struct {
+	// Fields are additional log keys whose values are redacted.
+	Fields []string
+
+	// Patterns are additional regular expressions that are redacted
+	// from log messages and values.
+	Patterns []*regexp.Regexp
+}
+
+// regexpSliceValue allows regular expressions to be parsed from the command line.
+type regexpSliceValue []*regexp.Regexp
+
+// Ensure the pflag.Value interface is implemented.
+var _ pflag.Value = &regexpSliceValue{}
+
+// String implements the pflag.Value interface.
+func (r *regexpSliceValue) String() string {
+	patterns := make([]string, len(*r))
+
+	for i, re := range *r {
+		patterns[i] = re.String()
+	}
+
+	return "[" + strings.Join(patterns, ",") + "]"
+}
+
+// Set implements the pflag.Value interface.
+func (r *regexpSliceValue) Set(s string) error {
+	re, err := regexp.Compile(s)
+	if err != nil {
+		return err
+	}
+
+	*r = append(*r, re)
+
+	return nil
+}
+
+// Type implements the pflag.Value interface.
+func (r *regexpSliceValue) Type() string {
+	return "regexp"
+}
+
+// AddFlags registers redaction flags with pflag.
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringSliceVar(&o.Fields, "log-redact-field", nil, "Additional log keys whose values are redacted, may be specified more than once")
+	flags.Var((*regexpSliceValue)(&o.Patterns), "log-redact-pattern", "Additional regular expressions redacted from log output, may be specified more than once")
+}
+
+// Redactor removes sensitive values from logs.
+type Redactor struct {
+	fields   []string
+	patterns []*regexp.Regexp
+}
+
+// normalizeField allows field names to be compared regardless of case and
+// word separators.
+func normalizeField(s string) string {
+	return strings.NewReplacer("-", "", "_", "", ".", "", " ", "").Replace(strings.ToLower(s))
+}
+
+// NewRedactor returns a redactor that applies the defaults, plus anything
+// specified in the options, which may be nil.
+func NewRedactor(o *Options) *Redactor {
+	r := &Redactor{
+		patterns: defaultPatterns,
+	}
+
+	for _, field := range defaultFields {
+		r.fields = append(r.fields, normalizeField(field))
+	}
+
+	if o != nil {
+		for _, field := range o.Fields {
+			r.fields = append(r.fields, normalizeField(field))
+		}
+
+		r.patterns = append(r.patterns, o.Patterns...)
+	}
+
+	return r
+}
+
+// String redacts any sensitive values in a string.
+func (r *Redactor) String(s string) string {
+	for _, re := range r.patterns {
+		s = re.ReplaceAllLiteralString(s, Redacted)
+	}
+
+	return s
+}
+
+// Value redacts a string that is associated with a key e.g. an HTTP header.
+func (r *Redactor) Value(key, value string) string {
+	if r.sensitive(key) {
+		return Redacted
+	}
+
+	return r.String(value)
+}
+
+// JSON redacts a JSON document.  Values of any object keys that are sensitive
+// are removed, as are any sensitive patterns in strings.  If the document
+// cannot be parsed it is treated as a string.
+func (r *Redactor) JSON(data []byte) []byte {
+	var document interface{}
+
+	if err := json.Unmarshal(data, &document); err != nil {
+		return []byte(r.String(string(data)))
+	}
+
+	out, err := json.Marshal(r.document(document))
+	if err != nil {
+		return []byte(r.String(string(data)))
+	}
+
+	return out
+}
+
+// document recursively redacts a decoded JSON document.
+func (r *Redactor) document(in interface{}) interface{} {
+	switch t := in.(type) {
+	case map[string]interface{}:
+		for key, value := range t {
+			if r.sensitive(key) {
+				t[key] = Redacted
+
+				continue
+			}
+
+			t[key] = r.document(value)
+		}
+	case []interface{}:
+		for i := range t {
+			t[i] = r.document(t[i])
+		}
+	case string:
+		return r.String(t)
+	}
+
+	return in
+}
+
+// sensitive returns true if the log key's value must be redacted.
+func (r *Redactor) sensitive(key interface{}) bool {
+	k, ok := key.(string)
+	if !ok {
+		return false
+	}
+
+	k = normalizeField(k)
+
+	for _, field := range r.fields {
+		if strings.Contains(k, field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// value redacts a log value.  Only strings, byte arrays and errors are
+// inspected, anything else is passed through unmodified, so take care not to
+// log structured types that contain secrets.
+func (r *Redactor) value(key, value interface{}) interface{} {
+	if r.sensitive(key) {
+		return Redacted
+	}
+
+	switch t := value.(type) {
+	case string:
+		return r.String(t)
+	case []byte:
+		return r.String(string(t))
+	case *[]byte:
+		if t == nil {
+			return t
+		}
+
+		return r.String(string(*t))
+	case error:
+		return r.Error(t)
+	}
+
+	return value
+}
+
+// keysAndValues redacts a set of log key/value pairs.
+func (r *Redactor) keysAndValues(in []interface{}) []interface{} {
+	if len(in) == 0 {
+		return in
+	}
+
+	out := make([]interface{}, len(in))
+
+	copy(out, in)
+
+	for i := 1; i < len(out); i += 2 {
+		out[i] = r.value(out[i-1], out[i])
+	}
+
+	return out
+}
+
+// redactedError wraps an error, redacting its message.
+type redactedError struct {
+	err      error
+	redactor *Redactor
+}
+
+// Error implements the error interface.  Redaction is performed lazily so
+// the logger's usual handling of errors that panic applies.
+func (e *redactedError) Error() string {
+	return e.redactor.String(e.err.Error())
+}
+
+// Unwrap allows errors.Is and errors.As to see the original error.
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// Error returns an error whose message is redacted, the original error is
+// preserved in the chain.
+func (r *Redactor) Error(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &redactedError{
+		err:      err,
+		redactor: r,
+	}
+}
+
+// logSink wraps another sink, redacting everything passed to it.
+type logSink struct {
+	sink     logr.LogSink
+	redactor *Redactor
+}
+
+// Ensure the logr.LogSink interfaces are implemented.
+var _ logr.LogSink = &logSink{}
+var _ logr.CallDepthLogSink = &logSink{}
+
+// Init implements the logr.LogSink interface.  The wrapped sink is already
+// initialized, it just needs to skip the extra stack frame added by this one.
+func (s *logSink) Init(_ logr.RuntimeInfo) {
+	if sink, ok := s.sink.(logr.CallDepthLogSink); ok {
+		s.sink = sink.WithCallDepth(1)
+	}
+}
+
+// Enabled implements the logr.LogSink interface.
+func (s *logSink) Enabled(level int) bool {
+	return s.sink.Enabled(level)
+}
+
+// Info implements the logr.LogSink interface.
+func (s *logSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	s.sink.Info(level, s.redactor.String(msg), s.redactor.keysAndValues(keysAndValues)...)
+}
+
+// Error implements the logr.LogSink interface.
+func (s *logSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	s.sink.Error(s.redactor.Error(err), s.redactor.String(msg), s.redactor.keysAndValues(keysAndValues)...)
+}
+
+// WithValues implements the logr.LogSink interface.
+func (s *logSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	return &logSink{
+		sink:     s.sink.WithValues(s.redactor.keysAndValues(keysAndValues)...),
+		redactor: s.redactor,
+	}
+}
+
+// WithName implements the logr.LogSink interface.
+func (s *logSink) WithName(name string) logr.LogSink {
+	return &logSink{
+		sink:     s.sink.WithName(name),
+		redactor: s.redactor,
+	}
+}
+
+// WithCallDepth implements the logr.CallDepthLogSink interface.
+func (s *logSink) WithCallDepth(depth int) logr.LogSink {
+	sink, ok := s.sink.(logr.CallDepthLogSink)
+	if !ok {
+		return s
+	}
+
+	return &logSink{
+		sink:     sink.WithCallDepth(depth),
+		redactor: s.redactor,
+	}
+}
+
+// Logger returns a logger that redacts everything before passing it to the
+// provided logger.
+func (r *Redactor) Logger(l logr.Logger) logr.Logger {
+	sink := l.GetSink()
+	if sink == nil {
+		return l
+	}
+
+	// Already redacted, don't do it twice.
+	if _, ok := sink.(*logSink); ok {
+		return l
+	}
+
+	return logr.New(&logSink{sink: sink, redactor: r}).V(l.GetV())
+}
+
+// reconciler wraps another reconciler, redacting anything it logs via the
+// context, or returns as an error for the controller to log.
+type reconciler struct {
+	reconciler reconcile.Reconciler
+	redactor   *Redactor
+}
+
+// Ensure the reconcile.Reconciler interface is implemented.
+var _ reconcile.Reconciler = &reconciler{}
+
+// Reconciler wraps a reconciler with redaction.  This is used where the logger
+// is configured by a library and cannot be wrapped at its source.
+func (r *Redactor) Reconciler(delegate reconcile.Reconciler) reconcile.Reconciler {
+	return &reconciler{
+		reconciler: delegate,
+		redactor:   r,
+	}
+}
+
+// Reconcile implements the reconcile.Reconciler interface.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	ctx = log.IntoContext(ctx, r.redactor.Logger(log.FromContext(ctx)))
+
+	result, err := r.reconciler.Reconcile(ctx, request)
+
+	return result, r.redactor.Error(err)
+}
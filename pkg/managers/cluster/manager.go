@@ -17,14 +17,23 @@ limitations under the License.
 package cluster
 
 import (
+	"github.com/spf13/pflag"
+
 	unikornscheme "github.com/eschercloudai/unikorn/generated/clientset/unikorn/scheme"
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/logging"
+	"github.com/eschercloudai/unikorn/pkg/managers/conditions"
+	"github.com/eschercloudai/unikorn/pkg/managers/events"
+	"github.com/eschercloudai/unikorn/pkg/managers/notification"
+	"github.com/eschercloudai/unikorn/pkg/managers/propagation"
+	"github.com/eschercloudai/unikorn/pkg/managers/verification"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/managers/cluster"
 
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
 	"github.com/eschercloudai/unikorn-core/pkg/constants"
 	coremanager "github.com/eschercloudai/unikorn-core/pkg/manager"
 	"github.com/eschercloudai/unikorn-core/pkg/manager/options"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -36,7 +45,16 @@ import (
 )
 
 // Factory provides methods that can build a type specific controller.
-type Factory struct{}
+type Factory struct {
+	// redaction configures removal of sensitive values from logs.
+	redaction logging.Options
+
+	// provisioner configures operator provided provisioning options.
+	provisioner cluster.Options
+
+	// verification configures periodic machine verification.
+	verification verification.Options
+}
 
 var _ coremanager.ControllerFactory = &Factory{}
 
@@ -45,18 +63,77 @@ func (*Factory) Metadata() (string, string, string) {
 	return constants.Application, constants.Version, constants.Revision
 }
 
+// AddFlags registers factory specific flags, these must be added before
+// the manager parses them.
+func (f *Factory) AddFlags(flags *pflag.FlagSet) {
+	f.redaction.AddFlags(flags)
+	f.provisioner.AddFlags(flags)
+	f.verification.AddFlags(flags)
+}
+
 // Reconciler returns a new reconciler instance.
-func (*Factory) Reconciler(options *options.Options, manager manager.Manager) reconcile.Reconciler {
-	return coremanager.NewReconciler(options, manager.GetClient(), cluster.New)
+func (f *Factory) Reconciler(options *options.Options, manager manager.Manager) reconcile.Reconciler {
+	newProvisioner := func() provisioners.ManagerProvisioner {
+		return cluster.New(&f.provisioner)
+	}
+
+	propagationObject := func() propagation.Resource {
+		return &unikornv1.KubernetesCluster{}
+	}
+
+	conditionObject := func() conditions.Resource {
+		return &unikornv1.KubernetesCluster{}
+	}
+
+	// Classified provisioning errors replace the generic condition set by
+	// the core reconciler.
+	conditioned := conditions.New(manager.GetClient(), manager.GetAPIReader(), conditionObject, coremanager.NewReconciler(options, manager.GetClient(), conditions.NewProvisioner(newProvisioner)))
+
+	// Project labels are propagated once the resource has been reconciled.
+	propagated := propagation.New(manager.GetClient(), propagationObject, conditioned)
+
+	newObject := func() notification.Resource {
+		return &unikornv1.KubernetesCluster{}
+	}
+
+	// Notifications are raised by observing how the core reconciler modifies
+	// the resource status.
+	reconciler := notification.New(manager.GetClient(), manager.GetAPIReader(), unikornv1.KubernetesClusterKind, newObject, propagated)
+
+	// The logger is configured by the core library, so redaction has to be
+	// applied to anything the reconciler logs.
+	redacted := logging.NewRedactor(&f.redaction).Reconciler(reconciler)
+
+	eventObject := func() events.Resource {
+		return &unikornv1.KubernetesCluster{}
+	}
+
+	// Events are emitted from errors that have already been redacted.
+	return events.New(manager.GetAPIReader(), manager.GetEventRecorderFor(constants.Application), eventObject, redacted)
 }
 
 // RegisterWatches adds any watches that would trigger a reconcile.
-func (*Factory) RegisterWatches(manager manager.Manager, controller controller.Controller) error {
+func (f *Factory) RegisterWatches(manager manager.Manager, controller controller.Controller) error {
 	// Any changes to the cluster spec, trigger a reconcile.
 	if err := controller.Watch(source.Kind(manager.GetCache(), &unikornv1.KubernetesCluster{}), &handler.EnqueueRequestForObject{}, &predicate.GenerationChangedPredicate{}); err != nil {
 		return err
 	}
 
+	// Changes to the project may need labels to be propagated.
+	newList := func() client.ObjectList {
+		return &unikornv1.KubernetesClusterList{}
+	}
+
+	if err := controller.Watch(source.Kind(manager.GetCache(), &unikornv1.Project{}), handler.EnqueueRequestsFromMapFunc(propagation.ProjectMapFunc(manager.GetClient(), newList)), &predicate.GenerationChangedPredicate{}); err != nil {
+		return err
+	}
+
+	// Machines are periodically verified alongside reconciliation, this
+	// isn't a watch as such, but it's the last chance to add to the manager.
+	if err := manager.Add(verification.New(manager.GetClient(), &f.verification)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
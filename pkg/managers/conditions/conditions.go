@@ -0,0 +1,184 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"context"
+	"errors"
+
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Resource is a resource whose available condition is managed.
+type Resource interface {
+	client.Object
+	coreunikornv1.StatusConditionWriter
+}
+
+// prefixes describe each class of error in the condition message.
+//
+//nolint:gochecknoglobals
+var prefixes = map[error]string{
+	unikornerrors.ErrNotFound:    "Resource not found",
+	unikornerrors.ErrConflict:    "Resource conflict",
+	unikornerrors.ErrQuota:       "Quota exceeded",
+	unikornerrors.ErrInvalid:     "Invalid configuration",
+	unikornerrors.ErrForbidden:   "Forbidden",
+	unikornerrors.ErrUnavailable: "Waiting",
+}
+
+// Condition maps a classified provisioning error to the available condition.
+// Unavailable errors are transient, so are reported as progressing in the same
+// way as a yield, rather than as an error that needs attention.  Returns false
+// if the error is unclassified, and the core reconciler's condition stands.
+func Condition(err error, deprovision bool) (corev1.ConditionStatus, coreunikornv1.ConditionReason, string, bool) {
+	class := unikornerrors.Class(err)
+	if class == nil {
+		return "", "", "", false
+	}
+
+	message := prefixes[class] + ": " + err.Error()
+
+	if !errors.Is(class, unikornerrors.ErrUnavailable) {
+		return corev1.ConditionFalse, coreunikornv1.ConditionReasonErrored, message, true
+	}
+
+	if deprovision {
+		return corev1.ConditionFalse, coreunikornv1.ConditionReasonDeprovisioning, message, true
+	}
+
+	return corev1.ConditionFalse, coreunikornv1.ConditionReasonProvisioning, message, true
+}
+
+// outcome records the result of provisioning, the core reconciler doesn't
+// expose it, so it's passed back via the context.
+type outcome struct {
+	err         error
+	deprovision bool
+}
+
+type key struct{}
+
+// record saves the provisioning outcome, if the context is being observed.
+func record(ctx context.Context, err error, deprovision bool) {
+	if r, ok := ctx.Value(key{}).(*outcome); ok {
+		r.err = err
+		r.deprovision = deprovision
+	}
+}
+
+// Provisioner wraps a manager provisioner, recording any error so that it can
+// be classified by the reconciler.
+type Provisioner struct {
+	provisioners.ManagerProvisioner
+}
+
+// Ensure the provisioners.ManagerProvisioner interface is implemented.
+var _ provisioners.ManagerProvisioner = &Provisioner{}
+
+// NewProvisioner returns a provisioner factory that wraps the provided one.
+func NewProvisioner(newProvisioner func() provisioners.ManagerProvisioner) func() provisioners.ManagerProvisioner {
+	return func() provisioners.ManagerProvisioner {
+		return &Provisioner{
+			ManagerProvisioner: newProvisioner(),
+		}
+	}
+}
+
+// Provision implements the provisioners.Provisioner interface.
+func (p *Provisioner) Provision(ctx context.Context) error {
+	err := p.ManagerProvisioner.Provision(ctx)
+
+	record(ctx, err, false)
+
+	return err
+}
+
+// Deprovision implements the provisioners.Provisioner interface.
+func (p *Provisioner) Deprovision(ctx context.Context) error {
+	err := p.ManagerProvisioner.Deprovision(ctx)
+
+	record(ctx, err, true)
+
+	return err
+}
+
+// Reconciler wraps the core reconciler, replacing the generic error condition
+// it sets with one derived from the error class.
+type Reconciler struct {
+	client     client.Client
+	reader     client.Reader
+	reconciler reconcile.Reconciler
+	newObject  func() Resource
+}
+
+// Ensure the reconcile.Reconciler interface is implemented.
+var _ reconcile.Reconciler = &Reconciler{}
+
+// New wraps a reconciler with error classification.  The reader must not be
+// cached, as the resource status has just been updated by the delegate.  The
+// delegate's provisioners must be wrapped with NewProvisioner.
+func New(client client.Client, reader client.Reader, newObject func() Resource, delegate reconcile.Reconciler) *Reconciler {
+	return &Reconciler{
+		client:     client,
+		reader:     reader,
+		reconciler: delegate,
+		newObject:  newObject,
+	}
+}
+
+// Reconcile implements the reconcile.Reconciler interface.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	provisioned := &outcome{}
+
+	result, err := r.reconciler.Reconcile(context.WithValue(ctx, key{}, provisioned), request)
+	if err != nil {
+		return result, err
+	}
+
+	status, reason, message, ok := Condition(provisioned.err, provisioned.deprovision)
+	if !ok {
+		return result, nil
+	}
+
+	object := r.newObject()
+
+	if err := r.reader.Get(ctx, request.NamespacedName, object); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+
+		return result, nil
+	}
+
+	object.StatusConditionWrite(coreunikornv1.ConditionAvailable, status, reason, message)
+
+	if err := r.client.Status().Update(ctx, object); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+	"github.com/eschercloudai/unikorn/pkg/managers/conditions"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	projectName = "foo"
+)
+
+var (
+	errUnhandled = errors.New("boom")
+)
+
+// provisioner fails with the configured error.
+type provisioner struct {
+	provisioners.ManagerProvisioner
+
+	err error
+}
+
+func (p *provisioner) Provision(_ context.Context) error {
+	return p.err
+}
+
+func (p *provisioner) Deprovision(_ context.Context) error {
+	return p.err
+}
+
+// newClient returns a fake client that contains a project.
+func newClient(t *testing.T) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+
+	if err := unikornv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	project := &unikornv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: projectName,
+		},
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(project).WithStatusSubresource(project).Build()
+}
+
+// mustReconcile provisions a project, that fails with the given error, and
+// returns its available condition.  The delegate mimics the core reconciler.
+func mustReconcile(t *testing.T, err error, deprovision bool) *coreunikornv1.Condition {
+	t.Helper()
+
+	c := newClient(t)
+
+	newProvisioner := conditions.NewProvisioner(func() provisioners.ManagerProvisioner {
+		return &provisioner{err: err}
+	})
+
+	delegate := reconcile.Func(func(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+		project := &unikornv1.Project{}
+
+		if err := c.Get(ctx, request.NamespacedName, project); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		provision := newProvisioner().Provision
+
+		if deprovision {
+			provision = newProvisioner().Deprovision
+		}
+
+		if err := provision(ctx); err != nil {
+			project.StatusConditionWrite(coreunikornv1.ConditionAvailable, corev1.ConditionFalse, coreunikornv1.ConditionReasonErrored, fmt.Sprintf("Unhandled error: %v", err))
+		} else {
+			project.StatusConditionWrite(coreunikornv1.ConditionAvailable, corev1.ConditionTrue, coreunikornv1.ConditionReasonProvisioned, "Provisioned")
+		}
+
+		return reconcile.Result{}, c.Status().Update(ctx, project)
+	})
+
+	newObject := func() conditions.Resource {
+		return &unikornv1.Project{}
+	}
+
+	request := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name: projectName,
+		},
+	}
+
+	if _, err := conditions.New(c, c, newObject, delegate).Reconcile(context.Background(), request); err != nil {
+		t.Fatal(err)
+	}
+
+	project := &unikornv1.Project{}
+
+	if err := c.Get(context.Background(), request.NamespacedName, project); err != nil {
+		t.Fatal(err)
+	}
+
+	condition, err := project.StatusConditionRead(coreunikornv1.ConditionAvailable)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return condition
+}
+
+func expectCondition(t *testing.T, condition *coreunikornv1.Condition, reason coreunikornv1.ConditionReason, message string) {
+	t.Helper()
+
+	if condition.Reason != reason || condition.Message != message {
+		t.Fatal("unexpected condition", condition.Reason, condition.Message)
+	}
+}
+
+// TestProvisioned checks successful provisioning is left alone.
+func TestProvisioned(t *testing.T) {
+	t.Parallel()
+
+	expectCondition(t, mustReconcile(t, nil, false), coreunikornv1.ConditionReasonProvisioned, "Provisioned")
+}
+
+// TestUnclassified checks unclassified errors are left alone.
+func TestUnclassified(t *testing.T) {
+	t.Parallel()
+
+	expectCondition(t, mustReconcile(t, errUnhandled, false), coreunikornv1.ConditionReasonErrored, "Unhandled error: boom")
+}
+
+// TestClassified checks classified errors are described by their class.
+func TestClassified(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("%w: bar", unikornerrors.NotFound("widget not found"))
+
+	expectCondition(t, mustReconcile(t, err, false), coreunikornv1.ConditionReasonErrored, "Resource not found: widget not found: bar")
+}
+
+// TestUnavailable checks transient errors are reported as progressing.
+func TestUnavailable(t *testing.T) {
+	t.Parallel()
+
+	err := unikornerrors.Unavailable("widget not ready")
+
+	expectCondition(t, mustReconcile(t, err, false), coreunikornv1.ConditionReasonProvisioning, "Waiting: widget not ready")
+	expectCondition(t, mustReconcile(t, err, true), coreunikornv1.ConditionReasonDeprovisioning, "Waiting: widget not ready")
+}
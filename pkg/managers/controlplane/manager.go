@@ -17,8 +17,15 @@ limitations under the License.
 package controlplane
 
 import (
+	"github.com/spf13/pflag"
+
 	unikornscheme "github.com/eschercloudai/unikorn/generated/clientset/unikorn/scheme"
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/logging"
+	"github.com/eschercloudai/unikorn/pkg/managers/conditions"
+	"github.com/eschercloudai/unikorn/pkg/managers/events"
+	"github.com/eschercloudai/unikorn/pkg/managers/notification"
+	"github.com/eschercloudai/unikorn/pkg/managers/propagation"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/managers/controlplane"
 
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
@@ -36,7 +43,10 @@ import (
 )
 
 // Factory provides methods that can build a type specific controller.
-type Factory struct{}
+type Factory struct {
+	// redaction configures removal of sensitive values from logs.
+	redaction logging.Options
+}
 
 var _ coremanager.ControllerFactory = &Factory{}
 
@@ -45,9 +55,47 @@ func (*Factory) Metadata() (string, string, string) {
 	return constants.Application, constants.Version, constants.Revision
 }
 
+// AddFlags registers factory specific flags, these must be added before
+// the manager parses them.
+func (f *Factory) AddFlags(flags *pflag.FlagSet) {
+	f.redaction.AddFlags(flags)
+}
+
 // Reconciler returns a new reconciler instance.
-func (*Factory) Reconciler(options *options.Options, manager manager.Manager) reconcile.Reconciler {
-	return coremanager.NewReconciler(options, manager.GetClient(), controlplane.New)
+func (f *Factory) Reconciler(options *options.Options, manager manager.Manager) reconcile.Reconciler {
+	propagationObject := func() propagation.Resource {
+		return &unikornv1.ControlPlane{}
+	}
+
+	conditionObject := func() conditions.Resource {
+		return &unikornv1.ControlPlane{}
+	}
+
+	// Classified provisioning errors replace the generic condition set by
+	// the core reconciler.
+	conditioned := conditions.New(manager.GetClient(), manager.GetAPIReader(), conditionObject, coremanager.NewReconciler(options, manager.GetClient(), conditions.NewProvisioner(controlplane.New)))
+
+	// Project labels are propagated once the resource has been reconciled.
+	propagated := propagation.New(manager.GetClient(), propagationObject, conditioned)
+
+	newObject := func() notification.Resource {
+		return &unikornv1.ControlPlane{}
+	}
+
+	// Notifications are raised by observing how the core reconciler modifies
+	// the resource status.
+	reconciler := notification.New(manager.GetClient(), manager.GetAPIReader(), unikornv1.ControlPlaneKind, newObject, propagated)
+
+	// The logger is configured by the core library, so redaction has to be
+	// applied to anything the reconciler logs.
+	redacted := logging.NewRedactor(&f.redaction).Reconciler(reconciler)
+
+	eventObject := func() events.Resource {
+		return &unikornv1.ControlPlane{}
+	}
+
+	// Events are emitted from errors that have already been redacted.
+	return events.New(manager.GetAPIReader(), manager.GetEventRecorderFor(constants.Application), eventObject, redacted)
 }
 
 // RegisterWatches adds any watches that would trigger a reconcile.
@@ -56,6 +104,15 @@ func (*Factory) RegisterWatches(manager manager.Manager, controller controller.C
 		return err
 	}
 
+	// Changes to the project may need labels to be propagated.
+	newList := func() client.ObjectList {
+		return &unikornv1.ControlPlaneList{}
+	}
+
+	if err := controller.Watch(source.Kind(manager.GetCache(), &unikornv1.Project{}), handler.EnqueueRequestsFromMapFunc(propagation.ProjectMapFunc(manager.GetClient(), newList)), &predicate.GenerationChangedPredicate{}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
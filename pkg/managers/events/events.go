@@ -0,0 +1,132 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ReasonReconcileFailed is used when the reconciler returns an error,
+	// and will be retried.
+	ReasonReconcileFailed = "ReconcileFailed"
+)
+
+// Resource is a resource that events can be emitted for.
+type Resource interface {
+	client.Object
+	coreunikornv1.StatusConditionReader
+}
+
+// Reconciler wraps another reconciler, emitting Kubernetes events as the
+// resource it manages progresses, so they are visible alongside the resource
+// rather than just in the controller logs.
+type Reconciler struct {
+	// reader must be uncached, updates made by the delegate are unlikely
+	// to be visible in the cache when they are examined.
+	reader     client.Reader
+	recorder   record.EventRecorder
+	reconciler reconcile.Reconciler
+	newObject  func() Resource
+}
+
+// Ensure the reconcile.Reconciler interface is implemented.
+var _ reconcile.Reconciler = &Reconciler{}
+
+// New wraps a reconciler with event emission.
+func New(reader client.Reader, recorder record.EventRecorder, newObject func() Resource, delegate reconcile.Reconciler) *Reconciler {
+	return &Reconciler{
+		reader:     reader,
+		recorder:   recorder,
+		reconciler: delegate,
+		newObject:  newObject,
+	}
+}
+
+// available returns the available condition, if it exists.
+func available(object Resource) *coreunikornv1.Condition {
+	condition, err := object.StatusConditionRead(coreunikornv1.ConditionAvailable)
+	if err != nil {
+		return nil
+	}
+
+	return condition
+}
+
+// eventType returns whether the condition reason is normal or needs attention.
+func eventType(reason coreunikornv1.ConditionReason) string {
+	//nolint:exhaustive
+	switch reason {
+	case coreunikornv1.ConditionReasonErrored, coreunikornv1.ConditionReasonCancelled:
+		return corev1.EventTypeWarning
+	}
+
+	return corev1.EventTypeNormal
+}
+
+// Reconcile implements the reconcile.Reconciler interface.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	before := r.newObject()
+
+	if err := r.reader.Get(ctx, request.NamespacedName, before); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+
+		// Nothing to attach events to.
+		return r.reconciler.Reconcile(ctx, request)
+	}
+
+	result, err := r.reconciler.Reconcile(ctx, request)
+	if err != nil {
+		r.recorder.Event(before, corev1.EventTypeWarning, ReasonReconcileFailed, err.Error())
+
+		return result, err
+	}
+
+	after := r.newObject()
+
+	if err := r.reader.Get(ctx, request.NamespacedName, after); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+
+		return result, nil
+	}
+
+	condition := available(after)
+	if condition == nil {
+		return result, nil
+	}
+
+	// Errors are emitted every time so the count reflects how often the
+	// reconcile is failing, everything else only on transitions.
+	if previous := available(before); previous == nil || previous.Reason != condition.Reason || previous.Message != condition.Message || condition.Reason == coreunikornv1.ConditionReasonErrored {
+		r.recorder.Event(after, eventType(condition.Reason), string(condition.Reason), condition.Message)
+	}
+
+	return result, nil
+}
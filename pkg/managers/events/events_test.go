@@ -0,0 +1,182 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/managers/events"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	projectName = "foo"
+)
+
+var (
+	errUnhandled = errors.New("boom")
+)
+
+// newClient returns a fake client that contains a project.
+func newClient(t *testing.T) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+
+	if err := unikornv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	project := &unikornv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: projectName,
+		},
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(project).Build()
+}
+
+// newReconciler returns an event reconciler whose delegate sets the available
+// condition to the given reason, or returns an error if one is set.
+func newReconciler(c client.Client, recorder record.EventRecorder, reason *coreunikornv1.ConditionReason, err *error) *events.Reconciler {
+	delegate := reconcile.Func(func(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+		if *err != nil {
+			return reconcile.Result{}, *err
+		}
+
+		project := &unikornv1.Project{}
+
+		if err := c.Get(ctx, request.NamespacedName, project); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		project.StatusConditionWrite(coreunikornv1.ConditionAvailable, corev1.ConditionTrue, *reason, string(*reason))
+
+		return reconcile.Result{}, c.Update(ctx, project)
+	})
+
+	newObject := func() events.Resource {
+		return &unikornv1.Project{}
+	}
+
+	return events.New(c, recorder, newObject, delegate)
+}
+
+func reconcileProject(r *events.Reconciler) error {
+	request := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name: projectName,
+		},
+	}
+
+	_, err := r.Reconcile(context.Background(), request)
+
+	return err
+}
+
+func mustReconcile(t *testing.T, r *events.Reconciler) {
+	t.Helper()
+
+	if err := reconcileProject(r); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// recorded drains all events from the recorder.
+func recorded(recorder *record.FakeRecorder) []string {
+	var events []string
+
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func expectEvents(t *testing.T, recorder *record.FakeRecorder, expected ...string) {
+	t.Helper()
+
+	if events := recorded(recorder); !slices.Equal(events, expected) {
+		t.Fatal("unexpected events", events, expected)
+	}
+}
+
+// TestTransitions checks events are emitted when the available condition changes.
+func TestTransitions(t *testing.T) {
+	t.Parallel()
+
+	recorder := record.NewFakeRecorder(16)
+
+	reason := coreunikornv1.ConditionReasonProvisioning
+
+	var err error
+
+	r := newReconciler(newClient(t), recorder, &reason, &err)
+
+	mustReconcile(t, r)
+	mustReconcile(t, r)
+	expectEvents(t, recorder, "Normal Provisioning Provisioning")
+
+	reason = coreunikornv1.ConditionReasonProvisioned
+
+	mustReconcile(t, r)
+	mustReconcile(t, r)
+	expectEvents(t, recorder, "Normal Provisioned Provisioned")
+}
+
+// TestErrors checks errors are emitted every time they occur.
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	recorder := record.NewFakeRecorder(16)
+
+	reason := coreunikornv1.ConditionReasonErrored
+
+	var err error
+
+	r := newReconciler(newClient(t), recorder, &reason, &err)
+
+	mustReconcile(t, r)
+	mustReconcile(t, r)
+	expectEvents(t, recorder, "Warning Errored Errored", "Warning Errored Errored")
+
+	err = errUnhandled
+
+	if !errors.Is(reconcileProject(r), errUnhandled) {
+		t.Fatal("expected an error")
+	}
+
+	expectEvents(t, recorder, "Warning "+events.ReasonReconcileFailed+" boom")
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var (
+	// ErrWebhookResponse is raised when the webhook returns an unexpected
+	// status code.
+	ErrWebhookResponse = errors.New("unexpected webhook response")
+
+	// ErrResourceType is raised when a resource cannot be copied.
+	ErrResourceType = errors.New("unable to assert resource type")
+)
+
+const (
+	// webhookTimeout is how long we wait for a webhook to respond, this
+	// is deliberately short so a slow endpoint doesn't hold up the manager.
+	webhookTimeout = 10 * time.Second
+)
+
+// Resource is a resource that notifications can be raised for.
+type Resource interface {
+	client.Object
+	coreunikornv1.StatusConditionReader
+
+	// ApplicationBundleName returns the application bundle the resource
+	// is provisioned with.
+	ApplicationBundleName() string
+}
+
+// Notification is the body that is sent to a generic webhook.
+type Notification struct {
+	// Event is the lifecycle event that occurred.
+	Event unikornv1.NotificationEvent `json:"event"`
+	// Kind is the resource kind the event occurred for.
+	Kind string `json:"kind"`
+	// Project is the project that owns the resource.
+	Project string `json:"project"`
+	// ControlPlane is the control plane the event occurred for, or the
+	// one that owns the cluster.
+	ControlPlane string `json:"controlPlane"`
+	// Cluster is the cluster the event occurred for, if applicable.
+	Cluster string `json:"cluster,omitempty"`
+	// ApplicationBundle is the application bundle the resource is
+	// provisioned with.
+	ApplicationBundle string `json:"applicationBundle,omitempty"`
+	// Message is a human readable description of the event.
+	Message string `json:"message,omitempty"`
+	// Time is when the event occurred.
+	Time time.Time `json:"time"`
+}
+
+// slackMessage is the body that is sent to a Slack compatible webhook.
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// text returns a human readable notification.
+func (n *Notification) text() string {
+	resource := fmt.Sprintf("control plane %s", n.ControlPlane)
+
+	if n.Cluster != "" {
+		resource = fmt.Sprintf("cluster %s/%s", n.ControlPlane, n.Cluster)
+	}
+
+	text := fmt.Sprintf("Project %s %s: %s", n.Project, resource, n.Event)
+
+	if n.Message != "" {
+		text += ": " + n.Message
+	}
+
+	return text
+}
+
+// state is recorded on the resource so events are only raised on transitions.
+type state struct {
+	// Event is the last event that was raised.
+	Event unikornv1.NotificationEvent `json:"event,omitempty"`
+	// ApplicationBundle is the application bundle that was last provisioned.
+	ApplicationBundle string `json:"applicationBundle,omitempty"`
+}
+
+// Reconciler wraps another reconciler, raising notifications when the
+// resource it manages changes state.
+type Reconciler struct {
+	client client.Client
+	// reader must be uncached, updates made by the delegate are unlikely
+	// to be visible in the cache when they are examined.
+	reader     client.Reader
+	reconciler reconcile.Reconciler
+	kind       string
+	newObject  func() Resource
+}
+
+// Ensure the reconcile.Reconciler interface is implemented.
+var _ reconcile.Reconciler = &Reconciler{}
+
+// New wraps a reconciler with lifecycle notifications.
+func New(client client.Client, reader client.Reader, kind string, newObject func() Resource, delegate reconcile.Reconciler) *Reconciler {
+	return &Reconciler{
+		client:     client,
+		reader:     reader,
+		reconciler: delegate,
+		kind:       kind,
+		newObject:  newObject,
+	}
+}
+
+// Reconcile implements the reconcile.Reconciler interface.  Notifications are
+// best effort, and failures never affect the result of the reconcile.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	before := r.newObject()
+
+	if err := r.client.Get(ctx, request.NamespacedName, before); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+
+		before = nil
+	}
+
+	result, err := r.reconciler.Reconcile(ctx, request)
+	if err != nil {
+		return result, err
+	}
+
+	if before != nil {
+		if err := r.notify(ctx, request, before); err != nil {
+			log.FromContext(ctx).Error(err, "failed to raise notifications")
+		}
+	}
+
+	return result, nil
+}
+
+// getState reads the last notified state from the resource.  Resources that
+// predate notifications are seeded from the state before reconciliation, so
+// we don't raise events for everything when first enabled.
+func getState(before, after Resource) (*state, error) {
+	s := &state{}
+
+	if value, ok := after.GetAnnotations()[constants.NotificationStateAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), s); err != nil {
+			return nil, err
+		}
+
+		return s, nil
+	}
+
+	if condition, err := before.StatusConditionRead(coreunikornv1.ConditionAvailable); err == nil && condition.Reason == coreunikornv1.ConditionReasonProvisioned {
+		s.Event = unikornv1.NotificationEventProvisioned
+		s.ApplicationBundle = before.ApplicationBundleName()
+	}
+
+	return s, nil
+}
+
+// transitions updates the state and returns any events raised by the
+// transition into it.
+func transitions(s *state, object Resource) []unikornv1.NotificationEvent {
+	var events []unikornv1.NotificationEvent
+
+	bundle := object.ApplicationBundleName()
+
+	// An upgrade is started when the application bundle differs from the
+	// one that was last provisioned successfully.
+	upgrading := s.ApplicationBundle != "" && s.ApplicationBundle != bundle
+
+	if upgrading && s.Event != unikornv1.NotificationEventUpgradeStarted && s.Event != unikornv1.NotificationEventUpgradeFailed {
+		events = append(events, unikornv1.NotificationEventUpgradeStarted)
+		s.Event = unikornv1.NotificationEventUpgradeStarted
+	}
+
+	condition, err := object.StatusConditionRead(coreunikornv1.ConditionAvailable)
+	if err != nil {
+		return events
+	}
+
+	//nolint:exhaustive
+	switch condition.Reason {
+	case coreunikornv1.ConditionReasonProvisioned:
+		if s.Event != unikornv1.NotificationEventProvisioned {
+			events = append(events, unikornv1.NotificationEventProvisioned)
+			s.Event = unikornv1.NotificationEventProvisioned
+		}
+
+		s.ApplicationBundle = bundle
+	case coreunikornv1.ConditionReasonErrored:
+		event := unikornv1.NotificationEventDegraded
+
+		if upgrading {
+			event = unikornv1.NotificationEventUpgradeFailed
+		}
+
+		if s.Event != event {
+			events = append(events, event)
+			s.Event = event
+		}
+	}
+
+	return events
+}
+
+// notify compares the resource before and after reconciliation and raises
+// any events.
+func (r *Reconciler) notify(ctx context.Context, request reconcile.Request, before Resource) error {
+	after := r.newObject()
+
+	if err := r.reader.Get(ctx, request.NamespacedName, after); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+
+		if before.GetDeletionTimestamp() != nil {
+			r.send(ctx, before, unikornv1.NotificationEventDeleted, "")
+		}
+
+		return nil
+	}
+
+	// Deprovisioning is only of interest once it's complete.
+	if after.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	s, err := getState(before, after)
+	if err != nil {
+		return err
+	}
+
+	var message string
+
+	if condition, err := after.StatusConditionRead(coreunikornv1.ConditionAvailable); err == nil {
+		message = condition.Message
+	}
+
+	for _, event := range transitions(s, after) {
+		r.send(ctx, after, event, message)
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	if after.GetAnnotations()[constants.NotificationStateAnnotation] == string(data) {
+		return nil
+	}
+
+	// Annotations don't affect the generation, so this won't trigger
+	// another reconcile.
+	updated, ok := after.DeepCopyObject().(Resource)
+	if !ok {
+		return ErrResourceType
+	}
+
+	annotations := updated.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[constants.NotificationStateAnnotation] = string(data)
+
+	updated.SetAnnotations(annotations)
+
+	return r.client.Patch(ctx, updated, client.MergeFrom(after))
+}
+
+// send delivers an event to all webhooks that are subscribed to it.
+func (r *Reconciler) send(ctx context.Context, object Resource, event unikornv1.NotificationEvent, message string) {
+	logger := log.FromContext(ctx)
+
+	labels := object.GetLabels()
+
+	project := &unikornv1.Project{}
+
+	if err := r.client.Get(ctx, client.ObjectKey{Name: labels[constants.ProjectLabel]}, project); err != nil {
+		if !kerrors.IsNotFound(err) {
+			logger.Error(err, "failed to get project for notification")
+		}
+
+		return
+	}
+
+	notification := &Notification{
+		Event:             event,
+		Kind:              r.kind,
+		Project:           labels[constants.ProjectLabel],
+		ControlPlane:      labels[constants.ControlPlaneLabel],
+		Cluster:           labels[constants.KubernetesClusterLabel],
+		ApplicationBundle: object.ApplicationBundleName(),
+		Message:           message,
+		Time:              time.Now(),
+	}
+
+	// Control planes aren't labelled with themselves.
+	if r.kind == unikornv1.ControlPlaneKind {
+		notification.ControlPlane = object.GetName()
+	}
+
+	for i := range project.Spec.Notifications {
+		webhook := &project.Spec.Notifications[i]
+
+		if !webhook.Subscribed(event) {
+			continue
+		}
+
+		if err := callWebhook(ctx, webhook, notification); err != nil {
+			logger.Error(err, "notification webhook failed", "event", event)
+
+			continue
+		}
+
+		logger.Info("notification sent", "event", event)
+	}
+}
+
+// callWebhook delivers a notification to a webhook in the format it expects.
+func callWebhook(ctx context.Context, webhook *unikornv1.NotificationWebhookSpec, notification *Notification) error {
+	var payload interface{} = notification
+
+	if webhook.Slack() {
+		payload = &slackMessage{
+			Text: notification.text(),
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("%w: status code %d", ErrWebhookResponse, response.StatusCode)
+	}
+
+	return nil
+}
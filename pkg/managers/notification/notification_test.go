@@ -0,0 +1,334 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/managers/notification"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn-core/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	projectName      = "foo"
+	controlPlaneName = "bar"
+	clusterName      = "baz"
+	namespace        = "unikorn"
+)
+
+// webhook records the bodies that are POSTed to it.
+type webhook struct {
+	lock   sync.Mutex
+	bodies [][]byte
+}
+
+// newWebhook returns a webhook that records what it receives.
+func newWebhook(t *testing.T) (*webhook, string) {
+	t.Helper()
+
+	w := &webhook{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+
+		w.lock.Lock()
+		defer w.lock.Unlock()
+
+		w.bodies = append(w.bodies, body)
+
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return w, server.URL
+}
+
+// events returns the events received by a generic webhook.
+func (w *webhook) events(t *testing.T) []unikornv1.NotificationEvent {
+	t.Helper()
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	events := make([]unikornv1.NotificationEvent, len(w.bodies))
+
+	for i, body := range w.bodies {
+		n := &notification.Notification{}
+
+		if err := json.Unmarshal(body, n); err != nil {
+			t.Fatal(err)
+		}
+
+		if n.Project != projectName || n.ControlPlane != controlPlaneName || n.Cluster != clusterName || n.Kind != unikornv1.KubernetesClusterKind {
+			t.Fatal("unexpected notification", string(body))
+		}
+
+		events[i] = n.Event
+	}
+
+	return events
+}
+
+// newClient returns a fake client that contains a project with the requested
+// webhooks and a cluster.
+func newClient(t *testing.T, webhooks ...unikornv1.NotificationWebhookSpec) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+
+	if err := unikornv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	project := &unikornv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: projectName,
+		},
+		Spec: unikornv1.ProjectSpec{
+			Notifications: webhooks,
+		},
+	}
+
+	cluster := &unikornv1.KubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      clusterName,
+			Labels: map[string]string{
+				constants.ProjectLabel:           projectName,
+				constants.ControlPlaneLabel:      controlPlaneName,
+				constants.KubernetesClusterLabel: clusterName,
+			},
+			Finalizers: []string{
+				constants.Finalizer,
+			},
+		},
+		Spec: unikornv1.KubernetesClusterSpec{
+			ApplicationBundle: util.ToPointer("bundle-1.0.0"),
+		},
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(project, cluster).Build()
+}
+
+// newReconciler returns a notification reconciler whose delegate sets the
+// available condition to the given reason.
+func newReconciler(c client.Client, reason *coreunikornv1.ConditionReason) *notification.Reconciler {
+	delegate := reconcile.Func(func(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+		cluster := &unikornv1.KubernetesCluster{}
+
+		if err := c.Get(ctx, request.NamespacedName, cluster); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if cluster.GetDeletionTimestamp() != nil {
+			cluster.Finalizers = nil
+
+			return reconcile.Result{}, c.Update(ctx, cluster)
+		}
+
+		cluster.StatusConditionWrite(coreunikornv1.ConditionAvailable, corev1.ConditionTrue, *reason, string(*reason))
+
+		return reconcile.Result{}, c.Update(ctx, cluster)
+	})
+
+	newObject := func() notification.Resource {
+		return &unikornv1.KubernetesCluster{}
+	}
+
+	return notification.New(c, c, unikornv1.KubernetesClusterKind, newObject, delegate)
+}
+
+func mustReconcile(t *testing.T, r *notification.Reconciler) {
+	t.Helper()
+
+	request := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: namespace,
+			Name:      clusterName,
+		},
+	}
+
+	if _, err := r.Reconcile(context.Background(), request); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustUpgrade(t *testing.T, c client.Client, bundle string) {
+	t.Helper()
+
+	cluster := &unikornv1.KubernetesCluster{}
+
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: clusterName}, cluster); err != nil {
+		t.Fatal(err)
+	}
+
+	cluster.Spec.ApplicationBundle = &bundle
+
+	if err := c.Update(context.Background(), cluster); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expectEvents(t *testing.T, w *webhook, expected ...unikornv1.NotificationEvent) {
+	t.Helper()
+
+	if events := w.events(t); !slices.Equal(events, expected) {
+		t.Fatal("unexpected events", events, expected)
+	}
+}
+
+// TestLifecycle checks events are raised on transitions only.
+func TestLifecycle(t *testing.T) {
+	t.Parallel()
+
+	w, url := newWebhook(t)
+
+	c := newClient(t, unikornv1.NotificationWebhookSpec{URL: url})
+
+	reason := coreunikornv1.ConditionReasonProvisioning
+
+	r := newReconciler(c, &reason)
+
+	mustReconcile(t, r)
+	expectEvents(t, w)
+
+	reason = coreunikornv1.ConditionReasonProvisioned
+
+	mustReconcile(t, r)
+	mustReconcile(t, r)
+	expectEvents(t, w, unikornv1.NotificationEventProvisioned)
+
+	reason = coreunikornv1.ConditionReasonErrored
+
+	mustReconcile(t, r)
+	mustReconcile(t, r)
+	expectEvents(t, w, unikornv1.NotificationEventProvisioned, unikornv1.NotificationEventDegraded)
+
+	reason = coreunikornv1.ConditionReasonProvisioned
+
+	mustReconcile(t, r)
+	expectEvents(t, w, unikornv1.NotificationEventProvisioned, unikornv1.NotificationEventDegraded, unikornv1.NotificationEventProvisioned)
+}
+
+// TestUpgrade checks upgrades are notified when the application bundle changes.
+func TestUpgrade(t *testing.T) {
+	t.Parallel()
+
+	w, url := newWebhook(t)
+
+	c := newClient(t, unikornv1.NotificationWebhookSpec{URL: url})
+
+	reason := coreunikornv1.ConditionReasonProvisioned
+
+	r := newReconciler(c, &reason)
+
+	mustReconcile(t, r)
+
+	mustUpgrade(t, c, "bundle-2.0.0")
+
+	reason = coreunikornv1.ConditionReasonErrored
+
+	mustReconcile(t, r)
+	mustReconcile(t, r)
+	expectEvents(t, w, unikornv1.NotificationEventProvisioned, unikornv1.NotificationEventUpgradeStarted, unikornv1.NotificationEventUpgradeFailed)
+
+	reason = coreunikornv1.ConditionReasonProvisioned
+
+	mustReconcile(t, r)
+	expectEvents(t, w, unikornv1.NotificationEventProvisioned, unikornv1.NotificationEventUpgradeStarted, unikornv1.NotificationEventUpgradeFailed, unikornv1.NotificationEventProvisioned)
+}
+
+// TestDeleted checks deletion is notified once the resource is gone.
+func TestDeleted(t *testing.T) {
+	t.Parallel()
+
+	w, url := newWebhook(t)
+
+	c := newClient(t, unikornv1.NotificationWebhookSpec{URL: url, Events: []unikornv1.NotificationEvent{unikornv1.NotificationEventDeleted}})
+
+	reason := coreunikornv1.ConditionReasonProvisioned
+
+	r := newReconciler(c, &reason)
+
+	mustReconcile(t, r)
+	expectEvents(t, w)
+
+	cluster := &unikornv1.KubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      clusterName,
+		},
+	}
+
+	if err := c.Delete(context.Background(), cluster); err != nil {
+		t.Fatal(err)
+	}
+
+	mustReconcile(t, r)
+	expectEvents(t, w, unikornv1.NotificationEventDeleted)
+}
+
+// TestSlack checks Slack compatible webhooks receive a message.
+func TestSlack(t *testing.T) {
+	t.Parallel()
+
+	w, url := newWebhook(t)
+
+	c := newClient(t, unikornv1.NotificationWebhookSpec{URL: url, Format: unikornv1.NotificationWebhookFormatSlack})
+
+	reason := coreunikornv1.ConditionReasonProvisioned
+
+	mustReconcile(t, newReconciler(c, &reason))
+
+	if len(w.bodies) != 1 {
+		t.Fatal("expected a notification")
+	}
+
+	message := map[string]string{}
+
+	if err := json.Unmarshal(w.bodies[0], &message); err != nil {
+		t.Fatal(err)
+	}
+
+	if message["text"] != "Project foo cluster bar/baz: Provisioned: Provisioned" {
+		t.Fatal("unexpected message", message["text"])
+	}
+}
@@ -17,8 +17,14 @@ limitations under the License.
 package project
 
 import (
+	"github.com/spf13/pflag"
+
 	unikornscheme "github.com/eschercloudai/unikorn/generated/clientset/unikorn/scheme"
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/logging"
+	"github.com/eschercloudai/unikorn/pkg/managers/conditions"
+	"github.com/eschercloudai/unikorn/pkg/managers/events"
+	"github.com/eschercloudai/unikorn/pkg/managers/propagation"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/managers/project"
 
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
@@ -36,7 +42,10 @@ import (
 )
 
 // Factory provides methods that can build a type specific controller.
-type Factory struct{}
+type Factory struct {
+	// redaction configures removal of sensitive values from logs.
+	redaction logging.Options
+}
 
 var _ coremanager.ControllerFactory = &Factory{}
 
@@ -45,9 +54,39 @@ func (*Factory) Metadata() (string, string, string) {
 	return constants.Application, constants.Version, constants.Revision
 }
 
+// AddFlags registers factory specific flags, these must be added before
+// the manager parses them.
+func (f *Factory) AddFlags(flags *pflag.FlagSet) {
+	f.redaction.AddFlags(flags)
+}
+
 // Reconciler returns a new reconciler instance.
-func (*Factory) Reconciler(options *options.Options, manager manager.Manager) reconcile.Reconciler {
-	return coremanager.NewReconciler(options, manager.GetClient(), project.New)
+func (f *Factory) Reconciler(options *options.Options, manager manager.Manager) reconcile.Reconciler {
+	propagationObject := func() propagation.Resource {
+		return &unikornv1.Project{}
+	}
+
+	conditionObject := func() conditions.Resource {
+		return &unikornv1.Project{}
+	}
+
+	// Classified provisioning errors replace the generic condition set by
+	// the core reconciler.
+	conditioned := conditions.New(manager.GetClient(), manager.GetAPIReader(), conditionObject, coremanager.NewReconciler(options, manager.GetClient(), conditions.NewProvisioner(project.New)))
+
+	// Project labels are propagated to the namespace once it's been provisioned.
+	propagated := propagation.New(manager.GetClient(), propagationObject, conditioned)
+
+	// The logger is configured by the core library, so redaction has to be
+	// applied to anything the reconciler logs.
+	redacted := logging.NewRedactor(&f.redaction).Reconciler(propagated)
+
+	eventObject := func() events.Resource {
+		return &unikornv1.Project{}
+	}
+
+	// Events are emitted from errors that have already been redacted.
+	return events.New(manager.GetAPIReader(), manager.GetEventRecorderFor(constants.Application), eventObject, redacted)
 }
 
 // RegisterWatches adds any watches that would trigger a reconcile.
@@ -0,0 +1,251 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagation
+
+import (
+	"context"
+	"maps"
+	"sort"
+	"strings"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Resource is a resource that belongs to a project.
+type Resource interface {
+	client.Object
+
+	// ResourceLabels identifies the project, and any namespace that is
+	// provisioned for the resource.
+	ResourceLabels() (labels.Set, error)
+}
+
+// Reconciler wraps another reconciler, propagating labels defined by the
+// project to the resource it manages, and to any namespace provisioned for
+// that resource.
+type Reconciler struct {
+	client     client.Client
+	reconciler reconcile.Reconciler
+	newObject  func() Resource
+}
+
+// Ensure the reconcile.Reconciler interface is implemented.
+var _ reconcile.Reconciler = &Reconciler{}
+
+// New wraps a reconciler with label propagation.
+func New(client client.Client, newObject func() Resource, delegate reconcile.Reconciler) *Reconciler {
+	return &Reconciler{
+		client:     client,
+		reconciler: delegate,
+		newObject:  newObject,
+	}
+}
+
+// propagatedKeys returns the set of label keys that were previously propagated.
+func propagatedKeys(object metav1.Object) map[string]bool {
+	result := map[string]bool{}
+
+	value, ok := object.GetAnnotations()[constants.PropagatedLabelsAnnotation]
+	if !ok || value == "" {
+		return result
+	}
+
+	for _, key := range strings.Split(value, ",") {
+		result[key] = true
+	}
+
+	return result
+}
+
+// Apply updates the object's labels to match those propagated from the project,
+// returning true if the object was modified.  Previously propagated labels are
+// updated or removed as the project changes.  Other existing labels are only
+// replaced if the project's conflict policy allows it, at which point they are
+// considered propagated.
+func Apply(object metav1.Object, project *unikornv1.Project) bool {
+	desired := project.PropagatedLabels()
+	policy := project.LabelConflictPolicy()
+	previous := propagatedKeys(object)
+
+	objectLabels := maps.Clone(object.GetLabels())
+	if objectLabels == nil {
+		objectLabels = map[string]string{}
+	}
+
+	for key := range previous {
+		if _, ok := desired[key]; !ok {
+			delete(objectLabels, key)
+		}
+	}
+
+	propagated := make([]string, 0, len(desired))
+
+	for key, value := range desired {
+		if _, ok := objectLabels[key]; ok && !previous[key] && policy == unikornv1.LabelConflictPolicyPreserve {
+			continue
+		}
+
+		objectLabels[key] = value
+
+		propagated = append(propagated, key)
+	}
+
+	sort.Strings(propagated)
+
+	annotations := maps.Clone(object.GetAnnotations())
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	if len(propagated) == 0 {
+		delete(annotations, constants.PropagatedLabelsAnnotation)
+	} else {
+		annotations[constants.PropagatedLabelsAnnotation] = strings.Join(propagated, ",")
+	}
+
+	if maps.Equal(objectLabels, object.GetLabels()) && maps.Equal(annotations, object.GetAnnotations()) {
+		return false
+	}
+
+	object.SetLabels(objectLabels)
+	object.SetAnnotations(annotations)
+
+	return true
+}
+
+// patch applies propagated labels to the object, if required.
+func (r *Reconciler) patch(ctx context.Context, object client.Object, project *unikornv1.Project) error {
+	original, ok := object.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil
+	}
+
+	if !Apply(object, project) {
+		return nil
+	}
+
+	return r.client.Patch(ctx, object, client.MergeFrom(original))
+}
+
+// propagate applies propagated labels to the resource and its namespace.
+func (r *Reconciler) propagate(ctx context.Context, request reconcile.Request) error {
+	object := r.newObject()
+
+	if err := r.client.Get(ctx, request.NamespacedName, object); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	// Don't bother with anything that's going away.
+	if object.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	resourceLabels, err := object.ResourceLabels()
+	if err != nil {
+		return err
+	}
+
+	project := &unikornv1.Project{}
+
+	if err := r.client.Get(ctx, client.ObjectKey{Name: resourceLabels[constants.ProjectLabel]}, project); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	// The project is the source of truth, so leave it alone.
+	if _, ok := object.(*unikornv1.Project); !ok {
+		if err := r.patch(ctx, object, project); err != nil {
+			return err
+		}
+	}
+
+	// Not all resources have their own namespace e.g. clusters.
+	namespaces := &corev1.NamespaceList{}
+
+	if err := r.client.List(ctx, namespaces, client.MatchingLabels(resourceLabels)); err != nil {
+		return err
+	}
+
+	for i := range namespaces.Items {
+		if err := r.patch(ctx, &namespaces.Items[i], project); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reconcile implements the reconcile.Reconciler interface.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	result, err := r.reconciler.Reconcile(ctx, request)
+	if err != nil {
+		return result, err
+	}
+
+	if err := r.propagate(ctx, request); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return result, nil
+}
+
+// ProjectMapFunc returns a function that maps a project to requests for all the
+// resources that belong to it, so they are reconciled when its labels change.
+func ProjectMapFunc(c client.Client, newList func() client.ObjectList) handler.MapFunc {
+	return func(ctx context.Context, object client.Object) []reconcile.Request {
+		list := newList()
+
+		if err := c.List(ctx, list, client.MatchingLabels{constants.ProjectLabel: object.GetName()}); err != nil {
+			log.FromContext(ctx).Error(err, "failed to list project resources")
+
+			return nil
+		}
+
+		var requests []reconcile.Request
+
+		// The callback never fails as the items are always client.Objects.
+		_ = meta.EachListItem(list, func(item runtime.Object) error {
+			if o, ok := item.(client.Object); ok {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+			}
+
+			return nil
+		})
+
+		return requests
+	}
+}
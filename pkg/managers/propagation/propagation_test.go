@@ -0,0 +1,184 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propagation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/managers/propagation"
+
+	"github.com/eschercloudai/unikorn-core/pkg/util"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	projectName      = "foo"
+	controlPlaneName = "bar"
+	namespaceName    = "controlplane-baz"
+)
+
+// newProject returns a project that propagates the given labels.
+func newProject(labels map[string]string, policy unikornv1.LabelConflictPolicy) *unikornv1.Project {
+	return &unikornv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: projectName,
+		},
+		Spec: unikornv1.ProjectSpec{
+			LabelPropagation: &unikornv1.LabelPropagationSpec{
+				Labels:         labels,
+				ConflictPolicy: util.ToPointer(policy),
+			},
+		},
+	}
+}
+
+// TestApply tests labels are propagated, and reserved labels are ignored.
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	project := newProject(map[string]string{"cost-centre": "42", constants.ProjectLabel: "evil"}, unikornv1.LabelConflictPolicyPreserve)
+
+	object := &metav1.ObjectMeta{
+		Labels: map[string]string{
+			constants.ProjectLabel: projectName,
+		},
+	}
+
+	assert.True(t, propagation.Apply(object, project))
+	assert.Equal(t, map[string]string{"cost-centre": "42", constants.ProjectLabel: projectName}, object.Labels)
+	assert.Equal(t, "cost-centre", object.Annotations[constants.PropagatedLabelsAnnotation])
+
+	// Nothing to do second time around.
+	assert.False(t, propagation.Apply(object, project))
+}
+
+// TestApplyUpdate tests propagated labels are updated and removed when the
+// project changes.
+func TestApplyUpdate(t *testing.T) {
+	t.Parallel()
+
+	object := &metav1.ObjectMeta{}
+
+	assert.True(t, propagation.Apply(object, newProject(map[string]string{"cost-centre": "42", "environment": "prod"}, unikornv1.LabelConflictPolicyPreserve)))
+	assert.Equal(t, "cost-centre,environment", object.Annotations[constants.PropagatedLabelsAnnotation])
+
+	assert.True(t, propagation.Apply(object, newProject(map[string]string{"cost-centre": "43"}, unikornv1.LabelConflictPolicyPreserve)))
+	assert.Equal(t, map[string]string{"cost-centre": "43"}, object.Labels)
+	assert.Equal(t, "cost-centre", object.Annotations[constants.PropagatedLabelsAnnotation])
+
+	assert.True(t, propagation.Apply(object, newProject(nil, unikornv1.LabelConflictPolicyPreserve)))
+	assert.Empty(t, object.Labels)
+	assert.NotContains(t, object.Annotations, constants.PropagatedLabelsAnnotation)
+}
+
+// TestApplyConflict tests existing labels are only replaced when the conflict
+// policy allows it.
+func TestApplyConflict(t *testing.T) {
+	t.Parallel()
+
+	labels := map[string]string{"environment": "prod"}
+
+	preserved := &metav1.ObjectMeta{
+		Labels: map[string]string{"environment": "dev"},
+	}
+
+	assert.False(t, propagation.Apply(preserved, newProject(labels, unikornv1.LabelConflictPolicyPreserve)))
+	assert.Equal(t, "dev", preserved.Labels["environment"])
+
+	overwritten := &metav1.ObjectMeta{
+		Labels: map[string]string{"environment": "dev"},
+	}
+
+	assert.True(t, propagation.Apply(overwritten, newProject(labels, unikornv1.LabelConflictPolicyOverwrite)))
+	assert.Equal(t, "prod", overwritten.Labels["environment"])
+	assert.Equal(t, "environment", overwritten.Annotations[constants.PropagatedLabelsAnnotation])
+}
+
+// TestReconcile tests labels are propagated to a control plane and its namespace
+// after the delegate reconciler has run.
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+
+	assert.NoError(t, unikornv1.AddToScheme(scheme))
+	assert.NoError(t, corev1.AddToScheme(scheme))
+
+	controlPlane := &unikornv1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "project-foo",
+			Name:      controlPlaneName,
+			Labels: map[string]string{
+				constants.ProjectLabel: projectName,
+			},
+		},
+	}
+
+	resourceLabels, err := controlPlane.ResourceLabels()
+	assert.NoError(t, err)
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   namespaceName,
+			Labels: resourceLabels,
+		},
+	}
+
+	project := newProject(map[string]string{"cost-centre": "42"}, unikornv1.LabelConflictPolicyPreserve)
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(project, controlPlane, namespace).Build()
+
+	newObject := func() propagation.Resource {
+		return &unikornv1.ControlPlane{}
+	}
+
+	delegate := reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+		return reconcile.Result{}, nil
+	})
+
+	request := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: controlPlane.Namespace, Name: controlPlaneName},
+	}
+
+	_, err = propagation.New(c, newObject, delegate).Reconcile(context.TODO(), request)
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Get(context.TODO(), request.NamespacedName, controlPlane))
+	assert.Equal(t, "42", controlPlane.Labels["cost-centre"])
+	assert.Equal(t, projectName, controlPlane.Labels[constants.ProjectLabel])
+
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKey{Name: namespaceName}, namespace))
+	assert.Equal(t, "42", namespace.Labels["cost-centre"])
+	assert.Equal(t, constants.KindLabelValueControlPlane, namespace.Labels[constants.KindLabel])
+
+	// Requests are generated for the project's control planes.
+	requests := propagation.ProjectMapFunc(c, func() client.ObjectList { return &unikornv1.ControlPlaneList{} })(context.TODO(), project)
+	assert.Equal(t, []reconcile.Request{request}, requests)
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/providers/openstack"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/clusteropenstack"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/vcluster"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var (
+	// ErrCACert is raised when the cluster's CA certificate cannot be parsed.
+	ErrCACert = errors.New("unable to parse CA certificate")
+)
+
+const (
+	// providerIDPrefix is prepended to the server ID by the OpenStack
+	// cluster API provider to form a machine's provider ID.
+	providerIDPrefix = "openstack:///"
+
+	// serverStatusDeleted is reported by Nova for servers that have been
+	// deleted, but are still visible.
+	serverStatusDeleted = "DELETED"
+)
+
+// Options allow the verifier to be configured by the operator.
+type Options struct {
+	// Period defines how often clusters are verified.
+	Period time.Duration
+
+	// GracePeriod is how long a server may exist before it's expected to
+	// have a machine, as there is a window between the server being created
+	// and cluster API recording it.
+	GracePeriod time.Duration
+
+	// DeleteStrays deletes any servers that have no machine.
+	DeleteStrays bool
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.DurationVar(&o.Period, "machine-verification-period", 10*time.Minute, "How often to cross-check OpenStack servers against cluster API machines, 0 disables verification.")
+	f.DurationVar(&o.GracePeriod, "machine-verification-grace-period", 15*time.Minute, "How long a server may exist without a cluster API machine before it's considered stray.")
+	f.BoolVar(&o.DeleteStrays, "machine-verification-delete-strays", false, "Delete stray servers that have no corresponding cluster API machine.")
+}
+
+// Machine is a cluster API machine.
+type Machine struct {
+	// Name is the machine name.
+	Name string
+
+	// ServerID is the OpenStack server ID, this is empty until the server
+	// has been created.
+	ServerID string
+}
+
+// Server is an OpenStack server.
+type Server struct {
+	// ID is the server ID.
+	ID string
+
+	// Name is the server name, which matches the machine's.
+	Name string
+
+	// Created is when the server was created.
+	Created time.Time
+}
+
+// compareDiscrepancies orders discrepancies so status updates are stable.
+func compareDiscrepancies(a, b unikornv1.MachineDiscrepancy) int {
+	if v := cmp.Compare(a.Kind, b.Kind); v != 0 {
+		return v
+	}
+
+	if v := cmp.Compare(a.Machine, b.Machine); v != 0 {
+		return v
+	}
+
+	return cmp.Compare(a.ServerID, b.ServerID)
+}
+
+// Compare returns any discrepancies between a cluster's machines and servers.
+// Servers are matched by ID, or by name where the machine has yet to record
+// its server.  Servers younger than the grace period are ignored, as they may
+// have been created but not yet recorded.
+func Compare(machines []Machine, servers []Server, now time.Time, gracePeriod time.Duration) []unikornv1.MachineDiscrepancy {
+	serverIDs := map[string]bool{}
+
+	for _, server := range servers {
+		serverIDs[server.ID] = true
+	}
+
+	machineServerIDs := map[string]bool{}
+	machineNames := map[string]bool{}
+
+	var discrepancies []unikornv1.MachineDiscrepancy
+
+	for _, machine := range machines {
+		machineNames[machine.Name] = true
+
+		if machine.ServerID == "" {
+			continue
+		}
+
+		machineServerIDs[machine.ServerID] = true
+
+		if !serverIDs[machine.ServerID] {
+			discrepancies = append(discrepancies, unikornv1.MachineDiscrepancy{
+				Kind:     unikornv1.MachineDiscrepancyKindMissingServer,
+				Machine:  machine.Name,
+				ServerID: machine.ServerID,
+			})
+		}
+	}
+
+	for _, server := range servers {
+		if machineServerIDs[server.ID] || machineNames[server.Name] {
+			continue
+		}
+
+		if now.Sub(server.Created) < gracePeriod {
+			continue
+		}
+
+		discrepancies = append(discrepancies, unikornv1.MachineDiscrepancy{
+			Kind:       unikornv1.MachineDiscrepancyKindStrayServer,
+			ServerID:   server.ID,
+			ServerName: server.Name,
+		})
+	}
+
+	slices.SortFunc(discrepancies, compareDiscrepancies)
+
+	return discrepancies
+}
+
+// Verifier periodically cross-checks OpenStack servers against cluster API
+// machines, as after scaling events they have been known to disagree.
+type Verifier struct {
+	client  client.Client
+	options *Options
+}
+
+// Ensure the manager interfaces are implemented.
+var _ manager.Runnable = &Verifier{}
+var _ manager.LeaderElectionRunnable = &Verifier{}
+
+// New returns a new verifier.
+func New(client client.Client, options *Options) *Verifier {
+	return &Verifier{
+		client:  client,
+		options: options,
+	}
+}
+
+// NeedLeaderElection implements the manager.LeaderElectionRunnable interface,
+// only the leader may modify clusters, or delete servers.
+func (v *Verifier) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements the manager.Runnable interface.
+func (v *Verifier) Start(ctx context.Context) error {
+	if v.options.Period == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(v.options.Period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := v.verifyAll(ctx); err != nil {
+				log.FromContext(ctx).Error(err, "machine verification failed")
+			}
+		}
+	}
+}
+
+// verifyAll verifies every provisioned cluster, failure to verify one cluster
+// does not prevent the others being verified.
+func (v *Verifier) verifyAll(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	clusters := &unikornv1.KubernetesClusterList{}
+
+	if err := v.client.List(ctx, clusters); err != nil {
+		return err
+	}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+
+		// Clusters that are being provisioned are expected to be
+		// inconsistent while machines come and go.
+		if cluster.DeletionTimestamp != nil || !provisioned(cluster) {
+			continue
+		}
+
+		if err := v.verify(ctx, cluster); err != nil {
+			log.Error(err, "cluster machine verification failed", "namespace", cluster.Namespace, "name", cluster.Name)
+		}
+	}
+
+	return nil
+}
+
+// provisioned returns true if the cluster was last provisioned successfully.
+func provisioned(cluster *unikornv1.KubernetesCluster) bool {
+	condition, err := cluster.StatusConditionRead(coreunikornv1.ConditionAvailable)
+	if err != nil {
+		return false
+	}
+
+	return condition.Reason == coreunikornv1.ConditionReasonProvisioned
+}
+
+// getMachines returns the cluster's machines from the control plane.
+func (v *Verifier) getMachines(ctx context.Context, cluster *unikornv1.KubernetesCluster) ([]Machine, error) {
+	// The vcluster configuration is read with whatever client is in the context.
+	ctx = coreclient.NewContextWithDynamicClient(ctx, v.client)
+
+	c, err := vcluster.NewControllerRuntimeClient().Client(ctx, cluster.Namespace, false)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := &unstructured.UnstructuredList{
+		Object: map[string]interface{}{
+			"apiVersion": "cluster.x-k8s.io/v1beta1",
+			"kind":       "Machine",
+		},
+	}
+
+	options := []client.ListOption{
+		client.InNamespace(cluster.Name),
+		client.MatchingLabels{
+			"cluster.x-k8s.io/cluster-name": clusteropenstack.CAPIClusterName(cluster),
+		},
+	}
+
+	if err := c.List(ctx, objects, options...); err != nil {
+		return nil, err
+	}
+
+	machines := make([]Machine, 0, len(objects.Items))
+
+	for _, object := range objects.Items {
+		// Machines being deleted may have already had their server removed.
+		if object.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		providerID, _, _ := unstructured.NestedString(object.Object, "spec", "providerID")
+
+		machines = append(machines, Machine{
+			Name:     object.GetName(),
+			ServerID: strings.TrimPrefix(providerID, providerIDPrefix),
+		})
+	}
+
+	return machines, nil
+}
+
+// getComputeClient returns a compute client using the cluster's credentials.
+func getComputeClient(cluster *unikornv1.KubernetesCluster) (*openstack.ComputeClient, error) {
+	provider, err := openstack.NewCloudConfigProvider(*cluster.Spec.Openstack.CloudConfig, *cluster.Spec.Openstack.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.Spec.Openstack.CACert != nil {
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(*cluster.Spec.Openstack.CACert) {
+			return nil, ErrCACert
+		}
+
+		//nolint:forcetypeassert
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+
+		provider.WithTransport(transport)
+	}
+
+	return openstack.NewComputeClient(&openstack.ComputeOptions{}, provider)
+}
+
+// getServers returns the cluster's servers, these are named after the machines
+// which are in turn prefixed with the cluster API cluster name.
+func getServers(ctx context.Context, compute *openstack.ComputeClient, cluster *unikornv1.KubernetesCluster) ([]Server, error) {
+	result, err := compute.ListServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := clusteropenstack.CAPIClusterName(cluster) + "-"
+
+	var servers []Server
+
+	for _, server := range result {
+		if !strings.HasPrefix(server.Name, prefix) || server.Status == serverStatusDeleted {
+			continue
+		}
+
+		servers = append(servers, Server{
+			ID:      server.ID,
+			Name:    server.Name,
+			Created: server.Created,
+		})
+	}
+
+	return servers, nil
+}
+
+// deleteStrays deletes any stray servers, returning the discrepancies that remain.
+func deleteStrays(ctx context.Context, compute *openstack.ComputeClient, discrepancies []unikornv1.MachineDiscrepancy) []unikornv1.MachineDiscrepancy {
+	log := log.FromContext(ctx)
+
+	var remaining []unikornv1.MachineDiscrepancy
+
+	for _, discrepancy := range discrepancies {
+		if discrepancy.Kind == unikornv1.MachineDiscrepancyKindStrayServer {
+			if err := compute.DeleteServer(ctx, discrepancy.ServerID); err != nil {
+				log.Error(err, "failed to delete stray server", "id", discrepancy.ServerID, "name", discrepancy.ServerName)
+			} else {
+				log.Info("deleted stray server", "id", discrepancy.ServerID, "name", discrepancy.ServerName)
+
+				continue
+			}
+		}
+
+		remaining = append(remaining, discrepancy)
+	}
+
+	return remaining
+}
+
+// verify cross-checks a single cluster, and records the result.
+func (v *Verifier) verify(ctx context.Context, cluster *unikornv1.KubernetesCluster) error {
+	machines, err := v.getMachines(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	compute, err := getComputeClient(cluster)
+	if err != nil {
+		return err
+	}
+
+	servers, err := getServers(ctx, compute, cluster)
+	if err != nil {
+		return err
+	}
+
+	discrepancies := Compare(machines, servers, time.Now(), v.options.GracePeriod)
+
+	if v.options.DeleteStrays {
+		discrepancies = deleteStrays(ctx, compute, discrepancies)
+	}
+
+	return v.setStatus(ctx, cluster, discrepancies)
+}
+
+// setStatus records the verification result, this is only written when something
+// has changed to avoid needless API traffic.
+func (v *Verifier) setStatus(ctx context.Context, cluster *unikornv1.KubernetesCluster, discrepancies []unikornv1.MachineDiscrepancy) error {
+	if status := cluster.Status.MachineVerification; status != nil && equality.Semantic.DeepEqual(status.Discrepancies, discrepancies) {
+		return nil
+	}
+
+	// There's nothing to report for clusters that have never been degraded.
+	if cluster.Status.MachineVerification == nil && len(discrepancies) == 0 {
+		return nil
+	}
+
+	if len(discrepancies) != 0 {
+		log.FromContext(ctx).Info("cluster machines degraded", "namespace", cluster.Namespace, "name", cluster.Name, "discrepancies", discrepancies)
+	}
+
+	original := cluster.DeepCopy()
+
+	cluster.Status.MachineVerification = &unikornv1.MachineVerificationStatus{
+		Degraded:           len(discrepancies) != 0,
+		LastTransitionTime: metav1.Now(),
+		Discrepancies:      discrepancies,
+	}
+
+	return v.client.Status().Patch(ctx, cluster, client.MergeFrom(original))
+}
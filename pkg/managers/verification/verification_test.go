@@ -0,0 +1,130 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/managers/verification"
+)
+
+const (
+	gracePeriod = 15 * time.Minute
+)
+
+// TestCompare checks machines and servers are cross-checked correctly.
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		machines      []verification.Machine
+		servers       []verification.Server
+		discrepancies []unikornv1.MachineDiscrepancy
+	}{
+		{
+			name: "Consistent",
+			machines: []verification.Machine{
+				{Name: "cluster-a-pool-1", ServerID: "1"},
+				{Name: "cluster-a-pool-2", ServerID: "2"},
+			},
+			servers: []verification.Server{
+				{ID: "1", Name: "cluster-a-pool-1", Created: old},
+				{ID: "2", Name: "cluster-a-pool-2", Created: old},
+			},
+		},
+		{
+			name: "PendingProviderID",
+			machines: []verification.Machine{
+				{Name: "cluster-a-pool-1"},
+			},
+			servers: []verification.Server{
+				{ID: "1", Name: "cluster-a-pool-1", Created: old},
+			},
+		},
+		{
+			name: "MissingServer",
+			machines: []verification.Machine{
+				{Name: "cluster-a-pool-1", ServerID: "1"},
+				{Name: "cluster-a-pool-2", ServerID: "2"},
+			},
+			servers: []verification.Server{
+				{ID: "1", Name: "cluster-a-pool-1", Created: old},
+			},
+			discrepancies: []unikornv1.MachineDiscrepancy{
+				{Kind: unikornv1.MachineDiscrepancyKindMissingServer, Machine: "cluster-a-pool-2", ServerID: "2"},
+			},
+		},
+		{
+			name: "StrayServer",
+			machines: []verification.Machine{
+				{Name: "cluster-a-pool-1", ServerID: "1"},
+			},
+			servers: []verification.Server{
+				{ID: "1", Name: "cluster-a-pool-1", Created: old},
+				{ID: "2", Name: "cluster-a-pool-2", Created: old},
+			},
+			discrepancies: []unikornv1.MachineDiscrepancy{
+				{Kind: unikornv1.MachineDiscrepancyKindStrayServer, ServerID: "2", ServerName: "cluster-a-pool-2"},
+			},
+		},
+		{
+			name: "StrayServerGracePeriod",
+			machines: []verification.Machine{
+				{Name: "cluster-a-pool-1", ServerID: "1"},
+			},
+			servers: []verification.Server{
+				{ID: "1", Name: "cluster-a-pool-1", Created: old},
+				{ID: "2", Name: "cluster-a-pool-2", Created: now.Add(-time.Minute)},
+			},
+		},
+		{
+			name: "Multiple",
+			machines: []verification.Machine{
+				{Name: "cluster-a-pool-2", ServerID: "2"},
+				{Name: "cluster-a-pool-1", ServerID: "1"},
+			},
+			servers: []verification.Server{
+				{ID: "4", Name: "cluster-a-pool-4", Created: old},
+				{ID: "3", Name: "cluster-a-pool-3", Created: old},
+			},
+			discrepancies: []unikornv1.MachineDiscrepancy{
+				{Kind: unikornv1.MachineDiscrepancyKindMissingServer, Machine: "cluster-a-pool-1", ServerID: "1"},
+				{Kind: unikornv1.MachineDiscrepancyKindMissingServer, Machine: "cluster-a-pool-2", ServerID: "2"},
+				{Kind: unikornv1.MachineDiscrepancyKindStrayServer, ServerID: "3", ServerName: "cluster-a-pool-3"},
+				{Kind: unikornv1.MachineDiscrepancyKindStrayServer, ServerID: "4", ServerName: "cluster-a-pool-4"},
+			},
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.discrepancies, verification.Compare(test.machines, test.servers, now, gracePeriod))
+		})
+	}
+}
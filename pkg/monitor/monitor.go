@@ -18,12 +18,15 @@ package monitor
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/spf13/pflag"
 
+	"github.com/eschercloudai/unikorn/pkg/monitor/shard"
 	upgradecluster "github.com/eschercloudai/unikorn/pkg/monitor/upgrade/cluster"
 	upgradecontrolplane "github.com/eschercloudai/unikorn/pkg/monitor/upgrade/controlplane"
+	"github.com/eschercloudai/unikorn/pkg/monitor/upgrade/util"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -35,11 +38,20 @@ type Options struct {
 	// run with high frequency, reads are all cached.  It's mostly down to
 	// burning CPU unnecessarily.
 	pollPeriod time.Duration
+
+	// freeze is a platform-wide upgrade freeze.
+	freeze util.Freeze
+
+	// shard allows work to be shared between replicas.
+	shard shard.Options
 }
 
 // AddFlags registers option flags with pflag.
 func (o *Options) AddFlags(flags *pflag.FlagSet) {
 	flags.DurationVar(&o.pollPeriod, "poll-period", time.Minute, "Period to poll for updates")
+
+	o.freeze.AddFlags(flags)
+	o.shard.AddFlags(flags)
 }
 
 // Checker is an interface that monitors must implement.
@@ -48,23 +60,83 @@ type Checker interface {
 	Check(context.Context) error
 }
 
+// Simulator is an interface that monitors must implement in order to preview
+// what they would do.
+type Simulator interface {
+	// Simulate returns what would happen, without modifying anything.
+	Simulate(context.Context) ([]*util.Plan, error)
+}
+
+// comparePlans orders plans by when they will happen, those that will never
+// happen go last.
+func comparePlans(a, b *util.Plan) int {
+	switch {
+	case a.Start == nil && b.Start == nil:
+		return 0
+	case a.Start == nil:
+		return 1
+	case b.Start == nil:
+		return -1
+	}
+
+	return a.Start.Compare(*b.Start)
+}
+
+// Simulate runs the upgrade scheduling algorithms against the current state, without
+// modifying anything, and returns the upgrade calendar.
+func Simulate(ctx context.Context, c client.Client, freeze *util.Freeze) ([]*util.Plan, error) {
+	simulators := []Simulator{
+		upgradecluster.New(c, freeze),
+		upgradecontrolplane.New(c, freeze),
+	}
+
+	var plans []*util.Plan
+
+	for _, simulator := range simulators {
+		result, err := simulator.Simulate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		plans = append(plans, result...)
+	}
+
+	slices.SortStableFunc(plans, comparePlans)
+
+	return plans, nil
+}
+
 // Run sits in an infinite loop, polling every so often.
-func Run(ctx context.Context, c client.Client, o *Options) {
+func Run(ctx context.Context, c client.Client, o *Options) error {
 	log := log.FromContext(ctx)
 
+	sharder := shard.New(c, &o.shard)
+
+	if err := sharder.Validate(); err != nil {
+		return err
+	}
+
 	ticker := time.NewTicker(o.pollPeriod)
 	defer ticker.Stop()
 
 	checkers := []Checker{
-		upgradecluster.New(c),
-		upgradecontrolplane.New(c),
+		upgradecluster.New(c, &o.freeze).WithSharder(sharder),
+		upgradecontrolplane.New(c, &o.freeze).WithSharder(sharder),
 	}
 
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return nil
 		case <-ticker.C:
+			// Without a current view of membership, we cannot safely
+			// decide what we own.
+			if err := sharder.Heartbeat(ctx); err != nil {
+				log.Error(err, "shard heartbeat failed")
+
+				continue
+			}
+
 			for _, checker := range checkers {
 				if err := checker.Check(ctx); err != nil {
 					log.Error(err, "check failed")
@@ -0,0 +1,287 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/monitor"
+	"github.com/eschercloudai/unikorn/pkg/monitor/upgrade/util"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newBundles(kind string) []client.Object {
+	oldVersion := "1.0.0"
+	newVersion := "2.0.0"
+
+	oldMeta := metav1.ObjectMeta{Name: kind + "-" + oldVersion}
+	newMeta := metav1.ObjectMeta{Name: kind + "-" + newVersion}
+
+	if kind == "control-plane" {
+		return []client.Object{
+			&unikornv1.ControlPlaneApplicationBundle{ObjectMeta: oldMeta, Spec: unikornv1.ApplicationBundleSpec{Version: &oldVersion}},
+			&unikornv1.ControlPlaneApplicationBundle{ObjectMeta: newMeta, Spec: unikornv1.ApplicationBundleSpec{Version: &newVersion}},
+		}
+	}
+
+	return []client.Object{
+		&unikornv1.KubernetesClusterApplicationBundle{ObjectMeta: oldMeta, Spec: unikornv1.KubernetesClusterApplicationBundleSpec{ApplicationBundleSpec: unikornv1.ApplicationBundleSpec{Version: &oldVersion}}},
+		&unikornv1.KubernetesClusterApplicationBundle{ObjectMeta: newMeta, Spec: unikornv1.KubernetesClusterApplicationBundleSpec{ApplicationBundleSpec: unikornv1.ApplicationBundleSpec{Version: &newVersion}}},
+	}
+}
+
+// TestSimulate tests the upgrade calendar is generated correctly, and that
+// nothing is modified while doing so.
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+
+	if err := unikornv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	controlPlaneBundle := "control-plane-1.0.0"
+	clusterBundle := "kubernetes-cluster-1.0.0"
+
+	controlPlane := &unikornv1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "project-foo",
+			Name:      "bar",
+			Labels: map[string]string{
+				constants.ProjectLabel: "foo",
+			},
+		},
+		Spec: unikornv1.ControlPlaneSpec{
+			ApplicationBundle: &controlPlaneBundle,
+		},
+	}
+
+	cluster := &unikornv1.KubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "control-plane-bar",
+			Name:      "baz",
+			UID:       "0b4d5f9b-1e07-4b5d-9e63-6c1d2b4f5a8e",
+			Labels: map[string]string{
+				constants.ProjectLabel:      "foo",
+				constants.ControlPlaneLabel: "bar",
+			},
+		},
+		Spec: unikornv1.KubernetesClusterSpec{
+			ApplicationBundle:            &clusterBundle,
+			ApplicationBundleAutoUpgrade: &unikornv1.ApplicationBundleAutoUpgradeSpec{},
+		},
+	}
+
+	objects := []client.Object{controlPlane, cluster}
+	objects = append(objects, newBundles("control-plane")...)
+	objects = append(objects, newBundles("kubernetes-cluster")...)
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(controlPlane, cluster).Build()
+
+	plans, err := monitor.Simulate(context.Background(), c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plans) != 2 {
+		t.Fatal("expected two plans", len(plans))
+	}
+
+	// Scheduled plans are ordered first.
+	if plans[0].Kind != unikornv1.KubernetesClusterKind || plans[0].Cluster != "baz" || plans[0].ControlPlane != "bar" || plans[0].Project != "foo" {
+		t.Fatal("unexpected cluster plan", plans[0])
+	}
+
+	if plans[0].Action == util.ActionNone || plans[0].To != "kubernetes-cluster-2.0.0" || plans[0].Start == nil {
+		t.Fatal("cluster upgrade not planned", plans[0])
+	}
+
+	if plans[0].Start.Sub(time.Now()) > 7*24*time.Hour {
+		t.Fatal("cluster upgrade not planned within a week", plans[0].Start)
+	}
+
+	if plans[1].Kind != unikornv1.ControlPlaneKind || plans[1].Action != util.ActionNone || plans[1].Start != nil {
+		t.Fatal("control plane upgrade unexpectedly planned", plans[1])
+	}
+
+	// Simulations must not modify anything.
+	result := &unikornv1.KubernetesCluster{}
+
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(cluster), result); err != nil {
+		t.Fatal(err)
+	}
+
+	if result.ResourceVersion != cluster.ResourceVersion || result.Status.Upgrade != nil {
+		t.Fatal("cluster was modified")
+	}
+}
+
+// TestSimulateChannels tests resources are only upgraded to bundles in the
+// channel they subscribe to, and never downgraded.
+func TestSimulateChannels(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+
+	if err := unikornv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	stableVersion := "1.0.0"
+	rapidVersion := "2.0.0"
+	stableBundle := "kubernetes-cluster-" + stableVersion
+	rapidBundle := "kubernetes-cluster-" + rapidVersion
+	rapid := unikornv1.ApplicationBundleChannelRapid
+
+	newCluster := func(name, bundle string, channel *unikornv1.ApplicationBundleChannel) *unikornv1.KubernetesCluster {
+		return &unikornv1.KubernetesCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "control-plane-bar",
+				Name:      name,
+				Labels: map[string]string{
+					constants.ProjectLabel:      "foo",
+					constants.ControlPlaneLabel: "bar",
+				},
+			},
+			Spec: unikornv1.KubernetesClusterSpec{
+				ApplicationBundle: &bundle,
+				ApplicationBundleAutoUpgrade: &unikornv1.ApplicationBundleAutoUpgradeSpec{
+					Channel: channel,
+				},
+			},
+		}
+	}
+
+	objects := []client.Object{
+		&unikornv1.KubernetesClusterApplicationBundle{ObjectMeta: metav1.ObjectMeta{Name: stableBundle}, Spec: unikornv1.KubernetesClusterApplicationBundleSpec{ApplicationBundleSpec: unikornv1.ApplicationBundleSpec{Version: &stableVersion}}},
+		&unikornv1.KubernetesClusterApplicationBundle{ObjectMeta: metav1.ObjectMeta{Name: rapidBundle}, Spec: unikornv1.KubernetesClusterApplicationBundleSpec{ApplicationBundleSpec: unikornv1.ApplicationBundleSpec{Version: &rapidVersion, Channel: &rapid}}},
+		newCluster("stable", stableBundle, nil),
+		newCluster("rapid", stableBundle, &rapid),
+		newCluster("downgrade", rapidBundle, nil),
+	}
+
+	objects = append(objects, newBundles("control-plane")...)
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+	plans, err := monitor.Simulate(context.Background(), c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plans) != 3 {
+		t.Fatal("expected three plans", len(plans))
+	}
+
+	// Scheduled plans are ordered first.
+	if plans[0].Cluster != "rapid" || plans[0].Channel != "rapid" || plans[0].Action == util.ActionNone || plans[0].To != rapidBundle {
+		t.Fatal("rapid cluster upgrade not planned", plans[0])
+	}
+
+	for _, plan := range plans[1:] {
+		if plan.Channel != "stable" || plan.Action != util.ActionNone {
+			t.Fatal("stable cluster upgrade unexpectedly planned", plan)
+		}
+	}
+}
+
+// TestSimulateFreeze tests upgrade freezes inhibit upgrades until they expire.
+func TestSimulateFreeze(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+
+	if err := unikornv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	clusterBundle := "kubernetes-cluster-1.0.0"
+
+	newCluster := func(name string, freeze *unikornv1.UpgradeFreezeSpec) *unikornv1.KubernetesCluster {
+		return &unikornv1.KubernetesCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "control-plane-bar",
+				Name:      name,
+				Labels: map[string]string{
+					constants.ProjectLabel:      "foo",
+					constants.ControlPlaneLabel: "bar",
+				},
+			},
+			Spec: unikornv1.KubernetesClusterSpec{
+				ApplicationBundle:            &clusterBundle,
+				ApplicationBundleAutoUpgrade: &unikornv1.ApplicationBundleAutoUpgradeSpec{},
+				UpgradeFreeze:                freeze,
+			},
+		}
+	}
+
+	objects := []client.Object{
+		newCluster("thawed", nil),
+		newCluster("expired", &unikornv1.UpgradeFreezeSpec{Until: metav1.NewTime(time.Now().Add(-time.Hour))}),
+		newCluster("frozen", &unikornv1.UpgradeFreezeSpec{Until: metav1.NewTime(time.Now().Add(time.Hour))}),
+	}
+
+	objects = append(objects, newBundles("control-plane")...)
+	objects = append(objects, newBundles("kubernetes-cluster")...)
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+	plans, err := monitor.Simulate(context.Background(), c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions := map[string]util.Action{}
+
+	for _, plan := range plans {
+		actions[plan.Cluster] = plan.Action
+	}
+
+	if actions["thawed"] == util.ActionNone || actions["expired"] == util.ActionNone {
+		t.Fatal("cluster upgrade not planned", actions)
+	}
+
+	if actions["frozen"] != util.ActionNone {
+		t.Fatal("frozen cluster upgrade planned", actions)
+	}
+
+	// A platform-wide freeze inhibits everything.
+	freeze := &util.Freeze{
+		Until: time.Now().Add(time.Hour),
+	}
+
+	plans, err = monitor.Simulate(context.Background(), c, freeze)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, plan := range plans {
+		if plan.Action != util.ActionNone {
+			t.Fatal("upgrade planned during platform freeze", plan)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/eschercloudai/unikorn/pkg/constants"
+
+	"github.com/eschercloudai/unikorn-core/pkg/util"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var (
+	// ErrIdentity is raised when the replica identity cannot be determined.
+	ErrIdentity = errors.New("shard identity undefined")
+)
+
+const (
+	// leasePrefix is prepended to all lease names.
+	leasePrefix = "unikorn-monitor-"
+)
+
+// Options allow sharding to be configured.
+type Options struct {
+	// Enabled turns on sharding, when disabled this replica owns everything.
+	Enabled bool
+
+	// Namespace is where membership leases are kept.
+	Namespace string
+
+	// Identity uniquely identifies this replica.
+	Identity string
+
+	// LeaseDuration is how long leases are valid for without renewal.
+	LeaseDuration time.Duration
+}
+
+// AddFlags registers option flags with pflag.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	identity, _ := os.Hostname()
+
+	f.BoolVar(&o.Enabled, "sharding", false, "Share resources between monitor replicas")
+	f.StringVar(&o.Namespace, "shard-namespace", "", "Namespace to keep shard membership leases in")
+	f.StringVar(&o.Identity, "shard-identity", identity, "Unique identity of this replica, defaults to the host name")
+	f.DurationVar(&o.LeaseDuration, "shard-lease-duration", 3*time.Minute, "How long leases are held without renewal, must be longer than the poll period")
+}
+
+// Member is a live monitor replica.
+type Member struct {
+	// Identity uniquely identifies the replica.
+	Identity string
+
+	// RenewTime is when the replica last renewed its membership.
+	RenewTime time.Time
+}
+
+// leaseExpired returns true if the lease has not been renewed in time.
+func leaseExpired(lease *coordinationv1.Lease) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+
+	expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+
+	return time.Now().After(expiry)
+}
+
+// Members returns all live monitor replicas, ordered by identity.
+func Members(ctx context.Context, c client.Client, namespace string) ([]Member, error) {
+	leases := &coordinationv1.LeaseList{}
+
+	if err := c.List(ctx, leases, client.InNamespace(namespace), client.HasLabels{constants.MonitorMemberLabel}); err != nil {
+		return nil, err
+	}
+
+	var members []Member
+
+	for i := range leases.Items {
+		lease := &leases.Items[i]
+
+		if lease.Spec.HolderIdentity == nil || leaseExpired(lease) {
+			continue
+		}
+
+		members = append(members, Member{
+			Identity:  *lease.Spec.HolderIdentity,
+			RenewTime: lease.Spec.RenewTime.Time,
+		})
+	}
+
+	slices.SortFunc(members, func(a, b Member) int {
+		return strings.Compare(a.Identity, b.Identity)
+	})
+
+	return members, nil
+}
+
+// Assign returns the replica a resource is assigned to, and whether that's
+// because it's pinned there.  Pinned resources whose replica is no longer live
+// fall back to rendezvous hashing, which, as replicas come and go, only moves
+// resources to or from the affected replica.
+func Assign(members []Member, object client.Object) (string, bool) {
+	if pin, ok := object.GetLabels()[constants.MonitorShardLabel]; ok {
+		if slices.ContainsFunc(members, func(m Member) bool { return m.Identity == pin }) {
+			return pin, true
+		}
+	}
+
+	var identity string
+
+	var weight uint64
+
+	for _, member := range members {
+		sum := sha256.Sum256([]byte(member.Identity + "/" + object.GetNamespace() + "/" + object.GetName()))
+
+		if w := binary.BigEndian.Uint64(sum[:8]); identity == "" || w > weight {
+			identity = member.Identity
+			weight = w
+		}
+	}
+
+	return identity, false
+}
+
+// Sharder decides which resources this replica is responsible for.
+type Sharder struct {
+	client  client.Client
+	options *Options
+	members []Member
+}
+
+// New returns a new sharder.
+func New(client client.Client, options *Options) *Sharder {
+	return &Sharder{
+		client:  client,
+		options: options,
+	}
+}
+
+// Validate checks the options are usable.
+func (s *Sharder) Validate() error {
+	if s.options.Enabled && s.options.Identity == "" {
+		return ErrIdentity
+	}
+
+	return nil
+}
+
+// renew updates the lease to be held by this replica.
+func (s *Sharder) renew(lease *coordinationv1.Lease) {
+	now := metav1.NewMicroTime(time.Now())
+
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != s.options.Identity {
+		lease.Spec.AcquireTime = &now
+	}
+
+	lease.Spec.HolderIdentity = util.ToPointer(s.options.Identity)
+	lease.Spec.LeaseDurationSeconds = util.ToPointer(int32(s.options.LeaseDuration.Seconds()))
+	lease.Spec.RenewTime = &now
+}
+
+// Heartbeat renews this replica's membership, and refreshes the set of live
+// replicas.  This must be called periodically, more often than the lease
+// duration.
+func (s *Sharder) Heartbeat(ctx context.Context) error {
+	if !s.options.Enabled {
+		return nil
+	}
+
+	lease := &coordinationv1.Lease{}
+
+	if err := s.client.Get(ctx, client.ObjectKey{Namespace: s.options.Namespace, Name: leasePrefix + s.options.Identity}, lease); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: s.options.Namespace,
+				Name:      leasePrefix + s.options.Identity,
+				Labels: map[string]string{
+					constants.MonitorMemberLabel: "true",
+				},
+			},
+		}
+
+		s.renew(lease)
+
+		if err := s.client.Create(ctx, lease); err != nil {
+			return err
+		}
+	} else {
+		s.renew(lease)
+
+		if err := s.client.Update(ctx, lease); err != nil {
+			return err
+		}
+	}
+
+	members, err := Members(ctx, s.client, s.options.Namespace)
+	if err != nil {
+		return err
+	}
+
+	s.members = members
+
+	return nil
+}
+
+// ownerLeaseName returns the name of the lease that records which replica
+// owns a resource.
+func (s *Sharder) ownerLeaseName(object client.Object) (string, error) {
+	gvk, err := apiutil.GVKForObject(object, s.client.Scheme())
+	if err != nil {
+		return "", err
+	}
+
+	return leasePrefix + strings.ToLower(gvk.Kind) + "-" + object.GetName(), nil
+}
+
+// Owns returns whether this replica is responsible for the resource.  Ownership
+// is recorded in a lease alongside the resource, so during rebalancing a
+// resource is only picked up once the previous owner has released it, or its
+// lease has expired, thus exactly one replica acts on a resource at a time.
+func (s *Sharder) Owns(ctx context.Context, object client.Object) (bool, error) {
+	if !s.options.Enabled {
+		return true, nil
+	}
+
+	name, err := s.ownerLeaseName(object)
+	if err != nil {
+		return false, err
+	}
+
+	assigned, _ := Assign(s.members, object)
+
+	lease := &coordinationv1.Lease{}
+
+	if err := s.client.Get(ctx, client.ObjectKey{Namespace: object.GetNamespace(), Name: name}, lease); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return false, err
+		}
+
+		if assigned != s.options.Identity {
+			return false, nil
+		}
+
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: object.GetNamespace(),
+				Name:      name,
+			},
+		}
+
+		// Leases are garbage collected along with the resource.
+		if err := controllerutil.SetOwnerReference(object, lease, s.client.Scheme()); err != nil {
+			return false, err
+		}
+
+		s.renew(lease)
+
+		if err := s.client.Create(ctx, lease); err != nil {
+			if kerrors.IsAlreadyExists(err) {
+				return false, nil
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	held := lease.Spec.HolderIdentity != nil && *lease.Spec.HolderIdentity == s.options.Identity
+
+	// Release ownership as soon as possible so the new owner can take over.
+	if assigned != s.options.Identity {
+		if held {
+			if err := s.client.Delete(ctx, lease); err != nil && !kerrors.IsNotFound(err) {
+				return false, err
+			}
+		}
+
+		return false, nil
+	}
+
+	if !held && !leaseExpired(lease) {
+		return false, nil
+	}
+
+	s.renew(lease)
+
+	// Conflicts mean someone else got there first.
+	if err := s.client.Update(ctx, lease); err != nil {
+		if kerrors.IsConflict(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/monitor/shard"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	namespace = "unikorn"
+)
+
+func newCluster(name string) *unikornv1.KubernetesCluster {
+	return &unikornv1.KubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "controlplane-foo",
+			Name:      name,
+		},
+	}
+}
+
+func newClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+
+	if err := unikornv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := coordinationv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func newSharder(c client.Client, identity string) *shard.Sharder {
+	options := &shard.Options{
+		Enabled:       true,
+		Namespace:     namespace,
+		Identity:      identity,
+		LeaseDuration: time.Minute,
+	}
+
+	return shard.New(c, options)
+}
+
+// TestAssignConsistent tests that when a replica leaves, only resources assigned
+// to it are reassigned.
+func TestAssignConsistent(t *testing.T) {
+	t.Parallel()
+
+	all := []shard.Member{{Identity: "a"}, {Identity: "b"}, {Identity: "c"}}
+	remaining := []shard.Member{{Identity: "a"}, {Identity: "c"}}
+
+	assigned := map[string]int{}
+
+	for i := 0; i < 100; i++ {
+		cluster := newCluster(fmt.Sprintf("cluster-%d", i))
+
+		before, _ := shard.Assign(all, cluster)
+		after, _ := shard.Assign(remaining, cluster)
+
+		assigned[before]++
+
+		if before != "b" && before != after {
+			t.Fatalf("cluster %s moved from %s to %s", cluster.Name, before, after)
+		}
+	}
+
+	for _, member := range all {
+		if assigned[member.Identity] == 0 {
+			t.Fatalf("member %s assigned nothing", member.Identity)
+		}
+	}
+}
+
+// TestAssignPinned tests resources can be pinned to a live replica, and that
+// pins to replicas that aren't live are ignored.
+func TestAssignPinned(t *testing.T) {
+	t.Parallel()
+
+	members := []shard.Member{{Identity: "a"}, {Identity: "b"}}
+
+	cluster := newCluster("foo")
+
+	hashed, pinned := shard.Assign(members, cluster)
+	if pinned {
+		t.Fatal("unexpected pin")
+	}
+
+	other := "a"
+	if hashed == "a" {
+		other = "b"
+	}
+
+	cluster.Labels = map[string]string{
+		constants.MonitorShardLabel: other,
+	}
+
+	if assigned, pinned := shard.Assign(members, cluster); assigned != other || !pinned {
+		t.Fatalf("expected pin to %s, got %s", other, assigned)
+	}
+
+	cluster.Labels[constants.MonitorShardLabel] = "gone"
+
+	if assigned, pinned := shard.Assign(members, cluster); assigned != hashed || pinned {
+		t.Fatalf("expected fallback to %s, got %s", hashed, assigned)
+	}
+}
+
+// TestOwnsExclusive tests exactly one replica owns a resource, and that ownership
+// is handed over when a resource is pinned elsewhere.
+func TestOwnsExclusive(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	cluster := newCluster("foo")
+
+	c := newClient(t, cluster)
+
+	a := newSharder(c, "a")
+	b := newSharder(c, "b")
+
+	for _, s := range []*shard.Sharder{a, b, a} {
+		if err := s.Heartbeat(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	members, err := shard.Members(ctx, c, namespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(members) != 2 {
+		t.Fatalf("expected 2 members, got %d", len(members))
+	}
+
+	aOwns, err := a.Owns(ctx, cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bOwns, err := b.Owns(ctx, cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aOwns == bOwns {
+		t.Fatalf("expected exactly one owner, got a=%v b=%v", aOwns, bOwns)
+	}
+
+	owner, next, nextIdentity := a, b, "b"
+	if bOwns {
+		owner, next, nextIdentity = b, a, "a"
+	}
+
+	// Pin to the other replica, it cannot take ownership until the current
+	// owner releases it.
+	cluster.Labels = map[string]string{
+		constants.MonitorShardLabel: nextIdentity,
+	}
+
+	if owned, err := next.Owns(ctx, cluster); err != nil || owned {
+		t.Fatalf("expected no ownership before release, got %v %v", owned, err)
+	}
+
+	if owned, err := owner.Owns(ctx, cluster); err != nil || owned {
+		t.Fatalf("expected ownership to be released, got %v %v", owned, err)
+	}
+
+	if owned, err := next.Owns(ctx, cluster); err != nil || !owned {
+		t.Fatalf("expected ownership after release, got %v %v", owned, err)
+	}
+}
+
+// TestOwnsDisabled tests everything is owned when sharding is disabled.
+func TestOwnsDisabled(t *testing.T) {
+	t.Parallel()
+
+	cluster := newCluster("foo")
+
+	s := shard.New(newClient(t, cluster), &shard.Options{})
+
+	if err := s.Heartbeat(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if owned, err := s.Owns(context.Background(), cluster); err != nil || !owned {
+		t.Fatalf("expected ownership, got %v %v", owned, err)
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	// ErrWebhookResponse is raised when the webhook returns an unexpected
+	// status code.
+	ErrWebhookResponse = errors.New("unexpected webhook response")
+)
+
+const (
+	// webhookTimeout is how long we wait for a webhook to respond, this
+	// is deliberately short so a slow change management system doesn't
+	// hold up the monitor.
+	webhookTimeout = 10 * time.Second
+)
+
+// Request is the body that is sent to the approval webhook.
+type Request struct {
+	// Kind is the resource kind that is being upgraded.
+	Kind string `json:"kind"`
+	// Project is the project that owns the resource.
+	Project string `json:"project"`
+	// ControlPlane is the control plane being upgraded, or the one
+	// that owns the cluster.
+	ControlPlane string `json:"controlPlane"`
+	// Cluster is the cluster being upgraded, if applicable.
+	Cluster string `json:"cluster,omitempty"`
+	// From is the current application bundle.
+	From string `json:"from"`
+	// To is the application bundle that will be upgraded to, this
+	// is also the upgrade ID that can be used for manual approval.
+	To string `json:"to"`
+}
+
+// Gate decides whether an upgrade is allowed to proceed.
+type Gate struct {
+	client client.Client
+}
+
+// New returns a new approval gate.
+func New(client client.Client) *Gate {
+	return &Gate{
+		client: client,
+	}
+}
+
+// decision is the outcome of an approval request.
+type decision int
+
+const (
+	// decisionNone means no decision was made.
+	decisionNone decision = iota
+	// decisionApproved means the upgrade can go ahead.
+	decisionApproved
+	// decisionDenied means the upgrade cannot go ahead.
+	decisionDenied
+)
+
+// callWebhook asks the webhook for a decision.  A 2XX status approves the
+// upgrade, 403 denies it, anything else is treated as no decision.
+func (g *Gate) callWebhook(ctx context.Context, webhook *unikornv1.UpgradeApprovalWebhookSpec, request *Request) (decision, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return decisionNone, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return decisionNone, err
+	}
+
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	response, err := http.DefaultClient.Do(httpRequest)
+	if err != nil {
+		return decisionNone, err
+	}
+
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		return decisionApproved, nil
+	case response.StatusCode == http.StatusForbidden:
+		return decisionDenied, nil
+	}
+
+	return decisionNone, fmt.Errorf("%w: status code %d", ErrWebhookResponse, response.StatusCode)
+}
+
+// Approved returns whether the upgrade is allowed to proceed.  If the project has
+// no approval policy then upgrades are always allowed.  Otherwise the upgrade must
+// be approved either manually, via the API, or by the project's webhook.  If no
+// decision is made before the timeout expires, then the default policy is applied.
+// The status is modified to record when approval was first requested.
+func (g *Gate) Approved(ctx context.Context, object client.Object, status *unikornv1.ApplicationBundleUpgradeStatus, request *Request) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	project := &unikornv1.Project{}
+
+	if err := g.client.Get(ctx, client.ObjectKey{Name: request.Project}, project); err != nil {
+		return false, err
+	}
+
+	policy := project.Spec.UpgradeApproval
+	if policy == nil {
+		return true, nil
+	}
+
+	// Manual approval always takes precedence, and is only valid for the
+	// upgrade that was requested.
+	if object.GetAnnotations()[constants.UpgradeApprovalAnnotation] == status.Target {
+		logger.Info("upgrade manually approved")
+
+		return true, nil
+	}
+
+	if status.ApprovalRequestedAt == nil {
+		now := metav1.Now()
+
+		status.ApprovalRequestedAt = &now
+	}
+
+	if policy.Webhook != nil {
+		decision, err := g.callWebhook(ctx, policy.Webhook, request)
+		if err != nil {
+			logger.Error(err, "approval webhook failed")
+		}
+
+		switch decision {
+		case decisionApproved:
+			logger.Info("upgrade approved by webhook")
+
+			return true, nil
+		case decisionDenied:
+			logger.Info("upgrade denied by webhook")
+
+			return false, nil
+		case decisionNone:
+		}
+	}
+
+	if time.Since(status.ApprovalRequestedAt.Time) < policy.GetTimeout() {
+		return false, nil
+	}
+
+	logger.Info("upgrade approval timed out, applying default policy", "policy", policy.DefaultPolicy)
+
+	return policy.AllowByDefault(), nil
+}
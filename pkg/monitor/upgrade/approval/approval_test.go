@@ -0,0 +1,200 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approval_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/monitor/upgrade/approval"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	projectName = "foo"
+	target      = "bundle-2.0.0"
+)
+
+// newGate returns an approval gate backed by a fake client that contains a
+// single project with the requested approval policy.
+func newGate(t *testing.T, policy *unikornv1.UpgradeApprovalSpec) *approval.Gate {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+
+	if err := unikornv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	project := &unikornv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: projectName,
+		},
+		Spec: unikornv1.ProjectSpec{
+			UpgradeApproval: policy,
+		},
+	}
+
+	return approval.New(fake.NewClientBuilder().WithScheme(scheme).WithObjects(project).Build())
+}
+
+// newWebhook returns a webhook that always responds with the given status code.
+func newWebhook(t *testing.T, status int) *unikornv1.UpgradeApprovalWebhookSpec {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return &unikornv1.UpgradeApprovalWebhookSpec{
+		URL: server.URL,
+	}
+}
+
+func approved(t *testing.T, gate *approval.Gate, object client.Object, status *unikornv1.ApplicationBundleUpgradeStatus) bool {
+	t.Helper()
+
+	request := &approval.Request{
+		Kind:         unikornv1.ControlPlaneKind,
+		Project:      projectName,
+		ControlPlane: "bar",
+		From:         "bundle-1.0.0",
+		To:           target,
+	}
+
+	ok, err := gate.Approved(context.Background(), object, status, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ok
+}
+
+// TestNoPolicy tests upgrades are allowed when no policy is defined.
+func TestNoPolicy(t *testing.T) {
+	t.Parallel()
+
+	gate := newGate(t, nil)
+	status := &unikornv1.ApplicationBundleUpgradeStatus{Target: target}
+
+	if !approved(t, gate, &unikornv1.ControlPlane{}, status) {
+		t.Fatal("upgrade not approved")
+	}
+}
+
+// TestManualApproval tests the approval annotation allows the upgrade, but
+// only for the upgrade that was approved.
+func TestManualApproval(t *testing.T) {
+	t.Parallel()
+
+	gate := newGate(t, &unikornv1.UpgradeApprovalSpec{})
+	status := &unikornv1.ApplicationBundleUpgradeStatus{Target: target}
+
+	object := &unikornv1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				constants.UpgradeApprovalAnnotation: "bundle-1.5.0",
+			},
+		},
+	}
+
+	if approved(t, gate, object, status) {
+		t.Fatal("upgrade approved by stale approval")
+	}
+
+	if status.ApprovalRequestedAt == nil {
+		t.Fatal("approval request time not recorded")
+	}
+
+	object.Annotations[constants.UpgradeApprovalAnnotation] = target
+
+	if !approved(t, gate, object, status) {
+		t.Fatal("upgrade not approved")
+	}
+}
+
+// TestWebhook tests the webhook response is honoured.
+func TestWebhook(t *testing.T) {
+	t.Parallel()
+
+	allow := unikornv1.UpgradeApprovalPolicyAllow
+
+	for code, expected := range map[int]bool{
+		http.StatusOK:                  true,
+		http.StatusNoContent:           true,
+		http.StatusForbidden:           false,
+		http.StatusInternalServerError: false,
+	} {
+		policy := &unikornv1.UpgradeApprovalSpec{
+			Webhook:       newWebhook(t, code),
+			DefaultPolicy: allow,
+		}
+
+		gate := newGate(t, policy)
+		status := &unikornv1.ApplicationBundleUpgradeStatus{Target: target}
+
+		if approved(t, gate, &unikornv1.ControlPlane{}, status) != expected {
+			t.Fatal("unexpected decision for status code", code)
+		}
+	}
+}
+
+// TestTimeout tests the default policy is applied once the timeout expires, but
+// a webhook denial still takes precedence.
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	requested := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	for _, test := range []struct {
+		policy   unikornv1.UpgradeApprovalPolicy
+		webhook  int
+		expected bool
+	}{
+		{policy: unikornv1.UpgradeApprovalPolicyAllow, webhook: http.StatusServiceUnavailable, expected: true},
+		{policy: unikornv1.UpgradeApprovalPolicyDeny, webhook: http.StatusServiceUnavailable, expected: false},
+		{policy: unikornv1.UpgradeApprovalPolicyAllow, webhook: http.StatusForbidden, expected: false},
+	} {
+		policy := &unikornv1.UpgradeApprovalSpec{
+			Webhook:       newWebhook(t, test.webhook),
+			Timeout:       &metav1.Duration{Duration: time.Minute},
+			DefaultPolicy: test.policy,
+		}
+
+		gate := newGate(t, policy)
+		status := &unikornv1.ApplicationBundleUpgradeStatus{
+			Target:              target,
+			ApprovalRequestedAt: &requested,
+		}
+
+		if approved(t, gate, &unikornv1.ControlPlane{}, status) != test.expected {
+			t.Fatal("unexpected decision for policy", test.policy, "and status code", test.webhook)
+		}
+	}
+}
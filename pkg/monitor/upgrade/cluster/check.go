@@ -24,43 +24,102 @@ import (
 
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/monitor/shard"
+	"github.com/eschercloudai/unikorn/pkg/monitor/upgrade/approval"
 	"github.com/eschercloudai/unikorn/pkg/monitor/upgrade/errors"
 	"github.com/eschercloudai/unikorn/pkg/monitor/upgrade/util"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type Checker struct {
-	client client.Client
+	client  client.Client
+	gate    *approval.Gate
+	freeze  *util.Freeze
+	sharder *shard.Sharder
 }
 
-func New(client client.Client) *Checker {
+func New(client client.Client, freeze *util.Freeze) *Checker {
 	return &Checker{
 		client: client,
+		gate:   approval.New(client),
+		freeze: freeze,
 	}
 }
 
-func (c *Checker) upgradeResource(ctx context.Context, resource *unikornv1.KubernetesCluster, bundles *unikornv1.KubernetesClusterApplicationBundleList, target *unikornv1.KubernetesClusterApplicationBundle) error {
+// WithSharder limits checks to resources owned by this replica.
+func (c *Checker) WithSharder(sharder *shard.Sharder) *Checker {
+	c.sharder = sharder
+
+	return c
+}
+
+// setUpgradeStatus records any pending upgrade in the resource status, this is only
+// written when something has changed to avoid needless API traffic.
+func (c *Checker) setUpgradeStatus(ctx context.Context, resource *unikornv1.KubernetesCluster, status *unikornv1.ApplicationBundleUpgradeStatus) error {
+	if equality.Semantic.DeepEqual(resource.Status.Upgrade, status) {
+		return nil
+	}
+
+	original := resource.DeepCopy()
+	resource.Status.Upgrade = status
+
+	if err := c.client.Status().Patch(ctx, resource, client.MergeFrom(original)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// planUpgrade decides what to do with the resource, without acting upon it.
+func planUpgrade(ctx context.Context, resource *unikornv1.KubernetesCluster, bundle *unikornv1.KubernetesClusterApplicationBundle, bundles *unikornv1.KubernetesClusterApplicationBundleList, freeze *util.Freeze) *util.Plan {
 	logger := log.FromContext(ctx)
 
-	bundle := bundles.Get(*resource.Spec.ApplicationBundle)
-	if bundle == nil {
-		return fmt.Errorf("%w: %s", errors.ErrMissingBundle, *resource.Spec.ApplicationBundle)
+	channel := resource.Spec.ApplicationBundleAutoUpgrade.GetChannel()
+
+	p := &util.Plan{
+		Kind:         unikornv1.KubernetesClusterKind,
+		Project:      resource.Labels[constants.ProjectLabel],
+		ControlPlane: resource.Labels[constants.ControlPlaneLabel],
+		Cluster:      resource.Name,
+		From:         bundle.Name,
+		Channel:      string(channel),
 	}
 
 	// If the current bundle is in preview, then don't offer to upgrade.
 	if bundle.Spec.Preview != nil && *bundle.Spec.Preview {
 		logger.Info("bundle in preview, ignoring")
 
-		return nil
+		return p.Skip("bundle in preview")
 	}
 
-	// If the current bundle is the best option already, we are done.
-	if bundle.Name == target.Name {
+	// Pick the newest bundle available in the subscribed channel.
+	candidates := bundles.InChannel(channel)
+	if len(candidates.Items) == 0 {
+		logger.Info("no bundles in channel, ignoring", "channel", channel)
+
+		return p.Skip("no bundles in channel")
+	}
+
+	target := &candidates.Items[len(candidates.Items)-1]
+
+	// If the current bundle is the best option already, we are done.  This
+	// also prevents downgrades when subscribing to a more stable channel.
+	if unikornv1.CompareKubernetesClusterApplicationBundle(*target, *bundle) <= 0 {
 		logger.Info("bundle already latest, ignoring")
 
-		return nil
+		return p.Skip("bundle already latest")
+	}
+
+	// Change embargoes trump everything, even end of life bundles.
+	if until, ok := util.FrozenUntil(time.Now(), freeze, resource.Spec.UpgradeFreeze); ok {
+		logger.Info("upgrades frozen, ignoring", "until", until)
+
+		return p.Skip("upgrades frozen until " + until.Format(time.RFC3339))
 	}
 
 	upgradable := util.UpgradeableResource(resource)
@@ -69,22 +128,75 @@ func (c *Checker) upgradeResource(ctx context.Context, resource *unikornv1.Kuber
 		if bundle.Spec.EndOfLife == nil || time.Now().Before(bundle.Spec.EndOfLife.Time) {
 			logger.Info("resource auto-upgrade disabled, ignoring")
 
-			return nil
+			return p.Skip("resource auto-upgrade disabled")
 		}
 
 		logger.Info("resource auto-upgrade disabled, but bundle is end of life, forcing auto-upgrade")
 
 		upgradable = util.NewForcedUpgradeResource(resource)
+
+		p.Forced = true
 	}
 
 	// Is it allowed to happen now?  Base it on the UID for ultimate randomness,
 	// you can cause a stampede if all the resources are called "default".
 	window := util.TimeWindowFromResource(ctx, upgradable)
 
-	if !window.In() {
+	return p.Schedule(target.Name, window)
+}
+
+func (c *Checker) upgradeResource(ctx context.Context, resource *unikornv1.KubernetesCluster, allBundles, bundles *unikornv1.KubernetesClusterApplicationBundleList) error {
+	logger := log.FromContext(ctx)
+
+	bundle := allBundles.Get(*resource.Spec.ApplicationBundle)
+	if bundle == nil {
+		return fmt.Errorf("%w: %s", errors.ErrMissingBundle, *resource.Spec.ApplicationBundle)
+	}
+
+	plan := planUpgrade(ctx, resource, bundle, bundles, c.freeze)
+
+	if plan.Action == util.ActionNone {
+		return c.setUpgradeStatus(ctx, resource, nil)
+	}
+
+	target := bundles.Get(plan.To)
+
+	window := plan.Window()
+
+	status := &unikornv1.ApplicationBundleUpgradeStatus{
+		Target:        target.Name,
+		NextUpgradeAt: metav1.NewTime(window.Next()),
+	}
+
+	// Retain when approval was requested, provided it's for the same upgrade.
+	if resource.Status.Upgrade != nil && resource.Status.Upgrade.Target == target.Name {
+		status.ApprovalRequestedAt = resource.Status.Upgrade.ApprovalRequestedAt
+	}
+
+	if plan.Action == util.ActionScheduled {
 		logger.Info("not in upgrade window, ignoring", "start", window.Start, "end", window.End)
 
-		return nil
+		return c.setUpgradeStatus(ctx, resource, status)
+	}
+
+	request := &approval.Request{
+		Kind:         plan.Kind,
+		Project:      plan.Project,
+		ControlPlane: plan.ControlPlane,
+		Cluster:      plan.Cluster,
+		From:         plan.From,
+		To:           plan.To,
+	}
+
+	approved, err := c.gate.Approved(ctx, resource, status, request)
+	if err != nil {
+		return err
+	}
+
+	if !approved {
+		logger.Info("upgrade awaiting approval")
+
+		return c.setUpgradeStatus(ctx, resource, status)
 	}
 
 	logger.Info("bundle upgrading", "from", *bundle.Spec.Version, "to", *target.Spec.Version)
@@ -95,47 +207,91 @@ func (c *Checker) upgradeResource(ctx context.Context, resource *unikornv1.Kuber
 		return err
 	}
 
-	return nil
+	return c.setUpgradeStatus(ctx, resource, nil)
 }
 
-func (c *Checker) Check(ctx context.Context) error {
-	logger := log.FromContext(ctx)
-
-	logger.Info("checking for kubernetes cluster upgrades")
-
+// list returns all application bundles, the potential upgrade targets, and all
+// resources that may be upgraded.
+func (c *Checker) list(ctx context.Context) (*unikornv1.KubernetesClusterApplicationBundleList, *unikornv1.KubernetesClusterApplicationBundleList, *unikornv1.KubernetesClusterList, error) {
 	allBundles := &unikornv1.KubernetesClusterApplicationBundleList{}
 
 	if err := c.client.List(ctx, allBundles); err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
 	// Extract the potential upgrade target bundles, these are sorted by version, so
-	// the newest is on the top, we shall see why later...
+	// the newest is on the top, and the target is the newest in a resource's channel.
 	bundles := allBundles.Upgradable()
 	if len(bundles.Items) == 0 {
-		return errors.ErrNoBundles
+		return nil, nil, nil, errors.ErrNoBundles
 	}
 
 	slices.SortStableFunc(bundles.Items, unikornv1.CompareKubernetesClusterApplicationBundle)
 
-	// Pick the most recent as our upgrade target.
-	upgradeTarget := &bundles.Items[len(bundles.Items)-1]
-
 	resources := &unikornv1.KubernetesClusterList{}
 
 	if err := c.client.List(ctx, resources); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return allBundles, bundles, resources, nil
+}
+
+func (c *Checker) Check(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	logger.Info("checking for kubernetes cluster upgrades")
+
+	allBundles, bundles, resources, err := c.list(ctx)
+	if err != nil {
 		return err
 	}
 
 	for i := range resources.Items {
 		resource := &resources.Items[i]
 
-		logger := logger.WithValues("project", resource.Labels[constants.ProjectLabel], "controlplane", resource.Labels[constants.ControlPlaneLabel], "cluster", resource.Name)
+		if c.sharder != nil {
+			owned, err := c.sharder.Owns(ctx, resource)
+			if err != nil {
+				return err
+			}
+
+			if !owned {
+				continue
+			}
+		}
 
-		if err := c.upgradeResource(log.IntoContext(ctx, logger), resource, allBundles, upgradeTarget); err != nil {
+		logger := logger.WithValues("project", resource.Labels[constants.ProjectLabel], "controlplane", resource.Labels[constants.ControlPlaneLabel], "cluster", resource.Name)
+		if err := c.upgradeResource(log.IntoContext(ctx, logger), resource, allBundles, bundles); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// Simulate returns what the checker would do, without actually doing it.
+func (c *Checker) Simulate(ctx context.Context) ([]*util.Plan, error) {
+	logger := log.FromContext(ctx)
+
+	allBundles, bundles, resources, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]*util.Plan, len(resources.Items))
+
+	for i := range resources.Items {
+		resource := &resources.Items[i]
+
+		logger := logger.WithValues("project", resource.Labels[constants.ProjectLabel], "controlplane", resource.Labels[constants.ControlPlaneLabel], "cluster", resource.Name)
+		bundle := allBundles.Get(*resource.Spec.ApplicationBundle)
+		if bundle == nil {
+			return nil, fmt.Errorf("%w: %s", errors.ErrMissingBundle, *resource.Spec.ApplicationBundle)
+		}
+
+		plans[i] = planUpgrade(log.IntoContext(ctx, logger), resource, bundle, bundles, c.freeze)
+	}
+
+	return plans, nil
+}
@@ -17,14 +17,14 @@ limitations under the License.
 package errors
 
 import (
-	"errors"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
 )
 
 var (
 	// ErrNoBundles is raised when there are no production bundles.
-	ErrNoBundles = errors.New("no bundles found")
+	ErrNoBundles = unikornerrors.NotFound("no bundles found")
 
 	// ErrMissingBundle is raised when a resource is linked to a non-existent
 	// bundle.
-	ErrMissingBundle = errors.New("referenced bundle not found")
+	ErrMissingBundle = unikornerrors.NotFound("referenced bundle not found")
 )
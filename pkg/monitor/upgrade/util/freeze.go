@@ -0,0 +1,86 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+)
+
+// Freeze is a platform-wide upgrade freeze, this inhibits all automatic
+// upgrades until it expires e.g. during a change embargo period.
+type Freeze struct {
+	// Until is when the freeze expires, the zero value means no freeze.
+	Until time.Time
+}
+
+// timeValue allows a time to be parsed from the command line.
+type timeValue time.Time
+
+// Ensure the pflag.Value interface is implemented.
+var _ pflag.Value = &timeValue{}
+
+// String implements the pflag.Value interface.
+func (t *timeValue) String() string {
+	if time.Time(*t).IsZero() {
+		return ""
+	}
+
+	return time.Time(*t).Format(time.RFC3339)
+}
+
+// Set implements the pflag.Value interface.
+func (t *timeValue) Set(s string) error {
+	v, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return err
+	}
+
+	*t = timeValue(v)
+
+	return nil
+}
+
+// Type implements the pflag.Value interface.
+func (t *timeValue) Type() string {
+	return "time"
+}
+
+// AddFlags registers freeze flags with pflag.
+func (f *Freeze) AddFlags(flags *pflag.FlagSet) {
+	flags.Var((*timeValue)(&f.Until), "upgrade-freeze-until", "Inhibit all automatic upgrades until the given RFC3339 time")
+}
+
+// FrozenUntil returns when any freeze that applies to a resource expires,
+// taking into account both the platform-wide and resource specific freezes.
+// If no freeze is active, it returns false.
+func FrozenUntil(now time.Time, platform *Freeze, resource *unikornv1.UpgradeFreezeSpec) (time.Time, bool) {
+	var until time.Time
+
+	if platform != nil && now.Before(platform.Until) {
+		until = platform.Until
+	}
+
+	if resource.Active(now) && resource.Until.Time.After(until) {
+		until = resource.Until.Time
+	}
+
+	return until, !until.IsZero()
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"time"
+)
+
+// Action is what the monitor will do with a resource.
+type Action string
+
+const (
+	// ActionNone means the resource will not be upgraded.
+	ActionNone Action = "None"
+
+	// ActionScheduled means the resource will be upgraded in the next
+	// upgrade window.
+	ActionScheduled Action = "Scheduled"
+
+	// ActionUpgrade means the resource is in its upgrade window, and
+	// will be upgraded now, subject to approval.
+	ActionUpgrade Action = "Upgrade"
+)
+
+// Plan describes what the monitor will do with a resource.  This allows the
+// scheduling decision to be made without acting upon it, so upgrades can be
+// simulated.
+type Plan struct {
+	// Kind is the resource kind.
+	Kind string `json:"kind"`
+	// Project is the project that owns the resource.
+	Project string `json:"project"`
+	// ControlPlane is the control plane, or the one that owns the cluster.
+	ControlPlane string `json:"controlPlane"`
+	// Cluster is the cluster name, if applicable.
+	Cluster string `json:"cluster,omitempty"`
+	// Action is what will happen to the resource.
+	Action Action `json:"action"`
+	// Reason is why no action will be taken.
+	Reason string `json:"reason,omitempty"`
+	// Channel is the release channel the resource is subscribed to.
+	Channel string `json:"channel"`
+	// From is the current application bundle.
+	From string `json:"from"`
+	// To is the application bundle that will be upgraded to.
+	To string `json:"to,omitempty"`
+	// Forced is true when the resource has not opted in to automatic upgrades,
+	// but its application bundle is end of life.
+	Forced bool `json:"forced,omitempty"`
+	// Start is the start of the next upgrade window.
+	Start *time.Time `json:"start,omitempty"`
+	// End is the end of the next upgrade window.
+	End *time.Time `json:"end,omitempty"`
+
+	// window is the upgrade window that was used to generate the plan.
+	window *TimeWindow
+}
+
+// Window returns the upgrade window the plan was generated with, this is only
+// set when an upgrade is planned.
+func (p *Plan) Window() *TimeWindow {
+	return p.window
+}
+
+// Skip marks the plan as taking no action.
+func (p *Plan) Skip(reason string) *Plan {
+	p.Action = ActionNone
+	p.Reason = reason
+
+	return p
+}
+
+// Schedule marks the plan as upgrading the resource in the given window.
+func (p *Plan) Schedule(to string, window *TimeWindow) *Plan {
+	p.To = to
+	p.window = window
+
+	start := window.Next()
+	end := start.Add(window.End.Sub(window.Start))
+
+	p.Start = &start
+	p.End = &end
+
+	p.Action = ActionScheduled
+
+	if window.In() {
+		p.Action = ActionUpgrade
+	}
+
+	return p
+}
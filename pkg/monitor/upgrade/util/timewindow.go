@@ -57,6 +57,10 @@ func (r *forcedUpgradeResource) UpgradeSpec() *unikornv1.ApplicationBundleAutoUp
 type TimeWindow struct {
 	Start time.Time
 	End   time.Time
+
+	// location is the time zone the window was generated in, and is
+	// used to calculate recurrences.
+	location *time.Location
 }
 
 // In tells us if we are in the time window.
@@ -70,6 +74,21 @@ func (t *TimeWindow) In() bool {
 	return now.After(t.Start) && now.Before(t.End)
 }
 
+// Next returns the start of the next upgrade window.  Windows are generated
+// for the current week, so if it has already passed, it'll recur next week.
+func (t *TimeWindow) Next() time.Time {
+	if time.Now().Before(t.End) {
+		return t.Start
+	}
+
+	location := t.location
+	if location == nil {
+		location = time.UTC
+	}
+
+	return t.Start.In(location).AddDate(0, 0, 7).UTC()
+}
+
 // GenerateTimeWindow returns a one hour time window in which to trigger a
 // resource upgrade.
 func autoTimeWindow(ctx context.Context, r UpgradeableResource) *TimeWindow {
@@ -103,10 +122,27 @@ func autoTimeWindow(ctx context.Context, r UpgradeableResource) *TimeWindow {
 		},
 	}
 
-	return weekDayTimeWindow(ctx, r, config)
+	return weekDayTimeWindow(ctx, r, config, time.UTC)
+}
+
+// getLocation returns the time zone that upgrade windows are defined in.  As the
+// upgrade specification may have been created via the Kubernetes API, rather
+// than the REST API, the time zone may not have been validated, in which case
+// fall back to UTC.
+func getLocation(ctx context.Context, spec *unikornv1.ApplicationBundleAutoUpgradeSpec) *time.Location {
+	log := log.FromContext(ctx)
+
+	location, err := spec.Location()
+	if err != nil {
+		log.Error(err, "falling back to UTC")
+
+		return time.UTC
+	}
+
+	return location
 }
 
-func weekDayTimeWindow(ctx context.Context, r UpgradeableResource, weekday *unikornv1.ApplicationBundleAutoUpgradeWeekDaySpec) *TimeWindow {
+func weekDayTimeWindow(ctx context.Context, r UpgradeableResource, weekday *unikornv1.ApplicationBundleAutoUpgradeWeekDaySpec, location *time.Location) *TimeWindow {
 	log := log.FromContext(ctx)
 
 	log.Info("using day of the week time window")
@@ -147,13 +183,20 @@ func weekDayTimeWindow(ctx context.Context, r UpgradeableResource, weekday *unik
 
 	windowHour := int(sum[1]) % windowLength
 
-	log.Info("selected window", "available", available, "selected", selected, "window_start", window.Start, "window_end", window.End, "selected_start", window.Start+windowHour)
+	log.Info("selected window", "available", available, "selected", selected, "window_start", window.Start, "window_end", window.End, "selected_start", window.Start+windowHour, "location", location.String())
 
-	now := time.Now()
+	// The current day of the week must be evaluated in the requested time zone
+	// otherwise we may select the wrong week around midnight.  Daylight saving
+	// is handled by time.Date, so 03:00 is always 03:00 local time.
+	now := time.Now().In(location)
+
+	start := time.Date(now.Year(), now.Month(), now.Day()-int(now.Weekday())+int(selected), window.Start+windowHour, 0, 0, 0, location)
 
-	start := time.Date(now.Year(), now.Month(), now.Day()-int(now.Weekday())+int(selected), window.Start+windowHour, 0, 0, 0, time.UTC)
+	// Canonicalize on UTC so times compare and display consistently regardless
+	// of the input time zone.
+	start = start.UTC()
 
-	return &TimeWindow{Start: start, End: start.Add(time.Hour)}
+	return &TimeWindow{Start: start, End: start.Add(time.Hour), location: location}
 }
 
 func TimeWindowFromResource(ctx context.Context, r UpgradeableResource) *TimeWindow {
@@ -161,7 +204,7 @@ func TimeWindowFromResource(ctx context.Context, r UpgradeableResource) *TimeWin
 
 	switch {
 	case spec.WeekDay != nil:
-		return weekDayTimeWindow(ctx, r, spec.WeekDay)
+		return weekDayTimeWindow(ctx, r, spec.WeekDay, getLocation(ctx, spec))
 	default:
 		return autoTimeWindow(ctx, r)
 	}
@@ -90,9 +90,10 @@ func (u autoGeneratingUpgrader) UpgradeSpec() *unikornv1.ApplicationBundleAutoUp
 // weekDayUpgrader provides as "resource" that has per-weekday upgrade
 // scheduling enabled.
 type weekDayUpgrader struct {
-	day   time.Weekday
-	start int
-	end   int
+	day      time.Weekday
+	start    int
+	end      int
+	timeZone *string
 }
 
 func newWeekDayUpgrader(day time.Weekday, start, end int) *weekDayUpgrader {
@@ -133,10 +134,17 @@ func (u weekDayUpgrader) UpgradeSpec() *unikornv1.ApplicationBundleAutoUpgradeSp
 	}
 
 	return &unikornv1.ApplicationBundleAutoUpgradeSpec{
-		WeekDay: spec,
+		WeekDay:  spec,
+		TimeZone: u.timeZone,
 	}
 }
 
+func (u *weekDayUpgrader) inTimeZone(timeZone string) *weekDayUpgrader {
+	u.timeZone = &timeZone
+
+	return u
+}
+
 func logStats(t *testing.T, freqs map[int]int) {
 	t.Helper()
 
@@ -282,3 +290,68 @@ func TestWeekdayOverflow(t *testing.T) {
 		t.Fatal("not valid at any point today")
 	}
 }
+
+// TestWeekdayTimeZone tests that upgrade windows are honoured in the requested
+// time zone, rather than UTC.
+func TestWeekdayTimeZone(t *testing.T) {
+	t.Parallel()
+
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := testContext(t)
+	upgrader := newWeekDayUpgrader(time.Wednesday, 3, 4).inTimeZone(location.String())
+	window := util.TimeWindowFromResource(ctx, upgrader)
+
+	if window.Start.Location() != time.UTC {
+		t.Fatal("window start is not in UTC")
+	}
+
+	start := window.Start.In(location)
+
+	if start.Weekday() != time.Wednesday || start.Hour() != 3 {
+		t.Fatal("window start is not in the requested time zone", start)
+	}
+}
+
+// TestWeekdayTimeZoneInvalid tests that an invalid time zone falls back to UTC.
+func TestWeekdayTimeZoneInvalid(t *testing.T) {
+	t.Parallel()
+
+	ctx := testContext(t)
+	upgrader := newWeekDayUpgrader(time.Wednesday, 3, 4).inTimeZone("Narnia/Cair_Paravel")
+	window := util.TimeWindowFromResource(ctx, upgrader)
+
+	if window.Start.Weekday() != time.Wednesday || window.Start.Hour() != 3 {
+		t.Fatal("window start is not in UTC", window.Start)
+	}
+}
+
+// TestNext tests that the next upgrade time is always in the future, and
+// within a week.
+func TestNext(t *testing.T) {
+	t.Parallel()
+
+	ctx := testContext(t)
+	now := time.Now()
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		upgrader := newWeekDayUpgrader(day, 0, 1).inTimeZone("Europe/London")
+		window := util.TimeWindowFromResource(ctx, upgrader)
+		next := window.Next()
+
+		if next.Before(window.Start) {
+			t.Fatal("next upgrade before the window start", next)
+		}
+
+		if !now.Before(next.Add(time.Hour)) {
+			t.Fatal("next upgrade window has already passed", next)
+		}
+
+		if next.Sub(now) > 7*24*time.Hour {
+			t.Fatal("next upgrade more than a week away", next)
+		}
+	}
+}
@@ -18,10 +18,12 @@ package openstack
 
 import (
 	"context"
+	"slices"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 
@@ -52,8 +54,9 @@ func NewBlockStorageClient(provider Provider) (*BlockStorageClient, error) {
 	return c, nil
 }
 
-// AvailabilityZones retrieves block storage availability zones.
-func (c *BlockStorageClient) AvailabilityZones(ctx context.Context) ([]availabilityzones.AvailabilityZone, error) {
+// ListAvailabilityZones retrieves all block storage availability zones, including
+// those that are unavailable.
+func (c *BlockStorageClient) ListAvailabilityZones(ctx context.Context) ([]availabilityzones.AvailabilityZone, error) {
 	url := c.client.ServiceURL("os-availability-zone")
 
 	tracer := otel.GetTracerProvider().Tracer(constants.Application)
@@ -66,20 +69,34 @@ func (c *BlockStorageClient) AvailabilityZones(ctx context.Context) ([]availabil
 		return nil, err
 	}
 
-	result, err := availabilityzones.ExtractAvailabilityZones(pages)
+	return availabilityzones.ExtractAvailabilityZones(pages)
+}
+
+// AvailabilityZones retrieves available block storage availability zones.
+func (c *BlockStorageClient) AvailabilityZones(ctx context.Context) ([]availabilityzones.AvailabilityZone, error) {
+	result, err := c.ListAvailabilityZones(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	filtered := []availabilityzones.AvailabilityZone{}
+	return slices.DeleteFunc(result, func(az availabilityzones.AvailabilityZone) bool {
+		return !az.ZoneState.Available
+	}), nil
+}
+
+// QuotaUsage returns block storage quota limits and usage for the project.
+func (c *BlockStorageClient) QuotaUsage(ctx context.Context, projectID string) (*quotasets.QuotaUsageSet, error) {
+	url := c.client.ServiceURL("os-quota-sets", projectID)
 
-	for _, az := range result {
-		if !az.ZoneState.Available {
-			continue
-		}
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, url, trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
 
-		filtered = append(filtered, az)
+	result, err := quotasets.GetUsage(c.client, projectID).Extract()
+	if err != nil {
+		return nil, err
 	}
 
-	return filtered, nil
+	return &result, nil
 }
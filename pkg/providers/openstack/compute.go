@@ -30,8 +30,10 @@ import (
 	"github.com/gophercloud/gophercloud/openstack"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/pagination"
 	"github.com/spf13/pflag"
 	"go.opentelemetry.io/otel"
@@ -40,6 +42,14 @@ import (
 	"github.com/eschercloudai/unikorn/pkg/constants"
 )
 
+const (
+	// internalAvailabilityZone is where Nova places its own services.
+	internalAvailabilityZone = "internal"
+
+	// computeServiceName is the Nova service that runs on hypervisors.
+	computeServiceName = "nova-compute"
+)
+
 var (
 	// ErrParseError is for when we cannot parse Openstack data correctly.
 	ErrParseError = errors.New("unable to parse value")
@@ -59,6 +69,14 @@ type flavorsGPUDescriptor struct {
 	propertyName string
 	// expression defines how to extract the number of GPUs from the chosen field.
 	expression string
+	// model is the optional GPU model e.g. A100.
+	model string
+	// memory is the optional amount of GPU memory in GiB.
+	memory *int
+	// profile is the optional vGPU profile e.g. A100D-3-40C.
+	profile string
+	// driver is the optional minimum driver version required by the GPU.
+	driver string
 }
 
 type flavorsGPUDescriptorVar struct {
@@ -92,6 +110,18 @@ func (v *flavorsGPUDescriptorVar) Set(s string) error {
 	desc := flavorsGPUDescriptor{
 		propertyName: pairs["property"],
 		expression:   pairs["expression"],
+		model:        pairs["model"],
+		profile:      pairs["profile"],
+		driver:       pairs["driver"],
+	}
+
+	if memory, ok := pairs["memory"]; ok {
+		i, err := strconv.Atoi(memory)
+		if err != nil {
+			return fmt.Errorf("%w: memory must be an integer: %s", ErrFlag, err.Error())
+		}
+
+		desc.memory = &i
 	}
 
 	v.descriptors = append(v.descriptors, desc)
@@ -113,7 +143,7 @@ type ComputeOptions struct {
 
 func (o *ComputeOptions) AddFlags(f *pflag.FlagSet) {
 	f.StringSliceVar(&o.flavorsExclusions, "flavors-exclude-property", nil, "Exclude flavours with the selected property key.  May be specified more than once.")
-	f.Var(&o.flavorsGPUDescriptors, "flavors-gpu-descriptor", "Defines how to extract GPU information from a flavor.  Expects the value to be in the form property=foo,expression=bar, where property is the property name to look for, and expression defines how to extract the number of GPUs e.g. ^(\\d+)$.  Exactly one sub string match is required in the expression.  May be specified more than once.")
+	f.Var(&o.flavorsGPUDescriptors, "flavors-gpu-descriptor", "Defines how to extract GPU information from a flavor.  Expects the value to be in the form property=foo,expression=bar, where property is the property name to look for, and expression defines how to extract the number of GPUs e.g. ^(\\d+)$.  Exactly one sub string match is required in the expression.  Optional model, memory (in GiB), profile (vGPU profile) and driver (minimum driver version) keys describe the GPU further.  May be specified more than once.")
 }
 
 // ComputeClient wraps the generic client because gophercloud is unsafe.
@@ -258,19 +288,30 @@ type GPUMeta struct {
 	// or physical GPUs, or a single virtual GPU.  This value
 	// is what will be reported for Kubernetes scheduling.
 	GPUs int
+	// Model is the GPU model, if known.
+	Model string
+	// Memory is the amount of GPU memory in GiB, if known.
+	Memory *int
+	// Profile is the vGPU profile, if known.
+	Profile string
+	// MinimumDriverVersion is the oldest driver version that supports
+	// the GPU, if known.
+	MinimumDriverVersion string
 }
 
 // extraSpecToGPUs evaluates the falvor extra spec and tries to derive
-// the number of GPUs, returns -1 if none are found.
-func (c *ComputeClient) extraSpecToGPUs(name, value string) (int, error) {
-	for _, desc := range c.options.flavorsGPUDescriptors.descriptors {
+// the number of GPUs and the matching descriptor, returns -1 if none are found.
+func (c *ComputeClient) extraSpecToGPUs(name, value string) (int, *flavorsGPUDescriptor, error) {
+	for i := range c.options.flavorsGPUDescriptors.descriptors {
+		desc := &c.options.flavorsGPUDescriptors.descriptors[i]
+
 		if desc.propertyName != name {
 			continue
 		}
 
 		re, err := regexp.Compile(desc.expression)
 		if err != nil {
-			return -1, err
+			return -1, nil, err
 		}
 
 		matches := re.FindStringSubmatch(value)
@@ -279,18 +320,18 @@ func (c *ComputeClient) extraSpecToGPUs(name, value string) (int, error) {
 		}
 
 		if len(matches) != 2 {
-			return -1, ErrExpression
+			return -1, nil, ErrExpression
 		}
 
-		i, err := strconv.Atoi(matches[1])
+		gpus, err := strconv.Atoi(matches[1])
 		if err != nil {
-			return -1, fmt.Errorf("%w: %s", ErrExpression, err.Error())
+			return -1, nil, fmt.Errorf("%w: %s", ErrExpression, err.Error())
 		}
 
-		return i, nil
+		return gpus, desc, nil
 	}
 
-	return -1, nil
+	return -1, nil, nil
 }
 
 // FlavorGPUs returns metadata about GPUs, e.g. the number of GPUs.  Sadly there is absolutely
@@ -298,7 +339,7 @@ func (c *ComputeClient) extraSpecToGPUs(name, value string) (int, error) {
 // aggregates, so we have to have knowledge of flavors built in somewhere.
 func (c *ComputeClient) FlavorGPUs(flavor *Flavor) (*GPUMeta, error) {
 	for name, value := range flavor.ExtraSpecs {
-		gpus, err := c.extraSpecToGPUs(name, value)
+		gpus, desc, err := c.extraSpecToGPUs(name, value)
 		if err != nil {
 			return nil, err
 		}
@@ -308,7 +349,11 @@ func (c *ComputeClient) FlavorGPUs(flavor *Flavor) (*GPUMeta, error) {
 		}
 
 		meta := &GPUMeta{
-			GPUs: gpus,
+			GPUs:                 gpus,
+			Model:                desc.model,
+			Memory:               desc.memory,
+			Profile:              desc.profile,
+			MinimumDriverVersion: desc.driver,
 		}
 
 		return meta, nil
@@ -318,34 +363,76 @@ func (c *ComputeClient) FlavorGPUs(flavor *Flavor) (*GPUMeta, error) {
 	return nil, nil
 }
 
-// AvailabilityZones returns a list of availability zones.
-func (c *ComputeClient) AvailabilityZones(ctx context.Context) ([]availabilityzones.AvailabilityZone, error) {
+// ListAvailabilityZones returns all availability zones, including those that are
+// unavailable.  Detailed information, including hosts, is returned when the user's
+// role permits, otherwise we fall back to the basic listing.
+func (c *ComputeClient) ListAvailabilityZones(ctx context.Context) ([]availabilityzones.AvailabilityZone, error) {
 	tracer := otel.GetTracerProvider().Tracer(constants.Application)
 
-	_, span := tracer.Start(ctx, "/compute/v2/os-availability-zones", trace.WithSpanKind(trace.SpanKindClient))
+	_, span := tracer.Start(ctx, "/compute/v2/os-availability-zones/detail", trace.WithSpanKind(trace.SpanKindClient))
 	defer span.End()
 
-	page, err := availabilityzones.List(c.client).AllPages()
+	page, err := availabilityzones.ListDetail(c.client).AllPages()
+	if err == nil {
+		result, err := availabilityzones.ExtractAvailabilityZones(page)
+		if err != nil {
+			return nil, err
+		}
+
+		// The detailed view includes the internal zone used by control plane
+		// services, this is never schedulable, so hide it.
+		return slices.DeleteFunc(result, func(az availabilityzones.AvailabilityZone) bool {
+			return az.ZoneName == internalAvailabilityZone
+		}), nil
+	}
+
+	var err403 gophercloud.ErrDefault403
+
+	var err404 gophercloud.ErrDefault404
+
+	if !errors.As(err, &err403) && !errors.As(err, &err404) {
+		return nil, err
+	}
+
+	_, span = tracer.Start(ctx, "/compute/v2/os-availability-zones", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	page, err = availabilityzones.List(c.client).AllPages()
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := availabilityzones.ExtractAvailabilityZones(page)
+	return availabilityzones.ExtractAvailabilityZones(page)
+}
+
+// AvailabilityZones returns a list of available availability zones.
+func (c *ComputeClient) AvailabilityZones(ctx context.Context) ([]availabilityzones.AvailabilityZone, error) {
+	result, err := c.ListAvailabilityZones(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	filtered := []availabilityzones.AvailabilityZone{}
+	return slices.DeleteFunc(result, func(az availabilityzones.AvailabilityZone) bool {
+		return !az.ZoneState.Available
+	}), nil
+}
 
-	for _, az := range result {
-		if !az.ZoneState.Available {
-			continue
-		}
+// AvailabilityZoneComputeHosts returns the number of compute hosts in an availability
+// zone, or nil if the information is not visible to the user.
+func AvailabilityZoneComputeHosts(az *availabilityzones.AvailabilityZone) *int {
+	if az.Hosts == nil {
+		return nil
+	}
+
+	var count int
 
-		filtered = append(filtered, az)
+	for _, services := range az.Hosts {
+		if _, ok := services[computeServiceName]; ok {
+			count++
+		}
 	}
 
-	return filtered, nil
+	return &count
 }
 
 // ListServerGroups returns all server groups in the project.
@@ -378,3 +465,43 @@ func (c *ComputeClient) CreateServerGroup(ctx context.Context, name, policy stri
 
 	return servergroups.Create(c.client, opts).Extract()
 }
+
+// ListServers returns all servers in the project.
+func (c *ComputeClient) ListServers(ctx context.Context) ([]servers.Server, error) {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/compute/v2/servers/detail", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	page, err := servers.List(c.client, &servers.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	return servers.ExtractServers(page)
+}
+
+// DeleteServer deletes the server.
+func (c *ComputeClient) DeleteServer(ctx context.Context, id string) error {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/compute/v2/servers/"+id, trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	return servers.Delete(c.client, id).ExtractErr()
+}
+
+// QuotaDetail returns compute quota limits and usage for the project.
+func (c *ComputeClient) QuotaDetail(ctx context.Context, projectID string) (*quotasets.QuotaDetailSet, error) {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/compute/v2/os-quota-sets", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	result, err := quotasets.GetDetail(c.client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
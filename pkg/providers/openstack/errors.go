@@ -17,11 +17,14 @@ limitations under the License.
 package openstack
 
 import (
-	"errors"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
 )
 
 var (
 	// ErrResourceNotFound is returned when a named resource cannot
 	// be looked up (we have to do it ourselves) and it cannot be found.
-	ErrResourceNotFound = errors.New("requested resource not found")
+	ErrResourceNotFound = unikornerrors.NotFound("requested resource not found")
+
+	// ErrCloudNotFound is returned when a cloud is not defined in clouds.yaml.
+	ErrCloudNotFound = unikornerrors.NotFound("cloud not found in clouds.yaml")
 )
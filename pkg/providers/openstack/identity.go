@@ -23,6 +23,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
 	"go.opentelemetry.io/otel"
@@ -133,7 +134,7 @@ func (o *CreateTokenOptionsScopedToken) Options() *tokens.AuthOptions {
 }
 
 // CreateToken issues a new token.
-func (c *IdentityClient) CreateToken(ctx context.Context, options CreateTokenOptions) (*tokens.Token, *tokens.User, error) {
+func (c *IdentityClient) CreateToken(ctx context.Context, options CreateTokenOptions) (*tokens.Token, *tokens.User, []tokens.Role, error) {
 	tracer := otel.GetTracerProvider().Tracer(constants.Application)
 
 	_, span := tracer.Start(ctx, "/identity/v3/auth/tokens", trace.WithSpanKind(trace.SpanKindClient))
@@ -143,15 +144,32 @@ func (c *IdentityClient) CreateToken(ctx context.Context, options CreateTokenOpt
 
 	token, err := result.ExtractToken()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	user, err := result.ExtractUser()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return token, user, nil
+	// Roles are only present for scoped tokens.
+	roles, err := result.ExtractRoles()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return token, user, roles, nil
+}
+
+// GetTokenRoles returns the roles granted to a scoped token, including any that
+// are implied by those directly assigned.
+func (c *IdentityClient) GetTokenRoles(ctx context.Context, token string) ([]tokens.Role, error) {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/identity/v3/auth/tokens", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	return tokens.Get(c.client, token).ExtractRoles()
 }
 
 // ListAvailableProjects lists projects that an authenticated (but unscoped) user can
@@ -241,3 +259,90 @@ func (c *IdentityClient) GetUser(ctx context.Context, userID string) (*users.Use
 
 	return users.Get(c.client, userID).Extract()
 }
+
+// FindUser looks up a user by name in the given domain.
+func (c *IdentityClient) FindUser(ctx context.Context, domainID, name string) ([]users.User, error) {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/identity/v3/users", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	opts := &users.ListOpts{
+		DomainID: domainID,
+		Name:     name,
+	}
+
+	page, err := users.List(c.client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	return users.ExtractUsers(page)
+}
+
+// ListRoles lists all roles.
+func (c *IdentityClient) ListRoles(ctx context.Context) ([]roles.Role, error) {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/identity/v3/roles", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	page, err := roles.List(c.client, nil).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	return roles.ExtractRoles(page)
+}
+
+// ListProjectRoleAssignments lists user role assignments on a project.
+func (c *IdentityClient) ListProjectRoleAssignments(ctx context.Context, projectID string) ([]roles.RoleAssignment, error) {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/identity/v3/role_assignments", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	includeNames := true
+
+	opts := &roles.ListAssignmentsOpts{
+		ScopeProjectID: projectID,
+		IncludeNames:   &includeNames,
+	}
+
+	page, err := roles.ListAssignments(c.client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	return roles.ExtractRoleAssignments(page)
+}
+
+// AssignProjectRole grants a user a role on a project.
+func (c *IdentityClient) AssignProjectRole(ctx context.Context, projectID, userID, roleID string) error {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/identity/v3/projects/"+projectID+"/users/"+userID+"/roles/"+roleID, trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	opts := roles.AssignOpts{
+		UserID:    userID,
+		ProjectID: projectID,
+	}
+
+	return roles.Assign(c.client, roleID, opts).ExtractErr()
+}
+
+// UnassignProjectRole revokes a user's role on a project.
+func (c *IdentityClient) UnassignProjectRole(ctx context.Context, projectID, userID, roleID string) error {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/identity/v3/projects/"+projectID+"/users/"+userID+"/roles/"+roleID, trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	opts := roles.UnassignOpts{
+		UserID:    userID,
+		ProjectID: projectID,
+	}
+
+	return roles.Unassign(c.client, roleID, opts).ExtractErr()
+}
@@ -27,10 +27,10 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
-	"golang.org/x/exp/slices"
+
+	"github.com/eschercloudai/unikorn/pkg/collections"
 
 	"github.com/eschercloudai/unikorn-core/pkg/constants"
-	"github.com/eschercloudai/unikorn-core/pkg/util"
 )
 
 // ImageClient wraps the generic client because gophercloud is unsafe.
@@ -59,7 +59,7 @@ func NewImageClient(provider Provider) (*ImageClient, error) {
 
 func validateProperties(image *images.Image, required []string) bool {
 	for _, r := range required {
-		if !slices.Contains(util.Keys(image.Properties), r) {
+		if _, ok := image.Properties[r]; !ok {
 			return false
 		}
 	}
@@ -117,27 +117,17 @@ func (c *ImageClient) Images(ctx context.Context, key *ecdsa.PublicKey, properti
 	}
 
 	// Filter out images that aren't compatible.
-	filtered := []images.Image{}
-
-	for i := range result {
-		image := result[i]
-
+	filtered := collections.Filter(result, func(image images.Image) bool {
 		if image.Status != "active" {
-			continue
-		}
-
-		if properties != nil {
-			if !validateProperties(&image, properties) {
-				continue
-			}
+			return false
 		}
 
-		if !verifyImage(&image, key) {
-			continue
+		if properties != nil && !validateProperties(&image, properties) {
+			return false
 		}
 
-		filtered = append(filtered, image)
-	}
+		return verifyImage(&image, key)
+	})
 
 	return filtered, nil
 }
@@ -22,7 +22,9 @@ import (
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 
@@ -75,3 +77,23 @@ func (c *NetworkClient) ExternalNetworks(ctx context.Context) ([]networks.Networ
 
 	return results, nil
 }
+
+// Subnet returns the subnet with the given ID.
+func (c *NetworkClient) Subnet(ctx context.Context, id string) (*subnets.Subnet, error) {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/networking/v2.0/subnets/"+id, trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	return subnets.Get(c.client, id).Extract()
+}
+
+// QuotaDetail returns network quota limits and usage for the project.
+func (c *NetworkClient) QuotaDetail(ctx context.Context, projectID string) (*quotas.QuotaDetailSet, error) {
+	tracer := otel.GetTracerProvider().Tracer(constants.Application)
+
+	_, span := tracer.Start(ctx, "/networking/v2.0/quotas", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	return quotas.GetDetail(c.client, projectID).Extract()
+}
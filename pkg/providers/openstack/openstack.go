@@ -17,21 +17,35 @@ limitations under the License.
 package openstack
 
 import (
+	"fmt"
+	"net/http"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
 	"github.com/gophercloud/utils/openstack/clientconfig"
+
+	"sigs.k8s.io/yaml"
 )
 
 // authenticatedClient returns a provider client used to initialize service clients.
-func authenticatedClient(options gophercloud.AuthOptions) (*gophercloud.ProviderClient, error) {
+// The transport is optional, and if nil the default is used.
+func authenticatedClient(options gophercloud.AuthOptions, transport http.RoundTripper) (*gophercloud.ProviderClient, error) {
 	// TODO: the JWT token issuer will cap the expiry at that of the
 	// keystone token, so we shouldn't get an unauthorized error.  Just
 	// as well as we cannot disambiguate from what gophercloud returns.
-	client, err := openstack.AuthenticatedClient(options)
+	client, err := openstack.NewClient(options.IdentityEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
+	if transport != nil {
+		client.HTTPClient.Transport = transport
+	}
+
+	if err := openstack.Authenticate(client, options); err != nil {
+		return nil, err
+	}
+
 	return client, nil
 }
 
@@ -49,6 +63,9 @@ type TokenProvider struct {
 
 	// token is an Openstack authorization token.
 	token string
+
+	// transport optionally overrides the HTTP transport.
+	transport http.RoundTripper
 }
 
 // Ensure the interface is implemented.
@@ -69,7 +86,15 @@ func (p *TokenProvider) Client() (*gophercloud.ProviderClient, error) {
 		TokenID:          p.token,
 	}
 
-	return authenticatedClient(options)
+	return authenticatedClient(options, p.transport)
+}
+
+// WithTransport overrides the HTTP transport used by clients, for example to
+// inspect requests and responses.
+func (p *TokenProvider) WithTransport(transport http.RoundTripper) *TokenProvider {
+	p.transport = transport
+
+	return p
 }
 
 // CloudsProvider cretes a client from clouds.yaml.
@@ -99,7 +124,64 @@ func (p *CloudsProvider) Client() (*gophercloud.ProviderClient, error) {
 		return nil, err
 	}
 
-	return authenticatedClient(*options)
+	return authenticatedClient(*options, nil)
+}
+
+// CloudConfigProvider creates a client from a clouds.yaml file held in memory,
+// for example the one stored with a cluster.
+type CloudConfigProvider struct {
+	// cloud is the cloud selected from clouds.yaml.
+	cloud *clientconfig.Cloud
+
+	// transport optionally overrides the HTTP transport.
+	transport http.RoundTripper
+}
+
+// Ensure the interface is implemented.
+var _ Provider = &CloudConfigProvider{}
+
+// NewCloudConfigProvider returns a new initialized provider.
+func NewCloudConfigProvider(cloudConfig []byte, cloud string) (*CloudConfigProvider, error) {
+	var clouds clientconfig.Clouds
+
+	if err := yaml.Unmarshal(cloudConfig, &clouds); err != nil {
+		return nil, err
+	}
+
+	c, ok := clouds.Clouds[cloud]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrCloudNotFound, cloud)
+	}
+
+	provider := &CloudConfigProvider{
+		cloud: &c,
+	}
+
+	return provider, nil
+}
+
+// WithTransport overrides the HTTP transport used by clients, for example to
+// trust a private CA.
+func (p *CloudConfigProvider) WithTransport(transport http.RoundTripper) *CloudConfigProvider {
+	p.transport = transport
+
+	return p
+}
+
+// Client implements the Provider interface.
+func (p *CloudConfigProvider) Client() (*gophercloud.ProviderClient, error) {
+	clientOpts := &clientconfig.ClientOpts{
+		AuthType:   p.cloud.AuthType,
+		AuthInfo:   p.cloud.AuthInfo,
+		RegionName: p.cloud.RegionName,
+	}
+
+	options, err := clientconfig.AuthOptions(clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return authenticatedClient(*options, p.transport)
 }
 
 // UnauthenticatedProvider is used for token issue.
@@ -0,0 +1,96 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calico
+
+import (
+	"context"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners/util"
+)
+
+const (
+	// BlockSize is the size of the pod prefix allocated to each node, and
+	// therefore the smallest pod network that can be used.
+	BlockSize = 26
+
+	// BlockSizeIPv6 is the size of the IPv6 pod prefix allocated to each node.
+	BlockSizeIPv6 = 122
+)
+
+// New returns a new initialized provisioner object.
+func New(getApplication application.GetterFunc) *application.Provisioner {
+	provisioner := &Provisioner{}
+
+	return application.New(getApplication).WithGenerator(provisioner).InNamespace("tigera-operator")
+}
+
+type Provisioner struct{}
+
+// Ensure the Provisioner interface is implemented.
+var _ application.ValuesGenerator = &Provisioner{}
+
+func (p *Provisioner) Values(ctx context.Context, _ *string) (interface{}, error) {
+	//nolint:forcetypeassert
+	cluster := application.FromContext(ctx).(*unikornv1.KubernetesCluster)
+
+	ipPools := make([]interface{}, 0, 2)
+
+	// Use VXLAN encapsulation as OpenStack port security will drop traffic
+	// from pod addresses, and BGP peering isn't available.
+	for _, prefix := range cluster.PodNetworks() {
+		blockSize := BlockSize
+
+		if prefix.IsIPv6() {
+			blockSize = BlockSizeIPv6
+		}
+
+		ipPools = append(ipPools, map[string]interface{}{
+			"cidr":          prefix.IPNet.String(),
+			"blockSize":     blockSize,
+			"encapsulation": "VXLAN",
+			"natOutgoing":   "Enabled",
+			"nodeSelector":  "all()",
+		})
+	}
+
+	calicoNetwork := map[string]interface{}{
+		"ipPools": ipPools,
+	}
+
+	// Node addresses are only detected for IPv4 by default.
+	if cluster.IPv6Enabled() {
+		calicoNetwork["nodeAddressAutodetectionV6"] = map[string]interface{}{
+			"firstFound": true,
+		}
+	}
+
+	// Scale to zero support.
+	values := map[string]interface{}{
+		"nodeSelector": util.ControlPlaneNodeSelector(),
+		"tolerations":  util.ControlPlaneTolerations(),
+		"installation": map[string]interface{}{
+			"controlPlaneNodeSelector": util.ControlPlaneNodeSelector(),
+			"controlPlaneTolerations":  util.ControlPlaneTolerations(),
+			"calicoNetwork":            calicoNetwork,
+		},
+	}
+
+	return values, nil
+}
@@ -25,6 +25,16 @@ import (
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners/util"
 )
 
+const (
+	// NodeMaskSize is the size of the pod prefix allocated to each node, and
+	// therefore the smallest pod network that can be used.
+	NodeMaskSize = 24
+
+	// NodeMaskSizeIPv6 is the size of the IPv6 pod prefix allocated to each
+	// node.
+	NodeMaskSizeIPv6 = 120
+)
+
 // New returns a new initialized provisioner object.
 func New(getApplication application.GetterFunc) *application.Provisioner {
 	provisioner := &Provisioner{}
@@ -66,5 +76,32 @@ func (p *Provisioner) Values(ctx context.Context, _ *string) (interface{}, error
 		},
 	}
 
+	// IPv4 addresses are allocated from Cilium's default cluster pool, IPv6
+	// ones need to be explicitly configured.
+	if cluster.IPv6Enabled() {
+		values["ipv4"] = map[string]interface{}{
+			"enabled": cluster.IPv4Enabled(),
+		}
+
+		values["ipv6"] = map[string]interface{}{
+			"enabled": true,
+		}
+
+		for _, prefix := range cluster.PodNetworks() {
+			if !prefix.IsIPv6() {
+				continue
+			}
+
+			values["ipam"] = map[string]interface{}{
+				"operator": map[string]interface{}{
+					"clusterPoolIPv6PodCIDRList": []interface{}{
+						prefix.IPNet.String(),
+					},
+					"clusterPoolIPv6MaskSize": NodeMaskSizeIPv6,
+				},
+			}
+		}
+	}
+
 	return values, nil
 }
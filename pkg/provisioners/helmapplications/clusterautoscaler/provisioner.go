@@ -18,6 +18,7 @@ package clusterautoscaler
 
 import (
 	"context"
+	"strconv"
 
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/clusteropenstack"
@@ -25,6 +26,14 @@ import (
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
 )
 
+const (
+	// defaultScaleDownDelayAfterAdd is used when the user doesn't specify one.
+	defaultScaleDownDelayAfterAdd = "5m"
+
+	// defaultScaleDownUnneededTime is used when the user doesn't specify one.
+	defaultScaleDownUnneededTime = "5m"
+)
+
 // Provisioner encapsulates provisioning.
 type Provisioner struct{}
 
@@ -43,9 +52,40 @@ func (p *Provisioner) Parameters(ctx context.Context, version *string) (map[stri
 	cluster := application.FromContext(ctx).(*unikornv1.KubernetesCluster)
 
 	parameters := map[string]string{
-		"autoDiscovery.clusterName":  clusteropenstack.CAPIClusterName(cluster),
-		"clusterAPIKubeconfigSecret": clusteropenstack.KubeconfigSecretName(cluster),
+		"autoDiscovery.clusterName":            clusteropenstack.CAPIClusterName(cluster),
+		"clusterAPIKubeconfigSecret":           clusteropenstack.KubeconfigSecretName(cluster),
+		"extraArgs.scale-down-delay-after-add": defaultScaleDownDelayAfterAdd,
+		"extraArgs.scale-down-unneeded-time":   defaultScaleDownUnneededTime,
+	}
+
+	if cluster.Spec.Features != nil {
+		addTuningParameters(parameters, cluster.Spec.Features.AutoscalingConfiguration)
 	}
 
 	return parameters, nil
 }
+
+// addTuningParameters overrides the application's default autoscaler
+// behaviour with any user specified values.
+func addTuningParameters(parameters map[string]string, config *unikornv1.ClusterAutoscalerSpec) {
+	if config == nil {
+		return
+	}
+
+	if config.ScaleDownDelayAfterAdd != nil {
+		parameters["extraArgs.scale-down-delay-after-add"] = config.ScaleDownDelayAfterAdd.Duration.String()
+	}
+
+	if config.ScaleDownUnneededTime != nil {
+		parameters["extraArgs.scale-down-unneeded-time"] = config.ScaleDownUnneededTime.Duration.String()
+	}
+
+	// The autoscaler expects a ratio, not a percentage.
+	if config.ScaleDownUtilizationThreshold != nil {
+		parameters["extraArgs.scale-down-utilization-threshold"] = strconv.FormatFloat(float64(*config.ScaleDownUtilizationThreshold)/100, 'f', -1, 64)
+	}
+
+	if config.Expander != nil {
+		parameters["extraArgs.expander"] = string(*config.Expander)
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteropenstack
+
+import (
+	"encoding/base64"
+	"slices"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+)
+
+// BootstrapFile is a file written to a node by cloud-init.
+type BootstrapFile struct {
+	// Path is the absolute path of the file.
+	Path string
+
+	// Content is the file contents.
+	Content []byte
+
+	// Sensitive is set for files supplied by the user, these may contain
+	// secrets e.g. registry credentials.
+	Sensitive bool
+
+	// Secret is set when the content is read from a secret when a node is
+	// created, so is unknown.
+	Secret string
+}
+
+// WorkloadPoolBootstrap is what we contribute to a workload pool's kubeadm
+// configuration, which is in turn rendered into the cloud-init user data that
+// its nodes boot with.
+type WorkloadPoolBootstrap struct {
+	// Labels are applied to the node when it joins the cluster.
+	Labels map[string]string
+
+	// Taints are applied to the node when it joins the cluster.
+	Taints []unikornv1.Taint
+
+	// PreKubeadmCommands are run before the node joins the cluster.
+	PreKubeadmCommands []string
+
+	// PostKubeadmCommands are run after the node joins the cluster.
+	PostKubeadmCommands []string
+
+	// Files are written before any commands are run.
+	Files []BootstrapFile
+
+	// KubeletExtraArgs are passed to the kubelet.
+	KubeletExtraArgs map[string]string
+}
+
+// NewWorkloadPoolBootstrap returns the bootstrap data for a workload pool.
+func NewWorkloadPoolBootstrap(cluster *unikornv1.KubernetesCluster, workloadPool *unikornv1.KubernetesClusterWorkloadPoolsPoolSpec) *WorkloadPoolBootstrap {
+	// Images are pulled after any user commands, as these may configure
+	// registry mirrors or credentials.
+	preKubeadmCommands := slices.Clone(workloadPool.PreKubeadmCommands)
+
+	for _, image := range workloadPool.PrePullImages {
+		preKubeadmCommands = append(preKubeadmCommands, "crictl pull "+image)
+	}
+
+	files := make([]BootstrapFile, 0, len(workloadPool.Files))
+
+	for _, file := range workloadPool.Files {
+		files = append(files, BootstrapFile{
+			Path:      *file.Path,
+			Content:   file.Content,
+			Sensitive: true,
+		})
+	}
+
+	files = append(files, generateSSHCertificateAuthorityFiles(cluster)...)
+
+	bootstrap := &WorkloadPoolBootstrap{
+		Labels:              generateWorkloadPoolLabels(cluster, workloadPool),
+		Taints:              workloadPool.Taints,
+		PreKubeadmCommands:  preKubeadmCommands,
+		PostKubeadmCommands: workloadPool.PostKubeadmCommands,
+		Files:               files,
+	}
+
+	if workloadPool.NodeConfiguration != nil {
+		bootstrap.KubeletExtraArgs = generateKubeletExtraArgs(workloadPool.NodeConfiguration)
+	}
+
+	return bootstrap
+}
+
+// generateWorkloadPoolLabels returns the labels applied to a workload pool's nodes.
+// When cost allocation is enabled, nodes are additionally labelled with their
+// ownership so spend can be attributed, user supplied labels take precedence.
+func generateWorkloadPoolLabels(cluster *unikornv1.KubernetesCluster, workloadPool *unikornv1.KubernetesClusterWorkloadPoolsPoolSpec) map[string]string {
+	if !cluster.CostAllocationEnabled() {
+		return workloadPool.Labels
+	}
+
+	labels := map[string]string{
+		constants.ProjectLabel:           cluster.Labels[constants.ProjectLabel],
+		constants.ControlPlaneLabel:      cluster.Labels[constants.ControlPlaneLabel],
+		constants.KubernetesClusterLabel: cluster.Name,
+		constants.WorkloadPoolLabel:      workloadPool.Name,
+	}
+
+	for key, value := range workloadPool.Labels {
+		labels[key] = value
+	}
+
+	return labels
+}
+
+// generateHelmValues adds the bootstrap data to a workload pool's Helm values.
+func (b *WorkloadPoolBootstrap) generateHelmValues(object map[string]interface{}) {
+	if len(b.Labels) != 0 {
+		labels := map[string]interface{}{}
+
+		for key, value := range b.Labels {
+			labels[key] = value
+		}
+
+		object["labels"] = labels
+	}
+
+	if len(b.Taints) != 0 {
+		taints := make([]interface{}, len(b.Taints))
+
+		for i, taint := range b.Taints {
+			taints[i] = map[string]interface{}{
+				"key":    taint.Key,
+				"value":  taint.Value,
+				"effect": string(taint.Effect),
+			}
+		}
+
+		object["taints"] = taints
+	}
+
+	if len(b.PreKubeadmCommands) != 0 {
+		object["preKubeadmCommands"] = b.PreKubeadmCommands
+	}
+
+	if len(b.PostKubeadmCommands) != 0 {
+		object["postKubeadmCommands"] = b.PostKubeadmCommands
+	}
+
+	if len(b.Files) != 0 {
+		files := make([]interface{}, len(b.Files))
+
+		for i, file := range b.Files {
+			files[i] = map[string]interface{}{
+				"path":    file.Path,
+				"content": base64.StdEncoding.EncodeToString(file.Content),
+			}
+		}
+
+		object["files"] = files
+	}
+
+	if len(b.KubeletExtraArgs) != 0 {
+		args := map[string]interface{}{}
+
+		for key, value := range b.KubeletExtraArgs {
+			args[key] = value
+		}
+
+		object["kubeletExtraArgs"] = args
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteropenstack
+
+import (
+	"context"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+
+	"github.com/eschercloudai/unikorn-core/pkg/cd"
+	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	// ErrControlPlaneMissing is returned when we expect to find a kubeadm control
+	// plane for the cluster, but can't.
+	ErrControlPlaneMissing = unikornerrors.NotFound("unable to locate expected control plane")
+)
+
+// apiCertificateReissueComplete returns true if the control plane has been fully
+// rolled since the reissue was requested.
+func apiCertificateReissueComplete(controlPlane *unstructured.Unstructured, status *unikornv1.APICertificateStatus) bool {
+	observedGeneration, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "observedGeneration")
+	if observedGeneration != controlPlane.GetGeneration() {
+		return false
+	}
+
+	replicas, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "replicas")
+
+	return status.UpdatedReplicas == status.Replicas && int(replicas) == status.Replicas
+}
+
+// reissueAPICertificate handles API server certificate reissue requests.  Certificates
+// are generated by kubeadm when a control plane machine is created, so the only way
+// to reliably pick up new SANs is to replace the machines, which cluster API will do
+// for us when it is told to roll out after a given time.  Progress is recorded
+// in the cluster status, and we yield until the roll out is complete.
+func (p *Provisioner) reissueAPICertificate(ctx context.Context) error {
+	if cd.FromContext(ctx).Kind() != cd.DriverKindArgoCD {
+		return nil
+	}
+
+	log := log.FromContext(ctx)
+
+	//nolint:forcetypeassert
+	cluster := application.FromContext(ctx).(*unikornv1.KubernetesCluster)
+
+	if cluster.Spec.API == nil || cluster.Spec.API.CertificateReissueTime == nil {
+		return nil
+	}
+
+	reissueTime := *cluster.Spec.API.CertificateReissueTime
+
+	if status := cluster.Status.APICertificate; status != nil && status.ReissueTime.Equal(&reissueTime) && status.CompletionTime != nil {
+		return nil
+	}
+
+	c := coreclient.DynamicClientFromContext(ctx)
+
+	controlPlanes, err := p.getKubeadmControlPlanes(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	if len(controlPlanes) != 1 {
+		return ErrControlPlaneMissing
+	}
+
+	controlPlane := &controlPlanes[0]
+
+	rolloutAfter := reissueTime.UTC().Format(time.RFC3339)
+
+	if current, _, _ := unstructured.NestedString(controlPlane.Object, "spec", "rolloutAfter"); current != rolloutAfter {
+		log.Info("reissuing api certificate", "rolloutAfter", rolloutAfter)
+
+		temp := controlPlane.DeepCopy()
+
+		if err := unstructured.SetNestedField(temp.Object, rolloutAfter, "spec", "rolloutAfter"); err != nil {
+			return err
+		}
+
+		if err := c.Patch(ctx, temp, client.MergeFrom(controlPlane)); err != nil {
+			return err
+		}
+
+		controlPlane = temp
+	}
+
+	replicas, _, _ := unstructured.NestedInt64(controlPlane.Object, "spec", "replicas")
+	updatedReplicas, _, _ := unstructured.NestedInt64(controlPlane.Object, "status", "updatedReplicas")
+
+	status := &unikornv1.APICertificateStatus{
+		ReissueTime:     reissueTime,
+		Replicas:        int(replicas),
+		UpdatedReplicas: int(updatedReplicas),
+	}
+
+	cluster.Status.APICertificate = status
+
+	if !apiCertificateReissueComplete(controlPlane, status) {
+		log.Info("awaiting api certificate reissue", "replicas", status.Replicas, "updated", status.UpdatedReplicas)
+
+		return provisioners.ErrYield
+	}
+
+	now := metav1.Now()
+	status.CompletionTime = &now
+
+	return nil
+}
@@ -14,16 +14,11 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package util
+package clusteropenstack
 
-import (
-	"fmt"
-	"os"
+// Export internals for testing.
+//
+//nolint:gochecknoglobals
+var (
+	ReleaseName = releaseName
 )
-
-func AssertNilError(err error) {
-	if err != nil {
-		fmt.Println(err)
-		os.Exit(1)
-	}
-}
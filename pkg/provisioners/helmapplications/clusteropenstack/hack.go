@@ -18,10 +18,10 @@ package clusteropenstack
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
 
 	"github.com/eschercloudai/unikorn-core/pkg/cd"
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
@@ -38,11 +38,11 @@ var (
 	// for a workload pool, but can't.  This is an indication that Argo hasn't yet
 	// sychronized the resources correctly.  We risk a race otherwise where it gets
 	// created when we check for existence, it gets created, and we immediately delete it.
-	ErrWorkloadPoolMissing = errors.New("unable to locate expected workload pool")
+	ErrWorkloadPoolMissing = unikornerrors.NotFound("unable to locate expected workload pool")
 )
 
 // filterOwnedResources removes any resources that aren't owned by the cluster.
-func (p *Provisioner) filterOwnedResources(cluster *unikornv1.KubernetesCluster, resources []unstructured.Unstructured) []unstructured.Unstructured {
+func filterOwnedResources(cluster *unikornv1.KubernetesCluster, resources []unstructured.Unstructured) []unstructured.Unstructured {
 	var filtered []unstructured.Unstructured
 
 	for _, resource := range resources {
@@ -66,6 +66,12 @@ func (p *Provisioner) getOwnedResource(ctx context.Context, c client.Client, api
 	//nolint:forcetypeassert
 	cluster := application.FromContext(ctx).(*unikornv1.KubernetesCluster)
 
+	return listOwnedResources(ctx, c, cluster, apiVersion, kind)
+}
+
+// listOwnedResources returns resources of the specified API version/kind that belong
+// to the cluster.
+func listOwnedResources(ctx context.Context, c client.Client, cluster *unikornv1.KubernetesCluster, apiVersion, kind string) ([]unstructured.Unstructured, error) {
 	objects := &unstructured.UnstructuredList{
 		Object: map[string]interface{}{
 			"apiVersion": apiVersion,
@@ -81,7 +87,7 @@ func (p *Provisioner) getOwnedResource(ctx context.Context, c client.Client, api
 		return nil, err
 	}
 
-	return p.filterOwnedResources(cluster, objects.Items), nil
+	return filterOwnedResources(cluster, objects.Items), nil
 }
 
 // getMachineDeployments gets all live machine deployments for the cluster.
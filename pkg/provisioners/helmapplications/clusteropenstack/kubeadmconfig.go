@@ -0,0 +1,255 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteropenstack
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+	"github.com/eschercloudai/unikorn/pkg/logging"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// redactedFields are kubeadm configuration fields that contain credentials.
+// Join tokens are normally added by Cluster API when a node is created, but
+// may be set in the template.
+//
+//nolint:gochecknoglobals
+var redactedFields = [][]string{
+	{"joinConfiguration", "discovery", "bootstrapToken", "token"},
+	{"joinConfiguration", "discovery", "tlsBootstrapToken"},
+	{"joinConfiguration", "discovery", "file", "kubeConfig", "user"},
+	{"ignition", "containerLinuxConfig", "additionalConfig"},
+}
+
+// WorkloadPoolKubeadmConfig is a workload pool's effective kubeadm configuration,
+// as rendered by the cluster chart into a Cluster API KubeadmConfigTemplate.
+// Cluster API renders this into the cloud-init user data nodes boot with, adding
+// a join token when each node is created.
+type WorkloadPoolKubeadmConfig struct {
+	// TemplateName is the name of the KubeadmConfigTemplate.
+	TemplateName string
+
+	// Files are written before any commands are run.  Only files generated
+	// by us, that are known not to contain secrets, are marked as not
+	// sensitive.
+	Files []BootstrapFile
+
+	// PreKubeadmCommands are run before the node joins the cluster.
+	PreKubeadmCommands []string
+
+	// PostKubeadmCommands are run after the node joins the cluster.
+	PostKubeadmCommands []string
+
+	// Config is the remainder of the kubeadm configuration, with any
+	// credentials redacted.
+	Config map[string]interface{}
+}
+
+// decodeFileContent returns the content of a kubeadm file.
+func decodeFileContent(content, encoding string) ([]byte, error) {
+	data := []byte(content)
+
+	if encoding == "base64" || encoding == "gzip+base64" {
+		decoded, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, err
+		}
+
+		data = decoded
+	}
+
+	if encoding == "gzip" || encoding == "gzip+base64" {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+
+		defer reader.Close()
+
+		return io.ReadAll(reader)
+	}
+
+	return data, nil
+}
+
+// newBootstrapFile converts a kubeadm file into bootstrap data.  Files are
+// considered sensitive unless we generated them, and they are unmodified.
+func newBootstrapFile(file map[string]interface{}, known map[string][]byte) BootstrapFile {
+	path, _, _ := unstructured.NestedString(file, "path")
+
+	out := BootstrapFile{
+		Path:      path,
+		Sensitive: true,
+	}
+
+	// Content from secrets is only resolved when a node is created.
+	if secret, ok, _ := unstructured.NestedString(file, "contentFrom", "secret", "name"); ok {
+		out.Secret = secret
+
+		return out
+	}
+
+	content, _, _ := unstructured.NestedString(file, "content")
+	encoding, _, _ := unstructured.NestedString(file, "encoding")
+
+	// Content that cannot be decoded is still digested, as delivered.
+	data, err := decodeFileContent(content, encoding)
+	if err != nil {
+		out.Content = []byte(content)
+
+		return out
+	}
+
+	out.Content = data
+
+	if expected, ok := known[path]; ok && bytes.Equal(data, expected) {
+		out.Sensitive = false
+	}
+
+	return out
+}
+
+// redactKubeadmConfig removes any credentials from the kubeadm configuration.
+func redactKubeadmConfig(config map[string]interface{}) error {
+	for _, fields := range redactedFields {
+		if _, ok, _ := unstructured.NestedFieldNoCopy(config, fields...); !ok {
+			continue
+		}
+
+		if err := unstructured.SetNestedField(config, logging.Redacted, fields...); err != nil {
+			return err
+		}
+	}
+
+	tokens, _, _ := unstructured.NestedSlice(config, "initConfiguration", "bootstrapTokens")
+
+	for _, token := range tokens {
+		if tokenObject, ok := token.(map[string]interface{}); ok {
+			if _, ok := tokenObject["token"]; ok {
+				tokenObject["token"] = logging.Redacted
+			}
+		}
+	}
+
+	if len(tokens) != 0 {
+		if err := unstructured.SetNestedSlice(config, tokens, "initConfiguration", "bootstrapTokens"); err != nil {
+			return err
+		}
+	}
+
+	users, _, _ := unstructured.NestedSlice(config, "users")
+
+	for _, user := range users {
+		if userObject, ok := user.(map[string]interface{}); ok {
+			if _, ok := userObject["passwd"]; ok {
+				userObject["passwd"] = logging.Redacted
+			}
+		}
+	}
+
+	if len(users) != 0 {
+		config["users"] = users
+	}
+
+	return nil
+}
+
+// newWorkloadPoolKubeadmConfig converts a KubeadmConfigTemplate into the
+// effective configuration, redacting anything that may contain secrets.
+func newWorkloadPoolKubeadmConfig(template *unstructured.Unstructured, bootstrap *WorkloadPoolBootstrap) (*WorkloadPoolKubeadmConfig, error) {
+	config, _, err := unstructured.NestedMap(template.Object, "spec", "template", "spec")
+	if err != nil {
+		return nil, err
+	}
+
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+
+	known := map[string][]byte{}
+
+	for _, file := range bootstrap.Files {
+		if !file.Sensitive {
+			known[file.Path] = file.Content
+		}
+	}
+
+	out := &WorkloadPoolKubeadmConfig{
+		TemplateName: template.GetName(),
+	}
+
+	files, _, _ := unstructured.NestedSlice(config, "files")
+
+	for _, file := range files {
+		if fileObject, ok := file.(map[string]interface{}); ok {
+			out.Files = append(out.Files, newBootstrapFile(fileObject, known))
+		}
+	}
+
+	out.PreKubeadmCommands, _, _ = unstructured.NestedStringSlice(config, "preKubeadmCommands")
+	out.PostKubeadmCommands, _, _ = unstructured.NestedStringSlice(config, "postKubeadmCommands")
+
+	delete(config, "files")
+	delete(config, "preKubeadmCommands")
+	delete(config, "postKubeadmCommands")
+
+	if err := redactKubeadmConfig(config); err != nil {
+		return nil, err
+	}
+
+	out.Config = config
+
+	return out, nil
+}
+
+// GetWorkloadPoolKubeadmConfig returns a workload pool's effective kubeadm
+// configuration.  The client must be for the control plane, and the template is
+// located in the same way the provisioner does when cleaning up after Argo.
+func GetWorkloadPoolKubeadmConfig(ctx context.Context, c client.Client, cluster *unikornv1.KubernetesCluster, workloadPool *unikornv1.KubernetesClusterWorkloadPoolsPoolSpec) (*WorkloadPoolKubeadmConfig, error) {
+	// TODO: this is flaky as hell, due to hard coded versions, needs a fix upstream.
+	deployments, err := listOwnedResources(ctx, c, cluster, "cluster.x-k8s.io/v1beta1", "MachineDeployment")
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := machineDeploymentForWorkloadPool(deployments, workloadPool.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	name := getExpectedKubeadmConfigTemplateNames([]unstructured.Unstructured{*deployment})[0]
+
+	template := &unstructured.Unstructured{}
+	template.SetAPIVersion("bootstrap.cluster.x-k8s.io/v1beta1")
+	template.SetKind("KubeadmConfigTemplate")
+
+	if err := c.Get(ctx, client.ObjectKey{Namespace: cluster.Name, Name: name}, template); err != nil {
+		return nil, fmt.Errorf("%w: %s", unikornerrors.FromKubernetes(err), name)
+	}
+
+	return newWorkloadPoolKubeadmConfig(template, NewWorkloadPoolBootstrap(cluster, workloadPool))
+}
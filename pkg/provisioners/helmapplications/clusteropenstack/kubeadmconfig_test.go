@@ -0,0 +1,256 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteropenstack_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+	"github.com/eschercloudai/unikorn/pkg/logging"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/clusteropenstack"
+
+	"github.com/eschercloudai/unikorn-core/pkg/util"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	// templateName is the name of the workload pool's kubeadm config template.
+	templateName = "foo-default-abcde"
+
+	// secret is contained in a user supplied file, and must never be exposed.
+	secret = "password: hunter2\n"
+)
+
+//nolint:gochecknoglobals
+var (
+	machineDeploymentGVK = schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "MachineDeployment"}
+
+	kubeadmConfigTemplateGVK = schema.GroupVersionKind{Group: "bootstrap.cluster.x-k8s.io", Version: "v1beta1", Kind: "KubeadmConfigTemplate"}
+)
+
+// newCluster returns a cluster with a single workload pool, and a file that
+// contains secrets.
+func newCluster() *unikornv1.KubernetesCluster {
+	cluster := &unikornv1.KubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+			Labels: map[string]string{
+				constants.ControlPlaneLabel: "bar",
+			},
+		},
+		Spec: unikornv1.KubernetesClusterSpec{
+			SSHCertificateAuthority: &unikornv1.SSHCertificateAuthoritySpec{
+				PublicKey: "ssh-ed25519 AAAA",
+			},
+			WorkloadPools: &unikornv1.KubernetesClusterWorkloadPoolsSpec{
+				Pools: []unikornv1.KubernetesClusterWorkloadPoolsPoolSpec{
+					{},
+				},
+			},
+		},
+	}
+
+	pool := &cluster.Spec.WorkloadPools.Pools[0]
+	pool.Name = "default"
+	pool.PreKubeadmCommands = []string{"echo hello"}
+	pool.Files = []unikornv1.File{
+		{
+			Path:    util.ToPointer("/etc/registry/credentials"),
+			Content: []byte(secret),
+		},
+	}
+
+	return cluster
+}
+
+// newFile returns a base64 encoded kubeadm file, as rendered by the chart.
+func newFile(file clusteropenstack.BootstrapFile) interface{} {
+	return map[string]interface{}{
+		"path":     file.Path,
+		"encoding": "base64",
+		"content":  base64.StdEncoding.EncodeToString(file.Content),
+	}
+}
+
+// newObjects returns the resources the cluster chart would create for the
+// workload pool.
+func newObjects(cluster *unikornv1.KubernetesCluster) []client.Object {
+	deployment := &unstructured.Unstructured{}
+	deployment.SetGroupVersionKind(machineDeploymentGVK)
+	deployment.SetNamespace(cluster.Name)
+	deployment.SetName("foo-default")
+	deployment.SetAnnotations(map[string]string{
+		"pool.eschercloud.ai/name": "default",
+	})
+	deployment.SetOwnerReferences([]metav1.OwnerReference{
+		{
+			APIVersion: "cluster.x-k8s.io/v1beta1",
+			Kind:       "Cluster",
+			Name:       clusteropenstack.ReleaseName(cluster),
+		},
+	})
+
+	//nolint:errcheck
+	unstructured.SetNestedField(deployment.Object, templateName, "spec", "template", "spec", "bootstrap", "configRef", "name")
+
+	files := []interface{}{
+		map[string]interface{}{
+			"path": "/etc/kubernetes/cloud.conf",
+			"contentFrom": map[string]interface{}{
+				"secret": map[string]interface{}{
+					"name": "foo-cloud-config",
+					"key":  "cloud.conf",
+				},
+			},
+		},
+	}
+
+	for _, file := range clusteropenstack.NewWorkloadPoolBootstrap(cluster, &cluster.Spec.WorkloadPools.Pools[0]).Files {
+		files = append(files, newFile(file))
+	}
+
+	template := &unstructured.Unstructured{}
+	template.SetGroupVersionKind(kubeadmConfigTemplateGVK)
+	template.SetNamespace(cluster.Name)
+	template.SetName(templateName)
+
+	//nolint:errcheck
+	unstructured.SetNestedMap(template.Object, map[string]interface{}{
+		"files":              files,
+		"preKubeadmCommands": []interface{}{"echo hello"},
+		"joinConfiguration": map[string]interface{}{
+			"discovery": map[string]interface{}{
+				"bootstrapToken": map[string]interface{}{
+					"token": "abcdef.0123456789abcdef",
+				},
+			},
+			"nodeRegistration": map[string]interface{}{
+				"kubeletExtraArgs": map[string]interface{}{
+					"cloud-provider": "external",
+				},
+			},
+		},
+		"users": []interface{}{
+			map[string]interface{}{
+				"name":   "capi",
+				"passwd": "hunter2",
+			},
+		},
+	}, "spec", "template", "spec")
+
+	return []client.Object{deployment, template}
+}
+
+// newClient returns a fake control plane client with the given resources.
+func newClient(objects ...client.Object) client.Client {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(machineDeploymentGVK, meta.RESTScopeNamespace)
+	mapper.Add(kubeadmConfigTemplateGVK, meta.RESTScopeNamespace)
+
+	return fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithRESTMapper(mapper).WithObjects(objects...).Build()
+}
+
+// TestGetWorkloadPoolKubeadmConfig tests the effective configuration is read from
+// the control plane, with anything that may contain secrets redacted.
+func TestGetWorkloadPoolKubeadmConfig(t *testing.T) {
+	t.Parallel()
+
+	cluster := newCluster()
+
+	config, err := clusteropenstack.GetWorkloadPoolKubeadmConfig(context.Background(), newClient(newObjects(cluster)...), cluster, &cluster.Spec.WorkloadPools.Pools[0])
+	require.NoError(t, err)
+
+	assert.Equal(t, templateName, config.TemplateName)
+	assert.Equal(t, []string{"echo hello"}, config.PreKubeadmCommands)
+	assert.Len(t, config.Files, 4)
+
+	file := config.Files[0]
+	assert.Equal(t, "/etc/kubernetes/cloud.conf", file.Path)
+	assert.Equal(t, "foo-cloud-config", file.Secret)
+	assert.True(t, file.Sensitive)
+	assert.Nil(t, file.Content)
+
+	file = config.Files[1]
+	assert.Equal(t, "/etc/registry/credentials", file.Path)
+	assert.True(t, file.Sensitive)
+	assert.Equal(t, []byte(secret), file.Content)
+
+	file = config.Files[2]
+	assert.False(t, file.Sensitive)
+	assert.Equal(t, []byte("ssh-ed25519 AAAA\n"), file.Content)
+
+	assert.NotContains(t, config.Config, "files")
+	assert.NotContains(t, config.Config, "preKubeadmCommands")
+
+	token, _, _ := unstructured.NestedString(config.Config, "joinConfiguration", "discovery", "bootstrapToken", "token")
+	assert.Equal(t, logging.Redacted, token)
+
+	args, _, _ := unstructured.NestedStringMap(config.Config, "joinConfiguration", "nodeRegistration", "kubeletExtraArgs")
+	assert.Equal(t, map[string]string{"cloud-provider": "external"}, args)
+
+	users, _, _ := unstructured.NestedSlice(config.Config, "users")
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "capi", "passwd": logging.Redacted}}, users)
+}
+
+// TestGetWorkloadPoolKubeadmConfigModified tests files we generate are treated as
+// sensitive if their contents have been changed.
+func TestGetWorkloadPoolKubeadmConfigModified(t *testing.T) {
+	t.Parallel()
+
+	cluster := newCluster()
+
+	objects := newObjects(cluster)
+
+	cluster.Spec.SSHCertificateAuthority.PublicKey = "ssh-ed25519 BBBB"
+
+	config, err := clusteropenstack.GetWorkloadPoolKubeadmConfig(context.Background(), newClient(objects...), cluster, &cluster.Spec.WorkloadPools.Pools[0])
+	require.NoError(t, err)
+
+	assert.Len(t, config.Files, 4)
+	assert.True(t, config.Files[2].Sensitive)
+}
+
+// TestGetWorkloadPoolKubeadmConfigNotFound tests workload pools that are yet to be
+// provisioned are reported as not found.
+func TestGetWorkloadPoolKubeadmConfigNotFound(t *testing.T) {
+	t.Parallel()
+
+	cluster := newCluster()
+
+	_, err := clusteropenstack.GetWorkloadPoolKubeadmConfig(context.Background(), newClient(), cluster, &cluster.Spec.WorkloadPools.Pools[0])
+	assert.ErrorIs(t, err, unikornerrors.ErrNotFound)
+
+	objects := newObjects(cluster)
+
+	_, err = clusteropenstack.GetWorkloadPoolKubeadmConfig(context.Background(), newClient(objects[0]), cluster, &cluster.Spec.WorkloadPools.Pools[0])
+	assert.ErrorIs(t, err, unikornerrors.ErrNotFound)
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strconv"
+	"strings"
 
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
 
@@ -32,6 +34,14 @@ const (
 	// not deleted and recreated, which would be quite catastrophic for an entire
 	// Kubernetes cluster!
 	legacyApplicationName = "kubernetes-cluster"
+
+	// sshTrustedUserCAKeysPath is where the cluster's SSH CA public key is
+	// installed on nodes.
+	sshTrustedUserCAKeysPath = "/etc/ssh/trusted-user-ca-keys.pem"
+
+	// sshTrustedUserCAConfigPath is an sshd configuration drop in that enables
+	// trust of user certificates signed by the cluster's SSH CA.
+	sshTrustedUserCAConfigPath = "/etc/ssh/sshd_config.d/50-unikorn-ssh-ca.conf"
 )
 
 // Provisioner encapsulates control plane provisioning.
@@ -94,43 +104,138 @@ func (p *Provisioner) generateWorkloadPoolHelmValues(cluster *unikornv1.Kubernet
 	for i := range cluster.Spec.WorkloadPools.Pools {
 		workloadPool := &cluster.Spec.WorkloadPools.Pools[i]
 
+		// While the control plane is being upgraded, workload pools are held
+		// at whatever they were last deployed with.
+		machineGeneric := workloadPool.MachineGeneric
+
+		if cluster.ControlPlaneUpgrading() {
+			if deployed := cluster.DeployedWorkloadPool(workloadPool.Name); deployed != nil {
+				machineGeneric.Version = &deployed.Version
+				machineGeneric.Image = &deployed.Image
+			}
+		}
+
+		machine := p.generateMachineHelmValues(&machineGeneric, workloadPool.FailureDomain)
+
+		// Pool specific SSH keys override the global one, which is rendered
+		// into the OpenStack values.  An empty string removes access.
+		if workloadPool.SSHKeyName != nil {
+			machine["sshKeyName"] = *workloadPool.SSHKeyName
+		}
+
 		object := map[string]interface{}{
-			"version":  string(*workloadPool.Version),
+			"version":  string(*machineGeneric.Version),
 			"replicas": *workloadPool.Replicas,
-			"machine":  p.generateMachineHelmValues(&workloadPool.MachineGeneric, workloadPool.FailureDomain),
+			"machine":  machine,
 		}
 
 		if cluster.AutoscalingEnabled() && workloadPool.Autoscaling != nil {
 			object["autoscaling"] = generateWorkloadPoolSchedulerHelmValues(workloadPool)
 		}
 
-		if len(workloadPool.Labels) != 0 {
-			labels := map[string]interface{}{}
+		NewWorkloadPoolBootstrap(cluster, workloadPool).generateHelmValues(object)
 
-			for key, value := range workloadPool.Labels {
-				labels[key] = value
-			}
+		workloadPools[workloadPool.Name] = object
+	}
+
+	return workloadPools
+}
+
+// generateSSHCertificateAuthorityFiles returns files that configure sshd to trust
+// user certificates signed by the cluster's SSH CA, if one is defined.
+func generateSSHCertificateAuthorityFiles(cluster *unikornv1.KubernetesCluster) []BootstrapFile {
+	if cluster.Spec.SSHCertificateAuthority == nil {
+		return nil
+	}
+
+	config := "TrustedUserCAKeys " + sshTrustedUserCAKeysPath + "\n"
+
+	return []BootstrapFile{
+		{
+			Path:    sshTrustedUserCAKeysPath,
+			Content: []byte(cluster.Spec.SSHCertificateAuthority.PublicKey + "\n"),
+		},
+		{
+			Path:    sshTrustedUserCAConfigPath,
+			Content: []byte(config),
+		},
+	}
+}
+
+// generateNodeAllowListHelmValues translates the node allow list rules in the
+// given direction into security group rules, one per prefix, as expected by the
+// underlying Helm chart.
+func generateNodeAllowListHelmValues(allowList []unikornv1.NodeAllowListRule, direction unikornv1.NodeAllowListDirection) []interface{} {
+	rules := []interface{}{}
 
-			object["labels"] = labels
+	for i := range allowList {
+		rule := &allowList[i]
+
+		if rule.EffectiveDirection() != direction {
+			continue
 		}
 
-		if len(workloadPool.Files) != 0 {
-			files := make([]interface{}, len(workloadPool.Files))
+		portMax := rule.Port
 
-			for i, file := range workloadPool.Files {
-				files[i] = map[string]interface{}{
-					"path":    *file.Path,
-					"content": base64.StdEncoding.EncodeToString(file.Content),
-				}
-			}
+		if rule.PortMax != nil {
+			portMax = *rule.PortMax
+		}
 
-			object["files"] = files
+		for _, prefix := range rule.Prefixes {
+			rules = append(rules, map[string]interface{}{
+				"protocol": string(rule.Protocol),
+				"portMin":  rule.Port,
+				"portMax":  portMax,
+				"prefix":   prefix.IPNet.String(),
+			})
 		}
+	}
 
-		workloadPools[workloadPool.Name] = object
+	return rules
+}
+
+// generateReservedResources translates reserved resources into the comma separated
+// key/value format expected by the kubelet command line.
+func generateReservedResources(r *unikornv1.ReservedResources) string {
+	var resources []string
+
+	if r.CPU != nil {
+		resources = append(resources, "cpu="+r.CPU.String())
 	}
 
-	return workloadPools
+	if r.Memory != nil {
+		resources = append(resources, "memory="+r.Memory.String())
+	}
+
+	if r.EphemeralStorage != nil {
+		resources = append(resources, "ephemeral-storage="+r.EphemeralStorage.String())
+	}
+
+	return strings.Join(resources, ",")
+}
+
+// generateKubeletExtraArgs translates node configuration into kubelet arguments that
+// are rendered into the kubeadm join configuration.
+func generateKubeletExtraArgs(c *unikornv1.NodeConfiguration) map[string]string {
+	args := map[string]string{}
+
+	if c.MaxPods != nil {
+		args["max-pods"] = strconv.Itoa(*c.MaxPods)
+	}
+
+	if c.SystemReserved != nil {
+		if reserved := generateReservedResources(c.SystemReserved); reserved != "" {
+			args["system-reserved"] = reserved
+		}
+	}
+
+	if c.KubeReserved != nil {
+		if reserved := generateReservedResources(c.KubeReserved); reserved != "" {
+			args["kube-reserved"] = reserved
+		}
+	}
+
+	return args
 }
 
 // generateWorkloadPoolSchedulerHelmValues translates from Kubernetes API scheduling
@@ -204,6 +309,61 @@ func (p *Provisioner) Values(ctx context.Context, version *string) (interface{},
 		openstackValues["sshKeyName"] = *cluster.Spec.Openstack.SSHKeyName
 	}
 
+	// Existing networks are used as is, rather than one being created from the
+	// node prefix.
+	if network := cluster.Spec.Openstack.Network; network != nil {
+		openstackValues["networkID"] = *network.NetworkID
+		openstackValues["subnetID"] = *network.SubnetID
+
+		if network.RouterID != nil {
+			openstackValues["routerID"] = *network.RouterID
+		}
+	}
+
+	// Dual-stack clusters have a prefix of each address family, the primary
+	// family is defined by the first.
+	serviceCIDRs := []interface{}{}
+
+	for _, prefix := range cluster.ServiceNetworks() {
+		serviceCIDRs = append(serviceCIDRs, prefix.IPNet.String())
+	}
+
+	podCIDRs := []interface{}{}
+
+	for _, prefix := range cluster.PodNetworks() {
+		podCIDRs = append(podCIDRs, prefix.IPNet.String())
+	}
+
+	networkValues := map[string]interface{}{
+		"nodeCIDR":       cluster.Spec.Network.NodeNetwork.IPNet.String(),
+		"serviceCIDRs":   serviceCIDRs,
+		"podCIDRs":       podCIDRs,
+		"dnsNameservers": nameservers,
+	}
+
+	// kube-proxy is configured once for the whole cluster by kubeadm.
+	if cluster.Spec.Network.KubeProxyMode != nil {
+		networkValues["kubeProxy"] = map[string]interface{}{
+			"mode": string(*cluster.Spec.Network.KubeProxyMode),
+		}
+	}
+
+	// The node firewall replaces the default node security group rules, which
+	// expose NodePort services to the world, with only those explicitly allowed.
+	// Egress is unrestricted unless egress rules are defined, in which case the
+	// default allow all egress rule is replaced by them.
+	if cluster.NodeFirewallEnabled() {
+		nodeFirewall := map[string]interface{}{
+			"allowList": generateNodeAllowListHelmValues(cluster.Spec.NodeAllowList, unikornv1.NodeAllowListDirectionIngress),
+		}
+
+		if egress := generateNodeAllowListHelmValues(cluster.Spec.NodeAllowList, unikornv1.NodeAllowListDirectionEgress); len(egress) != 0 {
+			nodeFirewall["egressAllowList"] = egress
+		}
+
+		networkValues["nodeFirewall"] = nodeFirewall
+	}
+
 	labels, err := cluster.ResourceLabels()
 	if err != nil {
 		return nil, err
@@ -239,16 +399,7 @@ func (p *Provisioner) Values(ctx context.Context, version *string) (interface{},
 			"machine":  p.generateMachineHelmValues(&cluster.Spec.ControlPlane.MachineGeneric, nil),
 		},
 		"workloadPools": workloadPools,
-		"network": map[string]interface{}{
-			"nodeCIDR": cluster.Spec.Network.NodeNetwork.IPNet.String(),
-			"serviceCIDRs": []interface{}{
-				cluster.Spec.Network.ServiceNetwork.IPNet.String(),
-			},
-			"podCIDRs": []interface{}{
-				cluster.Spec.Network.PodNetwork.IPNet.String(),
-			},
-			"dnsNameservers": nameservers,
-		},
+		"network":       networkValues,
 	}
 
 	if cluster.Spec.API != nil {
@@ -271,6 +422,26 @@ func (p *Provisioner) Values(ctx context.Context, version *string) (interface{},
 			apiValues["allowList"] = allowList
 		}
 
+		// Private clusters don't get a floating IP, the load balancer is only
+		// reachable from the node network.
+		if cluster.PrivateAPIEnabled() {
+			apiValues["disableFloatingIP"] = true
+		}
+
+		if loadBalancer := cluster.Spec.API.LoadBalancer; loadBalancer != nil {
+			loadBalancerValues := map[string]interface{}{}
+
+			if loadBalancer.Provider != nil {
+				loadBalancerValues["provider"] = string(*loadBalancer.Provider)
+			}
+
+			if loadBalancer.FlavorID != nil {
+				loadBalancerValues["flavorID"] = *loadBalancer.FlavorID
+			}
+
+			apiValues["loadBalancer"] = loadBalancerValues
+		}
+
 		values["api"] = apiValues
 	}
 
@@ -287,5 +458,9 @@ func (p *Provisioner) ReleaseName(ctx context.Context) string {
 
 // PostHook implements the apllication PostProvisionHook interface.
 func (p *Provisioner) PostProvision(ctx context.Context) error {
-	return p.deleteOrphanedMachineDeployments(ctx)
+	if err := p.deleteOrphanedMachineDeployments(ctx); err != nil {
+		return err
+	}
+
+	return p.reissueAPICertificate(ctx)
 }
@@ -18,11 +18,12 @@ package kubernetesdashboard
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net"
 	"strings"
 
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
 	"github.com/eschercloudai/unikorn-core/pkg/constants"
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
@@ -32,8 +33,8 @@ import (
 )
 
 var (
-	ErrIngress           = errors.New("ingress not as expected")
-	ErrIngressIPNotFound = errors.New("unable to find remote ingress IP address")
+	ErrIngress           = unikornerrors.Unavailable("ingress not as expected")
+	ErrIngressIPNotFound = unikornerrors.Unavailable("unable to find remote ingress IP address")
 )
 
 type Provisioner struct{}
@@ -0,0 +1,125 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kyverno
+
+import (
+	"context"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+
+	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
+	"github.com/eschercloudai/unikorn-core/pkg/constants"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// costAllocationPolicyName is the name of the cluster policy that labels
+	// pods for cost allocation.
+	costAllocationPolicyName = "unikorn-cost-allocation"
+)
+
+// Provisioner provides helm configuration interfaces.
+type Provisioner struct{}
+
+// New returns a new initialized provisioner object.
+func New(getApplication application.GetterFunc) *application.Provisioner {
+	provisioner := &Provisioner{}
+
+	return application.New(getApplication).WithGenerator(provisioner).InNamespace("kyverno")
+}
+
+// Ensure the Provisioner interface is implemented.
+var _ application.PostProvisionHook = &Provisioner{}
+
+// costAllocationLabels returns the labels added to all pods in the cluster.
+// The workload pool cannot be known at admission time, as pods are yet to be
+// scheduled, so that is attributed via the node's labels instead.
+func costAllocationLabels(cluster *unikornv1.KubernetesCluster) map[string]interface{} {
+	labels := map[string]string{
+		constants.ProjectLabel:           cluster.Labels[constants.ProjectLabel],
+		constants.ControlPlaneLabel:      cluster.Labels[constants.ControlPlaneLabel],
+		constants.KubernetesClusterLabel: cluster.Name,
+	}
+
+	// The +() anchor only adds the label if it doesn't already exist, so
+	// users are free to override them.
+	result := map[string]interface{}{}
+
+	for key, value := range labels {
+		result["+("+key+")"] = value
+	}
+
+	return result
+}
+
+// generateCostAllocationPolicySpec creates a policy that mutates pods on creation
+// with labels that identify the cluster they are running in.
+func generateCostAllocationPolicySpec(cluster *unikornv1.KubernetesCluster) map[string]interface{} {
+	return map[string]interface{}{
+		"background": false,
+		"rules": []interface{}{
+			map[string]interface{}{
+				"name": "add-cost-allocation-labels",
+				"match": map[string]interface{}{
+					"any": []interface{}{
+						map[string]interface{}{
+							"resources": map[string]interface{}{
+								"kinds": []interface{}{
+									"Pod",
+								},
+							},
+						},
+					},
+				},
+				"mutate": map[string]interface{}{
+					"patchStrategicMerge": map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"labels": costAllocationLabels(cluster),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// PostProvision implements the application.PostProvisionHook interface.
+// Policies are custom resources, and therefore can only be created once
+// Kyverno has been installed.
+func (p *Provisioner) PostProvision(ctx context.Context) error {
+	//nolint:forcetypeassert
+	cluster := application.FromContext(ctx).(*unikornv1.KubernetesCluster)
+
+	policy := &unstructured.Unstructured{}
+	policy.SetAPIVersion("kyverno.io/v1")
+	policy.SetKind("ClusterPolicy")
+	policy.SetName(costAllocationPolicyName)
+
+	mutate := func() error {
+		return unstructured.SetNestedField(policy.Object, generateCostAllocationPolicySpec(cluster), "spec")
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, coreclient.DynamicClientFromContext(ctx), policy, mutate); err != nil {
+		return err
+	}
+
+	return nil
+}
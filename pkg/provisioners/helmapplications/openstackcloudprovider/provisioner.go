@@ -19,13 +19,13 @@ package openstackcloudprovider
 import (
 	"bytes"
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/gophercloud/utils/openstack/clientconfig"
 	ini "gopkg.in/ini.v1"
 
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
 
 	"github.com/eschercloudai/unikorn-core/pkg/constants"
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
@@ -37,7 +37,7 @@ import (
 var (
 	// ErrCloudConfiguration is returned when the cloud configuration is not
 	// correctly formatted.
-	ErrCloudConfiguration = errors.New("invalid cloud configuration")
+	ErrCloudConfiguration = unikornerrors.Invalid("invalid cloud configuration")
 )
 
 // Provisioner encapsulates control plane provisioning.
@@ -53,6 +53,35 @@ func New(getApplication application.GetterFunc) *application.Provisioner {
 // Ensure the Provisioner interface is implemented.
 var _ application.ValuesGenerator = &Provisioner{}
 
+// generateLoadBalancerConfig sets the default provider and flavor for services
+// of type LoadBalancer.
+func generateLoadBalancerConfig(section *ini.Section, loadBalancer *unikornv1.LoadBalancerSpec) error {
+	if loadBalancer == nil {
+		return nil
+	}
+
+	if loadBalancer.Provider != nil {
+		if _, err := section.NewKey("lb-provider", string(*loadBalancer.Provider)); err != nil {
+			return err
+		}
+
+		// OVN doesn't support the default round robin algorithm.
+		if *loadBalancer.Provider == unikornv1.LoadBalancerProviderOVN {
+			if _, err := section.NewKey("lb-method", "SOURCE_IP_PORT"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if loadBalancer.FlavorID != nil {
+		if _, err := section.NewKey("flavor-id", *loadBalancer.FlavorID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GenerateCloudConfig does the horrific translation between the myriad ways that OpenStack
 // deems necessary to authenticate to the cloud configuration format.  See:
 // https://github.com/kubernetes/cloud-provider-openstack/blob/master/docs/openstack-cloud-controller-manager/using-openstack-cloud-controller-manager.md#config-openstack-cloud-controller-manager
@@ -102,6 +131,18 @@ func GenerateCloudConfig(cluster *unikornv1.KubernetesCluster) (string, error) {
 		return "", err
 	}
 
+	// Existing networks may have more than one subnet, so be explicit about
+	// where load balancers are attached.
+	if network := cluster.Spec.Openstack.Network; network != nil {
+		if _, err := loadBalancer.NewKey("subnet-id", *network.SubnetID); err != nil {
+			return "", err
+		}
+	}
+
+	if err := generateLoadBalancerConfig(loadBalancer, cluster.Spec.Openstack.LoadBalancer); err != nil {
+		return "", err
+	}
+
 	blockStorage, err := cloudConfig.NewSection("BlockStorage")
 	if err != nil {
 		return "", err
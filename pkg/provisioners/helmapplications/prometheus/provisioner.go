@@ -17,10 +17,185 @@ limitations under the License.
 package prometheus
 
 import (
+	"context"
+
+	"github.com/spf13/pflag"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+
+	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
+	"github.com/eschercloudai/unikorn-core/pkg/constants"
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// UsernameKey is the secret key that contains the remote write username.
+	UsernameKey = "username"
+
+	// PasswordKey is the secret key that contains the remote write password.
+	PasswordKey = "password"
+
+	// credentialsSecretName is the name of the secret created on the cluster
+	// to hold the remote write credentials.
+	credentialsSecretName = "metrics-federation"
+)
+
+var (
+	// ErrUnconfigured is raised when metrics federation is requested but no
+	// central Prometheus has been provided.
+	ErrUnconfigured = unikornerrors.Invalid("metrics federation is not configured")
+
+	// ErrCredentials is raised when the credentials secret is malformed.
+	ErrCredentials = unikornerrors.Invalid("metrics federation credentials missing " + UsernameKey + " or " + PasswordKey)
+)
+
+// Options define the central Prometheus that cluster metrics are federated into,
+// as provided by the operator.  Metrics are pushed with remote write, as the
+// platform has no route to the cluster's Prometheus.
+type Options struct {
+	// URL is the remote write endpoint of the central Prometheus.
+	URL string
+
+	// CredentialsNamespace is the namespace the credentials secret resides in.
+	CredentialsNamespace string
+
+	// CredentialsName is the name of the credentials secret, this is optional
+	// and if not set no authentication is performed.
+	CredentialsName string
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.StringVar(&o.URL, "metrics-federation-url", "", "Central Prometheus remote write URL to federate cluster metrics into, federation is disabled if not set.")
+	f.StringVar(&o.CredentialsNamespace, "metrics-federation-credentials-namespace", "", "Namespace of the secret containing remote write basic authentication credentials.")
+	f.StringVar(&o.CredentialsName, "metrics-federation-credentials-name", "", "Name of the secret containing remote write basic authentication credentials.")
+}
+
+// Provisioner provides helm configuration interfaces.
+type Provisioner struct {
+	// options define the central Prometheus to use.
+	options *Options
+}
+
 // New returns a new initialized provisioner object.
-func New(getApplication application.GetterFunc) *application.Provisioner {
-	return application.New(getApplication).InNamespace("prometheus-system")
+func New(getApplication application.GetterFunc, options *Options) *application.Provisioner {
+	provisioner := &Provisioner{
+		options: options,
+	}
+
+	return application.New(getApplication).WithGenerator(provisioner).InNamespace("prometheus-system")
+}
+
+// Ensure the Provisioner interface is implemented.
+var _ application.ValuesGenerator = &Provisioner{}
+
+// ExternalLabels returns the labels added to all federated metrics.  These are
+// set by the platform, so can be relied upon to isolate projects from one another
+// in the central Prometheus.
+func ExternalLabels(cluster *unikornv1.KubernetesCluster) map[string]interface{} {
+	return map[string]interface{}{
+		"unikorn_project":       cluster.Labels[constants.ProjectLabel],
+		"unikorn_control_plane": cluster.Labels[constants.ControlPlaneLabel],
+		"unikorn_cluster":       cluster.Name,
+	}
+}
+
+// getCredentials reads the remote write credentials from the management cluster.
+func (p *Provisioner) getCredentials(ctx context.Context) (string, string, error) {
+	secret := &corev1.Secret{}
+
+	if err := coreclient.StaticClientFromContext(ctx).Get(ctx, client.ObjectKey{Namespace: p.options.CredentialsNamespace, Name: p.options.CredentialsName}, secret); err != nil {
+		return "", "", err
+	}
+
+	username, ok := secret.Data[UsernameKey]
+	if !ok {
+		return "", "", ErrCredentials
+	}
+
+	password, ok := secret.Data[PasswordKey]
+	if !ok {
+		return "", "", ErrCredentials
+	}
+
+	return string(username), string(password), nil
+}
+
+// Values implements the application.ValuesGenerator interface.
+func (p *Provisioner) Values(ctx context.Context, version *string) (interface{}, error) {
+	//nolint:forcetypeassert
+	cluster := application.FromContext(ctx).(*unikornv1.KubernetesCluster)
+
+	// Without federation, only the operator is installed, and users are free
+	// to create their own Prometheus servers.
+	if !cluster.MetricsFederationEnabled() {
+		values := map[string]interface{}{
+			"prometheus": map[string]interface{}{
+				"enabled": false,
+			},
+		}
+
+		return values, nil
+	}
+
+	if p.options.URL == "" {
+		return nil, ErrUnconfigured
+	}
+
+	remoteWrite := map[string]interface{}{
+		"url": p.options.URL,
+	}
+
+	values := map[string]interface{}{}
+
+	if p.options.CredentialsName != "" {
+		username, password, err := p.getCredentials(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		remoteWrite["basicAuth"] = map[string]interface{}{
+			"username": map[string]interface{}{
+				"name": credentialsSecretName,
+				"key":  UsernameKey,
+			},
+			"password": map[string]interface{}{
+				"name": credentialsSecretName,
+				"key":  PasswordKey,
+			},
+		}
+
+		values["extraManifests"] = []interface{}{
+			map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata": map[string]interface{}{
+					"name": credentialsSecretName,
+				},
+				"stringData": map[string]interface{}{
+					UsernameKey: username,
+					PasswordKey: password,
+				},
+			},
+		}
+	}
+
+	// Select all monitors so users need only create them for their metrics
+	// to be federated.
+	values["prometheus"] = map[string]interface{}{
+		"enabled": true,
+		"prometheusSpec": map[string]interface{}{
+			"externalLabels": ExternalLabels(cluster),
+			"remoteWrite":    []interface{}{remoteWrite},
+			"serviceMonitorSelectorNilUsesHelmValues": false,
+			"podMonitorSelectorNilUsesHelmValues":     false,
+		},
+	}
+
+	return values, nil
 }
@@ -18,10 +18,11 @@ package vcluster
 
 import (
 	"context"
-	"errors"
 	"io"
 	"os"
 
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
 
@@ -38,7 +39,7 @@ import (
 )
 
 var (
-	ErrConfigDataMissing = errors.New("config data not found")
+	ErrConfigDataMissing = unikornerrors.Unavailable("config data not found")
 )
 
 // VCluster provides services around virtual clusters.
@@ -17,10 +17,16 @@ limitations under the License.
 package vcluster
 
 import (
+	"context"
+
 	"github.com/prometheus/client_golang/prometheus"
 
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
@@ -48,7 +54,102 @@ func init() {
 	metrics.Registry.MustRegister(durationMetric)
 }
 
+// resourceClass defines the resources allocated to a virtual cluster's
+// Kubernetes container.
+type resourceClass struct {
+	cpu    resource.Quantity
+	memory resource.Quantity
+}
+
+// resourceClasses maps from the API's notion of size to actual resources.
+// The small class matches the chart defaults.
+//
+//nolint:gochecknoglobals
+var resourceClasses = map[unikornv1.ControlPlaneResourceClass]resourceClass{
+	unikornv1.ControlPlaneResourceClassSmall: {
+		cpu:    resource.MustParse("200m"),
+		memory: resource.MustParse("256Mi"),
+	},
+	unikornv1.ControlPlaneResourceClassMedium: {
+		cpu:    resource.MustParse("1"),
+		memory: resource.MustParse("1Gi"),
+	},
+	unikornv1.ControlPlaneResourceClassLarge: {
+		cpu:    resource.MustParse("2"),
+		memory: resource.MustParse("4Gi"),
+	},
+}
+
+// minimumMemoryLimit is the chart default memory limit, we never go below
+// this to avoid OOM kills.
+//
+//nolint:gochecknoglobals
+var minimumMemoryLimit = resource.MustParse("2Gi")
+
+// Provisioner generates virtual cluster configuration.
+type Provisioner struct {
+	// resources, if set, defines the resources to allocate.
+	resources *unikornv1.ControlPlaneResourcesSpec
+}
+
+// Ensure the Provisioner interface is implemented.
+var _ application.ValuesGenerator = &Provisioner{}
+
 // New returns a new initialized provisioner object.
-func New(getApplication application.GetterFunc) *application.Provisioner {
-	return application.New(getApplication)
+func New(getApplication application.GetterFunc, resources *unikornv1.ControlPlaneResourcesSpec) *application.Provisioner {
+	provisioner := &Provisioner{
+		resources: resources,
+	}
+
+	return application.New(getApplication).WithGenerator(provisioner)
+}
+
+// Values implements the application.ValuesGenerator interface.
+// Any change in resources will trigger a rolling update of the virtual
+// cluster's stateful set.
+func (p *Provisioner) Values(ctx context.Context, version *string) (interface{}, error) {
+	if p.resources == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	class := resourceClasses[unikornv1.ControlPlaneResourceClassSmall]
+
+	if p.resources.Class != nil {
+		if c, ok := resourceClasses[*p.resources.Class]; ok {
+			class = c
+		}
+	}
+
+	if p.resources.CPU != nil {
+		class.cpu = *p.resources.CPU
+	}
+
+	if p.resources.Memory != nil {
+		class.memory = *p.resources.Memory
+	}
+
+	// Allow some burst headroom over the request.
+	memoryLimit := class.memory.DeepCopy()
+	memoryLimit.Add(class.memory)
+
+	if memoryLimit.Cmp(minimumMemoryLimit) < 0 {
+		memoryLimit = minimumMemoryLimit.DeepCopy()
+	}
+
+	values := map[string]interface{}{
+		"vcluster": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": map[string]interface{}{
+					"cpu":    class.cpu.String(),
+					"memory": class.memory.String(),
+				},
+				"limits": map[string]interface{}{
+					"memory": memoryLimit.String(),
+				},
+			},
+		},
+	}
+
+	return values, nil
 }
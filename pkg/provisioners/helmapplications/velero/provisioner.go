@@ -0,0 +1,197 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package velero
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+
+	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
+	"github.com/eschercloudai/unikorn-core/pkg/constants"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CredentialsKey is the secret key that contains the object storage
+	// credentials, in AWS shared credentials file format.
+	CredentialsKey = "cloud"
+
+	// defaultSchedule is used when the user doesn't specify one.
+	defaultSchedule = "0 1 * * *"
+
+	// defaultRetention is used when the user doesn't specify one.
+	defaultRetention = 30 * 24 * time.Hour
+)
+
+var (
+	// ErrUnconfigured is raised when backups are requested but no object
+	// storage has been provided.
+	ErrUnconfigured = unikornerrors.Invalid("backup storage is not configured")
+
+	// ErrCredentials is raised when the credentials secret is malformed.
+	ErrCredentials = unikornerrors.Invalid("backup storage credentials missing " + CredentialsKey)
+)
+
+// Options define the S3 compatible object storage that backups are written
+// to, as provided by the operator.  Each cluster is given its own prefix
+// within the bucket.
+type Options struct {
+	// Bucket is the bucket to store backups in.
+	Bucket string
+
+	// Region is the object storage region.
+	Region string
+
+	// Endpoint is the object storage endpoint, this is only required for
+	// non-AWS object storage e.g. OpenStack Swift or Ceph.
+	Endpoint string
+
+	// CredentialsNamespace is the namespace the credentials secret resides in.
+	CredentialsNamespace string
+
+	// CredentialsName is the name of the credentials secret.
+	CredentialsName string
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.StringVar(&o.Bucket, "backup-storage-bucket", "", "Object storage bucket to store cluster backups in, backups are disabled if not set.")
+	f.StringVar(&o.Region, "backup-storage-region", "", "Object storage region.")
+	f.StringVar(&o.Endpoint, "backup-storage-endpoint", "", "Object storage endpoint URL, required for non-AWS object storage.")
+	f.StringVar(&o.CredentialsNamespace, "backup-storage-credentials-namespace", "", "Namespace of the secret containing object storage credentials.")
+	f.StringVar(&o.CredentialsName, "backup-storage-credentials-name", "", "Name of the secret containing object storage credentials.")
+}
+
+// Provisioner provides helm configuration interfaces.
+type Provisioner struct {
+	// options define the object storage to use.
+	options *Options
+}
+
+// New returns a new initialized provisioner object.
+func New(getApplication application.GetterFunc, options *Options) *application.Provisioner {
+	provisioner := &Provisioner{
+		options: options,
+	}
+
+	return application.New(getApplication).WithGenerator(provisioner).InNamespace("velero")
+}
+
+// Ensure the Provisioner interface is implemented.
+var _ application.ValuesGenerator = &Provisioner{}
+
+// Prefix returns the unique location of a cluster's backups within the bucket.
+func Prefix(cluster *unikornv1.KubernetesCluster) string {
+	return path.Join(cluster.Labels[constants.ProjectLabel], cluster.Labels[constants.ControlPlaneLabel], cluster.Name)
+}
+
+// getCredentials reads the object storage credentials from the management cluster.
+func (p *Provisioner) getCredentials(ctx context.Context) (string, error) {
+	secret := &corev1.Secret{}
+
+	if err := coreclient.StaticClientFromContext(ctx).Get(ctx, client.ObjectKey{Namespace: p.options.CredentialsNamespace, Name: p.options.CredentialsName}, secret); err != nil {
+		return "", err
+	}
+
+	credentials, ok := secret.Data[CredentialsKey]
+	if !ok {
+		return "", ErrCredentials
+	}
+
+	return string(credentials), nil
+}
+
+// Values implements the application.ValuesGenerator interface.
+func (p *Provisioner) Values(ctx context.Context, version *string) (interface{}, error) {
+	//nolint:forcetypeassert
+	cluster := application.FromContext(ctx).(*unikornv1.KubernetesCluster)
+
+	if p.options.Bucket == "" {
+		return nil, ErrUnconfigured
+	}
+
+	credentials, err := p.getCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]interface{}{
+		"s3ForcePathStyle": "true",
+	}
+
+	if p.options.Region != "" {
+		config["region"] = p.options.Region
+	}
+
+	if p.options.Endpoint != "" {
+		config["s3Url"] = p.options.Endpoint
+	}
+
+	schedule := defaultSchedule
+	retention := defaultRetention
+
+	if cluster.Spec.Features != nil && cluster.Spec.Features.BackupConfiguration != nil {
+		backup := cluster.Spec.Features.BackupConfiguration
+
+		if backup.Schedule != nil {
+			schedule = *backup.Schedule
+		}
+
+		if backup.Retention != nil {
+			retention = backup.Retention.Duration
+		}
+	}
+
+	values := map[string]interface{}{
+		"configuration": map[string]interface{}{
+			"backupStorageLocation": []interface{}{
+				map[string]interface{}{
+					"name":     "default",
+					"provider": "aws",
+					"bucket":   p.options.Bucket,
+					"prefix":   Prefix(cluster),
+					"config":   config,
+				},
+			},
+		},
+		"credentials": map[string]interface{}{
+			"secretContents": map[string]interface{}{
+				CredentialsKey: credentials,
+			},
+		},
+		"schedules": map[string]interface{}{
+			"default": map[string]interface{}{
+				"schedule": schedule,
+				"template": map[string]interface{}{
+					"ttl": retention.String(),
+				},
+			},
+		},
+	}
+
+	return values, nil
+}
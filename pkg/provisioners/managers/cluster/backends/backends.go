@@ -0,0 +1,95 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backends provides the provider specific parts of cluster provisioning.
+// The cluster manager composes these with the provider agnostic add-ons, and
+// selects a backend per cluster based on its provider.  Only the capo backend,
+// Cluster API on OpenStack, is implemented so far.
+package backends
+
+import (
+	"fmt"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/collections"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
+)
+
+var (
+	// ErrUnknownProvider is raised when a cluster's provider has no backend.
+	ErrUnknownProvider = unikornerrors.Invalid("cluster provider is not supported")
+)
+
+// Applications returns a function that looks up the named application in the
+// cluster's application bundle.
+type Applications func(name string) application.GetterFunc
+
+// Backend provisions the provider specific parts of a cluster.  Provisioners
+// are run on the control plane, unless documented otherwise.
+type Backend interface {
+	// RemoteCluster returns the provisioned cluster, so that applications
+	// can be installed on it.
+	RemoteCluster(cluster *unikornv1.KubernetesCluster) provisioners.RemoteCluster
+
+	// Cluster returns a provisioner that creates the cluster's control plane
+	// and workload pools.  The control plane prefix is the network that the
+	// control plane's traffic originates from.
+	Cluster(applications Applications, cluster *unikornv1.KubernetesCluster, controlPlanePrefix string) provisioners.Provisioner
+
+	// Bootstrap returns a provisioner, run on the cluster, that installs
+	// components required for it to become healthy e.g. a cloud controller
+	// manager.
+	Bootstrap(applications Applications, cluster *unikornv1.KubernetesCluster) provisioners.Provisioner
+
+	// Autoscaler returns a provisioner that installs provider support for the
+	// cluster autoscaler.
+	Autoscaler(applications Applications, cluster *unikornv1.KubernetesCluster) provisioners.Provisioner
+
+	// Storage returns a provisioner, run on the cluster, that installs
+	// support for persistent volumes.
+	Storage(applications Applications, cluster *unikornv1.KubernetesCluster) provisioners.Provisioner
+}
+
+// backends maps providers to their backend.
+//
+// TODO: capv, Cluster API on vSphere, and kamaji-style hosted control plane
+// backends are outstanding.  They need cluster charts, application bundle
+// entries and provider specific cluster configuration that don't exist yet.
+// Register them here, and add them to the provider enum, once they do, and
+// the conformance suite will cover them.
+//
+//nolint:gochecknoglobals
+var backends = map[unikornv1.KubernetesClusterProvider]Backend{
+	unikornv1.KubernetesClusterProviderCAPO: &CAPO{},
+}
+
+// Providers returns the providers that have a backend, sorted by name.
+func Providers() []unikornv1.KubernetesClusterProvider {
+	return collections.SortedKeys(backends)
+}
+
+// Get returns the backend for the cluster's provider.
+func Get(cluster *unikornv1.KubernetesCluster) (Backend, error) {
+	backend, ok := backends[cluster.Provider()]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, cluster.Provider())
+	}
+
+	return backend, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/managers/cluster/backends"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn-core/pkg/constants"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newCluster returns a minimal cluster for the provider.
+func newCluster(name string, provider unikornv1.KubernetesClusterProvider) *unikornv1.KubernetesCluster {
+	return &unikornv1.KubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				constants.ProjectLabel:      "foo",
+				constants.ControlPlaneLabel: "bar",
+			},
+		},
+		Spec: unikornv1.KubernetesClusterSpec{
+			Provider: &provider,
+		},
+	}
+}
+
+// recorder records the applications a backend requests.
+type recorder struct {
+	names []string
+}
+
+func (r *recorder) applications(name string) application.GetterFunc {
+	r.names = append(r.names, name)
+
+	return func(context.Context) (*coreunikornv1.ApplicationReference, error) {
+		return nil, nil
+	}
+}
+
+// TestConformance checks every backend behaves as the cluster manager expects.
+// New backends are tested automatically once registered.
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	assert.NotEmpty(t, backends.Providers())
+
+	for _, provider := range backends.Providers() {
+		provider := provider
+
+		t.Run(string(provider), func(t *testing.T) {
+			t.Parallel()
+
+			cluster := newCluster("baz", provider)
+
+			backend, err := backends.Get(cluster)
+			assert.NoError(t, err)
+			assert.NotNil(t, backend)
+
+			t.Run("RemoteCluster", func(t *testing.T) {
+				t.Parallel()
+
+				// The identifier must be stable, so applications installed on the
+				// cluster are found on subsequent reconciles, and unique per cluster.
+				remote := backend.RemoteCluster(cluster)
+				assert.NotNil(t, remote)
+				assert.NotNil(t, remote.ID())
+				assert.Equal(t, remote.ID(), backend.RemoteCluster(newCluster("baz", provider)).ID())
+				assert.NotEqual(t, remote.ID(), backend.RemoteCluster(newCluster("qux", provider)).ID())
+			})
+
+			t.Run("Provisioners", func(t *testing.T) {
+				t.Parallel()
+
+				r := &recorder{}
+
+				stages := map[string]provisioners.Provisioner{
+					"cluster":    backend.Cluster(r.applications, cluster, "192.168.0.0/24"),
+					"bootstrap":  backend.Bootstrap(r.applications, cluster),
+					"autoscaler": backend.Autoscaler(r.applications, cluster),
+					"storage":    backend.Storage(r.applications, cluster),
+				}
+
+				// Provisioners are composed, so must accept options propagated from
+				// their parents.
+				for stage, provisioner := range stages {
+					assert.NotNil(t, provisioner, stage)
+					provisioner.OnRemote(backend.RemoteCluster(cluster))
+					provisioner.BackgroundDeletion()
+				}
+
+				// Applications are looked up in the bundle, and must be unique, or
+				// releases will clash.
+				for _, name := range r.names {
+					assert.NotEmpty(t, name)
+				}
+
+				seen := map[string]bool{}
+
+				for _, name := range r.names {
+					assert.False(t, seen[name], "application %s requested twice", name)
+					seen[name] = true
+				}
+			})
+		})
+	}
+}
+
+// TestDefault tests clusters created before providers were introduced have
+// a backend.
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	cluster := newCluster("baz", "")
+	cluster.Spec.Provider = nil
+
+	_, err := backends.Get(cluster)
+	assert.NoError(t, err)
+}
+
+// TestUnknown tests unknown providers are rejected as a configuration error,
+// so the cluster is reported as needing attention rather than retried.
+func TestUnknown(t *testing.T) {
+	t.Parallel()
+
+	_, err := backends.Get(newCluster("baz", "unknown"))
+	assert.ErrorIs(t, err, backends.ErrUnknownProvider)
+	assert.ErrorIs(t, err, unikornerrors.ErrInvalid)
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/clusterautoscaleropenstack"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/clusteropenstack"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/openstackcloudprovider"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/openstackplugincindercsi"
+
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+)
+
+// CAPO provisions clusters on OpenStack with Cluster API.
+type CAPO struct{}
+
+// Ensure the Backend interface is implemented.
+var _ Backend = &CAPO{}
+
+// RemoteCluster implements the Backend interface.
+func (*CAPO) RemoteCluster(cluster *unikornv1.KubernetesCluster) provisioners.RemoteCluster {
+	return clusteropenstack.NewRemoteCluster(cluster)
+}
+
+// Cluster implements the Backend interface.
+func (*CAPO) Cluster(applications Applications, cluster *unikornv1.KubernetesCluster, controlPlanePrefix string) provisioners.Provisioner {
+	return clusteropenstack.New(applications("cluster-openstack"), controlPlanePrefix).InNamespace(cluster.Name)
+}
+
+// Bootstrap implements the Backend interface.
+func (*CAPO) Bootstrap(applications Applications, _ *unikornv1.KubernetesCluster) provisioners.Provisioner {
+	return openstackcloudprovider.New(applications("openstack-cloud-provider"))
+}
+
+// Autoscaler implements the Backend interface.
+func (*CAPO) Autoscaler(applications Applications, cluster *unikornv1.KubernetesCluster) provisioners.Provisioner {
+	return clusterautoscaleropenstack.New(applications("cluster-autoscaler-openstack")).InNamespace(cluster.Name)
+}
+
+// Storage implements the Backend interface.
+func (*CAPO) Storage(applications Applications, _ *unikornv1.KubernetesCluster) provisioners.Provisioner {
+	return openstackplugincindercsi.New(applications("openstack-plugin-cinder-csi"))
+}
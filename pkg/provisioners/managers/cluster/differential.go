@@ -0,0 +1,183 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+
+	"github.com/eschercloudai/unikorn-core/pkg/cd"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// applicationResyncPeriod is how often unchanged applications are applied
+	// regardless, to correct any drift e.g. manual deletion.
+	applicationResyncPeriod = time.Hour
+)
+
+var (
+	//nolint:gochecknoglobals
+	applicationSyncMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "unikorn_cluster_application_sync_total",
+		Help: "Number of cluster application syncs, by whether they were applied or skipped",
+	}, []string{"result"})
+)
+
+//nolint:gochecknoinits
+func init() {
+	metrics.Registry.MustRegister(applicationSyncMetric)
+}
+
+// differentialDriver wraps a continuous delivery driver, skipping application
+// updates when nothing has changed since the application was last applied
+// successfully.  Applications may be provisioned concurrently, hence the lock.
+// This relies on the wrapped driver only returning success once an application
+// is healthy, as the Argo CD driver does by yielding until then, because skipped
+// applications aren't checked again.
+type differentialDriver struct {
+	cd.Driver
+
+	lock sync.Mutex
+
+	cluster *unikornv1.KubernetesCluster
+}
+
+// Ensure the Driver interface is implemented.
+var _ cd.Driver = &differentialDriver{}
+
+func newDifferentialDriver(driver cd.Driver, cluster *unikornv1.KubernetesCluster) *differentialDriver {
+	return &differentialDriver{
+		Driver:  driver,
+		cluster: cluster,
+	}
+}
+
+// applicationHash returns a digest of everything that defines an application.
+func applicationHash(id *cd.ResourceIdentifier, app *cd.HelmApplication) (string, error) {
+	data, err := json.Marshal([]interface{}{id, app})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// unchanged returns true if the application was last applied with the same
+// hash within the resync period.
+func (d *differentialDriver) unchanged(name, hash string) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	index := slices.IndexFunc(d.cluster.Status.Applications, func(s unikornv1.ApplicationSyncStatus) bool {
+		return s.Name == name
+	})
+
+	if index < 0 {
+		return false
+	}
+
+	status := &d.cluster.Status.Applications[index]
+
+	return status.Hash == hash && time.Since(status.SyncTime.Time) < applicationResyncPeriod
+}
+
+// record remembers the application was applied successfully.
+func (d *differentialDriver) record(name, hash string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	status := unikornv1.ApplicationSyncStatus{
+		Name:     name,
+		Hash:     hash,
+		SyncTime: metav1.Now(),
+	}
+
+	index := slices.IndexFunc(d.cluster.Status.Applications, func(s unikornv1.ApplicationSyncStatus) bool {
+		return s.Name == name
+	})
+
+	if index < 0 {
+		d.cluster.Status.Applications = append(d.cluster.Status.Applications, status)
+
+		return
+	}
+
+	d.cluster.Status.Applications[index] = status
+}
+
+// forget removes the application so it's applied next time it's provisioned.
+func (d *differentialDriver) forget(name string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.cluster.Status.Applications = slices.DeleteFunc(d.cluster.Status.Applications, func(s unikornv1.ApplicationSyncStatus) bool {
+		return s.Name == name
+	})
+}
+
+// CreateOrUpdateHelmApplication implements the cd.Driver interface.
+// Only applications that the wrapped driver reports as healthy are recorded,
+// anything else is applied until it does, so health is still gated on.
+func (d *differentialDriver) CreateOrUpdateHelmApplication(ctx context.Context, id *cd.ResourceIdentifier, app *cd.HelmApplication) error {
+	hash, err := applicationHash(id, app)
+	if err != nil {
+		return err
+	}
+
+	if d.unchanged(id.Name, hash) {
+		log.FromContext(ctx).Info("application unchanged, skipping", "application", id.Name)
+
+		applicationSyncMetric.WithLabelValues("skipped").Inc()
+
+		return nil
+	}
+
+	applicationSyncMetric.WithLabelValues("applied").Inc()
+
+	if err := d.Driver.CreateOrUpdateHelmApplication(ctx, id, app); err != nil {
+		d.forget(id.Name)
+
+		return err
+	}
+
+	d.record(id.Name, hash)
+
+	return nil
+}
+
+// DeleteHelmApplication implements the cd.Driver interface.
+func (d *differentialDriver) DeleteHelmApplication(ctx context.Context, id *cd.ResourceIdentifier, backgroundDelete bool) error {
+	d.forget(id.Name)
+
+	return d.Driver.DeleteHelmApplication(ctx, id, backgroundDelete)
+}
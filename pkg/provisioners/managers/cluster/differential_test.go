@@ -0,0 +1,184 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/managers/cluster"
+
+	"github.com/eschercloudai/unikorn-core/pkg/cd"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var errApply = errors.New("apply failed")
+
+// stubDriver counts the calls made to it, and fails applies when asked to.
+type stubDriver struct {
+	cd.Driver
+
+	// applied is the number of applies.
+	applied int
+
+	// deleted is the number of deletes.
+	deleted int
+
+	// err, if set, is returned by applies.
+	err error
+}
+
+func (d *stubDriver) CreateOrUpdateHelmApplication(_ context.Context, _ *cd.ResourceIdentifier, _ *cd.HelmApplication) error {
+	d.applied++
+
+	return d.err
+}
+
+func (d *stubDriver) DeleteHelmApplication(_ context.Context, _ *cd.ResourceIdentifier, _ bool) error {
+	d.deleted++
+
+	return nil
+}
+
+// newApplication returns a basic application.
+func newApplication() (*cd.ResourceIdentifier, *cd.HelmApplication) {
+	id := &cd.ResourceIdentifier{
+		Name: "foo",
+	}
+
+	app := &cd.HelmApplication{
+		Repo:    "https://charts.example.com",
+		Chart:   "foo",
+		Version: "v1.0.0",
+	}
+
+	return id, app
+}
+
+// expire ages the recorded application beyond the resync period.
+func expire(kc *unikornv1.KubernetesCluster) {
+	kc.Status.Applications[0].SyncTime = metav1.NewTime(time.Now().Add(-cluster.ApplicationResyncPeriod - time.Minute))
+}
+
+// TestDifferentialDriver tests applications are only applied when they have
+// changed, or are due a resync, and that failed or deleted applications are
+// always applied next time.
+func TestDifferentialDriver(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		// between is called between the initial and final applies.
+		between func(t *testing.T, driver cd.Driver, stub *stubDriver, kc *unikornv1.KubernetesCluster, id *cd.ResourceIdentifier, app *cd.HelmApplication)
+		// applied is the number of applies expected to reach the driver.
+		applied int
+	}{
+		{
+			name: "Unchanged",
+			between: func(*testing.T, cd.Driver, *stubDriver, *unikornv1.KubernetesCluster, *cd.ResourceIdentifier, *cd.HelmApplication) {
+			},
+			applied: 1,
+		},
+		{
+			name: "Changed",
+			between: func(_ *testing.T, _ cd.Driver, _ *stubDriver, _ *unikornv1.KubernetesCluster, _ *cd.ResourceIdentifier, app *cd.HelmApplication) {
+				app.Version = "v1.0.1"
+			},
+			applied: 2,
+		},
+		{
+			name: "Resync",
+			between: func(_ *testing.T, _ cd.Driver, _ *stubDriver, kc *unikornv1.KubernetesCluster, _ *cd.ResourceIdentifier, _ *cd.HelmApplication) {
+				expire(kc)
+			},
+			applied: 2,
+		},
+		{
+			name: "Error",
+			between: func(t *testing.T, driver cd.Driver, stub *stubDriver, kc *unikornv1.KubernetesCluster, id *cd.ResourceIdentifier, app *cd.HelmApplication) {
+				t.Helper()
+
+				expire(kc)
+
+				stub.err = errApply
+
+				assert.ErrorIs(t, driver.CreateOrUpdateHelmApplication(context.TODO(), id, app), errApply)
+				assert.Empty(t, kc.Status.Applications)
+
+				stub.err = nil
+			},
+			applied: 3,
+		},
+		{
+			name: "Unhealthy",
+			between: func(t *testing.T, driver cd.Driver, stub *stubDriver, kc *unikornv1.KubernetesCluster, id *cd.ResourceIdentifier, app *cd.HelmApplication) {
+				t.Helper()
+
+				expire(kc)
+
+				stub.err = provisioners.ErrYield
+
+				assert.ErrorIs(t, driver.CreateOrUpdateHelmApplication(context.TODO(), id, app), provisioners.ErrYield)
+				assert.Empty(t, kc.Status.Applications)
+
+				stub.err = nil
+			},
+			applied: 3,
+		},
+		{
+			name: "Delete",
+			between: func(t *testing.T, driver cd.Driver, stub *stubDriver, kc *unikornv1.KubernetesCluster, id *cd.ResourceIdentifier, _ *cd.HelmApplication) {
+				t.Helper()
+
+				assert.NoError(t, driver.DeleteHelmApplication(context.TODO(), id, false))
+				assert.Equal(t, 1, stub.deleted)
+				assert.Empty(t, kc.Status.Applications)
+			},
+			applied: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			stub := &stubDriver{}
+			kc := &unikornv1.KubernetesCluster{}
+			driver := cluster.NewDifferentialDriver(stub, kc)
+
+			id, app := newApplication()
+
+			assert.NoError(t, driver.CreateOrUpdateHelmApplication(context.TODO(), id, app))
+			assert.Len(t, kc.Status.Applications, 1)
+
+			tc.between(t, driver, stub, kc, id, app)
+
+			assert.NoError(t, driver.CreateOrUpdateHelmApplication(context.TODO(), id, app))
+			assert.Equal(t, tc.applied, stub.applied)
+			assert.Len(t, kc.Status.Applications, 1)
+		})
+	}
+}
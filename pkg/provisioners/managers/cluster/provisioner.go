@@ -19,27 +19,32 @@ package cluster
 import (
 	"context"
 
+	"github.com/spf13/pflag"
+
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/calico"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/certmanager"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/certmanagerissuers"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/cilium"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/clusterautoscaler"
-	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/clusterautoscaleropenstack"
-	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/clusteropenstack"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/ingressnginx"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/kubernetesdashboard"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/kyverno"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/longhorn"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/metricsserver"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/nvidiagpuoperator"
-	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/openstackcloudprovider"
-	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/openstackplugincindercsi"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/prometheus"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/vcluster"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/velero"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/managers/cluster/backends"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/warmpool"
 
 	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn-core/pkg/cd"
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
 	"github.com/eschercloudai/unikorn-core/pkg/constants"
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners/application"
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners/concurrent"
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners/conditional"
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners/remotecluster"
@@ -79,6 +84,14 @@ func (a *ApplicationReferenceGetter) getApplication(ctx context.Context, name st
 	return bundle.Spec.GetApplication(name)
 }
 
+// getter returns a function that looks up the named application, this allows
+// backends to select their own applications.
+func (a *ApplicationReferenceGetter) getter(name string) application.GetterFunc {
+	return func(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
+		return a.getApplication(ctx, name)
+	}
+}
+
 func (a *ApplicationReferenceGetter) certManager(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
 	return a.getApplication(ctx, "cert-manager")
 }
@@ -87,20 +100,12 @@ func (a *ApplicationReferenceGetter) certManagerIssuers(ctx context.Context) (*c
 	return a.getApplication(ctx, "cert-manager-issuers")
 }
 
-func (a *ApplicationReferenceGetter) clusterOpenstack(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
-	return a.getApplication(ctx, "cluster-openstack")
-}
-
 func (a *ApplicationReferenceGetter) cilium(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
 	return a.getApplication(ctx, "cilium")
 }
 
-func (a *ApplicationReferenceGetter) openstackCloudProvider(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
-	return a.getApplication(ctx, "openstack-cloud-provider")
-}
-
-func (a *ApplicationReferenceGetter) openstackPluginCinderCSI(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
-	return a.getApplication(ctx, "openstack-plugin-cinder-csi")
+func (a *ApplicationReferenceGetter) calico(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
+	return a.getApplication(ctx, "calico")
 }
 
 func (a *ApplicationReferenceGetter) metricsServer(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
@@ -115,10 +120,6 @@ func (a *ApplicationReferenceGetter) clusterAutoscaler(ctx context.Context) (*co
 	return a.getApplication(ctx, "cluster-autoscaler")
 }
 
-func (a *ApplicationReferenceGetter) clusterAutoscalerOpenstack(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
-	return a.getApplication(ctx, "cluster-autoscaler-openstack")
-}
-
 func (a *ApplicationReferenceGetter) ingressNginx(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
 	return a.getApplication(ctx, "ingress-nginx")
 }
@@ -127,6 +128,10 @@ func (a *ApplicationReferenceGetter) kubernetesDashboard(ctx context.Context) (*
 	return a.getApplication(ctx, "kubernetes-dashboard")
 }
 
+func (a *ApplicationReferenceGetter) kyverno(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
+	return a.getApplication(ctx, "kyverno")
+}
+
 func (a *ApplicationReferenceGetter) longhorn(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
 	return a.getApplication(ctx, "longhorn")
 }
@@ -135,17 +140,41 @@ func (a *ApplicationReferenceGetter) prometheus(ctx context.Context) (*coreuniko
 	return a.getApplication(ctx, "prometheus")
 }
 
+func (a *ApplicationReferenceGetter) velero(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
+	return a.getApplication(ctx, "velero")
+}
+
+// Options allow the provisioner to be configured by the operator.
+type Options struct {
+	// Backup defines where cluster backups are stored.
+	Backup velero.Options
+
+	// MetricsFederation defines where cluster metrics are federated to.
+	MetricsFederation prometheus.Options
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	o.Backup.AddFlags(f)
+	o.MetricsFederation.AddFlags(f)
+}
+
 // Provisioner encapsulates control plane provisioning.
 type Provisioner struct {
 	provisioners.Metadata
 
 	// cluster is the Kubernetes cluster we're provisioning.
 	cluster unikornv1.KubernetesCluster
+
+	// options are operator provided configuration.
+	options *Options
 }
 
 // New returns a new initialized provisioner object.
-func New() provisioners.ManagerProvisioner {
-	return &Provisioner{}
+func New(options *Options) provisioners.ManagerProvisioner {
+	return &Provisioner{
+		options: options,
+	}
 }
 
 // Ensure the ManagerProvisioner interface is implemented.
@@ -182,7 +211,16 @@ func (p *Provisioner) getControlPlane(ctx context.Context) (*unikornv1.ControlPl
 	return &controlPlane, nil
 }
 
-func (p *Provisioner) getProvisioner(ctx context.Context) (provisioners.Provisioner, error) {
+// getProvisioner returns the provisioner for the cluster.  When hibernated, only the
+// cluster itself is provisioned in order to scale the workload pools down.  Add-ons
+// are left as they are, they would never become healthy without any worker nodes to
+// schedule onto.
+func (p *Provisioner) getProvisioner(ctx context.Context, hibernated bool) (provisioners.Provisioner, error) {
+	backend, err := backends.Get(&p.cluster)
+	if err != nil {
+		return nil, err
+	}
+
 	apps := newApplicationReferenceGetter(&p.cluster)
 
 	controlPlane, err := p.getControlPlane(ctx)
@@ -197,46 +235,63 @@ func (p *Provisioner) getProvisioner(ctx context.Context) (provisioners.Provisio
 		return nil, err
 	}
 
-	remoteCluster := remotecluster.New(clusteropenstack.NewRemoteCluster(&p.cluster), true)
+	remoteCluster := remotecluster.New(backend.RemoteCluster(&p.cluster), true)
+
+	timer := newStageTimer(&p.cluster)
 
-	clusterProvisioner := clusteropenstack.New(apps.clusterOpenstack, controlPlanePrefix).InNamespace(p.cluster.Name)
+	clusterProvisioner := timer.wrap(stageCluster, backend.Cluster(apps.getter, &p.cluster, controlPlanePrefix))
 
 	// These applications are required to get the cluster up and running, they must
 	// tolerate control plane taints, be scheduled onto control plane nodes and allow
-	// scale from zero.
-	bootstrapProvisioner := concurrent.New("cluster bootstrap",
-		cilium.New(apps.cilium),
-		openstackcloudprovider.New(apps.openstackCloudProvider),
-	)
+	// scale from zero.  Users may bring their own CNI, in which case none is installed.
+	bootstrapProvisioner := timer.wrap(stageBootstrap, concurrent.New("cluster bootstrap",
+		conditional.New("cilium", p.cluster.CiliumEnabled, cilium.New(apps.cilium)),
+		conditional.New("calico", p.cluster.CalicoEnabled, calico.New(apps.calico)),
+		backend.Bootstrap(apps.getter, &p.cluster),
+	))
+
+	if hibernated {
+		provisioner := remoteControlPlane.ProvisionOn(
+			concurrent.New("kubernetes cluster",
+				clusterProvisioner,
+				remoteCluster.ProvisionOn(bootstrapProvisioner, remotecluster.BackgroundDeletion),
+			),
+		)
+
+		return provisioner, nil
+	}
 
-	clusterAutoscalerProvisioner := conditional.New("cluster-autoscaler",
+	clusterAutoscalerProvisioner := timer.wrap(stageAutoscaler, conditional.New("cluster-autoscaler",
 		p.cluster.AutoscalingEnabled,
 		concurrent.New("cluster-autoscaler",
 			clusterautoscaler.New(apps.clusterAutoscaler).InNamespace(p.cluster.Name),
-			clusterautoscaleropenstack.New(apps.clusterAutoscalerOpenstack).InNamespace(p.cluster.Name),
+			backend.Autoscaler(apps.getter, &p.cluster),
 		),
-	)
+	))
 
 	certManagerProvisioner := serial.New("cert-manager",
 		certmanager.New(apps.certManager),
 		certmanagerissuers.New(apps.certManagerIssuers),
 	)
 
-	addonsProvisioner := serial.New("cluster add-ons",
+	addonsProvisioner := timer.wrap(stageAddOns, serial.New("cluster add-ons",
 		concurrent.New("cluster add-ons wave 1",
-			openstackplugincindercsi.New(apps.openstackPluginCinderCSI),
+			backend.Storage(apps.getter, &p.cluster),
 			metricsserver.New(apps.metricsServer),
 			conditional.New("nvidia-gpu-operator", p.cluster.NvidiaOperatorEnabled, nvidiagpuoperator.New(apps.nvidiaGPUOperator)),
 			conditional.New("ingress-nginx", p.cluster.IngressEnabled, ingressnginx.New(apps.ingressNginx)),
 			conditional.New("cert-manager", p.cluster.CertManagerEnabled, certManagerProvisioner),
 			conditional.New("longhorn", p.cluster.FileStorageEnabled, longhorn.New(apps.longhorn)),
-			conditional.New("prometheus", p.cluster.PrometheusEnabled, prometheus.New(apps.prometheus)),
+			conditional.New("prometheus", p.cluster.PrometheusEnabled, prometheus.New(apps.prometheus, &p.options.MetricsFederation)),
+			conditional.New("velero", p.cluster.BackupEnabled, velero.New(apps.velero, &p.options.Backup)),
+			conditional.New("kyverno", p.cluster.CostAllocationEnabled, kyverno.New(apps.kyverno)),
+			conditional.New("warm-pool", p.cluster.WarmPoolsEnabled, warmpool.New(&p.cluster)),
 		),
 		concurrent.New("cluster add-ons wave 2",
 			// TODO: this hack where it needs the remote is pretty ugly.
 			conditional.New("kubernetes-dashboard", p.cluster.KubernetesDashboardEnabled, kubernetesdashboard.New(apps.kubernetesDashboard)),
 		),
-	)
+	))
 
 	// Create the cluster and the boostrap components in parallel, the cluster will
 	// come up but never reach healthy until the CNI and cloud controller manager
@@ -258,7 +313,12 @@ func (p *Provisioner) getProvisioner(ctx context.Context) (provisioners.Provisio
 
 // Provision implements the Provision interface.
 func (p *Provisioner) Provision(ctx context.Context) error {
-	provisioner, err := p.getProvisioner(ctx)
+	// Avoid needlessly reapplying applications that haven't changed.
+	ctx = cd.NewContext(ctx, newDifferentialDriver(cd.FromContext(ctx), &p.cluster))
+
+	hibernated := p.cluster.Hibernated()
+
+	provisioner, err := p.getProvisioner(ctx, hibernated)
 	if err != nil {
 		return err
 	}
@@ -267,12 +327,53 @@ func (p *Provisioner) Provision(ctx context.Context) error {
 		return err
 	}
 
+	// These are persisted by the reconciler along with the other status conditions.
+	p.cluster.HibernationConditionWrite(hibernated)
+
+	upgrading := p.cluster.ControlPlaneUpgrading()
+
+	p.recordDeployedVersions(upgrading)
+
+	// Workload pools were held back while the control plane was upgraded, now
+	// it has been, yield so they are upgraded on the next reconcile.
+	if upgrading {
+		return provisioners.ErrYield
+	}
+
 	return nil
 }
 
+// recordDeployedVersions records the Kubernetes versions and images that have
+// been successfully deployed.  Workload pools are only recorded when they
+// weren't held back by a control plane upgrade.
+func (p *Provisioner) recordDeployedVersions(upgrading bool) {
+	p.cluster.Status.ControlPlane = &unikornv1.MachineVersionStatus{
+		Version: *p.cluster.Spec.ControlPlane.Version,
+		Image:   *p.cluster.Spec.ControlPlane.Image,
+	}
+
+	if upgrading {
+		return
+	}
+
+	workloadPools := make([]unikornv1.WorkloadPoolVersionStatus, len(p.cluster.Spec.WorkloadPools.Pools))
+
+	for i, pool := range p.cluster.Spec.WorkloadPools.Pools {
+		workloadPools[i] = unikornv1.WorkloadPoolVersionStatus{
+			Name: pool.Name,
+			MachineVersionStatus: unikornv1.MachineVersionStatus{
+				Version: *pool.Version,
+				Image:   *pool.Image,
+			},
+		}
+	}
+
+	p.cluster.Status.WorkloadPools = workloadPools
+}
+
 // Deprovision implements the Provision interface.
 func (p *Provisioner) Deprovision(ctx context.Context) error {
-	provisioner, err := p.getProvisioner(ctx)
+	provisioner, err := p.getProvisioner(ctx, false)
 	if err != nil {
 		return err
 	}
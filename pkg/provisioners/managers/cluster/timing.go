@@ -0,0 +1,177 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// stageCluster covers the network, control plane machines and workload
+	// pool machines, all of which are created by Cluster API from a single
+	// application.
+	stageCluster = "cluster"
+
+	// stageBootstrap covers the CNI and cloud provider.
+	stageBootstrap = "bootstrap"
+
+	// stageAutoscaler covers the cluster autoscaler.
+	stageAutoscaler = "autoscaler"
+
+	// stageAddOns covers the optional cluster add-ons.
+	stageAddOns = "addons"
+)
+
+var (
+	// Stages may take anywhere from seconds, for something that's already
+	// deployed, to tens of minutes while waiting for servers to boot.
+	//nolint:gochecknoglobals
+	stageDurationMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "unikorn_cluster_provision_stage_duration",
+		Help: "Time taken for a cluster provisioning stage to complete",
+		Buckets: []float64{
+			1, 5, 10, 30, 60, 120, 180, 240, 300, 450, 600, 900, 1200, 1800, 3600,
+		},
+	}, []string{"stage"})
+)
+
+//nolint:gochecknoinits
+func init() {
+	metrics.Registry.MustRegister(stageDurationMetric)
+}
+
+// stageTimer records provisioning stage timings in the cluster status.  Stages
+// may be provisioned concurrently, hence the lock.
+type stageTimer struct {
+	lock sync.Mutex
+
+	cluster *unikornv1.KubernetesCluster
+}
+
+func newStageTimer(cluster *unikornv1.KubernetesCluster) *stageTimer {
+	return &stageTimer{
+		cluster: cluster,
+	}
+}
+
+// start records the start of a stage, unless it's already in progress or has
+// completed for the current generation of the cluster.
+func (t *stageTimer) start(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	generation := t.cluster.Generation
+
+	if timing := t.cluster.StageTiming(name); timing != nil {
+		if timing.Generation == generation {
+			return
+		}
+
+		timing.Generation = generation
+		timing.StartTime = metav1.Now()
+		timing.CompletionTime = nil
+
+		return
+	}
+
+	t.cluster.Status.StageTimings = append(t.cluster.Status.StageTimings, unikornv1.ProvisioningStageTiming{
+		Name:       name,
+		Generation: generation,
+		StartTime:  metav1.Now(),
+	})
+}
+
+// complete records the completion of a stage, only the first completion
+// is recorded and observed.
+func (t *stageTimer) complete(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	timing := t.cluster.StageTiming(name)
+	if timing == nil || timing.CompletionTime != nil {
+		return
+	}
+
+	now := metav1.Now()
+
+	timing.CompletionTime = &now
+
+	stageDurationMetric.WithLabelValues(name).Observe(time.Since(timing.StartTime.Time).Seconds())
+}
+
+// wrap returns a provisioner that times the provided one as the named stage.
+func (t *stageTimer) wrap(name string, provisioner provisioners.Provisioner) provisioners.Provisioner {
+	return &timedProvisioner{
+		Metadata: provisioners.Metadata{
+			Name: provisioner.ProvisionerName(),
+		},
+		stage:       name,
+		timer:       t,
+		provisioner: provisioner,
+	}
+}
+
+// timedProvisioner records the time taken to provision its child.
+type timedProvisioner struct {
+	provisioners.Metadata
+
+	// stage is the name of the stage being timed.
+	stage string
+
+	// timer records the timings.
+	timer *stageTimer
+
+	// provisioner is the provisioner to time.
+	provisioner provisioners.Provisioner
+}
+
+// Ensure the Provisioner interface is implemented.
+var _ provisioners.Provisioner = &timedProvisioner{}
+
+// Provision implements the Provision interface.
+func (p *timedProvisioner) Provision(ctx context.Context) error {
+	p.PropagateOptions(p.provisioner)
+
+	p.timer.start(p.stage)
+
+	if err := p.provisioner.Provision(ctx); err != nil {
+		return err
+	}
+
+	p.timer.complete(p.stage)
+
+	return nil
+}
+
+// Deprovision implements the Provision interface.
+func (p *timedProvisioner) Deprovision(ctx context.Context) error {
+	p.PropagateOptions(p.provisioner)
+
+	return p.provisioner.Deprovision(ctx)
+}
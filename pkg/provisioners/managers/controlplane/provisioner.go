@@ -72,7 +72,23 @@ func newApplicationReferenceGetter(controlPlane *unikornv1.ControlPlane) *Applic
 	}
 }
 
-func (a *ApplicationReferenceGetter) getApplication(ctx context.Context, name string) (*coreunikornv1.ApplicationReference, error) {
+const (
+	vclusterApplication    = "vcluster"
+	certManagerApplication = "cert-manager"
+	clusterAPIApplication  = "cluster-api"
+)
+
+// components are the applications that make up a control plane, and whose
+// versions are reported in the status.
+//
+//nolint:gochecknoglobals
+var components = []string{
+	vclusterApplication,
+	certManagerApplication,
+	clusterAPIApplication,
+}
+
+func (a *ApplicationReferenceGetter) getBundle(ctx context.Context) (*unikornv1.ControlPlaneApplicationBundle, error) {
 	cli := coreclient.StaticClientFromContext(ctx)
 
 	key := client.ObjectKey{
@@ -85,19 +101,28 @@ func (a *ApplicationReferenceGetter) getApplication(ctx context.Context, name st
 		return nil, err
 	}
 
+	return bundle, nil
+}
+
+func (a *ApplicationReferenceGetter) getApplication(ctx context.Context, name string) (*coreunikornv1.ApplicationReference, error) {
+	bundle, err := a.getBundle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return bundle.Spec.GetApplication(name)
 }
 
 func (a *ApplicationReferenceGetter) vCluster(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
-	return a.getApplication(ctx, "vcluster")
+	return a.getApplication(ctx, vclusterApplication)
 }
 
 func (a *ApplicationReferenceGetter) certManager(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
-	return a.getApplication(ctx, "cert-manager")
+	return a.getApplication(ctx, certManagerApplication)
 }
 
 func (a *ApplicationReferenceGetter) clusterAPI(ctx context.Context) (*coreunikornv1.ApplicationReference, error) {
-	return a.getApplication(ctx, "cluster-api")
+	return a.getApplication(ctx, clusterAPIApplication)
 }
 
 // Provisioner encapsulates control plane provisioning.
@@ -174,6 +199,53 @@ func (p *Provisioner) deprovisionClusters(ctx context.Context, namespace string)
 	return nil
 }
 
+// resolveComponents records the component versions requested by the application
+// bundle.  Deployed versions are preserved until provisioning succeeds.
+func (p *Provisioner) resolveComponents(ctx context.Context) error {
+	bundle, err := newApplicationReferenceGetter(&p.controlPlane).getBundle(ctx)
+	if err != nil {
+		return err
+	}
+
+	deployed := map[string]string{}
+
+	for _, component := range p.controlPlane.Status.Components {
+		deployed[component.Name] = component.DeployedVersion
+	}
+
+	result := make([]unikornv1.ControlPlaneComponentStatus, len(components))
+
+	for i, name := range components {
+		reference, err := bundle.Spec.GetApplication(name)
+		if err != nil {
+			return err
+		}
+
+		result[i] = unikornv1.ControlPlaneComponentStatus{
+			Name:            name,
+			DeployedVersion: deployed[name],
+		}
+
+		if reference.Version != nil {
+			result[i].Version = *reference.Version
+		}
+	}
+
+	p.controlPlane.Status.Components = result
+
+	return nil
+}
+
+// componentsDeployed records that the requested component versions are
+// now deployed.
+func (p *Provisioner) componentsDeployed() {
+	for i := range p.controlPlane.Status.Components {
+		component := &p.controlPlane.Status.Components[i]
+
+		component.DeployedVersion = component.Version
+	}
+}
+
 // getControlPlaneProvisioner returns a provisoner that encodes control plane
 // provisioning steps.
 func (p *Provisioner) getControlPlaneProvisioner(namespace string) provisioners.Provisioner {
@@ -192,7 +264,7 @@ func (p *Provisioner) getControlPlaneProvisioner(namespace string) provisioners.
 	// Provision the vitual cluster, setup the remote cluster then
 	// install cert manager and cluster API into it.
 	return serial.New("control plane",
-		vcluster.New(apps.vCluster).InNamespace(namespace),
+		vcluster.New(apps.vCluster, p.controlPlane.Spec.Resources).InNamespace(namespace),
 		remoteControlPlane.ProvisionOn(clusterAPIProvisioner),
 	)
 }
@@ -217,10 +289,16 @@ func (p *Provisioner) Provision(ctx context.Context) error {
 	// latency at the front-end.
 	p.controlPlane.Status.Namespace = namespace.Name
 
+	if err := p.resolveComponents(ctx); err != nil {
+		return err
+	}
+
 	if err := p.getControlPlaneProvisioner(namespace.Name).Provision(ctx); err != nil {
 		return err
 	}
 
+	p.componentsDeployed()
+
 	log.Info("control plane provisioned")
 
 	return nil
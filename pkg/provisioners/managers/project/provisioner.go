@@ -21,6 +21,7 @@ import (
 	"errors"
 
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
 
 	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
@@ -32,7 +33,7 @@ import (
 )
 
 var (
-	ErrLabelMissing = errors.New("expected label missing")
+	ErrLabelMissing = unikornerrors.Invalid("expected label missing")
 )
 
 // Provisioner encapsulates control plane provisioning.
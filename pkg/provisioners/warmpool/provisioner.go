@@ -0,0 +1,297 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package warmpool keeps spare machines booted in workload pools.  Each spare
+// is held by a placeholder pod with a negative priority, when a workload needs
+// the space the placeholder is preempted, becomes unschedulable, and the cluster
+// autoscaler boots a replacement machine for it to run on.
+package warmpool
+
+import (
+	"context"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+
+	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+	"github.com/eschercloudai/unikorn-core/pkg/util"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// namespace is where placeholders are run.
+	namespace = "unikorn-warm-pool"
+
+	// priorityClassName is the priority class given to placeholders.
+	priorityClassName = "unikorn-warm-pool"
+
+	// priority is low enough that any workload preempts a placeholder, but
+	// above the autoscaler's default expendable pod cutoff of -10, so pending
+	// placeholders still trigger a scale up.
+	priority = -1
+
+	// image does nothing and uses next to no resources.
+	image = "registry.k8s.io/pause:3.9"
+
+	// appLabel identifies placeholder deployments and their pods.
+	appLabel = "app.kubernetes.io/name"
+
+	// appLabelValue is the value of appLabel.
+	appLabelValue = "warm-pool"
+)
+
+// Provisioner keeps a number of spare machines booted in each workload pool.
+type Provisioner struct {
+	provisioners.Metadata
+
+	// cluster is the cluster the workload pools belong to.
+	cluster *unikornv1.KubernetesCluster
+}
+
+// Ensure the Provisioner interface is implemented.
+var _ provisioners.Provisioner = &Provisioner{}
+
+// New returns a new initialized provisioner object.
+func New(cluster *unikornv1.KubernetesCluster) *Provisioner {
+	return &Provisioner{
+		Metadata: provisioners.Metadata{
+			Name: "warm-pool",
+		},
+		cluster: cluster,
+	}
+}
+
+// deploymentName returns the placeholder deployment name for a pool.
+func deploymentName(pool *unikornv1.KubernetesClusterWorkloadPoolsPoolSpec) string {
+	return "warm-pool-" + pool.Name
+}
+
+// resources returns the placeholder's resource requests.  These are just over
+// half of a machine, so no two placeholders can share one, while still fitting
+// on a new machine alongside any daemon sets.
+func resources(pool *unikornv1.KubernetesClusterWorkloadPoolsPoolSpec) corev1.ResourceList {
+	scheduler := pool.Autoscaling.Scheduler
+
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewMilliQuantity(int64(*scheduler.CPU)*500+1, resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(scheduler.Memory.Value()/2+1, resource.BinarySI),
+	}
+}
+
+// generatePodSpec creates a placeholder that will only run on the pool's
+// machines, one per machine.
+func generatePodSpec(pool *unikornv1.KubernetesClusterWorkloadPoolsPoolSpec, labels map[string]string) corev1.PodSpec {
+	return corev1.PodSpec{
+		PriorityClassName:             priorityClassName,
+		TerminationGracePeriodSeconds: util.ToPointer[int64](0),
+		NodeSelector: map[string]string{
+			constants.WorkloadPoolLabel: pool.Name,
+		},
+		// Pool taints repel workloads, not spare capacity.
+		Tolerations: []corev1.Toleration{
+			{
+				Operator: corev1.TolerationOpExists,
+			},
+		},
+		Affinity: &corev1.Affinity{
+			PodAntiAffinity: &corev1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
+					{
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: labels,
+						},
+						TopologyKey: corev1.LabelHostname,
+					},
+				},
+			},
+		},
+		Containers: []corev1.Container{
+			{
+				Name:  "placeholder",
+				Image: image,
+				Resources: corev1.ResourceRequirements{
+					Requests: resources(pool),
+				},
+			},
+		},
+	}
+}
+
+// provisionPool creates or updates the placeholders for a pool.
+func provisionPool(ctx context.Context, c client.Client, pool *unikornv1.KubernetesClusterWorkloadPoolsPoolSpec) error {
+	labels := map[string]string{
+		appLabel:                    appLabelValue,
+		constants.WorkloadPoolLabel: pool.Name,
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      deploymentName(pool),
+		},
+	}
+
+	mutate := func() error {
+		deployment.Labels = labels
+		//nolint:gosec
+		deployment.Spec.Replicas = util.ToPointer(int32(pool.WarmMachines()))
+		deployment.Spec.Selector = &metav1.LabelSelector{
+			MatchLabels: labels,
+		}
+		deployment.Spec.Template = corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: labels,
+			},
+			Spec: generatePodSpec(pool, labels),
+		}
+
+		return nil
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, c, deployment, mutate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// prune deletes the placeholders for any pools that no longer need them.
+func prune(ctx context.Context, c client.Client, wanted map[string]bool) error {
+	log := log.FromContext(ctx)
+
+	deployments := &appsv1.DeploymentList{}
+
+	if err := c.List(ctx, deployments, client.InNamespace(namespace), client.MatchingLabels{appLabel: appLabelValue}); err != nil {
+		return err
+	}
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+
+		if wanted[deployment.Name] {
+			continue
+		}
+
+		log.Info("deleting warm pool", "pool", deployment.Labels[constants.WorkloadPoolLabel])
+
+		if err := c.Delete(ctx, deployment); err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Provision implements the Provision interface.
+func (p *Provisioner) Provision(ctx context.Context) error {
+	c := coreclient.DynamicClientFromContext(ctx)
+
+	priorityClass := &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: priorityClassName,
+		},
+	}
+
+	// The value and preemption policy are immutable, so are only set on creation.
+	mutatePriorityClass := func() error {
+		if priorityClass.CreationTimestamp.IsZero() {
+			priorityClass.Value = priority
+			priorityClass.PreemptionPolicy = util.ToPointer(corev1.PreemptNever)
+		}
+
+		priorityClass.Description = "Placeholders that keep spare machines booted"
+
+		return nil
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, c, priorityClass, mutatePriorityClass); err != nil {
+		return err
+	}
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+		},
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, c, ns, func() error { return nil }); err != nil {
+		return err
+	}
+
+	wanted := map[string]bool{}
+
+	for i := range p.cluster.Spec.WorkloadPools.Pools {
+		pool := &p.cluster.Spec.WorkloadPools.Pools[i]
+
+		if pool.WarmMachines() == 0 {
+			continue
+		}
+
+		if err := provisionPool(ctx, c, pool); err != nil {
+			return err
+		}
+
+		wanted[deploymentName(pool)] = true
+	}
+
+	return prune(ctx, c, wanted)
+}
+
+// Deprovision implements the Provision interface.
+func (p *Provisioner) Deprovision(ctx context.Context) error {
+	log := log.FromContext(ctx)
+
+	c := coreclient.DynamicClientFromContext(ctx)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+		},
+	}
+
+	if err := c.Delete(ctx, ns); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+	} else if !p.BackgroundDelete {
+		log.Info("awaiting warm pool deletion")
+
+		return provisioners.ErrYield
+	}
+
+	priorityClass := &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: priorityClassName,
+		},
+	}
+
+	if err := c.Delete(ctx, priorityClass); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
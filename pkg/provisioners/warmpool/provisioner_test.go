@@ -0,0 +1,165 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package warmpool_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/warmpool"
+
+	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
+	"github.com/eschercloudai/unikorn-core/pkg/provisioners"
+	"github.com/eschercloudai/unikorn-core/pkg/util"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newPool returns an autoscaled pool of 4 core, 16Gi machines.
+func newPool(name string, warmMachines int) unikornv1.KubernetesClusterWorkloadPoolsPoolSpec {
+	pool := unikornv1.KubernetesClusterWorkloadPoolsPoolSpec{}
+	pool.Name = name
+	pool.Autoscaling = &unikornv1.MachineGenericAutoscaling{
+		MinimumReplicas: util.ToPointer(1),
+		MaximumReplicas: util.ToPointer(5),
+		WarmMachines:    util.ToPointer(warmMachines),
+		Scheduler: &unikornv1.MachineGenericAutoscalingScheduler{
+			CPU:    util.ToPointer(4),
+			Memory: util.ToPointer(resource.MustParse("16Gi")),
+		},
+	}
+
+	return pool
+}
+
+// newCluster returns an autoscaled cluster with the given pools.
+func newCluster(pools ...unikornv1.KubernetesClusterWorkloadPoolsPoolSpec) *unikornv1.KubernetesCluster {
+	return &unikornv1.KubernetesCluster{
+		Spec: unikornv1.KubernetesClusterSpec{
+			Features: &unikornv1.KubernetesClusterFeaturesSpec{
+				Autoscaling: util.ToPointer(true),
+			},
+			WorkloadPools: &unikornv1.KubernetesClusterWorkloadPoolsSpec{
+				Pools: pools,
+			},
+		},
+	}
+}
+
+// newContext returns a context with a fake workload cluster client.
+func newContext(t *testing.T) (context.Context, client.Client) {
+	t.Helper()
+
+	scheme, err := coreclient.NewScheme()
+	assert.NoError(t, err)
+
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	return coreclient.NewContextWithDynamicClient(context.Background(), c), c
+}
+
+// TestProvision tests placeholders are sized to hold a machine each, and only
+// run on the pool's machines.
+func TestProvision(t *testing.T) {
+	t.Parallel()
+
+	ctx, c := newContext(t)
+
+	cluster := newCluster(newPool("foo", 2), newPool("bar", 0))
+	assert.True(t, cluster.WarmPoolsEnabled())
+
+	assert.NoError(t, warmpool.New(cluster).Provision(ctx))
+
+	priorityClass := &schedulingv1.PriorityClass{}
+	assert.NoError(t, c.Get(ctx, client.ObjectKey{Name: "unikorn-warm-pool"}, priorityClass))
+	assert.Less(t, priorityClass.Value, int32(0))
+
+	deployments := &appsv1.DeploymentList{}
+	assert.NoError(t, c.List(ctx, deployments, client.InNamespace("unikorn-warm-pool")))
+	assert.Len(t, deployments.Items, 1)
+
+	deployment := deployments.Items[0]
+	assert.Equal(t, "warm-pool-foo", deployment.Name)
+	assert.Equal(t, int32(2), *deployment.Spec.Replicas)
+
+	spec := deployment.Spec.Template.Spec
+	assert.Equal(t, "unikorn-warm-pool", spec.PriorityClassName)
+	assert.Equal(t, map[string]string{constants.WorkloadPoolLabel: "foo"}, spec.NodeSelector)
+
+	requests := spec.Containers[0].Resources.Requests
+	assert.Equal(t, "2001m", requests.Cpu().String())
+	assert.Equal(t, int64(8<<30+1), requests.Memory().Value())
+}
+
+// TestProvisionPrune tests placeholders are removed when a pool no longer
+// needs spare machines.
+func TestProvisionPrune(t *testing.T) {
+	t.Parallel()
+
+	ctx, c := newContext(t)
+
+	assert.NoError(t, warmpool.New(newCluster(newPool("foo", 2), newPool("bar", 1))).Provision(ctx))
+	assert.NoError(t, warmpool.New(newCluster(newPool("foo", 3), newPool("bar", 0))).Provision(ctx))
+
+	deployments := &appsv1.DeploymentList{}
+	assert.NoError(t, c.List(ctx, deployments, client.InNamespace("unikorn-warm-pool")))
+	assert.Len(t, deployments.Items, 1)
+	assert.Equal(t, "warm-pool-foo", deployments.Items[0].Name)
+	assert.Equal(t, int32(3), *deployments.Items[0].Spec.Replicas)
+}
+
+// TestDeprovision tests deprovisioning waits for placeholders to be removed.
+func TestDeprovision(t *testing.T) {
+	t.Parallel()
+
+	ctx, c := newContext(t)
+
+	provisioner := warmpool.New(newCluster(newPool("foo", 2)))
+
+	assert.NoError(t, provisioner.Provision(ctx))
+	assert.ErrorIs(t, provisioner.Deprovision(ctx), provisioners.ErrYield)
+	assert.NoError(t, provisioner.Deprovision(ctx))
+
+	err := c.Get(ctx, client.ObjectKey{Name: "unikorn-warm-pool"}, &corev1.Namespace{})
+	assert.True(t, kerrors.IsNotFound(err))
+
+	err = c.Get(ctx, client.ObjectKey{Name: "unikorn-warm-pool"}, &schedulingv1.PriorityClass{})
+	assert.True(t, kerrors.IsNotFound(err))
+}
+
+// TestWarmPoolsEnabled tests spare machines are only provided by the cluster
+// autoscaler.
+func TestWarmPoolsEnabled(t *testing.T) {
+	t.Parallel()
+
+	cluster := newCluster(newPool("foo", 2))
+	cluster.Spec.Features.Autoscaling = util.ToPointer(false)
+
+	assert.False(t, cluster.WarmPoolsEnabled())
+	assert.False(t, newCluster(newPool("foo", 0)).WarmPoolsEnabled())
+}
@@ -18,12 +18,14 @@ package authorization
 
 import (
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/eschercloudai/unikorn/pkg/providers/openstack"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization/jose"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization/keystone"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/rolebinding"
 	"github.com/eschercloudai/unikorn/pkg/server/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
 )
@@ -38,15 +40,19 @@ type Authenticator struct {
 
 	// Keystone provides OpenStack authentication.
 	Keystone *keystone.Authenticator
+
+	// roleBindings grants project roles declaratively.
+	roleBindings *rolebinding.Resolver
 }
 
 // NewAuthenticator returns a new authenticator with required fields populated.
 // You must call AddFlags after this.
-func NewAuthenticator(issuer *jose.JWTIssuer, oauth2 *oauth2.Authenticator, keystone *keystone.Authenticator) *Authenticator {
+func NewAuthenticator(issuer *jose.JWTIssuer, oauth2 *oauth2.Authenticator, keystone *keystone.Authenticator, roleBindings *rolebinding.Resolver) *Authenticator {
 	return &Authenticator{
-		issuer:   issuer,
-		OAuth2:   oauth2,
-		Keystone: keystone,
+		issuer:       issuer,
+		OAuth2:       oauth2,
+		Keystone:     keystone,
+		roleBindings: roleBindings,
 	}
 }
 
@@ -67,7 +73,7 @@ func (a *Authenticator) Token(r *http.Request, scope *generated.TokenScope) (*ge
 		return nil, errors.OAuth2ServerError("unable to get unikorn claims")
 	}
 
-	keystoneToken, user, err := identity.CreateToken(r.Context(), openstack.NewCreateTokenOptionsScopedToken(tokenClaims.UnikornClaims.Token, scope.Project.Id))
+	keystoneToken, user, roles, err := identity.CreateToken(r.Context(), openstack.NewCreateTokenOptionsScopedToken(tokenClaims.UnikornClaims.Token, scope.Project.Id))
 	if err != nil {
 		return nil, errors.OAuth2AccessDenied("authentication failed").WithError(err)
 	}
@@ -76,6 +82,7 @@ func (a *Authenticator) Token(r *http.Request, scope *generated.TokenScope) (*ge
 		Token:   keystoneToken.ID,
 		User:    user.ID,
 		Project: scope.Project.Id,
+		Groups:  tokenClaims.UnikornClaims.Groups,
 	}
 
 	// Add some scope to the claims to allow the token to do more.
@@ -85,6 +92,45 @@ func (a *Authenticator) Token(r *http.Request, scope *generated.TokenScope) (*ge
 		},
 	}
 
+	roleNames := make([]string, len(roles))
+
+	for i, role := range roles {
+		roleNames[i] = role.Name
+	}
+
+	for _, role := range roleNames {
+		if slices.Contains(a.Keystone.AdminRoles(), role) {
+			oAuth2Scope.Scopes = append(oAuth2Scope.Scopes, oauth2.ScopeAdmin)
+
+			break
+		}
+	}
+
+	// Project roles may be granted by Keystone role assignments, or by
+	// project role bindings, the most privileged wins.
+	projectRoles, err := a.roleBindings.Roles(r.Context(), scope.Project.Id, uClaims.Groups, roleNames)
+	if err != nil {
+		return nil, err
+	}
+
+	if projectRole, ok := a.Keystone.ProjectRole(roleNames); ok {
+		projectRoles = append(projectRoles, projectRole)
+	}
+
+	// Users without a recognised project role can only view resources.
+	if projectRole, ok := keystone.MostPrivileged(projectRoles); ok {
+		uClaims.Role = string(projectRole)
+
+		switch projectRole {
+		case keystone.ProjectRoleAdmin:
+			oAuth2Scope.Scopes = append(oAuth2Scope.Scopes, oauth2.ScopeProjectWrite, oauth2.ScopeProjectMembers)
+		case keystone.ProjectRoleEditor:
+			oAuth2Scope.Scopes = append(oAuth2Scope.Scopes, oauth2.ScopeProjectWrite)
+		case keystone.ProjectRoleReader:
+			// Readers can only view resources.
+		}
+	}
+
 	accessToken, err := oauth2.Issue(a.issuer, r, tokenClaims.Subject, uClaims, oAuth2Scope, keystoneToken.ExpiresAt)
 	if err != nil {
 		return nil, errors.OAuth2ServerError("unable to create access token").WithError(err)
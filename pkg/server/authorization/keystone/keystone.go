@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
@@ -36,6 +37,41 @@ var (
 	ErrTokenExchange = errors.New("keystone token exchange failed")
 )
 
+// ProjectRole defines what a user may do within a project.
+type ProjectRole string
+
+const (
+	// ProjectRoleAdmin may modify resources and manage project members.
+	ProjectRoleAdmin ProjectRole = "admin"
+
+	// ProjectRoleEditor may modify resources.
+	ProjectRoleEditor ProjectRole = "editor"
+
+	// ProjectRoleReader may only view resources.
+	ProjectRoleReader ProjectRole = "reader"
+)
+
+// projectRolesByPrivilege orders project roles, most privileged first.
+//
+//nolint:gochecknoglobals
+var projectRolesByPrivilege = []ProjectRole{
+	ProjectRoleAdmin,
+	ProjectRoleEditor,
+	ProjectRoleReader,
+}
+
+// MostPrivileged returns the most privileged of the project roles, or false
+// if none are given.
+func MostPrivileged(roles []ProjectRole) (ProjectRole, bool) {
+	for _, role := range projectRolesByPrivilege {
+		if slices.Contains(roles, role) {
+			return role, true
+		}
+	}
+
+	return "", false
+}
+
 type Options struct {
 	// Endpoint is the Keystone Endpoint.
 	Endpoint string
@@ -43,6 +79,18 @@ type Options struct {
 	// Domain is the default domain users live under.
 	Domain string
 
+	// AdminRoles are roles that grant administrative privileges.
+	AdminRoles []string
+
+	// ProjectAdminRoles are roles that map onto the project admin role.
+	ProjectAdminRoles []string
+
+	// ProjectEditorRoles are roles that map onto the project editor role.
+	ProjectEditorRoles []string
+
+	// ProjectReaderRoles are roles that map onto the project reader role.
+	ProjectReaderRoles []string
+
 	// keystoneFederationTokenEndpoint is where we can exchange an OpenID
 	// id_token for a Keystone API token.
 	keystoneFederationTokenEndpoint string
@@ -53,6 +101,10 @@ func (o *Options) AddFlags(f *pflag.FlagSet) {
 	f.StringVar(&o.Endpoint, "keystone-endpoint", "https://nl1.eschercloud.com:5000", "Keystone endpoint to use for authn/authz.")
 	f.StringVar(&o.keystoneFederationTokenEndpoint, "keystone-federation-token-endpoint", "https://nl1.eschercloud.com:5000/v3/OS-FEDERATION/identity_providers/onelogindev/protocols/openid/auth", "Where we can exchange an OpenID identity for an API token.")
 	f.StringVar(&o.Domain, "keystone-user-domain-name", "Default", "Keystone user domain name for password authentication.")
+	f.StringSliceVar(&o.AdminRoles, "keystone-admin-roles", nil, "Keystone roles that grant administrative privileges, for example debug capture.  May be specified more than once.")
+	f.StringSliceVar(&o.ProjectAdminRoles, "keystone-project-admin-roles", []string{"manager"}, "Keystone roles that map onto the project admin role, the first is assigned when granting the role.  May be specified more than once.")
+	f.StringSliceVar(&o.ProjectEditorRoles, "keystone-project-editor-roles", []string{"member", "_member_"}, "Keystone roles that map onto the project editor role, the first is assigned when granting the role.  May be specified more than once.")
+	f.StringSliceVar(&o.ProjectReaderRoles, "keystone-project-reader-roles", []string{"reader"}, "Keystone roles that map onto the project reader role, the first is assigned when granting the role.  May be specified more than once.")
 }
 
 // Authenticator provides Keystone authentication functionality.
@@ -79,6 +131,56 @@ func (a *Authenticator) Domain() string {
 	return a.options.Domain
 }
 
+// AdminRoles returns the roles that grant administrative privileges.
+func (a *Authenticator) AdminRoles() []string {
+	return a.options.AdminRoles
+}
+
+// projectRoles returns the Keystone roles that map onto a project role.
+func (a *Authenticator) projectRoles(role ProjectRole) []string {
+	switch role {
+	case ProjectRoleAdmin:
+		return a.options.ProjectAdminRoles
+	case ProjectRoleEditor:
+		return a.options.ProjectEditorRoles
+	case ProjectRoleReader:
+		return a.options.ProjectReaderRoles
+	}
+
+	return nil
+}
+
+// ProjectRole returns the most privileged project role granted by the
+// Keystone roles, or false if none are granted.
+func (a *Authenticator) ProjectRole(roles []string) (ProjectRole, bool) {
+	for _, role := range projectRolesByPrivilege {
+		for _, keystoneRole := range a.projectRoles(role) {
+			if slices.Contains(roles, keystoneRole) {
+				return role, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// IsProjectRole returns true if the Keystone role maps onto any project role.
+func (a *Authenticator) IsProjectRole(role string) bool {
+	_, ok := a.ProjectRole([]string{role})
+
+	return ok
+}
+
+// KeystoneRole returns the Keystone role to assign when granting a project role.
+func (a *Authenticator) KeystoneRole(role ProjectRole) (string, bool) {
+	roles := a.projectRoles(role)
+	if len(roles) == 0 {
+		return "", false
+	}
+
+	return roles[0], true
+}
+
 // OIDCTokenExchangeResult is what's returned by Keystone when we give it an OIDC
 // token to exchnage for an OpenStack token.
 //
@@ -183,7 +285,7 @@ func (a *Authenticator) Basic(ctx context.Context, username, password string) (*
 		return nil, nil, err
 	}
 
-	token, user, err := identity.CreateToken(ctx, openstack.NewCreateTokenOptionsUnscopedPassword(a.options.Domain, username, password))
+	token, user, _, err := identity.CreateToken(ctx, openstack.NewCreateTokenOptionsUnscopedPassword(a.options.Domain, username, password))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,6 +80,30 @@ func (o *Options) AddFlags(f *pflag.FlagSet) {
 	f.StringVar(&o.redirectURI, "oauth2-redirect-uri", "https://kubernetes.eschercloud.com/oauth2/callback", "Exprected redirect URI for the client ID.")
 }
 
+// OIDCProvider defines the backend IdP endpoints.
+type OIDCProvider struct {
+	// Issuer is the expected issuer of OIDC tokens.
+	Issuer string
+
+	// AuthorizationEndpoint is where to get authorization codes from.
+	AuthorizationEndpoint string
+
+	// TokenEndpoint is where to exchange authorization codes for tokens.
+	TokenEndpoint string
+
+	// JWKSURL is where to get token signing keys from.
+	JWKSURL string
+}
+
+// SetOIDCProvider overrides the backend IdP defined by flags, for example when
+// running against a development IdP.
+func (o *Options) SetOIDCProvider(p *OIDCProvider) {
+	o.oidcIssuer = p.Issuer
+	o.oidcAuthorizationEndpoint = p.AuthorizationEndpoint
+	o.oidcTokenEndpoint = p.TokenEndpoint
+	o.oidcJwksURL = p.JWKSURL
+}
+
 // Authenticator provides Keystone authentication functionality.
 type Authenticator struct {
 	options *Options
@@ -198,6 +222,8 @@ type Code struct {
 	KeystoneUserID string `json:"kui"`
 	// Email is exactly that.
 	Email string `json:"email"`
+	// Groups are the groups reported by the OIDC identity provider.
+	Groups []string `json:"grp,omitempty"`
 	// Expiry is when the token expires.
 	Expiry time.Time `json:"exp"`
 }
@@ -482,11 +508,12 @@ func (a *Authenticator) OIDCCallback(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var claims struct {
-		Email string `json:"email"`
+		Email  string   `json:"email"`
+		Groups []string `json:"groups"`
 	}
 
 	if err := idToken.Claims(&claims); err != nil {
-		authorizationError(w, r, state.ClientRedirectURI, ErrorServerError, "failed to extract id_token email claims: "+err.Error())
+		authorizationError(w, r, state.ClientRedirectURI, ErrorServerError, "failed to extract id_token claims: "+err.Error())
 		return
 	}
 
@@ -509,6 +536,7 @@ func (a *Authenticator) OIDCCallback(w http.ResponseWriter, r *http.Request) {
 		KeystoneToken:       token,
 		KeystoneUserID:      tokenMeta.Token.User.ID,
 		Email:               claims.Email,
+		Groups:              claims.Groups,
 		Expiry:              tokenMeta.Token.ExpiresAt,
 	}
 
@@ -642,8 +670,9 @@ func (a *Authenticator) Token(w http.ResponseWriter, r *http.Request) (*generate
 	}
 
 	claims := &UnikornClaims{
-		Token: code.KeystoneToken,
-		User:  code.KeystoneUserID,
+		Token:  code.KeystoneToken,
+		User:   code.KeystoneUserID,
+		Groups: code.Groups,
 	}
 
 	accessToken, err := Issue(a.issuer, r, code.Email, claims, nil, code.Expiry)
@@ -50,6 +50,15 @@ type APIScope string
 const (
 	// ScopeProject tells us the claims token is project scoped.
 	ScopeProject APIScope = "project"
+
+	// ScopeAdmin tells us the user has administrative privileges.
+	ScopeAdmin APIScope = "admin"
+
+	// ScopeProjectWrite tells us the user may modify project resources.
+	ScopeProjectWrite APIScope = "project:write"
+
+	// ScopeProjectMembers tells us the user may manage project members.
+	ScopeProjectMembers APIScope = "project:members"
 )
 
 // ScopeList defines a list of scopes.
@@ -127,6 +136,15 @@ type UnikornClaims struct {
 	// This effectively caches the unique user ID so we don't have to translate
 	// between names in the scope of the token, and what Openstack APIs expect.
 	User string `json:"userId,omitempty"`
+
+	// Role is the user's project role, if any, used to evaluate authorization
+	// policy.
+	Role string `json:"role,omitempty"`
+
+	// Groups are the groups the user is a member of, as reported by the
+	// OIDC identity provider.  These are used to evaluate project role
+	// bindings when the token is scoped to a project.
+	Groups []string `json:"groups,omitempty"`
 }
 
 // Claims is an application specific set of claims.
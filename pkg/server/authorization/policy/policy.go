@@ -0,0 +1,201 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/spf13/pflag"
+
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// PolicyKey is the config map key that contains the policy.
+	PolicyKey = "policy.yaml"
+)
+
+// Options allow the policy to be configured.
+type Options struct {
+	// Namespace is the namespace the policy resides in.
+	Namespace string
+
+	// Name is the name of the config map containing the policy.
+	Name string
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.StringVar(&o.Namespace, "policy-namespace", "", "Namespace of the config map containing the authorization policy.")
+	f.StringVar(&o.Name, "policy-name", "", "Name of the config map containing the authorization policy, policy is not enforced if not set.")
+}
+
+// Rule maps API operations to the project roles allowed to perform them.
+type Rule struct {
+	// Methods are the HTTP methods the rule applies to, if empty the rule
+	// applies to all methods.
+	Methods []string `json:"methods,omitempty"`
+
+	// Paths are the OpenAPI path templates the rule applies to.  These are
+	// shell patterns, so "/api/v1/controlplanes/*" will match any control plane.
+	Paths []string `json:"paths"`
+
+	// Roles are the project roles that are allowed to perform the operation.
+	// If empty, the operation is denied to everyone.
+	Roles []string `json:"roles,omitempty"`
+}
+
+// Policy is an ordered set of rules, as supplied by the operator.  The first
+// rule that matches an operation determines whether it is allowed, operations
+// that match no rules are allowed, subject to the usual scope checks.
+type Policy struct {
+	// Rules is the ordered set of rules.
+	Rules []Rule `json:"rules"`
+}
+
+// matches returns true if the rule applies to the operation.
+func (r *Rule) matches(method, route string) (bool, error) {
+	if len(r.Methods) != 0 && !slices.ContainsFunc(r.Methods, func(m string) bool { return strings.EqualFold(m, method) }) {
+		return false, nil
+	}
+
+	for _, pattern := range r.Paths {
+		ok, err := path.Match(pattern, route)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Engine evaluates API operations against the policy.
+type Engine struct {
+	// client allows Kubernetes access.
+	client client.Reader
+
+	// options control where the policy resides.
+	options *Options
+
+	// lock protects the parsed policy.
+	lock sync.Mutex
+
+	// resourceVersion is the version of the config map the policy was
+	// parsed from.
+	resourceVersion string
+
+	// policy is the parsed policy.
+	policy *Policy
+}
+
+// New returns a new policy engine.  The policy is read on every request, so
+// the client must be cache backed, as the server's is, so reads are served by
+// an informer rather than the API server.
+func New(client client.Reader, options *Options) *Engine {
+	return &Engine{
+		client:  client,
+		options: options,
+	}
+}
+
+// getPolicy reads the policy from the cache, this is done on demand so it
+// can be updated without a restart, and is only parsed when it changes.
+// A nil policy means nothing is enforced, as none is configured.  A configured
+// policy that cannot be read fails closed, otherwise deleting the config map
+// would silently lift all restrictions.
+func (e *Engine) getPolicy(ctx context.Context) (*Policy, error) {
+	if e.options.Name == "" {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+
+	if err := e.client.Get(ctx, client.ObjectKey{Namespace: e.options.Namespace, Name: e.options.Name}, configMap); err != nil {
+		return nil, errors.OAuth2ServerError("failed to read policy").WithError(err)
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.policy != nil && e.resourceVersion == configMap.ResourceVersion {
+		return e.policy, nil
+	}
+
+	data, ok := configMap.Data[PolicyKey]
+	if !ok {
+		return nil, errors.OAuth2ServerError("policy missing " + PolicyKey)
+	}
+
+	policy := &Policy{}
+
+	if err := yaml.Unmarshal([]byte(data), policy); err != nil {
+		return nil, errors.OAuth2ServerError("failed to unmarshal policy").WithError(err)
+	}
+
+	e.resourceVersion = configMap.ResourceVersion
+	e.policy = policy
+
+	return policy, nil
+}
+
+// Authorize checks whether a user with the project role may perform the
+// operation, identified by HTTP method and OpenAPI path template.
+func (e *Engine) Authorize(ctx context.Context, method, route, role string) error {
+	policy, err := e.getPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	if policy == nil {
+		return nil
+	}
+
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+
+		ok, err := rule.matches(method, route)
+		if err != nil {
+			return errors.OAuth2ServerError("failed to evaluate policy").WithError(err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		if !slices.Contains(rule.Roles, role) {
+			return errors.HTTPForbidden("policy does not allow this operation").WithValues("role", role)
+		}
+
+		return nil
+	}
+
+	return nil
+}
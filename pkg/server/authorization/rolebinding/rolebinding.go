@@ -0,0 +1,98 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolebinding
+
+import (
+	"context"
+	"slices"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/keystone"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/project"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Resolver evaluates project role bindings.
+type Resolver struct {
+	// client allows Kubernetes access.
+	client client.Client
+}
+
+// New returns a new role binding resolver.
+func New(client client.Client) *Resolver {
+	return &Resolver{
+		client: client,
+	}
+}
+
+// matches returns true if the subject matches the user's identity.
+func matches(subject *unikornv1.ProjectRoleBindingSubject, groups, keystoneRoles []string) bool {
+	switch subject.Kind {
+	case unikornv1.ProjectRoleBindingSubjectOIDCGroup:
+		return slices.Contains(groups, subject.Name)
+	case unikornv1.ProjectRoleBindingSubjectKeystoneRole:
+		return slices.Contains(keystoneRoles, subject.Name)
+	}
+
+	return false
+}
+
+// Roles returns the project roles bound to a user with the OIDC groups and
+// Keystone roles on the project.  Bindings are read on demand, so changes
+// take effect the next time a token is scoped to the project.
+func (r *Resolver) Roles(ctx context.Context, projectID string, groups, keystoneRoles []string) ([]keystone.ProjectRole, error) {
+	resource := &unikornv1.Project{}
+
+	if err := r.client.Get(ctx, client.ObjectKey{Name: project.Name(projectID)}, resource); err != nil {
+		// No project, no bindings.
+		if kerrors.IsNotFound(err) {
+			return []keystone.ProjectRole{}, nil
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get project").WithError(err)
+	}
+
+	if resource.Status.Namespace == "" {
+		return []keystone.ProjectRole{}, nil
+	}
+
+	bindings := &unikornv1.ProjectRoleBindingList{}
+
+	if err := r.client.List(ctx, bindings, &client.ListOptions{Namespace: resource.Status.Namespace}); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list project role bindings").WithError(err)
+	}
+
+	var roles []keystone.ProjectRole
+
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+
+		for j := range binding.Spec.Subjects {
+			if matches(&binding.Spec.Subjects[j], groups, keystoneRoles) {
+				roles = append(roles, keystone.ProjectRole(binding.Spec.Role))
+
+				break
+			}
+		}
+	}
+
+	return roles, nil
+}
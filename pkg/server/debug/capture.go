@@ -0,0 +1,242 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debug provides opt-in capture of individual API requests, along
+// with any downstream provider requests and resource modifications they cause,
+// so support staff can see exactly what happened for a customer.
+package debug
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eschercloudai/unikorn/pkg/logging"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+)
+
+const (
+	// Header is set by a client to request a capture is made.
+	Header = "X-Unikorn-Debug-Capture"
+
+	// IDHeader is returned to the client with the capture ID.
+	IDHeader = "X-Unikorn-Debug-Capture-ID"
+
+	// maxBodySize is the largest body that will be recorded, anything
+	// bigger will be truncated.
+	maxBodySize = 64 << 10
+
+	// truncated is appended to any body that is truncated.
+	truncated = "...[TRUNCATED]"
+)
+
+// Capture records a single API request.
+type Capture struct {
+	// lock protects the capture as provider requests may be concurrent.
+	lock sync.Mutex
+
+	// redactor sanitizes everything that is recorded.
+	redactor *logging.Redactor
+
+	// capture is the recorded data.
+	capture generated.DebugCapture
+}
+
+// newCapture returns a new capture.
+func newCapture(id string, redactor *logging.Redactor) *Capture {
+	return &Capture{
+		redactor: redactor,
+		capture: generated.DebugCapture{
+			Id:               id,
+			CreationTime:     time.Now(),
+			ProviderRequests: generated.DebugHTTPExchanges{},
+			Mutations:        generated.DebugResourceMutations{},
+		},
+	}
+}
+
+// ID returns the unique capture ID.
+func (c *Capture) ID() string {
+	return c.capture.Id
+}
+
+// body sanitizes and truncates a request or response body.
+func (c *Capture) body(body []byte) *string {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var s string
+
+	if len(body) > maxBodySize {
+		s = c.redactor.String(string(body[:maxBodySize])) + truncated
+	} else {
+		s = string(c.redactor.JSON(body))
+	}
+
+	return &s
+}
+
+// headers sanitizes HTTP headers.
+func (c *Capture) headers(header http.Header) *map[string]string {
+	if len(header) == 0 {
+		return nil
+	}
+
+	out := map[string]string{}
+
+	for key, values := range header {
+		out[key] = c.redactor.Value(key, strings.Join(values, ", "))
+	}
+
+	return &out
+}
+
+// error sanitizes an error.
+func (c *Capture) error(err error) *string {
+	if err == nil {
+		return nil
+	}
+
+	s := c.redactor.Error(err).Error()
+
+	return &s
+}
+
+// RecordRequest records the API request.
+func (c *Capture) RecordRequest(r *http.Request, body []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.capture.Request = generated.DebugHTTPExchange{
+		Method:         r.Method,
+		Url:            c.redactor.String(r.URL.RequestURI()),
+		RequestHeaders: c.headers(r.Header),
+		RequestBody:    c.body(body),
+	}
+}
+
+// RecordResponse records the API response.
+func (c *Capture) RecordResponse(status int, body []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	duration := int(time.Since(c.capture.CreationTime).Milliseconds())
+
+	c.capture.Request.Status = status
+	c.capture.Request.ResponseBody = c.body(body)
+	c.capture.Request.Duration = &duration
+}
+
+// Transport returns a HTTP transport that records all exchanges with a
+// provider.
+func (c *Capture) Transport(next http.RoundTripper) http.RoundTripper {
+	return &transport{
+		next:    next,
+		capture: c,
+	}
+}
+
+// transport records provider requests.
+type transport struct {
+	// next is the transport that actually does the work.
+	next http.RoundTripper
+
+	// capture is where the exchanges are recorded.
+	capture *Capture
+}
+
+// Ensure the http.RoundTripper interface is implemented.
+var _ http.RoundTripper = &transport{}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	var requestBody []byte
+
+	if r.Body != nil && r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		if requestBody, err = io.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+
+	response, err := t.next.RoundTrip(r)
+
+	duration := int(time.Since(start).Milliseconds())
+
+	exchange := generated.DebugHTTPExchange{
+		Method:         r.Method,
+		Url:            t.capture.redactor.String(r.URL.String()),
+		Duration:       &duration,
+		RequestHeaders: t.capture.headers(r.Header),
+		RequestBody:    t.capture.body(requestBody),
+		Error:          t.capture.error(err),
+	}
+
+	if response != nil {
+		exchange.Status = response.StatusCode
+
+		responseBody, readErr := io.ReadAll(response.Body)
+		if readErr != nil {
+			return nil, readErr
+		}
+
+		response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+		exchange.ResponseBody = t.capture.body(responseBody)
+	}
+
+	t.capture.lock.Lock()
+	defer t.capture.lock.Unlock()
+
+	t.capture.capture.ProviderRequests = append(t.capture.capture.ProviderRequests, exchange)
+
+	return response, err
+}
+
+// contextKey defines a new context key type unique to this package.
+type contextKey int
+
+const (
+	// captureKey is used to store a capture in a context.
+	captureKey contextKey = iota
+)
+
+// NewContext returns a new context with a capture attached.
+func NewContext(ctx context.Context, capture *Capture) context.Context {
+	return context.WithValue(ctx, captureKey, capture)
+}
+
+// FromContext returns the capture attached to a context, or nil if the
+// request is not being captured.
+func FromContext(ctx context.Context) *Capture {
+	if capture, ok := ctx.Value(captureKey).(*Capture); ok {
+		return capture
+	}
+
+	return nil
+}
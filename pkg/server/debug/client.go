@@ -0,0 +1,122 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/eschercloudai/unikorn/pkg/logging"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Client wraps a Kubernetes client, recording any resource modifications
+// made while servicing a captured request.
+type Client struct {
+	client.Client
+}
+
+// Ensure the client.Client interface is implemented.
+var _ client.Client = &Client{}
+
+// NewClient returns a new recording client.
+func NewClient(client client.Client) *Client {
+	return &Client{
+		Client: client,
+	}
+}
+
+// record adds a resource modification to the capture in the context, if any.
+func (c *Client) record(ctx context.Context, operation generated.DebugResourceMutationOperation, obj client.Object, err error) {
+	capture := FromContext(ctx)
+	if capture == nil {
+		return
+	}
+
+	mutation := generated.DebugResourceMutation{
+		Operation: operation,
+		Kind:      obj.GetObjectKind().GroupVersionKind().Kind,
+		Name:      obj.GetName(),
+		Error:     capture.error(err),
+	}
+
+	if gvk, err := apiutil.GVKForObject(obj, c.Scheme()); err == nil {
+		mutation.Kind = gvk.Kind
+	}
+
+	if namespace := obj.GetNamespace(); namespace != "" {
+		mutation.Namespace = &namespace
+	}
+
+	// Secrets are never recorded, as their keys are arbitrary we cannot
+	// reliably redact them.
+	if _, ok := obj.(*corev1.Secret); ok {
+		redacted := logging.Redacted
+
+		mutation.Object = &redacted
+	} else if data, err := json.Marshal(obj); err == nil {
+		object := string(capture.redactor.JSON(data))
+
+		mutation.Object = &object
+	}
+
+	capture.lock.Lock()
+	defer capture.lock.Unlock()
+
+	capture.capture.Mutations = append(capture.capture.Mutations, mutation)
+}
+
+// Create implements the client.Client interface.
+func (c *Client) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	err := c.Client.Create(ctx, obj, opts...)
+
+	c.record(ctx, generated.Create, obj, err)
+
+	return err
+}
+
+// Update implements the client.Client interface.
+func (c *Client) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	err := c.Client.Update(ctx, obj, opts...)
+
+	c.record(ctx, generated.Update, obj, err)
+
+	return err
+}
+
+// Patch implements the client.Client interface.
+func (c *Client) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	err := c.Client.Patch(ctx, obj, patch, opts...)
+
+	c.record(ctx, generated.Patch, obj, err)
+
+	return err
+}
+
+// Delete implements the client.Client interface.
+func (c *Client) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	err := c.Client.Delete(ctx, obj, opts...)
+
+	c.record(ctx, generated.Delete, obj, err)
+
+	return err
+}
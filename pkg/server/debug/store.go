@@ -0,0 +1,155 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/pflag"
+
+	"github.com/eschercloudai/unikorn/pkg/logging"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/state"
+)
+
+// Options defines configurable debug capture options.
+type Options struct {
+	// TTL is how long captures are retained for.
+	TTL time.Duration
+
+	// MaxCaptures limits the number of captures that are retained, the
+	// oldest being discarded first.
+	MaxCaptures int
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.DurationVar(&o.TTL, "debug-capture-ttl", 15*time.Minute, "How long debug captures are retained for.")
+	f.IntVar(&o.MaxCaptures, "debug-capture-max", 64, "Maximum number of debug captures retained.")
+}
+
+// bucket is where captures are stored.
+const bucket = "debug-captures"
+
+// Store retains completed captures until they expire.  Captures are kept in
+// shared state so they can be retrieved from any server replica.
+type Store struct {
+	options *Options
+
+	// redactor sanitizes everything that is recorded.
+	redactor *logging.Redactor
+
+	// state stores captures.
+	state state.Store
+}
+
+// NewStore returns a new capture store.
+func NewStore(options *Options, redactor *logging.Redactor, state state.Store) *Store {
+	return &Store{
+		options:  options,
+		redactor: redactor,
+		state:    state,
+	}
+}
+
+// New returns a new capture, it will not be retrievable until it is added
+// to the store.
+func (s *Store) New() *Capture {
+	return newCapture(uuid.New().String(), s.redactor)
+}
+
+// evict removes the oldest captures until the store is within its limits.
+func (s *Store) evict(ctx context.Context) error {
+	values, err := s.state.List(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	if len(values) <= s.options.MaxCaptures {
+		return nil
+	}
+
+	captures := make([]generated.DebugCapture, 0, len(values))
+
+	for _, value := range values {
+		var capture generated.DebugCapture
+
+		if err := json.Unmarshal(value, &capture); err != nil {
+			return err
+		}
+
+		captures = append(captures, capture)
+	}
+
+	// All captures have the same TTL, so the expiry time orders them.
+	sort.Slice(captures, func(i, j int) bool {
+		return captures[i].ExpiryTime.Before(captures[j].ExpiryTime)
+	})
+
+	for _, capture := range captures[:len(captures)-s.options.MaxCaptures] {
+		if err := s.state.Delete(ctx, bucket, capture.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Add retains a completed capture.
+func (s *Store) Add(ctx context.Context, capture *Capture) error {
+	capture.lock.Lock()
+	capture.capture.ExpiryTime = time.Now().Add(s.options.TTL)
+
+	value, err := json.Marshal(capture.capture)
+	capture.lock.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	if err := s.state.Set(ctx, bucket, capture.ID(), value, s.options.TTL); err != nil {
+		return err
+	}
+
+	return s.evict(ctx)
+}
+
+// Get returns a capture by ID.
+func (s *Store) Get(ctx context.Context, id string) (*generated.DebugCapture, error) {
+	value, err := s.state.Get(ctx, bucket, id)
+	if err != nil {
+		if goerrors.Is(err, state.ErrNotFound) {
+			return nil, errors.HTTPNotFound()
+		}
+
+		return nil, errors.OAuth2ServerError("failed to read debug capture").WithError(err)
+	}
+
+	result := &generated.DebugCapture{}
+
+	if err := json.Unmarshal(value, result); err != nil {
+		return nil, errors.OAuth2ServerError("failed to unmarshal debug capture").WithError(err)
+	}
+
+	return result, nil
+}
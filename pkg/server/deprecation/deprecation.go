@@ -0,0 +1,169 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deprecation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers"
+
+	"github.com/eschercloudai/unikorn/pkg/collections"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+)
+
+const (
+	// Header is used to warn clients they are using deprecated features.
+	Header = "Warning"
+)
+
+// Usage records the use of a deprecated API feature.
+type Usage struct {
+	// Kind is the kind of feature that is deprecated.
+	Kind generated.DeprecatedUsageKind
+
+	// Name identifies the feature.
+	Name string
+}
+
+// Warning returns an RFC 7234 miscellaneous persistent warning value.
+func (u Usage) Warning() string {
+	return fmt.Sprintf(`299 - "%s %s is deprecated"`, u.Kind, u.Name)
+}
+
+// Find returns all deprecated features used by a request, as annotated in the
+// OpenAPI specification.  The body must be the raw JSON request body, as the
+// request's own body will have already been consumed.
+func Find(route *routers.Route, r *http.Request, body []byte) []Usage {
+	var usages []Usage
+
+	if route.Operation.Deprecated {
+		usages = append(usages, Usage{
+			Kind: generated.Operation,
+			Name: route.Method + " " + route.Path,
+		})
+	}
+
+	parameters := make(openapi3.Parameters, 0, len(route.PathItem.Parameters)+len(route.Operation.Parameters))
+	parameters = append(parameters, route.PathItem.Parameters...)
+	parameters = append(parameters, route.Operation.Parameters...)
+
+	for _, parameter := range parameters {
+		if parameter.Value == nil || !parameter.Value.Deprecated || !parameterPresent(r, parameter.Value) {
+			continue
+		}
+
+		usages = append(usages, Usage{
+			Kind: generated.Parameter,
+			Name: parameter.Value.Name,
+		})
+	}
+
+	if len(body) != 0 && route.Operation.RequestBody != nil && route.Operation.RequestBody.Value != nil {
+		if mediaType := route.Operation.RequestBody.Value.Content.Get("application/json"); mediaType != nil && mediaType.Schema != nil {
+			var data any
+
+			// Invalid bodies are rejected by schema validation, so just ignore
+			// them here.
+			if err := json.Unmarshal(body, &data); err == nil {
+				fields := map[string]bool{}
+
+				findFields(fields, mediaType.Schema.Value, data, "")
+
+				for _, name := range collections.SortedKeys(fields) {
+					usages = append(usages, Usage{
+						Kind: generated.Field,
+						Name: name,
+					})
+				}
+			}
+		}
+	}
+
+	return usages
+}
+
+// parameterPresent returns true if the parameter was specified by the client.
+func parameterPresent(r *http.Request, parameter *openapi3.Parameter) bool {
+	switch parameter.In {
+	case openapi3.ParameterInQuery:
+		return r.URL.Query().Has(parameter.Name)
+	case openapi3.ParameterInHeader:
+		return r.Header.Get(parameter.Name) != ""
+	case openapi3.ParameterInCookie:
+		_, err := r.Cookie(parameter.Name)
+
+		return err == nil
+	}
+
+	// Path parameters are always present.
+	return true
+}
+
+// findFields recursively walks the data, recording the path of any fields that
+// are deprecated by the schema.  Array indices are omitted from the path so that
+// usage is reported once per field, not per element.
+func findFields(fields map[string]bool, schema *openapi3.Schema, data any, path string) {
+	if schema == nil {
+		return
+	}
+
+	for _, ref := range schema.AllOf {
+		findFields(fields, ref.Value, data, path)
+	}
+
+	switch t := data.(type) {
+	case map[string]any:
+		for name, value := range t {
+			fieldPath := name
+
+			if path != "" {
+				fieldPath = strings.Join([]string{path, name}, ".")
+			}
+
+			ref, ok := schema.Properties[name]
+			if !ok {
+				if schema.AdditionalProperties.Schema != nil {
+					findFields(fields, schema.AdditionalProperties.Schema.Value, value, fieldPath)
+				}
+
+				continue
+			}
+
+			if ref.Value == nil {
+				continue
+			}
+
+			if ref.Value.Deprecated {
+				fields[fieldPath] = true
+			}
+
+			findFields(fields, ref.Value, value, fieldPath)
+		}
+	case []any:
+		if schema.Items == nil {
+			return
+		}
+
+		for _, value := range t {
+			findFields(fields, schema.Items.Value, value, path)
+		}
+	}
+}
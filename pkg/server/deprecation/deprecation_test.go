@@ -0,0 +1,161 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deprecation_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eschercloudai/unikorn/pkg/server/deprecation"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/state"
+)
+
+// deprecatedSchema returns a string schema that is deprecated.
+func deprecatedSchema() *openapi3.Schema {
+	schema := openapi3.NewStringSchema()
+	schema.Deprecated = true
+
+	return schema
+}
+
+// mustNewRoute returns a route whose request body has deprecated fields at the
+// top level, in a nested object, and in an array of objects.
+func mustNewRoute(deprecated bool) *routers.Route {
+	pool := openapi3.NewObjectSchema().
+		WithProperty("name", openapi3.NewStringSchema()).
+		WithPropertyRef("version", openapi3.NewSchemaRef("", deprecatedSchema()))
+
+	network := openapi3.NewObjectSchema().
+		WithProperty("podPrefix", openapi3.NewStringSchema()).
+		WithPropertyRef("mode", openapi3.NewSchemaRef("", deprecatedSchema()))
+
+	body := openapi3.NewObjectSchema().
+		WithProperty("name", openapi3.NewStringSchema()).
+		WithPropertyRef("legacy", openapi3.NewSchemaRef("", deprecatedSchema())).
+		WithProperty("network", network).
+		WithProperty("pools", openapi3.NewArraySchema().WithItems(pool))
+
+	operation := openapi3.NewOperation()
+	operation.Deprecated = deprecated
+	operation.RequestBody = &openapi3.RequestBodyRef{
+		Value: openapi3.NewRequestBody().WithJSONSchema(body),
+	}
+
+	parameter := openapi3.NewQueryParameter("filter")
+	parameter.Deprecated = true
+
+	operation.AddParameter(parameter)
+
+	return &routers.Route{
+		Path:      "/api/v1/foo",
+		Method:    http.MethodPost,
+		PathItem:  &openapi3.PathItem{},
+		Operation: operation,
+	}
+}
+
+// TestFindNone tests requests not using deprecated features are not reported.
+func TestFindNone(t *testing.T) {
+	t.Parallel()
+
+	body := `{"name":"foo","network":{"podPrefix":"10.0.0.0/8"},"pools":[{"name":"bar"}]}`
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/foo", strings.NewReader(body))
+
+	assert.Empty(t, deprecation.Find(mustNewRoute(false), r, []byte(body)))
+}
+
+// TestFindOperation tests deprecated operations are reported.
+func TestFindOperation(t *testing.T) {
+	t.Parallel()
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/foo", nil)
+
+	usages := deprecation.Find(mustNewRoute(true), r, nil)
+	assert.Equal(t, []deprecation.Usage{{Kind: generated.Operation, Name: "POST /api/v1/foo"}}, usages)
+	assert.Equal(t, `299 - "operation POST /api/v1/foo is deprecated"`, usages[0].Warning())
+}
+
+// TestFindParameter tests deprecated parameters are reported only when used.
+func TestFindParameter(t *testing.T) {
+	t.Parallel()
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/foo?filter=bar", nil)
+
+	assert.Equal(t, []deprecation.Usage{{Kind: generated.Parameter, Name: "filter"}}, deprecation.Find(mustNewRoute(false), r, nil))
+}
+
+// TestFindFields tests deprecated fields are reported once each, with their
+// full path, in a stable order.
+func TestFindFields(t *testing.T) {
+	t.Parallel()
+
+	body := `{"name":"foo","legacy":"baz","network":{"mode":"ipvs"},"pools":[{"name":"a","version":"v1"},{"name":"b","version":"v2"}]}`
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/foo", strings.NewReader(body))
+
+	expected := []deprecation.Usage{
+		{Kind: generated.Field, Name: "legacy"},
+		{Kind: generated.Field, Name: "network.mode"},
+		{Kind: generated.Field, Name: "pools.version"},
+	}
+
+	assert.Equal(t, expected, deprecation.Find(mustNewRoute(false), r, []byte(body)))
+}
+
+// TestStore tests usage is aggregated per client and feature.
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	store := deprecation.NewStore(&deprecation.Options{MaxEntries: 2}, state.NewMemory())
+
+	operation := deprecation.Usage{Kind: generated.Operation, Name: "POST /api/v1/foo"}
+	field := deprecation.Usage{Kind: generated.Field, Name: "legacy"}
+
+	assert.NoError(t, store.Add(ctx, "foo", "curl", []deprecation.Usage{operation, field}))
+	assert.NoError(t, store.Add(ctx, "foo", "curl", []deprecation.Usage{operation}))
+
+	usages, err := store.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, usages, 2)
+	assert.Equal(t, generated.Field, usages[0].Kind)
+	assert.Equal(t, 1, usages[0].Count)
+	assert.Equal(t, generated.Operation, usages[1].Kind)
+	assert.Equal(t, 2, usages[1].Count)
+	assert.Equal(t, "curl", *usages[1].UserAgent)
+
+	// The field usage was seen least recently so should be evicted.
+	assert.NoError(t, store.Add(ctx, "bar", "", []deprecation.Usage{operation}))
+
+	usages, err = store.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, usages, 2)
+	assert.Equal(t, "bar", usages[0].Subject)
+	assert.Nil(t, usages[0].UserAgent)
+	assert.Equal(t, "foo", usages[1].Subject)
+	assert.Equal(t, generated.Operation, usages[1].Kind)
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deprecation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/state"
+)
+
+// Options defines configurable deprecation reporting options.
+type Options struct {
+	// MaxEntries limits the number of usage records that are retained, the
+	// least recently seen being discarded first.
+	MaxEntries int
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.IntVar(&o.MaxEntries, "deprecation-report-max-entries", 1024, "Maximum number of deprecated API usage records retained.")
+}
+
+// bucket is where usage records are stored.
+const bucket = "deprecations"
+
+// key uniquely identifies a usage record.
+func key(subject, userAgent string, usage Usage) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{subject, userAgent, string(usage.Kind), usage.Name}, "\x00")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Store retains deprecated API usage per client.  Records are kept in shared
+// state so usage is aggregated across all server replicas.
+type Store struct {
+	options *Options
+
+	// state stores usage records.
+	state state.Store
+}
+
+// NewStore returns a new usage store.
+func NewStore(options *Options, state state.Store) *Store {
+	return &Store{
+		options: options,
+		state:   state,
+	}
+}
+
+// records returns all usage records indexed by key.
+func (s *Store) records(ctx context.Context) (map[string]*generated.DeprecatedUsage, error) {
+	values, err := s.state.List(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[string]*generated.DeprecatedUsage, len(values))
+
+	for k, value := range values {
+		record := &generated.DeprecatedUsage{}
+
+		if err := json.Unmarshal(value, record); err != nil {
+			return nil, err
+		}
+
+		records[k] = record
+	}
+
+	return records, nil
+}
+
+// evict removes the least recently seen records until the store is within
+// its limits.
+func (s *Store) evict(ctx context.Context) error {
+	records, err := s.records(ctx)
+	if err != nil {
+		return err
+	}
+
+	for len(records) > s.options.MaxEntries {
+		var oldest string
+
+		for k, record := range records {
+			if oldest == "" || record.LastSeen.Before(records[oldest].LastSeen) {
+				oldest = k
+			}
+		}
+
+		if err := s.state.Delete(ctx, bucket, oldest); err != nil {
+			return err
+		}
+
+		delete(records, oldest)
+	}
+
+	return nil
+}
+
+// Add records usage of deprecated features by a client.
+func (s *Store) Add(ctx context.Context, subject, userAgent string, usages []Usage) error {
+	now := time.Now()
+
+	var created bool
+
+	for _, usage := range usages {
+		usage := usage
+
+		mutate := func(value []byte) ([]byte, error) {
+			record := &generated.DeprecatedUsage{}
+
+			if value == nil {
+				record.Subject = subject
+				record.Kind = usage.Kind
+				record.Name = usage.Name
+				record.FirstSeen = now
+
+				if userAgent != "" {
+					record.UserAgent = &userAgent
+				}
+
+				created = true
+			} else if err := json.Unmarshal(value, record); err != nil {
+				return nil, err
+			}
+
+			record.Count++
+			record.LastSeen = now
+
+			return json.Marshal(record)
+		}
+
+		if err := s.state.Update(ctx, bucket, key(subject, userAgent, usage), 0, mutate); err != nil {
+			return err
+		}
+	}
+
+	// Eviction needs to scan everything, so only do it when the number of
+	// records may have grown.
+	if !created {
+		return nil
+	}
+
+	return s.evict(ctx)
+}
+
+// List returns all usage records, ordered by client then feature.
+func (s *Store) List(ctx context.Context) (generated.DeprecatedUsages, error) {
+	records, err := s.records(ctx)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to read deprecated api usage").WithError(err)
+	}
+
+	result := make(generated.DeprecatedUsages, 0, len(records))
+
+	for _, record := range records {
+		result = append(result, *record)
+	}
+
+	userAgent := func(u *generated.DeprecatedUsage) string {
+		if u.UserAgent == nil {
+			return ""
+		}
+
+		return *u.UserAgent
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := &result[i], &result[j]
+
+		if a.Subject != b.Subject {
+			return a.Subject < b.Subject
+		}
+
+		if userAgent(a) != userAgent(b) {
+			return userAgent(a) < userAgent(b)
+		}
+
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+
+		return a.Name < b.Name
+	})
+
+	return result, nil
+}
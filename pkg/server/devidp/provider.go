@@ -0,0 +1,408 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package devidp provides a mock OpenID Connect identity provider that is run
+// in-process by the server for local development, and embedded in tests.
+// It implements just enough of the authorization code flow, with PKCE, to
+// exercise the server's delegating oauth2 implementation.  Every authorization
+// request is granted without user interaction.
+package devidp
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+)
+
+const (
+	// keyID is the key ID of the signing key.
+	keyID = "mock"
+
+	// rsaKeyBits is the size of the generated signing key.
+	rsaKeyBits = 2048
+
+	// tokenLifetime is how long issued tokens are valid for.
+	tokenLifetime = time.Hour
+)
+
+// Options allow the provider to be customized.
+type Options struct {
+	// ClientID is the only client ID that the provider will accept,
+	// when not set, any client is accepted.
+	ClientID string
+
+	// Subject is the user ID that is reported in ID tokens.
+	Subject string
+
+	// Email is the email address reported in ID tokens.
+	Email string
+
+	// Groups are the groups reported in ID tokens.
+	Groups []string
+}
+
+// grant records state about an authorization request, so it can be checked
+// when the code is exchanged for tokens.
+type grant struct {
+	clientID      string
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+}
+
+// Provider is a mock OpenID Connect identity provider.
+type Provider struct {
+	// issuer is the base URL the provider is served on.
+	issuer string
+
+	// options define the provider's behaviour.
+	options Options
+
+	// key is used to sign ID tokens.
+	key *rsa.PrivateKey
+
+	// grants records outstanding authorization codes.
+	grants map[string]*grant
+
+	// lock provides concurrency safety for grants.
+	lock sync.Mutex
+
+	// mux routes requests to the correct handler.
+	mux *http.ServeMux
+}
+
+// Ensure the http.Handler interface is implemented.
+var _ http.Handler = &Provider{}
+
+// New returns a new provider that will be served at the issuer URL.
+func New(issuer string, options Options) (*Provider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		issuer:  issuer,
+		options: options,
+		key:     key,
+		grants:  map[string]*grant{},
+		mux:     http.NewServeMux(),
+	}
+
+	p.mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	p.mux.HandleFunc("/auth", p.authorization)
+	p.mux.HandleFunc("/token", p.token)
+	p.mux.HandleFunc("/certs", p.jwks)
+
+	return p, nil
+}
+
+// Issuer returns the issuer name that appears in ID tokens.
+func (p *Provider) Issuer() string {
+	return p.issuer
+}
+
+// AuthorizationEndpoint returns where to get authorization codes from.
+func (p *Provider) AuthorizationEndpoint() string {
+	return p.issuer + "/auth"
+}
+
+// TokenEndpoint returns where to exchange authorization codes for tokens.
+func (p *Provider) TokenEndpoint() string {
+	return p.issuer + "/token"
+}
+
+// JWKSURL returns where to get ID token signing keys from.
+func (p *Provider) JWKSURL() string {
+	return p.issuer + "/certs"
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (p *Provider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mux.ServeHTTP(w, r)
+}
+
+// randomString returns a URL safe random string.
+func randomString() (string, error) {
+	buf := make([]byte, 32)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// writeJSON writes out a JSON response.
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes out an oauth2 error response.
+func writeError(w http.ResponseWriter, status int, kind, description string) {
+	body := map[string]string{
+		"error":             kind,
+		"error_description": description,
+	}
+
+	writeJSON(w, status, body)
+}
+
+// discovery returns the OpenID Connect discovery document.
+func (p *Provider) discovery(w http.ResponseWriter, r *http.Request) {
+	//nolint:tagliatelle
+	body := struct {
+		Issuer                            string   `json:"issuer"`
+		AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+		TokenEndpoint                     string   `json:"token_endpoint"`
+		JWKSURI                           string   `json:"jwks_uri"`
+		ResponseTypesSupported            []string `json:"response_types_supported"`
+		SubjectTypesSupported             []string `json:"subject_types_supported"`
+		IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+		CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+		ScopesSupported                   []string `json:"scopes_supported"`
+		TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	}{
+		Issuer:                            p.Issuer(),
+		AuthorizationEndpoint:             p.AuthorizationEndpoint(),
+		TokenEndpoint:                     p.TokenEndpoint(),
+		JWKSURI:                           p.JWKSURL(),
+		ResponseTypesSupported:            []string{"code"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{string(jose.RS256)},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		ScopesSupported:                   []string{"openid", "email", "profile", "groups"},
+		TokenEndpointAuthMethodsSupported: []string{"none"},
+	}
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+// authorization grants every valid request an authorization code and redirects
+// back to the client.
+func (p *Provider) authorization(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || !redirectURI.IsAbs() {
+		http.Error(w, "redirect_uri is invalid", http.StatusBadRequest)
+		return
+	}
+
+	values := url.Values{}
+
+	if query.Has("state") {
+		values.Set("state", query.Get("state"))
+	}
+
+	switch {
+	case p.options.ClientID != "" && query.Get("client_id") != p.options.ClientID:
+		values.Set("error", "unauthorized_client")
+	case query.Get("response_type") != "code":
+		values.Set("error", "unsupported_response_type")
+	case query.Get("code_challenge_method") != "S256", query.Get("code_challenge") == "":
+		values.Set("error", "invalid_request")
+	}
+
+	if !values.Has("error") {
+		code, err := randomString()
+		if err != nil {
+			values.Set("error", "server_error")
+		} else {
+			p.lock.Lock()
+			p.grants[code] = &grant{
+				clientID:      query.Get("client_id"),
+				redirectURI:   query.Get("redirect_uri"),
+				nonce:         query.Get("nonce"),
+				codeChallenge: query.Get("code_challenge"),
+			}
+			p.lock.Unlock()
+
+			values.Set("code", code)
+		}
+	}
+
+	redirectURI.RawQuery = values.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+// redeem looks up and removes an authorization code, codes are single use.
+func (p *Provider) redeem(code string) *grant {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	g, ok := p.grants[code]
+	if !ok {
+		return nil
+	}
+
+	delete(p.grants, code)
+
+	return g
+}
+
+// clientID returns the client identifier, public clients may use basic authentication
+// with an empty password, or a form parameter.
+func clientID(r *http.Request) string {
+	if username, _, ok := r.BasicAuth(); ok {
+		if unescaped, err := url.QueryUnescape(username); err == nil {
+			return unescaped
+		}
+
+		return username
+	}
+
+	return r.Form.Get("client_id")
+}
+
+// validateTokenRequest checks the token request against the authorization grant.
+func validateTokenRequest(r *http.Request, g *grant) string {
+	challenge := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+
+	switch {
+	case g == nil:
+		return "code is invalid"
+	case clientID(r) != g.clientID:
+		return "client_id mismatch"
+	case r.Form.Get("redirect_uri") != g.redirectURI:
+		return "redirect_uri mismatch"
+	case base64.RawURLEncoding.EncodeToString(challenge[:]) != g.codeChallenge:
+		return "code_verifier is invalid"
+	}
+
+	return ""
+}
+
+// idToken creates a signed ID token.
+func (p *Provider) idToken(g *grant, expiry time.Time) (string, error) {
+	signingKey := jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key:       p.key,
+	}
+
+	signerOptions := &jose.SignerOptions{}
+	signerOptions = signerOptions.WithType("JWT").WithHeader(jose.HeaderKey("kid"), keyID)
+
+	signer, err := jose.NewSigner(signingKey, signerOptions)
+	if err != nil {
+		return "", err
+	}
+
+	claims := &jwt.Claims{
+		Issuer:   p.issuer,
+		Subject:  p.options.Subject,
+		Audience: jwt.Audience{g.clientID},
+		Expiry:   jwt.NewNumericDate(expiry),
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	}
+
+	//nolint:tagliatelle
+	extra := struct {
+		Nonce  string   `json:"nonce,omitempty"`
+		Email  string   `json:"email,omitempty"`
+		Groups []string `json:"groups,omitempty"`
+	}{
+		Nonce:  g.nonce,
+		Email:  p.options.Email,
+		Groups: p.options.Groups,
+	}
+
+	return jwt.Signed(signer).Claims(claims).Claims(extra).CompactSerialize()
+}
+
+// token exchanges an authorization code for an access token and ID token.
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "invalid_request", "method must be POST")
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	if r.Form.Get("grant_type") != "authorization_code" {
+		writeError(w, http.StatusBadRequest, "unsupported_grant_type", "grant_type must be 'authorization_code'")
+		return
+	}
+
+	g := p.redeem(r.Form.Get("code"))
+
+	if description := validateTokenRequest(r, g); description != "" {
+		writeError(w, http.StatusBadRequest, "invalid_grant", description)
+		return
+	}
+
+	accessToken, err := randomString()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+
+	expiry := time.Now().Add(tokenLifetime)
+
+	idToken, err := p.idToken(g, expiry)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+
+	//nolint:tagliatelle
+	body := struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int    `json:"expires_in"`
+		IDToken     string `json:"id_token"`
+	}{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(tokenLifetime.Seconds()),
+		IDToken:     idToken,
+	}
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+// jwks returns the public signing keys.
+func (p *Provider) jwks(w http.ResponseWriter, r *http.Request) {
+	body := &jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{
+				Key:       &p.key.PublicKey,
+				KeyID:     keyID,
+				Algorithm: string(jose.RS256),
+				Use:       "sig",
+			},
+		},
+	}
+
+	writeJSON(w, http.StatusOK, body)
+}
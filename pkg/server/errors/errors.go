@@ -21,6 +21,7 @@ import (
 	"errors"
 	"net/http"
 
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
 
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -49,6 +50,20 @@ type HTTPError struct {
 
 	// values are arbitrary key value pairs for logging.
 	values []interface{}
+
+	// validationErrors are specific failures that are returned to the client.
+	validationErrors []string
+
+	// remediation is an optional hint returned to the client describing how
+	// the error may be resolved.
+	remediation string
+
+	// resource is an optional reference to an existing resource that caused
+	// the error, returned to the client.
+	resource *generated.ResourceReference
+
+	// retryAfter, if set, tells the client when to retry the request.
+	retryAfter string
 }
 
 // newHTTPError returns a new HTTP error.
@@ -76,6 +91,38 @@ func (e *HTTPError) WithValues(values ...interface{}) *HTTPError {
 	return e
 }
 
+// WithValidationErrors augments the error with a set of specific failures that
+// are reported to the client.
+func (e *HTTPError) WithValidationErrors(validationErrors []string) *HTTPError {
+	e.validationErrors = validationErrors
+
+	return e
+}
+
+// WithRemediation augments the error with a hint that is reported to the client
+// describing how the error may be resolved.
+func (e *HTTPError) WithRemediation(remediation string) *HTTPError {
+	e.remediation = remediation
+
+	return e
+}
+
+// WithResource augments the error with a reference to the resource that caused
+// it, typically an existing resource on a create conflict.
+func (e *HTTPError) WithResource(resource *generated.ResourceReference) *HTTPError {
+	e.resource = resource
+
+	return e
+}
+
+// WithRetryAfter augments the error with a Retry-After header value, as either
+// a number of seconds or an HTTP date, telling the client when to try again.
+func (e *HTTPError) WithRetryAfter(retryAfter string) *HTTPError {
+	e.retryAfter = retryAfter
+
+	return e
+}
+
 // Unwrap implements Go 1.13 errors.
 func (e *HTTPError) Unwrap() error {
 	return ErrRequest
@@ -112,6 +159,11 @@ func (e *HTTPError) Write(w http.ResponseWriter, r *http.Request) {
 	// Emit the response to the client.
 	w.Header().Add("Cache-Control", "no-cache")
 	w.Header().Add("Content-Type", "application/json")
+
+	if e.retryAfter != "" {
+		w.Header().Set("Retry-After", e.retryAfter)
+	}
+
 	w.WriteHeader(e.status)
 
 	// Emit the response body.
@@ -120,6 +172,18 @@ func (e *HTTPError) Write(w http.ResponseWriter, r *http.Request) {
 		ErrorDescription: e.description,
 	}
 
+	if len(e.validationErrors) != 0 {
+		ge.ValidationErrors = &e.validationErrors
+	}
+
+	if e.remediation != "" {
+		ge.Remediation = &e.remediation
+	}
+
+	if e.resource != nil {
+		ge.Resource = e.resource
+	}
+
 	body, err := json.Marshal(ge)
 	if err != nil {
 		log.Error(err, "failed to marshal error response")
@@ -143,17 +207,9 @@ func HTTPNotFound() *HTTPError {
 }
 
 func IsHTTPNotFound(err error) bool {
-	httpError := &HTTPError{}
-
-	if ok := errors.As(err, &httpError); !ok {
-		return false
-	}
+	httpError := toHTTPError(err)
 
-	if httpError.status != http.StatusNotFound {
-		return false
-	}
-
-	return true
+	return httpError != nil && httpError.status == http.StatusNotFound
 }
 
 func HTTPMethodNotAllowed() *HTTPError {
@@ -164,6 +220,46 @@ func HTTPConflict() *HTTPError {
 	return newHTTPError(http.StatusConflict, generated.Conflict, "the requested resource already exists")
 }
 
+// HTTPConflictWithDescription is like HTTPConflict, but allows the cause to be
+// described more precisely e.g. when raised by a provider.
+func HTTPConflictWithDescription(description string) *HTTPError {
+	return newHTTPError(http.StatusConflict, generated.Conflict, description)
+}
+
+// IsHTTPConflict returns true if the error is a conflict.
+func IsHTTPConflict(err error) bool {
+	httpError := toHTTPError(err)
+
+	return httpError != nil && httpError.status == http.StatusConflict
+}
+
+// IsHTTPServiceUnavailable returns true if the error was caused by a dependency
+// being temporarily unavailable.
+func IsHTTPServiceUnavailable(err error) bool {
+	httpError := toHTTPError(err)
+
+	return httpError != nil && httpError.status == http.StatusServiceUnavailable
+}
+
+// IsValidationError returns true if the error was caused by invalid client input.
+func IsValidationError(err error) bool {
+	httpError := toHTTPError(err)
+
+	return httpError != nil && (httpError.status == http.StatusBadRequest || httpError.status == http.StatusUnprocessableEntity)
+}
+
+// HTTPUnprocessableEntity indicates the request was well formed, but cannot be
+// satisfied, specific failures should be attached with WithValidationErrors.
+func HTTPUnprocessableEntity(description string) *HTTPError {
+	return newHTTPError(http.StatusUnprocessableEntity, generated.UnprocessableEntity, description)
+}
+
+// HTTPTooManyRequests indicates the client, or the platform on its behalf, is
+// being rate limited.
+func HTTPTooManyRequests(description string) *HTTPError {
+	return newHTTPError(http.StatusTooManyRequests, generated.TooManyRequests, description)
+}
+
 // OAuth2InvalidRequest indicates a client error.
 func OAuth2InvalidRequest(description string) *HTTPError {
 	return newHTTPError(http.StatusBadRequest, generated.InvalidRequest, description)
@@ -197,18 +293,54 @@ func OAuth2ServerError(description string) *HTTPError {
 	return newHTTPError(http.StatusInternalServerError, generated.ServerError, description)
 }
 
+// OAuth2TemporarilyUnavailable tells the client the request cannot be handled
+// at present, but may succeed if retried later.
+func OAuth2TemporarilyUnavailable(description string) *HTTPError {
+	return newHTTPError(http.StatusServiceUnavailable, generated.TemporarilyUnavailable, description)
+}
+
 // OAuth2InvalidScope tells the client it doesn't have the necessary scope
 // to access the resource.
 func OAuth2InvalidScope(description string) *HTTPError {
 	return newHTTPError(http.StatusUnauthorized, generated.InvalidScope, description)
 }
 
-// toHTTPError is a handy unwrapper to get a HTTP error from a generic one.
+// FromError maps a classified error, as raised by code shared with the
+// managers, to a HTTP error.  Unclassified errors return nil.
+func FromError(err error) *HTTPError {
+	description := unikornerrors.Description(err)
+
+	class := unikornerrors.Class(err)
+
+	var httpError *HTTPError
+
+	switch {
+	case errors.Is(class, unikornerrors.ErrNotFound):
+		httpError = newHTTPError(http.StatusNotFound, generated.NotFound, description)
+	case errors.Is(class, unikornerrors.ErrConflict):
+		httpError = HTTPConflictWithDescription(description)
+	case errors.Is(class, unikornerrors.ErrQuota):
+		httpError = HTTPUnprocessableEntity(description).WithRemediation("free up existing resources, or request a quota increase from your cloud administrator")
+	case errors.Is(class, unikornerrors.ErrInvalid):
+		httpError = OAuth2InvalidRequest(description)
+	case errors.Is(class, unikornerrors.ErrForbidden):
+		httpError = HTTPForbidden(description)
+	case errors.Is(class, unikornerrors.ErrUnavailable):
+		httpError = OAuth2TemporarilyUnavailable(description)
+	default:
+		return nil
+	}
+
+	return httpError.WithError(err)
+}
+
+// toHTTPError is a handy unwrapper to get a HTTP error from a generic one,
+// classified errors are mapped to their HTTP equivalent.
 func toHTTPError(err error) *HTTPError {
 	var httpErr *HTTPError
 
 	if !errors.As(err, &httpErr) {
-		return nil
+		return FromError(err)
 	}
 
 	return httpErr
@@ -0,0 +1,149 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors_test
+
+import (
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+)
+
+var (
+	errCause = goerrors.New("internal detail")
+)
+
+// mustHandleError returns the response generated for an error.
+func mustHandleError(t *testing.T, err error) (int, *generated.Oauth2Error) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+
+	errors.HandleError(w, httptest.NewRequest(http.MethodGet, "/", nil), err)
+
+	result := &generated.Oauth2Error{}
+
+	if err := json.Unmarshal(w.Body.Bytes(), result); err != nil {
+		t.Fatal(err)
+	}
+
+	return w.Code, result
+}
+
+// TestClassifiedErrors tests shared error classes are mapped to HTTP errors.
+func TestClassifiedErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		err         error
+		status      int
+		code        generated.Oauth2ErrorError
+		description string
+	}{
+		{
+			name:        "NotFound",
+			err:         unikornerrors.NotFound("widget not found").WithError(errCause),
+			status:      http.StatusNotFound,
+			code:        generated.NotFound,
+			description: "widget not found",
+		},
+		{
+			name:        "Conflict",
+			err:         fmt.Errorf("%w: %w", unikornerrors.ErrConflict, errCause),
+			status:      http.StatusConflict,
+			code:        generated.Conflict,
+			description: unikornerrors.ErrConflict.Error(),
+		},
+		{
+			name:        "Quota",
+			err:         unikornerrors.Quota("too many widgets"),
+			status:      http.StatusUnprocessableEntity,
+			code:        generated.UnprocessableEntity,
+			description: "too many widgets",
+		},
+		{
+			name:        "Invalid",
+			err:         unikornerrors.Invalid("widget malformed"),
+			status:      http.StatusBadRequest,
+			code:        generated.InvalidRequest,
+			description: "widget malformed",
+		},
+		{
+			name:        "Forbidden",
+			err:         unikornerrors.Forbidden("widget forbidden"),
+			status:      http.StatusForbidden,
+			code:        generated.Forbidden,
+			description: "widget forbidden",
+		},
+		{
+			name:        "Unavailable",
+			err:         unikornerrors.Unavailable("widget not ready"),
+			status:      http.StatusServiceUnavailable,
+			code:        generated.TemporarilyUnavailable,
+			description: "widget not ready",
+		},
+		{
+			name:        "Unclassified",
+			err:         errCause,
+			status:      http.StatusInternalServerError,
+			code:        generated.ServerError,
+			description: "unhandled error",
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, result := mustHandleError(t, test.err)
+
+			if status != test.status || result.Error != test.code || result.ErrorDescription != test.description {
+				t.Fatal("unexpected response", status, result.Error, result.ErrorDescription)
+			}
+		})
+	}
+}
+
+// TestClassifiedPredicates tests predicates recognise shared error classes.
+func TestClassifiedPredicates(t *testing.T) {
+	t.Parallel()
+
+	if !errors.IsHTTPNotFound(fmt.Errorf("%w: widget", unikornerrors.ErrNotFound)) {
+		t.Fatal("expected not found")
+	}
+
+	if !errors.IsHTTPConflict(unikornerrors.Conflict("widget exists")) {
+		t.Fatal("expected conflict")
+	}
+
+	if !errors.IsValidationError(unikornerrors.Invalid("widget malformed")) {
+		t.Fatal("expected validation error")
+	}
+
+	if errors.IsHTTPNotFound(errCause) {
+		t.Fatal("unexpected not found")
+	}
+}
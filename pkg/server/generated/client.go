@@ -13,6 +13,8 @@ import (
 	"net/url"
 	"strings"
 
+	"gopkg.in/yaml.v2"
+
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
@@ -89,6 +91,26 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetApiV1Activity request
+	GetApiV1Activity(ctx context.Context, params *GetApiV1ActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminDebugCapturesCaptureID request
+	GetApiV1AdminDebugCapturesCaptureID(ctx context.Context, captureID CaptureIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminDeprecations request
+	GetApiV1AdminDeprecations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminExport request
+	GetApiV1AdminExport(ctx context.Context, params *GetApiV1AdminExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1AdminMonitorShards request
+	GetApiV1AdminMonitorShards(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1AdminMonitorShards request with any body
+	PutApiV1AdminMonitorShardsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiV1AdminMonitorShards(ctx context.Context, body PutApiV1AdminMonitorShardsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApiV1ApplicationbundlesCluster request
 	GetApiV1ApplicationbundlesCluster(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -117,13 +139,22 @@ type ClientInterface interface {
 
 	PostApiV1AuthTokensToken(ctx context.Context, body PostApiV1AuthTokensTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetApiV1Changelog request
+	GetApiV1Changelog(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ChangelogChangelogVersionAcknowledge request
+	PostApiV1ChangelogChangelogVersionAcknowledge(ctx context.Context, changelogVersion ChangelogVersionParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1Clustertemplates request
+	GetApiV1Clustertemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApiV1Controlplanes request
 	GetApiV1Controlplanes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostApiV1Controlplanes request with any body
-	PostApiV1ControlplanesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostApiV1ControlplanesWithBody(ctx context.Context, params *PostApiV1ControlplanesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostApiV1Controlplanes(ctx context.Context, body PostApiV1ControlplanesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostApiV1Controlplanes(ctx context.Context, params *PostApiV1ControlplanesParams, body PostApiV1ControlplanesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteApiV1ControlplanesControlPlaneName request
 	DeleteApiV1ControlplanesControlPlaneName(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -140,29 +171,182 @@ type ClientInterface interface {
 	GetApiV1ControlplanesControlPlaneNameClusters(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostApiV1ControlplanesControlPlaneNameClusters request with any body
-	PostApiV1ControlplanesControlPlaneNameClustersWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostApiV1ControlplanesControlPlaneNameClustersWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, params *PostApiV1ControlplanesControlPlaneNameClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostApiV1ControlplanesControlPlaneNameClusters(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostApiV1ControlplanesControlPlaneNameClusters(ctx context.Context, controlPlaneName ControlPlaneNameParameter, params *PostApiV1ControlplanesControlPlaneNameClustersParams, body PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteApiV1ControlplanesControlPlaneNameClustersClusterName request
 	DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetApiV1ControlplanesControlPlaneNameClustersClusterName request
-	GetApiV1ControlplanesControlPlaneNameClustersClusterName(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetApiV1ControlplanesControlPlaneNameClustersClusterName(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, params *GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PutApiV1ControlplanesControlPlaneNameClustersClusterName request with any body
 	PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PutApiV1ControlplanesControlPlaneNameClustersClusterName(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate request with any body
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate request
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate request
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig request
 	GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist request with any body
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume request
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate request with any body
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade request with any body
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze request
+	DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze request with any body
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove request
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, upgradeID UpgradeIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools request with any body
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, workloadPoolName WorkloadPoolNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameMove request with any body
+	PostApiV1ControlplanesControlPlaneNameMoveWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1ControlplanesControlPlaneNameMove(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameResize request with any body
+	PostApiV1ControlplanesControlPlaneNameResizeWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1ControlplanesControlPlaneNameResize(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameResizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove request
+	PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove(ctx context.Context, controlPlaneName ControlPlaneNameParameter, upgradeID UpgradeIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1Environments request with any body
+	PostApiV1EnvironmentsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1Environments(ctx context.Context, body PostApiV1EnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1EnvironmentsEnvironmentName request
+	DeleteApiV1EnvironmentsEnvironmentName(ctx context.Context, environmentName EnvironmentNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1EnvironmentsEnvironmentName request
+	GetApiV1EnvironmentsEnvironmentName(ctx context.Context, environmentName EnvironmentNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1Export request
+	GetApiV1Export(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1Import request with any body
+	PostApiV1ImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1Import(ctx context.Context, body PostApiV1ImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1Kubernetesversions request
+	GetApiV1Kubernetesversions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteApiV1Project request
 	DeleteApiV1Project(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostApiV1Project request
-	PostApiV1Project(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostApiV1Project(ctx context.Context, params *PostApiV1ProjectParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ProjectMembers request
+	GetApiV1ProjectMembers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ProjectMembers request with any body
+	PostApiV1ProjectMembersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1ProjectMembers(ctx context.Context, body PostApiV1ProjectMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1ProjectMembersUserID request
+	DeleteApiV1ProjectMembersUserID(ctx context.Context, userID UserIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1ProjectMembersUserID request with any body
+	PutApiV1ProjectMembersUserIDWithBody(ctx context.Context, userID UserIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiV1ProjectMembersUserID(ctx context.Context, userID UserIDParameter, body PutApiV1ProjectMembersUserIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ProjectOffboarding request
+	GetApiV1ProjectOffboarding(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ProjectOffboarding request
+	PostApiV1ProjectOffboarding(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ProjectOffboardingConfirm request with any body
+	PostApiV1ProjectOffboardingConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1ProjectOffboardingConfirm(ctx context.Context, body PostApiV1ProjectOffboardingConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ProjectRolebindings request
+	GetApiV1ProjectRolebindings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiV1ProjectRolebindings request with any body
+	PostApiV1ProjectRolebindingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiV1ProjectRolebindings(ctx context.Context, body PostApiV1ProjectRolebindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiV1ProjectRolebindingsRoleBindingName request
+	DeleteApiV1ProjectRolebindingsRoleBindingName(ctx context.Context, roleBindingName RoleBindingNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ProjectRolebindingsRoleBindingName request
+	GetApiV1ProjectRolebindingsRoleBindingName(ctx context.Context, roleBindingName RoleBindingNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiV1ProjectRolebindingsRoleBindingName request with any body
+	PutApiV1ProjectRolebindingsRoleBindingNameWithBody(ctx context.Context, roleBindingName RoleBindingNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiV1ProjectRolebindingsRoleBindingName(ctx context.Context, roleBindingName RoleBindingNameParameter, body PutApiV1ProjectRolebindingsRoleBindingNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1Providers request
+	GetApiV1Providers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ProvidersOpenstackApplicationCredentialRoles request
+	GetApiV1ProvidersOpenstackApplicationCredentialRoles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage request
 	GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -176,6 +360,12 @@ type ClientInterface interface {
 	// GetApiV1ProvidersOpenstackFlavors request
 	GetApiV1ProvidersOpenstackFlavors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages request
+	GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages(ctx context.Context, flavorName FlavorNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ProvidersOpenstackHealth request
+	GetApiV1ProvidersOpenstackHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApiV1ProvidersOpenstackImages request
 	GetApiV1ProvidersOpenstackImages(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -184,6 +374,93 @@ type ClientInterface interface {
 
 	// GetApiV1ProvidersOpenstackProjects request
 	GetApiV1ProvidersOpenstackProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiV1ProvidersOpenstackQuotas request
+	GetApiV1ProvidersOpenstackQuotas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetApiV1Activity(ctx context.Context, params *GetApiV1ActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ActivityRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminDebugCapturesCaptureID(ctx context.Context, captureID CaptureIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminDebugCapturesCaptureIDRequest(c.Server, captureID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminDeprecations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminDeprecationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminExport(ctx context.Context, params *GetApiV1AdminExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminExportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1AdminMonitorShards(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1AdminMonitorShardsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1AdminMonitorShardsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1AdminMonitorShardsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiV1AdminMonitorShards(ctx context.Context, body PutApiV1AdminMonitorShardsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1AdminMonitorShardsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) GetApiV1ApplicationbundlesCluster(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -306,6 +583,42 @@ func (c *Client) PostApiV1AuthTokensToken(ctx context.Context, body PostApiV1Aut
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetApiV1Changelog(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ChangelogRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiV1ChangelogChangelogVersionAcknowledge(ctx context.Context, changelogVersion ChangelogVersionParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ChangelogChangelogVersionAcknowledgeRequest(c.Server, changelogVersion)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1Clustertemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ClustertemplatesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetApiV1Controlplanes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetApiV1ControlplanesRequest(c.Server)
 	if err != nil {
@@ -318,8 +631,8 @@ func (c *Client) GetApiV1Controlplanes(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiV1ControlplanesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiV1ControlplanesRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostApiV1ControlplanesWithBody(ctx context.Context, params *PostApiV1ControlplanesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -330,8 +643,8 @@ func (c *Client) PostApiV1ControlplanesWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiV1Controlplanes(ctx context.Context, body PostApiV1ControlplanesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiV1ControlplanesRequest(c.Server, body)
+func (c *Client) PostApiV1Controlplanes(ctx context.Context, params *PostApiV1ControlplanesParams, body PostApiV1ControlplanesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -402,8 +715,8 @@ func (c *Client) GetApiV1ControlplanesControlPlaneNameClusters(ctx context.Conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersRequestWithBody(c.Server, controlPlaneName, contentType, body)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, params *PostApiV1ControlplanesControlPlaneNameClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersRequestWithBody(c.Server, controlPlaneName, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -414,8 +727,8 @@ func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersWithBody(ctx cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiV1ControlplanesControlPlaneNameClusters(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersRequest(c.Server, controlPlaneName, body)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClusters(ctx context.Context, controlPlaneName ControlPlaneNameParameter, params *PostApiV1ControlplanesControlPlaneNameClustersParams, body PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersRequest(c.Server, controlPlaneName, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -438,8 +751,8 @@ func (c *Client) DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(ctx
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterName(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameRequest(c.Server, controlPlaneName, clusterName)
+func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterName(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, params *GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameRequest(c.Server, controlPlaneName, clusterName, params)
 	if err != nil {
 		return nil, err
 	}
@@ -474,8 +787,8 @@ func (c *Client) PutApiV1ControlplanesControlPlaneNameClustersClusterName(ctx co
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigRequest(c.Server, controlPlaneName, clusterName)
+func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -486,8 +799,8 @@ func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubecon
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteApiV1Project(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteApiV1ProjectRequest(c.Server)
+func (c *Client) PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateRequestWithBody(c.Server, controlPlaneName, clusterName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -498,8 +811,8 @@ func (c *Client) DeleteApiV1Project(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostApiV1Project(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiV1ProjectRequest(c.Server)
+func (c *Client) PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateRequest(c.Server, controlPlaneName, clusterName, body)
 	if err != nil {
 		return nil, err
 	}
@@ -510,8 +823,8 @@ func (c *Client) PostApiV1Project(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageRequest(c.Server)
+func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameCostRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -522,8 +835,8 @@ func (c *Client) GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(ctx con
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1ProvidersOpenstackAvailabilityZonesCompute(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1ProvidersOpenstackAvailabilityZonesComputeRequest(c.Server)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -534,8 +847,8 @@ func (c *Client) GetApiV1ProvidersOpenstackAvailabilityZonesCompute(ctx context.
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1ProvidersOpenstackExternalNetworks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1ProvidersOpenstackExternalNetworksRequest(c.Server)
+func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -546,8 +859,8 @@ func (c *Client) GetApiV1ProvidersOpenstackExternalNetworks(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1ProvidersOpenstackFlavors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1ProvidersOpenstackFlavorsRequest(c.Server)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -558,8 +871,8 @@ func (c *Client) GetApiV1ProvidersOpenstackFlavors(ctx context.Context, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1ProvidersOpenstackImages(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1ProvidersOpenstackImagesRequest(c.Server)
+func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -570,8 +883,8 @@ func (c *Client) GetApiV1ProvidersOpenstackImages(ctx context.Context, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1ProvidersOpenstackKeyPairs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1ProvidersOpenstackKeyPairsRequest(c.Server)
+func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -582,8 +895,8 @@ func (c *Client) GetApiV1ProvidersOpenstackKeyPairs(ctx context.Context, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1ProvidersOpenstackProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1ProvidersOpenstackProjectsRequest(c.Server)
+func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -594,671 +907,724 @@ func (c *Client) GetApiV1ProvidersOpenstackProjects(ctx context.Context, reqEdit
 	return c.Client.Do(req)
 }
 
-// NewGetApiV1ApplicationbundlesClusterRequest generates requests for GetApiV1ApplicationbundlesCluster
-func NewGetApiV1ApplicationbundlesClusterRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistRequestWithBody(c.Server, controlPlaneName, clusterName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/applicationbundles/cluster")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistRequest(c.Server, controlPlaneName, clusterName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1ApplicationbundlesControlPlaneRequest generates requests for GetApiV1ApplicationbundlesControlPlane
-func NewGetApiV1ApplicationbundlesControlPlaneRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/applicationbundles/controlPlane")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateRequestWithBody(c.Server, controlPlaneName, clusterName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1ApplicationsRequest generates requests for GetApiV1Applications
-func NewGetApiV1ApplicationsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateRequest(c.Server, controlPlaneName, clusterName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/applications")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1AuthJwksRequest generates requests for GetApiV1AuthJwks
-func NewGetApiV1AuthJwksRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeRequestWithBody(c.Server, controlPlaneName, clusterName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/auth/jwks")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeRequest(c.Server, controlPlaneName, clusterName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1AuthOauth2AuthorizationRequest generates requests for GetApiV1AuthOauth2Authorization
-func NewGetApiV1AuthOauth2AuthorizationRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeRequest(c.Server, controlPlaneName, clusterName)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/auth/oauth2/authorization")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeRequestWithBody(c.Server, controlPlaneName, clusterName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostApiV1AuthOauth2TokensRequestWithFormdataBody calls the generic PostApiV1AuthOauth2Tokens builder with application/x-www-form-urlencoded body
-func NewPostApiV1AuthOauth2TokensRequestWithFormdataBody(server string, body PostApiV1AuthOauth2TokensFormdataRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	bodyStr, err := runtime.MarshalForm(body, nil)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeRequest(c.Server, controlPlaneName, clusterName, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = strings.NewReader(bodyStr.Encode())
-	return NewPostApiV1AuthOauth2TokensRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiV1AuthOauth2TokensRequestWithBody generates requests for PostApiV1AuthOauth2Tokens with any type of body
-func NewPostApiV1AuthOauth2TokensRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, upgradeID UpgradeIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveRequest(c.Server, controlPlaneName, clusterName, upgradeID)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/auth/oauth2/tokens")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsRequestWithBody(c.Server, controlPlaneName, clusterName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1AuthOidcCallbackRequest generates requests for GetApiV1AuthOidcCallback
-func NewGetApiV1AuthOidcCallbackRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsRequest(c.Server, controlPlaneName, clusterName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/auth/oidc/callback")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, workloadPoolName WorkloadPoolNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapRequest(c.Server, controlPlaneName, clusterName, workloadPoolName)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostApiV1AuthTokensTokenRequest calls the generic PostApiV1AuthTokensToken builder with application/json body
-func NewPostApiV1AuthTokensTokenRequest(server string, body PostApiV1AuthTokensTokenJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameMoveWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameMoveRequestWithBody(c.Server, controlPlaneName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiV1AuthTokensTokenRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiV1AuthTokensTokenRequestWithBody generates requests for PostApiV1AuthTokensToken with any type of body
-func NewPostApiV1AuthTokensTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameMove(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameMoveRequest(c.Server, controlPlaneName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/auth/tokens/token")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameResizeWithBody(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameResizeRequestWithBody(c.Server, controlPlaneName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1ControlplanesRequest generates requests for GetApiV1Controlplanes
-func NewGetApiV1ControlplanesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameResize(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameResizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameResizeRequest(c.Server, controlPlaneName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/controlplanes")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove(ctx context.Context, controlPlaneName ControlPlaneNameParameter, upgradeID UpgradeIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveRequest(c.Server, controlPlaneName, upgradeID)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostApiV1ControlplanesRequest calls the generic PostApiV1Controlplanes builder with application/json body
-func NewPostApiV1ControlplanesRequest(server string, body PostApiV1ControlplanesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostApiV1EnvironmentsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1EnvironmentsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiV1ControlplanesRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiV1ControlplanesRequestWithBody generates requests for PostApiV1Controlplanes with any type of body
-func NewPostApiV1ControlplanesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1Environments(ctx context.Context, body PostApiV1EnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1EnvironmentsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/controlplanes")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteApiV1EnvironmentsEnvironmentName(ctx context.Context, environmentName EnvironmentNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1EnvironmentsEnvironmentNameRequest(c.Server, environmentName)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteApiV1ControlplanesControlPlaneNameRequest generates requests for DeleteApiV1ControlplanesControlPlaneName
-func NewDeleteApiV1ControlplanesControlPlaneNameRequest(server string, controlPlaneName ControlPlaneNameParameter) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+func (c *Client) GetApiV1EnvironmentsEnvironmentName(ctx context.Context, environmentName EnvironmentNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1EnvironmentsEnvironmentNameRequest(c.Server, environmentName)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetApiV1Export(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ExportRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1ControlplanesControlPlaneNameRequest generates requests for GetApiV1ControlplanesControlPlaneName
-func NewGetApiV1ControlplanesControlPlaneNameRequest(server string, controlPlaneName ControlPlaneNameParameter) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+func (c *Client) PostApiV1ImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ImportRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiV1Import(ctx context.Context, body PostApiV1ImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ImportRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutApiV1ControlplanesControlPlaneNameRequest calls the generic PutApiV1ControlplanesControlPlaneName builder with application/json body
-func NewPutApiV1ControlplanesControlPlaneNameRequest(server string, controlPlaneName ControlPlaneNameParameter, body PutApiV1ControlplanesControlPlaneNameJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetApiV1Kubernetesversions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1KubernetesversionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutApiV1ControlplanesControlPlaneNameRequestWithBody(server, controlPlaneName, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutApiV1ControlplanesControlPlaneNameRequestWithBody generates requests for PutApiV1ControlplanesControlPlaneName with any type of body
-func NewPutApiV1ControlplanesControlPlaneNameRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+func (c *Client) DeleteApiV1Project(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1ProjectRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1Project(ctx context.Context, params *PostApiV1ProjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ProjectRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetApiV1ProjectMembers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProjectMembersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1ControlplanesControlPlaneNameClustersRequest generates requests for GetApiV1ControlplanesControlPlaneNameClusters
-func NewGetApiV1ControlplanesControlPlaneNameClustersRequest(server string, controlPlaneName ControlPlaneNameParameter) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+func (c *Client) PostApiV1ProjectMembersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ProjectMembersRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1ProjectMembers(ctx context.Context, body PostApiV1ProjectMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ProjectMembersRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteApiV1ProjectMembersUserID(ctx context.Context, userID UserIDParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1ProjectMembersUserIDRequest(c.Server, userID)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostApiV1ControlplanesControlPlaneNameClustersRequest calls the generic PostApiV1ControlplanesControlPlaneNameClusters builder with application/json body
-func NewPostApiV1ControlplanesControlPlaneNameClustersRequest(server string, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutApiV1ProjectMembersUserIDWithBody(ctx context.Context, userID UserIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1ProjectMembersUserIDRequestWithBody(c.Server, userID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiV1ControlplanesControlPlaneNameClustersRequestWithBody(server, controlPlaneName, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiV1ControlplanesControlPlaneNameClustersRequestWithBody generates requests for PostApiV1ControlplanesControlPlaneNameClusters with any type of body
-func NewPostApiV1ControlplanesControlPlaneNameClustersRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+func (c *Client) PutApiV1ProjectMembersUserID(ctx context.Context, userID UserIDParameter, body PutApiV1ProjectMembersUserIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1ProjectMembersUserIDRequest(c.Server, userID, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetApiV1ProjectOffboarding(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProjectOffboardingRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiV1ProjectOffboarding(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ProjectOffboardingRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameRequest generates requests for DeleteApiV1ControlplanesControlPlaneNameClustersClusterName
-func NewDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+func (c *Client) PostApiV1ProjectOffboardingConfirmWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ProjectOffboardingConfirmRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1ProjectOffboardingConfirm(ctx context.Context, body PostApiV1ProjectOffboardingConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ProjectOffboardingConfirmRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetApiV1ProjectRolebindings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProjectRolebindingsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterName
-func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+func (c *Client) PostApiV1ProjectRolebindingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ProjectRolebindingsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiV1ProjectRolebindings(ctx context.Context, body PostApiV1ProjectRolebindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiV1ProjectRolebindingsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteApiV1ProjectRolebindingsRoleBindingName(ctx context.Context, roleBindingName RoleBindingNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiV1ProjectRolebindingsRoleBindingNameRequest(c.Server, roleBindingName)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameRequest calls the generic PutApiV1ControlplanesControlPlaneNameClustersClusterName builder with application/json body
-func NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetApiV1ProjectRolebindingsRoleBindingName(ctx context.Context, roleBindingName RoleBindingNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProjectRolebindingsRoleBindingNameRequest(c.Server, roleBindingName)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameRequestWithBody(server, controlPlaneName, clusterName, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameRequestWithBody generates requests for PutApiV1ControlplanesControlPlaneNameClustersClusterName with any type of body
-func NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+func (c *Client) PutApiV1ProjectRolebindingsRoleBindingNameWithBody(ctx context.Context, roleBindingName RoleBindingNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1ProjectRolebindingsRoleBindingNameRequestWithBody(c.Server, roleBindingName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutApiV1ProjectRolebindingsRoleBindingName(ctx context.Context, roleBindingName RoleBindingNameParameter, body PutApiV1ProjectRolebindingsRoleBindingNameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiV1ProjectRolebindingsRoleBindingNameRequest(c.Server, roleBindingName, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetApiV1Providers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig
-func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+func (c *Client) GetApiV1ProvidersOpenstackApplicationCredentialRoles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackApplicationCredentialRolesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+func (c *Client) GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ProvidersOpenstackAvailabilityZonesCompute(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackAvailabilityZonesComputeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ProvidersOpenstackExternalNetworks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackExternalNetworksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ProvidersOpenstackFlavors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackFlavorsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages(ctx context.Context, flavorName FlavorNameParameter, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesRequest(c.Server, flavorName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ProvidersOpenstackHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ProvidersOpenstackImages(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackImagesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ProvidersOpenstackKeyPairs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackKeyPairsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ProvidersOpenstackProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackProjectsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiV1ProvidersOpenstackQuotas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1ProvidersOpenstackQuotasRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetApiV1ActivityRequest generates requests for GetApiV1Activity
+func NewGetApiV1ActivityRequest(server string, params *GetApiV1ActivityParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/kubeconfig", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/activity")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1268,6 +1634,90 @@ func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigReques
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.Type != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Since != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Until != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Continue != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1276,16 +1726,23 @@ func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigReques
 	return req, nil
 }
 
-// NewDeleteApiV1ProjectRequest generates requests for DeleteApiV1Project
-func NewDeleteApiV1ProjectRequest(server string) (*http.Request, error) {
+// NewGetApiV1AdminDebugCapturesCaptureIDRequest generates requests for GetApiV1AdminDebugCapturesCaptureID
+func NewGetApiV1AdminDebugCapturesCaptureIDRequest(server string, captureID CaptureIDParameter) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "captureID", runtime.ParamLocationPath, captureID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/project")
+	operationPath := fmt.Sprintf("/api/v1/admin/debug/captures/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1295,7 +1752,7 @@ func NewDeleteApiV1ProjectRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1303,8 +1760,8 @@ func NewDeleteApiV1ProjectRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPostApiV1ProjectRequest generates requests for PostApiV1Project
-func NewPostApiV1ProjectRequest(server string) (*http.Request, error) {
+// NewGetApiV1AdminDeprecationsRequest generates requests for GetApiV1AdminDeprecations
+func NewGetApiV1AdminDeprecationsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1312,7 +1769,7 @@ func NewPostApiV1ProjectRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/project")
+	operationPath := fmt.Sprintf("/api/v1/admin/deprecations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1322,7 +1779,7 @@ func NewPostApiV1ProjectRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1330,8 +1787,8 @@ func NewPostApiV1ProjectRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageRequest generates requests for GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage
-func NewGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageRequest(server string) (*http.Request, error) {
+// NewGetApiV1AdminExportRequest generates requests for GetApiV1AdminExport
+func NewGetApiV1AdminExportRequest(server string, params *GetApiV1AdminExportParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1339,7 +1796,7 @@ func NewGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageRequest(server st
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/providers/openstack/availability-zones/block-storage")
+	operationPath := fmt.Sprintf("/api/v1/admin/export")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1349,6 +1806,42 @@ func NewGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageRequest(server st
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Continue != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "continue", runtime.ParamLocationQuery, *params.Continue); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1357,8 +1850,8 @@ func NewGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageRequest(server st
 	return req, nil
 }
 
-// NewGetApiV1ProvidersOpenstackAvailabilityZonesComputeRequest generates requests for GetApiV1ProvidersOpenstackAvailabilityZonesCompute
-func NewGetApiV1ProvidersOpenstackAvailabilityZonesComputeRequest(server string) (*http.Request, error) {
+// NewGetApiV1AdminMonitorShardsRequest generates requests for GetApiV1AdminMonitorShards
+func NewGetApiV1AdminMonitorShardsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1366,7 +1859,7 @@ func NewGetApiV1ProvidersOpenstackAvailabilityZonesComputeRequest(server string)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/providers/openstack/availability-zones/compute")
+	operationPath := fmt.Sprintf("/api/v1/admin/monitor/shards")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1384,8 +1877,19 @@ func NewGetApiV1ProvidersOpenstackAvailabilityZonesComputeRequest(server string)
 	return req, nil
 }
 
-// NewGetApiV1ProvidersOpenstackExternalNetworksRequest generates requests for GetApiV1ProvidersOpenstackExternalNetworks
-func NewGetApiV1ProvidersOpenstackExternalNetworksRequest(server string) (*http.Request, error) {
+// NewPutApiV1AdminMonitorShardsRequest calls the generic PutApiV1AdminMonitorShards builder with application/json body
+func NewPutApiV1AdminMonitorShardsRequest(server string, body PutApiV1AdminMonitorShardsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1AdminMonitorShardsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutApiV1AdminMonitorShardsRequestWithBody generates requests for PutApiV1AdminMonitorShards with any type of body
+func NewPutApiV1AdminMonitorShardsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1393,7 +1897,7 @@ func NewGetApiV1ProvidersOpenstackExternalNetworksRequest(server string) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/providers/openstack/external-networks")
+	operationPath := fmt.Sprintf("/api/v1/admin/monitor/shards")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1403,16 +1907,18 @@ func NewGetApiV1ProvidersOpenstackExternalNetworksRequest(server string) (*http.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetApiV1ProvidersOpenstackFlavorsRequest generates requests for GetApiV1ProvidersOpenstackFlavors
-func NewGetApiV1ProvidersOpenstackFlavorsRequest(server string) (*http.Request, error) {
+// NewGetApiV1ApplicationbundlesClusterRequest generates requests for GetApiV1ApplicationbundlesCluster
+func NewGetApiV1ApplicationbundlesClusterRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1420,7 +1926,7 @@ func NewGetApiV1ProvidersOpenstackFlavorsRequest(server string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/providers/openstack/flavors")
+	operationPath := fmt.Sprintf("/api/v1/applicationbundles/cluster")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1438,8 +1944,8 @@ func NewGetApiV1ProvidersOpenstackFlavorsRequest(server string) (*http.Request,
 	return req, nil
 }
 
-// NewGetApiV1ProvidersOpenstackImagesRequest generates requests for GetApiV1ProvidersOpenstackImages
-func NewGetApiV1ProvidersOpenstackImagesRequest(server string) (*http.Request, error) {
+// NewGetApiV1ApplicationbundlesControlPlaneRequest generates requests for GetApiV1ApplicationbundlesControlPlane
+func NewGetApiV1ApplicationbundlesControlPlaneRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1447,7 +1953,7 @@ func NewGetApiV1ProvidersOpenstackImagesRequest(server string) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/providers/openstack/images")
+	operationPath := fmt.Sprintf("/api/v1/applicationbundles/controlPlane")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1465,8 +1971,8 @@ func NewGetApiV1ProvidersOpenstackImagesRequest(server string) (*http.Request, e
 	return req, nil
 }
 
-// NewGetApiV1ProvidersOpenstackKeyPairsRequest generates requests for GetApiV1ProvidersOpenstackKeyPairs
-func NewGetApiV1ProvidersOpenstackKeyPairsRequest(server string) (*http.Request, error) {
+// NewGetApiV1ApplicationsRequest generates requests for GetApiV1Applications
+func NewGetApiV1ApplicationsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1474,7 +1980,7 @@ func NewGetApiV1ProvidersOpenstackKeyPairsRequest(server string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/providers/openstack/key-pairs")
+	operationPath := fmt.Sprintf("/api/v1/applications")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1492,8 +1998,8 @@ func NewGetApiV1ProvidersOpenstackKeyPairsRequest(server string) (*http.Request,
 	return req, nil
 }
 
-// NewGetApiV1ProvidersOpenstackProjectsRequest generates requests for GetApiV1ProvidersOpenstackProjects
-func NewGetApiV1ProvidersOpenstackProjectsRequest(server string) (*http.Request, error) {
+// NewGetApiV1AuthJwksRequest generates requests for GetApiV1AuthJwks
+func NewGetApiV1AuthJwksRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1501,7 +2007,7 @@ func NewGetApiV1ProvidersOpenstackProjectsRequest(server string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/providers/openstack/projects")
+	operationPath := fmt.Sprintf("/api/v1/auth/jwks")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1519,1160 +2025,8824 @@ func NewGetApiV1ProvidersOpenstackProjectsRequest(server string) (*http.Request,
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetApiV1AuthOauth2AuthorizationRequest generates requests for GetApiV1AuthOauth2Authorization
+func NewGetApiV1AuthOauth2AuthorizationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/api/v1/auth/oauth2/authorization")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewPostApiV1AuthOauth2TokensRequestWithFormdataBody calls the generic PostApiV1AuthOauth2Tokens builder with application/x-www-form-urlencoded body
+func NewPostApiV1AuthOauth2TokensRequestWithFormdataBody(server string, body PostApiV1AuthOauth2TokensFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostApiV1AuthOauth2TokensRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetApiV1ApplicationbundlesCluster request
-	GetApiV1ApplicationbundlesClusterWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationbundlesClusterResponse, error)
+// NewPostApiV1AuthOauth2TokensRequestWithBody generates requests for PostApiV1AuthOauth2Tokens with any type of body
+func NewPostApiV1AuthOauth2TokensRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetApiV1ApplicationbundlesControlPlane request
-	GetApiV1ApplicationbundlesControlPlaneWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationbundlesControlPlaneResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1Applications request
-	GetApiV1ApplicationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationsResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/auth/oauth2/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetApiV1AuthJwks request
-	GetApiV1AuthJwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthJwksResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1AuthOauth2Authorization request
-	GetApiV1AuthOauth2AuthorizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthOauth2AuthorizationResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostApiV1AuthOauth2Tokens request with any body
-	PostApiV1AuthOauth2TokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthOauth2TokensResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	PostApiV1AuthOauth2TokensWithFormdataBodyWithResponse(ctx context.Context, body PostApiV1AuthOauth2TokensFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthOauth2TokensResponse, error)
+	return req, nil
+}
 
-	// GetApiV1AuthOidcCallback request
-	GetApiV1AuthOidcCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthOidcCallbackResponse, error)
+// NewGetApiV1AuthOidcCallbackRequest generates requests for GetApiV1AuthOidcCallback
+func NewGetApiV1AuthOidcCallbackRequest(server string) (*http.Request, error) {
+	var err error
 
-	// PostApiV1AuthTokensToken request with any body
-	PostApiV1AuthTokensTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthTokensTokenResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PostApiV1AuthTokensTokenWithResponse(ctx context.Context, body PostApiV1AuthTokensTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthTokensTokenResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/auth/oidc/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetApiV1Controlplanes request
-	GetApiV1ControlplanesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostApiV1Controlplanes request with any body
-	PostApiV1ControlplanesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PostApiV1ControlplanesWithResponse(ctx context.Context, body PostApiV1ControlplanesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesResponse, error)
+	return req, nil
+}
 
-	// DeleteApiV1ControlplanesControlPlaneName request
-	DeleteApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ControlplanesControlPlaneNameResponse, error)
+// NewPostApiV1AuthTokensTokenRequest calls the generic PostApiV1AuthTokensToken builder with application/json body
+func NewPostApiV1AuthTokensTokenRequest(server string, body PostApiV1AuthTokensTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1AuthTokensTokenRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// GetApiV1ControlplanesControlPlaneName request
-	GetApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameResponse, error)
+// NewPostApiV1AuthTokensTokenRequestWithBody generates requests for PostApiV1AuthTokensToken with any type of body
+func NewPostApiV1AuthTokensTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// PutApiV1ControlplanesControlPlaneName request with any body
-	PutApiV1ControlplanesControlPlaneNameWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PutApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PutApiV1ControlplanesControlPlaneNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/auth/tokens/token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetApiV1ControlplanesControlPlaneNameClusters request
-	GetApiV1ControlplanesControlPlaneNameClustersWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostApiV1ControlplanesControlPlaneNameClusters request with any body
-	PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	PostApiV1ControlplanesControlPlaneNameClustersWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// DeleteApiV1ControlplanesControlPlaneNameClustersClusterName request
-	DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error)
+	return req, nil
+}
 
-	// GetApiV1ControlplanesControlPlaneNameClustersClusterName request
-	GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error)
+// NewGetApiV1ChangelogRequest generates requests for GetApiV1Changelog
+func NewGetApiV1ChangelogRequest(server string) (*http.Request, error) {
+	var err error
 
-	// PutApiV1ControlplanesControlPlaneNameClustersClusterName request with any body
-	PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/changelog")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig request
-	GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteApiV1Project request
-	DeleteApiV1ProjectWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiV1ProjectResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostApiV1Project request
-	PostApiV1ProjectWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiV1ProjectResponse, error)
+	return req, nil
+}
 
-	// GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage request
-	GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse, error)
+// NewPostApiV1ChangelogChangelogVersionAcknowledgeRequest generates requests for PostApiV1ChangelogChangelogVersionAcknowledge
+func NewPostApiV1ChangelogChangelogVersionAcknowledgeRequest(server string, changelogVersion ChangelogVersionParameter) (*http.Request, error) {
+	var err error
 
-	// GetApiV1ProvidersOpenstackAvailabilityZonesCompute request
-	GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse, error)
+	var pathParam0 string
 
-	// GetApiV1ProvidersOpenstackExternalNetworks request
-	GetApiV1ProvidersOpenstackExternalNetworksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackExternalNetworksResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "changelogVersion", runtime.ParamLocationPath, changelogVersion)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1ProvidersOpenstackFlavors request
-	GetApiV1ProvidersOpenstackFlavorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackFlavorsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1ProvidersOpenstackImages request
-	GetApiV1ProvidersOpenstackImagesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackImagesResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/changelog/%s/acknowledge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetApiV1ProvidersOpenstackKeyPairs request
-	GetApiV1ProvidersOpenstackKeyPairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackKeyPairsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1ProvidersOpenstackProjects request
-	GetApiV1ProvidersOpenstackProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackProjectsResponse, error)
-}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-type GetApiV1ApplicationbundlesClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ApplicationBundles
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ApplicationbundlesClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetApiV1ClustertemplatesRequest generates requests for GetApiV1Clustertemplates
+func NewGetApiV1ClustertemplatesRequest(server string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ApplicationbundlesClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1ApplicationbundlesControlPlaneResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ApplicationBundles
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	operationPath := fmt.Sprintf("/api/v1/clustertemplates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ApplicationbundlesControlPlaneResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ApplicationbundlesControlPlaneResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type GetApiV1ApplicationsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Applications
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+// NewGetApiV1ControlplanesRequest generates requests for GetApiV1Controlplanes
+func NewGetApiV1ControlplanesRequest(server string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ApplicationsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ApplicationsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/controlplanes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetApiV1AuthJwksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *JsonWebKeySet
-	JSON500      *Oauth2Error
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1AuthJwksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1AuthJwksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostApiV1ControlplanesRequest calls the generic PostApiV1Controlplanes builder with application/json body
+func NewPostApiV1ControlplanesRequest(server string, params *PostApiV1ControlplanesParams, body PostApiV1ControlplanesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ControlplanesRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-type GetApiV1AuthOauth2AuthorizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+// NewPostApiV1ControlplanesRequestWithBody generates requests for PostApiV1Controlplanes with any type of body
+func NewPostApiV1ControlplanesRequestWithBody(server string, params *PostApiV1ControlplanesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1AuthOauth2AuthorizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1AuthOauth2AuthorizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/controlplanes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type PostApiV1AuthOauth2TokensResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Token
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1AuthOauth2TokensResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1AuthOauth2TokensResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
 	}
-	return 0
-}
 
-type GetApiV1AuthOidcCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *Oauth2Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1AuthOidcCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeleteApiV1ControlplanesControlPlaneNameRequest generates requests for DeleteApiV1ControlplanesControlPlaneName
+func NewDeleteApiV1ControlplanesControlPlaneNameRequest(server string, controlPlaneName ControlPlaneNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1AuthOidcCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostApiV1AuthTokensTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Token
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1AuthTokensTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1AuthTokensTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1ControlplanesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ControlPlanes
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON404      *Oauth2Error
-	JSON500      *Oauth2Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ControlplanesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetApiV1ControlplanesControlPlaneNameRequest generates requests for GetApiV1ControlplanesControlPlaneName
+func NewGetApiV1ControlplanesControlPlaneNameRequest(server string, controlPlaneName ControlPlaneNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ControlplanesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostApiV1ControlplanesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON409      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1ControlplanesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1ControlplanesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteApiV1ControlplanesControlPlaneNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON404      *Oauth2Error
-	JSON500      *Oauth2Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiV1ControlplanesControlPlaneNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPutApiV1ControlplanesControlPlaneNameRequest calls the generic PutApiV1ControlplanesControlPlaneName builder with application/json body
+func NewPutApiV1ControlplanesControlPlaneNameRequest(server string, controlPlaneName ControlPlaneNameParameter, body PutApiV1ControlplanesControlPlaneNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1ControlplanesControlPlaneNameRequestWithBody(server, controlPlaneName, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiV1ControlplanesControlPlaneNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewPutApiV1ControlplanesControlPlaneNameRequestWithBody generates requests for PutApiV1ControlplanesControlPlaneName with any type of body
+func NewPutApiV1ControlplanesControlPlaneNameRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetApiV1ControlplanesControlPlaneNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ControlPlane
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON404      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ControlplanesControlPlaneNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ControlplanesControlPlaneNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutApiV1ControlplanesControlPlaneNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON404      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutApiV1ControlplanesControlPlaneNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiV1ControlplanesControlPlaneNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1ControlplanesControlPlaneNameClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *KubernetesClusters
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON404      *Oauth2Error
-	JSON500      *Oauth2Error
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ControlplanesControlPlaneNameClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetApiV1ControlplanesControlPlaneNameClustersRequest generates requests for GetApiV1ControlplanesControlPlaneNameClusters
+func NewGetApiV1ControlplanesControlPlaneNameClustersRequest(server string, controlPlaneName ControlPlaneNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ControlplanesControlPlaneNameClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostApiV1ControlplanesControlPlaneNameClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON403      *Oauth2Error
-	JSON409      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1ControlplanesControlPlaneNameClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1ControlplanesControlPlaneNameClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON404      *Oauth2Error
-	JSON500      *Oauth2Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostApiV1ControlplanesControlPlaneNameClustersRequest calls the generic PostApiV1ControlplanesControlPlaneNameClusters builder with application/json body
+func NewPostApiV1ControlplanesControlPlaneNameClustersRequest(server string, controlPlaneName ControlPlaneNameParameter, params *PostApiV1ControlplanesControlPlaneNameClustersParams, body PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ControlplanesControlPlaneNameClustersRequestWithBody(server, controlPlaneName, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewPostApiV1ControlplanesControlPlaneNameClustersRequestWithBody generates requests for PostApiV1ControlplanesControlPlaneNameClusters with any type of body
+func NewPostApiV1ControlplanesControlPlaneNameClustersRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, params *PostApiV1ControlplanesControlPlaneNameClustersParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *KubernetesCluster
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON404      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON404      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryValues := queryURL.Query()
+
+	if params.DryRun != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
-	return 0
-}
 
-type GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON404      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	if params.Template != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "template", runtime.ParamLocationQuery, *params.Template); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteApiV1ProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON404      *Oauth2Error
-	JSON500      *Oauth2Error
-}
-
-// Status returns HTTPResponse.Status
-func (r DeleteApiV1ProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	req.Header.Add("Content-Type", contentType)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiV1ProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
 
-type PostApiV1ProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON409      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
 
-// Status returns HTTPResponse.Status
-func (r PostApiV1ProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		req.Header.Set("Idempotency-Key", headerParam0)
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiV1ProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OpenstackAvailabilityZones
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+// NewDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameRequest generates requests for DeleteApiV1ControlplanesControlPlaneNameClustersClusterName
+func NewDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OpenstackAvailabilityZones
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1ProvidersOpenstackExternalNetworksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OpenstackExternalNetworks
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ProvidersOpenstackExternalNetworksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ProvidersOpenstackExternalNetworksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1ProvidersOpenstackFlavorsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OpenstackFlavors
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ProvidersOpenstackFlavorsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterName
+func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, params *GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ProvidersOpenstackFlavorsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1ProvidersOpenstackImagesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OpenstackImages
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ProvidersOpenstackImagesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ProvidersOpenstackImagesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetApiV1ProvidersOpenstackKeyPairsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OpenstackKeyPairs
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
-}
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ProvidersOpenstackKeyPairsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ProvidersOpenstackKeyPairsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetApiV1ProvidersOpenstackProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OpenstackProjects
-	JSON400      *Oauth2Error
-	JSON401      *Oauth2Error
-	JSON500      *Oauth2Error
-}
+	queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1ProvidersOpenstackProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params.View != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "view", runtime.ParamLocationQuery, *params.View); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1ProvidersOpenstackProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-// GetApiV1ApplicationbundlesClusterWithResponse request returning *GetApiV1ApplicationbundlesClusterResponse
-func (c *ClientWithResponses) GetApiV1ApplicationbundlesClusterWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationbundlesClusterResponse, error) {
-	rsp, err := c.GetApiV1ApplicationbundlesCluster(ctx, reqEditors...)
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ApplicationbundlesClusterResponse(rsp)
+
+	return req, nil
 }
 
-// GetApiV1ApplicationbundlesControlPlaneWithResponse request returning *GetApiV1ApplicationbundlesControlPlaneResponse
-func (c *ClientWithResponses) GetApiV1ApplicationbundlesControlPlaneWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationbundlesControlPlaneResponse, error) {
-	rsp, err := c.GetApiV1ApplicationbundlesControlPlane(ctx, reqEditors...)
+// NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameRequest calls the generic PutApiV1ControlplanesControlPlaneNameClustersClusterName builder with application/json body
+func NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ApplicationbundlesControlPlaneResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameRequestWithBody(server, controlPlaneName, clusterName, "application/json", bodyReader)
 }
 
-// GetApiV1ApplicationsWithResponse request returning *GetApiV1ApplicationsResponse
-func (c *ClientWithResponses) GetApiV1ApplicationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationsResponse, error) {
-	rsp, err := c.GetApiV1Applications(ctx, reqEditors...)
+// NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameRequestWithBody generates requests for PutApiV1ControlplanesControlPlaneNameClustersClusterName with any type of body
+func NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ApplicationsResponse(rsp)
-}
 
-// GetApiV1AuthJwksWithResponse request returning *GetApiV1AuthJwksResponse
-func (c *ClientWithResponses) GetApiV1AuthJwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthJwksResponse, error) {
-	rsp, err := c.GetApiV1AuthJwks(ctx, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1AuthJwksResponse(rsp)
-}
 
-// GetApiV1AuthOauth2AuthorizationWithResponse request returning *GetApiV1AuthOauth2AuthorizationResponse
-func (c *ClientWithResponses) GetApiV1AuthOauth2AuthorizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthOauth2AuthorizationResponse, error) {
-	rsp, err := c.GetApiV1AuthOauth2Authorization(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1AuthOauth2AuthorizationResponse(rsp)
-}
 
-// PostApiV1AuthOauth2TokensWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthOauth2TokensResponse
-func (c *ClientWithResponses) PostApiV1AuthOauth2TokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthOauth2TokensResponse, error) {
-	rsp, err := c.PostApiV1AuthOauth2TokensWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1AuthOauth2TokensResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostApiV1AuthOauth2TokensWithFormdataBodyWithResponse(ctx context.Context, body PostApiV1AuthOauth2TokensFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthOauth2TokensResponse, error) {
-	rsp, err := c.PostApiV1AuthOauth2TokensWithFormdataBody(ctx, body, reqEditors...)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1AuthOauth2TokensResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetApiV1AuthOidcCallbackWithResponse request returning *GetApiV1AuthOidcCallbackResponse
-func (c *ClientWithResponses) GetApiV1AuthOidcCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthOidcCallbackResponse, error) {
-	rsp, err := c.GetApiV1AuthOidcCallback(ctx, reqEditors...)
+// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate
+func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1AuthOidcCallbackResponse(rsp)
-}
 
-// PostApiV1AuthTokensTokenWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthTokensTokenResponse
-func (c *ClientWithResponses) PostApiV1AuthTokensTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthTokensTokenResponse, error) {
-	rsp, err := c.PostApiV1AuthTokensTokenWithBody(ctx, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1AuthTokensTokenResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostApiV1AuthTokensTokenWithResponse(ctx context.Context, body PostApiV1AuthTokensTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthTokensTokenResponse, error) {
-	rsp, err := c.PostApiV1AuthTokensToken(ctx, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1AuthTokensTokenResponse(rsp)
-}
 
-// GetApiV1ControlplanesWithResponse request returning *GetApiV1ControlplanesResponse
-func (c *ClientWithResponses) GetApiV1ControlplanesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesResponse, error) {
-	rsp, err := c.GetApiV1Controlplanes(ctx, reqEditors...)
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/certificate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ControlplanesResponse(rsp)
-}
 
-// PostApiV1ControlplanesWithBodyWithResponse request with arbitrary body returning *PostApiV1ControlplanesResponse
-func (c *ClientWithResponses) PostApiV1ControlplanesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesResponse, error) {
-	rsp, err := c.PostApiV1ControlplanesWithBody(ctx, contentType, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1ControlplanesResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) PostApiV1ControlplanesWithResponse(ctx context.Context, body PostApiV1ControlplanesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesResponse, error) {
-	rsp, err := c.PostApiV1Controlplanes(ctx, body, reqEditors...)
+// NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateRequest calls the generic PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate builder with application/json body
+func NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1ControlplanesResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateRequestWithBody(server, controlPlaneName, clusterName, "application/json", bodyReader)
 }
 
-// DeleteApiV1ControlplanesControlPlaneNameWithResponse request returning *DeleteApiV1ControlplanesControlPlaneNameResponse
-func (c *ClientWithResponses) DeleteApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ControlplanesControlPlaneNameResponse, error) {
-	rsp, err := c.DeleteApiV1ControlplanesControlPlaneName(ctx, controlPlaneName, reqEditors...)
+// NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateRequestWithBody generates requests for PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate with any type of body
+func NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteApiV1ControlplanesControlPlaneNameResponse(rsp)
-}
 
-// GetApiV1ControlplanesControlPlaneNameWithResponse request returning *GetApiV1ControlplanesControlPlaneNameResponse
-func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameResponse, error) {
-	rsp, err := c.GetApiV1ControlplanesControlPlaneName(ctx, controlPlaneName, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ControlplanesControlPlaneNameResponse(rsp)
-}
 
-// PutApiV1ControlplanesControlPlaneNameWithBodyWithResponse request with arbitrary body returning *PutApiV1ControlplanesControlPlaneNameResponse
-func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameResponse, error) {
-	rsp, err := c.PutApiV1ControlplanesControlPlaneNameWithBody(ctx, controlPlaneName, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1ControlplanesControlPlaneNameResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PutApiV1ControlplanesControlPlaneNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameResponse, error) {
-	rsp, err := c.PutApiV1ControlplanesControlPlaneName(ctx, controlPlaneName, body, reqEditors...)
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/certificate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1ControlplanesControlPlaneNameResponse(rsp)
-}
 
-// GetApiV1ControlplanesControlPlaneNameClustersWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersResponse
-func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersResponse, error) {
-	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClusters(ctx, controlPlaneName, reqEditors...)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ControlplanesControlPlaneNameClustersResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse request with arbitrary body returning *PostApiV1ControlplanesControlPlaneNameClustersResponse
-func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersResponse, error) {
-	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersWithBody(ctx, controlPlaneName, contentType, body, reqEditors...)
+// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameCostRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost
+func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameCostRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1ControlplanesControlPlaneNameClustersResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersResponse, error) {
-	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClusters(ctx, controlPlaneName, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1ControlplanesControlPlaneNameClustersResponse(rsp)
-}
 
-// DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse request returning *DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse
-func (c *ClientWithResponses) DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
-	rsp, err := c.DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(ctx, controlPlaneName, clusterName, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp)
-}
 
-// GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse
-func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
-	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterName(ctx, controlPlaneName, clusterName, reqEditors...)
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/cost", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp)
-}
 
-// PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBodyWithResponse request with arbitrary body returning *PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse
-func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
-	rsp, err := c.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBody(ctx, controlPlaneName, clusterName, contentType, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
-	rsp, err := c.PutApiV1ControlplanesControlPlaneNameClustersClusterName(ctx, controlPlaneName, clusterName, body, reqEditors...)
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateRequest generates requests for PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp)
-}
 
-// GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse
-func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse, error) {
-	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(ctx, controlPlaneName, clusterName, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse(rsp)
-}
 
-// DeleteApiV1ProjectWithResponse request returning *DeleteApiV1ProjectResponse
-func (c *ClientWithResponses) DeleteApiV1ProjectWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiV1ProjectResponse, error) {
-	rsp, err := c.DeleteApiV1Project(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteApiV1ProjectResponse(rsp)
-}
 
-// PostApiV1ProjectWithResponse request returning *PostApiV1ProjectResponse
-func (c *ClientWithResponses) PostApiV1ProjectWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiV1ProjectResponse, error) {
-	rsp, err := c.PostApiV1Project(ctx, reqEditors...)
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/credentials/rotate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostApiV1ProjectResponse(rsp)
-}
 
-// GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse request returning *GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse
-func (c *ClientWithResponses) GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse, error) {
-	rsp, err := c.GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(ctx, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse(rsp)
+
+	return req, nil
 }
 
-// GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse request returning *GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse
-func (c *ClientWithResponses) GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse, error) {
-	rsp, err := c.GetApiV1ProvidersOpenstackAvailabilityZonesCompute(ctx, reqEditors...)
+// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth
+func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse(rsp)
-}
 
-// GetApiV1ProvidersOpenstackExternalNetworksWithResponse request returning *GetApiV1ProvidersOpenstackExternalNetworksResponse
-func (c *ClientWithResponses) GetApiV1ProvidersOpenstackExternalNetworksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackExternalNetworksResponse, error) {
-	rsp, err := c.GetApiV1ProvidersOpenstackExternalNetworks(ctx, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ProvidersOpenstackExternalNetworksResponse(rsp)
-}
 
-// GetApiV1ProvidersOpenstackFlavorsWithResponse request returning *GetApiV1ProvidersOpenstackFlavorsResponse
-func (c *ClientWithResponses) GetApiV1ProvidersOpenstackFlavorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackFlavorsResponse, error) {
-	rsp, err := c.GetApiV1ProvidersOpenstackFlavors(ctx, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiV1ProvidersOpenstackFlavorsResponse(rsp)
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/health", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// GetApiV1ProvidersOpenstackImagesWithResponse request returning *GetApiV1ProvidersOpenstackImagesResponse
-func (c *ClientWithResponses) GetApiV1ProvidersOpenstackImagesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackImagesResponse, error) {
-	rsp, err := c.GetApiV1ProvidersOpenstackImages(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiV1ProvidersOpenstackImagesResponse(rsp)
-}
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateRequest generates requests for PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/hibernate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig
+func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/kubeconfig", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary
+func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/metrics-summary", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist
+func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/nodes/allowlist", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistRequest calls the generic PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist builder with application/json body
+func NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistRequestWithBody(server, controlPlaneName, clusterName, "application/json", bodyReader)
+}
+
+// NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistRequestWithBody generates requests for PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist with any type of body
+func NewPutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/nodes/allowlist", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeRequest generates requests for PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/resume", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateRequest calls the generic PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate builder with application/json body
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateRequestWithBody(server, controlPlaneName, clusterName, "application/json", bodyReader)
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateRequestWithBody generates requests for PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate with any type of body
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/ssh/certificate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings
+func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/timings", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeRequest calls the generic PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade builder with application/json body
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeRequestWithBody(server, controlPlaneName, clusterName, "application/json", bodyReader)
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeRequestWithBody generates requests for PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade with any type of body
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/upgrade", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeRequest generates requests for DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze
+func NewDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/upgrades/freeze", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeRequest calls the generic PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze builder with application/json body
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeRequestWithBody(server, controlPlaneName, clusterName, "application/json", bodyReader)
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeRequestWithBody generates requests for PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze with any type of body
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/upgrades/freeze", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveRequest generates requests for PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, upgradeID UpgradeIDParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "upgradeID", runtime.ParamLocationPath, upgradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/upgrades/%s/approve", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsRequest calls the generic PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools builder with application/json body
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsRequestWithBody(server, controlPlaneName, clusterName, "application/json", bodyReader)
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsRequestWithBody generates requests for PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools with any type of body
+func NewPostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/workloadpools", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapRequest generates requests for GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap
+func NewGetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapRequest(server string, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, workloadPoolName WorkloadPoolNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "workloadPoolName", runtime.ParamLocationPath, workloadPoolName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/clusters/%s/workloadpools/%s/bootstrap", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameMoveRequest calls the generic PostApiV1ControlplanesControlPlaneNameMove builder with application/json body
+func NewPostApiV1ControlplanesControlPlaneNameMoveRequest(server string, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameMoveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ControlplanesControlPlaneNameMoveRequestWithBody(server, controlPlaneName, "application/json", bodyReader)
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameMoveRequestWithBody generates requests for PostApiV1ControlplanesControlPlaneNameMove with any type of body
+func NewPostApiV1ControlplanesControlPlaneNameMoveRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/move", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameResizeRequest calls the generic PostApiV1ControlplanesControlPlaneNameResize builder with application/json body
+func NewPostApiV1ControlplanesControlPlaneNameResizeRequest(server string, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameResizeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ControlplanesControlPlaneNameResizeRequestWithBody(server, controlPlaneName, "application/json", bodyReader)
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameResizeRequestWithBody generates requests for PostApiV1ControlplanesControlPlaneNameResize with any type of body
+func NewPostApiV1ControlplanesControlPlaneNameResizeRequestWithBody(server string, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/resize", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveRequest generates requests for PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove
+func NewPostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveRequest(server string, controlPlaneName ControlPlaneNameParameter, upgradeID UpgradeIDParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "upgradeID", runtime.ParamLocationPath, upgradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/controlplanes/%s/upgrades/%s/approve", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1EnvironmentsRequest calls the generic PostApiV1Environments builder with application/json body
+func NewPostApiV1EnvironmentsRequest(server string, body PostApiV1EnvironmentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1EnvironmentsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1EnvironmentsRequestWithBody generates requests for PostApiV1Environments with any type of body
+func NewPostApiV1EnvironmentsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/environments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1EnvironmentsEnvironmentNameRequest generates requests for DeleteApiV1EnvironmentsEnvironmentName
+func NewDeleteApiV1EnvironmentsEnvironmentNameRequest(server string, environmentName EnvironmentNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environmentName", runtime.ParamLocationPath, environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/environments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1EnvironmentsEnvironmentNameRequest generates requests for GetApiV1EnvironmentsEnvironmentName
+func NewGetApiV1EnvironmentsEnvironmentNameRequest(server string, environmentName EnvironmentNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "environmentName", runtime.ParamLocationPath, environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/environments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ExportRequest generates requests for GetApiV1Export
+func NewGetApiV1ExportRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ImportRequest calls the generic PostApiV1Import builder with application/json body
+func NewPostApiV1ImportRequest(server string, body PostApiV1ImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ImportRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1ImportRequestWithBody generates requests for PostApiV1Import with any type of body
+func NewPostApiV1ImportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1KubernetesversionsRequest generates requests for GetApiV1Kubernetesversions
+func NewGetApiV1KubernetesversionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/kubernetesversions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteApiV1ProjectRequest generates requests for DeleteApiV1Project
+func NewDeleteApiV1ProjectRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ProjectRequest generates requests for PostApiV1Project
+func NewPostApiV1ProjectRequest(server string, params *PostApiV1ProjectParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProjectMembersRequest generates requests for GetApiV1ProjectMembers
+func NewGetApiV1ProjectMembersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ProjectMembersRequest calls the generic PostApiV1ProjectMembers builder with application/json body
+func NewPostApiV1ProjectMembersRequest(server string, body PostApiV1ProjectMembersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ProjectMembersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1ProjectMembersRequestWithBody generates requests for PostApiV1ProjectMembers with any type of body
+func NewPostApiV1ProjectMembersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/members")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1ProjectMembersUserIDRequest generates requests for DeleteApiV1ProjectMembersUserID
+func NewDeleteApiV1ProjectMembersUserIDRequest(server string, userID UserIDParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/members/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiV1ProjectMembersUserIDRequest calls the generic PutApiV1ProjectMembersUserID builder with application/json body
+func NewPutApiV1ProjectMembersUserIDRequest(server string, userID UserIDParameter, body PutApiV1ProjectMembersUserIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1ProjectMembersUserIDRequestWithBody(server, userID, "application/json", bodyReader)
+}
+
+// NewPutApiV1ProjectMembersUserIDRequestWithBody generates requests for PutApiV1ProjectMembersUserID with any type of body
+func NewPutApiV1ProjectMembersUserIDRequestWithBody(server string, userID UserIDParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/members/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1ProjectOffboardingRequest generates requests for GetApiV1ProjectOffboarding
+func NewGetApiV1ProjectOffboardingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/offboarding")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ProjectOffboardingRequest generates requests for PostApiV1ProjectOffboarding
+func NewPostApiV1ProjectOffboardingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/offboarding")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ProjectOffboardingConfirmRequest calls the generic PostApiV1ProjectOffboardingConfirm builder with application/json body
+func NewPostApiV1ProjectOffboardingConfirmRequest(server string, body PostApiV1ProjectOffboardingConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ProjectOffboardingConfirmRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1ProjectOffboardingConfirmRequestWithBody generates requests for PostApiV1ProjectOffboardingConfirm with any type of body
+func NewPostApiV1ProjectOffboardingConfirmRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/offboarding/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1ProjectRolebindingsRequest generates requests for GetApiV1ProjectRolebindings
+func NewGetApiV1ProjectRolebindingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/rolebindings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiV1ProjectRolebindingsRequest calls the generic PostApiV1ProjectRolebindings builder with application/json body
+func NewPostApiV1ProjectRolebindingsRequest(server string, body PostApiV1ProjectRolebindingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiV1ProjectRolebindingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiV1ProjectRolebindingsRequestWithBody generates requests for PostApiV1ProjectRolebindings with any type of body
+func NewPostApiV1ProjectRolebindingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/rolebindings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiV1ProjectRolebindingsRoleBindingNameRequest generates requests for DeleteApiV1ProjectRolebindingsRoleBindingName
+func NewDeleteApiV1ProjectRolebindingsRoleBindingNameRequest(server string, roleBindingName RoleBindingNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roleBindingName", runtime.ParamLocationPath, roleBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/rolebindings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProjectRolebindingsRoleBindingNameRequest generates requests for GetApiV1ProjectRolebindingsRoleBindingName
+func NewGetApiV1ProjectRolebindingsRoleBindingNameRequest(server string, roleBindingName RoleBindingNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roleBindingName", runtime.ParamLocationPath, roleBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/rolebindings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiV1ProjectRolebindingsRoleBindingNameRequest calls the generic PutApiV1ProjectRolebindingsRoleBindingName builder with application/json body
+func NewPutApiV1ProjectRolebindingsRoleBindingNameRequest(server string, roleBindingName RoleBindingNameParameter, body PutApiV1ProjectRolebindingsRoleBindingNameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiV1ProjectRolebindingsRoleBindingNameRequestWithBody(server, roleBindingName, "application/json", bodyReader)
+}
+
+// NewPutApiV1ProjectRolebindingsRoleBindingNameRequestWithBody generates requests for PutApiV1ProjectRolebindingsRoleBindingName with any type of body
+func NewPutApiV1ProjectRolebindingsRoleBindingNameRequestWithBody(server string, roleBindingName RoleBindingNameParameter, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roleBindingName", runtime.ParamLocationPath, roleBindingName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/project/rolebindings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersRequest generates requests for GetApiV1Providers
+func NewGetApiV1ProvidersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackApplicationCredentialRolesRequest generates requests for GetApiV1ProvidersOpenstackApplicationCredentialRoles
+func NewGetApiV1ProvidersOpenstackApplicationCredentialRolesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/application-credential-roles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageRequest generates requests for GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage
+func NewGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/availability-zones/block-storage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackAvailabilityZonesComputeRequest generates requests for GetApiV1ProvidersOpenstackAvailabilityZonesCompute
+func NewGetApiV1ProvidersOpenstackAvailabilityZonesComputeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/availability-zones/compute")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackExternalNetworksRequest generates requests for GetApiV1ProvidersOpenstackExternalNetworks
+func NewGetApiV1ProvidersOpenstackExternalNetworksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/external-networks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackFlavorsRequest generates requests for GetApiV1ProvidersOpenstackFlavors
+func NewGetApiV1ProvidersOpenstackFlavorsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/flavors")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesRequest generates requests for GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages
+func NewGetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesRequest(server string, flavorName FlavorNameParameter) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "flavorName", runtime.ParamLocationPath, flavorName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/flavors/%s/compatible-images", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackHealthRequest generates requests for GetApiV1ProvidersOpenstackHealth
+func NewGetApiV1ProvidersOpenstackHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackImagesRequest generates requests for GetApiV1ProvidersOpenstackImages
+func NewGetApiV1ProvidersOpenstackImagesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/images")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackKeyPairsRequest generates requests for GetApiV1ProvidersOpenstackKeyPairs
+func NewGetApiV1ProvidersOpenstackKeyPairsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/key-pairs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackProjectsRequest generates requests for GetApiV1ProvidersOpenstackProjects
+func NewGetApiV1ProvidersOpenstackProjectsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiV1ProvidersOpenstackQuotasRequest generates requests for GetApiV1ProvidersOpenstackQuotas
+func NewGetApiV1ProvidersOpenstackQuotasRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/providers/openstack/quotas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetApiV1Activity request
+	GetApiV1ActivityWithResponse(ctx context.Context, params *GetApiV1ActivityParams, reqEditors ...RequestEditorFn) (*GetApiV1ActivityResponse, error)
+
+	// GetApiV1AdminDebugCapturesCaptureID request
+	GetApiV1AdminDebugCapturesCaptureIDWithResponse(ctx context.Context, captureID CaptureIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1AdminDebugCapturesCaptureIDResponse, error)
+
+	// GetApiV1AdminDeprecations request
+	GetApiV1AdminDeprecationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminDeprecationsResponse, error)
+
+	// GetApiV1AdminExport request
+	GetApiV1AdminExportWithResponse(ctx context.Context, params *GetApiV1AdminExportParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminExportResponse, error)
+
+	// GetApiV1AdminMonitorShards request
+	GetApiV1AdminMonitorShardsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminMonitorShardsResponse, error)
+
+	// PutApiV1AdminMonitorShards request with any body
+	PutApiV1AdminMonitorShardsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1AdminMonitorShardsResponse, error)
+
+	PutApiV1AdminMonitorShardsWithResponse(ctx context.Context, body PutApiV1AdminMonitorShardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1AdminMonitorShardsResponse, error)
+
+	// GetApiV1ApplicationbundlesCluster request
+	GetApiV1ApplicationbundlesClusterWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationbundlesClusterResponse, error)
+
+	// GetApiV1ApplicationbundlesControlPlane request
+	GetApiV1ApplicationbundlesControlPlaneWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationbundlesControlPlaneResponse, error)
+
+	// GetApiV1Applications request
+	GetApiV1ApplicationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationsResponse, error)
+
+	// GetApiV1AuthJwks request
+	GetApiV1AuthJwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthJwksResponse, error)
+
+	// GetApiV1AuthOauth2Authorization request
+	GetApiV1AuthOauth2AuthorizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthOauth2AuthorizationResponse, error)
+
+	// PostApiV1AuthOauth2Tokens request with any body
+	PostApiV1AuthOauth2TokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthOauth2TokensResponse, error)
+
+	PostApiV1AuthOauth2TokensWithFormdataBodyWithResponse(ctx context.Context, body PostApiV1AuthOauth2TokensFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthOauth2TokensResponse, error)
+
+	// GetApiV1AuthOidcCallback request
+	GetApiV1AuthOidcCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthOidcCallbackResponse, error)
+
+	// PostApiV1AuthTokensToken request with any body
+	PostApiV1AuthTokensTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthTokensTokenResponse, error)
+
+	PostApiV1AuthTokensTokenWithResponse(ctx context.Context, body PostApiV1AuthTokensTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthTokensTokenResponse, error)
+
+	// GetApiV1Changelog request
+	GetApiV1ChangelogWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ChangelogResponse, error)
+
+	// PostApiV1ChangelogChangelogVersionAcknowledge request
+	PostApiV1ChangelogChangelogVersionAcknowledgeWithResponse(ctx context.Context, changelogVersion ChangelogVersionParameter, reqEditors ...RequestEditorFn) (*PostApiV1ChangelogChangelogVersionAcknowledgeResponse, error)
+
+	// GetApiV1Clustertemplates request
+	GetApiV1ClustertemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ClustertemplatesResponse, error)
+
+	// GetApiV1Controlplanes request
+	GetApiV1ControlplanesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesResponse, error)
+
+	// PostApiV1Controlplanes request with any body
+	PostApiV1ControlplanesWithBodyWithResponse(ctx context.Context, params *PostApiV1ControlplanesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesResponse, error)
+
+	PostApiV1ControlplanesWithResponse(ctx context.Context, params *PostApiV1ControlplanesParams, body PostApiV1ControlplanesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesResponse, error)
+
+	// DeleteApiV1ControlplanesControlPlaneName request
+	DeleteApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ControlplanesControlPlaneNameResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneName request
+	GetApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameResponse, error)
+
+	// PutApiV1ControlplanesControlPlaneName request with any body
+	PutApiV1ControlplanesControlPlaneNameWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameResponse, error)
+
+	PutApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PutApiV1ControlplanesControlPlaneNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClusters request
+	GetApiV1ControlplanesControlPlaneNameClustersWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClusters request with any body
+	PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, params *PostApiV1ControlplanesControlPlaneNameClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersResponse, error)
+
+	PostApiV1ControlplanesControlPlaneNameClustersWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, params *PostApiV1ControlplanesControlPlaneNameClustersParams, body PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersResponse, error)
+
+	// DeleteApiV1ControlplanesControlPlaneNameClustersClusterName request
+	DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterName request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, params *GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error)
+
+	// PutApiV1ControlplanesControlPlaneNameClustersClusterName request with any body
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error)
+
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse, error)
+
+	// PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate request with any body
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse, error)
+
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate request
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate request
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse, error)
+
+	// PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist request with any body
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse, error)
+
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume request
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate request with any body
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse, error)
+
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade request with any body
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse, error)
+
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse, error)
+
+	// DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze request
+	DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze request with any body
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse, error)
+
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove request
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, upgradeID UpgradeIDParameter, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools request with any body
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse, error)
+
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse, error)
+
+	// GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap request
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, workloadPoolName WorkloadPoolNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameMove request with any body
+	PostApiV1ControlplanesControlPlaneNameMoveWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameMoveResponse, error)
+
+	PostApiV1ControlplanesControlPlaneNameMoveWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameMoveResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameResize request with any body
+	PostApiV1ControlplanesControlPlaneNameResizeWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameResizeResponse, error)
+
+	PostApiV1ControlplanesControlPlaneNameResizeWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameResizeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameResizeResponse, error)
+
+	// PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove request
+	PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, upgradeID UpgradeIDParameter, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse, error)
+
+	// PostApiV1Environments request with any body
+	PostApiV1EnvironmentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1EnvironmentsResponse, error)
+
+	PostApiV1EnvironmentsWithResponse(ctx context.Context, body PostApiV1EnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1EnvironmentsResponse, error)
+
+	// DeleteApiV1EnvironmentsEnvironmentName request
+	DeleteApiV1EnvironmentsEnvironmentNameWithResponse(ctx context.Context, environmentName EnvironmentNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1EnvironmentsEnvironmentNameResponse, error)
+
+	// GetApiV1EnvironmentsEnvironmentName request
+	GetApiV1EnvironmentsEnvironmentNameWithResponse(ctx context.Context, environmentName EnvironmentNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1EnvironmentsEnvironmentNameResponse, error)
+
+	// GetApiV1Export request
+	GetApiV1ExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ExportResponse, error)
+
+	// PostApiV1Import request with any body
+	PostApiV1ImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ImportResponse, error)
+
+	PostApiV1ImportWithResponse(ctx context.Context, body PostApiV1ImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ImportResponse, error)
+
+	// GetApiV1Kubernetesversions request
+	GetApiV1KubernetesversionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1KubernetesversionsResponse, error)
+
+	// DeleteApiV1Project request
+	DeleteApiV1ProjectWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiV1ProjectResponse, error)
+
+	// PostApiV1Project request
+	PostApiV1ProjectWithResponse(ctx context.Context, params *PostApiV1ProjectParams, reqEditors ...RequestEditorFn) (*PostApiV1ProjectResponse, error)
+
+	// GetApiV1ProjectMembers request
+	GetApiV1ProjectMembersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProjectMembersResponse, error)
+
+	// PostApiV1ProjectMembers request with any body
+	PostApiV1ProjectMembersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ProjectMembersResponse, error)
+
+	PostApiV1ProjectMembersWithResponse(ctx context.Context, body PostApiV1ProjectMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ProjectMembersResponse, error)
+
+	// DeleteApiV1ProjectMembersUserID request
+	DeleteApiV1ProjectMembersUserIDWithResponse(ctx context.Context, userID UserIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ProjectMembersUserIDResponse, error)
+
+	// PutApiV1ProjectMembersUserID request with any body
+	PutApiV1ProjectMembersUserIDWithBodyWithResponse(ctx context.Context, userID UserIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ProjectMembersUserIDResponse, error)
+
+	PutApiV1ProjectMembersUserIDWithResponse(ctx context.Context, userID UserIDParameter, body PutApiV1ProjectMembersUserIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ProjectMembersUserIDResponse, error)
+
+	// GetApiV1ProjectOffboarding request
+	GetApiV1ProjectOffboardingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProjectOffboardingResponse, error)
+
+	// PostApiV1ProjectOffboarding request
+	PostApiV1ProjectOffboardingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiV1ProjectOffboardingResponse, error)
+
+	// PostApiV1ProjectOffboardingConfirm request with any body
+	PostApiV1ProjectOffboardingConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ProjectOffboardingConfirmResponse, error)
+
+	PostApiV1ProjectOffboardingConfirmWithResponse(ctx context.Context, body PostApiV1ProjectOffboardingConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ProjectOffboardingConfirmResponse, error)
+
+	// GetApiV1ProjectRolebindings request
+	GetApiV1ProjectRolebindingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProjectRolebindingsResponse, error)
+
+	// PostApiV1ProjectRolebindings request with any body
+	PostApiV1ProjectRolebindingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ProjectRolebindingsResponse, error)
+
+	PostApiV1ProjectRolebindingsWithResponse(ctx context.Context, body PostApiV1ProjectRolebindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ProjectRolebindingsResponse, error)
+
+	// DeleteApiV1ProjectRolebindingsRoleBindingName request
+	DeleteApiV1ProjectRolebindingsRoleBindingNameWithResponse(ctx context.Context, roleBindingName RoleBindingNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ProjectRolebindingsRoleBindingNameResponse, error)
+
+	// GetApiV1ProjectRolebindingsRoleBindingName request
+	GetApiV1ProjectRolebindingsRoleBindingNameWithResponse(ctx context.Context, roleBindingName RoleBindingNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ProjectRolebindingsRoleBindingNameResponse, error)
+
+	// PutApiV1ProjectRolebindingsRoleBindingName request with any body
+	PutApiV1ProjectRolebindingsRoleBindingNameWithBodyWithResponse(ctx context.Context, roleBindingName RoleBindingNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ProjectRolebindingsRoleBindingNameResponse, error)
+
+	PutApiV1ProjectRolebindingsRoleBindingNameWithResponse(ctx context.Context, roleBindingName RoleBindingNameParameter, body PutApiV1ProjectRolebindingsRoleBindingNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ProjectRolebindingsRoleBindingNameResponse, error)
+
+	// GetApiV1Providers request
+	GetApiV1ProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersResponse, error)
+
+	// GetApiV1ProvidersOpenstackApplicationCredentialRoles request
+	GetApiV1ProvidersOpenstackApplicationCredentialRolesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackApplicationCredentialRolesResponse, error)
+
+	// GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage request
+	GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse, error)
+
+	// GetApiV1ProvidersOpenstackAvailabilityZonesCompute request
+	GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse, error)
+
+	// GetApiV1ProvidersOpenstackExternalNetworks request
+	GetApiV1ProvidersOpenstackExternalNetworksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackExternalNetworksResponse, error)
+
+	// GetApiV1ProvidersOpenstackFlavors request
+	GetApiV1ProvidersOpenstackFlavorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackFlavorsResponse, error)
+
+	// GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages request
+	GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesWithResponse(ctx context.Context, flavorName FlavorNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse, error)
+
+	// GetApiV1ProvidersOpenstackHealth request
+	GetApiV1ProvidersOpenstackHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackHealthResponse, error)
+
+	// GetApiV1ProvidersOpenstackImages request
+	GetApiV1ProvidersOpenstackImagesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackImagesResponse, error)
+
+	// GetApiV1ProvidersOpenstackKeyPairs request
+	GetApiV1ProvidersOpenstackKeyPairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackKeyPairsResponse, error)
+
+	// GetApiV1ProvidersOpenstackProjects request
+	GetApiV1ProvidersOpenstackProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackProjectsResponse, error)
+
+	// GetApiV1ProvidersOpenstackQuotas request
+	GetApiV1ProvidersOpenstackQuotasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackQuotasResponse, error)
+}
+
+type GetApiV1ActivityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ActivityFeed
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminDebugCapturesCaptureIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DebugCapture
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminDebugCapturesCaptureIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminDebugCapturesCaptureIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminDeprecationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeprecatedUsages
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminDeprecationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminDeprecationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AdminMonitorShardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MonitorShards
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AdminMonitorShardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AdminMonitorShardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1AdminMonitorShardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1AdminMonitorShardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1AdminMonitorShardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ApplicationbundlesClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApplicationBundles
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ApplicationbundlesClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ApplicationbundlesClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ApplicationbundlesControlPlaneResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApplicationBundles
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ApplicationbundlesControlPlaneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ApplicationbundlesControlPlaneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ApplicationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Applications
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ApplicationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ApplicationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AuthJwksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JsonWebKeySet
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AuthJwksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AuthJwksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AuthOauth2AuthorizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AuthOauth2AuthorizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AuthOauth2AuthorizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthOauth2TokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Token
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthOauth2TokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthOauth2TokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1AuthOidcCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1AuthOidcCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1AuthOidcCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1AuthTokensTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Token
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1AuthTokensTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1AuthTokensTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ChangelogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PlatformChangelog
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ChangelogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ChangelogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ChangelogChangelogVersionAcknowledgeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ChangelogChangelogVersionAcknowledgeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ChangelogChangelogVersionAcknowledgeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ClustertemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterTemplates
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ClustertemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ClustertemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ControlPlanes
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON422      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1ControlplanesControlPlaneNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1ControlplanesControlPlaneNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1ControlplanesControlPlaneNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ControlPlane
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1ControlplanesControlPlaneNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1ControlplanesControlPlaneNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1ControlplanesControlPlaneNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusters
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterCost
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON422      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesCluster
+	YAML200      *map[string]interface{}
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterCertificate
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterCost
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON422      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterHealth
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterMetricsSummary
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NodeAllowList
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SshCertificate
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterStageTimings
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterWorkloadPoolOperationResults
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON422      *KubernetesClusterWorkloadPoolOperationResults
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesClusterWorkloadPoolBootstrap
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameMoveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameMoveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameMoveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameResizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameResizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameResizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1EnvironmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *EnvironmentStatus
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON422      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1EnvironmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1EnvironmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1EnvironmentsEnvironmentNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1EnvironmentsEnvironmentNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1EnvironmentsEnvironmentNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1EnvironmentsEnvironmentNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnvironmentStatus
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1EnvironmentsEnvironmentNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1EnvironmentsEnvironmentNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExportBundle
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportResults
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON422      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1KubernetesversionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KubernetesVersions
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1KubernetesversionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1KubernetesversionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1ProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1ProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1ProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON422      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProjectMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectMembers
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProjectMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProjectMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ProjectMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ProjectMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ProjectMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1ProjectMembersUserIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1ProjectMembersUserIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1ProjectMembersUserIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1ProjectMembersUserIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1ProjectMembersUserIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1ProjectMembersUserIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProjectOffboardingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectOffboarding
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProjectOffboardingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProjectOffboardingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ProjectOffboardingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ProjectOffboardingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ProjectOffboardingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ProjectOffboardingConfirmResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectOffboarding
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ProjectOffboardingConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ProjectOffboardingConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProjectRolebindingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectRoleBindings
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProjectRolebindingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProjectRolebindingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiV1ProjectRolebindingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON409      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiV1ProjectRolebindingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiV1ProjectRolebindingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiV1ProjectRolebindingsRoleBindingNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiV1ProjectRolebindingsRoleBindingNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiV1ProjectRolebindingsRoleBindingNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProjectRolebindingsRoleBindingNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectRoleBinding
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProjectRolebindingsRoleBindingNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProjectRolebindingsRoleBindingNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiV1ProjectRolebindingsRoleBindingNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON403      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiV1ProjectRolebindingsRoleBindingNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiV1ProjectRolebindingsRoleBindingNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Provider
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackApplicationCredentialRolesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackApplicationCredentialRoles
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackApplicationCredentialRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackApplicationCredentialRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackAvailabilityZones
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+	JSON503      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackAvailabilityZones
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+	JSON503      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackExternalNetworksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackExternalNetworks
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackExternalNetworksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackExternalNetworksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackFlavorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackFlavors
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+	JSON503      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackFlavorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackFlavorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackImages
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON404      *Oauth2Error
+	JSON500      *Oauth2Error
+	JSON503      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackHealth
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackImagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackImages
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+	JSON503      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackImagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackImagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackKeyPairsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackKeyPairs
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackKeyPairsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackKeyPairsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackProjects
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1ProvidersOpenstackQuotasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenstackQuotas
+	JSON400      *Oauth2Error
+	JSON401      *Oauth2Error
+	JSON500      *Oauth2Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1ProvidersOpenstackQuotasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1ProvidersOpenstackQuotasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetApiV1ActivityWithResponse request returning *GetApiV1ActivityResponse
+func (c *ClientWithResponses) GetApiV1ActivityWithResponse(ctx context.Context, params *GetApiV1ActivityParams, reqEditors ...RequestEditorFn) (*GetApiV1ActivityResponse, error) {
+	rsp, err := c.GetApiV1Activity(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ActivityResponse(rsp)
+}
+
+// GetApiV1AdminDebugCapturesCaptureIDWithResponse request returning *GetApiV1AdminDebugCapturesCaptureIDResponse
+func (c *ClientWithResponses) GetApiV1AdminDebugCapturesCaptureIDWithResponse(ctx context.Context, captureID CaptureIDParameter, reqEditors ...RequestEditorFn) (*GetApiV1AdminDebugCapturesCaptureIDResponse, error) {
+	rsp, err := c.GetApiV1AdminDebugCapturesCaptureID(ctx, captureID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminDebugCapturesCaptureIDResponse(rsp)
+}
+
+// GetApiV1AdminDeprecationsWithResponse request returning *GetApiV1AdminDeprecationsResponse
+func (c *ClientWithResponses) GetApiV1AdminDeprecationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminDeprecationsResponse, error) {
+	rsp, err := c.GetApiV1AdminDeprecations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminDeprecationsResponse(rsp)
+}
+
+// GetApiV1AdminExportWithResponse request returning *GetApiV1AdminExportResponse
+func (c *ClientWithResponses) GetApiV1AdminExportWithResponse(ctx context.Context, params *GetApiV1AdminExportParams, reqEditors ...RequestEditorFn) (*GetApiV1AdminExportResponse, error) {
+	rsp, err := c.GetApiV1AdminExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminExportResponse(rsp)
+}
+
+// GetApiV1AdminMonitorShardsWithResponse request returning *GetApiV1AdminMonitorShardsResponse
+func (c *ClientWithResponses) GetApiV1AdminMonitorShardsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AdminMonitorShardsResponse, error) {
+	rsp, err := c.GetApiV1AdminMonitorShards(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AdminMonitorShardsResponse(rsp)
+}
+
+// PutApiV1AdminMonitorShardsWithBodyWithResponse request with arbitrary body returning *PutApiV1AdminMonitorShardsResponse
+func (c *ClientWithResponses) PutApiV1AdminMonitorShardsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1AdminMonitorShardsResponse, error) {
+	rsp, err := c.PutApiV1AdminMonitorShardsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1AdminMonitorShardsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1AdminMonitorShardsWithResponse(ctx context.Context, body PutApiV1AdminMonitorShardsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1AdminMonitorShardsResponse, error) {
+	rsp, err := c.PutApiV1AdminMonitorShards(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1AdminMonitorShardsResponse(rsp)
+}
+
+// GetApiV1ApplicationbundlesClusterWithResponse request returning *GetApiV1ApplicationbundlesClusterResponse
+func (c *ClientWithResponses) GetApiV1ApplicationbundlesClusterWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationbundlesClusterResponse, error) {
+	rsp, err := c.GetApiV1ApplicationbundlesCluster(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ApplicationbundlesClusterResponse(rsp)
+}
+
+// GetApiV1ApplicationbundlesControlPlaneWithResponse request returning *GetApiV1ApplicationbundlesControlPlaneResponse
+func (c *ClientWithResponses) GetApiV1ApplicationbundlesControlPlaneWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationbundlesControlPlaneResponse, error) {
+	rsp, err := c.GetApiV1ApplicationbundlesControlPlane(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ApplicationbundlesControlPlaneResponse(rsp)
+}
+
+// GetApiV1ApplicationsWithResponse request returning *GetApiV1ApplicationsResponse
+func (c *ClientWithResponses) GetApiV1ApplicationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ApplicationsResponse, error) {
+	rsp, err := c.GetApiV1Applications(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ApplicationsResponse(rsp)
+}
+
+// GetApiV1AuthJwksWithResponse request returning *GetApiV1AuthJwksResponse
+func (c *ClientWithResponses) GetApiV1AuthJwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthJwksResponse, error) {
+	rsp, err := c.GetApiV1AuthJwks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AuthJwksResponse(rsp)
+}
+
+// GetApiV1AuthOauth2AuthorizationWithResponse request returning *GetApiV1AuthOauth2AuthorizationResponse
+func (c *ClientWithResponses) GetApiV1AuthOauth2AuthorizationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthOauth2AuthorizationResponse, error) {
+	rsp, err := c.GetApiV1AuthOauth2Authorization(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AuthOauth2AuthorizationResponse(rsp)
+}
+
+// PostApiV1AuthOauth2TokensWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthOauth2TokensResponse
+func (c *ClientWithResponses) PostApiV1AuthOauth2TokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthOauth2TokensResponse, error) {
+	rsp, err := c.PostApiV1AuthOauth2TokensWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthOauth2TokensResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthOauth2TokensWithFormdataBodyWithResponse(ctx context.Context, body PostApiV1AuthOauth2TokensFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthOauth2TokensResponse, error) {
+	rsp, err := c.PostApiV1AuthOauth2TokensWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthOauth2TokensResponse(rsp)
+}
+
+// GetApiV1AuthOidcCallbackWithResponse request returning *GetApiV1AuthOidcCallbackResponse
+func (c *ClientWithResponses) GetApiV1AuthOidcCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1AuthOidcCallbackResponse, error) {
+	rsp, err := c.GetApiV1AuthOidcCallback(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1AuthOidcCallbackResponse(rsp)
+}
+
+// PostApiV1AuthTokensTokenWithBodyWithResponse request with arbitrary body returning *PostApiV1AuthTokensTokenResponse
+func (c *ClientWithResponses) PostApiV1AuthTokensTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1AuthTokensTokenResponse, error) {
+	rsp, err := c.PostApiV1AuthTokensTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthTokensTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1AuthTokensTokenWithResponse(ctx context.Context, body PostApiV1AuthTokensTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1AuthTokensTokenResponse, error) {
+	rsp, err := c.PostApiV1AuthTokensToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1AuthTokensTokenResponse(rsp)
+}
+
+// GetApiV1ChangelogWithResponse request returning *GetApiV1ChangelogResponse
+func (c *ClientWithResponses) GetApiV1ChangelogWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ChangelogResponse, error) {
+	rsp, err := c.GetApiV1Changelog(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ChangelogResponse(rsp)
+}
+
+// PostApiV1ChangelogChangelogVersionAcknowledgeWithResponse request returning *PostApiV1ChangelogChangelogVersionAcknowledgeResponse
+func (c *ClientWithResponses) PostApiV1ChangelogChangelogVersionAcknowledgeWithResponse(ctx context.Context, changelogVersion ChangelogVersionParameter, reqEditors ...RequestEditorFn) (*PostApiV1ChangelogChangelogVersionAcknowledgeResponse, error) {
+	rsp, err := c.PostApiV1ChangelogChangelogVersionAcknowledge(ctx, changelogVersion, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ChangelogChangelogVersionAcknowledgeResponse(rsp)
+}
+
+// GetApiV1ClustertemplatesWithResponse request returning *GetApiV1ClustertemplatesResponse
+func (c *ClientWithResponses) GetApiV1ClustertemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ClustertemplatesResponse, error) {
+	rsp, err := c.GetApiV1Clustertemplates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ClustertemplatesResponse(rsp)
+}
+
+// GetApiV1ControlplanesWithResponse request returning *GetApiV1ControlplanesResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesResponse, error) {
+	rsp, err := c.GetApiV1Controlplanes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesResponse(rsp)
+}
+
+// PostApiV1ControlplanesWithBodyWithResponse request with arbitrary body returning *PostApiV1ControlplanesResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesWithBodyWithResponse(ctx context.Context, params *PostApiV1ControlplanesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ControlplanesWithResponse(ctx context.Context, params *PostApiV1ControlplanesParams, body PostApiV1ControlplanesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesResponse, error) {
+	rsp, err := c.PostApiV1Controlplanes(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesResponse(rsp)
+}
+
+// DeleteApiV1ControlplanesControlPlaneNameWithResponse request returning *DeleteApiV1ControlplanesControlPlaneNameResponse
+func (c *ClientWithResponses) DeleteApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ControlplanesControlPlaneNameResponse, error) {
+	rsp, err := c.DeleteApiV1ControlplanesControlPlaneName(ctx, controlPlaneName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1ControlplanesControlPlaneNameResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameWithResponse request returning *GetApiV1ControlplanesControlPlaneNameResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneName(ctx, controlPlaneName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameResponse(rsp)
+}
+
+// PutApiV1ControlplanesControlPlaneNameWithBodyWithResponse request with arbitrary body returning *PutApiV1ControlplanesControlPlaneNameResponse
+func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameResponse, error) {
+	rsp, err := c.PutApiV1ControlplanesControlPlaneNameWithBody(ctx, controlPlaneName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ControlplanesControlPlaneNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PutApiV1ControlplanesControlPlaneNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameResponse, error) {
+	rsp, err := c.PutApiV1ControlplanesControlPlaneName(ctx, controlPlaneName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ControlplanesControlPlaneNameResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClusters(ctx, controlPlaneName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameClustersResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse request with arbitrary body returning *PostApiV1ControlplanesControlPlaneNameClustersResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, params *PostApiV1ControlplanesControlPlaneNameClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersWithBody(ctx, controlPlaneName, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, params *PostApiV1ControlplanesControlPlaneNameClustersParams, body PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClusters(ctx, controlPlaneName, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersResponse(rsp)
+}
+
+// DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse request returning *DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse
+func (c *ClientWithResponses) DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
+	rsp, err := c.DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, params *GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterName(ctx, controlPlaneName, clusterName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp)
+}
+
+// PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBodyWithResponse request with arbitrary body returning *PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse
+func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
+	rsp, err := c.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBody(ctx, controlPlaneName, clusterName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
+	rsp, err := c.PutApiV1ControlplanesControlPlaneNameClustersClusterName(ctx, controlPlaneName, clusterName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse(rsp)
+}
+
+// PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithBodyWithResponse request with arbitrary body returning *PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse
+func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse, error) {
+	rsp, err := c.PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithBody(ctx, controlPlaneName, clusterName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse, error) {
+	rsp, err := c.PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(ctx, controlPlaneName, clusterName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateWithResponse request returning *PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateWithResponse request returning *PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse(rsp)
+}
+
+// PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithBodyWithResponse request with arbitrary body returning *PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse
+func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse, error) {
+	rsp, err := c.PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithBody(ctx, controlPlaneName, clusterName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse, error) {
+	rsp, err := c.PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(ctx, controlPlaneName, clusterName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeWithResponse request returning *PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithBodyWithResponse request with arbitrary body returning *PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithBody(ctx, controlPlaneName, clusterName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate(ctx, controlPlaneName, clusterName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithBodyWithResponse request with arbitrary body returning *PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithBody(ctx, controlPlaneName, clusterName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade(ctx, controlPlaneName, clusterName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse(rsp)
+}
+
+// DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithResponse request returning *DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse
+func (c *ClientWithResponses) DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse, error) {
+	rsp, err := c.DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(ctx, controlPlaneName, clusterName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithBodyWithResponse request with arbitrary body returning *PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithBody(ctx, controlPlaneName, clusterName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(ctx, controlPlaneName, clusterName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveWithResponse request returning *PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, upgradeID UpgradeIDParameter, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove(ctx, controlPlaneName, clusterName, upgradeID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithBodyWithResponse request with arbitrary body returning *PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithBody(ctx, controlPlaneName, clusterName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, body PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools(ctx, controlPlaneName, clusterName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse(rsp)
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapWithResponse request returning *GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse
+func (c *ClientWithResponses) GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, workloadPoolName WorkloadPoolNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse, error) {
+	rsp, err := c.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap(ctx, controlPlaneName, clusterName, workloadPoolName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameMoveWithBodyWithResponse request with arbitrary body returning *PostApiV1ControlplanesControlPlaneNameMoveResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameMoveWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameMoveResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameMoveWithBody(ctx, controlPlaneName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameMoveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameMoveWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameMoveResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameMove(ctx, controlPlaneName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameMoveResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameResizeWithBodyWithResponse request with arbitrary body returning *PostApiV1ControlplanesControlPlaneNameResizeResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameResizeWithBodyWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameResizeResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameResizeWithBody(ctx, controlPlaneName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameResizeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameResizeWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, body PostApiV1ControlplanesControlPlaneNameResizeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameResizeResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameResize(ctx, controlPlaneName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameResizeResponse(rsp)
+}
+
+// PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveWithResponse request returning *PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse
+func (c *ClientWithResponses) PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveWithResponse(ctx context.Context, controlPlaneName ControlPlaneNameParameter, upgradeID UpgradeIDParameter, reqEditors ...RequestEditorFn) (*PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse, error) {
+	rsp, err := c.PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove(ctx, controlPlaneName, upgradeID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse(rsp)
+}
+
+// PostApiV1EnvironmentsWithBodyWithResponse request with arbitrary body returning *PostApiV1EnvironmentsResponse
+func (c *ClientWithResponses) PostApiV1EnvironmentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1EnvironmentsResponse, error) {
+	rsp, err := c.PostApiV1EnvironmentsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1EnvironmentsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1EnvironmentsWithResponse(ctx context.Context, body PostApiV1EnvironmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1EnvironmentsResponse, error) {
+	rsp, err := c.PostApiV1Environments(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1EnvironmentsResponse(rsp)
+}
+
+// DeleteApiV1EnvironmentsEnvironmentNameWithResponse request returning *DeleteApiV1EnvironmentsEnvironmentNameResponse
+func (c *ClientWithResponses) DeleteApiV1EnvironmentsEnvironmentNameWithResponse(ctx context.Context, environmentName EnvironmentNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1EnvironmentsEnvironmentNameResponse, error) {
+	rsp, err := c.DeleteApiV1EnvironmentsEnvironmentName(ctx, environmentName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1EnvironmentsEnvironmentNameResponse(rsp)
+}
+
+// GetApiV1EnvironmentsEnvironmentNameWithResponse request returning *GetApiV1EnvironmentsEnvironmentNameResponse
+func (c *ClientWithResponses) GetApiV1EnvironmentsEnvironmentNameWithResponse(ctx context.Context, environmentName EnvironmentNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1EnvironmentsEnvironmentNameResponse, error) {
+	rsp, err := c.GetApiV1EnvironmentsEnvironmentName(ctx, environmentName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1EnvironmentsEnvironmentNameResponse(rsp)
+}
+
+// GetApiV1ExportWithResponse request returning *GetApiV1ExportResponse
+func (c *ClientWithResponses) GetApiV1ExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ExportResponse, error) {
+	rsp, err := c.GetApiV1Export(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ExportResponse(rsp)
+}
+
+// PostApiV1ImportWithBodyWithResponse request with arbitrary body returning *PostApiV1ImportResponse
+func (c *ClientWithResponses) PostApiV1ImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ImportResponse, error) {
+	rsp, err := c.PostApiV1ImportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ImportWithResponse(ctx context.Context, body PostApiV1ImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ImportResponse, error) {
+	rsp, err := c.PostApiV1Import(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ImportResponse(rsp)
+}
+
+// GetApiV1KubernetesversionsWithResponse request returning *GetApiV1KubernetesversionsResponse
+func (c *ClientWithResponses) GetApiV1KubernetesversionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1KubernetesversionsResponse, error) {
+	rsp, err := c.GetApiV1Kubernetesversions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1KubernetesversionsResponse(rsp)
+}
+
+// DeleteApiV1ProjectWithResponse request returning *DeleteApiV1ProjectResponse
+func (c *ClientWithResponses) DeleteApiV1ProjectWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteApiV1ProjectResponse, error) {
+	rsp, err := c.DeleteApiV1Project(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1ProjectResponse(rsp)
+}
+
+// PostApiV1ProjectWithResponse request returning *PostApiV1ProjectResponse
+func (c *ClientWithResponses) PostApiV1ProjectWithResponse(ctx context.Context, params *PostApiV1ProjectParams, reqEditors ...RequestEditorFn) (*PostApiV1ProjectResponse, error) {
+	rsp, err := c.PostApiV1Project(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ProjectResponse(rsp)
+}
+
+// GetApiV1ProjectMembersWithResponse request returning *GetApiV1ProjectMembersResponse
+func (c *ClientWithResponses) GetApiV1ProjectMembersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProjectMembersResponse, error) {
+	rsp, err := c.GetApiV1ProjectMembers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProjectMembersResponse(rsp)
+}
+
+// PostApiV1ProjectMembersWithBodyWithResponse request with arbitrary body returning *PostApiV1ProjectMembersResponse
+func (c *ClientWithResponses) PostApiV1ProjectMembersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ProjectMembersResponse, error) {
+	rsp, err := c.PostApiV1ProjectMembersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ProjectMembersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ProjectMembersWithResponse(ctx context.Context, body PostApiV1ProjectMembersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ProjectMembersResponse, error) {
+	rsp, err := c.PostApiV1ProjectMembers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ProjectMembersResponse(rsp)
+}
+
+// DeleteApiV1ProjectMembersUserIDWithResponse request returning *DeleteApiV1ProjectMembersUserIDResponse
+func (c *ClientWithResponses) DeleteApiV1ProjectMembersUserIDWithResponse(ctx context.Context, userID UserIDParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ProjectMembersUserIDResponse, error) {
+	rsp, err := c.DeleteApiV1ProjectMembersUserID(ctx, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1ProjectMembersUserIDResponse(rsp)
+}
+
+// PutApiV1ProjectMembersUserIDWithBodyWithResponse request with arbitrary body returning *PutApiV1ProjectMembersUserIDResponse
+func (c *ClientWithResponses) PutApiV1ProjectMembersUserIDWithBodyWithResponse(ctx context.Context, userID UserIDParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ProjectMembersUserIDResponse, error) {
+	rsp, err := c.PutApiV1ProjectMembersUserIDWithBody(ctx, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ProjectMembersUserIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1ProjectMembersUserIDWithResponse(ctx context.Context, userID UserIDParameter, body PutApiV1ProjectMembersUserIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ProjectMembersUserIDResponse, error) {
+	rsp, err := c.PutApiV1ProjectMembersUserID(ctx, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ProjectMembersUserIDResponse(rsp)
+}
+
+// GetApiV1ProjectOffboardingWithResponse request returning *GetApiV1ProjectOffboardingResponse
+func (c *ClientWithResponses) GetApiV1ProjectOffboardingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProjectOffboardingResponse, error) {
+	rsp, err := c.GetApiV1ProjectOffboarding(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProjectOffboardingResponse(rsp)
+}
+
+// PostApiV1ProjectOffboardingWithResponse request returning *PostApiV1ProjectOffboardingResponse
+func (c *ClientWithResponses) PostApiV1ProjectOffboardingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostApiV1ProjectOffboardingResponse, error) {
+	rsp, err := c.PostApiV1ProjectOffboarding(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ProjectOffboardingResponse(rsp)
+}
+
+// PostApiV1ProjectOffboardingConfirmWithBodyWithResponse request with arbitrary body returning *PostApiV1ProjectOffboardingConfirmResponse
+func (c *ClientWithResponses) PostApiV1ProjectOffboardingConfirmWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ProjectOffboardingConfirmResponse, error) {
+	rsp, err := c.PostApiV1ProjectOffboardingConfirmWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ProjectOffboardingConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ProjectOffboardingConfirmWithResponse(ctx context.Context, body PostApiV1ProjectOffboardingConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ProjectOffboardingConfirmResponse, error) {
+	rsp, err := c.PostApiV1ProjectOffboardingConfirm(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ProjectOffboardingConfirmResponse(rsp)
+}
+
+// GetApiV1ProjectRolebindingsWithResponse request returning *GetApiV1ProjectRolebindingsResponse
+func (c *ClientWithResponses) GetApiV1ProjectRolebindingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProjectRolebindingsResponse, error) {
+	rsp, err := c.GetApiV1ProjectRolebindings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProjectRolebindingsResponse(rsp)
+}
+
+// PostApiV1ProjectRolebindingsWithBodyWithResponse request with arbitrary body returning *PostApiV1ProjectRolebindingsResponse
+func (c *ClientWithResponses) PostApiV1ProjectRolebindingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiV1ProjectRolebindingsResponse, error) {
+	rsp, err := c.PostApiV1ProjectRolebindingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ProjectRolebindingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiV1ProjectRolebindingsWithResponse(ctx context.Context, body PostApiV1ProjectRolebindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiV1ProjectRolebindingsResponse, error) {
+	rsp, err := c.PostApiV1ProjectRolebindings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiV1ProjectRolebindingsResponse(rsp)
+}
+
+// DeleteApiV1ProjectRolebindingsRoleBindingNameWithResponse request returning *DeleteApiV1ProjectRolebindingsRoleBindingNameResponse
+func (c *ClientWithResponses) DeleteApiV1ProjectRolebindingsRoleBindingNameWithResponse(ctx context.Context, roleBindingName RoleBindingNameParameter, reqEditors ...RequestEditorFn) (*DeleteApiV1ProjectRolebindingsRoleBindingNameResponse, error) {
+	rsp, err := c.DeleteApiV1ProjectRolebindingsRoleBindingName(ctx, roleBindingName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiV1ProjectRolebindingsRoleBindingNameResponse(rsp)
+}
+
+// GetApiV1ProjectRolebindingsRoleBindingNameWithResponse request returning *GetApiV1ProjectRolebindingsRoleBindingNameResponse
+func (c *ClientWithResponses) GetApiV1ProjectRolebindingsRoleBindingNameWithResponse(ctx context.Context, roleBindingName RoleBindingNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ProjectRolebindingsRoleBindingNameResponse, error) {
+	rsp, err := c.GetApiV1ProjectRolebindingsRoleBindingName(ctx, roleBindingName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProjectRolebindingsRoleBindingNameResponse(rsp)
+}
+
+// PutApiV1ProjectRolebindingsRoleBindingNameWithBodyWithResponse request with arbitrary body returning *PutApiV1ProjectRolebindingsRoleBindingNameResponse
+func (c *ClientWithResponses) PutApiV1ProjectRolebindingsRoleBindingNameWithBodyWithResponse(ctx context.Context, roleBindingName RoleBindingNameParameter, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiV1ProjectRolebindingsRoleBindingNameResponse, error) {
+	rsp, err := c.PutApiV1ProjectRolebindingsRoleBindingNameWithBody(ctx, roleBindingName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ProjectRolebindingsRoleBindingNameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiV1ProjectRolebindingsRoleBindingNameWithResponse(ctx context.Context, roleBindingName RoleBindingNameParameter, body PutApiV1ProjectRolebindingsRoleBindingNameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiV1ProjectRolebindingsRoleBindingNameResponse, error) {
+	rsp, err := c.PutApiV1ProjectRolebindingsRoleBindingName(ctx, roleBindingName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiV1ProjectRolebindingsRoleBindingNameResponse(rsp)
+}
+
+// GetApiV1ProvidersWithResponse request returning *GetApiV1ProvidersResponse
+func (c *ClientWithResponses) GetApiV1ProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersResponse, error) {
+	rsp, err := c.GetApiV1Providers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackApplicationCredentialRolesWithResponse request returning *GetApiV1ProvidersOpenstackApplicationCredentialRolesResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackApplicationCredentialRolesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackApplicationCredentialRolesResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackApplicationCredentialRoles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackApplicationCredentialRolesResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse request returning *GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse request returning *GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackAvailabilityZonesCompute(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackExternalNetworksWithResponse request returning *GetApiV1ProvidersOpenstackExternalNetworksResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackExternalNetworksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackExternalNetworksResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackExternalNetworks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackExternalNetworksResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackFlavorsWithResponse request returning *GetApiV1ProvidersOpenstackFlavorsResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackFlavorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackFlavorsResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackFlavors(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackFlavorsResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesWithResponse request returning *GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesWithResponse(ctx context.Context, flavorName FlavorNameParameter, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages(ctx, flavorName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackHealthWithResponse request returning *GetApiV1ProvidersOpenstackHealthResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackHealthResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackHealthResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackImagesWithResponse request returning *GetApiV1ProvidersOpenstackImagesResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackImagesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackImagesResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackImages(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackImagesResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackKeyPairsWithResponse request returning *GetApiV1ProvidersOpenstackKeyPairsResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackKeyPairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackKeyPairsResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackKeyPairs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackKeyPairsResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackProjectsWithResponse request returning *GetApiV1ProvidersOpenstackProjectsResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackProjectsResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackProjects(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackProjectsResponse(rsp)
+}
+
+// GetApiV1ProvidersOpenstackQuotasWithResponse request returning *GetApiV1ProvidersOpenstackQuotasResponse
+func (c *ClientWithResponses) GetApiV1ProvidersOpenstackQuotasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackQuotasResponse, error) {
+	rsp, err := c.GetApiV1ProvidersOpenstackQuotas(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1ProvidersOpenstackQuotasResponse(rsp)
+}
+
+// ParseGetApiV1ActivityResponse parses an HTTP response from a GetApiV1ActivityWithResponse call
+func ParseGetApiV1ActivityResponse(rsp *http.Response) (*GetApiV1ActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActivityFeed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminDebugCapturesCaptureIDResponse parses an HTTP response from a GetApiV1AdminDebugCapturesCaptureIDWithResponse call
+func ParseGetApiV1AdminDebugCapturesCaptureIDResponse(rsp *http.Response) (*GetApiV1AdminDebugCapturesCaptureIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminDebugCapturesCaptureIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DebugCapture
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminDeprecationsResponse parses an HTTP response from a GetApiV1AdminDeprecationsWithResponse call
+func ParseGetApiV1AdminDeprecationsResponse(rsp *http.Response) (*GetApiV1AdminDeprecationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminDeprecationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeprecatedUsages
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminExportResponse parses an HTTP response from a GetApiV1AdminExportWithResponse call
+func ParseGetApiV1AdminExportResponse(rsp *http.Response) (*GetApiV1AdminExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AdminMonitorShardsResponse parses an HTTP response from a GetApiV1AdminMonitorShardsWithResponse call
+func ParseGetApiV1AdminMonitorShardsResponse(rsp *http.Response) (*GetApiV1AdminMonitorShardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AdminMonitorShardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MonitorShards
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1AdminMonitorShardsResponse parses an HTTP response from a PutApiV1AdminMonitorShardsWithResponse call
+func ParsePutApiV1AdminMonitorShardsResponse(rsp *http.Response) (*PutApiV1AdminMonitorShardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1AdminMonitorShardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ApplicationbundlesClusterResponse parses an HTTP response from a GetApiV1ApplicationbundlesClusterWithResponse call
+func ParseGetApiV1ApplicationbundlesClusterResponse(rsp *http.Response) (*GetApiV1ApplicationbundlesClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ApplicationbundlesClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApplicationBundles
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ApplicationbundlesControlPlaneResponse parses an HTTP response from a GetApiV1ApplicationbundlesControlPlaneWithResponse call
+func ParseGetApiV1ApplicationbundlesControlPlaneResponse(rsp *http.Response) (*GetApiV1ApplicationbundlesControlPlaneResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ApplicationbundlesControlPlaneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApplicationBundles
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ApplicationsResponse parses an HTTP response from a GetApiV1ApplicationsWithResponse call
+func ParseGetApiV1ApplicationsResponse(rsp *http.Response) (*GetApiV1ApplicationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ApplicationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Applications
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AuthJwksResponse parses an HTTP response from a GetApiV1AuthJwksWithResponse call
+func ParseGetApiV1AuthJwksResponse(rsp *http.Response) (*GetApiV1AuthJwksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AuthJwksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JsonWebKeySet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AuthOauth2AuthorizationResponse parses an HTTP response from a GetApiV1AuthOauth2AuthorizationWithResponse call
+func ParseGetApiV1AuthOauth2AuthorizationResponse(rsp *http.Response) (*GetApiV1AuthOauth2AuthorizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AuthOauth2AuthorizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthOauth2TokensResponse parses an HTTP response from a PostApiV1AuthOauth2TokensWithResponse call
+func ParsePostApiV1AuthOauth2TokensResponse(rsp *http.Response) (*PostApiV1AuthOauth2TokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthOauth2TokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Token
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1AuthOidcCallbackResponse parses an HTTP response from a GetApiV1AuthOidcCallbackWithResponse call
+func ParseGetApiV1AuthOidcCallbackResponse(rsp *http.Response) (*GetApiV1AuthOidcCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1AuthOidcCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1AuthTokensTokenResponse parses an HTTP response from a PostApiV1AuthTokensTokenWithResponse call
+func ParsePostApiV1AuthTokensTokenResponse(rsp *http.Response) (*PostApiV1AuthTokensTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1AuthTokensTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Token
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ChangelogResponse parses an HTTP response from a GetApiV1ChangelogWithResponse call
+func ParseGetApiV1ChangelogResponse(rsp *http.Response) (*GetApiV1ChangelogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ChangelogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PlatformChangelog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ChangelogChangelogVersionAcknowledgeResponse parses an HTTP response from a PostApiV1ChangelogChangelogVersionAcknowledgeWithResponse call
+func ParsePostApiV1ChangelogChangelogVersionAcknowledgeResponse(rsp *http.Response) (*PostApiV1ChangelogChangelogVersionAcknowledgeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ChangelogChangelogVersionAcknowledgeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ClustertemplatesResponse parses an HTTP response from a GetApiV1ClustertemplatesWithResponse call
+func ParseGetApiV1ClustertemplatesResponse(rsp *http.Response) (*GetApiV1ClustertemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ClustertemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterTemplates
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesResponse parses an HTTP response from a GetApiV1ControlplanesWithResponse call
+func ParseGetApiV1ControlplanesResponse(rsp *http.Response) (*GetApiV1ControlplanesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ControlPlanes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesResponse parses an HTTP response from a PostApiV1ControlplanesWithResponse call
+func ParsePostApiV1ControlplanesResponse(rsp *http.Response) (*PostApiV1ControlplanesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1ControlplanesControlPlaneNameResponse parses an HTTP response from a DeleteApiV1ControlplanesControlPlaneNameWithResponse call
+func ParseDeleteApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*DeleteApiV1ControlplanesControlPlaneNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1ControlplanesControlPlaneNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ControlPlane
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1ControlplanesControlPlaneNameResponse parses an HTTP response from a PutApiV1ControlplanesControlPlaneNameWithResponse call
+func ParsePutApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*PutApiV1ControlplanesControlPlaneNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1ControlplanesControlPlaneNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameClustersResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameClustersResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusters
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameClustersResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameClustersWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameClustersResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterCost
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse parses an HTTP response from a DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse call
+func ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp *http.Response) (*DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesCluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse parses an HTTP response from a PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse call
+func ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp *http.Response) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterCertificate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse parses an HTTP response from a PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse call
+func ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse(rsp *http.Response) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterCost
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterHealth
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterMetricsSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeAllowList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse parses an HTTP response from a PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithResponse call
+func ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse(rsp *http.Response) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SshCertificate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterStageTimings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse parses an HTTP response from a DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithResponse call
+func ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse(rsp *http.Response) (*DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApproveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterWorkloadPoolOperationResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest KubernetesClusterWorkloadPoolOperationResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapWithResponse call
+func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KubernetesClusterWorkloadPoolBootstrap
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameMoveResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameMoveWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameMoveResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameMoveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameMoveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameResizeResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameResizeWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameResizeResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameResizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameResizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveWithResponse call
+func ParsePostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApproveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiV1EnvironmentsResponse parses an HTTP response from a PostApiV1EnvironmentsWithResponse call
+func ParsePostApiV1EnvironmentsResponse(rsp *http.Response) (*PostApiV1EnvironmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiV1EnvironmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest EnvironmentStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiV1EnvironmentsEnvironmentNameResponse parses an HTTP response from a DeleteApiV1EnvironmentsEnvironmentNameWithResponse call
+func ParseDeleteApiV1EnvironmentsEnvironmentNameResponse(rsp *http.Response) (*DeleteApiV1EnvironmentsEnvironmentNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiV1EnvironmentsEnvironmentNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiV1EnvironmentsEnvironmentNameResponse parses an HTTP response from a GetApiV1EnvironmentsEnvironmentNameWithResponse call
+func ParseGetApiV1EnvironmentsEnvironmentNameResponse(rsp *http.Response) (*GetApiV1EnvironmentsEnvironmentNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiV1EnvironmentsEnvironmentNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// GetApiV1ProvidersOpenstackKeyPairsWithResponse request returning *GetApiV1ProvidersOpenstackKeyPairsResponse
-func (c *ClientWithResponses) GetApiV1ProvidersOpenstackKeyPairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackKeyPairsResponse, error) {
-	rsp, err := c.GetApiV1ProvidersOpenstackKeyPairs(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetApiV1ProvidersOpenstackKeyPairsResponse(rsp)
-}
 
-// GetApiV1ProvidersOpenstackProjectsWithResponse request returning *GetApiV1ProvidersOpenstackProjectsResponse
-func (c *ClientWithResponses) GetApiV1ProvidersOpenstackProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1ProvidersOpenstackProjectsResponse, error) {
-	rsp, err := c.GetApiV1ProvidersOpenstackProjects(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiV1ProvidersOpenstackProjectsResponse(rsp)
+	return response, nil
 }
 
-// ParseGetApiV1ApplicationbundlesClusterResponse parses an HTTP response from a GetApiV1ApplicationbundlesClusterWithResponse call
-func ParseGetApiV1ApplicationbundlesClusterResponse(rsp *http.Response) (*GetApiV1ApplicationbundlesClusterResponse, error) {
+// ParseGetApiV1ExportResponse parses an HTTP response from a GetApiV1ExportWithResponse call
+func ParseGetApiV1ExportResponse(rsp *http.Response) (*GetApiV1ExportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ApplicationbundlesClusterResponse{
+	response := &GetApiV1ExportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApplicationBundles
+		var dest ExportBundle
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2704,22 +10874,22 @@ func ParseGetApiV1ApplicationbundlesClusterResponse(rsp *http.Response) (*GetApi
 	return response, nil
 }
 
-// ParseGetApiV1ApplicationbundlesControlPlaneResponse parses an HTTP response from a GetApiV1ApplicationbundlesControlPlaneWithResponse call
-func ParseGetApiV1ApplicationbundlesControlPlaneResponse(rsp *http.Response) (*GetApiV1ApplicationbundlesControlPlaneResponse, error) {
+// ParsePostApiV1ImportResponse parses an HTTP response from a PostApiV1ImportWithResponse call
+func ParsePostApiV1ImportResponse(rsp *http.Response) (*PostApiV1ImportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ApplicationbundlesControlPlaneResponse{
+	response := &PostApiV1ImportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApplicationBundles
+		var dest ImportResults
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2739,6 +10909,20 @@ func ParseGetApiV1ApplicationbundlesControlPlaneResponse(rsp *http.Response) (*G
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2751,22 +10935,22 @@ func ParseGetApiV1ApplicationbundlesControlPlaneResponse(rsp *http.Response) (*G
 	return response, nil
 }
 
-// ParseGetApiV1ApplicationsResponse parses an HTTP response from a GetApiV1ApplicationsWithResponse call
-func ParseGetApiV1ApplicationsResponse(rsp *http.Response) (*GetApiV1ApplicationsResponse, error) {
+// ParseGetApiV1KubernetesversionsResponse parses an HTTP response from a GetApiV1KubernetesversionsWithResponse call
+func ParseGetApiV1KubernetesversionsResponse(rsp *http.Response) (*GetApiV1KubernetesversionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ApplicationsResponse{
+	response := &GetApiV1KubernetesversionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Applications
+		var dest KubernetesVersions
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2798,26 +10982,47 @@ func ParseGetApiV1ApplicationsResponse(rsp *http.Response) (*GetApiV1Application
 	return response, nil
 }
 
-// ParseGetApiV1AuthJwksResponse parses an HTTP response from a GetApiV1AuthJwksWithResponse call
-func ParseGetApiV1AuthJwksResponse(rsp *http.Response) (*GetApiV1AuthJwksResponse, error) {
+// ParseDeleteApiV1ProjectResponse parses an HTTP response from a DeleteApiV1ProjectWithResponse call
+func ParseDeleteApiV1ProjectResponse(rsp *http.Response) (*DeleteApiV1ProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1AuthJwksResponse{
+	response := &DeleteApiV1ProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JsonWebKeySet
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
@@ -2831,15 +11036,15 @@ func ParseGetApiV1AuthJwksResponse(rsp *http.Response) (*GetApiV1AuthJwksRespons
 	return response, nil
 }
 
-// ParseGetApiV1AuthOauth2AuthorizationResponse parses an HTTP response from a GetApiV1AuthOauth2AuthorizationWithResponse call
-func ParseGetApiV1AuthOauth2AuthorizationResponse(rsp *http.Response) (*GetApiV1AuthOauth2AuthorizationResponse, error) {
+// ParsePostApiV1ProjectResponse parses an HTTP response from a PostApiV1ProjectWithResponse call
+func ParsePostApiV1ProjectResponse(rsp *http.Response) (*PostApiV1ProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1AuthOauth2AuthorizationResponse{
+	response := &PostApiV1ProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -2852,6 +11057,34 @@ func ParseGetApiV1AuthOauth2AuthorizationResponse(rsp *http.Response) (*GetApiV1
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2864,22 +11097,22 @@ func ParseGetApiV1AuthOauth2AuthorizationResponse(rsp *http.Response) (*GetApiV1
 	return response, nil
 }
 
-// ParsePostApiV1AuthOauth2TokensResponse parses an HTTP response from a PostApiV1AuthOauth2TokensWithResponse call
-func ParsePostApiV1AuthOauth2TokensResponse(rsp *http.Response) (*PostApiV1AuthOauth2TokensResponse, error) {
+// ParseGetApiV1ProjectMembersResponse parses an HTTP response from a GetApiV1ProjectMembersWithResponse call
+func ParseGetApiV1ProjectMembersResponse(rsp *http.Response) (*GetApiV1ProjectMembersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1AuthOauth2TokensResponse{
+	response := &GetApiV1ProjectMembersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Token
+		var dest ProjectMembers
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2899,6 +11132,13 @@ func ParsePostApiV1AuthOauth2TokensResponse(rsp *http.Response) (*PostApiV1AuthO
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2911,20 +11151,55 @@ func ParsePostApiV1AuthOauth2TokensResponse(rsp *http.Response) (*PostApiV1AuthO
 	return response, nil
 }
 
-// ParseGetApiV1AuthOidcCallbackResponse parses an HTTP response from a GetApiV1AuthOidcCallbackWithResponse call
-func ParseGetApiV1AuthOidcCallbackResponse(rsp *http.Response) (*GetApiV1AuthOidcCallbackResponse, error) {
+// ParsePostApiV1ProjectMembersResponse parses an HTTP response from a PostApiV1ProjectMembersWithResponse call
+func ParsePostApiV1ProjectMembersResponse(rsp *http.Response) (*PostApiV1ProjectMembersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1AuthOidcCallbackResponse{
+	response := &PostApiV1ProjectMembersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2937,27 +11212,74 @@ func ParseGetApiV1AuthOidcCallbackResponse(rsp *http.Response) (*GetApiV1AuthOid
 	return response, nil
 }
 
-// ParsePostApiV1AuthTokensTokenResponse parses an HTTP response from a PostApiV1AuthTokensTokenWithResponse call
-func ParsePostApiV1AuthTokensTokenResponse(rsp *http.Response) (*PostApiV1AuthTokensTokenResponse, error) {
+// ParseDeleteApiV1ProjectMembersUserIDResponse parses an HTTP response from a DeleteApiV1ProjectMembersUserIDWithResponse call
+func ParseDeleteApiV1ProjectMembersUserIDResponse(rsp *http.Response) (*DeleteApiV1ProjectMembersUserIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1AuthTokensTokenResponse{
+	response := &DeleteApiV1ProjectMembersUserIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Token
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiV1ProjectMembersUserIDResponse parses an HTTP response from a PutApiV1ProjectMembersUserIDWithResponse call
+func ParsePutApiV1ProjectMembersUserIDResponse(rsp *http.Response) (*PutApiV1ProjectMembersUserIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiV1ProjectMembersUserIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2972,6 +11294,20 @@ func ParsePostApiV1AuthTokensTokenResponse(rsp *http.Response) (*PostApiV1AuthTo
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2984,22 +11320,22 @@ func ParsePostApiV1AuthTokensTokenResponse(rsp *http.Response) (*PostApiV1AuthTo
 	return response, nil
 }
 
-// ParseGetApiV1ControlplanesResponse parses an HTTP response from a GetApiV1ControlplanesWithResponse call
-func ParseGetApiV1ControlplanesResponse(rsp *http.Response) (*GetApiV1ControlplanesResponse, error) {
+// ParseGetApiV1ProjectOffboardingResponse parses an HTTP response from a GetApiV1ProjectOffboardingWithResponse call
+func ParseGetApiV1ProjectOffboardingResponse(rsp *http.Response) (*GetApiV1ProjectOffboardingResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ControlplanesResponse{
+	response := &GetApiV1ProjectOffboardingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ControlPlanes
+		var dest ProjectOffboarding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3038,33 +11374,47 @@ func ParseGetApiV1ControlplanesResponse(rsp *http.Response) (*GetApiV1Controlpla
 	return response, nil
 }
 
-// ParsePostApiV1ControlplanesResponse parses an HTTP response from a PostApiV1ControlplanesWithResponse call
-func ParsePostApiV1ControlplanesResponse(rsp *http.Response) (*PostApiV1ControlplanesResponse, error) {
+// ParsePostApiV1ProjectOffboardingResponse parses an HTTP response from a PostApiV1ProjectOffboardingWithResponse call
+func ParsePostApiV1ProjectOffboardingResponse(rsp *http.Response) (*PostApiV1ProjectOffboardingResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1ControlplanesResponse{
+	response := &PostApiV1ProjectOffboardingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest Oauth2Error
@@ -3085,20 +11435,27 @@ func ParsePostApiV1ControlplanesResponse(rsp *http.Response) (*PostApiV1Controlp
 	return response, nil
 }
 
-// ParseDeleteApiV1ControlplanesControlPlaneNameResponse parses an HTTP response from a DeleteApiV1ControlplanesControlPlaneNameWithResponse call
-func ParseDeleteApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*DeleteApiV1ControlplanesControlPlaneNameResponse, error) {
+// ParsePostApiV1ProjectOffboardingConfirmResponse parses an HTTP response from a PostApiV1ProjectOffboardingConfirmWithResponse call
+func ParsePostApiV1ProjectOffboardingConfirmResponse(rsp *http.Response) (*PostApiV1ProjectOffboardingConfirmResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiV1ControlplanesControlPlaneNameResponse{
+	response := &PostApiV1ProjectOffboardingConfirmResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectOffboarding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3113,6 +11470,13 @@ func ParseDeleteApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3132,22 +11496,22 @@ func ParseDeleteApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (
 	return response, nil
 }
 
-// ParseGetApiV1ControlplanesControlPlaneNameResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameWithResponse call
-func ParseGetApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameResponse, error) {
+// ParseGetApiV1ProjectRolebindingsResponse parses an HTTP response from a GetApiV1ProjectRolebindingsWithResponse call
+func ParseGetApiV1ProjectRolebindingsResponse(rsp *http.Response) (*GetApiV1ProjectRolebindingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ControlplanesControlPlaneNameResponse{
+	response := &GetApiV1ProjectRolebindingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ControlPlane
+		var dest ProjectRoleBindings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3167,12 +11531,12 @@ func ParseGetApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*Ge
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
@@ -3186,15 +11550,15 @@ func ParseGetApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*Ge
 	return response, nil
 }
 
-// ParsePutApiV1ControlplanesControlPlaneNameResponse parses an HTTP response from a PutApiV1ControlplanesControlPlaneNameWithResponse call
-func ParsePutApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*PutApiV1ControlplanesControlPlaneNameResponse, error) {
+// ParsePostApiV1ProjectRolebindingsResponse parses an HTTP response from a PostApiV1ProjectRolebindingsWithResponse call
+func ParsePostApiV1ProjectRolebindingsResponse(rsp *http.Response) (*PostApiV1ProjectRolebindingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutApiV1ControlplanesControlPlaneNameResponse{
+	response := &PostApiV1ProjectRolebindingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3214,6 +11578,13 @@ func ParsePutApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*Pu
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3221,6 +11592,13 @@ func ParsePutApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*Pu
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3233,27 +11611,20 @@ func ParsePutApiV1ControlplanesControlPlaneNameResponse(rsp *http.Response) (*Pu
 	return response, nil
 }
 
-// ParseGetApiV1ControlplanesControlPlaneNameClustersResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersWithResponse call
-func ParseGetApiV1ControlplanesControlPlaneNameClustersResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersResponse, error) {
+// ParseDeleteApiV1ProjectRolebindingsRoleBindingNameResponse parses an HTTP response from a DeleteApiV1ProjectRolebindingsRoleBindingNameWithResponse call
+func ParseDeleteApiV1ProjectRolebindingsRoleBindingNameResponse(rsp *http.Response) (*DeleteApiV1ProjectRolebindingsRoleBindingNameResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ControlplanesControlPlaneNameClustersResponse{
+	response := &DeleteApiV1ProjectRolebindingsRoleBindingNameResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KubernetesClusters
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3268,6 +11639,13 @@ func ParseGetApiV1ControlplanesControlPlaneNameClustersResponse(rsp *http.Respon
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3287,20 +11665,27 @@ func ParseGetApiV1ControlplanesControlPlaneNameClustersResponse(rsp *http.Respon
 	return response, nil
 }
 
-// ParsePostApiV1ControlplanesControlPlaneNameClustersResponse parses an HTTP response from a PostApiV1ControlplanesControlPlaneNameClustersWithResponse call
-func ParsePostApiV1ControlplanesControlPlaneNameClustersResponse(rsp *http.Response) (*PostApiV1ControlplanesControlPlaneNameClustersResponse, error) {
+// ParseGetApiV1ProjectRolebindingsRoleBindingNameResponse parses an HTTP response from a GetApiV1ProjectRolebindingsRoleBindingNameWithResponse call
+func ParseGetApiV1ProjectRolebindingsRoleBindingNameResponse(rsp *http.Response) (*GetApiV1ProjectRolebindingsRoleBindingNameResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1ControlplanesControlPlaneNameClustersResponse{
+	response := &GetApiV1ProjectRolebindingsRoleBindingNameResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectRoleBinding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3322,12 +11707,12 @@ func ParsePostApiV1ControlplanesControlPlaneNameClustersResponse(rsp *http.Respo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
@@ -3341,15 +11726,15 @@ func ParsePostApiV1ControlplanesControlPlaneNameClustersResponse(rsp *http.Respo
 	return response, nil
 }
 
-// ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse parses an HTTP response from a DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse call
-func ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp *http.Response) (*DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
+// ParsePutApiV1ProjectRolebindingsRoleBindingNameResponse parses an HTTP response from a PutApiV1ProjectRolebindingsRoleBindingNameWithResponse call
+func ParsePutApiV1ProjectRolebindingsRoleBindingNameResponse(rsp *http.Response) (*PutApiV1ProjectRolebindingsRoleBindingNameResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse{
+	response := &PutApiV1ProjectRolebindingsRoleBindingNameResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3369,6 +11754,13 @@ func ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rs
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3388,34 +11780,27 @@ func ParseDeleteApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rs
 	return response, nil
 }
 
-// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse call
-func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
+// ParseGetApiV1ProvidersResponse parses an HTTP response from a GetApiV1ProvidersWithResponse call
+func ParseGetApiV1ProvidersResponse(rsp *http.Response) (*GetApiV1ProvidersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse{
+	response := &GetApiV1ProvidersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest KubernetesCluster
+		var dest Provider
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Oauth2Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3423,13 +11808,6 @@ func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp *
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Oauth2Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3442,40 +11820,40 @@ func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp *
 	return response, nil
 }
 
-// ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse parses an HTTP response from a PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse call
-func ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp *http.Response) (*PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse, error) {
+// ParseGetApiV1ProvidersOpenstackApplicationCredentialRolesResponse parses an HTTP response from a GetApiV1ProvidersOpenstackApplicationCredentialRolesWithResponse call
+func ParseGetApiV1ProvidersOpenstackApplicationCredentialRolesResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackApplicationCredentialRolesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse{
+	response := &GetApiV1ProvidersOpenstackApplicationCredentialRolesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Oauth2Error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OpenstackApplicationCredentialRoles
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
@@ -3489,20 +11867,27 @@ func ParsePutApiV1ControlplanesControlPlaneNameClustersClusterNameResponse(rsp *
 	return response, nil
 }
 
-// ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse parses an HTTP response from a GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigWithResponse call
-func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse(rsp *http.Response) (*GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse, error) {
+// ParseGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse parses an HTTP response from a GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse call
+func ParseGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResponse{
+	response := &GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OpenstackAvailabilityZones
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3517,39 +11902,46 @@ func ParseGetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfigResp
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON503 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteApiV1ProjectResponse parses an HTTP response from a DeleteApiV1ProjectWithResponse call
-func ParseDeleteApiV1ProjectResponse(rsp *http.Response) (*DeleteApiV1ProjectResponse, error) {
+// ParseGetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse parses an HTTP response from a GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse call
+func ParseGetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiV1ProjectResponse{
+	response := &GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OpenstackAvailabilityZones
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3564,59 +11956,59 @@ func ParseDeleteApiV1ProjectResponse(rsp *http.Response) (*DeleteApiV1ProjectRes
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON503 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePostApiV1ProjectResponse parses an HTTP response from a PostApiV1ProjectWithResponse call
-func ParsePostApiV1ProjectResponse(rsp *http.Response) (*PostApiV1ProjectResponse, error) {
+// ParseGetApiV1ProvidersOpenstackExternalNetworksResponse parses an HTTP response from a GetApiV1ProvidersOpenstackExternalNetworksWithResponse call
+func ParseGetApiV1ProvidersOpenstackExternalNetworksResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackExternalNetworksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostApiV1ProjectResponse{
+	response := &GetApiV1ProvidersOpenstackExternalNetworksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Oauth2Error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OpenstackExternalNetworks
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
@@ -3630,22 +12022,22 @@ func ParsePostApiV1ProjectResponse(rsp *http.Response) (*PostApiV1ProjectRespons
 	return response, nil
 }
 
-// ParseGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse parses an HTTP response from a GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse call
-func ParseGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse, error) {
+// ParseGetApiV1ProvidersOpenstackFlavorsResponse parses an HTTP response from a GetApiV1ProvidersOpenstackFlavorsWithResponse call
+func ParseGetApiV1ProvidersOpenstackFlavorsResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackFlavorsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse{
+	response := &GetApiV1ProvidersOpenstackFlavorsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OpenstackAvailabilityZones
+		var dest OpenstackFlavors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3672,27 +12064,34 @@ func ParseGetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageResponse(rsp *h
 		}
 		response.JSON500 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse parses an HTTP response from a GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse call
-func ParseGetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse, error) {
+// ParseGetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse parses an HTTP response from a GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesWithResponse call
+func ParseGetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse{
+	response := &GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OpenstackAvailabilityZones
+		var dest OpenstackImages
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3712,6 +12111,13 @@ func ParseGetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse(rsp *http.R
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3719,39 +12125,39 @@ func ParseGetApiV1ProvidersOpenstackAvailabilityZonesComputeResponse(rsp *http.R
 		}
 		response.JSON500 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetApiV1ProvidersOpenstackExternalNetworksResponse parses an HTTP response from a GetApiV1ProvidersOpenstackExternalNetworksWithResponse call
-func ParseGetApiV1ProvidersOpenstackExternalNetworksResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackExternalNetworksResponse, error) {
+// ParseGetApiV1ProvidersOpenstackHealthResponse parses an HTTP response from a GetApiV1ProvidersOpenstackHealthWithResponse call
+func ParseGetApiV1ProvidersOpenstackHealthResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackHealthResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ProvidersOpenstackExternalNetworksResponse{
+	response := &GetApiV1ProvidersOpenstackHealthResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OpenstackExternalNetworks
+		var dest OpenstackHealth
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Oauth2Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Oauth2Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3771,22 +12177,22 @@ func ParseGetApiV1ProvidersOpenstackExternalNetworksResponse(rsp *http.Response)
 	return response, nil
 }
 
-// ParseGetApiV1ProvidersOpenstackFlavorsResponse parses an HTTP response from a GetApiV1ProvidersOpenstackFlavorsWithResponse call
-func ParseGetApiV1ProvidersOpenstackFlavorsResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackFlavorsResponse, error) {
+// ParseGetApiV1ProvidersOpenstackImagesResponse parses an HTTP response from a GetApiV1ProvidersOpenstackImagesWithResponse call
+func ParseGetApiV1ProvidersOpenstackImagesResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackImagesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ProvidersOpenstackFlavorsResponse{
+	response := &GetApiV1ProvidersOpenstackImagesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OpenstackFlavors
+		var dest OpenstackImages
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3813,27 +12219,34 @@ func ParseGetApiV1ProvidersOpenstackFlavorsResponse(rsp *http.Response) (*GetApi
 		}
 		response.JSON500 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Oauth2Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetApiV1ProvidersOpenstackImagesResponse parses an HTTP response from a GetApiV1ProvidersOpenstackImagesWithResponse call
-func ParseGetApiV1ProvidersOpenstackImagesResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackImagesResponse, error) {
+// ParseGetApiV1ProvidersOpenstackKeyPairsResponse parses an HTTP response from a GetApiV1ProvidersOpenstackKeyPairsWithResponse call
+func ParseGetApiV1ProvidersOpenstackKeyPairsResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackKeyPairsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ProvidersOpenstackImagesResponse{
+	response := &GetApiV1ProvidersOpenstackKeyPairsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OpenstackImages
+		var dest OpenstackKeyPairs
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3865,22 +12278,22 @@ func ParseGetApiV1ProvidersOpenstackImagesResponse(rsp *http.Response) (*GetApiV
 	return response, nil
 }
 
-// ParseGetApiV1ProvidersOpenstackKeyPairsResponse parses an HTTP response from a GetApiV1ProvidersOpenstackKeyPairsWithResponse call
-func ParseGetApiV1ProvidersOpenstackKeyPairsResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackKeyPairsResponse, error) {
+// ParseGetApiV1ProvidersOpenstackProjectsResponse parses an HTTP response from a GetApiV1ProvidersOpenstackProjectsWithResponse call
+func ParseGetApiV1ProvidersOpenstackProjectsResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackProjectsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ProvidersOpenstackKeyPairsResponse{
+	response := &GetApiV1ProvidersOpenstackProjectsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OpenstackKeyPairs
+		var dest OpenstackProjects
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3912,22 +12325,22 @@ func ParseGetApiV1ProvidersOpenstackKeyPairsResponse(rsp *http.Response) (*GetAp
 	return response, nil
 }
 
-// ParseGetApiV1ProvidersOpenstackProjectsResponse parses an HTTP response from a GetApiV1ProvidersOpenstackProjectsWithResponse call
-func ParseGetApiV1ProvidersOpenstackProjectsResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackProjectsResponse, error) {
+// ParseGetApiV1ProvidersOpenstackQuotasResponse parses an HTTP response from a GetApiV1ProvidersOpenstackQuotasWithResponse call
+func ParseGetApiV1ProvidersOpenstackQuotasResponse(rsp *http.Response) (*GetApiV1ProvidersOpenstackQuotasResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiV1ProvidersOpenstackProjectsResponse{
+	response := &GetApiV1ProvidersOpenstackQuotasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OpenstackProjects
+		var dest OpenstackQuotas
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
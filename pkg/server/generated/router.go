@@ -15,6 +15,24 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /api/v1/activity)
+	GetApiV1Activity(w http.ResponseWriter, r *http.Request, params GetApiV1ActivityParams)
+
+	// (GET /api/v1/admin/debug/captures/{captureID})
+	GetApiV1AdminDebugCapturesCaptureID(w http.ResponseWriter, r *http.Request, captureID CaptureIDParameter)
+
+	// (GET /api/v1/admin/deprecations)
+	GetApiV1AdminDeprecations(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/admin/export)
+	GetApiV1AdminExport(w http.ResponseWriter, r *http.Request, params GetApiV1AdminExportParams)
+
+	// (GET /api/v1/admin/monitor/shards)
+	GetApiV1AdminMonitorShards(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /api/v1/admin/monitor/shards)
+	PutApiV1AdminMonitorShards(w http.ResponseWriter, r *http.Request)
+
 	// (GET /api/v1/applicationbundles/cluster)
 	GetApiV1ApplicationbundlesCluster(w http.ResponseWriter, r *http.Request)
 
@@ -39,11 +57,20 @@ type ServerInterface interface {
 	// (POST /api/v1/auth/tokens/token)
 	PostApiV1AuthTokensToken(w http.ResponseWriter, r *http.Request)
 
+	// (GET /api/v1/changelog)
+	GetApiV1Changelog(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/changelog/{changelogVersion}/acknowledge)
+	PostApiV1ChangelogChangelogVersionAcknowledge(w http.ResponseWriter, r *http.Request, changelogVersion ChangelogVersionParameter)
+
+	// (GET /api/v1/clustertemplates)
+	GetApiV1Clustertemplates(w http.ResponseWriter, r *http.Request)
+
 	// (GET /api/v1/controlplanes)
 	GetApiV1Controlplanes(w http.ResponseWriter, r *http.Request)
 
 	// (POST /api/v1/controlplanes)
-	PostApiV1Controlplanes(w http.ResponseWriter, r *http.Request)
+	PostApiV1Controlplanes(w http.ResponseWriter, r *http.Request, params PostApiV1ControlplanesParams)
 
 	// (DELETE /api/v1/controlplanes/{controlPlaneName})
 	DeleteApiV1ControlplanesControlPlaneName(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter)
@@ -58,25 +85,148 @@ type ServerInterface interface {
 	GetApiV1ControlplanesControlPlaneNameClusters(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter)
 
 	// (POST /api/v1/controlplanes/{controlPlaneName}/clusters)
-	PostApiV1ControlplanesControlPlaneNameClusters(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter)
+	PostApiV1ControlplanesControlPlaneNameClusters(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, params PostApiV1ControlplanesControlPlaneNameClustersParams)
 
 	// (DELETE /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName})
 	DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
 
 	// (GET /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName})
-	GetApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+	GetApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, params GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams)
 
 	// (PUT /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName})
 	PutApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
 
+	// (GET /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/certificate)
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (PUT /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/certificate)
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (GET /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/cost)
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/credentials/rotate)
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (GET /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/health)
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/hibernate)
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
 	// (GET /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/kubeconfig)
 	GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
 
+	// (GET /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/metrics-summary)
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (GET /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/nodes/allowlist)
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (PUT /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/nodes/allowlist)
+	PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/resume)
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/ssh/certificate)
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (GET /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/timings)
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/upgrade)
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (DELETE /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/upgrades/freeze)
+	DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/upgrades/freeze)
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/upgrades/{upgradeID}/approve)
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, upgradeID UpgradeIDParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/workloadpools)
+	PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter)
+
+	// (GET /api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/workloadpools/{workloadPoolName}/bootstrap)
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, clusterName ClusterNameParameter, workloadPoolName WorkloadPoolNameParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/move)
+	PostApiV1ControlplanesControlPlaneNameMove(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/resize)
+	PostApiV1ControlplanesControlPlaneNameResize(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter)
+
+	// (POST /api/v1/controlplanes/{controlPlaneName}/upgrades/{upgradeID}/approve)
+	PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove(w http.ResponseWriter, r *http.Request, controlPlaneName ControlPlaneNameParameter, upgradeID UpgradeIDParameter)
+
+	// (POST /api/v1/environments)
+	PostApiV1Environments(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/environments/{environmentName})
+	DeleteApiV1EnvironmentsEnvironmentName(w http.ResponseWriter, r *http.Request, environmentName EnvironmentNameParameter)
+
+	// (GET /api/v1/environments/{environmentName})
+	GetApiV1EnvironmentsEnvironmentName(w http.ResponseWriter, r *http.Request, environmentName EnvironmentNameParameter)
+
+	// (GET /api/v1/export)
+	GetApiV1Export(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/import)
+	PostApiV1Import(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/kubernetesversions)
+	GetApiV1Kubernetesversions(w http.ResponseWriter, r *http.Request)
+
 	// (DELETE /api/v1/project)
 	DeleteApiV1Project(w http.ResponseWriter, r *http.Request)
 
 	// (POST /api/v1/project)
-	PostApiV1Project(w http.ResponseWriter, r *http.Request)
+	PostApiV1Project(w http.ResponseWriter, r *http.Request, params PostApiV1ProjectParams)
+
+	// (GET /api/v1/project/members)
+	GetApiV1ProjectMembers(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/project/members)
+	PostApiV1ProjectMembers(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/project/members/{userID})
+	DeleteApiV1ProjectMembersUserID(w http.ResponseWriter, r *http.Request, userID UserIDParameter)
+
+	// (PUT /api/v1/project/members/{userID})
+	PutApiV1ProjectMembersUserID(w http.ResponseWriter, r *http.Request, userID UserIDParameter)
+
+	// (GET /api/v1/project/offboarding)
+	GetApiV1ProjectOffboarding(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/project/offboarding)
+	PostApiV1ProjectOffboarding(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/project/offboarding/confirm)
+	PostApiV1ProjectOffboardingConfirm(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/project/rolebindings)
+	GetApiV1ProjectRolebindings(w http.ResponseWriter, r *http.Request)
+
+	// (POST /api/v1/project/rolebindings)
+	PostApiV1ProjectRolebindings(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/project/rolebindings/{roleBindingName})
+	DeleteApiV1ProjectRolebindingsRoleBindingName(w http.ResponseWriter, r *http.Request, roleBindingName RoleBindingNameParameter)
+
+	// (GET /api/v1/project/rolebindings/{roleBindingName})
+	GetApiV1ProjectRolebindingsRoleBindingName(w http.ResponseWriter, r *http.Request, roleBindingName RoleBindingNameParameter)
+
+	// (PUT /api/v1/project/rolebindings/{roleBindingName})
+	PutApiV1ProjectRolebindingsRoleBindingName(w http.ResponseWriter, r *http.Request, roleBindingName RoleBindingNameParameter)
+
+	// (GET /api/v1/providers)
+	GetApiV1Providers(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/providers/openstack/application-credential-roles)
+	GetApiV1ProvidersOpenstackApplicationCredentialRoles(w http.ResponseWriter, r *http.Request)
 
 	// (GET /api/v1/providers/openstack/availability-zones/block-storage)
 	GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(w http.ResponseWriter, r *http.Request)
@@ -90,6 +240,12 @@ type ServerInterface interface {
 	// (GET /api/v1/providers/openstack/flavors)
 	GetApiV1ProvidersOpenstackFlavors(w http.ResponseWriter, r *http.Request)
 
+	// (GET /api/v1/providers/openstack/flavors/{flavorName}/compatible-images)
+	GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages(w http.ResponseWriter, r *http.Request, flavorName FlavorNameParameter)
+
+	// (GET /api/v1/providers/openstack/health)
+	GetApiV1ProvidersOpenstackHealth(w http.ResponseWriter, r *http.Request)
+
 	// (GET /api/v1/providers/openstack/images)
 	GetApiV1ProvidersOpenstackImages(w http.ResponseWriter, r *http.Request)
 
@@ -98,6 +254,9 @@ type ServerInterface interface {
 
 	// (GET /api/v1/providers/openstack/projects)
 	GetApiV1ProvidersOpenstackProjects(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/providers/openstack/quotas)
+	GetApiV1ProvidersOpenstackQuotas(w http.ResponseWriter, r *http.Request)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -109,6 +268,185 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetApiV1Activity operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Activity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1ActivityParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Activity(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1AdminDebugCapturesCaptureID operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AdminDebugCapturesCaptureID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "captureID" -------------
+	var captureID CaptureIDParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "captureID", runtime.ParamLocationPath, chi.URLParam(r, "captureID"), &captureID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "captureID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"admin"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1AdminDebugCapturesCaptureID(w, r, captureID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1AdminDeprecations operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AdminDeprecations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"admin"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1AdminDeprecations(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1AdminExport operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AdminExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1AdminExportParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1AdminExport(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1AdminMonitorShards operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AdminMonitorShards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"admin"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1AdminMonitorShards(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutApiV1AdminMonitorShards operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1AdminMonitorShards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"admin"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1AdminMonitorShards(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetApiV1ApplicationbundlesCluster operation middleware
 func (siw *ServerInterfaceWrapper) GetApiV1ApplicationbundlesCluster(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -237,6 +575,68 @@ func (siw *ServerInterfaceWrapper) PostApiV1AuthTokensToken(w http.ResponseWrite
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetApiV1Changelog operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Changelog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Changelog(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ChangelogChangelogVersionAcknowledge operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ChangelogChangelogVersionAcknowledge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "changelogVersion" -------------
+	var changelogVersion ChangelogVersionParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "changelogVersion", runtime.ParamLocationPath, chi.URLParam(r, "changelogVersion"), &changelogVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "changelogVersion", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ChangelogChangelogVersionAcknowledge(w, r, changelogVersion)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1Clustertemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Clustertemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Clustertemplates(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetApiV1Controlplanes operation middleware
 func (siw *ServerInterfaceWrapper) GetApiV1Controlplanes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -258,10 +658,36 @@ func (siw *ServerInterfaceWrapper) GetApiV1Controlplanes(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) PostApiV1Controlplanes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
 	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiV1ControlplanesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyParameter
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiV1Controlplanes(w, r)
+		siw.Handler.PostApiV1Controlplanes(w, r, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -373,7 +799,1319 @@ func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClusters
 	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiV1ControlplanesControlPlaneNameClusters(w, r, controlPlaneName)
+		siw.Handler.GetApiV1ControlplanesControlPlaneNameClusters(w, r, controlPlaneName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameClusters operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameClusters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiV1ControlplanesControlPlaneNameClustersParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "template" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "template", r.URL.Query(), &params.Template)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyParameter
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameClusters(w, r, controlPlaneName, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteApiV1ControlplanesControlPlaneNameClustersClusterName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterName operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", r.URL.Query(), &params.View)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterName(w, r, controlPlaneName, clusterName, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutApiV1ControlplanesControlPlaneNameClustersClusterName operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1ControlplanesControlPlaneNameClustersClusterName(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "upgradeID" -------------
+	var upgradeID UpgradeIDParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "upgradeID", runtime.ParamLocationPath, chi.URLParam(r, "upgradeID"), &upgradeID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "upgradeID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove(w, r, controlPlaneName, clusterName, upgradeID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools(w, r, controlPlaneName, clusterName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clusterName" -------------
+	var clusterName ClusterNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "workloadPoolName" -------------
+	var workloadPoolName WorkloadPoolNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workloadPoolName", runtime.ParamLocationPath, chi.URLParam(r, "workloadPoolName"), &workloadPoolName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workloadPoolName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project", "project:write"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap(w, r, controlPlaneName, clusterName, workloadPoolName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameMove operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameMove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameMove(w, r, controlPlaneName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameResize operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameResize(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameResize(w, r, controlPlaneName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "controlPlaneName" -------------
+	var controlPlaneName ControlPlaneNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "upgradeID" -------------
+	var upgradeID UpgradeIDParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "upgradeID", runtime.ParamLocationPath, chi.URLParam(r, "upgradeID"), &upgradeID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "upgradeID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove(w, r, controlPlaneName, upgradeID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1Environments operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Environments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1Environments(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteApiV1EnvironmentsEnvironmentName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1EnvironmentsEnvironmentName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "environmentName" -------------
+	var environmentName EnvironmentNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "environmentName", runtime.ParamLocationPath, chi.URLParam(r, "environmentName"), &environmentName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "environmentName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1EnvironmentsEnvironmentName(w, r, environmentName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1EnvironmentsEnvironmentName operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1EnvironmentsEnvironmentName(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "environmentName" -------------
+	var environmentName EnvironmentNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "environmentName", runtime.ParamLocationPath, chi.URLParam(r, "environmentName"), &environmentName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "environmentName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1EnvironmentsEnvironmentName(w, r, environmentName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1Export operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Export(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Export(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1Import operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Import(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1Import(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1Kubernetesversions operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Kubernetesversions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{""})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Kubernetesversions(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteApiV1Project operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1Project(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1Project(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1Project operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Project(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiV1ProjectParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyParameter
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1Project(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ProjectMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ProjectMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ProjectMembers(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ProjectMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ProjectMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project", "project:members"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ProjectMembers(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteApiV1ProjectMembersUserID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1ProjectMembersUserID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userID" -------------
+	var userID UserIDParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userID", runtime.ParamLocationPath, chi.URLParam(r, "userID"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project", "project:members"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1ProjectMembersUserID(w, r, userID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutApiV1ProjectMembersUserID operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1ProjectMembersUserID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userID" -------------
+	var userID UserIDParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userID", runtime.ParamLocationPath, chi.URLParam(r, "userID"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project", "project:members"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1ProjectMembersUserID(w, r, userID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ProjectOffboarding operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ProjectOffboarding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ProjectOffboarding(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostApiV1ProjectOffboarding operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ProjectOffboarding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ProjectOffboarding(w, r)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -383,25 +2121,14 @@ func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClusters
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostApiV1ControlplanesControlPlaneNameClusters operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameClusters(w http.ResponseWriter, r *http.Request) {
+// PostApiV1ProjectOffboardingConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ProjectOffboardingConfirm(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "controlPlaneName" -------------
-	var controlPlaneName ControlPlaneNameParameter
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiV1ControlplanesControlPlaneNameClusters(w, r, controlPlaneName)
+		siw.Handler.PostApiV1ProjectOffboardingConfirm(w, r)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -411,34 +2138,31 @@ func (siw *ServerInterfaceWrapper) PostApiV1ControlplanesControlPlaneNameCluster
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteApiV1ControlplanesControlPlaneNameClustersClusterName operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request) {
+// GetApiV1ProjectRolebindings operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ProjectRolebindings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
 
-	// ------------- Path parameter "controlPlaneName" -------------
-	var controlPlaneName ControlPlaneNameParameter
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ProjectRolebindings(w, r)
+	})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "clusterName" -------------
-	var clusterName ClusterNameParameter
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
-		return
-	}
+// PostApiV1ProjectRolebindings operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ProjectRolebindings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project", "project:members"})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(w, r, controlPlaneName, clusterName)
+		siw.Handler.PostApiV1ProjectRolebindings(w, r)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -448,34 +2172,25 @@ func (siw *ServerInterfaceWrapper) DeleteApiV1ControlplanesControlPlaneNameClust
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetApiV1ControlplanesControlPlaneNameClustersClusterName operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request) {
+// DeleteApiV1ProjectRolebindingsRoleBindingName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1ProjectRolebindingsRoleBindingName(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "controlPlaneName" -------------
-	var controlPlaneName ControlPlaneNameParameter
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "clusterName" -------------
-	var clusterName ClusterNameParameter
+	// ------------- Path parameter "roleBindingName" -------------
+	var roleBindingName RoleBindingNameParameter
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "roleBindingName", runtime.ParamLocationPath, chi.URLParam(r, "roleBindingName"), &roleBindingName)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roleBindingName", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project", "project:members"})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterName(w, r, controlPlaneName, clusterName)
+		siw.Handler.DeleteApiV1ProjectRolebindingsRoleBindingName(w, r, roleBindingName)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -485,34 +2200,25 @@ func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClusters
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutApiV1ControlplanesControlPlaneNameClustersClusterName operation middleware
-func (siw *ServerInterfaceWrapper) PutApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request) {
+// GetApiV1ProjectRolebindingsRoleBindingName operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ProjectRolebindingsRoleBindingName(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "controlPlaneName" -------------
-	var controlPlaneName ControlPlaneNameParameter
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "clusterName" -------------
-	var clusterName ClusterNameParameter
+	// ------------- Path parameter "roleBindingName" -------------
+	var roleBindingName RoleBindingNameParameter
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "roleBindingName", runtime.ParamLocationPath, chi.URLParam(r, "roleBindingName"), &roleBindingName)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roleBindingName", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutApiV1ControlplanesControlPlaneNameClustersClusterName(w, r, controlPlaneName, clusterName)
+		siw.Handler.GetApiV1ProjectRolebindingsRoleBindingName(w, r, roleBindingName)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -522,34 +2228,25 @@ func (siw *ServerInterfaceWrapper) PutApiV1ControlplanesControlPlaneNameClusters
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(w http.ResponseWriter, r *http.Request) {
+// PutApiV1ProjectRolebindingsRoleBindingName operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1ProjectRolebindingsRoleBindingName(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "controlPlaneName" -------------
-	var controlPlaneName ControlPlaneNameParameter
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "controlPlaneName", runtime.ParamLocationPath, chi.URLParam(r, "controlPlaneName"), &controlPlaneName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "controlPlaneName", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "clusterName" -------------
-	var clusterName ClusterNameParameter
+	// ------------- Path parameter "roleBindingName" -------------
+	var roleBindingName RoleBindingNameParameter
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "clusterName", runtime.ParamLocationPath, chi.URLParam(r, "clusterName"), &clusterName)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "roleBindingName", runtime.ParamLocationPath, chi.URLParam(r, "roleBindingName"), &roleBindingName)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clusterName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roleBindingName", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project", "project:members"})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(w, r, controlPlaneName, clusterName)
+		siw.Handler.PutApiV1ProjectRolebindingsRoleBindingName(w, r, roleBindingName)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -559,14 +2256,14 @@ func (siw *ServerInterfaceWrapper) GetApiV1ControlplanesControlPlaneNameClusters
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteApiV1Project operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiV1Project(w http.ResponseWriter, r *http.Request) {
+// GetApiV1Providers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Providers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{""})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteApiV1Project(w, r)
+		siw.Handler.GetApiV1Providers(w, r)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -576,14 +2273,14 @@ func (siw *ServerInterfaceWrapper) DeleteApiV1Project(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostApiV1Project operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1Project(w http.ResponseWriter, r *http.Request) {
+// GetApiV1ProvidersOpenstackApplicationCredentialRoles operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ProvidersOpenstackApplicationCredentialRoles(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiV1Project(w, r)
+		siw.Handler.GetApiV1ProvidersOpenstackApplicationCredentialRoles(w, r)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -661,6 +2358,51 @@ func (siw *ServerInterfaceWrapper) GetApiV1ProvidersOpenstackFlavors(w http.Resp
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "flavorName" -------------
+	var flavorName FlavorNameParameter
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "flavorName", runtime.ParamLocationPath, chi.URLParam(r, "flavorName"), &flavorName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flavorName", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages(w, r, flavorName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetApiV1ProvidersOpenstackHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ProvidersOpenstackHealth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ProvidersOpenstackHealth(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetApiV1ProvidersOpenstackImages operation middleware
 func (siw *ServerInterfaceWrapper) GetApiV1ProvidersOpenstackImages(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -712,6 +2454,23 @@ func (siw *ServerInterfaceWrapper) GetApiV1ProvidersOpenstackProjects(w http.Res
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetApiV1ProvidersOpenstackQuotas operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ProvidersOpenstackQuotas(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2AuthenticationScopes, []string{"project"})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ProvidersOpenstackQuotas(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -825,6 +2584,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/activity", wrapper.GetApiV1Activity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/debug/captures/{captureID}", wrapper.GetApiV1AdminDebugCapturesCaptureID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/deprecations", wrapper.GetApiV1AdminDeprecations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/export", wrapper.GetApiV1AdminExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/monitor/shards", wrapper.GetApiV1AdminMonitorShards)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/admin/monitor/shards", wrapper.PutApiV1AdminMonitorShards)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/applicationbundles/cluster", wrapper.GetApiV1ApplicationbundlesCluster)
 	})
@@ -849,6 +2626,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/auth/tokens/token", wrapper.PostApiV1AuthTokensToken)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/changelog", wrapper.GetApiV1Changelog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/changelog/{changelogVersion}/acknowledge", wrapper.PostApiV1ChangelogChangelogVersionAcknowledge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/clustertemplates", wrapper.GetApiV1Clustertemplates)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/controlplanes", wrapper.GetApiV1Controlplanes)
 	})
@@ -879,15 +2665,138 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}", wrapper.PutApiV1ControlplanesControlPlaneNameClustersClusterName)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/certificate", wrapper.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/certificate", wrapper.PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/cost", wrapper.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/credentials/rotate", wrapper.PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/health", wrapper.GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/hibernate", wrapper.PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/kubeconfig", wrapper.GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/metrics-summary", wrapper.GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/nodes/allowlist", wrapper.GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/nodes/allowlist", wrapper.PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/resume", wrapper.PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/ssh/certificate", wrapper.PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/timings", wrapper.GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/upgrade", wrapper.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/upgrades/freeze", wrapper.DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/upgrades/freeze", wrapper.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/upgrades/{upgradeID}/approve", wrapper.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/workloadpools", wrapper.PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/clusters/{clusterName}/workloadpools/{workloadPoolName}/bootstrap", wrapper.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/move", wrapper.PostApiV1ControlplanesControlPlaneNameMove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/resize", wrapper.PostApiV1ControlplanesControlPlaneNameResize)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/controlplanes/{controlPlaneName}/upgrades/{upgradeID}/approve", wrapper.PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/environments", wrapper.PostApiV1Environments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/environments/{environmentName}", wrapper.DeleteApiV1EnvironmentsEnvironmentName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/environments/{environmentName}", wrapper.GetApiV1EnvironmentsEnvironmentName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/export", wrapper.GetApiV1Export)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/import", wrapper.PostApiV1Import)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/kubernetesversions", wrapper.GetApiV1Kubernetesversions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/api/v1/project", wrapper.DeleteApiV1Project)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/project", wrapper.PostApiV1Project)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/project/members", wrapper.GetApiV1ProjectMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/project/members", wrapper.PostApiV1ProjectMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/project/members/{userID}", wrapper.DeleteApiV1ProjectMembersUserID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/project/members/{userID}", wrapper.PutApiV1ProjectMembersUserID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/project/offboarding", wrapper.GetApiV1ProjectOffboarding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/project/offboarding", wrapper.PostApiV1ProjectOffboarding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/project/offboarding/confirm", wrapper.PostApiV1ProjectOffboardingConfirm)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/project/rolebindings", wrapper.GetApiV1ProjectRolebindings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/project/rolebindings", wrapper.PostApiV1ProjectRolebindings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/project/rolebindings/{roleBindingName}", wrapper.DeleteApiV1ProjectRolebindingsRoleBindingName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/project/rolebindings/{roleBindingName}", wrapper.GetApiV1ProjectRolebindingsRoleBindingName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/project/rolebindings/{roleBindingName}", wrapper.PutApiV1ProjectRolebindingsRoleBindingName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/providers", wrapper.GetApiV1Providers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/providers/openstack/application-credential-roles", wrapper.GetApiV1ProvidersOpenstackApplicationCredentialRoles)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/providers/openstack/availability-zones/block-storage", wrapper.GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage)
 	})
@@ -900,6 +2809,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/providers/openstack/flavors", wrapper.GetApiV1ProvidersOpenstackFlavors)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/providers/openstack/flavors/{flavorName}/compatible-images", wrapper.GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/providers/openstack/health", wrapper.GetApiV1ProvidersOpenstackHealth)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/providers/openstack/images", wrapper.GetApiV1ProvidersOpenstackImages)
 	})
@@ -909,6 +2824,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/providers/openstack/projects", wrapper.GetApiV1ProvidersOpenstackProjects)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/providers/openstack/quotas", wrapper.GetApiV1ProvidersOpenstackQuotas)
+	})
 
 	return r
 }
@@ -18,216 +18,624 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9a3PivrIv/FVcPE/VOqc2MFyTMFX7BYGQQLC5Q8hiKiVsAQJb9lg2YKbmu5+S5DuG",
-	"QCZrrf9/79S8GAK6tlqt7lb3T79Ssq4ZOobYIqnvv1IGMIEGLWiyv2TVJhY0JaDBrvcD/V6BRDaRYSEd",
-	"p76nhisouCUFDDSYFUSbWMIcCkDYAhUpQl0aCLKOLYAwwktBx6ojqPoOmoIMCBTkFTCBTDtNzzC2tTk0",
-	"iaCbwsoxVhCTtEAsYFoCwIoAsSLskLUSQFCLFuW10qwM7dgSNJ1YM3xTDLUuICyoEC+tVTaVTiE6dgNY",
-	"q1Q6RYed+h6ebyqdMuFPG5lQSX23TBumU0ReQQ3Q+f//Jlykvqf+v28B8b7xX8m3jT2HJoYWJFGy/f6d",
-	"TlEamLraVQGGlxCVFxcMWp6RNi2ghWAd/aTokAhYtwS4R8RK0xJYQJagAUeYwxlGmqEiGVmqI8gmBBZU",
-	"0sJCNwW4B5qh0nXy1g8Rr4QAlgBhYtEfw53NsLUCVqzLv/GSx5bkX7Duv3mTkFj3uoIg31mMyLVQ531e",
-	"hP2oYwti9hEYdOUAZYpva0I541fKXbXYz/c2VviX0Zll2Kpl8tlcNpdKp7bQJJzD8tl8Npf67VNCgQtg",
-	"qxb95rIZh0nHpxll4VqER10SCIGEyR7Rmm4RRphnn5g1zpifTp1guTIu7yeSKMdJFJnq91+phQq2OpcT",
-	"31PLbCFLLIAVYCqUyTSwhO5PUN5kCsXcbb6UKc3h4g7M82zSbFwk9b0Y7m2bzxZuswXa3wICyzY5qwDb",
-	"0okMVISXPpWi8opyM7R2urlhcgQzDiTQ3DIx/s/UXZb9S6XZp1K2lPqRTmFdgV0TLtCeTrRSyOZv7uh0",
-	"v+VvUumUoSvBj7ks+/eNtkCbRXKo5i2tySuyoesGxMQC8oavlWbYFqxuAVLBHKnIcl51SsIU1rcglU7B",
-	"vQVNDFSJj79Zp7OqKPlibi5nirm8kimV5VymUizcZcBN5aYEFjfl8m2FLpOu2trJpn+nU7RBVQdKV9dV",
-	"SocYKX+lNLBHmq31w8uhIRz9Lvc7ndKAvEJ85RVE2MwIOsDU9zL9NcYMpewKLVca1LIgn8tl88tsPrec",
-	"fxJjxPfqj9/Xyyd3SyVt2WDf+SfChfvW0jcQv79L95ndbpdZ6KaWsU0VYllXaCORbSurCGLrDSmUTuU7",
-	"pVTJwcxNYXGXKVVAMTO/VXKZeWUO5zf5sgLmlLS0GVraaa3mjzLqoFajl+s326PxsIl2aFrsl5trHQ1U",
-	"ZUT/fp2U1/Tv3rCZlzZKfThokqY23gGneQOdlqk8bXgbDv1echTUvGmqVUsaNve0Pqw1b5qbBpJz5dUo",
-	"f+9Mi9Nyf9wiE61hdp7Gdbkwzg0LjQIYtkrzQd4CL43uZD3e9rSG1C8Ylpwr1+YoVwIPd6XeqFKfP/YL",
-	"nbFYVOqqowzvH+b1FZgfGg/ycLXvPIjlycjITR5bC5CbonatxebSm4yK40G+Lm8sMi32W52X6UHM9clw",
-	"0iCD3Ov966YylWv5HhxXDq+5aXm4VgDIlaXepl/vb8bP81zD7Dv5xhCvhvKhWRAfyhrUlqUBbuEBvu/P",
-	"R43G5Gm1fc0Z+uTJKEwnr2Jv0Kq0ay0TTHqog5r716dVUS5Unkfq60NP2w+n2n470Cp0Hq3hprVTHlvD",
-	"eSH/MlLvX+VNuQ0nUqM3rvQpDZUndeevCc5ls7bZ1+b7p8LbHN+1RRVkp7scKP4k1pNYfcZ7sNs0p9h6",
-	"kred2hrs14ftON9StamYKdSG81oeFcZWlUjNZ72jNlrlm6eClLszxGmlY7wWZHtTe+rm73t78iwSuZQf",
-	"79Tm63S7bpiHSfMB1vVGpdDQjFr/cXKw7J28up8ot92H3tRYwFajVbiHSyA/rmDv56L/8lIs96W6k3nt",
-	"yCVlsrG3DXN81xzY1bvM7ZsMb59AoTww+/agD8zhQny7b1fzdr361q1UJ+sVcR6fO8+FxsYG9VHuRXtR",
-	"25P64UZ5Vp6dSr9l9d/waCQTdW2BptZ6WUtSt6q1fuZzuFXO5R+e35o3YuW+OOyPzJ9A7dxrpQ25zWy1",
-	"xttSfsgT0NkWqjJ6qHQL9+JGvimWN6BerJWfVGcyrJQHG+Wm9tbYGca6N9pOR9Occ/vwsyAZeLzYvJTs",
-	"QVe7W4zqpbk5WD9O8JMoPdwdSmLhrauKpefBaxXBdl8Tq+tpeT+5e5m+2bUXs4znmbuBVn3rZtR1bdzp",
-	"dqsv9ZeHPSjsB/t5tbU1pz8n0H4sNLfVTS0H5jeGvlZ/jrRNf7LtvJQt/NID2/K2U/jZqS5r09Fq0Jy8",
-	"HHKZ6d1KPvRHg2V96PS0csUZ3e5/jn/WkLOrrZYvaqdYeN6tVthctPeSaor3pfJLRz2sWt28XKzXlrev",
-	"k9t55613W83dPa635st+qN0uR3UzsybKpLIaDpDU6tlvb4eB2OiOx9LwJz7kxXqjCW2Cbh5bqDKu5apv",
-	"uv1ClJUsPeObNWzWxxUFi/uavJ73huWfpPbwU8+M5Nrj9in3tiuB2spQFXF59/TYhaPB6wrcD9p5B5O3",
-	"Zq5WqVbrDVhRtBfpZld7urfvWjUnMyw1dPjSV8eD57H9WHhsoTuyOFQbjdUNel71XvZPWvlZqr4h3bxv",
-	"jR86g5ei0r557oxeFgq5XwwPyyIQ9QfHKMxbFQkA2XrUGk7rVazAG3E/uBvtl9LN8xO8fVRsOSc9Npx7",
-	"0y7WVPFn4f4grzr7+aHee9NReaoP7H3bWD6qxT1qLSRcU382hj9fxNZt2R5scm+dzfNyqz1BUOk99gEg",
-	"+/JLtT0wgPEmb2qvW2m6fnzTX1elXCnzPFwboIBaywdJPsDRsNAorX+WK2atVh01XscLxy7+tO6rsKXB",
-	"0ni5wvPhFjSHrbnRgPcjZ7CcPsv2Yy9rb3viGqkjdNeSFecRFttzYC1dof+2hSZaIGpBpV4nvZz42Fq/",
-	"Pk4dabjavNanjljo7aRDz+kMpznpUcy9Tl7X4mFUfl33NbG+Obyuxxup3tpI6/FKWlf3r/Xp4XU43kwP",
-	"05yoSevXnp5Kp5YmwNab5Rj0kAG2tdJNdGAH2hs7eeh5qCATytabbaLU99TKsgzy/ds391TLyrr2TacV",
-	"C99koKpzqh5dfHKHj9YOO6lJ0tndqdL2BVbaO7XT1AYjtmoxo9GEKtwCbAluUWo5dZr1mkAMKKOFe0YT",
-	"ZhoubNNaQVNQoAWQeubMH8i68THjxTD1NZRZSXbW35RABZaKt3klr5Tu8gqoVBaFRSV3m7/LzUsQMBP6",
-	"CpKxkSVSyoB4QFVUgS4JxJY7SIHIukGtVZd6WWG4QkQAqqrviABwuDhUBJtAU7B0ARFiQwFogssZhDfG",
-	"F4I2CRVaDPhkFtyZZ4Uu/+B3jIjgUVmYO8ygFardJrWBDR1hK2kdmHlJDB0T116QZWhYUOm7Xyb7Fjy1",
-	"bgWIMIcQC141xhU7pKrUqF7Y6gKpKv2WOFhemTrWbaI62Rme6jbzLxi6qrrcRXTblCFrQNMxsnRTQBah",
-	"prxlc66iS6VCOows5f8jAy085ksZ6Z/X2nRFatP9uJSVjsaYuPmqgoqIJegLIVRemPMK8bl+cJbRHrum",
-	"vkUK5HypMivKQlu6DLwVqAjE0k2whJTdaFFT4J4bRCwTzW2q5HslgGzqhMwwXcdjGyArCA3XIBWobZMB",
-	"ntFlOWkBYdmEGsQWUAWCgUFWukW4VwbIG9sg6RlWEAGuNSHrW2g63G1DVoBy+gKpUNB0G1tE+D8mBMq3",
-	"nYksKGgAO/+XcryiyzbrwZ27J15VHS9XuomzSP+WSqdWtgZwHwIFzFXP0Gq7Raj9JXPCPUmFV+feeK3n",
-	"0PCxUX59aS3EQXP5+tjITQd5ezrJq91BS5y+qKqMqvsmui/NJ3tbPuQQeOrn5Lq+bReVouKUi6JT3sqa",
-	"vBXX1Z1YqxwUTUbNp1fj9UWpzYvLSnNdXYq16r4z7NnielQQh5ulOByV2+tqqTN8cJrr0p3yqObmj6P/",
-	"AhNpO1/vtt7f3af7lfK4XL5qKpnXc6h5GGviupmb0rHSsQ83xfb6wenUH0inXrWldbPQmTzsxVppJ9Y3",
-	"RBxWbbFeLbfrVSLWdvv28MHuDEel9qC07wzFg6TtLGlQcjp1sSzVcvv2upqX6ptDu96zpWGvJA03RFzL",
-	"dme4PIjD8aozKJXFdc/pDHbl9nrjSPVm0HattBfXm1KHfl5Pd1K9Vwb1kS0Om4XpcGN3hpuy5LB65c5Q",
-	"pnV27foDaa8fCuKhWqJjkw6bonh4JdKgtOsMl3tpkHMkp1QW69OcmNuVO/T7+nTfri937XXvIB5Gud7w",
-	"YddeV3ed+sZp18Of3XHVE2g01lH7ULqTHxs5ULvXwGRPuoPmWppMHXHdXzXR/aY7aEniUD6019OyNJwS",
-	"8WHpiLVSXlpXi+LogX4uiOuHnTTYhT/v3H537Xpz16brXZ8Wx+uHQ6dWyovrZU6ahOqiXfizV9frpyA5",
-	"oc+55V46iLa03uQlzW+DiGs2p/1xv6N8exgeQ/C5x76fOmIwdrdulUTm3DAs0SnlpOGISPUHWxou9+1h",
-	"05aGVUrr4tSlvViferwWzGOQK7bXm4M0HOXa9aUtHkY7abgSKT+019WcNOzl23U5T3lOnIgWbUdySjup",
-	"Xi2KgxxtqyTRPVNf7sX6lP6+lxDlsYeiVNhZEiodJD6Hg1QrlaRhNd95YHTZietpntOh6kjrkc9rneGG",
-	"0o+OcS+ul3ZnOC2I67HeHnp86tYZLot+ffbZ3z+Uf4ud+sjhn6v5Tr0hSqytXk46jIh0oG1titJwRdrD",
-	"3r697u3E4dRpD5e2uJ4Wemdpttt3BqWCWJfzncEuT3mmU28Qn+bDMM0fDh6/888ev9NxySXp8MDWisoY",
-	"cdgg4qBEx0fb5fJhvTkMQ3tDonxUb5altUSk4dKWDqOydJhaItuX4l6q90Jt5Pw2eu+Ppyg5pT1dHwnt",
-	"cuKAzQk00d1/dbm8/K/a8r//O5VOqUiG7ExMVQ0gr2CmkM0JbfdL3/HlSfxMPlvO5jP54GjnHr7wOV/O",
-	"5lO/f3zopH/vjOfnnwrDpz0/5udAcZXhj5zyv1LQNHVqzCDM7lHeXD0tlea/vEWH5Glxc11xBLfK5YYF",
-	"t0geWI8J8+2HG18ARNVAXpXf8bA5pAXddNU/Xtq/GHJvf2YY+Aqiq9kuEFQVTi5ZxwsVyX9ILK+VE1QC",
-	"gW7KLpLoYAjQ+JWaAFSqcjj8Iot8IvXcLr3BEfcWC+vUsEoLNrGBqjqCRW0MDQJM6MAcYQW2MDrEbPwK",
-	"4mPU+pTLoqNGqralj4ylCRTa3rGLOp3i+r9/64V0PESsSCFXKGZyt5lifpjPfS+Vv5cKr6kzDXCdl44I",
-	"KlcYg+/dU1WjF5xH1CYf1Nf/jN65vxy9f3yE4O9I0gjluUhY6OYcKQrEfyYT/GZOCAVmvcsmVKhBD1Qi",
-	"KDoTW/7288WVYaItUuESkk8XrTtABAVixM39iP8g7QoGdoUvyMAmvBAdWqTgDHNPgzt4hJfR4TMPBLO+",
-	"ARaq3aYvsRkFqLjG/wimPcMYypAQYDqhiQs6ZlV8S9JQgbXQTY2tGML8Em/ArhzZpP9s7fjd5Rv/M3n5",
-	"3PPI0l0/i6wCpH3a+lSxYGO4N6BM7WjWv6DLsm2aUIkuDIiUtEyACYLYcusArMwwLUlsWYZQoXSkp5Fl",
-	"OlmhueAtIbYALKoDEJgWDBUCwgx53bQEZAmAGfnMzcTovd5tyMcIvIEO15Nkc0v3d6ZcoCrUhvnf8sp+",
-	"R/RWf1y/VwdzVW/pO6vSlO4Naz7QtUm/OzWlZ0d+qL71aB3LSX1PPdRSabqV6KKhZSqd2lPqPU6qc/v5",
-	"HuPczxeyvkOKMlm9rsuZ16FYapSUstmCz/O52nkcy5kybkmjPunObzcZcfXw06z0qqi8fsbKrbrRNk+j",
-	"goaBuiO97nMqnaJ9VqvQqKmTwZ2ot9u1w0+xV5irxefdoXELB9P2Sh6YZHO3mdp9IEmlsobHdo88lYq9",
-	"TrP9cF9+eQFPK2cw6C/HNaCJu9fJaFc1t/nNNbeplLYTOH+GzgBaySKuNehIwg7OhQ10BAI9byIiAqB/",
-	"UulHJa8iGPZcRTItRriHBph09RfQhFjmm562NcO0McbthLYFQxUFGWDKjUxIWLrAvOKO25q7Q6isIWiJ",
-	"PTGCyAy7t/mMq44uiJ/tOaTKC1pewGy6bEErQywTAo2eSwkESbhc5s3bJvBdgpvjyI/P1nX+xqEf6ZQM",
-	"TUsEGCyhmfq+ACqB6dQCqXDAPXn+dwgvTUiI/3cw6Togq7lOB+z9hrdIQaBjQBNYetCsYeoatFbQ9lr5",
-	"Cjy5LPDkCgWs/JpKIGqyAvYV0fKBiJYksZMsaP4Vav6XqPkSNV+i5q8ran58WNa8Y9ceCx1u3GLdaug2",
-	"Vv7MPsK69bagzZwwjkL+OKgEzq9ozPanGUsjzFyhli4sEFaEwNuWjeyVe1WXN67siHM0+bOLX74bLl5M",
-	"f0hHwzi/qKG4gVBF4aB7rgu/4VqyTPiUaab9v7udeiYf/6LwlyLEQ1T2fZQAzD69UGa6tGgypwQ1zq4n",
-	"R3zUl1LDk/SCe1TFiNFgsu6jNJANKqdLaVeKFnLp1JJ9lU9z+lTAnXxTvM1lSrmbcqaklECmooBc5vbm",
-	"9k5ZlHKyUqECQ4Oabjqp78WCT6uTcvcDtHMneSnJuPyPEapJhf2H6cTzV/wzsJApFIb5wvdc6Xu+SM9A",
-	"RixwU1pUCjeVTPEG5jKlYr6Qmd8p+Uy5oFSKSvmmMr+lx46mK2iBElrLl7/n70Inqj23C4VcKUOPm3L2",
-	"JrM07Ey5UM7elbO5cuZWhkopXy5Frqt+hTQl96AqZ6liwpWkuom2LK7Nb+YaH2yMlpcuBztmQ24I2jKw",
-	"EJXv7tUJIlHnn9/RM3S6AJl/KOM0J0PIKrOBzkeYzxvDpdPdQEcwaIXoVNywLPIpgTqi48V7ebxXKPJA",
-	"t1wlFOgGQBDolg6o8ebV/QA1vGlcSg23K04MN+rwQ84XWYaEvLEWvqLyv6Lyv6Lyv6Lyv6Ly/5dE5cO9",
-	"gUxI3hBOfS/e5HL0zEs8CkaH0V5ErUqWfqk0Kvr0RdKp7FEeW0+S2niCm/Lk9aG8kNevN9Pcw6GvNpze",
-	"QVUlbdydj4yuVFTNwbpBho37vTRq5frsvGjkX2vNm4nTLE+H8r4zGe1fB/nVdLjMt4f9lbh+sKbDpiMO",
-	"cgdx3Velw7L4OnndSIclehnQMyi/ApMdHeDPeWFlt7X+9nV0r84nDWNeK6/nhRyV9Sp8qqLO+qHQGT7k",
-	"pYNYkg4PpKmpK6XWvBGH07I47JWkQ68oDnYIvEgHOi8WPPok3rSdiqlMWqqslVXlcXxoa+PDtLBSZU0i",
-	"8+J409ak7ZzOBd8b02I/L2sjOh5deerv5IMffIplrVGYvvRXMmLj2k5fXlfKY8NpH1aapI3K0rpZlB5F",
-	"ZzppadL6oTgdiuVOXVGlQ1/tTEZFaaioVObLxTFi49Mq+hyVN/PCuOrSwZ4WKhY9B6rT/UCv7jb28+Le",
-	"MMp6nhha1fl5WG0G/dub1XzdyHdqz7CE2oOb+1q34gxep3Cc2dzXlJxVlJWb8X7eKTfGvVa3b91tcj/v",
-	"7ky5kG9Vh874bjOQJWxm8uuGVm3ZL52bJcgV8s/Dfg8/3tzV7w6vUqW908RBf1V86jaszs9SuyZrvYdB",
-	"ASiw5RD9sVK50zTLHu6M0qJq7qgKxXjOS9q4h8CE5pU5F4l6UzRjgF2q2UzfWdgqC2o2oWWb2M8XiCUE",
-	"8Js7L2CfXw7rrHEWDISwrNoKu1ZmmRmIhSdYjnuHx7L/geXe6dPOfTcPU9ps7GWnwD90Mbk6HA9OOBVX",
-	"FaUFv5L/vDv4pNa92AU+PJcqK0AELnYoFfz+SWy6x4kQVRwO4MummCPagKblZulHSscrj6E51wkUQt9S",
-	"dXpH14cNMZQI4MZNsPQNlyOJZSK8pNSKRbfH+6mHfxZUhDcsmCPWBW2Z2mTAosaoiZI6SoiPj3f2RIsI",
-	"plsmMgceiZbQLI+rP6KtMAcE3pQEN8lXGIwfBVo0K/CLcLLSbVURdrq5ERAW5rq1ElS0XHHUBwWYGzpH",
-	"jRPNn9rcsWDSIPzw0aRkF/dHwcYKNIXdCsmroyViqTcs8kJJnCVOpNcIo5/2hXSywJJcEYM6pMV/Rx0G",
-	"F1Yde1U87AmeLvRPPokkRohuvjhPBuR1Vzs0qh/+TPU5N1TTyVdiR+zBfomlzBA3SoKuN4uHpFyECC3l",
-	"u7G9rrOCm5AjaMDcQGWGAREME24R3HnchXWGSkKgygN05o7guv/TPriIvhBUtIBetk606gx7MRVgqyNF",
-	"sEPxUTYPwyMslAcyL7iSpkJf14CFZP93nk7F4ocEtJhhIGC4g6Y3EUYCjxw8CpVLecS99Qh7s8oKkxXE",
-	"fuF/EHf8M8wm4KpeaZ9Ubs+M7Ze6AChZoQwVb2S05BKYdNaEyy5oraA5w0dzoGNxZ8hDyYLl0E06ymPh",
-	"CbHSWbTRImHx2SzY4vJJs8aVjL7I0HlE9rsCLJixkAYv35PDcDLayd3okjRxcCzzMTq+0CIErc11XYUA",
-	"h3Zp8mjcZtwyCcNJ3qZemxdtsWhcaBLB3fRDyqN8KQnjVX+JTySvCVVVjXMU3Rc+jzDFxW1E8QGKbNOE",
-	"2FKd0N4LL7a36xIOXeCQzmIC4eZdiRdMuR5UosR8n1wkSSSdzeFLp5AFNXJ1vmAqGA8wTeDEhlOHBsQK",
-	"xDJKHpMbKhaWkVw6eDmaCBMLsBzNOaT7m/toY6rBtUP3R+VcOnznPfVKUPyix4t+eidfcLQm7Z539kwf",
-	"yrqmQayco7npFYJKZBiM/G78Z0B9sLCY1Pg3En/oahQnxk8VDp7MjVQLUlrF8lVCQzu7chZYJms0p4c2",
-	"PiUPY02HZGJc947ui2tph3i4thlZ6AsbCXHHe6I9VOsfRHiCqsbQx6zLhf2FUn4cUgPflxGBkvQB/vPW",
-	"7vwKvydBE9nswhEkdp0o7Y/NJeAwXYYeQjsIN0zNowqEsENY0Xeu9DSgqSFL0FkkEheqOt3PBjSp7kEP",
-	"sgSmXJhIAc67zgOkwQnrjI5b0/HVdQiwbPP6Wvb1PVkr2yTX17Lh9ZV2UMFXV0s6zONxcu+k88QXMdE6",
-	"ufpIf08Bu6rBcN2zei3LWHO5OwizSRDNQWDYZWFNXrragNc7oZEeU+LHO+tzVkrEs38uFBTRhK5jSbHS",
-	"bTPx4KE/eNRTgEOV+tGwRvt1o9lS3wtBGFvqe85vG2ELLrkDMQj9P+4jHPOfFQYQRnFXWpPngRAxrU9h",
-	"rZw4lyPtpxJIf/RFNFHhbIOhJAUFWECgbVFtXYEL5GYNABzEuBRNRTCAaTmCF5ZKZpiem8iyIMwKtSTk",
-	"mYsmH92uPGfl12WsEVqcI8ZIIs9xDPERiZLSFtxYyxgoXlzMoKujCqvd5kn/yV9KQsVF8EXxCCKPBO3q",
-	"uhoPP76KSh4YyWlRWQvDESd6DoJY4qu6diPCjoKAr2rEj7j4DEF9FKp75WAmkdoXy/3w/ANyxhgjPrYf",
-	"l+xAugfObcJqt0kllYXwMmnXqaq+g25gd9LpM3B1ZUUxIWH+QlaQefho3eBqQYih4VS7zfMmU7O7LQm1",
-	"Zr0faz2RAzWEm7yl/PEJRmxGn2qA68PC3U/OBus440lh4SVbzlWEQVXik1IUby6UcjIlFYP6gucn47dy",
-	"7egvErPVaDD5BaliHicJhq6rQigYPZZEJnh7P1RkhjWbWAJQCdPxPUcm4k5IrwdPHh0z1VGse5Jq5hZy",
-	"cam5/4CX93krgMlesiBFhsjEB+HqHCFih1SOo7j6xP55ocv6Zx7foHM+9KTOY/IgPpL0EW0u2uONkOg/",
-	"Ycx6l6KMgamu4CFQeZdffjLPkQg4x1sPPFidlsm4hZIdupHslxOt0DIZjRdKbiWSL3OilW5n0HzhIFhz",
-	"QKBCbVCCiAWx5QN0/R8Px+r/Jvfj5+Ccmi8W3CKe0q2eGnJi+s6JZmMSUvEqZAWhz7mG+P1SOzxEVNfe",
-	"dvdi8lDi2ULxUTS5840NQxo3682q4BdOai+cZnRqMfwiSUO6SLZJoTylGG9vjuWae3SeOdLiyU6n7am6",
-	"NOAWIi9LSWyTs3Ler+LWYEeYe3pd5PILp1jFW3cJ4Z6CdDSGl2Qk0HqUN45fDmAH6FzV5U3yvU2QtXVN",
-	"f4aufKi7WC7YNV26VT/QbVwJC2gcH1CYHuk4p1wkigNl9CrLJxQ1fMYGOpkbd6Sw84LH+SQubGTIT8Av",
-	"bqPqgHunm7iCCel3v04CgsYzOIRmPZktyOoZOskxFEFrg8GT8AwdBhLqiiod06NYcJPikvfYqay/owgU",
-	"Vu7zaRZjv1OLeHKgSTS/iBfDtshptfCkVviuKnCdLR6qS63pd5djEtVQ46uSFTpbaJoMszOsdp7jXRXM",
-	"IbfpgKIgrhB1T4coUe2J1aVCxk62fM+NeQMdXlPgHTPF0TBUR3Altr//E51PoUzPj/gDkk356AivuAEM",
-	"xnM17509ZN+zTS73Y57n//dsxISU1KtG/QfjTFIETr1/c966+9P3ooTPfDtIOPN0kAb2bfZH6vsNdxF7",
-	"f+YTNtlJd815avhRK9wplHCeRpK8k6xAdtMVgWPeAf9Np8sjaxTIYZqv7YjVu6ajT73qSHhCi4eheBjU",
-	"8eaEJgP/UjmsllvIC6yYpUZ4g/UdnqUEG1tI5fjI/nxZxKCsY5kFprrQXL6nO2RmCU2LP8nFWuYYUW5E",
-	"8AzPgsx7hJezVAR/myMT0uPcJlDYuc96ySuAl9xlNQvn7c9SPLqYz2OGWSvM6RHpk43zqFt38orN4wSw",
-	"YBt04ViLMxwmDe+e916HRrSZHQ9Q43zgg0IiIswhbdcwdRkSApXsDDd5EAUbYLhNFhE8SwloEUPXiuJw",
-	"BUN1gmvc7Ayz6j4+l4/IdfGpEdljPnclnSHhAOYj7nuEGJpIdgetQULAMuEyEibXrgpUBEG3tnuow70B",
-	"sMIlIlvEp+Gw6xaRdQVmBXfuwPRcCW5BF/A/gvOfFuY2T/Lk7UJXVNLxmQhawHQ8ppCZsUbZsNptEkF3",
-	"4+OY90gnMIgso7uA90VnCrGtUcIeY5mG49Tf+Hs/qfRRzLmNiW0YumlBWpdHs/OA/rTfJouEd82iEGSc",
-	"BTVDN4GJVOfNxj5ea6ii36v3BXvkIdZr6OGHdAR1IYQ4qkFrpStv9FfX+RxrRIMKAl4jASzhjyRb5TjK",
-	"/lTYuctRbvj53IP9Yy28z+unofUSGf0UTkCi5/gMOsBVgV9HZs0fhn+dQTs4ozidxzq4UIM6TcAETeoU",
-	"DME7xI6brse0RspnmL74lNFLkpu5bNWQ4mU9n126I2SGi1YuAZfh2oWLr8W5deMQCO8sFwc+SFDxjFO6",
-	"TODYr3VHJPmmwEPYSdhO7IEFWhsaK6hBE6gCLU3V3Mf75NaWF4zlsTsi7FlSrFvMd08PB8jOFezu+OOG",
-	"kziRNmvzdApOm1MM6AFYnJ8lL8Vmh05M77TscQdwLeum+er5Q3TX4yxHe3gZFzGyj5ZxLfu6LHmOaxlQ",
-	"RBLTKnF8iBN2CUxaVBZ454X7s9oRg0Tg8BSekcbzInwnI7MxeDrGDM+hsABb3aaqn76lzKcq0PQQK4CL",
-	"ns01VG47uiosv3pY8JQLW8XQ5AIYxVKLztop73Asn9kphvVBRC4ljwoINUl5tc8wpnjTJ8MhtieDS9n6",
-	"+JkqGrSAAiyQEKUTgjJJGkDo5oNADWALyX6IbDQhzIMx5Q9qqQ6nD1cxHXaZG/ZlRCLIjr2czIoPnjeO",
-	"KMDJp1sEeyVR9LESgsKKXB7mGyJQrJdj8XBOwLg7LcRV7yRjxZFgLhI0V+LAXCuOuKw5J41cJJd3DtHB",
-	"4MnHcLlGt/TqfJpK6QPPXETdEOzMtZTz6HKOdmG/6mXBFa6nNOHu0dUnLhobv49IxVD9TmucsVP2HZ9a",
-	"CAHwdJNROfdOi+bJeArJV28SLmpCGsTJ1IAQjX0pFxNyCqTbXxEWpq6FxD9/k4K4O9B14syhYJiQSjXP",
-	"SnePOGgSNx/Pf1wuoev3SBFjdzOI7vAmGCZ/ZHnP7opu8MLfmU3svYZ3Xfp1lc4uKQHbxan3mrz2JHer",
-	"Xmf9xP2Vp/v/mNnjIzldJF4CHKdrpYu3YOeki7vLz68pvxlMuJD78KXmR67POM7oUYYItXroT2eMg9gy",
-	"sYaSViiUqpDkwwvSTtycPRMYVCVxnbAY7i0eiL7w32tITKR9bwFZxDt3hZvWZYXjM2Q106yzxIlyBJcj",
-	"yQsij44SdyViix7BA0vaO7oBWBp9CNXhxF1+AC5z8lICYYFAWccKZxQX/Z6KUaZWLyL+sYhRemqIVReN",
-	"o1k/M7Yw3sgRrAJjgBhsBfJzjkxIILagH6gZ3AMxr+b7YiTUdzpK7gjNTi5s7HXZo/Hr4WX2HiOlw9Ix",
-	"7CwY6F10ycMv5v7yHcEJT+f+OI42YA/qBo/Axx7Xjb/7++N3+rLODUDITjeV4y5tAk1XvQ4V+nFk5Abv",
-	"0h/Hm7NHP5r1rBf3pgRPTc3YiGcpgY2LpVVQ0mFb5T5pF277iKH4o/a/EmBRfBq6bv/P7TP0pvKJedJS",
-	"glfqM7uPrlws1NlzqIcbFfy7Y4jYrYTfs04/e8s5SyXHknmrfdSZ/3qXvsPQFLyCyXMNerl2vtFno09Q",
-	"2yskjPrNzyS2z/bvzd4r+Lmzj23C0NKfFFP8Recz6n/ozeXjYyj03vRlb0f72lBsqF5D58cZUn6Tg3dc",
-	"7PvzczFCL0TDa136Z3XZY0000RFBoGybyHIGMgPjod3x0yCKz5Q4DH4n66k2xLuGnDMwLneCURAp5utS",
-	"9Z0XvRWIuporDSNfjkw19Bxw4GLJQrqapqzqtsIeXwcG+rbN87Ul3wKWTaVTjLJRBkkNvQM69Hx3gsni",
-	"L/gHx8H+m6XiouivMKJQpDNbbY7QhfBCT9a8QlbngEeosnQTD/gqCIblLiX+pHo4doD57hiMjezIKkNG",
-	"w2DJHpY+Fb/Egh1oL4gIqr50kUjYlmZ334sYc/nvHZG077sKnjTzDWjaDFPNltAK3mrydTJKFncaMsDM",
-	"L82CO7wH2sCcWCaQrSSSBDAqlu76wPlz6WyuoRozHMzSVc6IwJJYXEeoe/8vtgUX242Na4ZXECiuxogs",
-	"KntTySsTeYEkly3wF0iokcfyFlPFbC5bZAqRtWKs6DFKKBXMhUb5Jp9KnfRTcY7wVHx+oCNdJmWmthkO",
-	"0lLV50BNAmThQa4+kbzHtQK8JlerZqFfOp21vvBREVjlMPdleUIbF1hNhcVvWFUDjfPVo/nW/MczIu/u",
-	"F3K5U0eLX+7b6Rfvf6dTpUtaSHhgllXNv181ER3wdzpVvqTfc4/8hc8JZgwknxD//MFe99lnIlnAmaWp",
-	"20bqe0oDiGVpnOO0s5n/tfBlwL+Q6aJZ6/9W1otmV37x37+J/8hlsu1C/opgOQVvSXNnQAAoRM27UKju",
-	"uzxC/pQjvnjhNC/Y1urberch70MoRPwjiVzQDyHEmiZwqHgIPwIZe8WxNRm6zz7yN0CpljHD7AaO+4fS",
-	"TKa4IK4BZKxuJgHQnuMl21q16Bw/wkeRR0k/ayH3GaxnvNXMuHYEXS3CjcozK0infrSCnBe+RWyIpDtv",
-	"Q+W9eBZLlI7MVD29ybuezhvDx6WKXrQhQATDRRpMitDMzjA7WgxgWki2VWAKyBtazLICgReAwXZ4JOYh",
-	"7t3n2kN2hqe6zcJiwyrkjOl8iFrvHFQYYUE3FZ48yB4Fds2MZl2o6RhD2ZrhGCoxRzwOInKZewjueUzv",
-	"eXbr+JszWI8Y9xVzhSTfuu8VcX2mQQyqPzpftR/1m9k/FGp/ZXbm+/oSPj5aGUMn73FwwK6sNjU8w15s",
-	"r7VjZp7hCDeHfUbHjmDPe8SwVIO3yzhHMbDJ0FbiXB3lyjhUdhD8zuLoOYdmBYHuqZNuK/Y6L63jR+/y",
-	"29Xwgb1j8W024eNikn9u6jsCTQ9QII6Lreo7Yedd+yLNoNYhNVYjcnuGeQwUR9WECrVjNW4TYyhw37Mb",
-	"1G3puorwMi2s9B3ceqCDHC12hiOohURAlgD31CAmkdfOA0gITkysWzzPxo3EskyqeSgzHMD8HO2r463d",
-	"1Ul8bw85c3JnEyTWva44p3eSVwRB1xfhbkXuLL32TIq+YfN312o+XXogRf4mA1WdJ2b/hqQH29PNes0/",
-	"Vrgo8OqePAlPOIZcYRQ7y/zXCV32YokdXMbH9/+RapMVhKbFVGcIFJZ8smSBf1RYBUBVoYPLZ2HXevN0",
-	"Ns8zFaqVIARn2IqIlQSwGG+uLAvNFZGK9wp/VFS9c0IiRa55i3Tl0TjnfmQOZezKKL6/ccKssn9ZTg07",
-	"J88z6pHP3DWwk8+5GvPAER54mqQsh72tgYPQi+7grKPxC6gZlrnZFlagKIsjGVmq4x0y/OzhDcxSvtrH",
-	"VC+mIFL+m+EAhIgn2/C8m8UCmkGq2TG3vSOQuSgeuvfCH5PH7GrjtFDO/28Tyn9uasY53nUvGSfQE0Pc",
-	"HnFECSF/ue+HEGrR+FjXz2ytoAsZz3ztJ/zracFambq9XEXcVWkX6pt9tHTByxTNznC8M7oxTEh5Fsss",
-	"uZe7wGJoyq5vjjnUOdQg4QMk+sLaATMA/D+CjBSCJeNaTMKzAT5M+Qx7QOcLG8v8Og5ZDoOq4WN0Ex/h",
-	"nm/aWF8svotqlTMc2tauE5+pfYToMmK6Wyj+64wfKBa8TCWzq0G6YifUTPIBUYvwykdUpAhW519hV5Zy",
-	"pfcrHz2j/O/czsH9L9vXlxwtUU66YqF9+X280ldKb86oYQfyaSleuMBtKMvQsOJL959imcpFjM4yNv8K",
-	"LHOp1zFyFHz7Fd6rEtDgb852KrSSohzZ95QBo8zHwo1Pc6DrckKsIiAy4PnG/l2+n53uZniDIN2G2qwn",
-	"/dV8OMesXIvNKfX3Z8a/mfz6Ui/+x6kXj9C6euNfpmO8v12v1Dm+tuxHVA4fco1VSuo/KPItfmwE2Dws",
-	"xtVOYKCRlzj5B5qLfSn7fCkyf1tG/CxFxgshOnPPftHdOldH3LYi3Oo9QnX0NsQHhJ6Pt/UR4XcM2/XF",
-	"ef9pEXiJBedBvV3PU8k23Fmm+pBIfI5z1v8guVh8v7KPrPNlGoYl6rdf7qcLLcZQtnxYYwRXsfyl1p7H",
-	"9LVgiF8G4H/WALz4vH1k0dFJvPIvO3DPsslHzt4vfvlPHr3p9ysHC36x1RJiyo8c1vYf8OPXsf1lzpw5",
-	"fJn84a+V/IGdE5G7/yBCFA0n9BLKp8na52DYnyJ1g/a+uPRvKn8v3SlGGAHk/EVFCDcj7Ov0owZDIUBQ",
-	"YTmp//Cy4WY4IXYjK1x9kzHDoauMY2Spi243vLTPL+H9V7nH8Jgq8QbDQ1ZJC4CEHi133zyc4eNYG7du",
-	"muFiea56WjscFhsK1ee3De/Ggle7TRfEjnD0xKTQoxkOg+Vc4Lbw5u5nL166s2bY7T9pZ512cfzP4f6/",
-	"k9/AjbibM83znQC7BAnNIiFDKEDfwuA7GYZ0+409VZMhp56yChKRWUH/3apjEN9Lc6RU9QhOKAGB9wT/",
-	"CitAwkF08XsLD6cpEYQqWWnqenTqnIQRvqdT9177+oim5K9AuKWjbv5Xxc5dqKF7XJwJXqW8nsfdh27O",
-	"cbd88p2iD/H1yeb+Woztvs70ZzxdS35G6IudP4WdPYjrDD6JjH0G6vtDzHuEqn2GZwPliOe5fDrPHgGD",
-	"/xGvxlv74tHP4NHFKajrY4HoQoP+kVB1u+OhP++yJsvp+Jewpofw/Ucc6TbyxYifwYjoBBLyMQ9xTNY/",
-	"YsMwlPJ/kAtd9Oc/YkLexhcPfgYPbqCTMZIho4+Boj/GgT7M9EUHs8t2/JGrz+M7Hxn7jzjPa+WL9z6D",
-	"94yTcMIhJO1QqiTPpvsIC3o9nRV/zEWrQRdy+xrm8nGR/4i5vFa+ktLO8tSp4vwaIuEtlktcnhxLIySF",
-	"2CO5fs5jVhCqMQw3RKJvmyGs2MTij5VhBZiKl1RpmLqly7pK20hKp3R9vDxrH5FjuAIOvO67SdlraxHs",
-	"Qv70F8cCYEVcHOPWZBg6zyMQGXOHAWoEsCIxoACWkEwnhSwE1CjUgjuiGbZd52xa0CDAvHNgCY5u8zIY",
-	"csexTaCAGEIGAwkPoIb8bUkn5z1r6D5c6KNmVLtNPhrMWuboc+xcCGCVExNjZ3gOF7rpwR3T8S1skxFe",
-	"Zl9jJQF2jy13iuOz86RID209VT3mpGqck9h1VsIDkLRD5lvnid106FFkQVbCB+ZjOQ0yNCzbexbSfczI",
-	"IxmllvsoRihO389D4CscOO85aiO7CvDeK4wCXgrCxD13QXD9RfsMvbEURxxrcs5nyH97yzumj/OB0wLg",
-	"V1pBBDeXtQEBVODwt/ysAKtRs1ULZSyIKTsgoqsh0I0EiMJjGEf32eTQNUtC/odHVe/1Sl//iAWdszua",
-	"oH19EXAvu5SLp6CwZx8VHUM/00J1BN0Mp1XEkDNUYLlIXqYO5BWDk6S7bqHCPXvyhN/LJKX384QNhpNj",
-	"6YK80tlziLoGPVx6/p4yR31zdDvoGYUIDoQF4GBi7D1aOhoGJmfSKUATUcbytwZz4Ptbo+by9wn2D53E",
-	"R4CXEXzOQABHkTqZ4NgCE+k2mWG/EX/XhhAtvW3hocp6eereFozCVG2RSffYDLsvbLi4mpQC3GLKCpMV",
-	"UiGTPTLAlGn5nvTgi/w7LUoK4stpyvFeh8jyXmn1EFF8QblAJmE5OISuErt8TqIQEShL+gn9DBIUC7bB",
-	"cpOABf3nFxIIEchbFzaQ2JrhhWawtUw4Zv2VDZau6w2sGxpY6veP3/8vAAD//wcBMyy+4wAA",
+	"H4sIAAAAAAAC/+z9C2/buvIoin8Vwv8LrHP+x05t59GmwAWOm0ebNnbSPJv+vFDQEm0zkUhVpOw4C/3u",
+	"FxySEmVLtuxk7b0ewd7ASi0+hzPD4Tz/qHk8jDgjTIra+z9qEY5xSCSJ4V/Yk3RC5exqFpFz+0V98Inw",
+	"YhpJylntfe2MBTMUE5nEDJkulAjEh0iOiSBIziIithDq4hkaECQi4tEhJT4KeUyQHGOGOPPIVq1eo2q8",
+	"nwmJZ7V6jeGQ1N7XVPdavSa8MQmxmp1KEsL6/p+YDGvva/+/N9km3uhm4o279tqvuh7lfQ3HMZ7Vfv2q",
+	"1zwcySQmJ4dLdnY1Jsgng2SETGtEfcKkWn1cR1iYXRMfUaY2i741rhl94DFrHKpujQPdrXFy2GcxERFn",
+	"gqAxwT6J0+1GWI6z3abLqtVrMfmZ0Jj4tfcyTogLArMbIWPKRno7Y8xGJOCjGxILytmKXUUBlkMeh2ii",
+	"m5vdRDyWxEeDGcIoHRERJuNZ2Xrn5l132UEiJIl7OCQrVmxaIjXvFuomQipkwmiCA+qjw94l8jiTmDLK",
+	"RogrlAz4lMTIw4KovcTYU3hd7zOWhAMSC8RjNJ5FY8JEHQmJY4kw8xFhPppSOUY466Wa6l51aKMmlijk",
+	"QvbZ3rYzusKDgLCRHJeBK9vvUkgtQ+2HZEBiRiQRebA58LyhZLoEnp/4FEmOopgIwiRgrum4hdCHGfLJ",
+	"ECdB7gOiQgF4QgBBKJMcvnbOTxRmm5GwGn8LodsxYUgQqSaJ8RRaJswncTBTp+MlQvIQxUTwJPYIog4h",
+	"YdFnd53uad0cApshQbyYSNXGV1D260iOoQsAT8Do2A8pQ8LjUSkfmVAyzfERwpKw9v5/ajGe1n6vFyEn",
+	"Z5KyZBlmHpgmaBjzEE3HJFY4GcVkQnmi10iERAEZSsSHwy2ErtTaqV41j/DPhPSZnUghc0IyYAxm+cE0",
+	"A9E4qPoLHBI0pAGgXpgodHQZbBkk7HS1FbTJmYx5cB5gRqoQqG6uWAsjQKZ1RIdILnzyORGIcYnIIxVS",
+	"nSZhiEoUwv3QZzSMAupRGcyQFxMMJz7kMSKPOIwCBV8XJ3ULhEeYMiERzk/WZ3KM5dyUf2P2MXckfwoP",
+	"8ePZRbLsArlRMMOS6A0rnFX/UAdt8V1BgCdSn46CKGYzOaZslGcOCvOFND3N7WiOQcBJComIkDRU4ysU",
+	"MC2BbZRht15+IaWrAYtJnbAJjTkLCZMVUN1pbRBdGqrGgdCMUf2sRCAqRR4jS052bgF/ysEOAzzhVe7a",
+	"s4iwS4m9B6S76Eu3eOHZoGte/dQnYcQlYd7sC5ktWVEHJYz+TAh6ILM6GhFGYmykFH1BUcKAjWCZyWeA",
+	"P8AbLFJu9dkFkTHcQDiHqRkvfSAzTaHcn6EpDQJgGmYcjPxEcSYsSZ9ZLKwjxXYI1gyZx3REGQ4UkqoL",
+	"1L3Z7Ex9dmJ3LhsXJArwjPhGKLSXpoLeFkIXJBF6uWphhq34dDgkMWGK2atlwhz3RN+MWGOhmjWeoemY",
+	"BmR+YXrfFJhNFPNRTITY6rMvZCYQjom+RH1kLvhEkBhAEsVcTaI5GHmMqLrphoq7tXfQmCexSBFE7yVD",
+	"kZPspBtfyCxHmiF+PAWOV3vf3t2t10LK7L9bRYQa0JDKFegb4kcaJqHhuZoKSShAGoHTKGMdMHhueUYS",
+	"qr1vNZv1mhkY/tWEtZp/piulTJKRIbeYB+QDZT5lowo0Z+CLVC800N2MsLvmddVnzn2Fnn1d9Vl2X6H1",
+	"rqs5CPwpTE1Q5m3wOgVuwT0viWMlPUi1aY3OwMolDUsvGJgxhyXqJYWlunuwJA3Vt1aEu5KE6t21ChPs",
+	"1ZHJObbjFkIdNkMcWuNAi4sC8ZBKxRBBBnWu4T4DFjYgVqB326RjluzSfq+9xCElTNLguYc0IEOtMFhx",
+	"PjDZJueTRKMY+6tVAqbdojLAfT7bC+I3gSKiqdn0KyGWdPY171HFoU8OK9/oqrmzco1olvmERJF92QJh",
+	"ojVXN+XxQ8Cxf855UIEL2uYo4jz4m7/157f+J7C/X3pIIuQH7lMCWjH3jdDlE3KhG9hPhMGfONKiDOXs",
+	"zb1Q8P+jZh5Y6k+DELX3tf1hm+wM3notfxvvkN3hO7w3aHptf4fsDd/h5qD2q+o25hem17/4ns5eiiGf",
+	"ZG+KTDe5tQDJudfqBRH0abONewEWova+FhKfJmGtXgtJyONZ7X2t/ZFuttcLwwnE6g3HsPDKWwa59CA3",
+	"1QZbdj5/SJivf8w/OBuwvEZrq7nVrNVrRmlYe19rbbW2mgospr2VlzYCVBX4rAGYo+xJtSEqwO27CkQZ",
+	"dTZMj0I4NTWccvt9/4f7fHpfG221t4TEzMexr3hKiEfEfCLeQ6O93Xzb2mnsDMjwHR60YOewLlF7v+3O",
+	"Nmlttd9utZ1zsXup1xiRijEB+2XAUASJJ6Dx/5/auy34X60Of+1s7ajXMuM+OY/JkD6qjey3t1p779R2",
+	"3rT2avVaxP3sY3ML/vdGjaCGpZ7T863qqTvC0nhEmFB3kj6WMEok6UwwDfCABlTOvnMFohrjE1yr18ij",
+	"JDHDQU+v/+RQ7Wrfb203B15ju9nyGzu7XrOxv91+18B7+3s7eLi3u/t2Xx0DD5KwdOi5S0rBQT1LvDEt",
+	"PKGd9IS2dppizVNqLz+llHp+z36L4karvb1Ty8RHtYwoachYX4ANEeIgqE5xjqahiODOlbqRTHM6jrXI",
+	"7ktKDwca6V6cKf21KW5IsLLgaAtaIrnwcKCkIQulV4rciCJzoPzDPsUv3OMw7/Hst+avukvJPhWwM3XH",
+	"1t7vNn/V55FhZ2tMR+OQhFu41WxutUZbreZo8LKsOEfk6wqAhqSKCDeju/TdWJFuaageLhuR6SClTZfM",
+	"9ImZVeh//Ntu0L80lf4/fxx9uzq66HVOf/SOrm7PLr78ODn89efdlH8aAf2+iA5/ijT763enWevXM3hf",
+	"ZZrXRHkG1F34cjiBBlVpfIGFHJBYaQA8LDd7NYhkoF6InQAAIekEThdoAEd0y7Tc8nhYqwP2N7faW63a",
+	"M5ies+IlYHHYYOf8BHlZJ6M3qwifWwfTzyISAyDEBqD6nxT9RlFSA/LVg9Xe17DvAyvgQe19jpRe6KpK",
+	"BgmTSaPd3mruNAIplpPZu60dB/nVan/9qqerD8gIe7O5DcQkhJf87xufajGci072NqcbChPtbgBqfXUA",
+	"sxXneq31axsh+zycdmsboLFZwAqkNVOlSsaK9B1yRiWPL8c49s/ppoj6QJmfu5APslvPmPs4N/8QEfYc",
+	"pqp56tBn7xwsA22sdogyC2w010CW+U0Vgc5qV1BEV+GCupA7QcCnp1TIzQCkWG7t/Xaz+a5Zr0VwRWue",
+	"597vbRDSo5hL7inKrkkvWmPXuWUWbbnHfYKwaoECKipfAUan1wUd7wmbUE1AGxGEsuzU3teIr86nppXQ",
+	"hufcKyO3z8n/xV6o+X9lWilZYfEz1dVYI5o23ggaFzwgz4FDrC2em21UTV5hi2qqNTd3NhwOOI6V8eGA",
+	"syGNw81PXEg8cuRgsfZmSxZTtHOnKfKctmtu/yIzP260ZauBMb6SDcJGlBG19/oCARhxSLhslFPf+xjz",
+	"JKrVl4y1xjtwcV/L8CZnSa4IOSHGzxUMo2QQUE/Z+d+r4RrEb+/utvZRp9PpHGz3nvBBK/h+eNLqXR3t",
+	"qt9OvvB3/Ot2eHsW/5/9yfnOOf/2ZdDsXF8dfnnrHUW3cTOefPn6f762+PZ3sF79X1e2rAw7Icbn6coK",
+	"oHZ5+SknLFYEmOQPpAJBPTam02kDTj6JA8I87hN/DnDakeUHVahDdt/5O/tN0thrD981dvbxdmPw1m82",
+	"BvsDMthr7fp4oGQ9NYxqPfs8Hnz06Bn9fPy1eXFyen1zdUKn9G77YvfkntPLwL9W//5+u3uv/v316qTV",
+	"e/APry5PxEl4M8Wzkz0y+xz7nx70GDP1e2/m05O9k6Aje1cnj6o/OTjZO3k4pl5zd3zd+jC7277bvbj5",
+	"LG7D4/js082h175pXrWP2/jq887gsiXxt+Pz2/ubydfwuHfRjqTX3D0Y0OYOPnq38/V6/3Dw8aJ9dtPd",
+	"9g+DmX/14WhwOMaDp+Mj72r8eHbU3b29jpq3Hz8PcfOOnh58hr18vb3evrlsHXoPUtxtX3w++3b31G1e",
+	"iKvbY3HZ/P7h+8P+nXfQ+kpu9p++N+92r+59jJu7va8PF4cXDzdfBs3j+GLWOr5i4yvv6aTdPdoNSTja",
+	"uWSf2SX7cDG4Pj6+/TSefG9G/PZT1L67/d79evl5//Tgc4xvv9IzevL4/dN422vvf7kOvh99DR+v7sLH",
+	"yWW4r/bx+erh89T/+Plq0G59uw4+fPcedk/Jbe/4683+hYKh/ymYpmfCmltbSXwRDh4/tX8M2LvTboC3",
+	"7qZNvP1TyE/dzhf2iKcPJ3dMfvImZwf3+PH+aXLT+hyEd91G++BqcNCi7RvZEb2TL/wsOP68u/ep3Wu+",
+	"i7p3+2fR97aXPBx8Om99+PoovnSFt9O6mQYn3+8m98fx0+3JETnkx/vt4zA6uPh4+ySTqTf+cOu/PT/6",
+	"ehcNyefjz+0PSuj/OCZffw4vvn3b3r3oHc4a38+8Hf/2IZkcxzfvTi6TzrvG2x8eefsJt3cv44vk8gLH",
+	"V8Pujw+nnVZy2Plxvt+5vR+L2ccvZ1/axw8JPrxufgu/Bae3h097/hf/y2z/4rO8+MGurz0R3Et8En7+",
+	"dt/rnXfCzz9bTfZ5t9k6+vLjZK+7/2H76uI6/omDsw/hzoN425iExz9G3lFL4LNJu+PRo/3z9ofug7e3",
+	"vfuAD7cPdj8Fs9ur/d3LB3/v4MfxNIruv15P7q7vmrO3Rz/bvYjdDB++7SSX5+G74fXhziC+vP94yz51",
+	"e0fvnna67R/nQXfny+X3DiWnF2G3c3+3+3j77tvdj+TgW7zLBo13l2Hnx3kjuD+4OTs/73w7/Hb0iNuP",
+	"l4+DzudJfPfzliQf2yeTzsNBEw/2In4f/LwOHy5uJ2ffdiX79hVPdidn7Z9nndHB3fX48uT221Ozcfdu",
+	"7D1dXF+ODq9mX8Pd/dn128efNz8P6Gx6MB59C86221+m4zGLh6ePvSDuftjZ/XYWPI0/n7e87cOD0dvv",
+	"t28HZz++vu003328n8TfHq/Ct6Prw7hxL/zb/fHVJe19/pr8+PF02T0+v7npXf1kT63u4fGJ8jjb+/iZ",
+	"7t8cNDs/ePJN+GOv94Xt3ZOTw5t9n3UfD7z7wder3Z/i4Ognb1x7Bx8nn5o/pjv4YBwFfnf07tPHc3J9",
+	"+X2MP1yetmZM/DhpHux3OofHZN8Pv/X2pgefPiTvPh/MGlc7x5x8uwhuLr/cJB/bHz/Td2L41Dk+Hu/R",
+	"L+Ov3x4/hbtfep0flMcfPt8cnV1+2/ZP976cXX8b+uLD8OpptI27/GgWtQef93sYe/JjeDz7/L27T/a6",
+	"j5fvrh9Hvb0vn8jbj37iNXsfj2cf4mT7IOj+bH948sZnj4Onw68/ON2945fJ42k0+hhsP9LPwx47CH4e",
+	"X/381v38dje5fGj+OHv4MpqEnwje//rxAmPxuPutc3oZ4eiH93DwfdK7u//4g38f7zR3Gl+u7iPcpp9H",
+	"Rz3viVxftY937n/u7scHB53r4+83w1my/VN+6JDPIdm5GY3Z4GqCT64+D6Jj8uF6djm6++IlH79uJZOv",
+	"3XsaXNN3nz1/9pFsnw6wHBmm/2NCYvDfqL2vfb/92ux+/Hz//ePdrHc1fvh+eDfrtr9Oe09fZ2dXd83e",
+	"x27z++33++7T9e73+4uwe/jw9P3+5qF3+Pmhd38z7t13Hr8f3j19v7p5uHu6a3bD3v33r7xWr41izOQP",
+	"GzqTyDGP6RNcaD/UIuA+9GlMPPkjiWntfW0sZSTev3nj3NBvuOrYfuPhIBgopWXlG9u9WpcofM46anwE",
+	"re2tXVdio7AhDDEJyAQziUxT5dZxdnJ4YD3lPaNIUB7GwySWYxIjn0hMgyV3/qXHo2f6VvxRg7t+bwfv",
+	"k53tty2/5e+8a/l4f3/YHu4337beNQc7BGs3t+ogg5UVQip1AlJHQpg0iwSXT0dI3NJBCvDCFAgztznx",
+	"tQeR5IgKkRCEQ2QwQ+jB9EFkXqQ4BbN1M9pCVkS1E2fhHOA9Ba6GSntHmB9xymTxORgVyXFMyIbeHuDH",
+	"Cs4dzfZOo9VutN9eNZvv4f/fYUostBvCOKZChliYsChEwgGOR3yrOjrnVlt0PEY/hIbQopoACg5A2nPe",
+	"hOx5JJLEvzA/FntZ2aHHWKABIQzZbkAa1mlwmARDGgTqVzFj3jjmjCcimG312R1PIFwj4kGQc8qHAYza",
+	"BnzfhcQy0aSlYBIQtQyAmo3QOyb55VY/vTSO5X2tXavbuMD/+WMxTCJT5deX6bhCIoR+5Z7HfEKVHo74",
+	"87qvFCfybcCr0CBSs9Votq5a7ffNXYNIqWOcgsYBoJBf+1XffKm5JRXP3czPbSJl1nlvukdUhLEdFOER",
+	"+KpaB0LbQ5/wvClmk2P+nz+c/ZsgQ+FYgI1Odh9MOalHtjEbucacilbG7a11NJQLWxTFcAI9nXK1zNoj",
+	"bTsV86DaEEgLOoAJ9Ynm3pmJBhm9rCJ1IXmsTi/STWPtbetTIWM6SCQRaQvsxVwIFUpF0KKVeQuhY+Py",
+	"gJRJooGt+VDOVHSEF5OQMIkDJBiOxJhLoR0rsfeQRMpJ06cCG3u1xycknmnPSzHG6j4Y0oCgkCdMCvS/",
+	"lKLtzTSmkqAQs9n/VizR514S2uhDRwgJOBuNecy2KH9Tq9fGSYjZBcE+HgSW1E5NE8U9PA24T73299mH",
+	"6Pthk159PN79/u3zsHt5Mvr+8bh5d9lK7m5bwfnl5+7dtyDwaOfxhH7YGdw+Jt5Tk+JPF03vkE9Ot/1t",
+	"f7a73Z3tTrzQm3TvO9Puwf6TH3r05NP36Ps3/2CwPdo/ue+Mugedx7Orr0n3/rrdvXoYda+ud0/vOztn",
+	"V0ezk/udd/7HoDn4eP1/8G1vMrifTuy/zz99GPsfR6PvYSAGh0168nQTdu9PmndqrWrtVw/bp/dHs7PD",
+	"I3F22El69yfts9ujx+7BzrR7+CC6V52ke9jZPT3siO7B9PH06ig5u7reOb3ceTy76j71wqnsXe7Mzg67",
+	"u72D5uPpfafVO3x4Oj38mvSuvu70rh5E995Lzq5GT92rm/HZ5c5u9/7r7Oxyunt6/zDrHZ5kYx/sPHbv",
+	"H3bO1N/3d9Pe4dddfHiddK9O2ndXD8nZ1cNubwb9ds+uPNVnenp4JE7vj9rdp86OWlvv6WG7+/Rd9C53",
+	"pmdXo8feZXPWm+3sdg/vmt3mdPdM/X5493h6OJqe3n996j5dN79eHU1P7zvTs8OH2emh+7dZ12EBjG44",
+	"PX3aeed9PG7igw8hvn0U55cn973bu1n3/mJ8Qj88nF9+7nWvvKfT+7vd3tWd6B6NZt2DnVbvvrPdvT5S",
+	"f7e790fT3uXU/Xtq5p2eHp5MT9V5H95t39wfPZ0d7LS696Nm79bpS6fu37avnafdmzl/N0ePvadu0rt/",
+	"aPXCdAzRvYc9PS7Oe906vXLXkP39FX6/m3WztZu+HZHb83Eku7OdZu/qWvQOj5Le1ejx9Ook6V11FKy3",
+	"7wzsu4d3FteyfVw2t0/vH556V9fN08NR0n26nvauxl2FD6f3nWbv6mvr9NBrKZzr3nalGqc325n2Djvb",
+	"3cumGmunp2jmcPTYPbxT3x97VOHY0XavPZU9uvPU03t46h3s7PSuOq2zI4DLtHt/19Jw6Mx699cprp1d",
+	"PSj4qTU+du9HydnVXbt7f8NPryyemj5Xo+3TQ/fvlH4U/m6fHV7P9N+d1tnhcbcHY31t9p6uRe9JjfWw",
+	"3bsai9Orr4+n91+n3au72enVKOne37W/LoXZ9PHscqfdPfRaZ5fTlsKZs8NjkcL8yoX50dPpofu3xXe1",
+	"Lm+n93QEZ6V4TPfqWHQvd9T61LiaP9w/PF05tNFTeHR4stu774ne1SjpPV3v9p7uZBfosvvYO/zqjNFM",
+	"x/i6ej3bvdnOozqfHp02u5ewJ3xC3/2fc80v/8/B6P/9f2v1WkA9AndirRNhb0wa7a0mOjU/ZvFbhp03",
+	"Wlu7W61GK7vatVzo3vO7Wy3jQbL2Tb/qjtf3X0Dc215f8wPsm3fKZhIviWMeQ5gZhEL8MIJ8ra6//Mgv",
+	"yXzV0YymS/X3in63H8GMhXZXZ/AhpuqdoLvqMA3YQx2lQbu6dRqIbQI4+gynLwjz/htSEvgaXMr2E1Dv",
+	"mcCyo5RAKQvVmQsGhTgsHCiRY6YDx7VlWzdWM4w1+N7giL6ZtN64lnDxZkGMz3kqFfgYvdjBmN3YfQub",
+	"SoErzUYdJSLBQTDTgVQhwQySEczQGE9IfvdbfQbR2lkYdwYrkBbz0IFQfv231iak+R1UvCwErXoKSzjy",
+	"iRfgWIukknPl1Ik8Jar6PJKIyq3aYkTHBhjwIr5gC4N0EsmtL8f7P2ChWQ4dkMSjgM+If5OO1dxq7W61",
+	"szOfZM6Ek/lGv+pFI0xaW6321k42hEdi2Qgxw6O5YWzLknGaW62ttwv5SBo4ovlRdLtfv6ctM3TWj1g4",
+	"CcALzq7S9+d2o/m2sd26ajXf7+y+32l/ry0ZIPeC/vViISOd+YD7OVwSG76wnodNzarY9B+D9++bAHzF",
+	"3ZeDvGbikEDJJELaUM+zsO0iNYfW5RW2aVk1DOhbm4O3eNvbJ42dQZM0dvxd3NgfbnuN9rCJ9yHSrU1q",
+	"9VrqYOaa9L+s4xllXZ9yHlLZsfCBCbH7o18LicQ+lrhfe/9HHwbp19731Zj92q9fc053AA/tdGcf7+Y2",
+	"NgwosU1b7boaeszV2j8eXdXSeMFP4LECWPWtodTijSult629r/3PxdFh5+Dq6PD3mqNd/MD9mV6qUv/q",
+	"ZVIfFjkYNvFbvDfs1+qFS7fo11Yx80kcOE/0BzITkjPiuou+mWy/UXOIN3Zg2Gmc6Xed/e1uOxs8P7t0",
+	"dpiteG5NhUDouNaNPBTqEIRGmGxcGUvIPLr+cjfZtptcKha8yfxoKjM+l5KKyTCXrMxQXxQTUPhcK9Xm",
+	"przPU/qX2vuddr02pLGQl4SwBTJLSdEQC0hytXotwIsd2rkOhoSMZ/2WJiXjUqNJ6/8OcGyciRVydEaw",
+	"8JokcYzBrcJSQsNQ3ZumvsB/rw7dPKSWM7qsNRgqTNAPSqArQJ644Ycbsb0s/nAl49/7XiuIVFhk/OAy",
+	"tOg0v3L83e+1goi04oulvulwKY87Udiz4+8OtsmO19jDO8PGzmD3bWN/sEMaTfzObw+a3tthiyzb49rR",
+	"cJd6pGI992JU3G/WuKFP+1HH0Gxqx3gNnXkNnfl3hM5UpEugJ7OMYpLksQQti0+GlFH1u8k5aq1Rv4n0",
+	"DaqJdMjjAfV9wp6nUEiHKdEogIHciwkk2cCBQD4HnUf6wE51HVFMJzQgcNu8sF5migXyCaMmH4lrojfp",
+	"ynS+PeThRGTZtHIN+0wb883i1Ss9t3ww8oNtFzN1DabqHoCA0vWw37Jt9xkjHhECxzNn44gze2baDGVd",
+	"ZOHEbGjihkLLEtuqNYcaZ4I/cqk5XZ5VbZQhDgSpLmyk+0oCWXjlKDM9T6THTS4ghnQXDRWmGdMlME9A",
+	"hedhtObCP/Q/i5HaqPgkNw4eXoBp+GJY22EoYeQxgjRmCOZPE//k0RXnWsoYM0EJk6YP5KtSLUXieYT4",
+	"CrtMNrQtdDK0efoUWiqk87AgdRQFBAti8vcgKhEGuyn4txh4TwiTPJ4dVb3lHxvML0TJJBYA8e7B/tQL",
+	"939+v+01T2+P6V37IsgkZ8PA5tSNIiKe1hEsUwmAfN1qXjX3F+Trt3iwi/cG3g4ZvtvBreE+2dnB/i5p",
+	"eu3BW9z2y3UFv+q5/R2kzG1efslRBzy393GT7GH/7WB/sLeDW/67Qdt7O2gNd4fv/CbZ9moFwXfpczrL",
+	"Q7Ny7b/qDnRn+/eD9m7TC/eF9/FxPAiv5ffwIjn71J18D/cnGaSLN7UGIVvUuCAej32xwk3Cpl1Ou5k8",
+	"txySm6gRUERiFFCdKHLsvtJtaumD1O2lMA0rwEDRaYSFUKhsM7CqPpk3EYJsePquUC0YeZSwznqf4QHk",
+	"BZ4q3a9W6WrKENaHBxZXnvZJgeB++iA2Y0pKKaCJJZ4oRGzstltwYOq8Wv7jVPDPFzeHH4LLQcA/86nc",
+	"P+l9iOTgkoe3F+d3ce/LzDvq/Piq+shZ7X3t6EC/GtUS6ahWrynZsPPxtjNIvnxgrPnzm7h/R33/dvz9",
+	"frfx/aq7c7zj78afyZfBIDj7eOM1dtnn3vWFOB+8fWh0x0c/4/2vHbp7/4X5b4OH8OHTdTtkOJiKr+df",
+	"avWamrPTIdFBcHv5rstPTw+efna/tgfB9pfp0/Fbcnl3OvYuY/Hw7uEuucC93s5uyG6Sr+LTzvbXs5PT",
+	"ow+7377hT+PZ5eXF6OYAh93p99vraSeetB7Wcd9RsL0lgy9kdklkMWJ+vjzroSkZQIJJQazrH+SQFARe",
+	"uop3+EhHdahmJi0bjglylPqDGYzVZ2owuCGEGos4HUHDPwDhAO4RcGGdmdF0H5BaBB0xK5Ao04GR6oET",
+	"Lw9j3gTbYgJ8Xv/piNGGNoi/nLOm/JKyc5NMUyFb5GPwwrMDtn6l+oz/TsT0mqHSEHU1Mno28MYxcMpS",
+	"hhYfB9/UtAl3PfMU7Xz8cK54H0/iYFZ739rahfBWOYZ/Nfd3dcS05hHLnrB2hObWtjNCu7VfL3zVzD+k",
+	"Ekblp3SI1q/6wmw7RbO1ttrObO/e7hVo5LN59ubnaT8nL4gCfzGhF2QHyaVXLj7OTwQHcrzZgWLfPzPK",
+	"cwttGuicainRjGH8Wc0JM7dqjOyp7rS3yAfXzO91RTdaBtbki72xehHmAn6F+aSUwdv1muQSB7X3OypK",
+	"UKdldIjkko5YFiwo1nmvloCu+DBEEobqFaQMJuYwNCSKT0GdnSbICifBPUlkQ8iY4FAJi1VxwaH34lV0",
+	"iYypJy712jck8iiBVkHAPSz1WbV2t/Ycdlt7v7vV3oX72q+9byu6q40KurVzfVq/skx9cw13323NtW1v",
+	"ZeM3t3YyRNkB3ZJYGGJnp5kbYaf1a3PEyMOxMoIkkgb0acn5vLyJ/G+cZ6sOBvKusY/r17kyXQTkUju1",
+	"pr9Rpi9t++9s04dYjCEMOf3GJtSnWGep4NmwUcyVDYokdpTXLF/VsnytYdnerWjg0AaI1/Rhq9KH5TwR",
+	"3sxwCC95N/H5Qtbj0gIudSfJvX0yqrIvYDGhaRUUXZDEvBWN8buirFLM8S4lHpErGlI22tyuaWNSisX8",
+	"t++3QczPTM47u81iRIzlspfCr/rqyfbeN+cm225mkw04l0LGOFo+XSudzvSzmL9qnXqrz8jk4x5HYSpX",
+	"3Uxreo2hDkFaCSR1r+JjvjJ5L1/Mb6e38npb5y5zKLfoJg9xEKSXuFKVfzy/hnirwJSxMBwGBQTHCiRb",
+	"tZV32/w9lM8sWZAcdBOOuLMuR2w1C1niXLrUDFztuVxTvz8D91IcWW61L5B8bVbVEuRz01N9sBS4mail",
+	"pA8NesgT/r72hkjvjXGEJbH/RoksYst/E5MRFUpj7XrFjLmQYkvyMNBht1AKyyKEGOP27l7tfe3dsLVD",
+	"dnaHHiG4tfcW7+LtPZ/4/s6A4PbuzvawSXbIO7zt75LWoO3tkf3BO9Iatry3uD3Y9sGorw+z1f5Vz680",
+	"g80bL+CJv6UeDUWLgXpdzlOu7b0b7pAWbkC3hn5rAKdRQ2I/PNC/vP+jds8pO3DfIgbNdFyQ+kfKBo2/",
+	"0h86HHfOccnI9BcakulIar6AyKNHGeNOPNKiByzKmp9q71OJpwYFPxr6RVBrtZpGs5lb9FWWlHdhu0pL",
+	"0dD1aoLGW39/2AKNNvliBwhDzHwQAL2YejJAURIEqPD4cRS9n2j29IyLvxCbV+daS2GOfCzxakJx8+Vt",
+	"/CghmdwdGzvZylx6CmXBEKTa1vLFBPI1wGq/16sltvv1spnt1jD5oQGW3lj9OS3OfGeLaakiefZHcLY2",
+	"vv5qt2MTXm1AmtWOU/Y8iNnTilxTusKWUVOqFJit+LD/DBfa19fm62vz9bX5131tbi6crS2UzctiNux7",
+	"Q64TjbE2/iWRZnO1xcynOvDCtsy8OwuaNnNN9UXhNwTnbKHx3tb+Ri8qu+HKgDPTasC5aTM3vYGFssKF",
+	"aUzLiyUGjShjuZu9LE9oCwCncx/qNeiiRXJW0LgJOM3IdNUTfMkYrVVjtOB5/GuT5KWFB6mq8Kb+abr0",
+	"no54HxA5JYSleTQstcLhziUx3YwgNs9iWnd6K+WE+kcXP6p/t5rzo2UXRX6kxH/RfKgdyzd+UwJeLjeq",
+	"AZk85gnzn+cjxbj8MVTDlDhIOWGOxE8Pdl7sfCmHqWsGvo+SoyFlvhOYZ3fc5T4UAV6VCcYsc4z1KnV6",
+	"Gx9BkTsQFG1C4rRmWOoreDJs9Dgjja6SU/tMO6vUnaKc4LOVCKKroGJvTHzk8Wg259pydIVHi8s7yUp5",
+	"Su3XEqsh7XKMq6eNT1ntkJIKIp0ilx+VU3NDXhlSbZB8/z81JQU0BjjAzCPxD828ar+7WXv+x7C0Wr2k",
+	"cXUEWb2fsldGrD5mbpySpyVOs6FcB9atnCD3IeDegxFs58WtjR8GNmY6VXHhMJPdfl8fJvPrWn6TOpmx",
+	"nI7oiUMQ27rISkUZlta1UxaViKpnWzEFqfMYEYkw2m7uoB6XyNJyOo4qrE0hfxP7LaXZrRX1vG+1fnFx",
+	"8Ze66Gyr1UQN1K9dpJMIdClxQPo1rdfPoEQFSlh6ZvpRifvMlioPZpbaC+rgmnq5axDsQfGr4cVwDVRr",
+	"Olguh3b1P2qYMZ6FASqtA/JwhD2FHR5nAvSsxFc0Xjbsu3TU87PDRksPm7U1glBh4/Yr6v/LUf8o/x7e",
+	"FOWpX/0dbYs2g485kZug4Pyqq2Kgff0jo774pyBg7kiP4RW/scEySrRtRusH2k3wjuka15eW+Sf3SaBg",
+	"3Gqql9EoSs5jrtRR5reGetUc6C8CylcbT+133t7222Zjp7m329jxd3Bj38fNxtu9t+/84U7T8/d9pyLm",
+	"djvVYvRAVXUY0wmJs2QDu+3drb3mVms7w6pSrcUGWGYAWRW5tPbklaf913nac5wKrcOgvTaN+jFN+yiI",
+	"lyjP2mOj3a69b5r4YxUPIoTzrt9uKm1fc+d9a9uk+nS9B9Oha+8zpYFWAWqlIBdZBsfFWXf1rOaHxVm3",
+	"3++CjrF0ac3997vv3jfbc0vLb1stRMtGztIUnMsX1ly604Ej2q+x2+WDWl30S40H6s2C0TZ4OpU7aerH",
+	"MaxBC0Q5XmLRLv8wOgmfE2FvI8mMOrrdaOsYeYugwKLx3s5wv72339jeI83Gznar3Ri881uN3ba/v+3v",
+	"7u0P3ioNcGg4zMJord33rXeOcjsZJO12c6ehlJW7W3sN5Tug2Pa73a3mbuOtR/yd1u5OLo+Wm4/TqDl3",
+	"t/Zq1l6hLwHD/WGYdVKNzMGyKm8HlHACE9TIWFKlITE5nahwAgtfb4D/7g3whczOMd1YBjK4G84aqr7I",
+	"A5ltIj3YNVRFMRXAEqkOeZo3mazFi2Rt7c5QFvkH9N7e1rnBm/tObnCMs9zg9QwaP2zfDaBht1EVGmaq",
+	"OWB8TbjEG2rRclfP+z9qIzrCg5nUFteAhlSChxHkkBHajRv8jSIS34Dl72PWYbfZtPbAue5Z51+/6unl",
+	"CQuNc23bu3u27c47CAEVEjMv12ZvxxmuXotx6HxsNXfe7b5NB2nt7+0136lJHdPsMOCQV+3kPL9M26md",
+	"NS9voDTw7tfdbJfbyi0+5okksdsi319dvzGVM6hUlANB2uzdr1/r360aGZbnof+p2iCYTycFhvwpgFSW",
+	"W+tk2QEfbart8R4YnwbEHzlmJ19LDqmJZzeX9V09UAI6GoPtq7Cckk5oDjUERgA2WPwXk80Imoqt2u9z",
+	"GdW2t/ZaaxDnAgSWE6dtbm6PgI8QYTKmRNQRI1MiJILUPRq6bgGyTZmXqYGF/ZAyk5cHMrfs4XfeO38P",
+	"v/XbrR3st3Dba7UGbbIzaL3z99rEtLX14viYzdeLKy4wd6Kzd7WHO7g58PeHuzvDYRNv413S2vPfef4e",
+	"bg9bpm15MbrfNyrStoIzRrlabcKFsVPMbDPOqCJzL231tVyqGCUmh9rRMzW1v2+6v+aaq4d+WVjjXAan",
+	"hWpvCqqGM+eS0yzNjkDYimlUzJzyBN1JXR9WR/WZhp9017dtHQ3YWxnQtyp+Lz/uzrvcuEWhe+1fv89Z",
+	"v+e8q7dVRYltd8eqx9CoLdbd57rr//X7r2eU6Ct7E9kIPBfpedbNRfxc+b2NEP/vWX+vkwLGvTJKIPNM",
+	"GRgnAAAHIqYUZTFEbL49tYBafW4QgMR/EOq/bw72itw4d2XbAzD5EjdBSBu7krq0rYM5MG8ZWYETcpoD",
+	"B5ZqdAzX2bPsef4LkoQRj3FMg9kP561X4s2QVYXIurlPxJfL/GKVKToLkBVifKLCcwVKIvBpzb9O3YzS",
+	"TrKXPqNDyPWiXtYBhrih3FP/gsh41ugMTVa9xWNgCZRa5UMkiMfV/JKjKaYSDciQg6OsjFUIVF092JVg",
+	"yVY/e+dram5yiE5sfr6sZkN9aUyarf8LWCnGSsiBWpsnn/K1Nk9ve4HHvkr/vvP49eP+9Pvt7pPXHiV3",
+	"7X0J7TuQaT2KKfNohMGT0RQQB0lWeRQb0JVd59DmA0BqrtF25oRVvV7nivQFHSTGPJaNgE6Ij1T9Tp14",
+	"K+sFdGTKiG0CdQyK2R82wOC1zOZrmc3XMpuvZTZfy2z+G8psQj5xIn5QFYe7p5Rq1C+8Cq6frh+79PP+",
+	"lvrRP97nd996XPEe/+PnT73g+BN52L39frQ79O6/7901j54uguPZ16cg6IU354Pr6Ly3HcSX98fi6vjD",
+	"Y+/6c/MC7ovj1veDk73b2cnu3ZX3eHZ7/fj9sjW+uxq1Tq8uxt37I3l3dTLrXjafuvcXQe9ptP399vtD",
+	"72lEv12qO6g1xrdTtcCfg/Y4OQ0vJt+vPwSD2+NocLB7P2g3Fa8PyKcOPbs/ap9dHbV6T11VG0achMHY",
+	"PzjZ617d7XZVraenr9vdyynF33pPal9Q5+pTd+90th/7t58DL9wN/I83T6fhzdNdexx4YU8Mtm8eTsPe",
+	"ZKD2wj5Ed9sXLS+8Vuvh/qeLqfeU1sliXnjcvvt2MfYorGty9+372P94PDt9Goe98Hq3d3+y3fvYnd3d",
+	"fg5796rOTXf37NAPek8Xwdnt9Xbvyg8Uz/e2byisL9znA7r7MGjfdAwcQMpR90Dn7vGSd6YPyZfhhyja",
+	"5S0RhZ3Zz6fxw+XF273x4P64dXbwhezQ08u9Dwfn+7PL73fkpvHw4cBvym3P37t5HJztHt98/Xx+Id89",
+	"NH++exd77dbnztXs5t3DpddjcaN1fxx2PiffzvZGuNlufbm6+Mo+7r07fPf0vbd/Og27lxfj7U/nx/Ls",
+	"587pgRd+PbpsY598ngn+cX//XRjK5Goa7Qw78RTXjABjq7B+IDgmcXUxCjoXSk/5EqCQeEsboodJYDI+",
+	"KQNPWgB0rsKnzu5l7Vc66SKHwaG4CGVekPiQrhHUpNaGrjsr6RmMdTqDqJrcSRKgSnsyW26WPNNt28hw",
+	"OhVqWQmYPCx0qsuXe+EUjW4zperlGagoJ2zNdiwUopir7+rZcwTwex4wcgP+SL0aimCShqzr5UYxaQxB",
+	"Ke6U97EiP/zjRxZ4aewqix6FSDlWNtqIamdz9xFKmUiGQ+qB3zhYY7R1oI7aO05x2ESi1p7T8feXzptL",
+	"BZqSIFAK/lDFS6oZPXADVbnrFAUIsNySrdGWMtmmOfCcZMN9pmsp8szxX503jkn+HctjRB7Vy1XMpS2G",
+	"nW85pQFMcVcorkm1Eaq0/FTaaisrilqtzic8lzSfwXGMZ26Z1gIrM8Qh6qTAWNmuo4gwW/M3V1OJMnd/",
+	"WzqvZ0Riu5VFJXJhZku3tgjCRcH8A6JKgCly2qrV5x/jNudnNVjYMkpfVJ9fTm3YRchDZUkUm9KSyPls",
+	"3QWysqgFq2KlO06BqJqoOps8Ti2vNpVtLnnyb8J+RyeHhZPZ6rV/FD+m62k6CrudOtJdwOK1ci+6Eu38",
+	"4Lc2K4ztm+b2VYMoSsOy9h6scg0YoWhk+KHa2UGdDl0bOYu7cAc2qGBg//tCVpp8deIiaNmMrhm11XXZ",
+	"6ph4hKWmtiJML87dCjASBJKxxgR4Rag0TtkEyOEc4HWiHVNwkBCkKazP7PjoZ0LiWT7b64jMZXotPMF1",
+	"GAYlYgHMuv8ykOYoqxDv1eEo4DpVpTPUiQmk6zA0TlgSqmkzv4m5MmuLIYu/F+w6hzmFa1JdnAOfbSHU",
+	"cbgcFiaGRoXYZ78PyAgz5BOd9qeufGrst7R+hHX3gfsg6/ubUGIXD7GkHjLFw+1FCPH8MZ/gwIWBWUCt",
+	"XtMTslEatGxLTqdF0zum/4UVu4rBkokVBUTA3HihAlzHkoxMRr6FgD3zLU0GC2Z0c7a+O65QuI1VBxzw",
+	"USHK5gafn+uGxAMuFrjydIw1NThTWTYqimfJlxmen+fQ/YwCyh4yjpmHUsrvkpgWTVRQqHh+sk/5G8fd",
+	"A1wVhYTtFfP9ARZkbwcR5nFf6VFvPiLVdAvpVMBizJPAhxQU6iQGXI6RlgPVG8HH8YPaY0hEbmvKEado",
+	"EWkdzyISMx91pjE0HVNvvHBE4HgG+dr9NS7Ta0Z/JhXhZHIvLrkiVbRfoJbLiJupcX2Ukngk1qg7eqWa",
+	"/8r7Ylbs6gSU59k1wKsI5/JkNY/+2UkaxHJWVcj7ixJ/LGAifJkrky5MSmqFWlrYGWBBRe56sFNvIT24",
+	"QCGOH5RBCIu0dI9BZCvIk0BXEBjMkDHua69Hoq+egA6JWZDId+0zy7PwhFMfJU5ZC8NcBdQaIBCi69cX",
+	"2biqyBkEWghCdNhnGDx0YtfLFFtw6NqaWsY27ybK7K7q5uaHO4SRAIlkoIA6UJuX3EbqpsHBJmGMGfs3",
+	"kduu0XjVnah0WCfQ44iry0uZdjzi242opiMcKyAJTQJEiS8FFxcVFh5omLvm+ozHalMF94fe0tol+A9M",
+	"v18QJBMFeLb2EIemH5Tx8s+Gp3S4TKw1JwV79Bt82FDgrC7aFuR9WHfBXxaHWONlUbQog2CFu4Yzzm88",
+	"Q0lntAHnAcHM4VnFqzHDmDYFyylmWnbMSgwnX2ezUPiOiAde2XWDqloAS1E4z5csc9hCnSBI4WkoRnGJ",
+	"lAZAH2YG8bXmKw1nD2YOJ3KxyBLln0MWeCbOhreEPKwcJYPaYdbJPPR0fp0CsfCk0+sg1cIofXBItL7k",
+	"KFFbeXPKmQ9lf7DUzaaU+Xyqk2BAOScFKGbLDyuW5xzOQg/K0PXVQTHarEaMgwye8xeSkTRS5gqcqwgF",
+	"zBh6OV4SJgHk968jwVGMI6pcE7RCFIR+JbOZvvrSsXdU2kiJWa5orzvV6jUYrZaR5wqpPc/OVsmRBgNt",
+	"MVLYL6RvUFcGGNeh8tAQe+RFxX17zz5P0l8ln2UMa7V8fx0HxTo29qCWn2ubyvn2wlaYblGHcUlEFamf",
+	"eitnxQwk87n5Vg9eiQy+FF5CBTeHekiWZB9CaYYlqDlWpKFbJJ+tPrPOqyhRR5cNxxMpqOa8oOvQcxsO",
+	"i2JyD4xzCylpC6OBSs5iRKQ+cxmGvqexzJokzIneX8Rlk7CrGALGmTrb6yIk6pqSBZ0U45oJmy0enwf+",
+	"88avdN6ld2EHmcIIBWdlr7GsTIziHYizYKYZmQqz4lGiydomg+6z1K1LWTjU3eInShLUlqW8pLh4GBXe",
+	"Dldjc8tYfeviyuH8Leqkt3GJohjPa0c6slxjp/3RHEEXK48xA0AYxmZdzBS2cIfZz32m1EegMcznyq4m",
+	"PtISLVq6IhvKFtfTNaRPGFgCye9gmNpbip/Y5FEa7OnI4qn19qTzhnfAk52/5AhcpqvudV7VqC7CReyY",
+	"X2El8XC5SaXgzq9sW1lYX5GRJWt0CI6PhHklZh5TlSmnIcvhNkQqBQq8xmcRznxOB7Xu0tNVzaouf7ZK",
+	"YYj8tOkizZe/XCrocIpeCyuQQFVvC0PC/GUwj22jvIZSg9+UJ8ygj4egd/9PAv8Kj5atX6mbQHgY0kCS",
+	"eI7F51F66clJXCyeLVnaTdn7b25o5w2Yxwh/ji7WhR3VNVbj3EFXHMTBjlVPWafXbwJ9IgHEY8Wy+uO2",
+	"4qu2XEor4hGZimwD/LNnt/yEV3HQQjSruILCqQufpot6eTwTViyYEvKgr2L3CQnkG5E4pBKlKaDB83tA",
+	"1O/aEwDRAqQcxtRfrV5Ss93CZCY759p9BJZJvH6vZP2Z5DiJxfq9ErJ+pynx2drdimTb+VzQC69P13Gg",
+	"ony59pW+SuG01oBuXxOnrLusGskFxUHWa6ku0BWcsyySRSrBAHskNB5H1RLZWlvvedr1V1bTdq3dXKSd",
+	"cqmc11uGLfGfmkHXPpn0VIpVkgvtC9l44SkVH45rEWCLUoe2ikTqglEt5lAd2Wdany17pxkjgZsfY+Hu",
+	"DfiM+DfLbj67UmuoyDSctrtdD0iqPh0OlXtZzMNcUfE+swP5iRZRWGZtwPb1rm64hEkamOq2BoaI2ndU",
+	"Oud6HjcuLaSjFo4xqQKLzG9tVvIufRFldwnVL7mP875UGeJXvpwLpyy6pt2GXT6xpSGp9hQ9d/DMxubn",
+	"U2LwCRHziK0RASsT2lDnljUKJKUXAUUZBxOFdTjrsytblD5MBNj/sAnVtoctcaz8Y0wPg2lSN8cB1I/s",
+	"swHJihcVqY1M72KcOLNRg46LlhIyQvv+zp+J2oiaIaTslLCRHEP49nJUsfOvwpELlwGvcRhpP2TKI1pv",
+	"v3m2ozIyYyGMbUOdXxQTq9hXGe1VkWllbH1U9EAlOlBFkZiPdG45qwURSJV9iZUKUI65yChTDb6F0A0O",
+	"EuVtiWPiKslS49bPBDOpfadAKbvbbIbKyab1kdraGYyj7Az1SMYJy41FBPuw1u4noujkYUWFurRs3+my",
+	"DPDMayBV8ZtKTSHxdZXUQKFkoYLflNFcxDEFRgO7Yr1jWiJzsW8e9BXVivl8BFUZzmZspoi7+GSQjA5w",
+	"JCHL2uL08B15uoG5RFWtY2eXcweZKw1RavG1IyrFXWi0ltW0dWBkn1Ud3uh0wJ/rRXSC2hHGjp+pBovR",
+	"JZHZ027ZMQGYLXfopr1yAde2pm6FkT5dXZ0fPWq3OPNqh95rdy5WGebOOHci2UwFK3fhUcRhF2cveppj",
+	"RqWKakCqZRrFrOMtTCpzhC4JE1RZEJGJXoYG4OoJXKjPbNEtfVMNuE+JsDooGScMmHOBJOeW1VqoRqCc",
+	"p03Bf70DJDkHt6+QBgE1MdEOqlAmyUiHo5gQg4UdsxmSMWZC2TIQNNISousBXMCn5JiXoDDATTco8XAG",
+	"kH7g/qzM90HDfMD92bIRPmWB48V35B8rDJS52cxBFpTArNfsyS9bs25RvujsSVQCMutsyn1i7ACWuzyR",
+	"mCutP+PZPDokxyMqtrr4wJM4KJ7N7vj64nS1dGtOWg+X7qISeS29b2DLFo2r3zcFHKTk0pnndsuJXSdk",
+	"tC83nreNuo/uPLkuISr4lCXhMw+MVH+1NP5hiT+QavKsKIWyvqYqzMoBoB2kV0j/VbwggxnLR8QCfb48",
+	"69WRLkqoRTgcTJVS0LLQ4tGzWnDLvMHdc110xQZ3a1//EWHpja1rdpFYN0cY2QJWByuUXL9LyCMFkLuB",
+	"NclkfsJiUrGFlK6Lo2fgZ627yNqCiGYqrmlPAl1PpCieImFyVS6P9DUBF482fY6JnaCYu4EW4ZIQtkRK",
+	"syvMrLeJWEdIqxKONAdAG40U4LVWF+C1F1dO7845WRCirOKj9sjK1YrRPF47OmE5Nm+/ISWBLwzjojHy",
+	"uUSCRFhXxVUN3dixlZe2SUVUQq4QXmmaZK9IWqbcUaqCzqhUKac+I6y+rxxrjqrtKvM0XTd47KKdc8bF",
+	"JL+IF0tjaUqoS5uOsUixw3Iwl/+kYUSwQhIUB4vMLWkp/ylZDeSYW4cN5WYsYkCETWjMWVh4lufG7c5p",
+	"hOzjIIudEkXPfR1KtH7h9YywqnVUvvjnKfjVBtOCs8v0l3ihvrHSyrAsRDv9bh4VPKQSKDrmYZ+5FJe9",
+	"QUELYtpopZkdu6oSM118PQVhEXI753FZItZ2Up9Jp3EaUvUCJ7ZoMpg3M208zlIVQ+o+A+8wBzGdELOX",
+	"4OLu0KWCW8oETvyyoJpglnF7kZdBNXMxa57fz9qPGKUKVCpf3cYyXnfE1NyB+m6Jzn4NcVPMrM9yeii4",
+	"kiwhqHsLopscFW8dgSJ5SqGGmXXe0ivoM7OEgKjb1SZGdBR9lcnCBfOCemLJk4g8qld1eUCN+mpCkoeU",
+	"URv7pqCY18e5gDBuuJnuPCsqpttp3borUDMyISqUXfsrgj/cDD7ERJe/VufPWZ/RUDVRDpvaTUajiXHV",
+	"9Jz80k5UvR4HbI8+SATgTuiRsfKTjI1QZ9X6RmmjZkNmsqXR5iuvGLupfCbVigENmkYLXD9SQbPE92Ne",
+	"sVp++PmVFbrX2IZoEfdd20kpzxSbFHxdj13m2s4DJfexnq2qKlCWCiLFwKkughSeQoEcojLdFp6O+mCZ",
+	"mY9naUBXLvQki6WgQzcUIuNOJgAidVxubztexs2iF44mj7Oo5K14Ap+XSkGDSt4SOSb1q7DE8x+lCcLn",
+	"K0IZm5mKcpNUJpKkzvDzLXNcwrkaUpMsHULhdC/zPU9TUKbdVjLwQbmRX8NX13svudGyYu+6MZi758iU",
+	"x3aJpQS6JGWGbgAXfF2HHgEIbA0HHYrnjL9oatogNUepOJHWOFkWU1YcU58FWAG/NyZZk1sFNOIBGSqf",
+	"AFv6pCgMbQljMTGvdoWrDnQpT9ENDZirsxJ3/CIWQtmEMMnjWadCMC0r9YHXwXqJKFI3rhXryDgYDEic",
+	"hSq8hGDqumAsCdfJOREt0/7MSTo8dkmeslHxRA6jrOTpsWrBy5063O0Uol7B0Wdi2crjz0q5ohAzPFr0",
+	"+VHy0oCMcTDMvR63+uyMBbMsIoyKvJhnxED1eyYEFlvIl4opi0xEjknVtD5lxs7sGikBRkmOnMVn+1Lm",
+	"CivNBrXRFYNZSVztEh8R87H65ovMmxulQinANeX1HBcmvzEBdmlL8IyPlX/X0SP2lMuXElXmXArrJacA",
+	"AeAFzCpDHwVSiLPRPuCKZrlmRtZa8UyHziWcNe/Zmae5dYd0eqvLMIlFsXEH8QiDlR5aGK6df2NrgOs8",
+	"QAoeVCKsv9gMQH2WJf9RN1FoemrytWWxlPykiqWrMcwhbqqpnsMbq6m2x79Gd9Nh4brWADOrqYC6q5Wi",
+	"Cxi8WUahMhxZ7g/qMLD8upcLF3NLFtaTKX3JYMWLpxD96hOorUN8sIIhglVRMt1PEZV6JEuB+BQS1pDq",
+	"kkp+vUuFlQvn/EvqJNTXEHkrBgMWCj+ObEmFq+YBaqgjPBCQusvJsrZSJq56lTkZoSw7L5xQa2IW3qKb",
+	"XyNVJy6cRT01y/0fdGHGJdZXq9jBJvmVUs/yeFH6qGt2pJ5jRq2UObgUOkw81/973iqjfzb7LeIsKqfm",
+	"LRl8IQX+GUBaUzJQJeK20CWxBx6QCWYSfb79clkQMD5MYnjy+ERiGiwLvsqNXwSNJau9JHL5gEgQaaJ5",
+	"QcBTYynqsE6LyvrKssf1duyDt/kM2cozQulUQ7AekC10wBm8LXMQqLT5PJmrQiXqv5XYkXM4C5yoCDwV",
+	"5LuCyPVKhiEc0bW1ZZ3zk9IUSX+xMJTqGr20RkpX55M85xykHmPpW1+neGw7KmUaVR9XqxLs0VGBsi45",
+	"XVAaFGG1P2k7baTWkpOyUQrtq+3T4QxRWZzkJlt0RYgvdMisRMUP5ANHoVMSnJ1WF1wLvEYblyvnuPYg",
+	"6YPrJaOF8oVAOjotdFE+2iObtAdFJG7Ys1d1QZySIDaVNSRwvOXxQ8CxjyLOA8S4T0Sfgb1TxomQbj+F",
+	"LyLJrq75YTvnJ8U48RcIVUqHOI4JeVo5UL6xCtQzYFI0vD7h3uZ6V46bcvGw7tSQnhPG82v7vQq3V/x2",
+	"GcNXDgmCSKmLRi1wePVcIv55TIb0kYji4rtKh+L7MRECRaYheP2pvlnKb0Ck/MTLY7BPzic7iMfqv3vo",
+	"4OTwYm6WslwjJ3rE1qKYrkD3AQeYeatt4+l5nLqdQBalk0KPBK00VFJiwVbTJOBpKAlGtiAqOjlPt4aZ",
+	"yp4kgE8b2EFhZ6MCUFRrdf6WtWf5ukETQxnyOLtPmKfW1Wda2NTnmJ6PdqTOepqkrKnp2L1MHDN8AcFr",
+	"F59OACKTcuJWuuVyRGGcNawwhb5t7Tb30WWnp/HF9y2aKIDlKhstw5N0lHUR4ldF+rkk8YTEpnr6alpS",
+	"jZEu3b9IUE6l92V3uTOShxmcEGcSngjGex4K4RozPVgHKpgAssmrsQ7fP2Nl21ZPH71JG63p+w3O5lWu",
+	"Bo9+EyXRgJUTYejRy/OYrnnxLG6x5LVkWPYSv4QKg1aFHrwa9dlqEBr9Fk8EaDcmJJ65Whs9xEzDERwy",
+	"dBpkn+iMP2rhiYgI03+H1DZIGJRzK9TPFO9HlCGCk/ZAn5HZl4aYYjU89ol5B9vzq/TSWYqQBVqYxfaJ",
+	"5MLDQWHZ+E5Rwq5pTkbCWX91EkM6MgElW8jM4DbpMxM/KSCXgk0AalOWmg72SVCahusiLblaGLemG+X8",
+	"fXX79MrdQl3jHzICzg06Vb0IYyM3kZYUzOWtIiuQ+bpiLZRVX0sAUkC6EPy4sJBCu/0Ux2E3LZa7ooph",
+	"BHnkTWu1hgdCIjTgHAw4qdZFVfeIEkhbQ0M8IqLeZ4KnZ6+dcLK8TaofTySyea60GdlMYxJn2DvS5HDE",
+	"GSIpEkBYr62e+joolCExLE19NR4/qV8X6TOpCLjBh0O4grmQdT2GXt2AwvID+kBgS9pLyaxpC2moQ2a4",
+	"QjSoBv354JU5nKgvYGw17phRzIFLU+XPPwdgMmGquixCF2lq+Gw4JF3C05rimCCsvT5BrJpPrlkQ+GpN",
+	"WosESh4jzHwSF4eT5ViHPiVvzAVhOjOcXWMSuQw8xsznKvw15EI2Iu4rsIKLXWOKhSTpv+C5VsiuATKH",
+	"fMoOSYBnULOy4/tLQt50qiYMKyIoiWxtIvUvX2nHiYKXvqitLQUCilvNsPjutSu4ZowQ31bbLl+AFmOt",
+	"F1tietkMXlqmIQEdgeSryM1dXLWVSBrQJ+1YOI6JUB4uJdpjEnuEyTQuQy3tNzHvgWHXmiasU5eZOq46",
+	"aJqnfZYlf4PNAdEyQfXNl99DznWppWqtLWPGlaTUD9h7SKKK9DSAxvM3WkZS5vufTE0xkYStiM7UK9HE",
+	"9ECitDDtgChSMnHCGiXetpvjEpzQjLwwV07MwZQEkpN14dXKYKHJ1l2BxA+E1S156Kv9+uqgz2ABTdRC",
+	"/3/1v4rB5ItnyLkUMsbRMS1e7ZAGBE1jKiUxYXWAaoOZLqjcoIyq60N119Gx8GagzJoZIEGvDj8xxbTg",
+	"FK3tASUMLunMiXXBcDHmU/B7UL/6dESEtM8Tm2JxQmI6nClyMJEWRmwFDDrQs2pNJPjVY8elAp68IJZq",
+	"0OOMkoxfFFzT0CzvjGEl5mI/jNLAFoCnaaHEVBMUQIcpSIqNUbjsPYQHggeJJDqex3gEqFkKx7GTLH8J",
+	"5haJxniisJ+woiW6z3KATfEq9beCwbXvsT2a4jMoJrExbu/ulb1yHrNqG5867d09izl8uLiGuVOYR5Pi",
+	"2ekTWXLA6jPU8phJssb4ZVIQnL5zeL+vTdpLDd5qyWIlkW/8gsrzmCpvKLcsdSGQ5/Qfrpo4f8MU3ACg",
+	"ZV57E7n64nqI5ZqotUa/LBmnJLhtoV0lhHC24Pgkr5GcZgnUddCTMCFQaWoDrcjv9ISuvMTSdDR9ZqSa",
+	"uo5+MsdSpogrPcP57DnZKO7qyMQWxNSrMXUCrQJSFxocIgq3Rz91cEU8AfoYYLa+JvIvff4XZRA0vg2g",
+	"1nGSubjANNBXV2v2qwZpdosrRj4fCpQ+klXT1O1YcHMHZNYf/TI1jqOhiUjBKOaBec34hWihAK2rgC3J",
+	"/bIQmZMta4x9e83pWJjqvrbxUp1FiadsOnOxU2wq9q/IZGNJZ67Ea9Wlb6jEXEQm15ymZvQvngUUW/Jy",
+	"QhYPZcV1mYfcenrU0n0VbiKLlMMlNHICwqJ1qswS6mW+UXGfYU8aV7G6LvNiCHA6tnJKCRXpN4kFjQn7",
+	"NnRAtGFFIXwKxZz3nV6TutbZuZmxVrdURKqpaQ+4kMUhUULSEG4Gx9n5N4Fs4VqtXRpgoSPJsqhGHkOJ",
+	"TeoRJMaEqPC4IzOW2bPbJ3sbGxJEEOetnx2mOiz24Df1IFYAmqVqkSzzrE0emCp9lDqLMzkOZrBSgbAA",
+	"NwXMEFZRkiMCwYlvt5sQXqROOEah6lHAl5I4Lk7XfZWWqfHSeWJiH4dpRtyFY1BTBiXj6W8wWhY6n7r6",
+	"ZTyBJ7rciRlck6PJnivHZaOHDlA2Gz7ayMStjM8K10SRz6qGbgqWbAt2tkqUf+z4zZSke05Du5XZkLNU",
+	"r56GSJU7VS6zCmi3CsC+hmlULG7gJWrM9YwaZQP9qte09qF0lRGJKfepl2opFAfXozoKLMhEQWJBBTy+",
+	"JzwAld7/uiEBifn/hsppqdomCymF0wEXxnzxVAcGg2Kt03ovkoIxlPcViWUXTIlx6fZVm4a2N8bFC1Sk",
+	"0UnzDy4OdIoHxLjCaDBxX2TO4iUOu24YtYr9VTp2nUuMa7O6Hs8OQ+esSsAOgWZDzqjksdZc80AYRZLy",
+	"KzrgRrWCpYzpIJEEgRlvC6FzbiwUgVp8kHotYh/Me8rkH/GAejP0v77MJiRm/H8XA0c9NS/18ZaC+Pzs",
+	"8uSbfkdrXu8gkkEN9L9OORuNecxK5qFM32eltMaQaWIBHZSdZ4Y9h1iMBxzHfumwcz4Yvu3gajrtvHCk",
+	"GcbNqT4LlxISGVNPHBO/NHXSMY+nOM6QxXSxbzFLc+omJkzGOEDnMVQZJ4moZ8erZe5qCOluLkoHq7If",
+	"hbTHNCZTHBSEWB0SNsv8YmWMVfF6Nex00anM6hHt+zKYaVsg8ftswStA9dCfQQWyhdC18U2c+2K9EvtM",
+	"B12BewT1iCjZzoT6FJ8ZMaYg4FeH5MNMvZuTw5MOShsXjZcBs5xW0iYlrhir771yG7uQceLJJCa+W+TL",
+	"YpYxuUuOMPWRjKli2Qj1uG+wI5OH+0zQEdOiqk55QpkWFUzBWe15ZItvp2WNXJcUk2Jb+5IUXLDgL7CZ",
+	"dV9k5n0cUe15s4mPcc5nx6D3+ktSAMzGMFK68yy51KB0030ufV1lPdHcKSy8sRihuriorlnk9xnjMfIJ",
+	"o8aplwgCSv4oJhPCpKE9UGfec6rGBkUPkAkbWeZT4cmWwb1uj7KS0KaYLfbDAx6GmC0P6xFjAm9/3VLh",
+	"bZwwxFkRO1FXXkwaD3r0Pkt7qS5p0R/DL9TOhctiTMVBZV01I6TT9hlYQsEuYYaEa9XEEFGmVcdYZBk+",
+	"UmMGmlDsKGShSm4MMQXLfNXy+16Z7xqshMZ5bW+ngq62q8/4sryms8dxLEgRC0kyi6mCyPm1a1jRToVQ",
+	"LVcnTlYVHtFH+kGD9+P5tSM/YWEefgXPryhZmwati7Bj0lWbH73cUFme6JcYLeU0yzgBNJpzDSpWPSmQ",
+	"vszS5shcr1On105hoOFqZq1E9b3MBX8e2R4W/atsYotyj2OfQRYwfdMsZSKHvUsdPq/bIsltnH8p/dku",
+	"pkfOw9h44K7vVay2eR7zx1mX+yVqXNWkEak2KOQ+MUtFQ8ufPYJinkjt2nKVFtEiVlZU9lQeEEfKO7RZ",
+	"2iVHNIK8R8LVKNnfFDSiSbHniMIA7dm9uGpzrMZhWM2SaslS5M2cu8ApgPraS3sQcO+hpKRKMqIlqQUO",
+	"eiemNpGpIa+YicUXDRiTwp4ZP6qswoEpQwZCMo1VhKetQUgyzz5l2tDezpCGxLhOZmrAGfK5gX6fVfCM",
+	"HmOhb2prGc0fikcDmoTukehfFMXhgHq8pg6AFev2Iu5vcjDAfjc4F53qGsez82fNC/jsJzho6MoP7uGZ",
+	"FfXZ4pL0WRkFFo8iLqgklhzREIc0mBk6AJxY4v2fbuRSU9Umm7HvigobAu+6523IzNZnS3f1EptZEysK",
+	"bguzgPkFuehan+ff1e4Q7pMFLdIaxlAoemXr21oFIQNGC/6C1iRquKop45GTNX8TwKQDIsFUli0FbAGM",
+	"SooDKuCXN0rILAjiTAbkQu/b3+TCho65uk8hfjznfmVHYKBCnYQQMytQa/eBfLKs3ZzHWaHbrZgJScKX",
+	"3M6vqohQIc4CjnZJhEVZXvl5+QuAZb1egCSx55FIpqhRYg6UXOLgpUS8OULTY9fNNiqRTxaIuFZkb9pt",
+	"efJXbSTqaIUADaicFVfCP9ANEXZa6ixuiosWVDTKv/WMv+BWcRWR5yVRKxz0RYLBNg1ATZfrRKIKMf5C",
+	"ZsVpx7LtqVDMLwSQ1Yg9QOZBUGTCznartf6rT/EG2r38IS4kIyvGqtKFFiHBesRR/lBhOrGauigyOFvs",
+	"yV2nrvzHWR3F2PjpYSMGQhgIN8XoCBLJgBH5m41Y1NJqiKU3doeyalDdSMM1NA78kAg2wJFWANvHAo8d",
+	"SYgII7auH8+XJ3VWhbxWUFXME0ni5UPoNpDcEG4rzhjxpMk2CxCz0tE8LafyudVTWpxSakqjBzKjZ7Dw",
+	"qfC41tqkuZz1NGWp1RmRy3dgVnlyaGqLpAlZTWlCNYVd8mrRKgW7M3kl3LaW2OKryJr9/dRCjDWV8mGO",
+	"V8wVeQ3whC9JspgBQbcsD8lb0zKulvZnmcXXGLs8BBFgxyC3ZEEBO0V9YK4xvzfS+qsbeyg57jfZaaqZ",
+	"rNtFSakaRuWn6rDHNsGZma4SnIqDJB3ccXaZW1GBZ8BaqL5UIwQnBPuy0BKbu8qmxFXFS3ZR4N201qDk",
+	"KNaDZeI7XL4qclozfe4RIUhWEhDlKwIq20yFkoBlytk5qe782llSsXQWjUlIYhyUG5Nti9RmvGLIssp9",
+	"Xfh9ee9KD40iBWkhsThqcU0sKWyxF3MBtSGNnaIweYKHZXG0txoch+AMNZc60DG+SQ6vuq0iRpW6W601",
+	"9kIAU+HYiVhzWOzJBAfBzCRbNddidktrM4JSYxJmTVGN1JpoJdmFaNmtlZwng0I9B+9KTOVS4hG5omGh",
+	"J5ApTQDqA7fERCoNqi9CaksrjGQjKCQNjdstmZBYS26O/OXm7N3Aa9bk5B+R1LnQT1Oc0SESUik6Hc/F",
+	"6u6n5eXysjIN+EGHJdi5neXoQCPOfLFyPWvcunpsEDK0TG0haWEw0WnxU4FrzjtC+2Hmnyn2Yq2jgY2H",
+	"cAdSqmjtU8ET3+qjY+XR6QSiOivJfjalen0flE1uARIdGy/KchfEstKJTyGpHF4n+XBpOgMz5bqEsvwC",
+	"XqANQ0IizfTOY9/4TaEpiYm7nc3uaZeIq1zVl/+RjCUIHWFvjMJM7W1tT/UUKYKZiUlHAcHgkzylge/h",
+	"2LchGllimOekQFkJkitMi2LkOkaHqr4uMisyHBamZLzVfgxRRJiwF5gJrOTsN4kkD0iMDedIx7ZGkh6/",
+	"tPGa9do5FMbI/dTjR4/ES8p8okmJzAvzqAyAcw82qwp39GTgdzWXfdDRoyiJa9kc0KDqLNB49QtRbatu",
+	"AV6JYOFEl5JqdrTPkJE14lRCsdJCTpABWHteoSgZBFSMiV+UmyOKCaRvNL4vtjy11tCQhq3w32cLmk7r",
+	"Y2NtiGm9Fd/U25trWM/x7j4rqBqFNigatSK3a29JDToYtyjPa3n1gY3qN82dlsm8sVDrdRGtJiQecEGQ",
+	"83tabL68btYLZUgslx7s3OVwel66NwuoCmnfqtHtHOALHDE1KhiU1LlICoglSwVyEmrRVJVU1gn4TRkj",
+	"rsK/FbH4Oo1y5uVEPenGf1fJ3ORT8VBZi66VzLVfOY3BEnXbKk1TuUKlt6BMKTHBVD8a96g3P5+cQLoy",
+	"RmHTgAJdPHNAgqV1nQt8TZUwUnY5zesu8nklVZZd6KmvOC0nRRFkydeXjuW2hdl9wwzxn8uxirlCfrUl",
+	"KPVs+r2d5ypl1/Aq1LC8/zmXdBHirnNnr7sBy3RfYM2V1rmcIm2er/8O9eGVFrc8Qi4Y3rbQ2YTEMfVJ",
+	"zq91qb30H0nyZTmWn0Hm2gXuefFLi74r4L8lZIE79FoDz/dXw8bkzxn1PAkCLScU2fUhiQyJTbI0kLcT",
+	"bXbOHW693Bs7lclT98YJp75AAfg+z/TIMKpJuxPxhTRsajoyVW7wvlVnQ1OHWI2GKJc6DV6eRL3F9SQ+",
+	"CfBMaKUcLBL8AbUnPvZnEJ0FSktgcHbL1vxDhclQo13WlzqbennAZa8OvXg28eItyt/oSJU30Uzy2Bu/",
+	"b+9sNVuNaLa9VVvqEL7dXuSMyzwI8gShvAgU2Rq92lIW4xikwU4Ovt8yZwpUQ0WYxgKgpk9CmdXDCHLn",
+	"A2+hzDfpCeBIGFeL6DPVVYx5EvhpRUtdpqJw/zJ9167/Ti3N7WkZUKUb3r1s0mwqRcc/716mn+90or2B",
+	"VSBCpnz0scQm9yfTGcMoM4qlwiADC0J1YmoU0COlqZ8U3dgMMlBiGwyWDF7JBUEQMVGoXF8ZRDGkWmdV",
+	"GEyBzDAFBmWb8mbzRDXCngRwLsVrNynTEWKqgGufpdkOHOZd17SpIDD/QYFGmdZGVEj9G0DFeA0iHI+g",
+	"7IMwiSZhCKjE7taRzQpBrSr6kdvuVaUy2KC7NrSr9JFfioYwpTd1dbM0A1Kxu/bf5xabV5gVAm9t+k7r",
+	"/C8pCzZnWUhkSbKjaiUA86NJrgdcUa76WUnq0z1eqfFNUoBnjbhwGm5x/epPqcJFLiswCjrWLG1lkPdu",
+	"qHpOJI55UdzJBcGCG7Zoe2vTJcybJRYDoUTnsIQvS2UEZ81DTIMkJpU0+Bsg038Ohf7s0xcr68tiNAAf",
+	"Oz4sOXZRoo5dlYwv7a9tV447ebHR2gkgjsvWfk5ivbgF/NVGM6tkBsPZ5i/qZeRUVEcoHxGqYZPtYvMz",
+	"BBwpdtyyVCW5TQejxEjf14WCNUccYyOF6jRmWXoq+EfIJ9YD05qciGC/ybqiRswQELdrbsK+b1yVsKed",
+	"lkI+qZhvp3B7y1KWlOBi5oZu8QlLHlLlTjarIxsGrMKbROJ5hPhIewMRt481OOqh8Uy/YQYGqsYT9IWx",
+	"p1JkXOkwz1KLiZfZyvo7WHPVz1jnctWXeuSdW7f9Fcnt9SNvPvpH2aPNExXoB968fAquswI8iGPsqS3U",
+	"jUuiUHg3nkVjwkRd2+1BsiXMt3bstJNqqnvpt4OaV6KQC4n2tp2xFYPTSgATomKjofe2VwZHL6ukVMTF",
+	"FbGU1kSkNjM6wyMYInv7WCMjpn6f+WSQjEZOoGa+sBZm+q8sclp19FW0DbwaeGGIRVbYXETYW3Kzw+fc",
+	"uguyHa2sar75JK5jzbJCjc+axYyxtmvfXC2p5TSR4oFOG1dwKEarVeyXkzlEldRwX8PrigRkk4mg38sX",
+	"IbdzrKiyUpY8TTp5+HLDoRN49trczbqRNZz3a9e6IEm/pgNy+8ztrInM48yjQWaWTCsuOlmDVI4/0HXA",
+	"yDLGTFAjU/RZv3bu+Cf1azqAxSxBh4ApHEwESYV4HUcBOk+nN/H7tS2UJR00ZdSg8kduTljnwrRm834S",
+	"g2TDbBZNXfjBBU2WvLVfOyRRfhiToFnjQZbNRZhMhNZPWBWlOJEgE8AC3TGP4pjH/Zry01PLUKl1CKQC",
+	"B0EJcQ8O1c+WOkOpOK+SAapWOtX4gJidEyYrF4TP0VjF3JBOrcEl5G0L1ZvqbspVWv8icsKR+ax4NpQz",
+	"4bHt2WdKJgPSM059zKhxU8S2jrR2rtTbx7rvLjKVpcX1C5YvuV1ipYLodvhKEDwfY1EW6aA+6YfUUphe",
+	"FYVBWJj2mdE96prbGd0uCnJphHy1andFIkC+omSRk5GbzHPFrkrrZZpGfabUa5KnQlDae+HEIwvltQpj",
+	"6rNR7mZrIowm/RSt10GaulnrcuS5KVtQEfk9GxxmtrXBIUiImaSeu5A/AQpLSEgkEVSeX0pKynFctyNz",
+	"zjkxUYp1wnzIJOuTKCYezrXKbCbpaStFbj3v6KMTv+lXrY8E1wUBg0B1j2UwQ4xDpQ0SL3AuS5TCrhDq",
+	"itmFpG9lv6FGXUGdlvlWfDzZ5deVxY8Iqa0YGzylLLYWPKVM8sXLMY79jhB0xMLSQhGmbZrXNv+MwNA7",
+	"pba5rACmKv+y9bpLsUIsVPNfKrSlSyg3bi+XwnMDQLvCUSLK2Cq9WO5VBe2t8GNAlg+VBS7VZyngdKE7",
+	"m1dyjMW4NO2qGa9sR/BxYUnOCbmhCVK/CWMCVEAn6QCigkuuOh8XxHUr1Bh4FTGQYpxbShXpNnDWPldR",
+	"Ty2knh0hkP9aVfZKCGEFyXQJhG4UrnyyQDGLdEHBDCZL3LUTRn8m2XnaxiXOf4xMV+Zl1wMFWEgEHYiP",
+	"qBQohG2IMY02jKdI9+EuZNXZa+AtPfciKOYP3gXK2gdtjm/FIZ8XJWs6p0y4HBByqaw8778/H1zKeVzu",
+	"AfpryvJcSHLrfK75j3YxT/MORJSZWkz6prbZqwq52Cp2uSbLWoWt6sArsaiIMrERNio8W4GKOXwozrFG",
+	"mZ9bznTMRSodzXsTmbeImWLJI2RRL1sk6rhLFcUFzZyE3GolYwx0TOSUELZA6QX2qfx9sT5HFyaw1nKe",
+	"9VjFoo3dDlXPLa0ImRj3icrCPT2lQi7FpCQgQtdfU0SxmG8YSvHhGYLks0XJQlWQnnJN07KG6UaFyZua",
+	"JbPVUdUm3bHNHa8aOjmSK6Fxbm8XSXFRpcVGi0BQn0VhiuXyzSIeuw0DgifwTg3TOqSKx+gnr4KthsSQ",
+	"MlutzwaWwEdthtLrUFAxHc0ELnty9Nx2ekhqYsrEuFNqfUu6srJQaZ/GxCuPPU0/g/Yl2zGstm63AdNz",
+	"m9TbpWnzk/pF/1GcdC+WZZkfYulMZ/TU+vFv0mrEEsVKr5bLtLW3u7u9u6rUrurbxY/FM5MskVw2Rx30",
+	"dWot2jIKPyLIfxBLscEKSqv75xJk2maOvdIodnSdegDMkMe546+nRKuT5XlGJ+nS13rpNqOYS+7xkvxb",
+	"J+fINsiKEDuYIL2oVq8lflSAAvOV3uxEBjUcQBVxOY4TOW6DSnRxaR8JIzH1jF41JEKYogoFzidFHFKS",
+	"WBDTWy8X6Wq0FEx3gCCfrq7OTROPK02WUc8uFGo566iV2sqdnnXeTaSteIKZb/LOK2BGMSUSxzOrt/Z0",
+	"omke65TsPHvXSbhxzbj6ktVz5WkR7I8/jAJVnQZToOMxfSL+Dy+ghKlfNar80JwbWqWaiB8xERFngvyA",
+	"U6inYwqPw791ZoEfGpz1miRhxGMc02D2I2GplsPpmM5qfxjFmMm5WeE3OyXj8seQJyBSeZwNA+qp9iGR",
+	"Y+7/UF8NdcwNEhKfYjvIkMcD6vuEQSOjsVdL+5G+KiTnP0LMZhZexbwLdvpjaYzejYnQM8hnIvUGKc+2",
+	"rhEFgi+sucwPbkyZdAcb86mxSCqQmuta8GBCsnnqKCYyiZlzJaucq4kgmYc1OGJibZvyAizGtoC3IzWb",
+	"0PzlRiv7cdVVbttdWD9tE+9rnHJ+lHmGZfKLpagCty4xv2XsWmymREkhUP+ozxQhZtmrBJZUADUBQPyE",
+	"6DtOJOoSBBD/TPiKvOMbuZnNsUNLTIuoVsgNbbRGJwsePEidXy94UJYjO+ZaBgzICFv7eTaE60BrTa2W",
+	"rSUCUqkNyBgHQ1NLBUBtM7NlSTyM7SLLIAx590FyhMtW2HVwg5uOTXquaD8VojR9iAWfGcwuErySEON6",
+	"WhWiOUzzpahfwW2+rieG9tZGmTCbFUZv3W5HuFmMofif4lTEPCF5QJamD4AW5a/n+fs3w4ny48tVgjYd",
+	"Xm4NBSlg4O96ehrLMXJlSFbHTY65GJG1+DZTxFrm/nWWBrfb0kqKYwqEBzzRVXgXZtCk7uEIe1Tqxz4k",
+	"t5Viq8909Ptc2eVRQn0AuWecV8acestBzlC27EoHn92bS3XBi7uhwv5oyrmbNO2L2t0xr1BFAxpZh53F",
+	"08kMePBAiWJi9L3ap8BwCcC3iMQhNYkH3Soo2mqibO2DXO1mR2Yu10ct7n+NIFMXymsh8dJ7aQkyV1fY",
+	"lNNPAa6kjT+o9NImMdhXdU+JZYHekIw6TRIG91rBY3FERxiqOVdeMswMjwcS6+Dzj+4YBfo8HEONap2N",
+	"NFM8DNJqqcgWwGi0nNAm3R5k7j7TLnWmnIhROKVrz27tReQyo6y7vXmTphml7gCsEAJLEe2Axl5CpTKs",
+	"l2dokqlbhKebo0FM8IPOliWJJ42jS3bSJpOpKjUScKHEnpBgJrJyODgmKMQ+QVggpigzUHFqEWFIN3TT",
+	"oY6xALlGDROTCM7HeuOlA6oGiIZaliUm945iwdpmHmrj/yccDJHavF1QGkDgiGv23atzww7AXSjlf+6i",
+	"YmIyj+YUjLDhmoZxrV5T4oraWKFgn52Dzpi7moZsVc8y6vF4vAnlQIo25m3SNcbhM1FZr1mP5C5lKeYe",
+	"5RMGr7jmF1LNFpisXiLtNCvLdSGKh6l2eVB/mTa/DCQVL435NW1wZ8yfxbIr4xiyfaw4Lp0SpDCJ5UoB",
+	"4uD8uiSxu81isizvYZruEqnWcA18KB5tFCXdkkSW+SE/nl+bukrprUKH+hlcPjL3SYkODIZTn7Uc2Wk1",
+	"m4UDZkg5ipLzmA9pWaLKiRoy0i3qtnq7PoKsXgtGExqrNJBqAWXTrDwcVUsKpmBcIkFkzkrPSqQx6i+1",
+	"I5uVllBkWOmMcudTvAqjZe1BQPdhTCckvlnmsGTaIx0BjnzokbpypY9HIzkooKbZfMC21WfFPalAPPAz",
+	"pRwVWfUb4ClphdOMitbwFl6ejaeUMdU1bTqlpoDalvIrzQoqsim9rg2Yk55lKU8qK0qhX/lQCVHL1LkV",
+	"uSUq82zKVB8qf0kpXYTtbsqLB5RMiJ+6dDnlFxcfUrZrYbhZw3zNrXx9sJkaO1l9xKWvdLtjZ21Ljx4Q",
+	"vegS8B0Ag0duie9+oYaChk5FS+idc9pPyUuHszAyddPIgR++9rsDY8YQTzhU5FQyniE5qgew6bS0F7eO",
+	"sjFu3jocfAhDT5KAkVifACVrpGpdwfD0zsr4nck/Wx084Dbjpq19bsCBHrpU3TMpdReE88k8ronEtubi",
+	"YjEebbUvL8hW5itqFAiOg6UgvjFBBjMNH63pnYEbmxv15TpDi8UCGaC2yFIJ5ywwxVzYuUtKbs6iK6CC",
+	"M0gGoLlZFqlyGUs3lOZglXN8qwm8ImtP07AYw6NiS1hSqE+r7zIqUoXj+pxM85pl/P8LmZ1jukootVlV",
+	"IkzjdTIA2D7PTUc2v9yK0LXTb3B1Wrgsg93pXKGd+Sy7AfGkALNRTkMARDMw/SDSPowC0G5CtKsO6AMh",
+	"0dob6zbVNk/830SaOS3NFFpW7OLksPhY8itIhci6+VMPnNqgLDGXxcVBqyoT2bYq+DmMxjzGWZicgIAf",
+	"rW3yU3nbJLDps4jE+cHq6Oyml0FOq03mIKu5vR3LZtHWebOpQN6Y4EiNpOxSoNIyxkyBrg7OgcddH57n",
+	"gqr1qmv1Gp+wYjt7Oeq62b2WBxnMp7r8r+akXBGrCgxs1ZD5W3HFiOvmvExdQIrrvYHB/GPMk+icB9Qr",
+	"kExNEmawu0ITt7jKb8JWYR2pMXLR6EoRRqVAyqirG/UZtDIVpHRVT4eO8+YjQ8Z2Uio0LSN06Y6lP1Pi",
+	"FkGyV1Id2bA3a+zONuCYk/sMlquvNKHFv9yuUq9Ms2MLXhOxAdaXnKZP+SkxbcbHTNKG82/Bh3Lx37lG",
+	"v5dLRksLvaVyzJwY4xN1wTu5iTN8Q1jof9lQRkjFRRSjsI4gaTYzm9Q0NXYXx/0sRd8FC15aKiaLw8lI",
+	"JkeSS+89o15arSS12rUyJekw4GAyPjnfQN/JHO3aej2Bra7fTZe82qCjIF4SUzkDwn+uvcGFmQMEu6ts",
+	"mQvzLj3Tc216XyF6lRroN89bXR6HvvL9ZbqupwOej8ReGge/gfLXALKiUGhm30AmtAe2TCbUGLT8SIE2",
+	"tf0MOC+VIg0yT4r956BxMWSd0eZsdvN+9gkzNruS8lrr1sZRHepIZ9rWeSbVpZLlIVpREFTvycy79IBX",
+	"sz3N7tJMC+Au56eIhpCt4XnR6c6X/+/SD4vwHjjW3cr4UWAShiQVMHXlUfL2sOqlOEvuipIClbV6fo/Z",
+	"NEtPIq8aq6Q1LLKOQkpIPhAkTu/tmHiEydSq2WdgIx3M8jITRAKPwZQJnsIKB4uLuzLFiiWdkGPjp7ZK",
+	"P+90MTbXdDFzNa2LyUfpksxcJQFbmWdcfjythtJzVldCqU6XCfiSbjSfybm0zpSpbna5o4ydhorNlLvr",
+	"KmpBfDJJPNagM9f+P08ldiXunu0E9ULsqkI2xe+kTgGBzL8UIsJ8gZKIM1cMd2L0VpK1kTuX2+LNW3H5",
+	"ErX7xSLN4Y1r8G6SClzQp6IqEspyqT4tsWfNHzV9cqGSnZ4F/4F6YJGAj5YWiLJn5dnWiDAZU7JprPfC",
+	"7EdMxrMi2aOkZXH9Ils/l2r2EBATxe/iW8HResrEGhB/tCpgGtw61cPY7QJfFDhm4BZD1Ubt+00rXsZ8",
+	"2mcGYFobCo6r+r1LWG60Yt4BfjmUjTQUitJ96w9urBUgAcI6AGcwSzewPIhjHv5+oQNRGqJrdfpTLCzA",
+	"12C5YzoaB3Q0LpJuexxKAsJz3pab0QJlqPRqWjG23l6W5qFIcXyD5BO+5pw5RCokOi2ymZDEa1FoK9Mu",
+	"ziZnTerH5GQKWxAGcmnCigfM53tx2dCAQCaJkqwjih37K8K07UhOeqs02kqd/FzuHeuIYLr12RiLjBbM",
+	"AGim6zxXxCOexCXxm2pzuVXGmClJumrNYqMsWsnQ9MkaNSic7IoaR3Y9pea0KvUFXdCvmcJsQXKeQ6P6",
+	"YtHBDBksyB0AVcT3pe9Yux1A/+pv2CKy+lUYgqaaab+BJcQnucSBS4JlXksvV3TbQPFTMR4X1peGgtAi",
+	"tZCsW/s5V/Q5N/2Sg3RAt/QczXY3O0b3fMpP0aW01TxUZ3L9T5RP/w+cpE723fvrVTyvII+mKy8vO14V",
+	"GfO8dgk2WjBvho45RFuCj6WZVUwDk7RkEfdiHpCKa1EBWEaZFZ/4q1BVtTL5Roa0JMmoalMF7WGsanZu",
+	"szhn7Lre47KzBNicsAmVZRGLvi8Q1uswD/5S5fGGEF0LDm6tUDBFWYuswCFBPg8xZcafyISDhSDBu5JQ",
+	"NViuBcILXpimAFI9KPipUXTulxdHy7mlV1vvqrrEzhLXp9zSbDmmwdlwOOA49ksjAdPMg85ieNZpEWiM",
+	"PEqobFxxic4KdDcduKuTO5fnrp7T7Zl2+vGnK3cDShar8rLxHZGvigLR9ZGqOpXJtrlEjHXgaV6Rpk91",
+	"8V88E+KJqN4f7oEL0LCXV7U3MnIJpIuO2C5iCcE4K4cKKSbsrbD0l/oKqfNd6Oqq9ws4+zzozYFAyOq7",
+	"SAllsbLwwqqRLZGjzfb2lejWSXJjjCGQKyYT/mCyMs2hb/pMdSskOXcKommbfAxQelze3JGajsUpShw2",
+	"uURAUBxzC6ELgn0SOxlvJ5Q4eYjqiPhU2gS+kBsYPCZm4EYV2lr2bu5y3TKtuxTMTML0BQaLkFqjqS4V",
+	"4iiCMG3JnQsQLhAndZAN43Yu46xKQ0iZ+jesV2ub/ZJMTA6IPlBWzJE/xlhNiHMAg8uMzUxQuE1nw1mq",
+	"dRC6cLzanBnZ7I+o4odYZpH9UPZJnbwxsOXFjMwdhQgk8QMxpcH6LHW9TQdQ1wCKyTAmYuy4vRnxhYo0",
+	"0jtMAkmjwARg1/tsMEMDs0rEY/SFzITkTH/PJxPUebCERFFMJzQgSoWjq0qIcifGavk381UKftU3Eags",
+	"2As8ksyXzC90Pu593YvewRoz+Ip0NMUxvLBLZ+VLuFjBjItOz1byFtkLFAx6UL2cISxlTAdJGoRJ41xy",
+	"wOJEfIsKLgfHFWYBCQDOKT5KfQ+cNczPaiXaJ8q1EYOICj8L5AWYhpZ0zk4OD9I1pQ6Ovwl0cqhxXc2C",
+	"HgyOKpj0WTZRHndz6vNynpGuWOVwcwYuZBrlyjVYud7pAhVVe8U4afcqIkIlURZWYCn8GXi+RKp1JZSC",
+	"FWm1iD7/heINCEFvm7k/9i13zIxWuuwBoqLPPC1oqHs0zWTBEAXWFxgSJ3PiqXEFCWaZ3FhYVmMTpZ/I",
+	"/EA3UDYVeBNk170dtQQVlngJg19zSjx50ydIGVHAZ3DPLsJCmqpLKzYCIxdW8YIBlq25vK6T6qJfF+76",
+	"c7RqDauFxLmYl6cAGbPiqnCNZzmC3NRA5faOqrYOO5oxdgjIKAzVE4qT044B3kVjqwzyEZbjSnU3Hkqz",
+	"Xlrgut0tWK0kWd8sp+Wz64UsYYQGMkUIJcT4QB0SxMUUnjXkLm8E4MyqQi7gNvCyPgVHvWxAOOmsAdh8",
+	"s3xk6u5A+vVY4tlPmUcjHIgy5bHmWPk5TJrFgI/UbOtaH3FA/c5QljGKtF6MOyOUOSZOTcJqL2Jo/oEM",
+	"eUzWmIw8RjbjzyYWJOe0cgDObT2/thJMOk8GAfW+kFnh61AhTwQtIAZGcrOJArEpKh8I9DtFo6yFSfPq",
+	"7XS+op0pMN5S5vNpEX1ICOODz/qqnMY4EllJYXhU+HimGJedc3HHZHXCZmVuSA2M1RovvvIhs6KarHCj",
+	"Sjgs8GiEXIvmmaRrEhZ5Y0ACwZIh1KnxCCtvXd3QCKJFRGDQ+QdlS2iAMiSIx5kvnCccONNrvzUeF6u2",
+	"qF+2xA7T4mcqJBetDb78kIW3r5Xq3Q0iaqtCmMxIbq5+c7npoIfVSOrMXc+DOwez0oO90Gqus6gkdRZ3",
+	"j5kwP+JUVxfijJwNa+//54/5zEBZhsb3f6T3oKVBncbP4z6p/b5otfbVJnTSwh9U17fQMZ8/kpiqT9wn",
+	"PyYkBhNI7fdf9WqTR1iIKY/9xSnVzWBrBmSNfl8UY+2SFvVz8El5maMLM3AW29+HFfdr+lEMgoICHUsC",
+	"k8RLV8ouqBZXWF3JhaHJL/qyc2awLdunaoVsq5ecPn9y80oGW6DFGRSl1RRNec50Zq7+tsfZrxWLDOZz",
+	"UZFhQ4F8ykiMbMPivWazrLvfHGaXQds2QtcXJy8J7BTtV+3eNnzZ3c8RoXP0pWzqErLKLnGrh1Za2Vcg",
+	"OWTxK8uux2ymNH5iMR/xnGK4aJ1OuExxgfwhDgSpr9iLmatsT8tTIS2NflmMXSnaj8nafxwT8lSs2Tct",
+	"0BCagGaUBtpLcULq+ddxmv0EJ5IrYwfU1s2qebmXXx2Ribq4tQJC5JKPDhLmByZNvK+rdAzThINapO8z",
+	"M6q9ZcHPOCuhZ6rvkXCA4xFHEYkp94WtDRGlGcVTnza5shqZAYHOxiytEh/RApEILuXZEiFGCYtj6pns",
+	"MHpcc5O7duoBsUbqYSJNNtnKvvGiJI9wEmIG21TUi3TDVK9k16KTAhsopo/+1Xhmdl6Y3MBGo6kIV5N2",
+	"T0se6tIjTJrjL0vt6STrETa39oDgmMSGmHBuGICVQhUgUfdaPTA3b+7H6ziova+NpYzE+zeOan2LKM4R",
+	"g1Jly+PhGxzRN5OW5iPiTcYea/UaULGeDywp72tXVhS0GnPHzkMnJDMFuBYpp1tm2MAFUXhZn/dpwQnT",
+	"1zx85+xFhla0Mcl3uk9jKklZ56yihuluU1BYhrgh7OA//dr8Vf0Kxc2g6IT7A1XVfv2CfIJDvrKOnwkI",
+	"0coym2csTYvkFqxwa6OC4VKxZuTNPG1KSKs6l1TpRlYlR7VSRl8QcE0LAqw+T8R9ZldRz66ZhVQQ4CAH",
+	"cB0RmdlusqJAs8huw8MMcgqpSXTCGWXbGShU8mQRSHLHZtJBq33rvTo9+izb5YWN1AIurpc5M8UDuqeg",
+	"7yRGlOqzMRhZ05tVZhAyNUTgDiBxCF4tny/PevXUM3vAfUoywzJsTaihce5GfTPDYWDzdOqU/iLLk44F",
+	"uut0TxUk3Hw78/3TVMCeRyKJzLLVjUBlQPKx8Q5COcHm72vNrfZW08b44IjW3te2t5pb2/A2k2Ogeovf",
+	"IGIUli4zsheyLXIJwEakwIqiCsIIG9SXdlOXXna+SuilLG9BBnut6aYDZvrMkUJM8n3ADUFUTicIFRFp",
+	"XIvNfepxJkzlFIK9cZ/ZaSE+dkL9RBHClk5Wqu865S5Y+0hkJ6I3rY6FhYKTsfMKeJgXybpZkxSIymzg",
+	"WohXdhSUeev1AIvSWj0g5HatHopyKEvchf1er6U4rQ6+3WyWvQHSdilYjgnxL8yvCi13qnQeYN8QeL5r",
+	"a3VXtwSH23m3yryU6eSeOiEHVB3JxnDkK8CLYsnKed38+v1XvfbY8LmXKI4NDRpgga29r4VYlwpLaVFd",
+	"uG+gKP8bD0cQ2/PmD/PXyeGvgtA31RaZFqsJ9COROueO00v5EJm50mKtsT/v/pG+VWGNfQaXPRLEGDO/",
+	"Na4ZfeAxa8CKGmZEw74Qd+JSjSdOTED+VzQqMbUV87E2h5iXBFhlaEiWUKxaDUxp93BgoVXbBGN9Z6i/",
+	"AsbuNHdWd2ZcHvOE/ZdQXYuPGtHX45opYuf5TBm16IkKyEVX8S1Wuh5mxYbVde9a+cGLrtqVlsZMOLWL",
+	"U4RUQlO6KeM5RwIf/Fn0zbXVZzYAMhFqWmeYLIYPSlAJbiQLdItjEP8MCVmHFHt65gFrbsh8vLyt0SCh",
+	"gL/3gHw+ZdktOsayzxhJA7R1JkcfDcD4lF+RqZG2kgKdM9iM7ixAtM/Bv+u2cEloTewnj8UOLecWGSib",
+	"ECZ5PEO66WqUP4J2CpOdzibzgrnXRH3Oc7SePj5MpZdFRZPJIZoo1IUboLAOTpoFs46wF3Mh+iw/r7ol",
+	"KBsRAR3BCojRQffwA+TQ8mIiLeL62JNZogktCPZZLksluHbCfWc7ZcI6I9OAMvCpNUUP1KNAx1ALiMHt",
+	"Mx77JK4jzgiKFIdQLzh9YyF0NCHxzNymOjgaeUkseAwekwBcSeI4iaRK9G8OxxRCiYlIQje5lSn1C2PF",
+	"xCPgLTCYgU5dMQmTTUbwWBWiTuObKEtIxpysXi7k6TUPRRAxZfW5W/zAdLbvJpswywwNM+lqjibXhDXE",
+	"AhiUsLCCY2gkW1vQ/u/Jsikp6JW/MqiKDMoUX30jSorJdvV3KCFr3/tq8KpXc0lpaZ2LT+l+zW9ptVyR",
+	"FdFVvEQ/FPO+UapzVlvrIl/qNgLdtWZO6YRoMOuzxTLGKGEBEW4VeSfTnFNdegmhdHO1eDfB3Fw1338v",
+	"3kaJLDLSmcyhwlFRKL5KGTNRaSF4xfEYJSz3q+LNdYt5fWZOU98o+s801UY2dFrTjHEIwSdxVghbe//S",
+	"uM80J+WBUiYNIY49tWIwiHnQGC3xAxGQrnsRh86TpTgEB/qB+7Pyg7BNKFkoby0MRhh/Rhcf21W0Ah5R",
+	"l97r++pP5L2ZcGWkrzeGoRVZyOFDkW2wIg8eBXyAA1Qk8ykWm2lsbTEzCP00FYeIee/oIm9K0LQZt6Gz",
+	"qwpfwioX9mt2tRHHdDbyAUb7V3HNNXVWBZi21BX5IH/V/mlI507zH0a9vH/yK/79h/BPVONtFfHLHdip",
+	"+KhVJlC0C9JFcJbxtyo4Ip6LEa+4UI4LiRy/uZ8WVQKDt/uUDMCpWRCZc8AsxIIL0AWAGgR81SFcKXWM",
+	"FmmxUnDhm6HPt1daVa6YjEjgeW78XbQDqlZdkEccRgHJwsd4bL2qhS3XqQZZgkuJHH+ePmyGRwo4L36Q",
+	"jw3GG/Y0G8Z5RJ2W0F5rywSXRI4XTlDjwpuc40hR8ZS0DIBxU8nDEbwYyon8PC30lcM5rZrKDYQFikyE",
+	"UlGteWurjHAsqZcEOM4qFMy50+DMzRAiXTJdrpr1/MvB0Vaf3fEELM2uPbsPllyq3AO14YUyBLonhYC6",
+	"2rN2uTg5RAecMYi/TVHMOpYb3Zb13eK+8obSNtTl6HaWEmd2HnPYt91sL8K4k7ld2mSkaVb8hfhJ8Mx8",
+	"JlP7K6OzpusqeLxwMhEXqzA4Q1foLXneTd6OtojMcxpR1yl10dPcuqduqSLfaVoHg1F9licljdV5rERz",
+	"SAm+jGD1GGTa1y2EFE2V+sXqoqWC29Id1nboXthTiCwHa0ufYeD8g5hPRVYiZI7ulRMbmtrqAjSMYmW9",
+	"9nCQ49t9potpac9LyBYVhtpBhxFjYUEDrK8GrspL1FUtGDIBmKdF0ZW6QPUkzNfKZipBPS+IyOVrMFTT",
+	"OT/RwGTcFC3Rq0AyTgSoj7djHxjQbJGuCnQDXMzT9pVGzg1UA27oQ4E+oAI9mhH+IVLNi3MP6ntvlOfV",
+	"QEWULuMeaWi6XaxmBbZv6U1Y4qVmmNHcXZYW+zfoBUkmNI+fp/8F0UYVfJQgOisNqk8CMtIp65VaNMVg",
+	"5+JyagfBFWtlNmv1dHoVMME+kzm2YqmpYK+KtiyLNMyEzbGqFTck9b0De0hrXo0D7agOa7M8StM3K9jV",
+	"1l8WU11PyeWIuuCUb5NGFd5zB7kSNgXCsut5mqnX09j9K8fRu89Mlj9XgFIoTj2qgu/NJaPvHj1Av5aK",
+	"fWoaLSAq/Ouz9IpFHOJmwGedD4fEKXS6iG0rGLJmxVcm8GwzfgyxE+VMufVvY8rPf2rOY7xXnh/8fDEr",
+	"eEWVw3QuWbeyTZXl657LMK5LVOl02rPUuDtdmaSbmgQ+xjdE2Z91Xm61lt+EJjmwQeAsSgVEEM68Jc+G",
+	"LH/6JiLBQnbzV0wsVXqkWPbmj/RPU6j51xvnrNdG1DWduebmztvbizl7J1sdws4qNBLnyn6kWdoRynpp",
+	"73iL9ODWAEWiFWoinT7bZKNawnNTHDuY24Gzutqrnes/gPRGJBnAXbdCAlmgAq2DlSRUvIMsUQXbJlWZ",
+	"sjfXT9sSiNBV3FNfOxtFAFYFmcVBgNDhmBwaEY8SmN8OnFZkNhf5Et3fwfwuN2GuC/lSruxwr1y2HL+0",
+	"TScqSQrqSLd5V5IihxaUM4EJ00b7zWWBPiXBPcpTK+bJaJwzT9XN3Qx/Sp7mo1Kep3OTJeBJZvMLYWvy",
+	"In6RLQ6wWKO2ydkr+FBOFeanprL5LKgoOzKTSJLHodDPGyyoMPFHOnQ1jTBFw4R5Or5XZXpTXhR6jZrJ",
+	"K/UICOlzc0HyHqVF6jNHjDcRRGpKLAT3KOhqnLxqy+g9Dy8nXmWuwFg5leZwZRMSzWXRfL0/Ngi5qPKU",
+	"zGPSGgedyQ5zJ72eyER9EkZcEubNoPJ03kFxzXefRnnX9PwPctLZXt15yOMB9f35R+t+JWIbBtTLr7fd",
+	"rrLeKOYeEUIZho9AV/R3ijbKXWlv/pivdGKijQJSlGXsEH4Hn+YcEYHDcjklGVMZhY5YeHBhZZEU2mtT",
+	"mQT0vOBybkv1+kvs7Ho5iyR5sFi95d9LCn8zDv4qYP3jBCwTfrgWy6gmZa0m9DWlrlehaxOhaz2N0dyZ",
+	"zWmMity1ryFSbRGH1pHdkqro8yqA/QtvnZcSnt54pVVKrCKqkv5Ji0BmrByek4B4ksyVcNiQXTr1Nl5A",
+	"n/T6Yv2vM88qr19bI3ElTtl0XiRWgobxqPG4kMhXtqaE1RHjEuKdaFpv0QR56nZESBpCNmLhevmoYdVY",
+	"qUqWisxDrI54pMUVleU3IQLxkErpRkXa6EN4Mig9q6kd7LaxYyvng+Fi4be0ImeajMTn2kNHV69KA3YW",
+	"hB+1gatcshrzaJFaOSzSIqV9lr1wbLYzwiY05qZWSOf8pKqSYQnlrodAfjy7SNhaoZQWlGt1+hOUHF/m",
+	"Gc6z3I8W2NcBz7OhV33Jq75krSv/zR/mr4pqlCxBYu4xhNe656uqQCzDOMiW+KoV+btqRSqLkh+JLMGy",
+	"P02WzCPYmtKN7ntDyfS5cfsPi5fFK8L+HcXaelWsWUuX4FDFBgRRSZlQxnH/bNnnlYn/a5QMeYnjzdIC",
+	"MY7KW2XEctquvkZsTBwki9nabe6jy05PqBqKhe7Vc+PrJyEoud0KssqD3F1FTCCA7uWun4NcBZaXeCFk",
+	"A76qOv5Jd8IlkZsid6pfmBlHxrpKD4WwRWfIs/6Y6hDS4Kn6QrkhKmwfcCKPSRRgD7Qu85YvXV7eBgPF",
+	"PAgg5wxcbFsInVsiM1mlTBYPFS9kuoyIdItuv8zlNk9ua95zy6nt9bJ7vezmLrtiXad1rnTUj5WC/490",
+	"W2KTqOk6lXGiM/9gJy+UdWUAb/gsQQAdmituIR2j8kg2IU0+lOulHkFiTIh8wbtOQeNPUYP97W63v7NO",
+	"6q9wQ/7phJsFur6JuSwUVg8yH2nT9jkhCn+OIFHIfy5gQ26h19+EqRnq7BsKBUOITXEyTKgmq60NIDtn",
+	"aUcoc8c2pd6VJJ1Wj84yjFEwO+jq3k719ueYG1yOk21Hb/pVnfg3uZyfFW+xIdGPCQ7kuJzQ9ffqL1GM",
+	"RBKGWGeo9XKD1HWVIR1lG8wyk6BthpnfZ/CrSRLKE6EIhKrMrbpSeMJiFYQHF7sS+AWAXovoJvUAFkgk",
+	"3rjeZzE2wXYYkoZghog6I/AXw9RHMqZ49ILv2k8ali9y2+uxXl+zr3d1MdlShS9Lr2jbZF7K/uve0Z/s",
+	"pnJifScI0JTHDwHHPoo4D0zeVw8HWg/wRGKeqrJUuk7JIx7w0SwtnQAhtdTN5gzPbl1RweVAVNhUz1s6",
+	"48mcnydmjEN9svzsanidt1nYl4kOSkur4zhdbXb3KdS9SQ/yJUWAFJCvV/8Gz5RNbe7/GplBXVYKAHT0",
+	"DGe6nAX0N5Hz/IaxkzgtaPcy1/OXbNkvckVn471e06/XdCGlhETG1BMNIxOXk0siaWDLH68ha3scx4IU",
+	"idzOgGVyd3o56ZzqSWAqNXhYZWZHg5iSoSoIKjjkv1O3XkBHYzUET0ALB3rtF6PPrgbWpYHVi9BofsxX",
+	"On2l00I6Zdwn4g1kEQroMvW1aqizDSHVsNo1B/6lj/pgkIzxUKVDgkG0CAlP2txlmJN3YVLxcnTWU8N1",
+	"0r1uQmdqRTCCcol/pap/ksn1Asyb5LlI22caa+EZZBvpWDzIj6qGB2Ia0phMVVCFKXOFCFPaHf/l7J8F",
+	"+L6mCXQO3V9tnq82z/z9oZUG/yRdzAXsCGFHQeHoZG4X9TGpUkV7ZjhaGPDBGOMCdcsUiz9HAaJX/6r9",
+	"eNV+vDytCzFe7tBnif7y8lOpN99fl/BPwDnK1H1tBFDYbn4nQxOjJRJlJiW+k4e/jnT1pD4z+TYz+WB+",
+	"FIPzcmaFhLzP1UChJpJcJe3XWXPBmUqQuG7yw0aQxJ1xFGBp7TygAs7n2vY5EVaduyCHYFa+riWiyKaM",
+	"6VKMn+mOJXIjPCvSan6oV5HkHySSSKoiQ5eEO7vZNJBuXV31NFYPYG7quueHEhJye3D+UE8ZhZfEMWES",
+	"xUS36zOTQjLzl+BoTILIJHkezkzCeElDG4XKXtAr68oA50V0TJdqw2bE17fwq4apkBxN0pdycnTsHybR",
+	"TJre9+8hOFyb1ZYYdcymzF1vciXT0JandePHlfRgYUBFFvyTcpOF4qDAhXL6BzOPaQrenww4iQGqr/M0",
+	"G0ctNeqcjRly1lKoHAr5fcBNq45soESfuT4neVlnC6EznZGZpGdoNOiUpSM4xYlfTr4wh/A8P28zyKui",
+	"49/yjPr1H+N/4s0wJuSJLAvCPqVD6WY31z1sNXwqreT/ojHXBufFsV7eK8r/zSOw55Dn73GFauTLcsVl",
+	"lbFVWF5m22WSBkZBH9F4Zivcd9gMQZYUCE4yO9fXVIC9F33HFpDLmteN2Zoe4PWqeX3Alt4Yf5i/Tg5/",
+	"vcGRemsuEaP/kjLz6n7pFiuVadBAUCFLhEHGVi+/+/lgKFNLKNPA95k5S/vJKcKvizQZQP8ZPOPa7tXs",
+	"4/WyfY1PKOUC9lUGj7Jyss87TPw9bvuO79ft3Qyv2JiEiqwFmZAYLzg9U5YmMANtuPUwDhNpiyLHRKVm",
+	"o9q/mDKfTqif4EA5canxsdHWY8lDqoaYpca67OV6Mpz3iA65r0uEepwZRV4wy+V7AxnjHh7pfaZmMq/d",
+	"mMiYvigPuc2hw0tEM9sRzzkPzuwixctmMCub4+8czPmnZibbAIL/VDEoxwDf/DF1AKEbDDiXQsY4WuQw",
+	"OTM9ShsiH0u8XnIRXbWJTghSR4P9cH40q5vLMS0wAPSZhSqo/8nUkU7AMTWNA7PMXAV2fdHTHIB/tS2I",
+	"02cxYb5bzS8NrxjjWNZVTIU3zo1DGVK7QLqj4qIZy+OJ36CMSl2KDbYBTkuwN9BKbiH0mVNbA9yOj2OC",
+	"RoQpLITcDWNiSsOp3ospN9nMlCN0K8mqMWLiY0+3gx+MpZMzSZjUbz7lF0UDIlQ1TBJJJMdcOJNbMKSx",
+	"7TbatM9UrSyGGJdIcpuzHwnixUTqMNed5o6b/tN5Vc45cikN6IDoCpHafAO5NjtCV9C1dXJh8znX/Tqi",
+	"joCJHaGT20zrHmb6flHIIXgSey/p6Zi7LW7nKOdDSjcvzuPToV+l0/8QL67bv95PYyrJX8WwtLrfPD//",
+	"k6xSYfGjOZ+/ho7iP9FFrVQO7vLJYgr5uq3rqtgglW45CGDhmGmeaovCQgYdnkgUk7xhe0zCLZReCcVx",
+	"/Yb7pll+7GUmcTwiMktFrGMMuXA8YIBlZavQbH3CH4BFXhAlMtOAYmltWYmIdJFxHf6nxgChX92HLMvD",
+	"lQni8HWIaSCgsDKa4pktiFFftIaBWSogQ+nMpFedSesvI5x37ct93bR5zjhqjFfV36uVaX1+FhNBn1Zy",
+	"NN3qP8zOdNlSYUjOyDTgOe+ByKaY10JC98VYY5VqRK8fkotoLUFhZrF6VqZpQGzYFkpYmgKpzyyzoQKN",
+	"cRQRJjKeaMvxZDJ1bh04JmoskP835xYX+ryeyS/0KK8c419sLFjTKpBD5f+ybeC5Ov6ivfwFNP2vav1/",
+	"sVrfLZuxtApvpHU/bp2NjA4htY/7hS68B7ReOVWAL9aJq1uti9YnZUVM6lkKLnvRYdFnnBHtn0oesVqm",
+	"uv0OTpCYCUlCUy56kNBAyc+5tUVa3cRGxGrgLYVRUVCVBFH1fgEndNDGgIF+q1ZG91lNmPTVQYcpWdfn",
+	"wWKLutjnTWGRk0L5QuIHI6Q4m/tN6PpyalSRDNTCBkT0jc4MCQmJl9TuGQn0G0Wpt+BhkmU4sTtPc6Cl",
+	"YpDScGGJpiTOWuknUwgsSC80VUmlO0Anh9YzmBKtd1LSEdB7PX3g5DciJJaJsK+diEOaNnXgxpuvMLdE",
+	"yvKOXMTeODu4M8qzpBbijvNa0OQfWtDEZaZv/nD+Vb38K5sjggKdCrwhqMy732PQzxrmOs84HP6GA8Ft",
+	"zkLD2VQQn6Vl/YLoM5dfqjmN/hmCdYwu3F3Yclc+lxSP8kB5lTH+AfVjl4oGy0uXsmKev2kJ07Uwrfk3",
+	"ZNv/6MCQOYa5mSZdWbPiAnw7NzxQf6+QthraibRidsbrNEO0uheDoZbFggCnemq9jS5KXNeaG5O9joYm",
+	"6CCnBzeZaFekzNKr2gyXoetfAY3/6te4PqByFKLhAgqVhOKGGoeW4Y9+7DCDl+oKNigDV7KxgDhPlCFi",
+	"BCSkWJectvpJ/UYKYoJ940Sssyw+0CgyCRSVARfHyl6ikkhhCmmP9V4Mago8JMGsgmXhJEzxcE25Wk/4",
+	"LPccO8Tf+vb/F4jDmYndVl5fWjzHNKpWxrcoNM0QQUpaBqkdrwfrE3JjOtgU3jFEyad+LE7tARXfJqwf",
+	"CONSZ/7UWnwVPa+IJxpjAXXmkRdQXVEfMyQkITF4pggk+RTHvrA9iJ8uuZzVf1mE3vP8HOym/1VXwHoY",
+	"a/G8wkstf+mnFfvTUEicrYT4gAa/CSPH9i1QBKxGOydtpXpgjDwsPAw640yBkpqIfFPiMgh0/RpMGfGX",
+	"XTFL32bnqf/F6zvs7/8Og2ZGSzqnoTUHLUBVkHkB+2RI3WwfDlc1feu5as2qt5ucQyDjcmE1Bzi3es04",
+	"c9iunPqwsEWd4/RxB2ZVU6zaSwWeKopWRqYoM5IYj46qNNlnZv4imiwXgDK6We+Ns7x687+VAv9tOsXn",
+	"WGzMMG9CEg4A6f6owBGgbTFjQF09ENDiWUTYpcTeQ59pySVzWACSoiOt3uG5V+8KSW1xpLnuCF3rJuCa",
+	"pVoIUzUehThSph5gDjnvU7VaU7++XIYyVGp2uJH8FOWG+HsT2n9NDVQWPqO4t8IOWw3QnvBiQgk49NX8",
+	"2D3pNR+muYM+YROq6fDVWeVf4d6W+R9bvrrRy8Fy5Td/KLQ+OVxq9LkAo6l+Suh+2RO0VNW9KLsbnL+G",
+	"CV8F+b+Xt3se26pe5Js7QGm0rJ5G2MXO30Th7V2a57cUP5/DmS948OpA+I9A9nVZKx8OBxzHSi9SSeh1",
+	"2rvi7pnzsyQ4VqLmlGXiZZ9RpnPgibqOCuNDEzcGWScHxC07DPFScUj8Uhn4oy2B7JbodtZmlIsoEXhE",
+	"TEaqNDxhpcXT0JizqedIuc4wr/bOFxN0P5ARZSKHj/nXz7G+9alAEadMIsZBp5HX6UH1ay9z+Z45nlr1",
+	"NG2M67Gec/zW4YEQoUhjrUQ0IS05FF4uXi9Fs1fe+5qbeQnPfmPwrNyu6jS2SFkQzlasDNTNwaPEHQb4",
+	"eN2gu6E7RzOYhawiBFlARZ9ZJp+SBaIM8di3aYuxHtR1l0xbpiG4fZYOnXpb2VDfmEwoT4QZBmKReRZD",
+	"YhKt6o8hnvVZbgY8whDODDbaGThnGkuuJWlbssEiRuq7BbSPhpThIH/ZcGbKRdibU0++MWswh/EMUW9x",
+	"sBVv8b/xFfcaQlLCO2IekAGF2IlqWk7VAZkeJbrOC6eJQKMYM5mPaoegCq2wHGBBfPPYoTE6Ozk8QLBm",
+	"8xwSYxohHqMvZCYkZ4rm1QB1XWRFrSE1lCguYX3X0ye+9oeWM+OyvkKJGudWTtla4uGFC8pnEI8a54MZ",
+	"51UV+nISYmbLyuHwqlOe58ELx7wZ93VO+fWl/ar93JBlv/kjzvCougN8ngI20Ye6VHCRX8Lro+UfrR3N",
+	"oU5FD/R18W3JzboS2Ta6aF/R7i/ssj7H4tbVq2tLto7VU2sweYJdlFypXl+Fga8ywCvzXO8qn1C/0LHk",
+	"3CYps21W89hDYkJQTbQaT/ysdy7xGRXIJ1HAZ/DmguwW2pdWjHkS+OCPAj3kLCJIclNVwjJrsx7jPqac",
+	"zAQiW6MthJnr1JK2pNq/LVPLLOz/DY8IE6objGYD9W0csA5fpTFBOPNgeyDMLbecCOLbtPd6ufAqrHS5",
+	"mEPY8A6B3v8Cb935o3rj5IdqZPmhGvAyX0TPFDNK8kqtl2PR6A9ssLoSdUdpxpjC8U1rHeFhKcEzj0Fj",
+	"ZjWuwwMyxsHQyUg4NJms7AjQsM8sfurqaPMxdLqb4+e1Bi6eWSB3sr0cpFu5AAhvgq589bivUUuL1GCx",
+	"v5HCbyVt6LAGGlA5azxxpuAUcO+hISSP8Ygsow9oiExD5I6E1EhVgzZUNF026IQHSVgwmijx1kVjlfgh",
+	"06pVx+4+Ky+QrwWwMTZZSdUStQLQjJbmcRBEah36gerfsLlpbFq2McG+dmVjvAFTqL9HRKJhTMQYxXoF",
+	"W312MnSWSIWb6qme2geUcVmvM3aWnqZWUHvvM4xucQx58vTsOiMGRhGJKffrKMZybCsrKjtBoUC5hNKd",
+	"k/muDuaDQoNLgy7PInZ3pIVpXLrbrih7dU2W638Gr1BjVJBWDdldZwj0l2Y3ag+JXMpoTJOXYjGlw73y",
+	"mL8qjzkwSPIs9mIGeeUs/0TOQh71/hqMSJV/d6lkbxsj03gzPjI/yjL2kdn9+qw6+1iDZI7MYnp2+88i",
+	"lfnRXknkv43fwwBPeCyqXJO66fPuRjNd9pJcitaQTOz1VvyTb8VjgwLPomwzyCtB/wPuPEOkb/7Qf5iS",
+	"IzyMsKSDgDR0poI1WAZ0QHYELRg/i43oFWRlIawCNA3mYjgkvpl+q8+OeYw+nl+bH0RdJ0A1o0AnzBCb",
+	"UJ9i5Md0QuI0RQSWKCBYQDQyI9M+0wHFZqjfBAopo2ESLvRz6PiVMz2LMx2naHiQIuGJxsFn8Sw9xt+d",
+	"Zf0XDUb/NV63nuU0Y2PV8nytzzHHBAdyvIwj6hZVlPw6j5OOSMXe2CoS+FBHiGRDmrEgNROglNC2Kj2D",
+	"SZ+qqA7FJALffcVsYoLEGPIw0dhLqK2VntGsn8SKoCEMpc8MDwi0y79mAsaaZaKs8xygkOT7DOxZMRkG",
+	"YHmOiUeYtJxLoBD7ZL48UT2XCTeK+YD0md0colKQYLgOL/mkj+hZDEOP8fp6sJ/fVJcEoOXLXfqvT4e/",
+	"xAX9egu/PhwcdvBAZo0I0+XqhAeikoDTDRUJtnc1pZjhAH1WgQWsgfaQToc+98lsR3lVZL0E7plxl6Je",
+	"GgZhGm+GgnampTcRJFczIfR8LUHFZs96HnLZUV4z8D0Dp34mXOKlGAUtqrvuGFGmPu/jwPzUZKBHDGhI",
+	"pUkGaeP0IJCu3mc2YHsBI5fgYuo2tg4mftXbfxYe6jFeWVw1dCxrrp+5eZy6yh92eQY8CD1zL0XFznQm",
+	"aONtmN8C1AV0I9IQZX4iVAyokJj5OPbRmerSVognucdVeY9OOnw2tE35p6OLVB0O6zWpV2frkKVqu09X",
+	"V+doQHAMcvIDYSgkcswVHtuQWh7hnwlBn2+vHElftUwlXyWjM7vCOQgNAz41QauUUfC7c1MM2hX1WWJy",
+	"9dVRSLCpDoklmvFEt2EEyEkRGBRI4PBEdtK5preEFsT1ez4gE8xk5kTaOT/Rq2EwMsQDw7ywVb2kXLLC",
+	"LHOE8fXUq1frGyaxqZYQa45S7KqqGABVdK8gU6vXGA6JypC1iEmdeUyCwlWLSAgTKkSDYESTxSULieRD",
+	"3cIJgD7gzCORTHRZ+TGJdWyyBZlRXbi5ICFT9JDEhHnmhHOesDYzpNFd5A99C6FbIwZmid3U4BixxFzQ",
+	"8zUm0AlL69uRR2mlRidl5WWasrLPcp3N1Z8BIMAz/cRKD16gMAkkbUjCMFQw4oEpgqzgnk2S1tpHuTql",
+	"qpHwcJDPNrJY5khYqOZyEms4zBUVPHfH58MMe21Fjgw21uPY5yyXnoTHfZYdVx2N+ZRMYONUoABLU1I1",
+	"5kqLpX4iQqBhQB6VOtek6SwAMJCbKiw9geeyN+ZcECR4SBR3wUkg0QQHCRHwsp3xJJuZOgDHaIgBkmpD",
+	"A6JWo/MOqi2QmBLmkZQ0wPs3JY0Dg98l6I99ZQEQMs44bjpr6mirr1xucxPaUzMevNSHgq4mZbM6ArUy",
+	"kXHVrDB2yqdsnlE1u6YFW3Lc1HfrM2D8KZuKM0uHu+QJmc+0pJmGXXrGL/zQhUpnYdsl8HHElAWn/hyw",
+	"sgsqDx5grBMcU54Ix3c55WrxXGL6mGSl7dIylfoI81W8JjRWPKjPQuyNKdP+/hrlta5pC91CMUzFm6H6",
+	"OGaaZ+m5s2JVYG8S6an0WTYhlbpyusfDUNfYTS+SIY2FVNQlFBbnQhRcCOlqvzw2yqMRkeoMk0j9w8eS",
+	"aADxYREgsvtIV7NiIgkjW/cBjrVADEnPODu6NB7j3FlY7dfvv/6/AQBEEn8iygADAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -15,6 +15,142 @@ const (
 	Oauth2AuthenticationScopes = "oauth2Authentication.Scopes"
 )
 
+// Defines values for ActivityResourceKind.
+const (
+	ActivityResourceKindControlPlane      ActivityResourceKind = "controlPlane"
+	ActivityResourceKindKubernetesCluster ActivityResourceKind = "kubernetesCluster"
+	ActivityResourceKindProject           ActivityResourceKind = "project"
+)
+
+// Defines values for ActivityType.
+const (
+	Created                  ActivityType = "created"
+	Deleting                 ActivityType = "deleting"
+	StatusChanged            ActivityType = "statusChanged"
+	UpgradeApprovalRequested ActivityType = "upgradeApprovalRequested"
+)
+
+// Defines values for ApplicationBundleChannel.
+const (
+	Preview ApplicationBundleChannel = "preview"
+	Rapid   ApplicationBundleChannel = "rapid"
+	Stable  ApplicationBundleChannel = "stable"
+)
+
+// Defines values for ControlPlaneResourcesClass.
+const (
+	Large  ControlPlaneResourcesClass = "large"
+	Medium ControlPlaneResourcesClass = "medium"
+	Small  ControlPlaneResourcesClass = "small"
+)
+
+// Defines values for DebugResourceMutationOperation.
+const (
+	Create DebugResourceMutationOperation = "create"
+	Delete DebugResourceMutationOperation = "delete"
+	Patch  DebugResourceMutationOperation = "patch"
+	Update DebugResourceMutationOperation = "update"
+)
+
+// Defines values for DeprecatedUsageKind.
+const (
+	Field     DeprecatedUsageKind = "field"
+	Operation DeprecatedUsageKind = "operation"
+	Parameter DeprecatedUsageKind = "parameter"
+)
+
+// Defines values for InventoryRecordKind.
+const (
+	InventoryRecordKindApplicationBundle     InventoryRecordKind = "applicationBundle"
+	InventoryRecordKindApplicationCredential InventoryRecordKind = "applicationCredential"
+	InventoryRecordKindControlPlane          InventoryRecordKind = "controlPlane"
+	InventoryRecordKindKubernetesCluster     InventoryRecordKind = "kubernetesCluster"
+	InventoryRecordKindProject               InventoryRecordKind = "project"
+)
+
+// Defines values for KubernetesClusterAddOnHealthStatus.
+const (
+	Degraded    KubernetesClusterAddOnHealthStatus = "degraded"
+	Healthy     KubernetesClusterAddOnHealthStatus = "healthy"
+	Missing     KubernetesClusterAddOnHealthStatus = "missing"
+	Progressing KubernetesClusterAddOnHealthStatus = "progressing"
+	Suspended   KubernetesClusterAddOnHealthStatus = "suspended"
+	Unknown     KubernetesClusterAddOnHealthStatus = "unknown"
+)
+
+// Defines values for KubernetesClusterAutoscalingConfigurationExpander.
+const (
+	LeastNodes KubernetesClusterAutoscalingConfigurationExpander = "least-nodes"
+	LeastWaste KubernetesClusterAutoscalingConfigurationExpander = "least-waste"
+	MostPods   KubernetesClusterAutoscalingConfigurationExpander = "most-pods"
+	Random     KubernetesClusterAutoscalingConfigurationExpander = "random"
+)
+
+// Defines values for KubernetesClusterCertificateReissueStatus.
+const (
+	Complete   KubernetesClusterCertificateReissueStatus = "complete"
+	InProgress KubernetesClusterCertificateReissueStatus = "inProgress"
+	Pending    KubernetesClusterCertificateReissueStatus = "pending"
+)
+
+// Defines values for KubernetesClusterNetworkKubeProxyMode.
+const (
+	Iptables KubernetesClusterNetworkKubeProxyMode = "iptables"
+	Ipvs     KubernetesClusterNetworkKubeProxyMode = "ipvs"
+)
+
+// Defines values for KubernetesClusterNetworkPlugin.
+const (
+	Calico KubernetesClusterNetworkPlugin = "calico"
+	Cilium KubernetesClusterNetworkPlugin = "cilium"
+	None   KubernetesClusterNetworkPlugin = "none"
+)
+
+// Defines values for KubernetesClusterTaintEffect.
+const (
+	NoExecute        KubernetesClusterTaintEffect = "NoExecute"
+	NoSchedule       KubernetesClusterTaintEffect = "NoSchedule"
+	PreferNoSchedule KubernetesClusterTaintEffect = "PreferNoSchedule"
+)
+
+// Defines values for KubernetesClusterWorkloadPoolOperationType.
+const (
+	Add     KubernetesClusterWorkloadPoolOperationType = "add"
+	Remove  KubernetesClusterWorkloadPoolOperationType = "remove"
+	Replace KubernetesClusterWorkloadPoolOperationType = "replace"
+)
+
+// Defines values for KubernetesUpgradePhase.
+const (
+	KubernetesUpgradePhaseControlPlane  KubernetesUpgradePhase = "controlPlane"
+	KubernetesUpgradePhaseWorkloadPools KubernetesUpgradePhase = "workloadPools"
+)
+
+// Defines values for KubernetesVersionPhase.
+const (
+	Deprecated  KubernetesVersionPhase = "deprecated"
+	RemovedSoon KubernetesVersionPhase = "removed-soon"
+	Supported   KubernetesVersionPhase = "supported"
+)
+
+// Defines values for MonitorShardResourceKind.
+const (
+	MonitorShardResourceKindControlPlane      MonitorShardResourceKind = "controlPlane"
+	MonitorShardResourceKindKubernetesCluster MonitorShardResourceKind = "kubernetesCluster"
+)
+
+// Defines values for NodeAllowListRuleDirection.
+const (
+	Egress  NodeAllowListRuleDirection = "egress"
+	Ingress NodeAllowListRuleDirection = "ingress"
+)
+
+// Defines values for NodeAllowListRuleProtocol.
+const (
+	Tcp NodeAllowListRuleProtocol = "tcp"
+	Udp NodeAllowListRuleProtocol = "udp"
+)
+
 // Defines values for Oauth2ErrorError.
 const (
 	AccessDenied            Oauth2ErrorError = "access_denied"
@@ -28,14 +164,146 @@ const (
 	NotFound                Oauth2ErrorError = "not_found"
 	ServerError             Oauth2ErrorError = "server_error"
 	TemporarilyUnavailable  Oauth2ErrorError = "temporarily_unavailable"
+	TooManyRequests         Oauth2ErrorError = "too_many_requests"
 	UnauthorizedClient      Oauth2ErrorError = "unauthorized_client"
+	UnprocessableEntity     Oauth2ErrorError = "unprocessable_entity"
 	UnsupportedGrantType    Oauth2ErrorError = "unsupported_grant_type"
 	UnsupportedMediaType    Oauth2ErrorError = "unsupported_media_type"
 	UnsupportedResponseType Oauth2ErrorError = "unsupported_response_type"
 )
 
+// Defines values for OpenstackCircuitState.
+const (
+	Closed   OpenstackCircuitState = "closed"
+	HalfOpen OpenstackCircuitState = "halfOpen"
+	Open     OpenstackCircuitState = "open"
+)
+
+// Defines values for OpenstackLoadBalancerProvider.
+const (
+	Amphora OpenstackLoadBalancerProvider = "amphora"
+	Ovn     OpenstackLoadBalancerProvider = "ovn"
+)
+
+// Defines values for OpenstackMachinePoolServerGroupPolicy.
+const (
+	Affinity         OpenstackMachinePoolServerGroupPolicy = "affinity"
+	AntiAffinity     OpenstackMachinePoolServerGroupPolicy = "anti-affinity"
+	SoftAffinity     OpenstackMachinePoolServerGroupPolicy = "soft-affinity"
+	SoftAntiAffinity OpenstackMachinePoolServerGroupPolicy = "soft-anti-affinity"
+)
+
+// Defines values for OpenstackServiceName.
+const (
+	BlockStorage OpenstackServiceName = "blockStorage"
+	Compute      OpenstackServiceName = "compute"
+	Identity     OpenstackServiceName = "identity"
+	Image        OpenstackServiceName = "image"
+	Network      OpenstackServiceName = "network"
+)
+
+// Defines values for ProjectOffboardingStage.
+const (
+	ProjectOffboardingStageClusters      ProjectOffboardingStage = "clusters"
+	ProjectOffboardingStageControlPlanes ProjectOffboardingStage = "controlPlanes"
+	ProjectOffboardingStageProject       ProjectOffboardingStage = "project"
+)
+
+// Defines values for ProjectRole.
+const (
+	Admin  ProjectRole = "admin"
+	Editor ProjectRole = "editor"
+	Reader ProjectRole = "reader"
+)
+
+// Defines values for ProjectRoleBindingSubjectKind.
+const (
+	KeystoneRole ProjectRoleBindingSubjectKind = "keystoneRole"
+	OidcGroup    ProjectRoleBindingSubjectKind = "oidcGroup"
+)
+
+// Defines values for ProviderType.
+const (
+	Openstack ProviderType = "openstack"
+)
+
+// Defines values for ResourceReferenceKind.
+const (
+	ResourceReferenceKindControlPlane      ResourceReferenceKind = "controlPlane"
+	ResourceReferenceKindKubernetesCluster ResourceReferenceKind = "kubernetesCluster"
+	ResourceReferenceKindProject           ResourceReferenceKind = "project"
+)
+
+// Defines values for ClusterViewParameter.
+const (
+	ClusterViewParameterRaw ClusterViewParameter = "raw"
+)
+
+// Defines values for DryRunParameter.
+const (
+	DryRunParameterCost DryRunParameter = "cost"
+)
+
+// Defines values for PostApiV1ControlplanesControlPlaneNameClustersParamsDryRun.
+const (
+	PostApiV1ControlplanesControlPlaneNameClustersParamsDryRunCost PostApiV1ControlplanesControlPlaneNameClustersParamsDryRun = "cost"
+)
+
+// Defines values for GetApiV1ControlplanesControlPlaneNameClustersClusterNameParamsView.
+const (
+	GetApiV1ControlplanesControlPlaneNameClustersClusterNameParamsViewRaw GetApiV1ControlplanesControlPlaneNameClustersClusterNameParamsView = "raw"
+)
+
+// Activities A list of activities.
+type Activities = []Activity
+
+// Activity Something that happened to a resource within the project.
+type Activity struct {
+	// ControlPlaneName The control plane a Kubernetes cluster belongs to.
+	ControlPlaneName *string `json:"controlPlaneName,omitempty"`
+
+	// Kind The kind of resource an activity relates to.
+	Kind ActivityResourceKind `json:"kind"`
+
+	// Message A human readable description of the activity.
+	Message *string `json:"message,omitempty"`
+
+	// Name The resource name.  For projects this is the provider's project ID.
+	Name string `json:"name"`
+
+	// Reason A short, machine readable, reason for the activity.
+	Reason *string `json:"reason,omitempty"`
+
+	// Time When the activity occurred.
+	Time time.Time `json:"time"`
+
+	// Type The type of activity.  A resource was created, a resource began deletion, a
+	// resource's status changed, or a resource's automatic upgrade requires approval.
+	Type ActivityType `json:"type"`
+}
+
+// ActivityFeed A page of activities, most recent first.
+type ActivityFeed struct {
+	// Continue When set there are more activities available, pass this value in the
+	// continue query parameter to get the next page.
+	Continue *string `json:"continue,omitempty"`
+
+	// Items A list of activities.
+	Items Activities `json:"items"`
+}
+
+// ActivityResourceKind The kind of resource an activity relates to.
+type ActivityResourceKind string
+
+// ActivityType The type of activity.  A resource was created, a resource began deletion, a
+// resource's status changed, or a resource's automatic upgrade requires approval.
+type ActivityType string
+
 // Application An application.
 type Application struct {
+	// Category A category used to group related applications in a catalog.
+	Category *string `json:"category,omitempty"`
+
 	// Description Verbose description of what the application provides.
 	Description string `json:"description"`
 
@@ -54,6 +322,9 @@ type Application struct {
 	// Name Unique application name.
 	Name string `json:"name"`
 
+	// Summary A short, single line summary of what the application provides.
+	Summary *string `json:"summary,omitempty"`
+
 	// Tags A set of tags for filtering applications.
 	Tags *ApplicationTags `json:"tags,omitempty"`
 
@@ -64,13 +335,26 @@ type Application struct {
 // ApplicationBundle A bundle of applications. This forms the basis of resource versions. Bundles marked
 // as preview should not be selected by default, and end of life bundles should not be
 // used to avoid unnecessary upgrades. If enabled, automatic upgrades will occur if
-// a newer version of a bundle exists that is not in preview. When a bundle's end of
-// life expires, resources will undergo a foreced upgrade, regardless of whether
-// automatic upgrade is enabled for a resource or not.
+// a newer version of a bundle exists that is not in preview, in the channel subscribed
+// to by the resource. When a bundle's end of life expires, resources will undergo a
+// foreced upgrade, regardless of whether automatic upgrade is enabled for a resource
+// or not.
 type ApplicationBundle struct {
+	// Channel A release channel for application bundles. Channels are cumulative, so rapid
+	// includes stable bundles, and preview includes both.
+	Channel *ApplicationBundleChannel `json:"channel,omitempty"`
+
+	// Display Human readable bundle metadata for use in a user interface.
+	Display *ApplicationBundleDisplay `json:"display,omitempty"`
+
 	// EndOfLife When the bundle is end-of-life.
 	EndOfLife *time.Time `json:"endOfLife,omitempty"`
 
+	// KubernetesVersions The range of Kubernetes versions supported by a Kubernetes cluster application bundle.
+	// Clusters using versions outside of this range will be rejected.  If a bound is not
+	// specified, then that bound is unconstrained.
+	KubernetesVersions *ApplicationBundleKubernetesVersions `json:"kubernetesVersions,omitempty"`
+
 	// Name The resource name.
 	Name string `json:"name"`
 
@@ -84,8 +368,63 @@ type ApplicationBundle struct {
 // ApplicationBundleAutoUpgrade When specified, enables auto upgrade of application bundles. All resources will be
 // automatically upgraded if the currently selected bundle is end of life.
 type ApplicationBundleAutoUpgrade struct {
+	// Channel A release channel for application bundles. Channels are cumulative, so rapid
+	// includes stable bundles, and preview includes both.
+	Channel *ApplicationBundleChannel `json:"channel,omitempty"`
+
 	// DaysOfWeek Days of the week and time windows that permit operations to be performed in.
 	DaysOfWeek *AutoUpgradeDaysOfWeek `json:"daysOfWeek,omitempty"`
+
+	// TimeZone An IANA time zone name e.g. Europe/London that time windows are defined in.
+	// When not specified, time windows are in UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// ApplicationBundleChannel A release channel for application bundles. Channels are cumulative, so rapid
+// includes stable bundles, and preview includes both.
+type ApplicationBundleChannel string
+
+// ApplicationBundleDisplay Human readable bundle metadata for use in a user interface.
+type ApplicationBundleDisplay struct {
+	// Category A category used to group related bundles in a catalog.
+	Category *string `json:"category,omitempty"`
+
+	// Description A short, single line summary of the bundle.
+	Description *string `json:"description,omitempty"`
+
+	// DocumentationUrl A link to documentation for the bundle e.g. release notes.
+	DocumentationUrl *string `json:"documentationUrl,omitempty"`
+
+	// IconUrl A link to an icon for the bundle.
+	IconUrl *string `json:"iconUrl,omitempty"`
+}
+
+// ApplicationBundleKubernetesVersions The range of Kubernetes versions supported by a Kubernetes cluster application bundle.
+// Clusters using versions outside of this range will be rejected.  If a bound is not
+// specified, then that bound is unconstrained.
+type ApplicationBundleKubernetesVersions struct {
+	// Maximum The newest supported Kubernetes version, inclusive.
+	Maximum *string `json:"maximum,omitempty"`
+
+	// Minimum The oldest supported Kubernetes version, inclusive.
+	Minimum *string `json:"minimum,omitempty"`
+}
+
+// ApplicationBundleUpgrade A pending application bundle upgrade. This is read only, and is populated when the
+// platform has scheduled an automatic upgrade.
+type ApplicationBundleUpgrade struct {
+	// ApplicationBundle The name of the application bundle that will be upgraded to.
+	ApplicationBundle string `json:"applicationBundle"`
+
+	// ApprovalRequestedAt When set, the upgrade is awaiting approval, and this is the time approval
+	// was first requested.
+	ApprovalRequestedAt *time.Time `json:"approvalRequestedAt,omitempty"`
+
+	// Id The upgrade identifier, this is used to approve the upgrade if required.
+	Id string `json:"id"`
+
+	// NextUpgradeAt The time at which the upgrade is scheduled to start.
+	NextUpgradeAt time.Time `json:"nextUpgradeAt"`
 }
 
 // ApplicationBundles A list of application bundles.
@@ -153,28 +492,367 @@ type ControlPlane struct {
 	// ApplicationBundle A bundle of applications. This forms the basis of resource versions. Bundles marked
 	// as preview should not be selected by default, and end of life bundles should not be
 	// used to avoid unnecessary upgrades. If enabled, automatic upgrades will occur if
-	// a newer version of a bundle exists that is not in preview. When a bundle's end of
-	// life expires, resources will undergo a foreced upgrade, regardless of whether
-	// automatic upgrade is enabled for a resource or not.
+	// a newer version of a bundle exists that is not in preview, in the channel subscribed
+	// to by the resource. When a bundle's end of life expires, resources will undergo a
+	// foreced upgrade, regardless of whether automatic upgrade is enabled for a resource
+	// or not.
 	ApplicationBundle ApplicationBundle `json:"applicationBundle"`
 
 	// ApplicationBundleAutoUpgrade When specified, enables auto upgrade of application bundles. All resources will be
 	// automatically upgraded if the currently selected bundle is end of life.
 	ApplicationBundleAutoUpgrade *ApplicationBundleAutoUpgrade `json:"applicationBundleAutoUpgrade,omitempty"`
 
+	// Components A list of control plane components.
+	Components *ControlPlaneComponents `json:"components,omitempty"`
+
 	// Name The name of the resource.
 	Name string `json:"name"`
 
+	// Placement Where a resource is provisioned in the management cluster, used to aid
+	// debugging.  This is read only, and only reported to administrators.
+	Placement *KubernetesResourcePlacement `json:"placement,omitempty"`
+
+	// Resources Resources allocated to a control plane.  A class selects a predefined size,
+	// and explicit CPU and memory requests override those of the class.  Values
+	// are Kubernetes resource quantities e.g. 500m or 1Gi.  When no properties
+	// are set the platform defaults are used.
+	Resources *ControlPlaneResources `json:"resources,omitempty"`
+
 	// Status A Kubernetes resource status.
 	Status *KubernetesResourceStatus `json:"status,omitempty"`
+
+	// Upgrade A pending application bundle upgrade. This is read only, and is populated when the
+	// platform has scheduled an automatic upgrade.
+	Upgrade *ApplicationBundleUpgrade `json:"upgrade,omitempty"`
 }
 
+// ControlPlaneComponent The version of an application that forms part of a control plane.  This is
+// read only, and is populated by the platform.
+type ControlPlaneComponent struct {
+	// DeployedVersion The version that is currently deployed.  This will differ from the required
+	// version during an upgrade, and is unset until the component is first deployed.
+	DeployedVersion *string `json:"deployedVersion,omitempty"`
+
+	// Name The name of the component.
+	Name string `json:"name"`
+
+	// Version The version required by the application bundle.
+	Version string `json:"version"`
+}
+
+// ControlPlaneComponents A list of control plane components.
+type ControlPlaneComponents = []ControlPlaneComponent
+
+// ControlPlaneMove Moves a control plane, and all of its clusters, into another project.
+// The user must be a member of the target project, and it must already
+// be provisioned.
+type ControlPlaneMove struct {
+	// Project The Openstack project ID to move the control plane into.
+	Project string `json:"project"`
+}
+
+// ControlPlaneResources Resources allocated to a control plane.  A class selects a predefined size,
+// and explicit CPU and memory requests override those of the class.  Values
+// are Kubernetes resource quantities e.g. 500m or 1Gi.  When no properties
+// are set the platform defaults are used.
+type ControlPlaneResources struct {
+	// Class A predefined resource allocation.
+	Class *ControlPlaneResourcesClass `json:"class,omitempty"`
+
+	// Cpu The CPU request.
+	Cpu *string `json:"cpu,omitempty"`
+
+	// Memory The memory request.
+	Memory *string `json:"memory,omitempty"`
+}
+
+// ControlPlaneResourcesClass A predefined resource allocation.
+type ControlPlaneResourcesClass string
+
 // ControlPlanes A list of control planes.
 type ControlPlanes = []ControlPlane
 
-// Hour An hour of the day in UTC.
+// DebugCapture A debug capture of an API request.
+type DebugCapture struct {
+	// CreationTime When the capture was made.
+	CreationTime time.Time `json:"creationTime"`
+
+	// ExpiryTime When the capture will be deleted.
+	ExpiryTime time.Time `json:"expiryTime"`
+
+	// Id The unique capture identifier.
+	Id string `json:"id"`
+
+	// Mutations A list of resource modifications.
+	Mutations DebugResourceMutations `json:"mutations"`
+
+	// ProviderRequests A list of HTTP exchanges.
+	ProviderRequests DebugHTTPExchanges `json:"providerRequests"`
+
+	// Request A sanitized HTTP request and response.  Sensitive headers and values are
+	// redacted, and bodies may be truncated.
+	Request DebugHTTPExchange `json:"request"`
+}
+
+// DebugHTTPExchange A sanitized HTTP request and response.  Sensitive headers and values are
+// redacted, and bodies may be truncated.
+type DebugHTTPExchange struct {
+	// Duration How long the exchange took in milliseconds.
+	Duration *int `json:"duration,omitempty"`
+
+	// Error Any transport error that occurred.
+	Error *string `json:"error,omitempty"`
+
+	// Method The HTTP method.
+	Method string `json:"method"`
+
+	// RequestBody The request body.
+	RequestBody *string `json:"requestBody,omitempty"`
+
+	// RequestHeaders The request headers.
+	RequestHeaders *map[string]string `json:"requestHeaders,omitempty"`
+
+	// ResponseBody The response body.
+	ResponseBody *string `json:"responseBody,omitempty"`
+
+	// Status The HTTP status code, this will be zero if no response was received.
+	Status int `json:"status"`
+
+	// Url The request URL.
+	Url string `json:"url"`
+}
+
+// DebugHTTPExchanges A list of HTTP exchanges.
+type DebugHTTPExchanges = []DebugHTTPExchange
+
+// DebugResourceMutation A sanitized modification to a Kubernetes resource.
+type DebugResourceMutation struct {
+	// Error Any error returned by the operation.
+	Error *string `json:"error,omitempty"`
+
+	// Kind The resource kind.
+	Kind string `json:"kind"`
+
+	// Name The resource name.
+	Name string `json:"name"`
+
+	// Namespace The resource namespace, if namespaced.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Object The resource as JSON, secrets are always redacted.
+	Object *string `json:"object,omitempty"`
+
+	// Operation The type of modification.
+	Operation DebugResourceMutationOperation `json:"operation"`
+}
+
+// DebugResourceMutationOperation The type of modification.
+type DebugResourceMutationOperation string
+
+// DebugResourceMutations A list of resource modifications.
+type DebugResourceMutations = []DebugResourceMutation
+
+// DeprecatedUsage Usage of a deprecated API feature by a client.
+type DeprecatedUsage struct {
+	// Count The number of requests that used the feature.
+	Count int `json:"count"`
+
+	// FirstSeen When the feature was first used.
+	FirstSeen time.Time `json:"firstSeen"`
+
+	// Kind The kind of deprecated API feature that was used.
+	Kind DeprecatedUsageKind `json:"kind"`
+
+	// LastSeen When the feature was last used.
+	LastSeen time.Time `json:"lastSeen"`
+
+	// Name The deprecated feature. Operations are identified by method and path,
+	// and fields by their dot separated path within the request body.
+	Name string `json:"name"`
+
+	// Subject The token subject of the client.
+	Subject string `json:"subject"`
+
+	// UserAgent The user agent of the client.
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// DeprecatedUsageKind The kind of deprecated API feature that was used.
+type DeprecatedUsageKind string
+
+// DeprecatedUsages A list of deprecated API feature usages.
+type DeprecatedUsages = []DeprecatedUsage
+
+// Environment Preview environment creation parameters.
+type Environment struct {
+	// Cluster Kubernetes cluster creation parameters.
+	Cluster KubernetesCluster `json:"cluster"`
+
+	// Name A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+	Name KubernetesNameParameter `json:"name"`
+
+	// Template The name of a cluster template.  Any optional cluster values omitted from
+	// the request will be defaulted from the template.
+	Template string `json:"template"`
+}
+
+// EnvironmentStatus A preview environment's status.
+type EnvironmentStatus struct {
+	// Cluster A Kubernetes resource status.
+	Cluster *KubernetesResourceStatus `json:"cluster,omitempty"`
+
+	// ControlPlane A Kubernetes resource status.
+	ControlPlane *KubernetesResourceStatus `json:"controlPlane,omitempty"`
+
+	// CreationTime The time the environment was created.
+	CreationTime time.Time `json:"creationTime"`
+
+	// Name The environment name.
+	Name string `json:"name"`
+
+	// OperationId Uniquely identifies the operation that created the environment.
+	OperationId string `json:"operationId"`
+
+	// Status The overall status of the environment.  This is "Provisioned" once the
+	// control plane and cluster are both provisioned, otherwise it is the status
+	// of the least progressed resource.
+	Status string `json:"status"`
+}
+
+// ExportBundle A portable definition of all control planes and clusters in a project.
+// Credentials and other secrets are never exported, they are regenerated on
+// import.  Installation specific OpenStack references are replaced with
+// placeholders that must be provided on import.
+type ExportBundle struct {
+	// ControlPlanes A list of exported control planes.
+	ControlPlanes ExportedControlPlanes `json:"controlPlanes"`
+
+	// Version The bundle format version.
+	Version int `json:"version"`
+}
+
+// ExportedControlPlane An exported control plane and its clusters.
+type ExportedControlPlane struct {
+	// Clusters A list of Kubernetes clusters.
+	Clusters KubernetesClusters `json:"clusters"`
+
+	// ControlPlane A control plane.
+	ControlPlane ControlPlane `json:"controlPlane"`
+}
+
+// ExportedControlPlanes A list of exported control planes.
+type ExportedControlPlanes = []ExportedControlPlane
+
+// Hour An hour of the day, in the auto upgrade time zone if specified, otherwise UTC.
 type Hour = int
 
+// ImportOptions Import parameters.
+type ImportOptions struct {
+	// Bundle A portable definition of all control planes and clusters in a project.
+	// Credentials and other secrets are never exported, they are regenerated on
+	// import.  Installation specific OpenStack references are replaced with
+	// placeholders that must be provided on import.
+	Bundle ExportBundle `json:"bundle"`
+
+	// ExternalNetworkID OpenStack external network ID to substitute for the external network
+	// placeholder.  This is required if any clusters use the placeholder.
+	ExternalNetworkID *string `json:"externalNetworkID,omitempty"`
+}
+
+// ImportResult The outcome of importing a control plane or cluster.
+type ImportResult struct {
+	// ClusterName The cluster name, if this result is for a cluster.
+	ClusterName *string `json:"clusterName,omitempty"`
+
+	// ControlPlaneName The control plane name.
+	ControlPlaneName string `json:"controlPlaneName"`
+
+	// Created Whether the resource was created, resources that already exist are
+	// left unmodified.
+	Created bool `json:"created"`
+}
+
+// ImportResults A list of import results.
+type ImportResults = []ImportResult
+
+// InventoryApplicationBundle An application bundle that is in use.
+type InventoryApplicationBundle struct {
+	// EndOfLife When the bundle is no longer supported.
+	EndOfLife *time.Time `json:"endOfLife,omitempty"`
+
+	// Name The name of the bundle.
+	Name string `json:"name"`
+
+	// Resources The number of control planes or clusters using the bundle.
+	Resources int `json:"resources"`
+
+	// Version The version of the bundle.
+	Version string `json:"version"`
+}
+
+// InventoryApplicationCredential An application credential managed by the platform on behalf of a cluster.
+// Only metadata is exported, the secret is never exposed.
+type InventoryApplicationCredential struct {
+	// ControlPlane The control plane the cluster belongs to.
+	ControlPlane string `json:"controlPlane"`
+
+	// Id The OpenStack application credential ID.
+	Id string `json:"id"`
+
+	// KubernetesCluster The cluster the credential is used by.
+	KubernetesCluster string `json:"kubernetesCluster"`
+
+	// Project The project the cluster belongs to.
+	Project string `json:"project"`
+}
+
+// InventoryRecord A single inventory record.  Exactly one of the resource, application credential
+// or application bundle properties is set, depending on the kind.
+type InventoryRecord struct {
+	// ApplicationBundle An application bundle that is in use.
+	ApplicationBundle *InventoryApplicationBundle `json:"applicationBundle,omitempty"`
+
+	// ApplicationCredential An application credential managed by the platform on behalf of a cluster.
+	// Only metadata is exported, the secret is never exposed.
+	ApplicationCredential *InventoryApplicationCredential `json:"applicationCredential,omitempty"`
+
+	// Cursor An opaque cursor that identifies the record, passing it as the continue
+	// parameter resumes the export with the following record.
+	Cursor string `json:"cursor"`
+
+	// Kind The kind of inventory record.
+	Kind InventoryRecordKind `json:"kind"`
+
+	// Resource A project, control plane or cluster.
+	Resource *InventoryResource `json:"resource,omitempty"`
+}
+
+// InventoryRecordKind The kind of inventory record.
+type InventoryRecordKind string
+
+// InventoryRecords A list of inventory records.  When exported as newline delimited JSON each
+// record is on its own line.
+type InventoryRecords = []InventoryRecord
+
+// InventoryResource A project, control plane or cluster.
+type InventoryResource struct {
+	// ApplicationBundle The application bundle the resource is provisioned with, absent for projects.
+	ApplicationBundle *string `json:"applicationBundle,omitempty"`
+
+	// ControlPlane The control plane the resource belongs to, absent for projects and control planes.
+	ControlPlane *string `json:"controlPlane,omitempty"`
+
+	// Project The project the resource belongs to, absent for projects.
+	Project *string `json:"project,omitempty"`
+
+	// Spec The resource specification, as stored by the platform, with any secrets
+	// redacted.
+	Spec map[string]interface{} `json:"spec"`
+
+	// Status A Kubernetes resource status.
+	Status KubernetesResourceStatus `json:"status"`
+}
+
 // JsonWebKey JSON web key. See the relevant JWKS documentation for further details.
 type JsonWebKey = map[string]interface{}
 
@@ -192,9 +870,10 @@ type KubernetesCluster struct {
 	// ApplicationBundle A bundle of applications. This forms the basis of resource versions. Bundles marked
 	// as preview should not be selected by default, and end of life bundles should not be
 	// used to avoid unnecessary upgrades. If enabled, automatic upgrades will occur if
-	// a newer version of a bundle exists that is not in preview. When a bundle's end of
-	// life expires, resources will undergo a foreced upgrade, regardless of whether
-	// automatic upgrade is enabled for a resource or not.
+	// a newer version of a bundle exists that is not in preview, in the channel subscribed
+	// to by the resource. When a bundle's end of life expires, resources will undergo a
+	// foreced upgrade, regardless of whether automatic upgrade is enabled for a resource
+	// or not.
 	ApplicationBundle ApplicationBundle `json:"applicationBundle"`
 
 	// ApplicationBundleAutoUpgrade When specified, enables auto upgrade of application bundles. All resources will be
@@ -207,6 +886,14 @@ type KubernetesCluster struct {
 	// Features A set of optional add on features for the cluster.
 	Features *KubernetesClusterFeatures `json:"features,omitempty"`
 
+	// Hibernated Whether the cluster is hibernated.  This is read only, use the hibernate
+	// and resume APIs to modify it.
+	Hibernated *bool `json:"hibernated,omitempty"`
+
+	// KubernetesUpgrade An in progress Kubernetes version upgrade.  This is read only, use the upgrade
+	// API to start an upgrade.
+	KubernetesUpgrade *KubernetesUpgradeStatus `json:"kubernetesUpgrade,omitempty"`
+
 	// Name Cluster name.
 	Name string `json:"name"`
 
@@ -216,9 +903,27 @@ type KubernetesCluster struct {
 	// Openstack Kubernetes cluster creation OpenStack parameters.
 	Openstack KubernetesClusterOpenStack `json:"openstack"`
 
+	// Placement Where a resource is provisioned in the management cluster, used to aid
+	// debugging.  This is read only, and only reported to administrators.
+	Placement *KubernetesResourcePlacement `json:"placement,omitempty"`
+
+	// SshCertificateAuthority Enables a per-cluster SSH certificate authority.  Workload pool nodes
+	// will trust certificates issued by the SSH certificate API.
+	SshCertificateAuthority *bool `json:"sshCertificateAuthority,omitempty"`
+
 	// Status A Kubernetes resource status.
 	Status *KubernetesResourceStatus `json:"status,omitempty"`
 
+	// Upgrade A pending application bundle upgrade. This is read only, and is populated when the
+	// platform has scheduled an automatic upgrade.
+	Upgrade *ApplicationBundleUpgrade `json:"upgrade,omitempty"`
+
+	// UpgradeFreeze An upgrade freeze.  While active, the platform will not automatically upgrade
+	// the resource, even when its application bundle is end of life.  This allows
+	// upgrades to be blocked during change embargo periods.  When part of a cluster
+	// this is read only, use the upgrade freeze APIs to modify it.
+	UpgradeFreeze *UpgradeFreeze `json:"upgradeFreeze,omitempty"`
+
 	// WorkloadPools A list of Kubernetes cluster workload pools.
 	WorkloadPools KubernetesClusterWorkloadPools `json:"workloadPools"`
 }
@@ -228,10 +933,40 @@ type KubernetesClusterAPI struct {
 	// AllowedPrefixes Set of address prefixes to allow access to the Kubernetes API.
 	AllowedPrefixes *[]string `json:"allowedPrefixes,omitempty"`
 
+	// LoadBalancer Selects how an OpenStack load balancer is implemented.  Where not specified,
+	// the cloud's defaults are used.
+	LoadBalancer *OpenstackLoadBalancer `json:"loadBalancer,omitempty"`
+
+	// Private When true, the Kubernetes API is not allocated a floating IP address and
+	// is only accessible on the node network.  This cannot be used in conjunction
+	// with allowed prefixes, and cannot be changed once the cluster is created.
+	Private *bool `json:"private,omitempty"`
+
 	// SubjectAlternativeNames Set of non-standard X.509 SANs to add to the API certificate.
 	SubjectAlternativeNames *[]string `json:"subjectAlternativeNames,omitempty"`
 }
 
+// KubernetesClusterAPIServerHealth Kubernetes API server health.
+type KubernetesClusterAPIServerHealth struct {
+	// Reachable Whether the API server can be contacted and reports it is ready.
+	Reachable bool `json:"reachable"`
+}
+
+// KubernetesClusterAddOnHealth The health of an add-on managed by the cluster's application bundle.
+type KubernetesClusterAddOnHealth struct {
+	// Name The add-on name.
+	Name string `json:"name"`
+
+	// Status The health of an add-on, as reported by continuous delivery.
+	Status KubernetesClusterAddOnHealthStatus `json:"status"`
+}
+
+// KubernetesClusterAddOnHealthStatus The health of an add-on, as reported by continuous delivery.
+type KubernetesClusterAddOnHealthStatus string
+
+// KubernetesClusterAddOnsHealth A list of add-on health statuses, ordered by name.
+type KubernetesClusterAddOnsHealth = []KubernetesClusterAddOnHealth
+
 // KubernetesClusterAutoscaling A Kubernetes cluster workload pool autoscaling configuration. Cluster autoscaling
 // must also be enabled in the cluster features.
 type KubernetesClusterAutoscaling struct {
@@ -240,6 +975,140 @@ type KubernetesClusterAutoscaling struct {
 
 	// MinimumReplicas The minimum number of replicas to allow. Must be less than the maximum.
 	MinimumReplicas int `json:"minimumReplicas"`
+
+	// WarmMachines The number of spare machines to keep booted, with any pre-pulled images,
+	// so workloads are scheduled without waiting for a machine to be created.
+	// When a workload uses a spare, the autoscaler boots a replacement.  The
+	// trade-off is cost, spares are billed like any other machine.  Must not
+	// be greater than the maximum.
+	WarmMachines *int `json:"warmMachines,omitempty"`
+}
+
+// KubernetesClusterAutoscalingConfiguration Cluster autoscaler tuning.  Requires autoscaling to be enabled.  Where a value
+// is not specified, the platform default is used.
+type KubernetesClusterAutoscalingConfiguration struct {
+	// Expander How workload pools are chosen when scaling up.
+	Expander *KubernetesClusterAutoscalingConfigurationExpander `json:"expander,omitempty"`
+
+	// ScaleDownDelayAfterAdd How long after a scale up that scale down evaluation resumes e.g. 10m.
+	ScaleDownDelayAfterAdd *string `json:"scaleDownDelayAfterAdd,omitempty"`
+
+	// ScaleDownUnneededTime How long a node must be unneeded before it is eligible for scale down e.g. 10m.
+	ScaleDownUnneededTime *string `json:"scaleDownUnneededTime,omitempty"`
+
+	// ScaleDownUtilizationThreshold The percentage of a node's resources that must be requested by pods, below
+	// which the node is considered for scale down.
+	ScaleDownUtilizationThreshold *int `json:"scaleDownUtilizationThreshold,omitempty"`
+}
+
+// KubernetesClusterAutoscalingConfigurationExpander How workload pools are chosen when scaling up.
+type KubernetesClusterAutoscalingConfigurationExpander string
+
+// KubernetesClusterBackupConfiguration Cluster backup configuration.  Requires backup to be enabled.  Where a value
+// is not specified, the platform default is used.
+type KubernetesClusterBackupConfiguration struct {
+	// Retention How long backups are kept before being deleted e.g. 720h.
+	Retention *string `json:"retention,omitempty"`
+
+	// Schedule A cron expression that defines when backups are taken, evaluated in UTC
+	// e.g. 0 1 * * *.
+	Schedule *string `json:"schedule,omitempty"`
+}
+
+// KubernetesClusterBootstrapFile A file written to a node by cloud-init.  Files may contain secrets, so their content
+// is redacted unless generated by the platform, however the digest can be used to verify
+// what was delivered.  Content read from a secret is only known when a node is created,
+// so only the secret is reported.
+type KubernetesClusterBootstrapFile struct {
+	// Content The file contents, omitted if redacted.
+	Content *string `json:"content,omitempty"`
+
+	// Path The absolute path of the file.
+	Path string `json:"path"`
+
+	// Redacted Whether the file contents have been redacted.
+	Redacted bool `json:"redacted"`
+
+	// Secret The secret the file contents are read from when a node is created.
+	Secret *string `json:"secret,omitempty"`
+
+	// Sha256 The hex encoded SHA256 digest of the file contents, omitted if read from a secret.
+	Sha256 *string `json:"sha256,omitempty"`
+
+	// Size The file size in bytes, omitted if read from a secret.
+	Size *int `json:"size,omitempty"`
+}
+
+// KubernetesClusterBootstrapFiles A list of files written to a node by cloud-init.
+type KubernetesClusterBootstrapFiles = []KubernetesClusterBootstrapFile
+
+// KubernetesClusterCertificate The Kubernetes API certificate configuration.
+type KubernetesClusterCertificate struct {
+	// Reissue The progress of an API certificate reissue.  Certificates are generated when
+	// control plane machines are created, so are reissued by replacing them with
+	// a rolling update.
+	Reissue *KubernetesClusterCertificateReissue `json:"reissue,omitempty"`
+
+	// SubjectAlternativeNames Set of non-standard X.509 SANs to add to the API certificate.  Each must be
+	// a DNS name, optionally with a leading wildcard, or an IP address.
+	SubjectAlternativeNames KubernetesClusterSubjectAlternativeNames `json:"subjectAlternativeNames"`
+}
+
+// KubernetesClusterCertificateOptions Kubernetes API certificate options.  Any change to the SANs, or an explicit
+// request, will reissue the API certificate.
+type KubernetesClusterCertificateOptions struct {
+	// Reissue Reissue the certificate even if the SANs are unchanged e.g. if it was
+	// modified out of band.
+	Reissue *bool `json:"reissue,omitempty"`
+
+	// SubjectAlternativeNames Set of non-standard X.509 SANs to add to the API certificate.  Each must be
+	// a DNS name, optionally with a leading wildcard, or an IP address.
+	SubjectAlternativeNames KubernetesClusterSubjectAlternativeNames `json:"subjectAlternativeNames"`
+}
+
+// KubernetesClusterCertificateReissue The progress of an API certificate reissue.  Certificates are generated when
+// control plane machines are created, so are reissued by replacing them with
+// a rolling update.
+type KubernetesClusterCertificateReissue struct {
+	// CompletionTime When all control plane machines had been replaced.
+	CompletionTime *time.Time `json:"completionTime,omitempty"`
+
+	// Replicas The number of control plane machines.
+	Replicas *int `json:"replicas,omitempty"`
+
+	// RequestedTime When the reissue was requested.
+	RequestedTime time.Time `json:"requestedTime"`
+
+	// Status The status of a certificate reissue.  It is pending until the provisioner
+	// acts on it, in progress while control plane machines are being replaced,
+	// and complete once all have been.
+	Status KubernetesClusterCertificateReissueStatus `json:"status"`
+
+	// UpdatedReplicas The number of control plane machines that have been replaced.
+	UpdatedReplicas *int `json:"updatedReplicas,omitempty"`
+}
+
+// KubernetesClusterCertificateReissueStatus The status of a certificate reissue.  It is pending until the provisioner
+// acts on it, in progress while control plane machines are being replaced,
+// and complete once all have been.
+type KubernetesClusterCertificateReissueStatus string
+
+// KubernetesClusterCost An estimate of a cluster's compute cost, based on the operator's price sheet.
+// Estimates are based on the requested replica counts, so do not account for
+// any scaling performed by the autoscaler.  Monthly costs assume an average
+// of 730 hours per month.
+type KubernetesClusterCost struct {
+	// Currency The currency costs are expressed in.
+	Currency string `json:"currency"`
+
+	// Hourly The hourly cost of the cluster.
+	Hourly float64 `json:"hourly"`
+
+	// Monthly The monthly cost of the cluster.
+	Monthly float64 `json:"monthly"`
+
+	// Pools A list of pool cost estimates.
+	Pools KubernetesClusterPoolCosts `json:"pools"`
 }
 
 // KubernetesClusterFeatures A set of optional add on features for the cluster.
@@ -247,9 +1116,27 @@ type KubernetesClusterFeatures struct {
 	// Autoscaling Enable auto-scaling.
 	Autoscaling *bool `json:"autoscaling,omitempty"`
 
+	// AutoscalingConfiguration Cluster autoscaler tuning.  Requires autoscaling to be enabled.  Where a value
+	// is not specified, the platform default is used.
+	AutoscalingConfiguration *KubernetesClusterAutoscalingConfiguration `json:"autoscalingConfiguration,omitempty"`
+
+	// Backup Enable periodic backups of cluster resources and persistent volumes (Velero)
+	// to platform provided object storage.
+	Backup *bool `json:"backup,omitempty"`
+
+	// BackupConfiguration Cluster backup configuration.  Requires backup to be enabled.  Where a value
+	// is not specified, the platform default is used.
+	BackupConfiguration *KubernetesClusterBackupConfiguration `json:"backupConfiguration,omitempty"`
+
 	// CertManager Enable cert-manager.
 	CertManager *bool `json:"certManager,omitempty"`
 
+	// CostAllocation Label nodes and pods with the project, control plane and cluster they
+	// belong to, and nodes with their workload pool, so cost monitoring tools
+	// e.g. OpenCost can attribute spend.  Pods are labelled by an admission
+	// policy (Kyverno).
+	CostAllocation *bool `json:"costAllocation,omitempty"`
+
 	// FileStorage Enable POSIX file based persistent storage (Longhorn).
 	FileStorage *bool `json:"fileStorage,omitempty"`
 
@@ -259,6 +1146,15 @@ type KubernetesClusterFeatures struct {
 	// KubernetesDashboard Enable the Kubernetes dashboard.  Requires ingress and certManager to be enabled.
 	KubernetesDashboard *bool `json:"kubernetesDashboard,omitempty"`
 
+	// MetricsFederation Forward cluster metrics to the platform's central Prometheus, labelled with
+	// the project, control plane and cluster.  Requires prometheus to be enabled.
+	MetricsFederation *bool `json:"metricsFederation,omitempty"`
+
+	// NodeFirewall Deny external traffic to workload pool nodes unless explicitly allowed
+	// by the cluster's node allow list.  Use the node allow list APIs to
+	// expose services.
+	NodeFirewall *bool `json:"nodeFirewall,omitempty"`
+
 	// NvidiaOperator Install the NVIDIA Operator
 	NvidiaOperator *bool `json:"nvidiaOperator,omitempty"`
 
@@ -266,21 +1162,115 @@ type KubernetesClusterFeatures struct {
 	Prometheus *bool `json:"prometheus,omitempty"`
 }
 
+// KubernetesClusterHealth A structured summary of cluster health to aid triage.  Node and certificate
+// signing request information is only available when the API server is
+// reachable.
+type KubernetesClusterHealth struct {
+	// AddOns A list of add-on health statuses, ordered by name.
+	AddOns KubernetesClusterAddOnsHealth `json:"addOns"`
+
+	// ApiServer Kubernetes API server health.
+	ApiServer KubernetesClusterAPIServerHealth `json:"apiServer"`
+
+	// Nodes Kubernetes node health.
+	Nodes *KubernetesClusterNodeHealth `json:"nodes,omitempty"`
+
+	// PendingCertificateSigningRequests The number of certificate signing requests that have been neither approved
+	// nor denied.  These may prevent nodes from joining, or serving metrics.
+	PendingCertificateSigningRequests *int `json:"pendingCertificateSigningRequests,omitempty"`
+}
+
+// KubernetesClusterKubeadmCommands A list of shell commands to run on workload pool nodes.  Pre-kubeadm
+// commands run before the node joins the cluster, and post-kubeadm commands
+// after.  Commands are limited in size as they are delivered via cloud-init
+// user data.
+type KubernetesClusterKubeadmCommands = []string
+
+// KubernetesClusterMetricsSummary A coarse summary of cluster utilization.  CPU is reported in cores, memory
+// in GiB, and GPUs and pods as counts.
+type KubernetesClusterMetricsSummary struct {
+	// Cpu The utilization of a resource across all nodes.
+	Cpu KubernetesClusterResourceUtilization `json:"cpu"`
+
+	// Gpu The utilization of a resource across all nodes.
+	Gpu KubernetesClusterResourceUtilization `json:"gpu"`
+
+	// Memory The utilization of a resource across all nodes.
+	Memory KubernetesClusterResourceUtilization `json:"memory"`
+
+	// Nodes The number of nodes in the cluster.
+	Nodes int `json:"nodes"`
+
+	// Pods The utilization of a resource across all nodes.
+	Pods KubernetesClusterResourceUtilization `json:"pods"`
+}
+
 // KubernetesClusterNetwork A kubernetes cluster network settings.
 type KubernetesClusterNetwork struct {
 	// DnsNameservers A list of DNS name server to use.
 	DnsNameservers []string `json:"dnsNameservers"`
 
+	// KubeProxyMode The kube-proxy mode to use for service routing.  This applies to the
+	// whole cluster.  Defaults to iptables.
+	KubeProxyMode *KubernetesClusterNetworkKubeProxyMode `json:"kubeProxyMode,omitempty"`
+
 	// NodePrefix Network prefix to provision nodes in. Must be a valid CIDR block.
 	NodePrefix string `json:"nodePrefix"`
 
+	// Plugin The CNI that provides pod networking.  When none, the user must install
+	// their own, and the cluster will not become healthy until they do.  This
+	// cannot be changed once the cluster has been created.  Defaults to cilium.
+	Plugin *KubernetesClusterNetworkPlugin `json:"plugin,omitempty"`
+
 	// PodPrefix Network prefix to provision pods in. Must be a valid CIDR block.
 	PodPrefix string `json:"podPrefix"`
 
+	// SecondaryPodPrefix Network prefix to provision pods in for dual-stack networking. Must be
+	// a valid CIDR block, and of the opposite address family to the pod prefix.
+	SecondaryPodPrefix *string `json:"secondaryPodPrefix,omitempty"`
+
+	// SecondaryServicePrefix Network prefix to provision services in for dual-stack networking. Must
+	// be a valid CIDR block, and of the opposite address family to the service
+	// prefix.
+	SecondaryServicePrefix *string `json:"secondaryServicePrefix,omitempty"`
+
 	// ServicePrefix Network prefix to provision services in. Must be a valid CIDR block.
 	ServicePrefix string `json:"servicePrefix"`
 }
 
+// KubernetesClusterNetworkKubeProxyMode The kube-proxy mode to use for service routing.  This applies to the
+// whole cluster.  Defaults to iptables.
+type KubernetesClusterNetworkKubeProxyMode string
+
+// KubernetesClusterNetworkPlugin The CNI that provides pod networking.  When none, the user must install
+// their own, and the cluster will not become healthy until they do.  This
+// cannot be changed once the cluster has been created.  Defaults to cilium.
+type KubernetesClusterNetworkPlugin string
+
+// KubernetesClusterNodeConfiguration A constrained set of node tuning options applied to a workload pool's kubelet
+// configuration on initialisation/join.
+type KubernetesClusterNodeConfiguration struct {
+	// KubeReserved Resources to reserve on a node for non-pod processes.  Values are Kubernetes
+	// resource quantities e.g. 500m or 1Gi.
+	KubeReserved *KubernetesClusterReservedResources `json:"kubeReserved,omitempty"`
+
+	// MaxPods The maximum number of pods that can run on a node.
+	MaxPods *int `json:"maxPods,omitempty"`
+
+	// SystemReserved Resources to reserve on a node for non-pod processes.  Values are Kubernetes
+	// resource quantities e.g. 500m or 1Gi.
+	SystemReserved *KubernetesClusterReservedResources `json:"systemReserved,omitempty"`
+}
+
+// KubernetesClusterNodeHealth Kubernetes node health.
+type KubernetesClusterNodeHealth struct {
+	// Ready The number of nodes that are ready to accept workloads.
+	Ready int `json:"ready"`
+
+	// Total The number of nodes in the cluster.
+	Total int `json:"total"`
+}
+
 // KubernetesClusterOpenStack Kubernetes cluster creation OpenStack parameters.
 type KubernetesClusterOpenStack struct {
 	// ComputeAvailabilityZone Compute availability zone for control plane, and workload pool default.
@@ -289,6 +1279,15 @@ type KubernetesClusterOpenStack struct {
 	// ExternalNetworkID OpenStack external network ID.
 	ExternalNetworkID string `json:"externalNetworkID"`
 
+	// LoadBalancer Selects how an OpenStack load balancer is implemented.  Where not specified,
+	// the cloud's defaults are used.
+	LoadBalancer *OpenstackLoadBalancer `json:"loadBalancer,omitempty"`
+
+	// Network An existing OpenStack network to provision the cluster on, rather than creating
+	// one.  The subnet's prefix must match the cluster's node prefix, and must not overlap
+	// the service or pod prefixes.  This cannot be changed once the cluster is created.
+	Network *KubernetesClusterOpenStackNetwork `json:"network,omitempty"`
+
 	// SshKeyName OpenStack SSH Key to install on all machines.
 	SshKeyName *string `json:"sshKeyName,omitempty"`
 
@@ -296,6 +1295,174 @@ type KubernetesClusterOpenStack struct {
 	VolumeAvailabilityZone string `json:"volumeAvailabilityZone"`
 }
 
+// KubernetesClusterOpenStackNetwork An existing OpenStack network to provision the cluster on, rather than creating
+// one.  The subnet's prefix must match the cluster's node prefix, and must not overlap
+// the service or pod prefixes.  This cannot be changed once the cluster is created.
+type KubernetesClusterOpenStackNetwork struct {
+	// NetworkID OpenStack network ID.
+	NetworkID string `json:"networkID"`
+
+	// RouterID OpenStack router ID that connects the subnet to the external network.  This
+	// is only required where the router cannot be discovered from the subnet.
+	RouterID *string `json:"routerID,omitempty"`
+
+	// SubnetID OpenStack subnet ID, this must be part of the network.
+	SubnetID string `json:"subnetID"`
+}
+
+// KubernetesClusterPoolCost The estimated cost of a pool of machines.
+type KubernetesClusterPoolCost struct {
+	// FlavorName The OpenStack flavor name.
+	FlavorName string `json:"flavorName"`
+
+	// Hourly The hourly cost of the pool.
+	Hourly float64 `json:"hourly"`
+
+	// Monthly The monthly cost of the pool.
+	Monthly float64 `json:"monthly"`
+
+	// Name The pool name, the control plane is called control-plane.
+	Name string `json:"name"`
+
+	// Replicas The number of machines the estimate is based on.
+	Replicas int `json:"replicas"`
+
+	// UnitHourly The hourly cost of a single machine.
+	UnitHourly float64 `json:"unitHourly"`
+}
+
+// KubernetesClusterPoolCosts A list of pool cost estimates.
+type KubernetesClusterPoolCosts = []KubernetesClusterPoolCost
+
+// KubernetesClusterReservedResources Resources to reserve on a node for non-pod processes.  Values are Kubernetes
+// resource quantities e.g. 500m or 1Gi.
+type KubernetesClusterReservedResources struct {
+	// Cpu CPU to reserve.
+	Cpu *string `json:"cpu,omitempty"`
+
+	// EphemeralStorage Ephemeral storage to reserve.
+	EphemeralStorage *string `json:"ephemeralStorage,omitempty"`
+
+	// Memory Memory to reserve.
+	Memory *string `json:"memory,omitempty"`
+}
+
+// KubernetesClusterResourceUtilization The utilization of a resource across all nodes.
+type KubernetesClusterResourceUtilization struct {
+	// Allocatable The amount of the resource available to pods.
+	Allocatable float32 `json:"allocatable"`
+
+	// Requested The amount of the resource requested by pods.
+	Requested float32 `json:"requested"`
+
+	// Used The amount of the resource actually in use, this is only reported
+	// when metrics-server is installed in the cluster.
+	Used *float32 `json:"used,omitempty"`
+}
+
+// KubernetesClusterStageTiming The timing of a cluster provisioning stage.  Stages are retimed whenever
+// the cluster is modified.
+type KubernetesClusterStageTiming struct {
+	// CompletionTime When the stage completed, absent if still in progress.
+	CompletionTime *time.Time `json:"completionTime,omitempty"`
+
+	// Duration The time taken to complete the stage in seconds, absent if still in progress.
+	Duration *float32 `json:"duration,omitempty"`
+
+	// Name The stage name.  The cluster stage covers the network, control plane
+	// and workload pool machines, bootstrap covers the CNI and cloud provider,
+	// autoscaler the cluster autoscaler, and addons any optional add-ons.
+	Name string `json:"name"`
+
+	// StartTime When the stage was started.
+	StartTime time.Time `json:"startTime"`
+}
+
+// KubernetesClusterStageTimings A list of provisioning stage timings, in the order they were started.
+type KubernetesClusterStageTimings = []KubernetesClusterStageTiming
+
+// KubernetesClusterSubjectAlternativeNames Set of non-standard X.509 SANs to add to the API certificate.  Each must be
+// a DNS name, optionally with a leading wildcard, or an IP address.
+type KubernetesClusterSubjectAlternativeNames = []string
+
+// KubernetesClusterTaint A node taint.
+type KubernetesClusterTaint struct {
+	// Effect What happens to pods that don't tolerate the taint.
+	Effect KubernetesClusterTaintEffect `json:"effect"`
+
+	// Key The taint key, this must be a valid Kubernetes label key.
+	Key string `json:"key"`
+
+	// Value The taint value, this must be a valid Kubernetes label value.
+	Value *string `json:"value,omitempty"`
+}
+
+// KubernetesClusterTaintEffect What happens to pods that don't tolerate the taint.
+type KubernetesClusterTaintEffect string
+
+// KubernetesClusterTaints A list of node taints.
+type KubernetesClusterTaints = []KubernetesClusterTaint
+
+// KubernetesClusterTemplate An operator published Kubernetes cluster preset.  These are used to pre-populate
+// cluster creation requests.  When referenced by a creation request, any optional
+// values omitted from the request will be defaulted from the template.
+type KubernetesClusterTemplate struct {
+	// ApplicationBundleName The default application bundle name.
+	ApplicationBundleName *string `json:"applicationBundleName,omitempty"`
+
+	// ControlPlane Default values for a Kubernetes cluster machine.  Images and versions are not
+	// included as they are dictated by the application bundle.
+	ControlPlane *KubernetesClusterTemplateMachine `json:"controlPlane,omitempty"`
+
+	// Description A verbose description of the template.
+	Description *string `json:"description,omitempty"`
+
+	// Features A set of optional add on features for the cluster.
+	Features *KubernetesClusterFeatures `json:"features,omitempty"`
+
+	// Name The template name.
+	Name string `json:"name"`
+
+	// WorkloadPools A list of Kubernetes cluster workload pool defaults.
+	WorkloadPools *KubernetesClusterTemplateWorkloadPools `json:"workloadPools,omitempty"`
+}
+
+// KubernetesClusterTemplateMachine Default values for a Kubernetes cluster machine.  Images and versions are not
+// included as they are dictated by the application bundle.
+type KubernetesClusterTemplateMachine struct {
+	// Disk An OpenStack volume.
+	Disk *OpenstackVolume `json:"disk,omitempty"`
+
+	// FlavorName OpenStack flavor name.
+	FlavorName *string `json:"flavorName,omitempty"`
+
+	// Replicas Number of machines.
+	Replicas *int `json:"replicas,omitempty"`
+}
+
+// KubernetesClusterTemplateWorkloadPool Default values for a Kubernetes cluster workload pool.
+type KubernetesClusterTemplateWorkloadPool struct {
+	// Autoscaling A Kubernetes cluster workload pool autoscaling configuration. Cluster autoscaling
+	// must also be enabled in the cluster features.
+	Autoscaling *KubernetesClusterAutoscaling `json:"autoscaling,omitempty"`
+
+	// Labels Workload pool key value labels to apply on node creation.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Machine Default values for a Kubernetes cluster machine.  Images and versions are not
+	// included as they are dictated by the application bundle.
+	Machine *KubernetesClusterTemplateMachine `json:"machine,omitempty"`
+
+	// Name Workload pool name.
+	Name string `json:"name"`
+}
+
+// KubernetesClusterTemplateWorkloadPools A list of Kubernetes cluster workload pool defaults.
+type KubernetesClusterTemplateWorkloadPools = []KubernetesClusterTemplateWorkloadPool
+
+// KubernetesClusterTemplates A list of Kubernetes cluster templates.
+type KubernetesClusterTemplates = []KubernetesClusterTemplate
+
 // KubernetesClusterWorkloadPool A Kuberntes cluster workload pool.
 type KubernetesClusterWorkloadPool struct {
 	// Autoscaling A Kubernetes cluster workload pool autoscaling configuration. Cluster autoscaling
@@ -313,38 +1480,289 @@ type KubernetesClusterWorkloadPool struct {
 
 	// Name Workload pool name.
 	Name string `json:"name"`
+
+	// NodeConfiguration A constrained set of node tuning options applied to a workload pool's kubelet
+	// configuration on initialisation/join.
+	NodeConfiguration *KubernetesClusterNodeConfiguration `json:"nodeConfiguration,omitempty"`
+
+	// PostKubeadmCommands A list of shell commands to run on workload pool nodes.  Pre-kubeadm
+	// commands run before the node joins the cluster, and post-kubeadm commands
+	// after.  Commands are limited in size as they are delivered via cloud-init
+	// user data.
+	PostKubeadmCommands *KubernetesClusterKubeadmCommands `json:"postKubeadmCommands,omitempty"`
+
+	// PreKubeadmCommands A list of shell commands to run on workload pool nodes.  Pre-kubeadm
+	// commands run before the node joins the cluster, and post-kubeadm commands
+	// after.  Commands are limited in size as they are delivered via cloud-init
+	// user data.
+	PreKubeadmCommands *KubernetesClusterKubeadmCommands `json:"preKubeadmCommands,omitempty"`
+
+	// PrePullImages Container images to pull on node creation, before the node joins the
+	// cluster.  This avoids lengthy image pulls when pods are scheduled on new
+	// nodes e.g. when autoscaling.  The trade-off is that each image delays
+	// the node becoming ready, so only list images the pool is known to run.
+	PrePullImages *[]string `json:"prePullImages,omitempty"`
+
+	// SshKeyName Workload pool SSH key name.  Overrides the cluster default, and must exist
+	// in the OpenStack key pairs listing.  An empty string indicates that no SSH
+	// key should be provisioned.
+	SshKeyName *string `json:"sshKeyName,omitempty"`
+
+	// Taints A list of node taints.
+	Taints *KubernetesClusterTaints `json:"taints,omitempty"`
+}
+
+// KubernetesClusterWorkloadPoolBootstrap A workload pool's effective kubeadm bootstrap data, as rendered into the cloud-init
+// user data that nodes boot with.  Files are written first, then pre-kubeadm commands
+// are run, the node joins the cluster, and finally post-kubeadm commands are run.
+type KubernetesClusterWorkloadPoolBootstrap struct {
+	// Files A list of files written to a node by cloud-init.
+	Files *KubernetesClusterBootstrapFiles `json:"files,omitempty"`
+
+	// KubeadmConfig The remainder of the kubeadm configuration, e.g. join configuration, node
+	// registration and kubelet arguments, with join tokens and other credentials
+	// redacted.
+	KubeadmConfig *map[string]interface{} `json:"kubeadmConfig,omitempty"`
+
+	// KubeadmConfigTemplate The name of the Cluster API KubeadmConfigTemplate the data is read from.
+	KubeadmConfigTemplate string `json:"kubeadmConfigTemplate"`
+
+	// PostKubeadmCommands A list of shell commands to run on workload pool nodes.  Pre-kubeadm
+	// commands run before the node joins the cluster, and post-kubeadm commands
+	// after.  Commands are limited in size as they are delivered via cloud-init
+	// user data.
+	PostKubeadmCommands *KubernetesClusterKubeadmCommands `json:"postKubeadmCommands,omitempty"`
+
+	// PreKubeadmCommands A list of shell commands to run on workload pool nodes.  Pre-kubeadm
+	// commands run before the node joins the cluster, and post-kubeadm commands
+	// after.  Commands are limited in size as they are delivered via cloud-init
+	// user data.
+	PreKubeadmCommands *KubernetesClusterKubeadmCommands `json:"preKubeadmCommands,omitempty"`
+}
+
+// KubernetesClusterWorkloadPoolOperation A single workload pool mutation.
+type KubernetesClusterWorkloadPoolOperation struct {
+	// Name The name of the workload pool to mutate.
+	Name string `json:"name"`
+
+	// Operation The mutation to perform.  Adding a pool that exists, or replacing or removing
+	// one that doesn't, is an error.
+	Operation KubernetesClusterWorkloadPoolOperationType `json:"operation"`
+
+	// Pool A Kuberntes cluster workload pool.
+	Pool *KubernetesClusterWorkloadPool `json:"pool,omitempty"`
+}
+
+// KubernetesClusterWorkloadPoolOperationResult The validation result of a single workload pool mutation.
+type KubernetesClusterWorkloadPoolOperationResult struct {
+	// Errors Reasons the mutation is invalid, omitted when it is valid.
+	Errors *[]string `json:"errors,omitempty"`
+
+	// Name The name of the workload pool.
+	Name string `json:"name"`
+
+	// Operation The mutation to perform.  Adding a pool that exists, or replacing or removing
+	// one that doesn't, is an error.
+	Operation KubernetesClusterWorkloadPoolOperationType `json:"operation"`
+}
+
+// KubernetesClusterWorkloadPoolOperationResults The outcome of a batch of workload pool mutations.
+type KubernetesClusterWorkloadPoolOperationResults struct {
+	// Applied Whether the mutations were applied to the cluster.
+	Applied bool `json:"applied"`
+
+	// Results Per pool validation results, in request order.
+	Results []KubernetesClusterWorkloadPoolOperationResult `json:"results"`
+}
+
+// KubernetesClusterWorkloadPoolOperationType The mutation to perform.  Adding a pool that exists, or replacing or removing
+// one that doesn't, is an error.
+type KubernetesClusterWorkloadPoolOperationType string
+
+// KubernetesClusterWorkloadPoolOperations A set of workload pool mutations that are applied atomically, either all
+// succeed or none are applied.  Each pool may only be mutated once.
+type KubernetesClusterWorkloadPoolOperations = []KubernetesClusterWorkloadPoolOperation
+
+// KubernetesClusterWorkloadPools A list of Kubernetes cluster workload pools.
+type KubernetesClusterWorkloadPools = []KubernetesClusterWorkloadPool
+
+// KubernetesClusters A list of Kubernetes clusters.
+type KubernetesClusters = []KubernetesCluster
+
+// KubernetesNameParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type KubernetesNameParameter = string
+
+// KubernetesResourcePlacement Where a resource is provisioned in the management cluster, used to aid
+// debugging.  This is read only, and only reported to administrators.
+type KubernetesResourcePlacement struct {
+	// ClusterNamespace The namespace provisioned for the cluster.
+	ClusterNamespace *string `json:"clusterNamespace,omitempty"`
+
+	// ControlPlaneNamespace The namespace provisioned for the control plane.
+	ControlPlaneNamespace *string `json:"controlPlaneNamespace,omitempty"`
+
+	// ProjectNamespace The namespace provisioned for the project.
+	ProjectNamespace *string `json:"projectNamespace,omitempty"`
+}
+
+// KubernetesResourceStatus A Kubernetes resource status.
+type KubernetesResourceStatus struct {
+	// CreationTime The time the resource was created.
+	CreationTime time.Time `json:"creationTime"`
+
+	// DeletionTime The time the resource was deleted.
+	DeletionTime *time.Time `json:"deletionTime,omitempty"`
+
+	// Name The name of the resource.
+	Name string `json:"name"`
+
+	// Status The current status of the resource. Intially the status will be "Unknown" until
+	// the resource is reconciled by the relevant controller. It then will transition to
+	// "Provisioning" and will be ready for use when it changes to "Provisioned". The status
+	// will also transition to the "Provisioning" status during an update. The
+	// status will change to "Deprovisioning" when a delete request is being processed.
+	// It may also change to "Error" if an unexpected error occurred during any operation.
+	// Errors may be transient.
+	Status string `json:"status"`
+}
+
+// KubernetesUpgrade A Kubernetes version upgrade.  Versions may only be upgraded one minor version
+// at a time, and an image of the requested version must be available.
+type KubernetesUpgrade struct {
+	// Version The Kubernetes version to upgrade to.
+	Version string `json:"version"`
+}
+
+// KubernetesUpgradePhase The phase of a Kubernetes version upgrade.  The control plane is upgraded
+// first, followed by the workload pools.
+type KubernetesUpgradePhase string
+
+// KubernetesUpgradeStatus An in progress Kubernetes version upgrade.  This is read only, use the upgrade
+// API to start an upgrade.
+type KubernetesUpgradeStatus struct {
+	// Phase The phase of a Kubernetes version upgrade.  The control plane is upgraded
+	// first, followed by the workload pools.
+	Phase KubernetesUpgradePhase `json:"phase"`
+
+	// Version The Kubernetes version being upgraded to.
+	Version string `json:"version"`
+}
+
+// KubernetesVersion A Kubernetes version.
+type KubernetesVersion struct {
+	// Phase The support phase of a Kubernetes version.  Supported versions are recommended,
+	// deprecated versions should be upgraded from, and versions to be removed soon
+	// will shortly no longer be available.
+	Phase KubernetesVersionPhase `json:"phase"`
+
+	// Version The Kubernetes semantic version.
+	Version string `json:"version"`
+}
+
+// KubernetesVersionPhase The support phase of a Kubernetes version.  Supported versions are recommended,
+// deprecated versions should be upgraded from, and versions to be removed soon
+// will shortly no longer be available.
+type KubernetesVersionPhase string
+
+// KubernetesVersions A list of Kubernetes versions, newest first.
+type KubernetesVersions = []KubernetesVersion
+
+// MonitorShardAssignment The monitor replica a resource is assigned to.
+type MonitorShardAssignment struct {
+	// Kind The kind of resource whose upgrades are scheduled by the monitor.
+	Kind MonitorShardResourceKind `json:"kind"`
+
+	// Name The resource name.
+	Name string `json:"name"`
+
+	// Namespace The resource namespace.
+	Namespace string `json:"namespace"`
+
+	// Pinned Whether the resource is pinned to the replica, rather than being
+	// assigned by consistent hashing.
+	Pinned bool `json:"pinned"`
+
+	// Replica The replica the resource is assigned to, absent if there are no live replicas.
+	Replica *string `json:"replica,omitempty"`
+}
+
+// MonitorShardAssignments A list of resource assignments, ordered by kind, namespace and name.
+type MonitorShardAssignments = []MonitorShardAssignment
+
+// MonitorShardMember A live monitor replica.
+type MonitorShardMember struct {
+	// Identity The unique replica identity.
+	Identity string `json:"identity"`
+
+	// RenewTime When the replica last renewed its membership.
+	RenewTime time.Time `json:"renewTime"`
+}
+
+// MonitorShardMembers A list of live monitor replicas, ordered by identity.
+type MonitorShardMembers = []MonitorShardMember
+
+// MonitorShardPin Pins a resource to a monitor replica.
+type MonitorShardPin struct {
+	// Kind The kind of resource whose upgrades are scheduled by the monitor.
+	Kind MonitorShardResourceKind `json:"kind"`
+
+	// Name The resource name.
+	Name string `json:"name"`
+
+	// Namespace The resource namespace.
+	Namespace string `json:"namespace"`
+
+	// Replica The live replica to pin the resource to.  When absent, any existing pin
+	// is removed and the resource is assigned by consistent hashing.
+	Replica *string `json:"replica,omitempty"`
 }
 
-// KubernetesClusterWorkloadPools A list of Kubernetes cluster workload pools.
-type KubernetesClusterWorkloadPools = []KubernetesClusterWorkloadPool
+// MonitorShardPins A list of resource pins.
+type MonitorShardPins = []MonitorShardPin
 
-// KubernetesClusters A list of Kubernetes clusters.
-type KubernetesClusters = []KubernetesCluster
+// MonitorShardResourceKind The kind of resource whose upgrades are scheduled by the monitor.
+type MonitorShardResourceKind string
 
-// KubernetesNameParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
-type KubernetesNameParameter = string
+// MonitorShards How resources are shared between monitor replicas.
+type MonitorShards struct {
+	// Assignments A list of resource assignments, ordered by kind, namespace and name.
+	Assignments MonitorShardAssignments `json:"assignments"`
 
-// KubernetesResourceStatus A Kubernetes resource status.
-type KubernetesResourceStatus struct {
-	// CreationTime The time the resource was created.
-	CreationTime time.Time `json:"creationTime"`
+	// Members A list of live monitor replicas, ordered by identity.
+	Members MonitorShardMembers `json:"members"`
+}
 
-	// DeletionTime The time the resource was deleted.
-	DeletionTime *time.Time `json:"deletionTime,omitempty"`
+// NodeAllowList A list of rules defining external traffic that may reach workload pool nodes,
+// all other traffic is denied when the node firewall feature is enabled.
+type NodeAllowList = []NodeAllowListRule
 
-	// Name The name of the resource.
-	Name string `json:"name"`
+// NodeAllowListRule Allows external traffic to reach workload pool nodes, or traffic to leave them.
+// When any egress rule is defined, the default rule that allows all egress traffic
+// is removed, and only traffic matching the egress rules may leave the nodes.
+type NodeAllowListRule struct {
+	// Direction The direction of traffic to allow, defaulting to ingress.
+	Direction *NodeAllowListRuleDirection `json:"direction,omitempty"`
 
-	// Status The current status of the resource. Intially the status will be "Unknown" until
-	// the resource is reconciled by the relevant controller. It then will transition to
-	// "Provisioning" and will be ready for use when it changes to "Provisioned". The status
-	// will also transition to the "Provisioning" status during an update. The
-	// status will change to "Deprovisioning" when a delete request is being processed.
-	// It may also change to "Error" if an unexpected error occurred during any operation.
-	// Errors may be transient.
-	Status string `json:"status"`
+	// Port The port to allow, or the start of a port range.
+	Port int `json:"port"`
+
+	// PortMax The end of the port range, if allowing a range of ports.
+	PortMax *int `json:"portMax,omitempty"`
+
+	// Prefixes IPv4 or IPv6 prefixes that are allowed access, or for egress rules, that may
+	// be accessed.
+	Prefixes []string `json:"prefixes"`
+
+	// Protocol The IP protocol to allow.
+	Protocol NodeAllowListRuleProtocol `json:"protocol"`
 }
 
+// NodeAllowListRuleDirection The direction of traffic to allow, defaulting to ingress.
+type NodeAllowListRuleDirection string
+
+// NodeAllowListRuleProtocol The IP protocol to allow.
+type NodeAllowListRuleProtocol string
+
 // Oauth2Error Generic error message.
 type Oauth2Error struct {
 	// Error A terse error string expanding on the HTTP error code. Errors are based on the OAuth2 specification, but are expanded with proprietary status codes for APIs other than those specified by OAuth2.
@@ -352,13 +1770,47 @@ type Oauth2Error struct {
 
 	// ErrorDescription Verbose message describing the error.
 	ErrorDescription string `json:"error_description"`
+
+	// Remediation A hint describing how the client may resolve the error, returned when the
+	// cause is known e.g. a name clash with an existing provider resource.
+	Remediation *string `json:"remediation,omitempty"`
+
+	// Resource A reference to an existing resource.
+	Resource *ResourceReference `json:"resource,omitempty"`
+
+	// ValidationErrors A list of specific validation failures, returned when a request is well formed
+	// but cannot be satisfied e.g. due to insufficient quota.
+	ValidationErrors *[]string `json:"validation_errors,omitempty"`
 }
 
 // Oauth2ErrorError A terse error string expanding on the HTTP error code. Errors are based on the OAuth2 specification, but are expanded with proprietary status codes for APIs other than those specified by OAuth2.
 type Oauth2ErrorError string
 
+// OpenstackApplicationCredentialRoles The roles delegated to application credentials created on the user's behalf
+// e.g. when creating a cluster.  The user must have all of these roles on the
+// project.
+type OpenstackApplicationCredentialRoles struct {
+	// Missing The required roles the user does not have.  If this is not empty, the
+	// user will be unable to create clusters until they are granted the roles.
+	Missing []string `json:"missing"`
+
+	// Required The roles the platform requires.
+	Required []string `json:"required"`
+}
+
 // OpenstackAvailabilityZone An OpenStack availability zone.
 type OpenstackAvailabilityZone struct {
+	// Annotations Operator provided hints about the availability zone e.g. capacity constraints.
+	// These can be used to guide placement choices.
+	Annotations *[]string `json:"annotations,omitempty"`
+
+	// Available Whether the availability zone is available for use.
+	Available bool `json:"available"`
+
+	// Hosts The number of hosts in the availability zone.  This is only present if
+	// the user's role permits the information to be visible.
+	Hosts *int `json:"hosts,omitempty"`
+
 	// Name The availability zone name.
 	Name string `json:"name"`
 }
@@ -366,6 +1818,37 @@ type OpenstackAvailabilityZone struct {
 // OpenstackAvailabilityZones A list of OpenStack availability zones.
 type OpenstackAvailabilityZones = []OpenstackAvailabilityZone
 
+// OpenstackBlockStorageQuotas OpenStack block storage quotas.
+type OpenstackBlockStorageQuotas struct {
+	// Gigabytes An OpenStack quota limit and its current usage.
+	Gigabytes OpenstackQuota `json:"gigabytes"`
+
+	// PerVolumeGigabytes The largest volume that may be created in GiB, -1 indicates volumes are
+	// only limited by the gigabytes quota.
+	PerVolumeGigabytes int `json:"perVolumeGigabytes"`
+
+	// Volumes An OpenStack quota limit and its current usage.
+	Volumes OpenstackQuota `json:"volumes"`
+}
+
+// OpenstackCircuitState The state of the circuit breaker protecting an OpenStack service.  Closed
+// means requests are made as normal.  Open means the service has failed
+// repeatedly, and requests fail immediately without being made.  Half open
+// means a single request is allowed to probe whether the service has recovered.
+type OpenstackCircuitState string
+
+// OpenstackComputeQuotas OpenStack compute quotas.
+type OpenstackComputeQuotas struct {
+	// Cores An OpenStack quota limit and its current usage.
+	Cores OpenstackQuota `json:"cores"`
+
+	// Instances An OpenStack quota limit and its current usage.
+	Instances OpenstackQuota `json:"instances"`
+
+	// Ram An OpenStack quota limit and its current usage.
+	Ram OpenstackQuota `json:"ram"`
+}
+
 // OpenstackExternalNetwork An OpenStack external network.
 type OpenstackExternalNetwork struct {
 	// Id OpenStack external network ID.
@@ -386,6 +1869,15 @@ type OpenstackFlavor struct {
 	// Disk The amount of ephemeral disk in GB.
 	Disk int `json:"disk"`
 
+	// GpuMemory The amount of GPU memory in GiB, if known.
+	GpuMemory *int `json:"gpuMemory,omitempty"`
+
+	// GpuModel The GPU model e.g. A100, if known.
+	GpuModel *string `json:"gpuModel,omitempty"`
+
+	// GpuProfile The vGPU profile, if the flavor provides a virtual GPU.
+	GpuProfile *string `json:"gpuProfile,omitempty"`
+
 	// Gpus The number of GPUs, if not set there are none.
 	Gpus *int `json:"gpus,omitempty"`
 
@@ -395,6 +1887,10 @@ type OpenstackFlavor struct {
 	// Memory The amount of memory in GiB.
 	Memory int `json:"memory"`
 
+	// MinimumNvidiaDriverVersion The minimum nvidia driver version required by the GPU.  Images whose
+	// nvidia driver version is older than this will not work with the flavor.
+	MinimumNvidiaDriverVersion *string `json:"minimumNvidiaDriverVersion,omitempty"`
+
 	// Name The flavor name.
 	Name string `json:"name"`
 }
@@ -402,6 +1898,15 @@ type OpenstackFlavor struct {
 // OpenstackFlavors A list of OpenStack flavors.
 type OpenstackFlavors = []OpenstackFlavor
 
+// OpenstackHealth The reachability of OpenStack services.
+type OpenstackHealth struct {
+	// Healthy Whether all services are believed to be reachable.
+	Healthy bool `json:"healthy"`
+
+	// Services Per-service reachability.
+	Services []OpenstackServiceHealth `json:"services"`
+}
+
 // OpenstackImage And OpenStack image.
 type OpenstackImage struct {
 	// Created Time when the image was created. Images with a newer creation time should
@@ -440,6 +1945,23 @@ type OpenstackKeyPair struct {
 // OpenstackKeyPairs A list of OpenStack key pairs.
 type OpenstackKeyPairs = []OpenstackKeyPair
 
+// OpenstackLoadBalancer Selects how an OpenStack load balancer is implemented.  Where not specified,
+// the cloud's defaults are used.
+type OpenstackLoadBalancer struct {
+	// FlavorID The load balancer flavor ID, flavors are provider specific.
+	FlavorID *string `json:"flavorID,omitempty"`
+
+	// Provider The load balancer provider.  Amphora provisions a dedicated virtual machine
+	// per load balancer, OVN implements the load balancer in the virtual network,
+	// and is cheaper, but only supports TCP and UDP.
+	Provider *OpenstackLoadBalancerProvider `json:"provider,omitempty"`
+}
+
+// OpenstackLoadBalancerProvider The load balancer provider.  Amphora provisions a dedicated virtual machine
+// per load balancer, OVN implements the load balancer in the virtual network,
+// and is cheaper, but only supports TCP and UDP.
+type OpenstackLoadBalancerProvider string
+
 // OpenstackMachinePool A Kubernetes cluster machine.
 type OpenstackMachinePool struct {
 	// Disk An OpenStack volume.
@@ -454,11 +1976,41 @@ type OpenstackMachinePool struct {
 	// Replicas Number of machines.
 	Replicas int `json:"replicas"`
 
+	// ServerGroupPolicy Scheduling policy of the pool's server group.  Each pool has its own server
+	// group, and when not specified the platform default policy is used.  Server
+	// group policies cannot be modified, so changing the policy of an existing
+	// pool creates a new server group and the pool's machines are replaced.
+	ServerGroupPolicy *OpenstackMachinePoolServerGroupPolicy `json:"serverGroupPolicy,omitempty"`
+
 	// Version Kubernetes version. This should be derived from the image name as images
 	// will be preloaded with containers for a specific Kubernetes version.
 	Version string `json:"version"`
 }
 
+// OpenstackMachinePoolServerGroupPolicy Scheduling policy of the pool's server group.  Each pool has its own server
+// group, and when not specified the platform default policy is used.  Server
+// group policies cannot be modified, so changing the policy of an existing
+// pool creates a new server group and the pool's machines are replaced.
+type OpenstackMachinePoolServerGroupPolicy string
+
+// OpenstackNetworkQuotas OpenStack network quotas.
+type OpenstackNetworkQuotas struct {
+	// FloatingIPs An OpenStack quota limit and its current usage.
+	FloatingIPs OpenstackQuota `json:"floatingIPs"`
+
+	// Networks An OpenStack quota limit and its current usage.
+	Networks OpenstackQuota `json:"networks"`
+
+	// Ports An OpenStack quota limit and its current usage.
+	Ports OpenstackQuota `json:"ports"`
+
+	// Routers An OpenStack quota limit and its current usage.
+	Routers OpenstackQuota `json:"routers"`
+
+	// SecurityGroups An OpenStack quota limit and its current usage.
+	SecurityGroups OpenstackQuota `json:"securityGroups"`
+}
+
 // OpenstackProject An OpenStack project.
 type OpenstackProject struct {
 	// Description A verbose description of the project.
@@ -474,6 +2026,55 @@ type OpenstackProject struct {
 // OpenstackProjects A list of OpenStack projects.
 type OpenstackProjects = []OpenstackProject
 
+// OpenstackQuota An OpenStack quota limit and its current usage.
+type OpenstackQuota struct {
+	// Limit The quota limit, -1 indicates the resource is unlimited.
+	Limit int `json:"limit"`
+
+	// Used The amount of the resource used, including reservations.
+	Used int `json:"used"`
+}
+
+// OpenstackQuotas OpenStack quotas for the scoped project.  Compute RAM is reported in MiB.
+type OpenstackQuotas struct {
+	// BlockStorage OpenStack block storage quotas.
+	BlockStorage OpenstackBlockStorageQuotas `json:"blockStorage"`
+
+	// Compute OpenStack compute quotas.
+	Compute OpenstackComputeQuotas `json:"compute"`
+
+	// Network OpenStack network quotas.
+	Network OpenstackNetworkQuotas `json:"network"`
+}
+
+// OpenstackServiceHealth The reachability of an OpenStack service, as observed from recent requests
+// made by the platform on behalf of all users.
+type OpenstackServiceHealth struct {
+	// ConsecutiveFailures The number of consecutive failed requests to the service.
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+
+	// LastFailure When a request to the service last failed.
+	LastFailure *time.Time `json:"lastFailure,omitempty"`
+
+	// LastSuccess When a request to the service last succeeded.
+	LastSuccess *time.Time `json:"lastSuccess,omitempty"`
+
+	// Reachable Whether the service is believed to be reachable.
+	Reachable bool `json:"reachable"`
+
+	// Service An OpenStack service the platform depends upon.
+	Service OpenstackServiceName `json:"service"`
+
+	// State The state of the circuit breaker protecting an OpenStack service.  Closed
+	// means requests are made as normal.  Open means the service has failed
+	// repeatedly, and requests fail immediately without being made.  Half open
+	// means a single request is allowed to probe whether the service has recovered.
+	State OpenstackCircuitState `json:"state"`
+}
+
+// OpenstackServiceName An OpenStack service the platform depends upon.
+type OpenstackServiceName string
+
 // OpenstackVolume An OpenStack volume.
 type OpenstackVolume struct {
 	// AvailabilityZone Volume availability zone. Overrides the cluster default.
@@ -483,12 +2084,260 @@ type OpenstackVolume struct {
 	Size int `json:"size"`
 }
 
+// PlatformChangelog A list of platform changelog entries, newest first.
+type PlatformChangelog = []PlatformChangelogEntry
+
+// PlatformChangelogEntry What changed in a release of the platform.
+type PlatformChangelogEntry struct {
+	// Acknowledged Whether the user has acknowledged the entry.  Clients should only show
+	// entries that have not been acknowledged.
+	Acknowledged bool `json:"acknowledged"`
+
+	// BreakingChanges Changes that may require action by the user.
+	BreakingChanges *[]string `json:"breakingChanges,omitempty"`
+
+	// Date When the version was released.
+	Date time.Time `json:"date"`
+
+	// Highlights Notable new features and improvements.
+	Highlights *[]string `json:"highlights,omitempty"`
+
+	// Version The platform version.
+	Version string `json:"version"`
+}
+
+// ProjectClusterUsage The usage of a single cluster.
+type ProjectClusterUsage struct {
+	// ControlPlaneName The control plane the cluster belonged to.
+	ControlPlaneName string `json:"controlPlaneName"`
+
+	// EndTime When the cluster was deleted, or the time of the request if the cluster
+	// has not been deleted yet.
+	EndTime time.Time `json:"endTime"`
+
+	// Hours How long the cluster ran for.
+	Hours float64 `json:"hours"`
+
+	// Machines A list of machine usages.
+	Machines ProjectMachineUsages `json:"machines"`
+
+	// Name The cluster name.
+	Name string `json:"name"`
+
+	// StartTime When the cluster was created.
+	StartTime time.Time `json:"startTime"`
+}
+
+// ProjectClusterUsages A list of cluster usages.
+type ProjectClusterUsages = []ProjectClusterUsage
+
+// ProjectFlavorUsage The total usage of a flavor.
+type ProjectFlavorUsage struct {
+	// FlavorName The OpenStack flavor name.
+	FlavorName string `json:"flavorName"`
+
+	// MachineHours The number of machine hours used.
+	MachineHours float64 `json:"machineHours"`
+}
+
+// ProjectFlavorUsages A list of flavor usages.
+type ProjectFlavorUsages = []ProjectFlavorUsage
+
+// ProjectMachineUsage The usage of a set of machines.
+type ProjectMachineUsage struct {
+	// FlavorName The OpenStack flavor name.
+	FlavorName string `json:"flavorName"`
+
+	// MachineHours The number of machine hours used.
+	MachineHours float64 `json:"machineHours"`
+
+	// PoolName The pool name, the control plane is called control-plane.
+	PoolName string `json:"poolName"`
+
+	// Replicas The number of machines.
+	Replicas int `json:"replicas"`
+}
+
+// ProjectMachineUsages A list of machine usages.
+type ProjectMachineUsages = []ProjectMachineUsage
+
+// ProjectMember A project member.
+type ProjectMember struct {
+	// Role A project role.  Readers may only view resources, editors may also modify
+	// them, and administrators may additionally manage project members.  Roles
+	// are mapped onto OpenStack role assignments on the project.
+	Role ProjectRole `json:"role"`
+
+	// UserId The OpenStack user identifier.
+	UserId string `json:"userId"`
+
+	// UserName The OpenStack user name.
+	UserName string `json:"userName"`
+}
+
+// ProjectMemberInvitation Adds a user to the project.
+type ProjectMemberInvitation struct {
+	// Role A project role.  Readers may only view resources, editors may also modify
+	// them, and administrators may additionally manage project members.  Roles
+	// are mapped onto OpenStack role assignments on the project.
+	Role ProjectRole `json:"role"`
+
+	// UserName The OpenStack user name, this must exist in the same domain as the
+	// user making the request.
+	UserName string `json:"userName"`
+}
+
+// ProjectMemberRole Assigns a role to a project member.
+type ProjectMemberRole struct {
+	// Role A project role.  Readers may only view resources, editors may also modify
+	// them, and administrators may additionally manage project members.  Roles
+	// are mapped onto OpenStack role assignments on the project.
+	Role ProjectRole `json:"role"`
+}
+
+// ProjectMembers A list of project members.
+type ProjectMembers = []ProjectMember
+
+// ProjectOffboarding The progress of project offboarding.
+type ProjectOffboarding struct {
+	// NextStage An offboarding stage.  Clusters are deleted first, then credentials are
+	// revoked and control planes deleted, and finally the project is deleted.
+	NextStage *ProjectOffboardingStage `json:"nextStage,omitempty"`
+
+	// RemainingClusters The number of clusters that still exist.
+	RemainingClusters int `json:"remainingClusters"`
+
+	// RemainingControlPlanes The number of control planes that still exist.
+	RemainingControlPlanes int `json:"remainingControlPlanes"`
+
+	// RequestedTime When offboarding was requested.
+	RequestedTime time.Time `json:"requestedTime"`
+
+	// Stage An offboarding stage.  Clusters are deleted first, then credentials are
+	// revoked and control planes deleted, and finally the project is deleted.
+	Stage *ProjectOffboardingStage `json:"stage,omitempty"`
+
+	// Usage A usage report for the project.  Usage is recorded when cluster deletion is
+	// confirmed, until then it reflects the clusters that currently exist.
+	Usage ProjectUsageReport `json:"usage"`
+}
+
+// ProjectOffboardingConfirmation Confirms an offboarding stage.
+type ProjectOffboardingConfirmation struct {
+	// Stage An offboarding stage.  Clusters are deleted first, then credentials are
+	// revoked and control planes deleted, and finally the project is deleted.
+	Stage ProjectOffboardingStage `json:"stage"`
+}
+
+// ProjectOffboardingStage An offboarding stage.  Clusters are deleted first, then credentials are
+// revoked and control planes deleted, and finally the project is deleted.
+type ProjectOffboardingStage string
+
+// ProjectRole A project role.  Readers may only view resources, editors may also modify
+// them, and administrators may additionally manage project members.  Roles
+// are mapped onto OpenStack role assignments on the project.
+type ProjectRole string
+
+// ProjectRoleBinding Grants a project role to any user matching one of the subjects.  Bindings
+// are evaluated when a token is scoped to the project, so changes take effect
+// when the token is next refreshed.  Where a user is granted multiple roles,
+// by bindings or Keystone role assignments, the most privileged applies.
+type ProjectRoleBinding struct {
+	// Name A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+	Name KubernetesNameParameter `json:"name"`
+
+	// Role A project role.  Readers may only view resources, editors may also modify
+	// them, and administrators may additionally manage project members.  Roles
+	// are mapped onto OpenStack role assignments on the project.
+	Role ProjectRole `json:"role"`
+
+	// Subjects Subjects that are granted the role.
+	Subjects []ProjectRoleBindingSubject `json:"subjects"`
+}
+
+// ProjectRoleBindingSubject Identifies a set of users by an attribute of their identity.
+type ProjectRoleBindingSubject struct {
+	// Kind How the subject is matched.  An oidcGroup matches a group reported in the
+	// groups claim of the OIDC identity provider's ID token.  A keystoneRole
+	// matches a Keystone role the user has on the project.
+	Kind ProjectRoleBindingSubjectKind `json:"kind"`
+
+	// Name The OIDC group or Keystone role name.
+	Name string `json:"name"`
+}
+
+// ProjectRoleBindingSubjectKind How the subject is matched.  An oidcGroup matches a group reported in the
+// groups claim of the OIDC identity provider's ID token.  A keystoneRole
+// matches a Keystone role the user has on the project.
+type ProjectRoleBindingSubjectKind string
+
+// ProjectRoleBindings A list of project role bindings.
+type ProjectRoleBindings = []ProjectRoleBinding
+
+// ProjectUsageReport A usage report for the project.  Usage is recorded when cluster deletion is
+// confirmed, until then it reflects the clusters that currently exist.
+type ProjectUsageReport struct {
+	// Clusters A list of cluster usages.
+	Clusters ProjectClusterUsages `json:"clusters"`
+
+	// Flavors A list of flavor usages.
+	Flavors ProjectFlavorUsages `json:"flavors"`
+}
+
+// Provider The cloud provider the platform is deployed on.
+type Provider struct {
+	// Type The type of cloud provider.
+	Type ProviderType `json:"type"`
+}
+
+// ProviderType The type of cloud provider.
+type ProviderType string
+
+// ResourceReference A reference to an existing resource.
+type ResourceReference struct {
+	// ControlPlane The control plane the resource belongs to, if any.
+	ControlPlane *string `json:"controlPlane,omitempty"`
+
+	// Href The API path of the resource.
+	Href string `json:"href"`
+
+	// Kind The type of resource.
+	Kind ResourceReferenceKind `json:"kind"`
+
+	// Name The name of the resource.
+	Name string `json:"name"`
+}
+
+// ResourceReferenceKind The type of resource.
+type ResourceReferenceKind string
+
+// SshCertificate A short-lived SSH user certificate.
+type SshCertificate struct {
+	// Certificate The certificate in authorized_keys format.
+	Certificate string `json:"certificate"`
+
+	// Principals The users the certificate allows login as.
+	Principals []string `json:"principals"`
+
+	// ValidAfter The time the certificate becomes valid.
+	ValidAfter time.Time `json:"validAfter"`
+
+	// ValidBefore The time the certificate expires.
+	ValidBefore time.Time `json:"validBefore"`
+}
+
+// SshPublicKey An SSH public key to certify.
+type SshPublicKey struct {
+	// PublicKey The public key to certify in authorized_keys format.
+	PublicKey string `json:"publicKey"`
+}
+
 // TimeWindow A time window that wraps into the next day if required.
 type TimeWindow struct {
-	// End An hour of the day in UTC.
+	// End An hour of the day, in the auto upgrade time zone if specified, otherwise UTC.
 	End Hour `json:"end"`
 
-	// Start An hour of the day in UTC.
+	// Start An hour of the day, in the auto upgrade time zone if specified, otherwise UTC.
 	Start Hour `json:"start"`
 }
 
@@ -554,12 +2403,78 @@ type TokenScopeProject struct {
 	Id string `json:"id"`
 }
 
+// UpgradeFreeze An upgrade freeze.  While active, the platform will not automatically upgrade
+// the resource, even when its application bundle is end of life.  This allows
+// upgrades to be blocked during change embargo periods.  When part of a cluster
+// this is read only, use the upgrade freeze APIs to modify it.
+type UpgradeFreeze struct {
+	// Expiry The time at which the freeze expires, this must be in the future.
+	Expiry time.Time `json:"expiry"`
+
+	// Reason A human readable reason for the freeze e.g. a change reference.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ActivityTypeParameter defines model for activityTypeParameter.
+type ActivityTypeParameter = []ActivityType
+
+// CaptureIDParameter defines model for captureIDParameter.
+type CaptureIDParameter = string
+
+// ChangelogVersionParameter defines model for changelogVersionParameter.
+type ChangelogVersionParameter = string
+
 // ClusterNameParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
 type ClusterNameParameter = KubernetesNameParameter
 
+// ClusterViewParameter defines model for clusterViewParameter.
+type ClusterViewParameter string
+
+// ContinueParameter defines model for continueParameter.
+type ContinueParameter = string
+
 // ControlPlaneNameParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
 type ControlPlaneNameParameter = KubernetesNameParameter
 
+// DryRunParameter defines model for dryRunParameter.
+type DryRunParameter string
+
+// EnvironmentNameParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type EnvironmentNameParameter = KubernetesNameParameter
+
+// FlavorNameParameter defines model for flavorNameParameter.
+type FlavorNameParameter = string
+
+// IdempotencyKeyParameter defines model for idempotencyKeyParameter.
+type IdempotencyKeyParameter = string
+
+// LimitParameter defines model for limitParameter.
+type LimitParameter = int
+
+// RoleBindingNameParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type RoleBindingNameParameter = KubernetesNameParameter
+
+// SinceParameter defines model for sinceParameter.
+type SinceParameter = time.Time
+
+// TemplateParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type TemplateParameter = KubernetesNameParameter
+
+// UntilParameter defines model for untilParameter.
+type UntilParameter = time.Time
+
+// UpgradeIDParameter defines model for upgradeIDParameter.
+type UpgradeIDParameter = string
+
+// UserIDParameter defines model for userIDParameter.
+type UserIDParameter = string
+
+// WorkloadPoolNameParameter A Kubernetes name. Must be a valid DNS containing only lower case characters, numbers or hyphens, start and end with a character or number, and be at most 63 characters in length.
+type WorkloadPoolNameParameter = KubernetesNameParameter
+
+// ActivityFeedResponse A page of activities, most recent first.
+type ActivityFeedResponse = ActivityFeed
+
 // ApplicationBundleResponse A list of application bundles.
 type ApplicationBundleResponse = ApplicationBundles
 
@@ -578,9 +2493,27 @@ type ControlPlaneResponse = ControlPlane
 // ControlPlanesResponse A list of control planes.
 type ControlPlanesResponse = ControlPlanes
 
+// DebugCaptureResponse A debug capture of an API request.
+type DebugCaptureResponse = DebugCapture
+
+// DeprecatedUsagesResponse A list of deprecated API feature usages.
+type DeprecatedUsagesResponse = DeprecatedUsages
+
+// EnvironmentResponse A preview environment's status.
+type EnvironmentResponse = EnvironmentStatus
+
+// ExportResponse A portable definition of all control planes and clusters in a project.
+// Credentials and other secrets are never exported, they are regenerated on
+// import.  Installation specific OpenStack references are replaced with
+// placeholders that must be provided on import.
+type ExportResponse = ExportBundle
+
 // ForbiddenResponse Generic error message.
 type ForbiddenResponse = Oauth2Error
 
+// ImportResponse A list of import results.
+type ImportResponse = ImportResults
+
 // InternalServerErrorResponse Generic error message.
 type InternalServerErrorResponse = Oauth2Error
 
@@ -588,15 +2521,62 @@ type InternalServerErrorResponse = Oauth2Error
 // committee. Consult the relevant documentation for further details.
 type JwksResponse = JsonWebKeySet
 
+// KubernetesClusterCertificateResponse The Kubernetes API certificate configuration.
+type KubernetesClusterCertificateResponse = KubernetesClusterCertificate
+
+// KubernetesClusterCostResponse An estimate of a cluster's compute cost, based on the operator's price sheet.
+// Estimates are based on the requested replica counts, so do not account for
+// any scaling performed by the autoscaler.  Monthly costs assume an average
+// of 730 hours per month.
+type KubernetesClusterCostResponse = KubernetesClusterCost
+
+// KubernetesClusterHealthResponse A structured summary of cluster health to aid triage.  Node and certificate
+// signing request information is only available when the API server is
+// reachable.
+type KubernetesClusterHealthResponse = KubernetesClusterHealth
+
+// KubernetesClusterMetricsSummaryResponse A coarse summary of cluster utilization.  CPU is reported in cores, memory
+// in GiB, and GPUs and pods as counts.
+type KubernetesClusterMetricsSummaryResponse = KubernetesClusterMetricsSummary
+
 // KubernetesClusterResponse Kubernetes cluster creation parameters.
 type KubernetesClusterResponse = KubernetesCluster
 
+// KubernetesClusterStageTimingsResponse A list of provisioning stage timings, in the order they were started.
+type KubernetesClusterStageTimingsResponse = KubernetesClusterStageTimings
+
+// KubernetesClusterTemplatesResponse A list of Kubernetes cluster templates.
+type KubernetesClusterTemplatesResponse = KubernetesClusterTemplates
+
+// KubernetesClusterWorkloadPoolBootstrapResponse A workload pool's effective kubeadm bootstrap data, as rendered into the cloud-init
+// user data that nodes boot with.  Files are written first, then pre-kubeadm commands
+// are run, the node joins the cluster, and finally post-kubeadm commands are run.
+type KubernetesClusterWorkloadPoolBootstrapResponse = KubernetesClusterWorkloadPoolBootstrap
+
+// KubernetesClusterWorkloadPoolOperationsResponse The outcome of a batch of workload pool mutations.
+type KubernetesClusterWorkloadPoolOperationsResponse = KubernetesClusterWorkloadPoolOperationResults
+
 // KubernetesClustersResponse A list of Kubernetes clusters.
 type KubernetesClustersResponse = KubernetesClusters
 
+// KubernetesVersionsResponse A list of Kubernetes versions, newest first.
+type KubernetesVersionsResponse = KubernetesVersions
+
+// MonitorShardsResponse How resources are shared between monitor replicas.
+type MonitorShardsResponse = MonitorShards
+
+// NodeAllowListResponse A list of rules defining external traffic that may reach workload pool nodes,
+// all other traffic is denied when the node firewall feature is enabled.
+type NodeAllowListResponse = NodeAllowList
+
 // NotFoundResponse Generic error message.
 type NotFoundResponse = Oauth2Error
 
+// OpenstackApplicationCredentialRolesResponse The roles delegated to application credentials created on the user's behalf
+// e.g. when creating a cluster.  The user must have all of these roles on the
+// project.
+type OpenstackApplicationCredentialRolesResponse = OpenstackApplicationCredentialRoles
+
 // OpenstackBlockStorageAvailabilityZonesResponse A list of OpenStack availability zones.
 type OpenstackBlockStorageAvailabilityZonesResponse = OpenstackAvailabilityZones
 
@@ -609,6 +2589,9 @@ type OpenstackExternalNetworksResponse = OpenstackExternalNetworks
 // OpenstackFlavorsResponse A list of OpenStack flavors.
 type OpenstackFlavorsResponse = OpenstackFlavors
 
+// OpenstackHealthResponse The reachability of OpenStack services.
+type OpenstackHealthResponse = OpenstackHealth
+
 // OpenstackImagesResponse A list of OpenStack images that are compatible with this platform.
 type OpenstackImagesResponse = OpenstackImages
 
@@ -618,21 +2601,203 @@ type OpenstackKeyPairsResponse = OpenstackKeyPairs
 // OpenstackProjectsResponse A list of OpenStack projects.
 type OpenstackProjectsResponse = OpenstackProjects
 
+// OpenstackQuotasResponse OpenStack quotas for the scoped project.  Compute RAM is reported in MiB.
+type OpenstackQuotasResponse = OpenstackQuotas
+
+// PlatformChangelogResponse A list of platform changelog entries, newest first.
+type PlatformChangelogResponse = PlatformChangelog
+
+// ProjectMembersResponse A list of project members.
+type ProjectMembersResponse = ProjectMembers
+
+// ProjectOffboardingResponse The progress of project offboarding.
+type ProjectOffboardingResponse = ProjectOffboarding
+
+// ProjectRoleBindingResponse Grants a project role to any user matching one of the subjects.  Bindings
+// are evaluated when a token is scoped to the project, so changes take effect
+// when the token is next refreshed.  Where a user is granted multiple roles,
+// by bindings or Keystone role assignments, the most privileged applies.
+type ProjectRoleBindingResponse = ProjectRoleBinding
+
+// ProjectRoleBindingsResponse A list of project role bindings.
+type ProjectRoleBindingsResponse = ProjectRoleBindings
+
+// ProviderResponse The cloud provider the platform is deployed on.
+type ProviderResponse = Provider
+
+// ServiceUnavailableResponse Generic error message.
+type ServiceUnavailableResponse = Oauth2Error
+
+// SshCertificateResponse A short-lived SSH user certificate.
+type SshCertificateResponse = SshCertificate
+
 // TokenResponse Oauth2 token result.
 type TokenResponse = Token
 
 // UnauthorizedResponse Generic error message.
 type UnauthorizedResponse = Oauth2Error
 
+// UnprocessableEntityResponse Generic error message.
+type UnprocessableEntityResponse = Oauth2Error
+
+// ControlPlaneMoveRequest Moves a control plane, and all of its clusters, into another project.
+// The user must be a member of the target project, and it must already
+// be provisioned.
+type ControlPlaneMoveRequest = ControlPlaneMove
+
+// ControlPlaneResizeRequest Resources allocated to a control plane.  A class selects a predefined size,
+// and explicit CPU and memory requests override those of the class.  Values
+// are Kubernetes resource quantities e.g. 500m or 1Gi.  When no properties
+// are set the platform defaults are used.
+type ControlPlaneResizeRequest = ControlPlaneResources
+
 // CreateControlPlaneRequest A control plane.
 type CreateControlPlaneRequest = ControlPlane
 
+// CreateEnvironmentRequest Preview environment creation parameters.
+type CreateEnvironmentRequest = Environment
+
 // CreateKubernetesClusterRequest Kubernetes cluster creation parameters.
 type CreateKubernetesClusterRequest = KubernetesCluster
 
+// ImportRequest Import parameters.
+type ImportRequest = ImportOptions
+
+// KubernetesClusterCertificateRequest Kubernetes API certificate options.  Any change to the SANs, or an explicit
+// request, will reissue the API certificate.
+type KubernetesClusterCertificateRequest = KubernetesClusterCertificateOptions
+
+// KubernetesClusterWorkloadPoolOperationsRequest A set of workload pool mutations that are applied atomically, either all
+// succeed or none are applied.  Each pool may only be mutated once.
+type KubernetesClusterWorkloadPoolOperationsRequest = KubernetesClusterWorkloadPoolOperations
+
+// KubernetesUpgradeRequest A Kubernetes version upgrade.  Versions may only be upgraded one minor version
+// at a time, and an image of the requested version must be available.
+type KubernetesUpgradeRequest = KubernetesUpgrade
+
+// MonitorShardPinsRequest A list of resource pins.
+type MonitorShardPinsRequest = MonitorShardPins
+
+// NodeAllowListRequest A list of rules defining external traffic that may reach workload pool nodes,
+// all other traffic is denied when the node firewall feature is enabled.
+type NodeAllowListRequest = NodeAllowList
+
+// ProjectMemberInvitationRequest Adds a user to the project.
+type ProjectMemberInvitationRequest = ProjectMemberInvitation
+
+// ProjectMemberRoleRequest Assigns a role to a project member.
+type ProjectMemberRoleRequest = ProjectMemberRole
+
+// ProjectOffboardingConfirmationRequest Confirms an offboarding stage.
+type ProjectOffboardingConfirmationRequest = ProjectOffboardingConfirmation
+
+// ProjectRoleBindingRequest Grants a project role to any user matching one of the subjects.  Bindings
+// are evaluated when a token is scoped to the project, so changes take effect
+// when the token is next refreshed.  Where a user is granted multiple roles,
+// by bindings or Keystone role assignments, the most privileged applies.
+type ProjectRoleBindingRequest = ProjectRoleBinding
+
+// SshCertificateRequest An SSH public key to certify.
+type SshCertificateRequest = SshPublicKey
+
 // TokenScopeRequest OpenStack token scope.
 type TokenScopeRequest = TokenScope
 
+// UpgradeFreezeRequest An upgrade freeze.  While active, the platform will not automatically upgrade
+// the resource, even when its application bundle is end of life.  This allows
+// upgrades to be blocked during change embargo periods.  When part of a cluster
+// this is read only, use the upgrade freeze APIs to modify it.
+type UpgradeFreezeRequest = UpgradeFreeze
+
+// GetApiV1ActivityParams defines parameters for GetApiV1Activity.
+type GetApiV1ActivityParams struct {
+	// Type Only return activities of these types.  May be specified more than once.
+	Type *ActivityTypeParameter `form:"type,omitempty" json:"type,omitempty"`
+
+	// Since Only return activities that occurred at or after this time.
+	Since *SinceParameter `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return activities that occurred before this time.
+	Until *UntilParameter `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit The maximum number of items to return.
+	Limit *LimitParameter `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Continue from where a previous request left off.  This is the opaque
+	// continue value returned by a previous response, and the same filters must
+	// be specified.
+	Continue *ContinueParameter `form:"continue,omitempty" json:"continue,omitempty"`
+}
+
+// GetApiV1AdminExportParams defines parameters for GetApiV1AdminExport.
+type GetApiV1AdminExportParams struct {
+	// Limit The maximum number of items to return.
+	Limit *LimitParameter `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Continue Continue from where a previous request left off.  This is the opaque
+	// continue value returned by a previous response, and the same filters must
+	// be specified.
+	Continue *ContinueParameter `form:"continue,omitempty" json:"continue,omitempty"`
+}
+
+// PostApiV1ControlplanesParams defines parameters for PostApiV1Controlplanes.
+type PostApiV1ControlplanesParams struct {
+	// IdempotencyKey A unique key, generated by the client, that identifies a create request.
+	// Retrying a request with the same key and body will not create a duplicate
+	// resource, instead the original result is returned with the
+	// Idempotent-Replayed header set to true.  Reusing a key with a different
+	// body is rejected, as is a retry while the original request is in progress.
+	// Keys are scoped to the user and project, and expire after 24 hours.
+	IdempotencyKey *IdempotencyKeyParameter `json:"Idempotency-Key,omitempty"`
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersParams defines parameters for PostApiV1ControlplanesControlPlaneNameClusters.
+type PostApiV1ControlplanesControlPlaneNameClustersParams struct {
+	// DryRun Validate and evaluate the request without creating anything.  When set to
+	// cost the response contains a cost estimate for the resource.
+	DryRun *PostApiV1ControlplanesControlPlaneNameClustersParamsDryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// Template The name of a cluster template.  Any optional values omitted from the request
+	// will be defaulted from the template.
+	Template *TemplateParameter `form:"template,omitempty" json:"template,omitempty"`
+
+	// IdempotencyKey A unique key, generated by the client, that identifies a create request.
+	// Retrying a request with the same key and body will not create a duplicate
+	// resource, instead the original result is returned with the
+	// Idempotent-Replayed header set to true.  Reusing a key with a different
+	// body is rejected, as is a retry while the original request is in progress.
+	// Keys are scoped to the user and project, and expire after 24 hours.
+	IdempotencyKey *IdempotencyKeyParameter `json:"Idempotency-Key,omitempty"`
+}
+
+// PostApiV1ControlplanesControlPlaneNameClustersParamsDryRun defines parameters for PostApiV1ControlplanesControlPlaneNameClusters.
+type PostApiV1ControlplanesControlPlaneNameClustersParamsDryRun string
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams defines parameters for GetApiV1ControlplanesControlPlaneNameClustersClusterName.
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams struct {
+	// View How to present the cluster.  By default the cluster is converted into the API
+	// representation.  When set to raw the underlying custom resource is returned as
+	// YAML, with any secrets redacted, this requires the admin scope.
+	View *GetApiV1ControlplanesControlPlaneNameClustersClusterNameParamsView `form:"view,omitempty" json:"view,omitempty"`
+}
+
+// GetApiV1ControlplanesControlPlaneNameClustersClusterNameParamsView defines parameters for GetApiV1ControlplanesControlPlaneNameClustersClusterName.
+type GetApiV1ControlplanesControlPlaneNameClustersClusterNameParamsView string
+
+// PostApiV1ProjectParams defines parameters for PostApiV1Project.
+type PostApiV1ProjectParams struct {
+	// IdempotencyKey A unique key, generated by the client, that identifies a create request.
+	// Retrying a request with the same key and body will not create a duplicate
+	// resource, instead the original result is returned with the
+	// Idempotent-Replayed header set to true.  Reusing a key with a different
+	// body is rejected, as is a retry while the original request is in progress.
+	// Keys are scoped to the user and project, and expire after 24 hours.
+	IdempotencyKey *IdempotencyKeyParameter `json:"Idempotency-Key,omitempty"`
+}
+
+// PutApiV1AdminMonitorShardsJSONRequestBody defines body for PutApiV1AdminMonitorShards for application/json ContentType.
+type PutApiV1AdminMonitorShardsJSONRequestBody = MonitorShardPins
+
 // PostApiV1AuthOauth2TokensFormdataRequestBody defines body for PostApiV1AuthOauth2Tokens for application/x-www-form-urlencoded ContentType.
 type PostApiV1AuthOauth2TokensFormdataRequestBody = TokenRequestOptions
 
@@ -651,6 +2816,51 @@ type PostApiV1ControlplanesControlPlaneNameClustersJSONRequestBody = KubernetesC
 // PutApiV1ControlplanesControlPlaneNameClustersClusterNameJSONRequestBody defines body for PutApiV1ControlplanesControlPlaneNameClustersClusterName for application/json ContentType.
 type PutApiV1ControlplanesControlPlaneNameClustersClusterNameJSONRequestBody = KubernetesCluster
 
+// PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateJSONRequestBody defines body for PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate for application/json ContentType.
+type PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateJSONRequestBody = KubernetesClusterCertificateOptions
+
+// PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistJSONRequestBody defines body for PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist for application/json ContentType.
+type PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistJSONRequestBody = NodeAllowList
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateJSONRequestBody defines body for PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate for application/json ContentType.
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateJSONRequestBody = SshPublicKey
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeJSONRequestBody defines body for PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade for application/json ContentType.
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeJSONRequestBody = KubernetesUpgrade
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeJSONRequestBody defines body for PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze for application/json ContentType.
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreezeJSONRequestBody = UpgradeFreeze
+
+// PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsJSONRequestBody defines body for PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools for application/json ContentType.
+type PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsJSONRequestBody = KubernetesClusterWorkloadPoolOperations
+
+// PostApiV1ControlplanesControlPlaneNameMoveJSONRequestBody defines body for PostApiV1ControlplanesControlPlaneNameMove for application/json ContentType.
+type PostApiV1ControlplanesControlPlaneNameMoveJSONRequestBody = ControlPlaneMove
+
+// PostApiV1ControlplanesControlPlaneNameResizeJSONRequestBody defines body for PostApiV1ControlplanesControlPlaneNameResize for application/json ContentType.
+type PostApiV1ControlplanesControlPlaneNameResizeJSONRequestBody = ControlPlaneResources
+
+// PostApiV1EnvironmentsJSONRequestBody defines body for PostApiV1Environments for application/json ContentType.
+type PostApiV1EnvironmentsJSONRequestBody = Environment
+
+// PostApiV1ImportJSONRequestBody defines body for PostApiV1Import for application/json ContentType.
+type PostApiV1ImportJSONRequestBody = ImportOptions
+
+// PostApiV1ProjectMembersJSONRequestBody defines body for PostApiV1ProjectMembers for application/json ContentType.
+type PostApiV1ProjectMembersJSONRequestBody = ProjectMemberInvitation
+
+// PutApiV1ProjectMembersUserIDJSONRequestBody defines body for PutApiV1ProjectMembersUserID for application/json ContentType.
+type PutApiV1ProjectMembersUserIDJSONRequestBody = ProjectMemberRole
+
+// PostApiV1ProjectOffboardingConfirmJSONRequestBody defines body for PostApiV1ProjectOffboardingConfirm for application/json ContentType.
+type PostApiV1ProjectOffboardingConfirmJSONRequestBody = ProjectOffboardingConfirmation
+
+// PostApiV1ProjectRolebindingsJSONRequestBody defines body for PostApiV1ProjectRolebindings for application/json ContentType.
+type PostApiV1ProjectRolebindingsJSONRequestBody = ProjectRoleBinding
+
+// PutApiV1ProjectRolebindingsRoleBindingNameJSONRequestBody defines body for PutApiV1ProjectRolebindingsRoleBindingName for application/json ContentType.
+type PutApiV1ProjectRolebindingsRoleBindingNameJSONRequestBody = ProjectRoleBinding
+
 // AsTokenRequestOptions0 returns the union data inside the TokenRequestOptions as a TokenRequestOptions0
 func (t TokenRequestOptions) AsTokenRequestOptions0() (TokenRequestOptions0, error) {
 	var body TokenRequestOptions0
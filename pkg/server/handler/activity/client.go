@@ -0,0 +1,297 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package activity
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/project"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// defaultLimit is used when the client doesn't specify a page size.
+	defaultLimit = 100
+)
+
+// Client wraps up project activity handling.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client) *Client {
+	return &Client{
+		client: client,
+	}
+}
+
+// resource is what is common to all resources that generate activities.
+type resource struct {
+	// kind is the resource kind.
+	kind generated.ActivityResourceKind
+
+	// name is the resource name.
+	name string
+
+	// controlPlaneName is set for clusters.
+	controlPlaneName *string
+
+	// object is the resource's metadata.
+	object metav1.Object
+
+	// conditions are the resource's status conditions.
+	conditions []coreunikornv1.Condition
+
+	// upgrade is any pending upgrade.
+	upgrade *unikornv1.ApplicationBundleUpgradeStatus
+}
+
+// activities derives activities from a resource's metadata and status.
+func (r *resource) activities() []generated.Activity {
+	newActivity := func(t time.Time, activityType generated.ActivityType) generated.Activity {
+		return generated.Activity{
+			Time:             t,
+			Type:             activityType,
+			Kind:             r.kind,
+			Name:             r.name,
+			ControlPlaneName: r.controlPlaneName,
+		}
+	}
+
+	out := []generated.Activity{
+		newActivity(r.object.GetCreationTimestamp().Time, generated.Created),
+	}
+
+	if deletionTimestamp := r.object.GetDeletionTimestamp(); deletionTimestamp != nil {
+		out = append(out, newActivity(deletionTimestamp.Time, generated.Deleting))
+	}
+
+	if condition, err := coreunikornv1.GetCondition(r.conditions, coreunikornv1.ConditionAvailable); err == nil {
+		activity := newActivity(condition.LastTransitionTime.Time, generated.StatusChanged)
+
+		reason := string(condition.Reason)
+		activity.Reason = &reason
+
+		if condition.Message != "" {
+			activity.Message = &condition.Message
+		}
+
+		out = append(out, activity)
+	}
+
+	if r.upgrade != nil && r.upgrade.ApprovalRequestedAt != nil {
+		activity := newActivity(r.upgrade.ApprovalRequestedAt.Time, generated.UpgradeApprovalRequested)
+
+		message := "upgrade to " + r.upgrade.Target + " requires approval"
+		activity.Reason = &r.upgrade.Target
+		activity.Message = &message
+
+		out = append(out, activity)
+	}
+
+	return out
+}
+
+// resources returns all resources in the project.
+func (c *Client) resources(ctx context.Context) ([]resource, error) {
+	claims, err := oauth2.ClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed get claims").WithError(err)
+	}
+
+	name, err := project.NameFromContext(ctx)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed get project name").WithError(err)
+	}
+
+	p := &unikornv1.Project{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Name: name}, p); err != nil {
+		// No project, no activity.
+		if kerrors.IsNotFound(err) {
+			return []resource{}, nil
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get project").WithError(err)
+	}
+
+	resources := []resource{
+		{
+			kind:       generated.ActivityResourceKindProject,
+			name:       claims.UnikornClaims.Project,
+			object:     p,
+			conditions: p.Status.Conditions,
+		},
+	}
+
+	if p.Status.Namespace == "" {
+		return resources, nil
+	}
+
+	controlPlanes := &unikornv1.ControlPlaneList{}
+
+	if err := c.client.List(ctx, controlPlanes, &client.ListOptions{Namespace: p.Status.Namespace}); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list control planes").WithError(err)
+	}
+
+	for i := range controlPlanes.Items {
+		controlPlane := &controlPlanes.Items[i]
+
+		resources = append(resources, resource{
+			kind:       generated.ActivityResourceKindControlPlane,
+			name:       controlPlane.Name,
+			object:     controlPlane,
+			conditions: controlPlane.Status.Conditions,
+			upgrade:    controlPlane.Status.Upgrade,
+		})
+
+		if controlPlane.Status.Namespace == "" {
+			continue
+		}
+
+		clusters := &unikornv1.KubernetesClusterList{}
+
+		if err := c.client.List(ctx, clusters, &client.ListOptions{Namespace: controlPlane.Status.Namespace}); err != nil {
+			return nil, errors.OAuth2ServerError("failed to list clusters").WithError(err)
+		}
+
+		for j := range clusters.Items {
+			cluster := &clusters.Items[j]
+
+			resources = append(resources, resource{
+				kind:             generated.ActivityResourceKindKubernetesCluster,
+				name:             cluster.Name,
+				controlPlaneName: &controlPlane.Name,
+				object:           cluster,
+				conditions:       cluster.Status.Conditions,
+				upgrade:          cluster.Status.Upgrade,
+			})
+		}
+	}
+
+	return resources, nil
+}
+
+// filter returns true if the activity should be included in the feed.
+func filter(activity *generated.Activity, params *generated.GetApiV1ActivityParams) bool {
+	if params.Type != nil && len(*params.Type) > 0 && !slices.Contains(*params.Type, activity.Type) {
+		return false
+	}
+
+	if params.Since != nil && activity.Time.Before(*params.Since) {
+		return false
+	}
+
+	if params.Until != nil && !activity.Time.Before(*params.Until) {
+		return false
+	}
+
+	return true
+}
+
+// compare orders activities most recent first, then by kind and name so that
+// pagination is stable.
+func compare(a, b generated.Activity) int {
+	if v := b.Time.Compare(a.Time); v != 0 {
+		return v
+	}
+
+	if v := cmp.Compare(a.Kind, b.Kind); v != 0 {
+		return v
+	}
+
+	if v := cmp.Compare(a.Name, b.Name); v != 0 {
+		return v
+	}
+
+	return cmp.Compare(a.Type, b.Type)
+}
+
+// List returns a page of activity for the project.
+func (c *Client) List(ctx context.Context, params *generated.GetApiV1ActivityParams) (*generated.ActivityFeed, error) {
+	limit := defaultLimit
+
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var offset int
+
+	if params.Continue != nil {
+		o, err := strconv.Atoi(*params.Continue)
+		if err != nil || o < 0 {
+			return nil, errors.OAuth2InvalidRequest("continue parameter invalid")
+		}
+
+		offset = o
+	}
+
+	resources, err := c.resources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []generated.Activity{}
+
+	for i := range resources {
+		for _, activity := range resources[i].activities() {
+			if filter(&activity, params) {
+				items = append(items, activity)
+			}
+		}
+	}
+
+	slices.SortStableFunc(items, compare)
+
+	result := &generated.ActivityFeed{
+		Items: []generated.Activity{},
+	}
+
+	if offset >= len(items) {
+		return result, nil
+	}
+
+	end := offset + limit
+
+	if end < len(items) {
+		next := strconv.Itoa(end)
+
+		result.Continue = &next
+	} else {
+		end = len(items)
+	}
+
+	result.Items = items[offset:end]
+
+	return result, nil
+}
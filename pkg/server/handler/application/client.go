@@ -21,6 +21,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/eschercloudai/unikorn/pkg/constants"
 	"github.com/eschercloudai/unikorn/pkg/server/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
 
@@ -88,6 +89,14 @@ func convert(in *coreunikornv1.HelmApplication) *generated.Application {
 		Tags:              &in.Spec.Tags,
 	}
 
+	if summary, ok := in.Annotations[constants.ApplicationSummaryAnnotation]; ok {
+		out.Summary = &summary
+	}
+
+	if category, ok := in.Annotations[constants.ApplicationCategoryAnnotation]; ok {
+		out.Category = &category
+	}
+
 	return out
 }
 
@@ -40,11 +40,38 @@ func NewClient(client client.Client) *Client {
 	}
 }
 
+func convertChannel(in *unikornv1.ApplicationBundleChannel) *generated.ApplicationBundleChannel {
+	if in == nil {
+		return nil
+	}
+
+	out := generated.ApplicationBundleChannel(*in)
+
+	return &out
+}
+
+func convertDisplay(in *unikornv1.ApplicationBundleDisplaySpec) *generated.ApplicationBundleDisplay {
+	if in == nil {
+		return nil
+	}
+
+	out := &generated.ApplicationBundleDisplay{
+		Description:      in.Description,
+		Category:         in.Category,
+		IconUrl:          in.IconURL,
+		DocumentationUrl: in.DocumentationURL,
+	}
+
+	return out
+}
+
 func convertControlPlane(in *unikornv1.ControlPlaneApplicationBundle) *generated.ApplicationBundle {
 	out := &generated.ApplicationBundle{
 		Name:    in.Name,
 		Version: *in.Spec.Version,
 		Preview: in.Spec.Preview,
+		Channel: convertChannel(in.Spec.Channel),
+		Display: convertDisplay(in.Spec.Display),
 	}
 
 	if in.Spec.EndOfLife != nil {
@@ -54,11 +81,27 @@ func convertControlPlane(in *unikornv1.ControlPlaneApplicationBundle) *generated
 	return out
 }
 
+func convertKubernetesVersions(in *unikornv1.ApplicationBundleKubernetesSpec) *generated.ApplicationBundleKubernetesVersions {
+	if in == nil {
+		return nil
+	}
+
+	out := &generated.ApplicationBundleKubernetesVersions{
+		Minimum: (*string)(in.MinimumVersion),
+		Maximum: (*string)(in.MaximumVersion),
+	}
+
+	return out
+}
+
 func convertKubernetesCluster(in *unikornv1.KubernetesClusterApplicationBundle) *generated.ApplicationBundle {
 	out := &generated.ApplicationBundle{
-		Name:    in.Name,
-		Version: *in.Spec.Version,
-		Preview: in.Spec.Preview,
+		Name:               in.Name,
+		Version:            *in.Spec.Version,
+		Preview:            in.Spec.Preview,
+		Channel:            convertChannel(in.Spec.Channel),
+		KubernetesVersions: convertKubernetesVersions(in.Spec.Kubernetes),
+		Display:            convertDisplay(in.Spec.Display),
 	}
 
 	if in.Spec.EndOfLife != nil {
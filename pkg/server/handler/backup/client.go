@@ -0,0 +1,247 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+
+	"github.com/eschercloudai/unikorn/pkg/server/authorization"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/cluster"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Version is the current bundle format version.
+	Version = 1
+
+	// ExternalNetworkIDPlaceholder replaces external network IDs on export, as
+	// they are specific to an OpenStack installation.
+	ExternalNetworkIDPlaceholder = "${EXTERNAL_NETWORK_ID}"
+)
+
+// Client wraps up project export and import handling.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+
+	// authenticator provides access to authentication services.
+	authenticator *authorization.Authenticator
+
+	// provider is required to provision imported clusters.
+	provider providers.CloudProvider
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, authenticator *authorization.Authenticator, provider providers.CloudProvider) *Client {
+	return &Client{
+		client:        client,
+		authenticator: authenticator,
+		provider:      provider,
+	}
+}
+
+// exportControlPlane removes anything that is read only from the control plane.
+func exportControlPlane(in *generated.ControlPlane) generated.ControlPlane {
+	out := *in
+
+	out.Status = nil
+	out.Upgrade = nil
+	out.Components = nil
+
+	return out
+}
+
+// exportCluster removes anything that is read only, or specific to this installation,
+// from the cluster.
+func exportCluster(in *generated.KubernetesCluster) generated.KubernetesCluster {
+	out := *in
+
+	out.Status = nil
+	out.Upgrade = nil
+	out.UpgradeFreeze = nil
+	out.Hibernated = nil
+	out.Openstack.ExternalNetworkID = ExternalNetworkIDPlaceholder
+
+	return out
+}
+
+// Export returns all control planes and clusters in the project.  Resources that
+// are being deleted are omitted.
+func (c *Client) Export(ctx context.Context) (*generated.ExportBundle, error) {
+	controlPlanes, err := controlplane.NewClient(c.client).List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &generated.ExportBundle{
+		Version:       Version,
+		ControlPlanes: generated.ExportedControlPlanes{},
+	}
+
+	for _, controlPlane := range controlPlanes {
+		if controlPlane.Status.DeletionTime != nil {
+			continue
+		}
+
+		clusters, err := cluster.NewClient(c.client, c.authenticator, c.provider).List(ctx, controlPlane.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		exported := generated.ExportedControlPlane{
+			ControlPlane: exportControlPlane(controlPlane),
+			Clusters:     generated.KubernetesClusters{},
+		}
+
+		for _, cluster := range clusters {
+			if cluster.Status.DeletionTime != nil {
+				continue
+			}
+
+			exported.Clusters = append(exported.Clusters, exportCluster(cluster))
+		}
+
+		out.ControlPlanes = append(out.ControlPlanes, exported)
+	}
+
+	return out, nil
+}
+
+// substitute replaces any placeholders in the bundle, this is done up front so we
+// don't partially import a bundle that cannot be completed.
+func substitute(request *generated.ImportOptions) error {
+	for i := range request.Bundle.ControlPlanes {
+		clusters := request.Bundle.ControlPlanes[i].Clusters
+
+		for j := range clusters {
+			openstack := &clusters[j].Openstack
+
+			if openstack.ExternalNetworkID != ExternalNetworkIDPlaceholder {
+				continue
+			}
+
+			if request.ExternalNetworkID == nil {
+				return errors.OAuth2InvalidRequest("external network ID required to import cluster " + clusters[j].Name)
+			}
+
+			openstack.ExternalNetworkID = *request.ExternalNetworkID
+		}
+	}
+
+	return nil
+}
+
+// exists returns whether a resource exists given the result of getting it.
+func exists(err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.IsHTTPNotFound(err) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+// importControlPlane creates the control plane if it doesn't already exist, this
+// will implicitly create the project if required.
+func (c *Client) importControlPlane(ctx context.Context, request *generated.ControlPlane) (bool, error) {
+	client := controlplane.NewClient(c.client)
+
+	_, err := client.Get(ctx, request.Name)
+
+	ok, err := exists(err)
+	if err != nil || ok {
+		return false, err
+	}
+
+	if err := client.Create(ctx, request); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// importCluster creates the cluster if it doesn't already exist.  Cluster creation
+// will wait for the control plane to become available.
+func (c *Client) importCluster(ctx context.Context, controlPlaneName string, request *generated.KubernetesCluster) (bool, error) {
+	client := cluster.NewClient(c.client, c.authenticator, c.provider)
+
+	_, err := client.Get(ctx, controlPlaneName, request.Name)
+
+	ok, err := exists(err)
+	if err != nil || ok {
+		return false, err
+	}
+
+	if err := client.Create(ctx, controlPlaneName, request); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Import creates all control planes and clusters defined in the bundle.  Existing
+// resources are skipped, so a failed import can be retried.
+func (c *Client) Import(ctx context.Context, request *generated.ImportOptions) (generated.ImportResults, error) {
+	if request.Bundle.Version != Version {
+		return nil, errors.OAuth2InvalidRequest("unsupported bundle version")
+	}
+
+	if err := substitute(request); err != nil {
+		return nil, err
+	}
+
+	results := generated.ImportResults{}
+
+	for i := range request.Bundle.ControlPlanes {
+		controlPlane := &request.Bundle.ControlPlanes[i]
+
+		created, err := c.importControlPlane(ctx, &controlPlane.ControlPlane)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, generated.ImportResult{
+			ControlPlaneName: controlPlane.ControlPlane.Name,
+			Created:          created,
+		})
+
+		for j := range controlPlane.Clusters {
+			cluster := &controlPlane.Clusters[j]
+
+			created, err := c.importCluster(ctx, controlPlane.ControlPlane.Name, cluster)
+			if err != nil {
+				return nil, err
+			}
+
+			results = append(results, generated.ImportResult{
+				ControlPlaneName: controlPlane.ControlPlane.Name,
+				ClusterName:      &cluster.Name,
+				Created:          created,
+			})
+		}
+	}
+
+	return results, nil
+}
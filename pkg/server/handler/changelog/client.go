@@ -0,0 +1,189 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changelog
+
+import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
+	"slices"
+	"strings"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/state"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// bucket is where acknowledgements are stored, keyed by user.
+const bucket = "changelog-acknowledgements"
+
+// Client wraps up platform changelog related management handling.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+
+	// state stores per user acknowledgements.
+	state state.Store
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, state state.Store) *Client {
+	return &Client{
+		client: client,
+		state:  state,
+	}
+}
+
+// subject returns the user the request is on behalf of.
+func subject(ctx context.Context) (string, error) {
+	claims, err := oauth2.ClaimsFromContext(ctx)
+	if err != nil {
+		return "", errors.OAuth2ServerError("failed get claims").WithError(err)
+	}
+
+	return claims.Subject, nil
+}
+
+// acknowledged returns the versions the user has acknowledged.
+func (c *Client) acknowledged(ctx context.Context, subject string) ([]string, error) {
+	value, err := c.state.Get(ctx, bucket, subject)
+	if err != nil {
+		if goerrors.Is(err, state.ErrNotFound) {
+			return []string{}, nil
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get changelog acknowledgements").WithError(err)
+	}
+
+	var versions []string
+
+	if err := json.Unmarshal(value, &versions); err != nil {
+		return nil, errors.OAuth2ServerError("failed to unmarshal changelog acknowledgements").WithError(err)
+	}
+
+	return versions, nil
+}
+
+// list returns all changelog entries, newest first.
+func (c *Client) list(ctx context.Context) ([]unikornv1.PlatformChangelog, error) {
+	result := &unikornv1.PlatformChangelogList{}
+
+	if err := c.client.List(ctx, result); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list platform changelog").WithError(err)
+	}
+
+	slices.SortStableFunc(result.Items, func(a, b unikornv1.PlatformChangelog) int {
+		if cmp := b.Spec.Date.Compare(a.Spec.Date.Time); cmp != 0 {
+			return cmp
+		}
+
+		return strings.Compare(b.Spec.Version, a.Spec.Version)
+	})
+
+	return result.Items, nil
+}
+
+// convert converts from a custom resource into the API definition.
+func convert(in *unikornv1.PlatformChangelog, acknowledged bool) generated.PlatformChangelogEntry {
+	out := generated.PlatformChangelogEntry{
+		Version:      in.Spec.Version,
+		Date:         in.Spec.Date.Time,
+		Acknowledged: acknowledged,
+	}
+
+	if len(in.Spec.Highlights) > 0 {
+		out.Highlights = &in.Spec.Highlights
+	}
+
+	if len(in.Spec.BreakingChanges) > 0 {
+		out.BreakingChanges = &in.Spec.BreakingChanges
+	}
+
+	return out
+}
+
+// List returns the platform changelog, newest first, annotated with whether
+// the user has acknowledged each entry.
+func (c *Client) List(ctx context.Context) (generated.PlatformChangelog, error) {
+	subject, err := subject(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	acknowledged, err := c.acknowledged(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(generated.PlatformChangelog, len(items))
+
+	for i := range items {
+		out[i] = convert(&items[i], slices.Contains(acknowledged, items[i].Spec.Version))
+	}
+
+	return out, nil
+}
+
+// Acknowledge records that the user has seen a changelog entry.
+func (c *Client) Acknowledge(ctx context.Context, version generated.ChangelogVersionParameter) error {
+	subject, err := subject(ctx)
+	if err != nil {
+		return err
+	}
+
+	items, err := c.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(items, func(item unikornv1.PlatformChangelog) bool { return item.Spec.Version == version }) {
+		return errors.HTTPNotFound()
+	}
+
+	mutate := func(value []byte) ([]byte, error) {
+		var versions []string
+
+		if value != nil {
+			if err := json.Unmarshal(value, &versions); err != nil {
+				return nil, err
+			}
+		}
+
+		if !slices.Contains(versions, version) {
+			versions = append(versions, version)
+		}
+
+		slices.Sort(versions)
+
+		return json.Marshal(versions)
+	}
+
+	if err := c.state.Update(ctx, bucket, subject, 0, mutate); err != nil {
+		return errors.OAuth2ServerError("failed to acknowledge changelog entry").WithError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"net"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// convertNodeAllowList converts from a custom resource into the API definition.
+func convertNodeAllowList(in []unikornv1.NodeAllowListRule) generated.NodeAllowList {
+	out := make(generated.NodeAllowList, len(in))
+
+	for i, rule := range in {
+		prefixes := make([]string, len(rule.Prefixes))
+
+		for j, prefix := range rule.Prefixes {
+			prefixes[j] = prefix.IPNet.String()
+		}
+
+		out[i] = generated.NodeAllowListRule{
+			Protocol: generated.NodeAllowListRuleProtocol(rule.Protocol),
+			Port:     rule.Port,
+			PortMax:  rule.PortMax,
+			Prefixes: prefixes,
+		}
+
+		if rule.Direction != nil {
+			direction := generated.NodeAllowListRuleDirection(*rule.Direction)
+
+			out[i].Direction = &direction
+		}
+	}
+
+	return out
+}
+
+// createNodeAllowList creates the node allow list part of the cluster.
+func createNodeAllowList(in generated.NodeAllowList) ([]unikornv1.NodeAllowListRule, error) {
+	out := make([]unikornv1.NodeAllowListRule, len(in))
+
+	for i, rule := range in {
+		if rule.PortMax != nil && *rule.PortMax < rule.Port {
+			return nil, errors.OAuth2InvalidRequest("node allow list port range end must not be less than the start")
+		}
+
+		prefixes := make([]unikornv1.IPPrefix, len(rule.Prefixes))
+
+		for j, prefix := range rule.Prefixes {
+			_, network, err := net.ParseCIDR(prefix)
+			if err != nil {
+				return nil, errors.OAuth2InvalidRequest("failed to parse node allow list prefix").WithError(err)
+			}
+
+			prefixes[j] = unikornv1.IPPrefix{IPNet: *network}
+		}
+
+		out[i] = unikornv1.NodeAllowListRule{
+			Protocol: unikornv1.NodeAllowListProtocol(rule.Protocol),
+			Port:     rule.Port,
+			PortMax:  rule.PortMax,
+			Prefixes: prefixes,
+		}
+
+		if rule.Direction != nil {
+			direction := unikornv1.NodeAllowListDirection(*rule.Direction)
+
+			out[i].Direction = &direction
+		}
+	}
+
+	return out, nil
+}
+
+// GetNodeAllowList returns the external traffic allowed to reach, or leave, the
+// cluster's nodes.
+func (c *Client) GetNodeAllowList(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) (generated.NodeAllowList, error) {
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertNodeAllowList(resource.Spec.NodeAllowList), nil
+}
+
+// SetNodeAllowList replaces the external traffic allowed to reach, or leave, the
+// cluster's nodes.  This is managed separately from the rest of the cluster so that exposing
+// workloads is always a deliberate act.
+func (c *Client) SetNodeAllowList(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, request generated.NodeAllowList) error {
+	allowList, err := createNodeAllowList(request)
+	if err != nil {
+		return err
+	}
+
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return err
+	}
+
+	if controlPlane.Deleting {
+		return errors.OAuth2InvalidRequest("control plane is being deleted")
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if resource.DeletionTimestamp != nil {
+		return errors.OAuth2InvalidRequest("cluster is being deleted")
+	}
+
+	if !resource.NodeFirewallEnabled() {
+		return errors.OAuth2InvalidRequest("cluster does not have the node firewall feature enabled")
+	}
+
+	temp := resource.DeepCopy()
+	temp.Spec.NodeAllowList = allowList
+
+	if err := c.client.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
+		return errors.OAuth2ServerError("failed to patch cluster").WithError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/clusteropenstack"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+
+	"github.com/eschercloudai/unikorn-core/pkg/util"
+)
+
+// convertBootstrapFile converts from the provisioner's bootstrap data into the
+// API definition, redacting anything that may contain secrets.
+func convertBootstrapFile(in *clusteropenstack.BootstrapFile) generated.KubernetesClusterBootstrapFile {
+	out := generated.KubernetesClusterBootstrapFile{
+		Path:     in.Path,
+		Redacted: in.Sensitive,
+	}
+
+	if in.Secret != "" {
+		out.Secret = &in.Secret
+
+		return out
+	}
+
+	sum := sha256.Sum256(in.Content)
+
+	out.Size = util.ToPointer(len(in.Content))
+	out.Sha256 = util.ToPointer(hex.EncodeToString(sum[:]))
+
+	if !in.Sensitive {
+		out.Content = util.ToPointer(string(in.Content))
+	}
+
+	return out
+}
+
+// convertBootstrap converts from the provisioner's kubeadm configuration into the
+// API definition.
+func convertBootstrap(in *clusteropenstack.WorkloadPoolKubeadmConfig) *generated.KubernetesClusterWorkloadPoolBootstrap {
+	out := &generated.KubernetesClusterWorkloadPoolBootstrap{
+		KubeadmConfigTemplate: in.TemplateName,
+	}
+
+	if len(in.PreKubeadmCommands) != 0 {
+		out.PreKubeadmCommands = &in.PreKubeadmCommands
+	}
+
+	if len(in.PostKubeadmCommands) != 0 {
+		out.PostKubeadmCommands = &in.PostKubeadmCommands
+	}
+
+	if len(in.Files) != 0 {
+		files := make(generated.KubernetesClusterBootstrapFiles, len(in.Files))
+
+		for i := range in.Files {
+			files[i] = convertBootstrapFile(&in.Files[i])
+		}
+
+		out.Files = &files
+	}
+
+	if len(in.Config) != 0 {
+		out.KubeadmConfig = &in.Config
+	}
+
+	return out
+}
+
+// GetWorkloadPoolBootstrap returns a workload pool's effective bootstrap data from
+// the control plane, with any credentials redacted.
+func (c *Client) GetWorkloadPoolBootstrap(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, workloadPoolName generated.WorkloadPoolNameParameter) (*generated.KubernetesClusterWorkloadPoolBootstrap, error) {
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.Spec.WorkloadPools == nil {
+		return nil, errors.HTTPNotFound()
+	}
+
+	for i := range cluster.Spec.WorkloadPools.Pools {
+		workloadPool := &cluster.Spec.WorkloadPools.Pools[i]
+
+		if workloadPool.Name != workloadPoolName {
+			continue
+		}
+
+		vclusterClient, err := c.controlPlaneClient(ctx, controlPlane)
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := clusteropenstack.GetWorkloadPoolKubeadmConfig(ctx, vclusterClient, cluster, workloadPool)
+		if err != nil {
+			if httpErr := errors.FromError(err); httpErr != nil {
+				return nil, httpErr
+			}
+
+			return nil, errors.OAuth2ServerError("unable to get workload pool bootstrap data").WithError(err)
+		}
+
+		return convertBootstrap(config), nil
+	}
+
+	return nil, errors.HTTPNotFound()
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// validateSubjectAlternativeNames checks each SAN is an IP address or a DNS name,
+// optionally with a leading wildcard label.  Anything else will be rejected by
+// kubeadm, and leave the control plane unable to provision.
+func validateSubjectAlternativeNames(names []string) error {
+	for _, name := range names {
+		if net.ParseIP(name) != nil {
+			continue
+		}
+
+		if errs := validation.IsDNS1123Subdomain(strings.TrimPrefix(name, "*.")); len(errs) != 0 {
+			return errors.OAuth2InvalidRequest("invalid subject alternative name " + name)
+		}
+	}
+
+	return nil
+}
+
+// subjectAlternativeNames returns the SANs from the cluster specification.
+func subjectAlternativeNames(in *unikornv1.KubernetesClusterSpec) []string {
+	if in.API == nil {
+		return nil
+	}
+
+	return in.API.SubjectAlternativeNames
+}
+
+// certificateReissueTime returns the last certificate reissue request time
+// from the cluster specification.
+func certificateReissueTime(in *unikornv1.KubernetesClusterSpec) *metav1.Time {
+	if in.API == nil {
+		return nil
+	}
+
+	return in.API.CertificateReissueTime
+}
+
+// updateCertificateReissue preserves any existing certificate reissue request, or
+// makes a new one if the SANs have changed, as they will not otherwise be applied
+// to existing control plane machines.
+func updateCertificateReissue(required, existing *unikornv1.KubernetesClusterSpec) {
+	reissueTime := certificateReissueTime(existing)
+
+	if !slices.Equal(subjectAlternativeNames(required), subjectAlternativeNames(existing)) {
+		now := metav1.Now()
+		reissueTime = &now
+	}
+
+	if reissueTime == nil {
+		return
+	}
+
+	if required.API == nil {
+		required.API = &unikornv1.KubernetesClusterAPISpec{}
+	}
+
+	required.API.CertificateReissueTime = reissueTime
+}
+
+// convertCertificate converts from a custom resource into the API definition.
+func convertCertificate(in *unikornv1.KubernetesCluster) *generated.KubernetesClusterCertificate {
+	out := &generated.KubernetesClusterCertificate{
+		SubjectAlternativeNames: generated.KubernetesClusterSubjectAlternativeNames{},
+	}
+
+	if names := subjectAlternativeNames(&in.Spec); names != nil {
+		out.SubjectAlternativeNames = names
+	}
+
+	reissueTime := certificateReissueTime(&in.Spec)
+	if reissueTime == nil {
+		return out
+	}
+
+	out.Reissue = &generated.KubernetesClusterCertificateReissue{
+		RequestedTime: reissueTime.Time,
+		Status:        generated.Pending,
+	}
+
+	// Status is only relevant if it refers to the current request, until the
+	// provisioner picks it up, it's still pending.
+	status := in.Status.APICertificate
+	if status == nil || !status.ReissueTime.Equal(reissueTime) {
+		return out
+	}
+
+	out.Reissue.Status = generated.InProgress
+	out.Reissue.Replicas = &status.Replicas
+	out.Reissue.UpdatedReplicas = &status.UpdatedReplicas
+
+	if status.CompletionTime != nil {
+		out.Reissue.Status = generated.Complete
+		out.Reissue.CompletionTime = &status.CompletionTime.Time
+	}
+
+	return out
+}
+
+// GetCertificate returns the API certificate SANs and any reissue progress.
+func (c *Client) GetCertificate(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) (*generated.KubernetesClusterCertificate, error) {
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertCertificate(resource), nil
+}
+
+// UpdateCertificate sets the API certificate SANs, requesting a certificate reissue
+// if they have changed, or if explicitly requested.
+func (c *Client) UpdateCertificate(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, request *generated.KubernetesClusterCertificateOptions) error {
+	if err := validateSubjectAlternativeNames(request.SubjectAlternativeNames); err != nil {
+		return err
+	}
+
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return err
+	}
+
+	if controlPlane.Deleting {
+		return errors.OAuth2InvalidRequest("control plane is being deleted")
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if resource.DeletionTimestamp != nil {
+		return errors.OAuth2InvalidRequest("cluster is being deleted")
+	}
+
+	// There are no control plane machines to replace.
+	if resource.Hibernated() {
+		return errors.OAuth2InvalidRequest("cluster is hibernated")
+	}
+
+	temp := resource.DeepCopy()
+
+	if temp.Spec.API == nil {
+		temp.Spec.API = &unikornv1.KubernetesClusterAPISpec{}
+	}
+
+	temp.Spec.API.SubjectAlternativeNames = nil
+
+	if len(request.SubjectAlternativeNames) > 0 {
+		temp.Spec.API.SubjectAlternativeNames = request.SubjectAlternativeNames
+	}
+
+	updateCertificateReissue(&temp.Spec, &resource.Spec)
+
+	if request.Reissue != nil && *request.Reissue {
+		now := metav1.Now()
+		temp.Spec.API.CertificateReissueTime = &now
+	}
+
+	if err := c.client.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
+		return errors.OAuth2ServerError("failed to patch cluster").WithError(err)
+	}
+
+	return nil
+}
@@ -18,28 +18,26 @@ package cluster
 
 import (
 	"context"
-	"net/http"
 	"slices"
 
-	"github.com/gophercloud/utils/openstack/clientconfig"
-
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/clusteropenstack"
 	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/vcluster"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization"
 	"github.com/eschercloudai/unikorn/pkg/server/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/common"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
-	"github.com/eschercloudai/unikorn/pkg/server/handler/providers/openstack"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
 
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/yaml"
 )
 
 // Client wraps up cluster related management handling.
@@ -47,22 +45,18 @@ type Client struct {
 	// client allows Kubernetes API access.
 	client client.Client
 
-	// request is the http request that invoked this client.
-	request *http.Request
-
 	// authenticator provides access to authentication services.
 	authenticator *authorization.Authenticator
 
-	openstack *openstack.Openstack
+	provider providers.CloudProvider
 }
 
 // NewClient returns a new client with required parameters.
-func NewClient(client client.Client, request *http.Request, authenticator *authorization.Authenticator, openstack *openstack.Openstack) *Client {
+func NewClient(client client.Client, authenticator *authorization.Authenticator, provider providers.CloudProvider) *Client {
 	return &Client{
 		client:        client,
-		request:       request,
 		authenticator: authenticator,
-		openstack:     openstack,
+		provider:      provider,
 	}
 }
 
@@ -81,7 +75,7 @@ func (c *Client) List(ctx context.Context, controlPlaneName generated.ControlPla
 
 	slices.SortStableFunc(result.Items, unikornv1.CompareKubernetesCluster)
 
-	out, err := c.convertList(ctx, result)
+	out, err := c.convertList(ctx, controlPlane, result)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +110,7 @@ func (c *Client) Get(ctx context.Context, controlPlaneName generated.ControlPlan
 		return nil, err
 	}
 
-	out, err := c.convert(ctx, result)
+	out, err := c.convert(ctx, controlPlane, result)
 	if err != nil {
 		return nil, err
 	}
@@ -124,13 +118,8 @@ func (c *Client) Get(ctx context.Context, controlPlaneName generated.ControlPlan
 	return out, nil
 }
 
-// GetKubeconfig returns the kubernetes configuation associated with a cluster.
-func (c *Client) GetKubeconfig(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) ([]byte, error) {
-	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
-	if err != nil {
-		return nil, err
-	}
-
+// controlPlaneClient returns a client for the control plane's virtual cluster.
+func (c *Client) controlPlaneClient(ctx context.Context, controlPlane *controlplane.Meta) (client.Client, error) {
 	// TODO: propagate the client like we do in the controllers, then code sharing
 	// becomes a lot easier!
 	ctx = coreclient.NewContextWithDynamicClient(ctx, c.client)
@@ -147,6 +136,21 @@ func (c *Client) GetKubeconfig(ctx context.Context, controlPlaneName generated.C
 		return nil, errors.OAuth2ServerError("failed to get control plane client").WithError(err)
 	}
 
+	return vclusterClient, nil
+}
+
+// GetKubeconfig returns the kubernetes configuation associated with a cluster.
+func (c *Client) GetKubeconfig(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) ([]byte, error) {
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	vclusterClient, err := c.controlPlaneClient(ctx, controlPlane)
+	if err != nil {
+		return nil, err
+	}
+
 	clusterObjectKey := client.ObjectKey{
 		Namespace: controlPlane.Namespace,
 		Name:      name,
@@ -176,76 +180,109 @@ func (c *Client) GetKubeconfig(ctx context.Context, controlPlaneName generated.C
 	return secret.Data["value"], nil
 }
 
-// createClientConfig creates an Openstack client configuration from the API.
-func (c *Client) createClientConfig(controlPlane *controlplane.Meta, name string) ([]byte, string, error) {
+// createServerGroup creates an OpenStack server group.
+func (c *Client) createServerGroup(ctx context.Context, identity *providers.Identity, controlPlane *controlplane.Meta, name, kind string, policy *unikornv1.ServerGroupPolicy) (string, error) {
 	// Name is fully qualified to avoid namespace clashes with control planes sharing
 	// the same project.
-	applicationCredentialName := controlPlane.Name + "-" + name
+	serverGroupName := controlPlane.Name + "-" + name + "-" + kind
 
-	// Find and delete and existing credential.
-	if _, err := c.openstack.GetApplicationCredential(c.request, applicationCredentialName); err != nil {
+	// Server group policies are immutable, so explicit policies are part of the
+	// name, allowing a policy change to create a new server group.
+	var policyName string
+
+	if policy != nil {
+		policyName = string(*policy)
+		serverGroupName += "-" + policyName
+	}
+
+	// Reuse the server group if it exists, otherwise create a new one.
+	sg, err := c.provider.GetServerGroup(ctx, identity, serverGroupName)
+	if err != nil {
 		if !errors.IsHTTPNotFound(err) {
-			return nil, "", err
+			return "", err
 		}
-	} else {
-		if err := c.openstack.DeleteApplicationCredential(c.request, applicationCredentialName); err != nil {
-			return nil, "", err
+	}
+
+	if sg == nil {
+		if sg, err = c.provider.CreateServerGroup(ctx, identity, serverGroupName, policyName); err != nil {
+			return "", err
 		}
 	}
 
-	ac, err := c.openstack.CreateApplicationCredential(c.request, applicationCredentialName, c.openstack.ApplicationCredentialRoles())
-	if err != nil {
-		return nil, "", err
+	return sg.ID, nil
+}
+
+// serverGroupPolicyEqual checks whether two optional server group policies are the same.
+func serverGroupPolicyEqual(a, b *unikornv1.ServerGroupPolicy) bool {
+	if a == nil || b == nil {
+		return a == b
 	}
 
-	cloud := "cloud"
+	return *a == *b
+}
 
-	clientConfig := &clientconfig.Clouds{
-		Clouds: map[string]clientconfig.Cloud{
-			cloud: {
-				AuthType: clientconfig.AuthV3ApplicationCredential,
-				AuthInfo: &clientconfig.AuthInfo{
-					AuthURL:                     c.authenticator.Keystone.Endpoint(),
-					ApplicationCredentialID:     ac.ID,
-					ApplicationCredentialSecret: ac.Secret,
-				},
-			},
-		},
+// createMachineServerGroup sets the server group for a machine.  If the machine
+// already exists with the same policy, its server group is preserved.
+func (c *Client) createMachineServerGroup(ctx context.Context, identity *providers.Identity, controlPlane *controlplane.Meta, name, kind string, machine, existing *unikornv1.MachineGeneric) error {
+	if existing != nil && serverGroupPolicyEqual(machine.ServerGroupPolicy, existing.ServerGroupPolicy) {
+		machine.ServerGroupID = existing.ServerGroupID
+
+		return nil
 	}
 
-	clientConfigYAML, err := yaml.Marshal(clientConfig)
+	serverGroupID, err := c.createServerGroup(ctx, identity, controlPlane, name, kind, machine.ServerGroupPolicy)
 	if err != nil {
-		return nil, "", errors.OAuth2ServerError("unable to create cloud config").WithError(err)
+		return err
 	}
 
-	return clientConfigYAML, cloud, nil
+	machine.ServerGroupID = &serverGroupID
+
+	return nil
 }
 
-// createServerGroup creates an OpenStack server group.
-func (c *Client) createServerGroup(controlPlane *controlplane.Meta, name, kind string) (string, error) {
-	// Name is fully qualified to avoid namespace clashes with control planes sharing
-	// the same project.
-	serverGroupName := controlPlane.Name + "-" + name + "-" + kind
+// createServerGroups creates a server group for the control plane and each
+// workload pool.  When updating, the existing cluster is provided so that
+// server groups can be preserved, and to avoid rolling machines in pools that
+// predate per pool server groups.
+func (c *Client) createServerGroups(ctx context.Context, identity *providers.Identity, controlPlane *controlplane.Meta, cluster, existing *unikornv1.KubernetesCluster) error {
+	var existingControlPlane *unikornv1.MachineGeneric
 
-	// Reuse the server group if it exists, otherwise create a new one.
-	sg, err := c.openstack.GetServerGroup(c.request, serverGroupName)
-	if err != nil {
-		if !errors.IsHTTPNotFound(err) {
-			return "", err
-		}
+	if existing != nil {
+		existingControlPlane = &existing.Spec.ControlPlane.MachineGeneric
 	}
 
-	if sg == nil {
-		if sg, err = c.openstack.CreateServerGroup(c.request, serverGroupName); err != nil {
-			return "", err
+	if err := c.createMachineServerGroup(ctx, identity, controlPlane, cluster.Name, "control-plane", &cluster.Spec.ControlPlane.MachineGeneric, existingControlPlane); err != nil {
+		return err
+	}
+
+	for i := range cluster.Spec.WorkloadPools.Pools {
+		pool := &cluster.Spec.WorkloadPools.Pools[i]
+
+		var existingPool *unikornv1.MachineGeneric
+
+		if existing != nil {
+			for j := range existing.Spec.WorkloadPools.Pools {
+				if existing.Spec.WorkloadPools.Pools[j].Name == pool.Name {
+					existingPool = &existing.Spec.WorkloadPools.Pools[j].MachineGeneric
+				}
+			}
+		}
+
+		if err := c.createMachineServerGroup(ctx, identity, controlPlane, cluster.Name, "pool-"+pool.Name, &pool.MachineGeneric, existingPool); err != nil {
+			return err
 		}
 	}
 
-	return sg.ID, nil
+	return nil
 }
 
 // Create creates the implicit cluster indentified by the JTW claims.
 func (c *Client) Create(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, options *generated.KubernetesCluster) error {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
 	controlPlane, err := controlplane.NewClient(c.client).GetOrCreateMetadata(ctx, controlPlaneName)
 	if err != nil {
 		return err
@@ -255,37 +292,77 @@ func (c *Client) Create(ctx context.Context, controlPlaneName generated.ControlP
 		return errors.OAuth2InvalidRequest("control plane is being deleted")
 	}
 
-	cluster, err := c.createCluster(controlPlane, options)
-	if err != nil {
+	if controlPlane.Project.Offboarding {
+		return errors.OAuth2InvalidRequest("project is being offboarded")
+	}
+
+	if err := c.preflight(ctx, identity, options); err != nil {
 		return err
 	}
 
-	clientConfig, cloud, err := c.createClientConfig(controlPlane, options.Name)
+	cluster, err := c.createCluster(ctx, identity, controlPlane, options)
 	if err != nil {
 		return err
 	}
 
-	serverGroupID, err := c.createServerGroup(controlPlane, options.Name, "control-plane")
+	// Clean up after any previous failed attempts.
+	if err := c.collectApplicationCredentials(ctx, identity, controlPlane); err != nil {
+		return err
+	}
+
+	clientConfig, cloud, applicationCredentialID, err := c.createClientConfig(ctx, identity, controlPlane, options.Name)
 	if err != nil {
 		return err
 	}
 
+	// Don't leak the credential if we fail from here on in.
+	cleanup := func() {
+		_ = c.provider.DeleteCredential(ctx, identity, applicationCredentialID)
+	}
+
+	if err := c.createServerGroups(ctx, identity, controlPlane, cluster, nil); err != nil {
+		cleanup()
+
+		return err
+	}
+
 	// TODO: should allow a private/self-signed CA via the API, or perhaps provide a
 	// default.
 	cluster.Spec.Openstack.Cloud = &cloud
 	cluster.Spec.Openstack.CloudConfig = &clientConfig
 
-	cluster.Spec.ControlPlane.ServerGroupID = &serverGroupID
+	var sshCertificateAuthorityKey []byte
+
+	if sshCertificateAuthorityEnabled(options) {
+		if cluster.Spec.SSHCertificateAuthority, sshCertificateAuthorityKey, err = generateSSHCertificateAuthority(); err != nil {
+			cleanup()
+
+			return err
+		}
+	}
 
 	if err := c.client.Create(ctx, cluster); err != nil {
+		cleanup()
+
 		// TODO: we can do a cached lookup to save the API traffic.
 		if kerrors.IsAlreadyExists(err) {
-			return errors.HTTPConflict()
+			return errors.HTTPConflict().WithResource(&generated.ResourceReference{
+				Kind:         generated.ResourceReferenceKindKubernetesCluster,
+				Name:         options.Name,
+				ControlPlane: &controlPlaneName,
+				Href:         "/api/v1/controlplanes/" + controlPlaneName + "/clusters/" + options.Name,
+			})
 		}
 
 		return errors.OAuth2ServerError("failed to create cluster").WithError(err)
 	}
 
+	if sshCertificateAuthorityKey != nil {
+		if err := c.createSSHCertificateAuthority(ctx, cluster, sshCertificateAuthorityKey); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -320,6 +397,11 @@ func (c *Client) Delete(ctx context.Context, controlPlaneName generated.ControlP
 
 // Update implements read/modify/write for the cluster.
 func (c *Client) Update(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, request *generated.KubernetesCluster) error {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
 	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
 	if err != nil {
 		return err
@@ -334,11 +416,41 @@ func (c *Client) Update(ctx context.Context, controlPlaneName generated.ControlP
 		return err
 	}
 
-	required, err := c.createCluster(controlPlane, request)
+	if resource.Hibernated() {
+		return errors.OAuth2InvalidRequest("cluster is hibernated")
+	}
+
+	return c.update(ctx, identity, controlPlane, resource, request)
+}
+
+// update replaces the specification of an existing cluster with the one requested,
+// preserving anything that is managed by the platform.
+func (c *Client) update(ctx context.Context, identity *providers.Identity, controlPlane *controlplane.Meta, resource *unikornv1.KubernetesCluster, request *generated.KubernetesCluster, opts ...client.MergeFromOption) error {
+	required, err := c.createCluster(ctx, identity, controlPlane, request)
 	if err != nil {
 		return err
 	}
 
+	// Replacing the CNI would leave existing pods without networking.
+	if required.NetworkPlugin() != resource.NetworkPlugin() {
+		return errors.OAuth2InvalidRequest("network plugin cannot be changed")
+	}
+
+	// The API load balancer's floating IP is allocated at creation time.
+	if required.PrivateAPIEnabled() != resource.PrivateAPIEnabled() {
+		return errors.OAuth2InvalidRequest("private api cannot be changed")
+	}
+
+	// The API load balancer would need to be replaced, changing its address.
+	if !equality.Semantic.DeepEqual(required.APILoadBalancer(), resource.APILoadBalancer()) {
+		return errors.OAuth2InvalidRequest("api load balancer cannot be changed")
+	}
+
+	// Nodes cannot be moved between networks.
+	if !equality.Semantic.DeepEqual(required.Spec.Openstack.Network, resource.Spec.Openstack.Network) {
+		return errors.OAuth2InvalidRequest("openstack network cannot be changed")
+	}
+
 	// Experience has taught me that modifying caches by accident is a bad thing
 	// so be extra safe and deep copy the existing resource.
 	temp := resource.DeepCopy()
@@ -348,11 +460,65 @@ func (c *Client) Update(ctx context.Context, controlPlaneName generated.ControlP
 	temp.Spec.Openstack.Cloud = resource.Spec.Openstack.Cloud
 	temp.Spec.Openstack.CloudConfig = resource.Spec.Openstack.CloudConfig
 
-	temp.Spec.ControlPlane.ServerGroupID = resource.Spec.ControlPlane.ServerGroupID
+	if err := c.createServerGroups(ctx, identity, controlPlane, temp, resource); err != nil {
+		return err
+	}
+
+	// The provider is fixed at creation, and isn't exposed by the API.
+	temp.Spec.Provider = resource.Spec.Provider
+	temp.Spec.UpgradeFreeze = resource.Spec.UpgradeFreeze
+	temp.Spec.NodeAllowList = resource.Spec.NodeAllowList
+
+	updateCertificateReissue(&temp.Spec, &resource.Spec)
+
+	// The SSH CA is preserved if it exists, so existing certificates continue
+	// to work, otherwise it's created or removed as requested.
+	var sshCertificateAuthorityKey []byte
+
+	if sshCertificateAuthorityEnabled(request) {
+		temp.Spec.SSHCertificateAuthority = resource.Spec.SSHCertificateAuthority
+
+		if temp.Spec.SSHCertificateAuthority == nil {
+			if temp.Spec.SSHCertificateAuthority, sshCertificateAuthorityKey, err = generateSSHCertificateAuthority(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := c.client.Patch(ctx, temp, client.MergeFromWithOptions(resource, opts...)); err != nil {
+		if kerrors.IsConflict(err) {
+			return errors.HTTPConflictWithDescription("cluster was modified concurrently").WithRemediation("retry the request").WithError(err)
+		}
 
-	if err := c.client.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
 		return errors.OAuth2ServerError("failed to patch cluster").WithError(err)
 	}
 
+	if sshCertificateAuthorityKey != nil {
+		if err := c.createSSHCertificateAuthority(ctx, temp, sshCertificateAuthorityKey); err != nil {
+			return err
+		}
+	}
+
+	if temp.Spec.SSHCertificateAuthority == nil && resource.Spec.SSHCertificateAuthority != nil {
+		if err := c.deleteSSHCertificateAuthority(ctx, temp); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// ApproveUpgrade approves a pending cluster upgrade.
+func (c *Client) ApproveUpgrade(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, upgradeID generated.UpgradeIDParameter) error {
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return err
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return common.ApproveUpgrade(ctx, c.client, resource, resource.Status.Upgrade, upgradeID)
+}
@@ -20,20 +20,31 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"regexp"
+	"strings"
+	"time"
 
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/calico"
+	"github.com/eschercloudai/unikorn/pkg/provisioners/helmapplications/cilium"
 	"github.com/eschercloudai/unikorn/pkg/server/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/applicationbundle"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/common"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
 
 	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
 	coreconstants "github.com/eschercloudai/unikorn-core/pkg/constants"
+	"github.com/eschercloudai/unikorn-core/pkg/util"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // convertOpenstack converts from a custom resource into the API definition.
@@ -45,9 +56,38 @@ func convertOpenstack(in *unikornv1.KubernetesCluster) generated.KubernetesClust
 		SshKeyName:              in.Spec.Openstack.SSHKeyName,
 	}
 
+	if network := in.Spec.Openstack.Network; network != nil {
+		openstack.Network = &generated.KubernetesClusterOpenStackNetwork{
+			NetworkID: *network.NetworkID,
+			SubnetID:  *network.SubnetID,
+			RouterID:  network.RouterID,
+		}
+	}
+
+	openstack.LoadBalancer = convertLoadBalancer(in.Spec.Openstack.LoadBalancer)
+
 	return openstack
 }
 
+// convertLoadBalancer converts from a custom resource into the API definition.
+func convertLoadBalancer(in *unikornv1.LoadBalancerSpec) *generated.OpenstackLoadBalancer {
+	if in == nil {
+		return nil
+	}
+
+	loadBalancer := &generated.OpenstackLoadBalancer{
+		FlavorID: in.FlavorID,
+	}
+
+	if in.Provider != nil {
+		provider := generated.OpenstackLoadBalancerProvider(*in.Provider)
+
+		loadBalancer.Provider = &provider
+	}
+
+	return loadBalancer
+}
+
 // convertNetwork converts from a custom resource into the API definition.
 func convertNetwork(in *unikornv1.KubernetesCluster) generated.KubernetesClusterNetwork {
 	dnsNameservers := make([]string, len(in.Spec.Network.DNSNameservers))
@@ -63,6 +103,24 @@ func convertNetwork(in *unikornv1.KubernetesCluster) generated.KubernetesCluster
 		DnsNameservers: dnsNameservers,
 	}
 
+	if in.Spec.Network.SecondaryServiceNetwork != nil {
+		network.SecondaryServicePrefix = util.ToPointer(in.Spec.Network.SecondaryServiceNetwork.IPNet.String())
+	}
+
+	if in.Spec.Network.SecondaryPodNetwork != nil {
+		network.SecondaryPodPrefix = util.ToPointer(in.Spec.Network.SecondaryPodNetwork.IPNet.String())
+	}
+
+	if in.Spec.Network.KubeProxyMode != nil {
+		mode := generated.KubernetesClusterNetworkKubeProxyMode(*in.Spec.Network.KubeProxyMode)
+
+		network.KubeProxyMode = &mode
+	}
+
+	plugin := generated.KubernetesClusterNetworkPlugin(in.NetworkPlugin())
+
+	network.Plugin = &plugin
+
 	return network
 }
 
@@ -88,6 +146,14 @@ func convertAPI(in *unikornv1.KubernetesCluster) *generated.KubernetesClusterAPI
 		api.AllowedPrefixes = &allowedPrefixes
 	}
 
+	if in.PrivateAPIEnabled() {
+		private := true
+
+		api.Private = &private
+	}
+
+	api.LoadBalancer = convertLoadBalancer(in.Spec.API.LoadBalancer)
+
 	return api
 }
 
@@ -100,6 +166,12 @@ func convertMachine(in *unikornv1.MachineGeneric) generated.OpenstackMachinePool
 		FlavorName: *in.Flavor,
 	}
 
+	if in.ServerGroupPolicy != nil {
+		policy := generated.OpenstackMachinePoolServerGroupPolicy(*in.ServerGroupPolicy)
+
+		machine.ServerGroupPolicy = &policy
+	}
+
 	if in.DiskSize != nil {
 		machine.Disk = &generated.OpenstackVolume{
 			Size:             int(in.DiskSize.Value()) >> 30,
@@ -110,6 +182,65 @@ func convertMachine(in *unikornv1.MachineGeneric) generated.OpenstackMachinePool
 	return machine
 }
 
+// convertQuantity converts from an optional resource quantity to an optional string.
+func convertQuantity(in *resource.Quantity) *string {
+	if in == nil {
+		return nil
+	}
+
+	out := in.String()
+
+	return &out
+}
+
+// convertReservedResources converts from a custom resource into the API definition.
+func convertReservedResources(in *unikornv1.ReservedResources) *generated.KubernetesClusterReservedResources {
+	if in == nil {
+		return nil
+	}
+
+	return &generated.KubernetesClusterReservedResources{
+		Cpu:              convertQuantity(in.CPU),
+		Memory:           convertQuantity(in.Memory),
+		EphemeralStorage: convertQuantity(in.EphemeralStorage),
+	}
+}
+
+// convertNodeConfiguration converts from a custom resource into the API definition.
+func convertNodeConfiguration(in *unikornv1.NodeConfiguration) *generated.KubernetesClusterNodeConfiguration {
+	if in == nil {
+		return nil
+	}
+
+	return &generated.KubernetesClusterNodeConfiguration{
+		MaxPods:        in.MaxPods,
+		SystemReserved: convertReservedResources(in.SystemReserved),
+		KubeReserved:   convertReservedResources(in.KubeReserved),
+	}
+}
+
+// convertTaints converts from a custom resource into the API definition.
+func convertTaints(in []unikornv1.Taint) *generated.KubernetesClusterTaints {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(generated.KubernetesClusterTaints, len(in))
+
+	for i, taint := range in {
+		out[i] = generated.KubernetesClusterTaint{
+			Key:    taint.Key,
+			Effect: generated.KubernetesClusterTaintEffect(taint.Effect),
+		}
+
+		if taint.Value != "" {
+			out[i].Value = util.ToPointer(taint.Value)
+		}
+	}
+
+	return &out
+}
+
 // convertWorkloadPool converts from a custom resource into the API definition.
 func convertWorkloadPool(in *unikornv1.KubernetesClusterWorkloadPoolsPoolSpec) generated.KubernetesClusterWorkloadPool {
 	workloadPool := generated.KubernetesClusterWorkloadPool{
@@ -121,13 +252,31 @@ func convertWorkloadPool(in *unikornv1.KubernetesClusterWorkloadPoolsPoolSpec) g
 		workloadPool.Labels = &in.KubernetesWorkloadPoolSpec.Labels
 	}
 
+	workloadPool.Taints = convertTaints(in.KubernetesWorkloadPoolSpec.Taints)
+
+	if len(in.KubernetesWorkloadPoolSpec.PreKubeadmCommands) != 0 {
+		workloadPool.PreKubeadmCommands = &in.KubernetesWorkloadPoolSpec.PreKubeadmCommands
+	}
+
+	if len(in.KubernetesWorkloadPoolSpec.PostKubeadmCommands) != 0 {
+		workloadPool.PostKubeadmCommands = &in.KubernetesWorkloadPoolSpec.PostKubeadmCommands
+	}
+
+	if len(in.KubernetesWorkloadPoolSpec.PrePullImages) != 0 {
+		workloadPool.PrePullImages = &in.KubernetesWorkloadPoolSpec.PrePullImages
+	}
+
 	if in.KubernetesWorkloadPoolSpec.Autoscaling != nil {
 		workloadPool.Autoscaling = &generated.KubernetesClusterAutoscaling{
 			MinimumReplicas: *in.KubernetesWorkloadPoolSpec.Autoscaling.MinimumReplicas,
 			MaximumReplicas: *in.KubernetesWorkloadPoolSpec.Autoscaling.MaximumReplicas,
+			WarmMachines:    in.KubernetesWorkloadPoolSpec.Autoscaling.WarmMachines,
 		}
 	}
 
+	workloadPool.NodeConfiguration = convertNodeConfiguration(in.KubernetesWorkloadPoolSpec.NodeConfiguration)
+	workloadPool.SshKeyName = in.KubernetesWorkloadPoolSpec.SSHKeyName
+
 	return workloadPool
 }
 
@@ -144,21 +293,7 @@ func convertWorkloadPools(in *unikornv1.KubernetesCluster) []generated.Kubernete
 
 // convertFeatures converts from a custom resource into the API definition.
 func convertFeatures(in *unikornv1.KubernetesCluster) *generated.KubernetesClusterFeatures {
-	if in.Spec.Features == nil {
-		return nil
-	}
-
-	features := &generated.KubernetesClusterFeatures{
-		Autoscaling:         in.Spec.Features.Autoscaling,
-		Ingress:             in.Spec.Features.Ingress,
-		CertManager:         in.Spec.Features.CertManager,
-		KubernetesDashboard: in.Spec.Features.KubernetesDashboard,
-		FileStorage:         in.Spec.Features.FileStorage,
-		Prometheus:          in.Spec.Features.Prometheus,
-		NvidiaOperator:      in.Spec.Features.NvidiaOperator,
-	}
-
-	return features
+	return common.ConvertFeatures(in.Spec.Features)
 }
 
 // convertStatus converts from a custom resource into the API definition.
@@ -182,12 +317,14 @@ func convertStatus(in *unikornv1.KubernetesCluster) *generated.KubernetesResourc
 }
 
 // convert converts from a custom resource into the API definition.
-func (c *Client) convert(ctx context.Context, in *unikornv1.KubernetesCluster) (*generated.KubernetesCluster, error) {
+func (c *Client) convert(ctx context.Context, controlPlane *controlplane.Meta, in *unikornv1.KubernetesCluster) (*generated.KubernetesCluster, error) {
 	bundle, err := applicationbundle.NewClient(c.client).GetKubernetesCluster(ctx, *in.Spec.ApplicationBundle)
 	if err != nil {
 		return nil, err
 	}
 
+	hibernated := in.Hibernated()
+
 	out := &generated.KubernetesCluster{
 		Name:                         in.Name,
 		ApplicationBundle:            *bundle,
@@ -199,17 +336,28 @@ func (c *Client) convert(ctx context.Context, in *unikornv1.KubernetesCluster) (
 		WorkloadPools:                convertWorkloadPools(in),
 		Features:                     convertFeatures(in),
 		Status:                       convertStatus(in),
+		Upgrade:                      common.ConvertApplicationBundleUpgrade(in.Status.Upgrade),
+		UpgradeFreeze:                convertUpgradeFreeze(in.Spec.UpgradeFreeze),
+		KubernetesUpgrade:            convertKubernetesUpgrade(in),
+		Hibernated:                   &hibernated,
+		Placement:                    common.ConvertPlacement(ctx, controlPlane.Project.Namespace, controlPlane.Namespace, in.Status.Namespace),
+	}
+
+	if in.Spec.SSHCertificateAuthority != nil {
+		sshCertificateAuthority := true
+
+		out.SshCertificateAuthority = &sshCertificateAuthority
 	}
 
 	return out, nil
 }
 
 // uconvertList converts from a custom resource list into the API definition.
-func (c *Client) convertList(ctx context.Context, in *unikornv1.KubernetesClusterList) ([]*generated.KubernetesCluster, error) {
+func (c *Client) convertList(ctx context.Context, controlPlane *controlplane.Meta, in *unikornv1.KubernetesClusterList) ([]*generated.KubernetesCluster, error) {
 	out := make([]*generated.KubernetesCluster, len(in.Items))
 
 	for i := range in.Items {
-		item, err := c.convert(ctx, &in.Items[i])
+		item, err := c.convert(ctx, controlPlane, &in.Items[i])
 		if err != nil {
 			return nil, err
 		}
@@ -232,9 +380,134 @@ func createOpenstack(options *generated.KubernetesCluster) *unikornv1.Kubernetes
 		openstack.SSHKeyName = options.Openstack.SshKeyName
 	}
 
+	if network := options.Openstack.Network; network != nil {
+		openstack.Network = &unikornv1.KubernetesClusterOpenstackNetworkSpec{
+			NetworkID: &network.NetworkID,
+			SubnetID:  &network.SubnetID,
+			RouterID:  network.RouterID,
+		}
+	}
+
+	openstack.LoadBalancer = createLoadBalancer(options.Openstack.LoadBalancer)
+
 	return openstack
 }
 
+// createLoadBalancer creates the load balancer configuration.
+func createLoadBalancer(options *generated.OpenstackLoadBalancer) *unikornv1.LoadBalancerSpec {
+	if options == nil {
+		return nil
+	}
+
+	loadBalancer := &unikornv1.LoadBalancerSpec{
+		FlavorID: options.FlavorID,
+	}
+
+	if options.Provider != nil {
+		provider := unikornv1.LoadBalancerProvider(*options.Provider)
+
+		loadBalancer.Provider = &provider
+	}
+
+	return loadBalancer
+}
+
+// prefixesOverlap returns true if either network contains the other.
+func prefixesOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// isIPv6 returns true if the network is an IPv6 one.
+func isIPv6(network *net.IPNet) bool {
+	return network.IP.To4() == nil
+}
+
+// createNetworkPlugin checks the requested CNI is supported, and that the
+// network prefixes are compatible with it.
+func createNetworkPlugin(options *generated.KubernetesClusterNetwork, nodeNet *net.IPNet, serviceNets, podNets []*net.IPNet) (*unikornv1.NetworkPlugin, error) {
+	plugin := unikornv1.NetworkPluginCilium
+
+	if options.Plugin != nil {
+		plugin = unikornv1.NetworkPlugin(*options.Plugin)
+	}
+
+	networks := append([]*net.IPNet{nodeNet}, serviceNets...)
+	networks = append(networks, podNets...)
+
+	for i := range networks {
+		for j := i + 1; j < len(networks); j++ {
+			if prefixesOverlap(networks[i], networks[j]) {
+				return nil, errors.OAuth2InvalidRequest("node, service and pod prefixes must not overlap")
+			}
+		}
+	}
+
+	// Each node is allocated a fixed size prefix from the pod network by the
+	// CNI, so it needs to be at least that big.
+	var nodeMaskSize, nodeMaskSizeIPv6 int
+
+	switch plugin {
+	case unikornv1.NetworkPluginCilium:
+		nodeMaskSize, nodeMaskSizeIPv6 = cilium.NodeMaskSize, cilium.NodeMaskSizeIPv6
+	case unikornv1.NetworkPluginCalico:
+		nodeMaskSize, nodeMaskSizeIPv6 = calico.BlockSize, calico.BlockSizeIPv6
+	case unikornv1.NetworkPluginNone:
+		return &plugin, nil
+	default:
+		return nil, errors.OAuth2InvalidRequest("unsupported network plugin")
+	}
+
+	for _, podNet := range podNets {
+		size := nodeMaskSize
+
+		if isIPv6(podNet) {
+			size = nodeMaskSizeIPv6
+		}
+
+		if ones, _ := podNet.Mask.Size(); ones > size {
+			return nil, errors.OAuth2InvalidRequest(fmt.Sprintf("pod prefix %s must be at least /%d for the %s network plugin", podNet, size, plugin))
+		}
+	}
+
+	return &plugin, nil
+}
+
+// createDualStack checks the secondary prefixes, if specified, are compatible
+// with the primary ones.
+func createDualStack(options *generated.KubernetesClusterNetwork, serviceNet, podNet *net.IPNet) (*net.IPNet, *net.IPNet, error) {
+	if isIPv6(serviceNet) != isIPv6(podNet) {
+		return nil, nil, errors.OAuth2InvalidRequest("service and pod prefixes must be of the same address family")
+	}
+
+	if options.SecondaryServicePrefix == nil && options.SecondaryPodPrefix == nil {
+		return nil, nil, nil
+	}
+
+	if options.SecondaryServicePrefix == nil || options.SecondaryPodPrefix == nil {
+		return nil, nil, errors.OAuth2InvalidRequest("secondary service and pod prefixes must both be specified for dual-stack networking")
+	}
+
+	_, secondaryServiceNet, err := net.ParseCIDR(*options.SecondaryServicePrefix)
+	if err != nil {
+		return nil, nil, errors.OAuth2InvalidRequest("failed to parse secondary service prefix").WithError(err)
+	}
+
+	_, secondaryPodNet, err := net.ParseCIDR(*options.SecondaryPodPrefix)
+	if err != nil {
+		return nil, nil, errors.OAuth2InvalidRequest("failed to parse secondary pod prefix").WithError(err)
+	}
+
+	if isIPv6(secondaryServiceNet) != isIPv6(secondaryPodNet) {
+		return nil, nil, errors.OAuth2InvalidRequest("secondary service and pod prefixes must be of the same address family")
+	}
+
+	if isIPv6(secondaryPodNet) == isIPv6(podNet) {
+		return nil, nil, errors.OAuth2InvalidRequest("secondary prefixes must be of a different address family to the primary prefixes")
+	}
+
+	return secondaryServiceNet, secondaryPodNet, nil
+}
+
 // createNetwork creates the network part of a cluster.
 func createNetwork(options *generated.KubernetesCluster) (*unikornv1.KubernetesClusterNetworkSpec, error) {
 	_, nodeNet, err := net.ParseCIDR(options.Network.NodePrefix)
@@ -264,10 +537,42 @@ func createNetwork(options *generated.KubernetesCluster) (*unikornv1.KubernetesC
 	}
 
 	network := &unikornv1.KubernetesClusterNetworkSpec{
-		NodeNetwork:    &unikornv1.IPv4Prefix{IPNet: *nodeNet},
-		ServiceNetwork: &unikornv1.IPv4Prefix{IPNet: *serviceNet},
-		PodNetwork:     &unikornv1.IPv4Prefix{IPNet: *podNet},
-		DNSNameservers: unikornv1.IPv4AddressSliceFromIPSlice(dnsNameservers),
+		NodeNetwork:    &unikornv1.IPPrefix{IPNet: *nodeNet},
+		ServiceNetwork: &unikornv1.IPPrefix{IPNet: *serviceNet},
+		PodNetwork:     &unikornv1.IPPrefix{IPNet: *podNet},
+		DNSNameservers: unikornv1.IPAddressSliceFromIPSlice(dnsNameservers),
+	}
+
+	secondaryServiceNet, secondaryPodNet, err := createDualStack(&options.Network, serviceNet, podNet)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceNets := []*net.IPNet{serviceNet}
+	podNets := []*net.IPNet{podNet}
+
+	if secondaryPodNet != nil {
+		network.SecondaryServiceNetwork = &unikornv1.IPPrefix{IPNet: *secondaryServiceNet}
+		network.SecondaryPodNetwork = &unikornv1.IPPrefix{IPNet: *secondaryPodNet}
+
+		serviceNets = append(serviceNets, secondaryServiceNet)
+		podNets = append(podNets, secondaryPodNet)
+	}
+
+	plugin, err := createNetworkPlugin(&options.Network, nodeNet, serviceNets, podNets)
+	if err != nil {
+		return nil, err
+	}
+
+	network.Plugin = plugin
+
+	if options.Network.KubeProxyMode != nil {
+		switch mode := unikornv1.KubeProxyMode(*options.Network.KubeProxyMode); mode {
+		case unikornv1.KubeProxyModeIPTables, unikornv1.KubeProxyModeIPVS:
+			network.KubeProxyMode = &mode
+		default:
+			return nil, errors.OAuth2InvalidRequest("unsupported kube-proxy mode")
+		}
 	}
 
 	return network, nil
@@ -283,11 +588,15 @@ func createAPI(options *generated.KubernetesCluster) (*unikornv1.KubernetesClust
 	api := &unikornv1.KubernetesClusterAPISpec{}
 
 	if options.Api.SubjectAlternativeNames != nil {
+		if err := validateSubjectAlternativeNames(*options.Api.SubjectAlternativeNames); err != nil {
+			return nil, err
+		}
+
 		api.SubjectAlternativeNames = *options.Api.SubjectAlternativeNames
 	}
 
 	if options.Api.AllowedPrefixes != nil {
-		prefixes := make([]unikornv1.IPv4Prefix, len(*options.Api.AllowedPrefixes))
+		prefixes := make([]unikornv1.IPPrefix, len(*options.Api.AllowedPrefixes))
 
 		for i, prefix := range *options.Api.AllowedPrefixes {
 			_, network, err := net.ParseCIDR(prefix)
@@ -295,19 +604,30 @@ func createAPI(options *generated.KubernetesCluster) (*unikornv1.KubernetesClust
 				return nil, errors.OAuth2InvalidRequest("failed to parse api allowed prefix").WithError(err)
 			}
 
-			prefixes[i] = unikornv1.IPv4Prefix{IPNet: *network}
+			prefixes[i] = unikornv1.IPPrefix{IPNet: *network}
 		}
 
 		api.AllowedPrefixes = prefixes
 	}
 
+	if options.Api.Private != nil && *options.Api.Private {
+		// Without a floating IP there's nothing to apply the allow list to.
+		if api.AllowedPrefixes != nil {
+			return nil, errors.OAuth2InvalidRequest("api allowed prefixes cannot be specified for a private cluster")
+		}
+
+		api.Private = options.Api.Private
+	}
+
+	api.LoadBalancer = createLoadBalancer(options.Api.LoadBalancer)
+
 	return api, nil
 }
 
 // createMachineGeneric creates a generic machine part of the cluster.
-func (c *Client) createMachineGeneric(m *generated.OpenstackMachinePool) (*unikornv1.MachineGeneric, *generated.OpenstackFlavor, error) {
+func (c *Client) createMachineGeneric(ctx context.Context, identity *providers.Identity, m *generated.OpenstackMachinePool) (*unikornv1.MachineGeneric, *providers.Flavor, error) {
 	// Check the image passed in is valid.
-	image, err := c.openstack.GetImage(c.request, m.ImageName)
+	image, err := c.provider.GetImage(ctx, identity, m.ImageName)
 	if err != nil {
 		if errors.IsHTTPNotFound(err) {
 			return nil, nil, errors.OAuth2InvalidRequest("invalid image").WithError(err)
@@ -318,12 +638,12 @@ func (c *Client) createMachineGeneric(m *generated.OpenstackMachinePool) (*uniko
 
 	// TODO: we can derive the version from the image, but its useful to have that
 	// in the GET data.
-	if m.Version != image.Versions.Kubernetes {
+	if m.Version != image.KubernetesVersion {
 		return nil, nil, errors.OAuth2InvalidRequest("invalid version for image").WithError(err)
 	}
 
 	// Check the flavor is valid
-	flavor, err := c.openstack.GetFlavor(c.request, m.FlavorName)
+	flavor, err := c.provider.GetFlavor(ctx, identity, m.FlavorName)
 	if err != nil {
 		if errors.IsHTTPNotFound(err) {
 			return nil, nil, errors.OAuth2InvalidRequest("invalid flavor").WithError(err)
@@ -341,6 +661,12 @@ func (c *Client) createMachineGeneric(m *generated.OpenstackMachinePool) (*uniko
 		Flavor:   &m.FlavorName,
 	}
 
+	if m.ServerGroupPolicy != nil {
+		policy := unikornv1.ServerGroupPolicy(*m.ServerGroupPolicy)
+
+		machine.ServerGroupPolicy = &policy
+	}
+
 	if m.Disk != nil {
 		size, err := resource.ParseQuantity(fmt.Sprintf("%dGi", m.Disk.Size))
 		if err != nil {
@@ -357,9 +683,210 @@ func (c *Client) createMachineGeneric(m *generated.OpenstackMachinePool) (*uniko
 	return machine, flavor, nil
 }
 
+// createQuantity parses an optional resource quantity, rejecting negative values.
+func createQuantity(in *string) (*resource.Quantity, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	quantity, err := resource.ParseQuantity(*in)
+	if err != nil {
+		return nil, errors.OAuth2InvalidRequest("failed to parse reserved resource quantity").WithError(err)
+	}
+
+	if quantity.Sign() < 0 {
+		return nil, errors.OAuth2InvalidRequest("reserved resource quantity must not be negative")
+	}
+
+	return &quantity, nil
+}
+
+// createReservedResources creates a set of reserved resources for a node.
+func createReservedResources(in *generated.KubernetesClusterReservedResources) (*unikornv1.ReservedResources, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	cpu, err := createQuantity(in.Cpu)
+	if err != nil {
+		return nil, err
+	}
+
+	memory, err := createQuantity(in.Memory)
+	if err != nil {
+		return nil, err
+	}
+
+	ephemeralStorage, err := createQuantity(in.EphemeralStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := &unikornv1.ReservedResources{
+		CPU:              cpu,
+		Memory:           memory,
+		EphemeralStorage: ephemeralStorage,
+	}
+
+	return reserved, nil
+}
+
+const (
+	// minimumMaxPods is the lowest allowed max pods setting, below this
+	// nodes may be unable to run the required system daemons.
+	minimumMaxPods = 10
+
+	// maximumMaxPods is the highest allowed max pods setting, this is the
+	// upper limit supported by upstream Kubernetes.
+	maximumMaxPods = 250
+)
+
+// createNodeConfiguration creates the node tuning options for a workload pool.
+// Only the options defined by the API are accepted, and these are further
+// constrained to sane bounds here so we don't rely on the CRD validation
+// to produce a user facing error.
+func createNodeConfiguration(in *generated.KubernetesClusterNodeConfiguration) (*unikornv1.NodeConfiguration, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	if in.MaxPods != nil && (*in.MaxPods < minimumMaxPods || *in.MaxPods > maximumMaxPods) {
+		return nil, errors.OAuth2InvalidRequest(fmt.Sprintf("node max pods must be between %d and %d", minimumMaxPods, maximumMaxPods))
+	}
+
+	systemReserved, err := createReservedResources(in.SystemReserved)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeReserved, err := createReservedResources(in.KubeReserved)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeConfiguration := &unikornv1.NodeConfiguration{
+		MaxPods:        in.MaxPods,
+		SystemReserved: systemReserved,
+		KubeReserved:   kubeReserved,
+	}
+
+	return nodeConfiguration, nil
+}
+
+// createTaints creates the node taints for a workload pool.  Taints are
+// checked here so we don't rely on kubeadm to fail the node join.
+func createTaints(in *generated.KubernetesClusterTaints) ([]unikornv1.Taint, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	taints := make([]unikornv1.Taint, len(*in))
+
+	for i, taint := range *in {
+		if errs := validation.IsQualifiedName(taint.Key); len(errs) != 0 {
+			return nil, errors.OAuth2InvalidRequest(fmt.Sprintf("invalid taint key %s: %s", taint.Key, strings.Join(errs, ", ")))
+		}
+
+		switch effect := unikornv1.TaintEffect(taint.Effect); effect {
+		case unikornv1.TaintEffectNoSchedule, unikornv1.TaintEffectPreferNoSchedule, unikornv1.TaintEffectNoExecute:
+			taints[i].Effect = effect
+		default:
+			return nil, errors.OAuth2InvalidRequest("unsupported taint effect")
+		}
+
+		taints[i].Key = taint.Key
+
+		if taint.Value != nil {
+			if errs := validation.IsValidLabelValue(*taint.Value); len(errs) != 0 {
+				return nil, errors.OAuth2InvalidRequest(fmt.Sprintf("invalid taint value %s: %s", *taint.Value, strings.Join(errs, ", ")))
+			}
+
+			taints[i].Value = *taint.Value
+		}
+
+		// Kubernetes identifies taints by key and effect.
+		for j := 0; j < i; j++ {
+			if taints[j].Key == taints[i].Key && taints[j].Effect == taints[i].Effect {
+				return nil, errors.OAuth2InvalidRequest(fmt.Sprintf("duplicate taint %s:%s", taint.Key, taint.Effect))
+			}
+		}
+	}
+
+	return taints, nil
+}
+
+// maximumKubeadmCommandsSize limits the combined size of a workload pool's
+// kubeadm commands.  These are delivered in cloud-init user data, which
+// OpenStack limits to 64KiB once base64 encoded, and that also needs to
+// accommodate the kubeadm configuration and any files.
+const maximumKubeadmCommandsSize = 16 * 1024
+
+// createKubeadmCommands creates the pre and post kubeadm commands for a workload
+// pool, ensuring they are well formed and won't exceed the user data limits.
+func createKubeadmCommands(pool *generated.KubernetesClusterWorkloadPool) ([]string, []string, error) {
+	var pre, post []string
+
+	if pool.PreKubeadmCommands != nil {
+		pre = *pool.PreKubeadmCommands
+	}
+
+	if pool.PostKubeadmCommands != nil {
+		post = *pool.PostKubeadmCommands
+	}
+
+	var size int
+
+	for _, commands := range [][]string{pre, post} {
+		for _, command := range commands {
+			if strings.TrimSpace(command) == "" {
+				return nil, nil, errors.OAuth2InvalidRequest("kubeadm commands must not be empty")
+			}
+
+			size += len(command)
+		}
+	}
+
+	if size > maximumKubeadmCommandsSize {
+		return nil, nil, errors.OAuth2InvalidRequest(fmt.Sprintf("kubeadm commands must not exceed %d bytes", maximumKubeadmCommandsSize))
+	}
+
+	return pre, post, nil
+}
+
+// imageReferencePattern matches a container image reference, as defined by the
+// distribution project, with an optional domain, tag and digest.  Images are
+// pulled by a shell command on the node, so this needs to be strict.
+//
+//nolint:gochecknoglobals
+var imageReferencePattern = regexp.MustCompile(`^` +
+	`(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?)*(?::[0-9]+)?/)?` +
+	`[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*` +
+	`(?::[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127})?` +
+	`(?:@[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,})?` +
+	`$`)
+
+// createPrePullImages creates the set of images to pull when a workload pool's
+// nodes are created.
+func createPrePullImages(in *[]string) ([]string, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	for _, image := range *in {
+		if !imageReferencePattern.MatchString(image) {
+			return nil, errors.OAuth2InvalidRequest(fmt.Sprintf("invalid pre-pull image %q", image))
+		}
+	}
+
+	return *in, nil
+}
+
 // createControlPlane creates the control plane part of a cluster.
-func (c *Client) createControlPlane(options *generated.KubernetesCluster) (*unikornv1.KubernetesClusterControlPlaneSpec, error) {
-	machine, _, err := c.createMachineGeneric(&options.ControlPlane)
+func (c *Client) createControlPlane(ctx context.Context, identity *providers.Identity, options *generated.KubernetesCluster) (*unikornv1.KubernetesClusterControlPlaneSpec, error) {
+	machine, _, err := c.createMachineGeneric(ctx, identity, &options.ControlPlane)
 	if err != nil {
 		return nil, err
 	}
@@ -372,18 +899,18 @@ func (c *Client) createControlPlane(options *generated.KubernetesCluster) (*unik
 }
 
 // createWorkloadPools creates the workload pools part of a cluster.
-func (c *Client) createWorkloadPools(clusterContext *createClusterContext, options *generated.KubernetesCluster) (*unikornv1.KubernetesClusterWorkloadPoolsSpec, error) {
+func (c *Client) createWorkloadPools(ctx context.Context, identity *providers.Identity, clusterContext *createClusterContext, options *generated.KubernetesCluster) (*unikornv1.KubernetesClusterWorkloadPoolsSpec, error) {
 	workloadPools := &unikornv1.KubernetesClusterWorkloadPoolsSpec{}
 
 	for i := range options.WorkloadPools {
 		pool := &options.WorkloadPools[i]
 
-		machine, flavor, err := c.createMachineGeneric(&pool.Machine)
+		machine, flavor, err := c.createMachineGeneric(ctx, identity, &pool.Machine)
 		if err != nil {
 			return nil, err
 		}
 
-		if flavor.Gpus != nil {
+		if flavor.GPU != nil {
 			clusterContext.hasGPUWorkloadPool = true
 		}
 
@@ -399,6 +926,48 @@ func (c *Client) createWorkloadPools(clusterContext *createClusterContext, optio
 			workloadPool.Labels = *pool.Labels
 		}
 
+		taints, err := createTaints(pool.Taints)
+		if err != nil {
+			return nil, err
+		}
+
+		workloadPool.Taints = taints
+
+		preKubeadmCommands, postKubeadmCommands, err := createKubeadmCommands(pool)
+		if err != nil {
+			return nil, err
+		}
+
+		workloadPool.PreKubeadmCommands = preKubeadmCommands
+		workloadPool.PostKubeadmCommands = postKubeadmCommands
+
+		prePullImages, err := createPrePullImages(pool.PrePullImages)
+		if err != nil {
+			return nil, err
+		}
+
+		workloadPool.PrePullImages = prePullImages
+
+		nodeConfiguration, err := createNodeConfiguration(pool.NodeConfiguration)
+		if err != nil {
+			return nil, err
+		}
+
+		workloadPool.NodeConfiguration = nodeConfiguration
+
+		// An empty key name means no key, so skip validation in that case.
+		if pool.SshKeyName != nil && *pool.SshKeyName != "" {
+			if _, err := c.provider.GetKeyPair(ctx, identity, *pool.SshKeyName); err != nil {
+				if errors.IsHTTPNotFound(err) {
+					return nil, errors.OAuth2InvalidRequest("invalid workload pool ssh key name").WithError(err)
+				}
+
+				return nil, err
+			}
+		}
+
+		workloadPool.SSHKeyName = pool.SshKeyName
+
 		// With autoscaling, we automatically fill in the required metadata from
 		// the flavor used in validation, this prevents having to surface this
 		// complexity to the client via the API.
@@ -411,18 +980,19 @@ func (c *Client) createWorkloadPools(clusterContext *createClusterContext, optio
 			workloadPool.Autoscaling = &unikornv1.MachineGenericAutoscaling{
 				MinimumReplicas: &pool.Autoscaling.MinimumReplicas,
 				MaximumReplicas: &pool.Autoscaling.MaximumReplicas,
+				WarmMachines:    pool.Autoscaling.WarmMachines,
 				Scheduler: &unikornv1.MachineGenericAutoscalingScheduler{
-					CPU:    &flavor.Cpus,
+					CPU:    &flavor.CPUs,
 					Memory: &memory,
 				},
 			}
 
-			if flavor.Gpus != nil {
+			if flavor.GPU != nil {
 				t := constants.NvidiaGPUType
 
 				workloadPool.Autoscaling.Scheduler.GPU = &unikornv1.MachineGenericAutoscalingSchedulerGPU{
 					Type:  &t,
-					Count: flavor.Gpus,
+					Count: &flavor.GPU.Count,
 				}
 			}
 		}
@@ -433,23 +1003,136 @@ func (c *Client) createWorkloadPools(clusterContext *createClusterContext, optio
 	return workloadPools, nil
 }
 
+// createDuration parses an optional duration.
+func createDuration(in *string) (*metav1.Duration, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	duration, err := time.ParseDuration(*in)
+	if err != nil {
+		return nil, errors.OAuth2InvalidRequest("failed to parse duration").WithError(err)
+	}
+
+	if duration < 0 {
+		return nil, errors.OAuth2InvalidRequest("duration must not be negative")
+	}
+
+	return &metav1.Duration{Duration: duration}, nil
+}
+
+// createAutoscalingConfiguration creates the cluster autoscaler tuning.
+func createAutoscalingConfiguration(in *generated.KubernetesClusterAutoscalingConfiguration) (*unikornv1.ClusterAutoscalerSpec, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	scaleDownDelayAfterAdd, err := createDuration(in.ScaleDownDelayAfterAdd)
+	if err != nil {
+		return nil, err
+	}
+
+	scaleDownUnneededTime, err := createDuration(in.ScaleDownUnneededTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.ScaleDownUtilizationThreshold != nil && (*in.ScaleDownUtilizationThreshold < 1 || *in.ScaleDownUtilizationThreshold > 100) {
+		return nil, errors.OAuth2InvalidRequest("scale down utilization threshold must be between 1 and 100")
+	}
+
+	out := &unikornv1.ClusterAutoscalerSpec{
+		ScaleDownDelayAfterAdd:        scaleDownDelayAfterAdd,
+		ScaleDownUnneededTime:         scaleDownUnneededTime,
+		ScaleDownUtilizationThreshold: in.ScaleDownUtilizationThreshold,
+	}
+
+	if in.Expander != nil {
+		switch expander := unikornv1.ClusterAutoscalerExpander(*in.Expander); expander {
+		case unikornv1.ClusterAutoscalerExpanderRandom, unikornv1.ClusterAutoscalerExpanderMostPods, unikornv1.ClusterAutoscalerExpanderLeastWaste, unikornv1.ClusterAutoscalerExpanderLeastNodes:
+			out.Expander = &expander
+		default:
+			return nil, errors.OAuth2InvalidRequest("unsupported autoscaler expander")
+		}
+	}
+
+	return out, nil
+}
+
+// createBackupConfiguration creates the backup schedule.
+func createBackupConfiguration(in *generated.KubernetesClusterBackupConfiguration) (*unikornv1.BackupSpec, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	// Velero accepts standard 5 field cron expressions, anything else will be
+	// rejected when the schedule is created, long after the request has returned.
+	if in.Schedule != nil && len(strings.Fields(*in.Schedule)) != 5 {
+		return nil, errors.OAuth2InvalidRequest("backup schedule must be a 5 field cron expression")
+	}
+
+	retention, err := createDuration(in.Retention)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &unikornv1.BackupSpec{
+		Schedule:  in.Schedule,
+		Retention: retention,
+	}
+
+	return out, nil
+}
+
 // createFeatures creates the features part of a cluster.
-func createFeatures(options *generated.KubernetesCluster) *unikornv1.KubernetesClusterFeaturesSpec {
+func createFeatures(options *generated.KubernetesCluster) (*unikornv1.KubernetesClusterFeaturesSpec, error) {
 	if options.Features == nil {
-		return nil
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	autoscalingConfiguration, err := createAutoscalingConfiguration(options.Features.AutoscalingConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	if autoscalingConfiguration != nil && (options.Features.Autoscaling == nil || !*options.Features.Autoscaling) {
+		return nil, errors.OAuth2InvalidRequest("autoscaling configuration requires autoscaling to be enabled")
+	}
+
+	backupConfiguration, err := createBackupConfiguration(options.Features.BackupConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	if backupConfiguration != nil && (options.Features.Backup == nil || !*options.Features.Backup) {
+		return nil, errors.OAuth2InvalidRequest("backup configuration requires backup to be enabled")
+	}
+
+	if options.Features.MetricsFederation != nil && *options.Features.MetricsFederation && (options.Features.Prometheus == nil || !*options.Features.Prometheus) {
+		return nil, errors.OAuth2InvalidRequest("metrics federation requires prometheus to be enabled")
 	}
 
 	features := &unikornv1.KubernetesClusterFeaturesSpec{
-		Autoscaling:         options.Features.Autoscaling,
-		Ingress:             options.Features.Ingress,
-		CertManager:         options.Features.CertManager,
-		KubernetesDashboard: options.Features.KubernetesDashboard,
-		FileStorage:         options.Features.FileStorage,
-		Prometheus:          options.Features.Prometheus,
-		NvidiaOperator:      options.Features.NvidiaOperator,
+		Autoscaling:              options.Features.Autoscaling,
+		AutoscalingConfiguration: autoscalingConfiguration,
+		Ingress:                  options.Features.Ingress,
+		CertManager:              options.Features.CertManager,
+		KubernetesDashboard:      options.Features.KubernetesDashboard,
+		FileStorage:              options.Features.FileStorage,
+		Prometheus:               options.Features.Prometheus,
+		MetricsFederation:        options.Features.MetricsFederation,
+		NvidiaOperator:           options.Features.NvidiaOperator,
+		NodeFirewall:             options.Features.NodeFirewall,
+		Backup:                   options.Features.Backup,
+		BackupConfiguration:      backupConfiguration,
+		CostAllocation:           options.Features.CostAllocation,
 	}
 
-	return features
+	return features, nil
 }
 
 type createClusterContext struct {
@@ -461,7 +1144,7 @@ func installNvidiaOperator(features *unikornv1.KubernetesClusterFeaturesSpec) bo
 }
 
 // createCluster creates the full cluster custom resource.
-func (c *Client) createCluster(controlPlane *controlplane.Meta, options *generated.KubernetesCluster) (*unikornv1.KubernetesCluster, error) {
+func (c *Client) createCluster(ctx context.Context, identity *providers.Identity, controlPlane *controlplane.Meta, options *generated.KubernetesCluster) (*unikornv1.KubernetesCluster, error) {
 	var clusterContext createClusterContext
 
 	network, err := createNetwork(options)
@@ -474,12 +1157,22 @@ func (c *Client) createCluster(controlPlane *controlplane.Meta, options *generat
 		return nil, err
 	}
 
-	kubernetesControlPlane, err := c.createControlPlane(options)
+	kubernetesControlPlane, err := c.createControlPlane(ctx, identity, options)
+	if err != nil {
+		return nil, err
+	}
+
+	kubernetesWorkloadPools, err := c.createWorkloadPools(ctx, identity, &clusterContext, options)
+	if err != nil {
+		return nil, err
+	}
+
+	autoUpgrade, err := common.CreateApplicationBundleAutoUpgrade(options.ApplicationBundleAutoUpgrade)
 	if err != nil {
 		return nil, err
 	}
 
-	kubernetesWorkloadPools, err := c.createWorkloadPools(&clusterContext, options)
+	features, err := createFeatures(options)
 	if err != nil {
 		return nil, err
 	}
@@ -496,13 +1189,13 @@ func (c *Client) createCluster(controlPlane *controlplane.Meta, options *generat
 		},
 		Spec: unikornv1.KubernetesClusterSpec{
 			ApplicationBundle:            &options.ApplicationBundle.Name,
-			ApplicationBundleAutoUpgrade: common.CreateApplicationBundleAutoUpgrade(options.ApplicationBundleAutoUpgrade),
+			ApplicationBundleAutoUpgrade: autoUpgrade,
 			Openstack:                    createOpenstack(options),
 			Network:                      network,
 			API:                          api,
 			ControlPlane:                 kubernetesControlPlane,
 			WorkloadPools:                kubernetesWorkloadPools,
-			Features:                     createFeatures(options),
+			Features:                     features,
 		},
 	}
 
@@ -516,5 +1209,42 @@ func (c *Client) createCluster(controlPlane *controlplane.Meta, options *generat
 		cluster.Spec.Features.NvidiaOperator = &clusterContext.hasGPUWorkloadPool
 	}
 
+	if err := c.validateApplicationBundle(ctx, cluster); err != nil {
+		return nil, err
+	}
+
 	return cluster, nil
 }
+
+// validateApplicationBundle checks the requested application bundle exists,
+// that all Kubernetes versions are supported by it, and that it provides the
+// requested network plugin.
+func (c *Client) validateApplicationBundle(ctx context.Context, cluster *unikornv1.KubernetesCluster) error {
+	bundle := &unikornv1.KubernetesClusterApplicationBundle{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Name: *cluster.Spec.ApplicationBundle}, bundle); err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.OAuth2InvalidRequest("invalid application bundle").WithError(err)
+		}
+
+		return errors.OAuth2ServerError("failed to get application bundle").WithError(err)
+	}
+
+	if err := bundle.Spec.ValidateKubernetesVersion(*cluster.Spec.ControlPlane.Version); err != nil {
+		return errors.OAuth2InvalidRequest("control plane version unsupported by application bundle").WithError(err)
+	}
+
+	for _, pool := range cluster.Spec.WorkloadPools.Pools {
+		if err := bundle.Spec.ValidateKubernetesVersion(*pool.Version); err != nil {
+			return errors.OAuth2InvalidRequest(fmt.Sprintf("workload pool %s version unsupported by application bundle", pool.Name)).WithError(err)
+		}
+	}
+
+	if plugin := cluster.NetworkPlugin(); plugin != unikornv1.NetworkPluginNone {
+		if _, err := bundle.Spec.GetApplication(string(plugin)); err != nil {
+			return errors.OAuth2InvalidRequest(fmt.Sprintf("network plugin %s unsupported by application bundle", plugin)).WithError(err)
+		}
+	}
+
+	return nil
+}
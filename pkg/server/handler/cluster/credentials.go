@@ -0,0 +1,388 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/gophercloud/utils/openstack/clientconfig"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/common"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// applicationCredentialDescription is used to identify credentials that are
+	// managed by the platform.
+	applicationCredentialDescription = "Automatically generated by platform service [DO NOT DELETE]."
+
+	// applicationCredentialOwnerTag precedes the owning cluster in a credential's
+	// description.
+	applicationCredentialOwnerTag = " cluster="
+
+	// applicationCredentialSuffixLength is the number of random bytes appended
+	// to credential names, this allows a new credential to be created before
+	// the old one is deleted during rotation.
+	applicationCredentialSuffixLength = 4
+
+	// applicationCredentialCreateAttempts is the number of times we will try to
+	// create a credential, with a new name each time, when the name clashes with
+	// an existing one.
+	applicationCredentialCreateAttempts = 3
+)
+
+// applicationCredentialOwner uniquely identifies the cluster a credential
+// belongs to.
+type applicationCredentialOwner struct {
+	project      string
+	controlPlane string
+	cluster      string
+}
+
+// String renders the owner as a tag.
+func (o *applicationCredentialOwner) String() string {
+	return o.project + "/" + o.controlPlane + "/" + o.cluster
+}
+
+// newApplicationCredentialOwner returns the owner of a cluster's credentials.
+func newApplicationCredentialOwner(controlPlane *controlplane.Meta, name string) *applicationCredentialOwner {
+	return &applicationCredentialOwner{
+		project:      controlPlane.Project.Name,
+		controlPlane: controlPlane.Name,
+		cluster:      name,
+	}
+}
+
+// parseApplicationCredentialOwner returns the cluster that owns a credential, if
+// the credential is managed by the platform.
+func parseApplicationCredentialOwner(credential *providers.Credential) (*applicationCredentialOwner, bool) {
+	tag, ok := strings.CutPrefix(credential.Description, applicationCredentialDescription+applicationCredentialOwnerTag)
+	if !ok {
+		return nil, false
+	}
+
+	parts := strings.Split(tag, "/")
+	if len(parts) != 3 {
+		return nil, false
+	}
+
+	owner := &applicationCredentialOwner{
+		project:      parts[0],
+		controlPlane: parts[1],
+		cluster:      parts[2],
+	}
+
+	return owner, true
+}
+
+// ApplicationCredentialID returns the ID of the credential a cluster is
+// currently configured to use.
+func ApplicationCredentialID(cluster *unikornv1.KubernetesCluster) (string, error) {
+	if cluster.Spec.Openstack.Cloud == nil || cluster.Spec.Openstack.CloudConfig == nil {
+		return "", nil
+	}
+
+	clientConfig := &clientconfig.Clouds{}
+
+	if err := yaml.Unmarshal(*cluster.Spec.Openstack.CloudConfig, clientConfig); err != nil {
+		return "", errors.OAuth2ServerError("unable to parse cloud config").WithError(err)
+	}
+
+	cloud, ok := clientConfig.Clouds[*cluster.Spec.Openstack.Cloud]
+	if !ok || cloud.AuthInfo == nil {
+		return "", nil
+	}
+
+	return cloud.AuthInfo.ApplicationCredentialID, nil
+}
+
+// applicationCredentialName generates a unique name for a cluster's credential.
+func applicationCredentialName(controlPlane *controlplane.Meta, name string) (string, error) {
+	suffix := make([]byte, applicationCredentialSuffixLength)
+
+	if _, err := rand.Read(suffix); err != nil {
+		return "", errors.OAuth2ServerError("unable to generate application credential name").WithError(err)
+	}
+
+	// Name is fully qualified to avoid namespace clashes with control planes sharing
+	// the same project.
+	return controlPlane.Name + "-" + name + "-" + hex.EncodeToString(suffix), nil
+}
+
+// createApplicationCredential creates an application credential for the cluster.
+// As the platform owns the name, if it clashes with an existing credential we
+// can just pick another one and try again.
+func (c *Client) createApplicationCredential(ctx context.Context, identity *providers.Identity, controlPlane *controlplane.Meta, name string) (*providers.Credential, error) {
+	description := applicationCredentialDescription + applicationCredentialOwnerTag + newApplicationCredentialOwner(controlPlane, name).String()
+
+	var err error
+
+	for i := 0; i < applicationCredentialCreateAttempts; i++ {
+		var credentialName string
+
+		if credentialName, err = applicationCredentialName(controlPlane, name); err != nil {
+			return nil, err
+		}
+
+		var ac *providers.Credential
+
+		ac, err = c.provider.CreateCredential(ctx, identity, credentialName, description)
+		if err == nil {
+			return ac, nil
+		}
+
+		if !errors.IsHTTPConflict(err) {
+			return nil, err
+		}
+	}
+
+	return nil, err
+}
+
+// createClientConfig creates an application credential for the cluster, tagged
+// with the owning cluster so it can be garbage collected, and returns an Openstack
+// client configuration that uses it.
+func (c *Client) createClientConfig(ctx context.Context, identity *providers.Identity, controlPlane *controlplane.Meta, name string) ([]byte, string, string, error) {
+	ac, err := c.createApplicationCredential(ctx, identity, controlPlane, name)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	cloud := "cloud"
+
+	clientConfig := &clientconfig.Clouds{
+		Clouds: map[string]clientconfig.Cloud{
+			cloud: {
+				AuthType: clientconfig.AuthV3ApplicationCredential,
+				AuthInfo: &clientconfig.AuthInfo{
+					AuthURL:                     c.authenticator.Keystone.Endpoint(),
+					ApplicationCredentialID:     ac.ID,
+					ApplicationCredentialSecret: ac.Secret,
+				},
+			},
+		},
+	}
+
+	clientConfigYAML, err := yaml.Marshal(clientConfig)
+	if err != nil {
+		// Don't leak the credential, it's useless without the secret.
+		_ = c.provider.DeleteCredential(ctx, identity, ac.ID)
+
+		return nil, "", "", errors.OAuth2ServerError("unable to create cloud config").WithError(err)
+	}
+
+	return clientConfigYAML, cloud, ac.ID, nil
+}
+
+// isOrphanedApplicationCredential returns true if the cluster that owns the credential
+// no longer exists, or no longer uses it.
+func (c *Client) isOrphanedApplicationCredential(ctx context.Context, controlPlane *controlplane.Meta, owner *applicationCredentialOwner, id string) (bool, error) {
+	cluster := &unikornv1.KubernetesCluster{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: controlPlane.Namespace, Name: owner.cluster}, cluster); err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, errors.OAuth2ServerError("unable to get cluster").WithError(err)
+	}
+
+	current, err := ApplicationCredentialID(cluster)
+	if err != nil {
+		return false, err
+	}
+
+	return current != id, nil
+}
+
+// collectApplicationCredentials garbage collects any of the user's credentials that
+// belong to the control plane, but are no longer used e.g. cluster creation failed,
+// the cluster has been deleted, or the credential has been rotated.  Credentials
+// are owned by the user that created them, so this is done by the server on their
+// behalf, and can only ever see that user's credentials.
+func (c *Client) collectApplicationCredentials(ctx context.Context, identity *providers.Identity, controlPlane *controlplane.Meta) error {
+	credentials, err := c.provider.ListCredentials(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	for i := range credentials {
+		credential := &credentials[i]
+
+		owner, ok := parseApplicationCredentialOwner(credential)
+		if !ok || owner.project != controlPlane.Project.Name || owner.controlPlane != controlPlane.Name {
+			continue
+		}
+
+		orphaned, err := c.isOrphanedApplicationCredential(ctx, controlPlane, owner, credential.ID)
+		if err != nil {
+			return err
+		}
+
+		if !orphaned {
+			continue
+		}
+
+		if err := c.provider.DeleteCredential(ctx, identity, credential.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RevokeProjectCredentials deletes all of the user's credentials that were
+// created for clusters in the project, regardless of whether they are still
+// in use.  This is used when offboarding, after all clusters have been deleted.
+func (c *Client) RevokeProjectCredentials(ctx context.Context, projectName string) error {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	credentials, err := c.provider.ListCredentials(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	for i := range credentials {
+		credential := &credentials[i]
+
+		owner, ok := parseApplicationCredentialOwner(credential)
+		if !ok || owner.project != projectName {
+			continue
+		}
+
+		if err := c.provider.DeleteCredential(ctx, identity, credential.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReissueCredentials replaces the credentials of all clusters managed by the
+// control plane with ones owned by its current project, then revokes any owned
+// by the previous project.  This is used when a control plane is moved between
+// projects, and may be safely repeated if it fails part way through.
+func (c *Client) ReissueCredentials(ctx context.Context, controlPlane *controlplane.Meta, previous string) error {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	resources := &unikornv1.KubernetesClusterList{}
+
+	if err := c.client.List(ctx, resources, &client.ListOptions{Namespace: controlPlane.Namespace}); err != nil {
+		return errors.OAuth2ServerError("failed to list clusters").WithError(err)
+	}
+
+	for i := range resources.Items {
+		resource := &resources.Items[i]
+
+		clientConfig, cloud, id, err := c.createClientConfig(ctx, identity, controlPlane, resource.Name)
+		if err != nil {
+			return err
+		}
+
+		temp := resource.DeepCopy()
+		temp.Spec.Openstack.Cloud = &cloud
+		temp.Spec.Openstack.CloudConfig = &clientConfig
+
+		if err := c.client.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
+			_ = c.provider.DeleteCredential(ctx, identity, id)
+
+			return errors.OAuth2ServerError("failed to patch cluster").WithError(err)
+		}
+	}
+
+	credentials, err := c.provider.ListCredentials(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	for i := range credentials {
+		credential := &credentials[i]
+
+		owner, ok := parseApplicationCredentialOwner(credential)
+		if !ok || owner.project != previous || owner.controlPlane != controlPlane.Name {
+			continue
+		}
+
+		if err := c.provider.DeleteCredential(ctx, identity, credential.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RotateCredentials replaces the cluster's application credential with a new one.
+// The new credential is created and installed before the old one is deleted.
+func (c *Client) RotateCredentials(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) error {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return err
+	}
+
+	if controlPlane.Deleting {
+		return errors.OAuth2InvalidRequest("control plane is being deleted")
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if resource.DeletionTimestamp != nil {
+		return errors.OAuth2InvalidRequest("cluster is being deleted")
+	}
+
+	clientConfig, cloud, id, err := c.createClientConfig(ctx, identity, controlPlane, name)
+	if err != nil {
+		return err
+	}
+
+	temp := resource.DeepCopy()
+	temp.Spec.Openstack.Cloud = &cloud
+	temp.Spec.Openstack.CloudConfig = &clientConfig
+
+	if err := c.client.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
+		_ = c.provider.DeleteCredential(ctx, identity, id)
+
+		return errors.OAuth2ServerError("failed to patch cluster").WithError(err)
+	}
+
+	return c.collectApplicationCredentials(ctx, identity, controlPlane)
+}
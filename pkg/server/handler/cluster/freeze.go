@@ -0,0 +1,93 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// convertUpgradeFreeze converts from a custom resource into the API definition.
+func convertUpgradeFreeze(in *unikornv1.UpgradeFreezeSpec) *generated.UpgradeFreeze {
+	if in == nil {
+		return nil
+	}
+
+	return &generated.UpgradeFreeze{
+		Expiry: in.Until.Time,
+		Reason: in.Reason,
+	}
+}
+
+// setUpgradeFreeze sets or clears the cluster's upgrade freeze.
+func (c *Client) setUpgradeFreeze(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, freeze *unikornv1.UpgradeFreezeSpec) error {
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return err
+	}
+
+	if controlPlane.Deleting {
+		return errors.OAuth2InvalidRequest("control plane is being deleted")
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if resource.DeletionTimestamp != nil {
+		return errors.OAuth2InvalidRequest("cluster is being deleted")
+	}
+
+	temp := resource.DeepCopy()
+	temp.Spec.UpgradeFreeze = freeze
+
+	if err := c.client.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
+		return errors.OAuth2ServerError("failed to patch cluster").WithError(err)
+	}
+
+	return nil
+}
+
+// FreezeUpgrades inhibits automatic upgrades of the cluster until the freeze
+// expires, replacing any existing freeze.
+func (c *Client) FreezeUpgrades(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, request *generated.UpgradeFreeze) error {
+	if !request.Expiry.After(time.Now()) {
+		return errors.OAuth2InvalidRequest("upgrade freeze expiry must be in the future")
+	}
+
+	freeze := &unikornv1.UpgradeFreezeSpec{
+		Until:  metav1.NewTime(request.Expiry),
+		Reason: request.Reason,
+	}
+
+	return c.setUpgradeFreeze(ctx, controlPlaneName, name, freeze)
+}
+
+// ThawUpgrades lifts any upgrade freeze on the cluster.
+func (c *Client) ThawUpgrades(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) error {
+	return c.setUpgradeFreeze(ctx, controlPlaneName, name, nil)
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+
+	"github.com/eschercloudai/unikorn-core/pkg/constants"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// healthTimeout bounds how long we wait for the cluster to respond, health
+	// checks are typically performed when a cluster is broken.
+	healthTimeout = 10 * time.Second
+
+	// argoCDNamespace is where continuous delivery applications live.
+	argoCDNamespace = "argocd"
+)
+
+// convertAddOnHealthStatus converts from an Argo CD health status into the API.
+func convertAddOnHealthStatus(in string) generated.KubernetesClusterAddOnHealthStatus {
+	switch in {
+	case "Healthy":
+		return generated.Healthy
+	case "Progressing":
+		return generated.Progressing
+	case "Degraded":
+		return generated.Degraded
+	case "Suspended":
+		return generated.Suspended
+	case "Missing":
+		return generated.Missing
+	}
+
+	return generated.Unknown
+}
+
+// addOnHealth reports the health of all applications provisioned for the cluster
+// by continuous delivery.
+func (c *Client) addOnHealth(ctx context.Context, cluster *unikornv1.KubernetesCluster) (generated.KubernetesClusterAddOnsHealth, error) {
+	resourceLabels, err := cluster.ResourceLabels()
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to get cluster labels").WithError(err)
+	}
+
+	applications := &unstructured.UnstructuredList{}
+	applications.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "argoproj.io",
+		Version: "v1alpha1",
+		Kind:    "ApplicationList",
+	})
+
+	options := &client.ListOptions{
+		Namespace:     argoCDNamespace,
+		LabelSelector: labels.SelectorFromSet(resourceLabels),
+	}
+
+	if err := c.client.List(ctx, applications, options); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list cluster applications").WithError(err)
+	}
+
+	out := make(generated.KubernetesClusterAddOnsHealth, len(applications.Items))
+
+	for i := range applications.Items {
+		application := &applications.Items[i]
+
+		status, _, _ := unstructured.NestedString(application.Object, "status", "health", "status")
+
+		out[i] = generated.KubernetesClusterAddOnHealth{
+			Name:   application.GetLabels()[constants.ApplicationLabel],
+			Status: convertAddOnHealthStatus(status),
+		}
+	}
+
+	slices.SortStableFunc(out, func(a, b generated.KubernetesClusterAddOnHealth) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return out, nil
+}
+
+// apiServerReady returns true if the API server can be contacted and reports that
+// it's ready to serve requests.
+func apiServerReady(ctx context.Context, config *rest.Config) bool {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		log.FromContext(ctx).Info("failed to create cluster client", "error", err)
+
+		return false
+	}
+
+	if _, err := clientset.Discovery().RESTClient().Get().AbsPath("/readyz").DoRaw(ctx); err != nil {
+		log.FromContext(ctx).Info("cluster api server not ready", "error", err)
+
+		return false
+	}
+
+	return true
+}
+
+// nodeReady returns true if the node is ready to accept workloads.
+func nodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// addClusterHealth adds health information read directly from the cluster.
+// Certificate signing requests are pending until they are approved or denied,
+// at which point they gain a condition.
+func addClusterHealth(ctx context.Context, c client.Client, health *generated.KubernetesClusterHealth) error {
+	nodes := &corev1.NodeList{}
+
+	if err := c.List(ctx, nodes); err != nil {
+		return errors.OAuth2ServerError("failed to list cluster nodes").WithError(err)
+	}
+
+	csrs := &certificatesv1.CertificateSigningRequestList{}
+
+	if err := c.List(ctx, csrs); err != nil {
+		return errors.OAuth2ServerError("failed to list cluster certificate signing requests").WithError(err)
+	}
+
+	nodeHealth := &generated.KubernetesClusterNodeHealth{
+		Total: len(nodes.Items),
+	}
+
+	for i := range nodes.Items {
+		if nodeReady(&nodes.Items[i]) {
+			nodeHealth.Ready++
+		}
+	}
+
+	var pending int
+
+	for i := range csrs.Items {
+		if len(csrs.Items[i].Status.Conditions) == 0 {
+			pending++
+		}
+	}
+
+	health.Nodes = nodeHealth
+	health.PendingCertificateSigningRequests = &pending
+
+	return nil
+}
+
+// GetHealth returns a structured summary of cluster health.  Failure to contact
+// the cluster is reported in the summary, rather than as an error, as that's
+// exactly the kind of thing this is used to triage.
+func (c *Client) GetHealth(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) (*generated.KubernetesClusterHealth, error) {
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	addOns, err := c.addOnHealth(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &generated.KubernetesClusterHealth{
+		AddOns: addOns,
+	}
+
+	config, err := c.clusterRESTConfig(ctx, controlPlaneName, name, healthTimeout)
+	if err != nil {
+		log.FromContext(ctx).Info("cluster configuration unavailable", "error", err)
+
+		return health, nil
+	}
+
+	if !apiServerReady(ctx, config) {
+		return health, nil
+	}
+
+	health.ApiServer.Reachable = true
+
+	clusterClient, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to create cluster client").WithError(err)
+	}
+
+	if err := addClusterHealth(ctx, clusterClient, health); err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
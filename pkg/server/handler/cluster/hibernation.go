@@ -0,0 +1,155 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// hibernatedPool records the parts of a workload pool's topology that are modified
+// when a cluster is hibernated.
+type hibernatedPool struct {
+	// Replicas is the pool's size.
+	Replicas *int `json:"replicas,omitempty"`
+	// Autoscaling is the pool's autoscaling configuration, this is removed
+	// when hibernated to prevent the pool being scaled back up.
+	Autoscaling *unikornv1.MachineGenericAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// hibernatedTopology maps from workload pool name to its topology.
+type hibernatedTopology map[string]hibernatedPool
+
+// getHibernationCandidate returns a cluster that can be hibernated or resumed.
+func (c *Client) getHibernationCandidate(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) (*unikornv1.KubernetesCluster, error) {
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	if controlPlane.Deleting {
+		return nil, errors.OAuth2InvalidRequest("control plane is being deleted")
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource.DeletionTimestamp != nil {
+		return nil, errors.OAuth2InvalidRequest("cluster is being deleted")
+	}
+
+	return resource, nil
+}
+
+// Hibernate scales all workload pools to zero, recording their topology so they
+// can be restored on resumption.  The control plane is left alone as cluster API
+// doesn't allow it to be scaled to zero.
+func (c *Client) Hibernate(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) error {
+	resource, err := c.getHibernationCandidate(ctx, controlPlaneName, name)
+	if err != nil {
+		return err
+	}
+
+	if resource.Hibernated() {
+		return errors.HTTPConflict()
+	}
+
+	temp := resource.DeepCopy()
+
+	topology := hibernatedTopology{}
+
+	for i := range temp.Spec.WorkloadPools.Pools {
+		pool := &temp.Spec.WorkloadPools.Pools[i]
+
+		topology[pool.Name] = hibernatedPool{
+			Replicas:    pool.Replicas,
+			Autoscaling: pool.Autoscaling,
+		}
+
+		replicas := 0
+
+		pool.Replicas = &replicas
+		pool.Autoscaling = nil
+	}
+
+	data, err := json.Marshal(topology)
+	if err != nil {
+		return errors.OAuth2ServerError("failed to marshal cluster topology").WithError(err)
+	}
+
+	if temp.Annotations == nil {
+		temp.Annotations = map[string]string{}
+	}
+
+	temp.Annotations[constants.HibernationAnnotation] = string(data)
+
+	if err := c.client.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
+		return errors.OAuth2ServerError("failed to patch cluster").WithError(err)
+	}
+
+	return nil
+}
+
+// Resume restores a hibernated cluster's workload pools to their previous topology.
+func (c *Client) Resume(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) error {
+	resource, err := c.getHibernationCandidate(ctx, controlPlaneName, name)
+	if err != nil {
+		return err
+	}
+
+	if !resource.Hibernated() {
+		return errors.HTTPConflict()
+	}
+
+	topology := hibernatedTopology{}
+
+	if err := json.Unmarshal([]byte(resource.Annotations[constants.HibernationAnnotation]), &topology); err != nil {
+		return errors.OAuth2ServerError("failed to unmarshal cluster topology").WithError(err)
+	}
+
+	temp := resource.DeepCopy()
+
+	for i := range temp.Spec.WorkloadPools.Pools {
+		pool := &temp.Spec.WorkloadPools.Pools[i]
+
+		saved, ok := topology[pool.Name]
+		if !ok {
+			continue
+		}
+
+		pool.Replicas = saved.Replicas
+		pool.Autoscaling = saved.Autoscaling
+	}
+
+	delete(temp.Annotations, constants.HibernationAnnotation)
+
+	if err := c.client.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
+		return errors.OAuth2ServerError("failed to patch cluster").WithError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// metricsTimeout bounds how long we wait for the cluster to respond, a
+	// broken cluster shouldn't hold up the UI.
+	metricsTimeout = 10 * time.Second
+)
+
+// MetricsOptions allow metrics summaries to be configured.
+type MetricsOptions struct {
+	// CacheTTL is how long a metrics summary is cached for.
+	CacheTTL time.Duration
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *MetricsOptions) AddFlags(f *pflag.FlagSet) {
+	f.DurationVar(&o.CacheTTL, "metrics-summary-cache-ttl", 30*time.Second, "How long to cache cluster metrics summaries for.")
+}
+
+// metricsCacheEntry is a cached metrics summary.
+type metricsCacheEntry struct {
+	summary *generated.KubernetesClusterMetricsSummary
+	expires time.Time
+}
+
+// MetricsCache caches metrics summaries.  Collection involves a number of calls
+// to the cluster, and clients will typically poll for updates.
+type MetricsCache struct {
+	options *MetricsOptions
+	lock    sync.Mutex
+	entries map[string]metricsCacheEntry
+}
+
+// NewMetricsCache returns a new metrics cache.
+func NewMetricsCache(options *MetricsOptions) *MetricsCache {
+	return &MetricsCache{
+		options: options,
+		entries: map[string]metricsCacheEntry{},
+	}
+}
+
+// get returns a cached summary if one exists and has not expired.  Expired
+// entries are removed so the cache doesn't grow as clusters are deleted.
+func (c *MetricsCache) get(key string) *generated.KubernetesClusterMetricsSummary {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+
+	if entry, ok := c.entries[key]; ok {
+		return entry.summary
+	}
+
+	return nil
+}
+
+// set caches a summary.
+func (c *MetricsCache) set(key string, summary *generated.KubernetesClusterMetricsSummary) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries[key] = metricsCacheEntry{
+		summary: summary,
+		expires: time.Now().Add(c.options.CacheTTL),
+	}
+}
+
+// utilization converts from Kubernetes quantities into the API, scale converts
+// from base units e.g. bytes into something more useful.
+func utilization(requested, allocatable resource.Quantity, scale float64) generated.KubernetesClusterResourceUtilization {
+	return generated.KubernetesClusterResourceUtilization{
+		Requested:   float32(requested.AsApproximateFloat64() / scale),
+		Allocatable: float32(allocatable.AsApproximateFloat64() / scale),
+	}
+}
+
+// addResources accumulates resources.
+func addResources(total, in corev1.ResourceList) {
+	for name, quantity := range in {
+		value := total[name]
+		value.Add(quantity)
+		total[name] = value
+	}
+}
+
+// addUsage adds actual resource usage from metrics-server, if it's installed.
+func addUsage(ctx context.Context, c client.Client, summary *generated.KubernetesClusterMetricsSummary) {
+	metrics := &unstructured.UnstructuredList{}
+	metrics.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "metrics.k8s.io",
+		Version: "v1beta1",
+		Kind:    "NodeMetricsList",
+	})
+
+	if err := c.List(ctx, metrics); err != nil {
+		log.FromContext(ctx).Info("cluster metrics unavailable", "error", err)
+
+		return
+	}
+
+	usage := corev1.ResourceList{}
+
+	for i := range metrics.Items {
+		values, _, err := unstructured.NestedStringMap(metrics.Items[i].Object, "usage")
+		if err != nil {
+			continue
+		}
+
+		for name, value := range values {
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				continue
+			}
+
+			addResources(usage, corev1.ResourceList{corev1.ResourceName(name): quantity})
+		}
+	}
+
+	cpu := float32(usage.Cpu().AsApproximateFloat64())
+	memory := float32(usage.Memory().AsApproximateFloat64() / (1 << 30))
+
+	summary.Cpu.Used = &cpu
+	summary.Memory.Used = &memory
+}
+
+// summarizeMetrics totals up pod resource requests and node allocatable resources.
+// Only pods that are scheduled and not terminated consume resources.
+func summarizeMetrics(ctx context.Context, c client.Client) (*generated.KubernetesClusterMetricsSummary, error) {
+	nodes := &corev1.NodeList{}
+
+	if err := c.List(ctx, nodes); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list cluster nodes").WithError(err)
+	}
+
+	pods := &corev1.PodList{}
+
+	if err := c.List(ctx, pods); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list cluster pods").WithError(err)
+	}
+
+	allocatable := corev1.ResourceList{}
+
+	for i := range nodes.Items {
+		addResources(allocatable, nodes.Items[i].Status.Allocatable)
+	}
+
+	requested := corev1.ResourceList{}
+
+	var podCount int64
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		podCount++
+
+		for j := range pod.Spec.Containers {
+			addResources(requested, pod.Spec.Containers[j].Resources.Requests)
+		}
+	}
+
+	summary := &generated.KubernetesClusterMetricsSummary{
+		Nodes:  len(nodes.Items),
+		Cpu:    utilization(*requested.Cpu(), *allocatable.Cpu(), 1),
+		Memory: utilization(*requested.Memory(), *allocatable.Memory(), 1<<30),
+		Gpu:    utilization(requested[constants.NvidiaGPUType], allocatable[constants.NvidiaGPUType], 1),
+		Pods:   utilization(*resource.NewQuantity(podCount, resource.DecimalSI), *allocatable.Pods(), 1),
+	}
+
+	addUsage(ctx, c, summary)
+
+	return summary, nil
+}
+
+// clusterRESTConfig returns configuration to talk directly to the cluster.  A
+// broken cluster shouldn't hold up the UI, so requests are bounded by the timeout.
+func (c *Client) clusterRESTConfig(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, timeout time.Duration) (*rest.Config, error) {
+	kubeconfig, err := c.GetKubeconfig(ctx, controlPlaneName, name)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to parse cluster configuration").WithError(err)
+	}
+
+	config.Timeout = timeout
+
+	return config, nil
+}
+
+// GetMetricsSummary returns a coarse summary of cluster utilization.
+func (c *Client) GetMetricsSummary(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, cache *MetricsCache) (*generated.KubernetesClusterMetricsSummary, error) {
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.get(ctx, controlPlane.Namespace, name); err != nil {
+		return nil, err
+	}
+
+	// The control plane namespace is unique to the project, so this is scoped
+	// correctly.
+	key := controlPlane.Namespace + "/" + name
+
+	if summary := cache.get(key); summary != nil {
+		return summary, nil
+	}
+
+	config, err := c.clusterRESTConfig(ctx, controlPlaneName, name, metricsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterClient, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to create cluster client").WithError(err)
+	}
+
+	summary, err := summarizeMetrics(ctx, clusterClient)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.set(key, summary)
+
+	return summary, nil
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/eschercloudai/unikorn/pkg/collections"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+)
+
+// preflightContext collects validation failures so they can be reported to
+// the client in one go, rather than having them fix one thing at a time.
+type preflightContext struct {
+	failures []string
+}
+
+// fail records a validation failure.
+func (p *preflightContext) fail(format string, args ...interface{}) {
+	p.failures = append(p.failures, fmt.Sprintf(format, args...))
+}
+
+// machinePools returns all machine pools requested by the cluster.
+func machinePools(options *generated.KubernetesCluster) []*generated.OpenstackMachinePool {
+	pools := []*generated.OpenstackMachinePool{
+		&options.ControlPlane,
+	}
+
+	for i := range options.WorkloadPools {
+		pools = append(pools, &options.WorkloadPools[i].Machine)
+	}
+
+	return pools
+}
+
+// machinePoolValues returns the unique values selected from all machine pools,
+// in the order they are first requested.
+func machinePoolValues(options *generated.KubernetesCluster, selector func(*generated.OpenstackMachinePool) string) []string {
+	pools := machinePools(options)
+
+	values := make([]string, len(pools))
+
+	for i, pool := range pools {
+		values[i] = selector(pool)
+	}
+
+	return collections.Unique(values)
+}
+
+// preflightFlavors checks all requested flavors exist, returning a lookup
+// table for use in quota calculations.
+func (c *Client) preflightFlavors(ctx context.Context, identity *providers.Identity, p *preflightContext, options *generated.KubernetesCluster) (map[string]*providers.Flavor, error) {
+	result, err := c.provider.ListFlavors(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	flavors := map[string]*providers.Flavor{}
+
+	for i := range result {
+		flavors[result[i].Name] = &result[i]
+	}
+
+	flavorName := func(pool *generated.OpenstackMachinePool) string {
+		return pool.FlavorName
+	}
+
+	for _, name := range machinePoolValues(options, flavorName) {
+		if _, ok := flavors[name]; !ok {
+			p.fail("flavor %s does not exist", name)
+		}
+	}
+
+	return flavors, nil
+}
+
+// preflightImages checks all requested images exist and are active.
+func (c *Client) preflightImages(ctx context.Context, identity *providers.Identity, p *preflightContext, options *generated.KubernetesCluster) error {
+	result, err := c.provider.ListImages(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	// Only active images are ever listed, so anything else is missing.
+	images := map[string]bool{}
+
+	for _, image := range result {
+		images[image.Name] = true
+	}
+
+	imageName := func(pool *generated.OpenstackMachinePool) string {
+		return pool.ImageName
+	}
+
+	for _, name := range machinePoolValues(options, imageName) {
+		if !images[name] {
+			p.fail("image %s does not exist or is not active", name)
+		}
+	}
+
+	return nil
+}
+
+// availableZones returns a set of available zones from an API listing.
+func availableZones(in []providers.AvailabilityZone) map[string]bool {
+	out := map[string]bool{}
+
+	for _, az := range in {
+		out[az.Name] = az.Available
+	}
+
+	return out
+}
+
+// preflightAvailabilityZones checks all requested availability zones exist and
+// are available.
+func (c *Client) preflightAvailabilityZones(ctx context.Context, identity *providers.Identity, p *preflightContext, options *generated.KubernetesCluster) error {
+	computeResult, err := c.provider.ListAvailabilityZonesCompute(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	blockStorageResult, err := c.provider.ListAvailabilityZonesBlockStorage(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	compute := availableZones(computeResult)
+	blockStorage := availableZones(blockStorageResult)
+
+	computeZones := []string{
+		options.Openstack.ComputeAvailabilityZone,
+	}
+
+	blockStorageZones := []string{
+		options.Openstack.VolumeAvailabilityZone,
+	}
+
+	for i := range options.WorkloadPools {
+		if zone := options.WorkloadPools[i].AvailabilityZone; zone != nil {
+			computeZones = append(computeZones, *zone)
+		}
+	}
+
+	for _, pool := range machinePools(options) {
+		if pool.Disk != nil && pool.Disk.AvailabilityZone != nil {
+			blockStorageZones = append(blockStorageZones, *pool.Disk.AvailabilityZone)
+		}
+	}
+
+	for _, zone := range collections.Unique(computeZones) {
+		if !compute[zone] {
+			p.fail("compute availability zone %s is not available", zone)
+		}
+	}
+
+	for _, zone := range collections.Unique(blockStorageZones) {
+		if !blockStorage[zone] {
+			p.fail("block storage availability zone %s is not available", zone)
+		}
+	}
+
+	return nil
+}
+
+// preflightNetwork checks an existing network, if requested, can be used by the
+// cluster.  The subnet must be part of the network, its prefix must match the
+// node prefix, and it must not clash with the service or pod prefixes.  Prefixes
+// that fail to parse are ignored here, they are reported when creating the cluster.
+func (c *Client) preflightNetwork(ctx context.Context, identity *providers.Identity, p *preflightContext, options *generated.KubernetesCluster) error {
+	network := options.Openstack.Network
+	if network == nil {
+		return nil
+	}
+
+	subnet, err := c.provider.GetSubnet(ctx, identity, network.SubnetID)
+	if err != nil {
+		if !errors.IsHTTPNotFound(err) {
+			return err
+		}
+
+		p.fail("subnet %s does not exist", network.SubnetID)
+
+		return nil
+	}
+
+	if subnet.NetworkID != network.NetworkID {
+		p.fail("subnet %s is not part of network %s", network.SubnetID, network.NetworkID)
+	}
+
+	_, subnetNet, err := net.ParseCIDR(subnet.CIDR)
+	if err != nil {
+		return errors.OAuth2ServerError("failed to parse subnet prefix").WithError(err)
+	}
+
+	if _, nodeNet, err := net.ParseCIDR(options.Network.NodePrefix); err == nil && nodeNet.String() != subnetNet.String() {
+		p.fail("node prefix %s does not match subnet prefix %s", nodeNet, subnetNet)
+	}
+
+	prefixes := []string{
+		options.Network.ServicePrefix,
+		options.Network.PodPrefix,
+	}
+
+	if options.Network.SecondaryServicePrefix != nil {
+		prefixes = append(prefixes, *options.Network.SecondaryServicePrefix)
+	}
+
+	if options.Network.SecondaryPodPrefix != nil {
+		prefixes = append(prefixes, *options.Network.SecondaryPodPrefix)
+	}
+
+	for _, prefix := range prefixes {
+		if _, prefixNet, err := net.ParseCIDR(prefix); err == nil && prefixesOverlap(subnetNet, prefixNet) {
+			p.fail("subnet prefix %s overlaps service or pod prefix %s", subnetNet, prefixNet)
+		}
+	}
+
+	return nil
+}
+
+// checkQuota records a failure if the required amount of a resource exceeds what
+// is left of the quota.
+func checkQuota(p *preflightContext, name string, quota providers.Quota, required int) {
+	// Negative limits mean unlimited.
+	if quota.Limit < 0 {
+		return
+	}
+
+	if available := quota.Limit - quota.Used; required > available {
+		p.fail("insufficient %s quota, %d required but %d available", name, required, available)
+	}
+}
+
+// preflightQuotas checks the initial cluster topology fits within the project's
+// quota.  Autoscaled pools are only checked for their initial replica count, the
+// autoscaler will handle any shortfall gracefully when scaling up.
+func (c *Client) preflightQuotas(ctx context.Context, identity *providers.Identity, p *preflightContext, options *generated.KubernetesCluster, flavors map[string]*providers.Flavor) error {
+	quotas, err := c.provider.GetQuotas(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	var cores, ram, instances, volumes, gigabytes, largest int
+
+	for _, pool := range machinePools(options) {
+		flavor := flavors[pool.FlavorName]
+
+		cores += pool.Replicas * flavor.CPUs
+		ram += pool.Replicas * (flavor.Memory << 10) // Convert GiB to MiB
+		instances += pool.Replicas
+
+		if pool.Disk != nil {
+			volumes += pool.Replicas
+			gigabytes += pool.Replicas * pool.Disk.Size
+			largest = max(largest, pool.Disk.Size)
+		}
+	}
+
+	checkQuota(p, "cores", quotas.Compute.Cores, cores)
+	checkQuota(p, "ram", quotas.Compute.RAM, ram)
+	checkQuota(p, "instances", quotas.Compute.Instances, instances)
+	checkQuota(p, "volumes", quotas.BlockStorage.Volumes, volumes)
+	checkQuota(p, "gigabytes", quotas.BlockStorage.Gigabytes, gigabytes)
+
+	// Negative limits mean unlimited.
+	if limit := quotas.BlockStorage.PerVolumeGigabytes; limit >= 0 && largest > limit {
+		p.fail("disk size of %dGiB exceeds the volume size limit of %dGiB", largest, limit)
+	}
+
+	return nil
+}
+
+// preflight checks the cluster can actually be provisioned before accepting it,
+// otherwise failures only surface much later as a cluster that is stuck provisioning.
+// Errors accessing OpenStack are returned as is, validation failures are aggregated
+// and returned as a single error.
+func (c *Client) preflight(ctx context.Context, identity *providers.Identity, options *generated.KubernetesCluster) error {
+	p := &preflightContext{}
+
+	flavors, err := c.preflightFlavors(ctx, identity, p, options)
+	if err != nil {
+		return err
+	}
+
+	flavorsValid := len(p.failures) == 0
+
+	if err := c.preflightImages(ctx, identity, p, options); err != nil {
+		return err
+	}
+
+	if err := c.preflightAvailabilityZones(ctx, identity, p, options); err != nil {
+		return err
+	}
+
+	if err := c.preflightNetwork(ctx, identity, p, options); err != nil {
+		return err
+	}
+
+	// Quota calculations can only be performed if we know about all the flavors.
+	if flavorsValid {
+		if err := c.preflightQuotas(ctx, identity, p, options, flavors); err != nil {
+			return err
+		}
+	}
+
+	if len(p.failures) != 0 {
+		return errors.HTTPUnprocessableEntity("cluster failed pre-flight validation").WithValidationErrors(p.failures)
+	}
+
+	return nil
+}
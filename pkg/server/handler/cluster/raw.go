@@ -0,0 +1,125 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// redacted replaces any secret values in the raw view.
+const redacted = "<redacted>"
+
+// redact removes anything sensitive from the raw custom resource.  Cloud
+// configuration contains application credentials, and node files and commands
+// may contain anything at all.  The last applied configuration annotation would leak the
+// same things, and managed fields are just noise.
+func redact(in *unikornv1.KubernetesCluster) (map[string]interface{}, error) {
+	cluster := in.DeepCopy()
+
+	cluster.APIVersion = unikornv1.SchemeGroupVersion.String()
+	cluster.Kind = "KubernetesCluster"
+	cluster.ManagedFields = nil
+
+	delete(cluster.Annotations, corev1.LastAppliedConfigAnnotation)
+
+	data, err := json.Marshal(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	object := map[string]interface{}{}
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	if _, ok, _ := unstructured.NestedFieldNoCopy(object, "spec", "openstack", "cloudConfig"); ok {
+		if err := unstructured.SetNestedField(object, redacted, "spec", "openstack", "cloudConfig"); err != nil {
+			return nil, err
+		}
+	}
+
+	pools, _, _ := unstructured.NestedFieldNoCopy(object, "spec", "workloadPools", "pools")
+
+	poolList, _ := pools.([]interface{})
+
+	for _, pool := range poolList {
+		poolObject, ok := pool.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		files, _ := poolObject["files"].([]interface{})
+
+		for _, file := range files {
+			fileObject, ok := file.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if _, ok := fileObject["content"]; ok {
+				fileObject["content"] = redacted
+			}
+		}
+
+		for _, field := range []string{"preKubeadmCommands", "postKubeadmCommands"} {
+			commands, _ := poolObject[field].([]interface{})
+
+			for i := range commands {
+				commands[i] = redacted
+			}
+		}
+	}
+
+	return object, nil
+}
+
+// GetRaw returns the underlying custom resource with secrets redacted.  This
+// exposes platform internals, so is only available to administrators.
+func (c *Client) GetRaw(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) (map[string]interface{}, error) {
+	claims, err := oauth2.ClaimsFromContext(ctx)
+	if err != nil || !claims.Scope.Includes(oauth2.ScopeAdmin) {
+		return nil, errors.HTTPForbidden("raw view requires the admin scope")
+	}
+
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := redact(cluster)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("unable to redact cluster").WithError(err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/crypto/ssh"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// sshCertificateClockSkew backdates certificates so they are usable on
+	// nodes whose clocks lag behind ours.
+	sshCertificateClockSkew = 5 * time.Minute
+)
+
+// SSHOptions allow SSH certificate issuance to be configured.
+type SSHOptions struct {
+	// CertificateLifetime is the maximum lifetime of an SSH certificate,
+	// certificates never outlive the access token used to request them.
+	CertificateLifetime time.Duration
+
+	// CertificatePrincipals are the users an SSH certificate allows login as.
+	CertificatePrincipals []string
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *SSHOptions) AddFlags(f *pflag.FlagSet) {
+	f.DurationVar(&o.CertificateLifetime, "ssh-certificate-lifetime", time.Hour, "Maximum lifetime of issued SSH certificates.")
+	f.StringSliceVar(&o.CertificatePrincipals, "ssh-certificate-principals", []string{"ubuntu"}, "Users that issued SSH certificates allow login as.")
+}
+
+// sshCertificateAuthorityEnabled returns true if the cluster requests an SSH CA.
+func sshCertificateAuthorityEnabled(options *generated.KubernetesCluster) bool {
+	return options.SshCertificateAuthority != nil && *options.SshCertificateAuthority
+}
+
+// sshCertificateAuthoritySecretName returns the name of the secret holding the
+// cluster's SSH CA private key.
+func sshCertificateAuthoritySecretName(cluster *unikornv1.KubernetesCluster) string {
+	return cluster.Name + "-ssh-ca"
+}
+
+// generateSSHCertificateAuthority creates a new SSH CA, returning the public part
+// for inclusion in the cluster specification, and the PEM encoded private key.
+func generateSSHCertificateAuthority() (*unikornv1.SSHCertificateAuthoritySpec, []byte, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, errors.OAuth2ServerError("failed to generate ssh certificate authority").WithError(err)
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		return nil, nil, errors.OAuth2ServerError("failed to create ssh certificate authority public key").WithError(err)
+	}
+
+	block, err := ssh.MarshalPrivateKey(privateKey, "unikorn")
+	if err != nil {
+		return nil, nil, errors.OAuth2ServerError("failed to marshal ssh certificate authority private key").WithError(err)
+	}
+
+	spec := &unikornv1.SSHCertificateAuthoritySpec{
+		PublicKey: strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPublicKey))),
+	}
+
+	return spec, pem.EncodeToMemory(block), nil
+}
+
+// createSSHCertificateAuthority stores the SSH CA private key, it's owned by the
+// cluster so it's garbage collected along with it.
+func (c *Client) createSSHCertificateAuthority(ctx context.Context, cluster *unikornv1.KubernetesCluster, privateKey []byte) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sshCertificateAuthoritySecretName(cluster),
+			Namespace: cluster.Namespace,
+			Labels:    cluster.Labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cluster, unikornv1.SchemeGroupVersion.WithKind(unikornv1.KubernetesClusterKind)),
+			},
+		},
+		Type: corev1.SecretTypeSSHAuth,
+		Data: map[string][]byte{
+			corev1.SSHAuthPrivateKey: privateKey,
+		},
+	}
+
+	if err := c.client.Create(ctx, secret); err != nil {
+		return errors.OAuth2ServerError("failed to create ssh certificate authority").WithError(err)
+	}
+
+	return nil
+}
+
+// deleteSSHCertificateAuthority removes the SSH CA private key.
+func (c *Client) deleteSSHCertificateAuthority(ctx context.Context, cluster *unikornv1.KubernetesCluster) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sshCertificateAuthoritySecretName(cluster),
+			Namespace: cluster.Namespace,
+		},
+	}
+
+	if err := c.client.Delete(ctx, secret); err != nil && !kerrors.IsNotFound(err) {
+		return errors.OAuth2ServerError("failed to delete ssh certificate authority").WithError(err)
+	}
+
+	return nil
+}
+
+// getSSHCertificateAuthority returns a signer for the cluster's SSH CA.
+func (c *Client) getSSHCertificateAuthority(ctx context.Context, cluster *unikornv1.KubernetesCluster) (ssh.Signer, error) {
+	secret := &corev1.Secret{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: sshCertificateAuthoritySecretName(cluster)}, secret); err != nil {
+		return nil, errors.OAuth2ServerError("failed to get ssh certificate authority").WithError(err)
+	}
+
+	signer, err := ssh.ParsePrivateKey(secret.Data[corev1.SSHAuthPrivateKey])
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to parse ssh certificate authority").WithError(err)
+	}
+
+	return signer, nil
+}
+
+// sshCertificateSerial returns a random certificate serial number.
+func sshCertificateSerial() (uint64, error) {
+	var serial [8]byte
+
+	if _, err := rand.Read(serial[:]); err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(serial[:]), nil
+}
+
+// IssueSSHCertificate signs the requested public key with the cluster's SSH CA.
+// The certificate identifies the caller, and is valid for no longer than either
+// the configured lifetime or the caller's access token, whichever is shorter.
+func (c *Client) IssueSSHCertificate(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, request *generated.SshPublicKey, options *SSHOptions) (*generated.SshCertificate, error) {
+	claims, err := oauth2.ClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed get claims").WithError(err)
+	}
+
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource.Spec.SSHCertificateAuthority == nil {
+		return nil, errors.OAuth2InvalidRequest("cluster does not have an ssh certificate authority")
+	}
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(request.PublicKey))
+	if err != nil {
+		return nil, errors.OAuth2InvalidRequest("public key is invalid").WithError(err)
+	}
+
+	signer, err := c.getSSHCertificateAuthority(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := sshCertificateSerial()
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to generate ssh certificate serial").WithError(err)
+	}
+
+	now := time.Now()
+
+	validAfter := now.Add(-sshCertificateClockSkew)
+	validBefore := now.Add(options.CertificateLifetime)
+
+	if claims.Expiry != nil && claims.Expiry.Time().Before(validBefore) {
+		validBefore = claims.Expiry.Time()
+	}
+
+	certificate := &ssh.Certificate{
+		Key:             publicKey,
+		Serial:          serial,
+		CertType:        ssh.UserCert,
+		KeyId:           claims.Subject,
+		ValidPrincipals: options.CertificatePrincipals,
+		ValidAfter:      uint64(validAfter.Unix()),
+		ValidBefore:     uint64(validBefore.Unix()),
+		Permissions: ssh.Permissions{
+			Extensions: map[string]string{
+				"permit-pty":             "",
+				"permit-port-forwarding": "",
+			},
+		},
+	}
+
+	if err := certificate.SignCert(rand.Reader, signer); err != nil {
+		return nil, errors.OAuth2ServerError("failed to sign ssh certificate").WithError(err)
+	}
+
+	log.FromContext(ctx).Info("ssh certificate issued", "project", controlPlane.Project.Name, "controlPlane", controlPlane.Name, "cluster", resource.Name, "subject", claims.Subject, "serial", serial, "fingerprint", ssh.FingerprintSHA256(publicKey), "validBefore", validBefore)
+
+	out := &generated.SshCertificate{
+		Certificate: strings.TrimSpace(string(ssh.MarshalAuthorizedKey(certificate))),
+		Principals:  options.CertificatePrincipals,
+		ValidAfter:  time.Unix(int64(certificate.ValidAfter), 0).UTC(),
+		ValidBefore: time.Unix(int64(certificate.ValidBefore), 0).UTC(),
+	}
+
+	return out, nil
+}
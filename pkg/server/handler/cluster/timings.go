@@ -0,0 +1,76 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+
+	"github.com/eschercloudai/unikorn-core/pkg/util"
+)
+
+// convertStageTiming converts from a custom resource into the API definition.
+func convertStageTiming(in *unikornv1.ProvisioningStageTiming) generated.KubernetesClusterStageTiming {
+	out := generated.KubernetesClusterStageTiming{
+		Name:      in.Name,
+		StartTime: in.StartTime.Time,
+	}
+
+	if duration, ok := in.Duration(); ok {
+		out.CompletionTime = &in.CompletionTime.Time
+		out.Duration = util.ToPointer(float32(duration.Seconds()))
+	}
+
+	return out
+}
+
+// convertStageTimings converts from a custom resource into the API definition.
+// Only stages provisioned for the current generation are reported, anything
+// else is stale.
+func convertStageTimings(in *unikornv1.KubernetesCluster) generated.KubernetesClusterStageTimings {
+	out := generated.KubernetesClusterStageTimings{}
+
+	for i := range in.Status.StageTimings {
+		timing := &in.Status.StageTimings[i]
+
+		if timing.Generation != in.Generation {
+			continue
+		}
+
+		out = append(out, convertStageTiming(timing))
+	}
+
+	return out
+}
+
+// GetStageTimings returns how long each provisioning stage took.
+func (c *Client) GetStageTimings(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter) (generated.KubernetesClusterStageTimings, error) {
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertStageTimings(cluster), nil
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/common"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// convertKubernetesUpgrade reports the progress of a Kubernetes version upgrade,
+// if one is in progress.
+func convertKubernetesUpgrade(in *unikornv1.KubernetesCluster) *generated.KubernetesUpgradeStatus {
+	var phase generated.KubernetesUpgradePhase
+
+	switch {
+	case in.ControlPlaneUpgrading():
+		phase = generated.KubernetesUpgradePhaseControlPlane
+	case in.WorkloadPoolsUpgrading():
+		phase = generated.KubernetesUpgradePhaseWorkloadPools
+	default:
+		return nil
+	}
+
+	return &generated.KubernetesUpgradeStatus{
+		Version: string(*in.Spec.ControlPlane.Version),
+		Phase:   phase,
+	}
+}
+
+// validateKubernetesUpgrade checks the target version is newer than the current one,
+// and that it's no more than one minor version ahead, as that's all Kubernetes
+// supports.
+func validateKubernetesUpgrade(from unikornv1.SemanticVersion, to string) error {
+	current, err := version.ParseSemantic(string(from))
+	if err != nil {
+		return errors.OAuth2ServerError("failed to parse kubernetes version").WithError(err)
+	}
+
+	target, err := version.ParseSemantic(to)
+	if err != nil {
+		return errors.OAuth2InvalidRequest("invalid kubernetes version").WithError(err)
+	}
+
+	if !current.LessThan(target) {
+		return errors.OAuth2InvalidRequest(fmt.Sprintf("kubernetes version must be newer than %s", from))
+	}
+
+	if target.Major() != current.Major() || target.Minor() > current.Minor()+1 {
+		return errors.OAuth2InvalidRequest("kubernetes version may only be upgraded one minor version at a time")
+	}
+
+	return nil
+}
+
+// upgradeImage selects the newest image with the requested Kubernetes version.
+// Images with GPU drivers are only replaced with ones that also have them, and
+// vice versa.
+func upgradeImage(images []providers.Image, current, kubernetesVersion string) (string, error) {
+	var gpu bool
+
+	for _, image := range images {
+		if image.Name == current {
+			gpu = image.NvidiaDriverVersion != ""
+
+			break
+		}
+	}
+
+	// Images are ordered oldest first.
+	for i := len(images) - 1; i >= 0; i-- {
+		image := &images[i]
+
+		if image.KubernetesVersion == kubernetesVersion && (image.NvidiaDriverVersion != "") == gpu {
+			return image.Name, nil
+		}
+	}
+
+	return "", errors.OAuth2InvalidRequest(fmt.Sprintf("no image available for kubernetes version %s", kubernetesVersion))
+}
+
+// UpgradeKubernetes upgrades the control plane and all workload pools to the
+// requested Kubernetes version.  The provisioner takes care of upgrading the
+// control plane before the workload pools.
+func (c *Client) UpgradeKubernetes(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, request *generated.KubernetesUpgrade) error {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return err
+	}
+
+	if controlPlane.Deleting {
+		return errors.OAuth2InvalidRequest("control plane is being deleted")
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if resource.DeletionTimestamp != nil {
+		return errors.OAuth2InvalidRequest("cluster is being deleted")
+	}
+
+	if resource.Hibernated() {
+		return errors.OAuth2InvalidRequest("cluster is hibernated")
+	}
+
+	if resource.ControlPlaneUpgrading() || resource.WorkloadPoolsUpgrading() {
+		return errors.HTTPConflict()
+	}
+
+	if err := validateKubernetesUpgrade(*resource.Spec.ControlPlane.Version, request.Version); err != nil {
+		return err
+	}
+
+	bundle := &unikornv1.KubernetesClusterApplicationBundle{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Name: *resource.Spec.ApplicationBundle}, bundle); err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.OAuth2InvalidRequest("invalid application bundle").WithError(err)
+		}
+
+		return errors.OAuth2ServerError("failed to get application bundle").WithError(err)
+	}
+
+	kubernetesVersion := unikornv1.SemanticVersion(request.Version)
+
+	if err := bundle.Spec.ValidateKubernetesVersion(kubernetesVersion); err != nil {
+		return errors.OAuth2InvalidRequest("kubernetes version unsupported by application bundle").WithError(err)
+	}
+
+	images, err := c.provider.ListImages(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	temp := resource.DeepCopy()
+
+	machines := []*unikornv1.MachineGeneric{
+		&temp.Spec.ControlPlane.MachineGeneric,
+	}
+
+	for i := range temp.Spec.WorkloadPools.Pools {
+		machines = append(machines, &temp.Spec.WorkloadPools.Pools[i].MachineGeneric)
+	}
+
+	for _, machine := range machines {
+		image, err := upgradeImage(images, *machine.Image, request.Version)
+		if err != nil {
+			return err
+		}
+
+		machine.Version = &kubernetesVersion
+		machine.Image = &image
+	}
+
+	if err := c.client.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
+		return errors.OAuth2ServerError("failed to patch cluster").WithError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"slices"
+
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/common"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// validateWorkloadPool checks a single workload pool would be accepted as part
+// of a cluster, returning any reasons it would not.  Errors that aren't caused
+// by the pool itself e.g. the provider is unavailable, are returned as is.
+func (c *Client) validateWorkloadPool(ctx context.Context, identity *providers.Identity, operation *generated.KubernetesClusterWorkloadPoolOperation) ([]string, error) {
+	if operation.Pool == nil {
+		return []string{"workload pool must be specified"}, nil
+	}
+
+	if operation.Pool.Name != operation.Name {
+		return []string{"workload pool name does not match"}, nil
+	}
+
+	options := &generated.KubernetesCluster{
+		WorkloadPools: generated.KubernetesClusterWorkloadPools{
+			*operation.Pool,
+		},
+	}
+
+	if _, err := c.createWorkloadPools(ctx, identity, &createClusterContext{}, options); err != nil {
+		if errors.IsValidationError(err) {
+			return []string{err.Error()}, nil
+		}
+
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// mutateWorkloadPools validates each mutation against the existing pools, and
+// returns the resulting set of pools.  Existing pools retain their order, and
+// new ones are appended in request order.
+func (c *Client) mutateWorkloadPools(ctx context.Context, identity *providers.Identity, pools generated.KubernetesClusterWorkloadPools, operations generated.KubernetesClusterWorkloadPoolOperations) (generated.KubernetesClusterWorkloadPools, []generated.KubernetesClusterWorkloadPoolOperationResult, bool, error) {
+	results := make([]generated.KubernetesClusterWorkloadPoolOperationResult, len(operations))
+
+	valid := true
+
+	seen := map[string]bool{}
+
+	for i := range operations {
+		operation := &operations[i]
+
+		var failures []string
+
+		exists := slices.ContainsFunc(pools, func(pool generated.KubernetesClusterWorkloadPool) bool {
+			return pool.Name == operation.Name
+		})
+
+		switch {
+		case seen[operation.Name]:
+			failures = append(failures, "workload pool is mutated more than once")
+		case operation.Operation == generated.Add && exists:
+			failures = append(failures, "workload pool already exists")
+		case operation.Operation != generated.Add && !exists:
+			failures = append(failures, "workload pool does not exist")
+		case operation.Operation != generated.Remove:
+			poolFailures, err := c.validateWorkloadPool(ctx, identity, operation)
+			if err != nil {
+				return nil, nil, false, err
+			}
+
+			failures = append(failures, poolFailures...)
+		}
+
+		seen[operation.Name] = true
+
+		results[i] = generated.KubernetesClusterWorkloadPoolOperationResult{
+			Operation: operation.Operation,
+			Name:      operation.Name,
+		}
+
+		if len(failures) != 0 {
+			results[i].Errors = &failures
+
+			valid = false
+		}
+	}
+
+	if !valid {
+		return nil, results, false, nil
+	}
+
+	out := make(generated.KubernetesClusterWorkloadPools, 0, len(pools)+len(operations))
+
+	for _, pool := range pools {
+		index := slices.IndexFunc(operations, func(operation generated.KubernetesClusterWorkloadPoolOperation) bool {
+			return operation.Name == pool.Name
+		})
+
+		switch {
+		case index < 0:
+			out = append(out, pool)
+		case operations[index].Operation == generated.Replace:
+			out = append(out, *operations[index].Pool)
+		}
+	}
+
+	for i := range operations {
+		if operations[i].Operation == generated.Add {
+			out = append(out, *operations[i].Pool)
+		}
+	}
+
+	// A cluster must have somewhere to schedule workloads, so blame the removals.
+	if len(out) == 0 {
+		for i := range operations {
+			if operations[i].Operation == generated.Remove {
+				results[i].Errors = &[]string{"cluster must have at least one workload pool"}
+			}
+		}
+
+		return nil, results, false, nil
+	}
+
+	return out, results, true, nil
+}
+
+// MutateWorkloadPools adds, replaces and removes workload pools in a single atomic
+// update.  If any mutation is invalid, nothing is applied and the results describe
+// why.  Concurrent modification of the cluster is detected and reported as a
+// conflict.
+func (c *Client) MutateWorkloadPools(ctx context.Context, controlPlaneName generated.ControlPlaneNameParameter, name generated.ClusterNameParameter, request generated.KubernetesClusterWorkloadPoolOperations) (*generated.KubernetesClusterWorkloadPoolOperationResults, error) {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	controlPlane, err := controlplane.NewClient(c.client).GetMetadata(ctx, controlPlaneName)
+	if err != nil {
+		return nil, err
+	}
+
+	if controlPlane.Deleting {
+		return nil, errors.OAuth2InvalidRequest("control plane is being deleted")
+	}
+
+	resource, err := c.get(ctx, controlPlane.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource.Hibernated() {
+		return nil, errors.OAuth2InvalidRequest("cluster is hibernated")
+	}
+
+	current, err := c.convert(ctx, controlPlane, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	pools, results, valid, err := c.mutateWorkloadPools(ctx, identity, current.WorkloadPools, request)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &generated.KubernetesClusterWorkloadPoolOperationResults{
+		Applied: valid,
+		Results: results,
+	}
+
+	if !valid {
+		return out, nil
+	}
+
+	current.WorkloadPools = pools
+
+	// The optimistic lock ensures the pools we validated against are the ones
+	// we are replacing.
+	if err := c.update(ctx, identity, controlPlane, resource, current, client.MergeFromWithOptimisticLock{}); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertemplate
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/common"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Client wraps up cluster template related management handling.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client) *Client {
+	return &Client{
+		client: client,
+	}
+}
+
+// convertDisk converts from a custom resource into the API definition.
+func convertDisk(in *resource.Quantity) *generated.OpenstackVolume {
+	if in == nil {
+		return nil
+	}
+
+	return &generated.OpenstackVolume{
+		Size: int(in.Value()) >> 30,
+	}
+}
+
+// convertMachine converts from a custom resource into the API definition.
+func convertMachine(in *unikornv1.ClusterTemplateMachineSpec) *generated.KubernetesClusterTemplateMachine {
+	return &generated.KubernetesClusterTemplateMachine{
+		FlavorName: in.Flavor,
+		Replicas:   in.Replicas,
+		Disk:       convertDisk(in.DiskSize),
+	}
+}
+
+// convertWorkloadPool converts from a custom resource into the API definition.
+func convertWorkloadPool(in *unikornv1.ClusterTemplateWorkloadPoolSpec) generated.KubernetesClusterTemplateWorkloadPool {
+	out := generated.KubernetesClusterTemplateWorkloadPool{
+		Name:    in.Name,
+		Machine: convertMachine(&in.ClusterTemplateMachineSpec),
+	}
+
+	if in.Labels != nil {
+		out.Labels = &in.Labels
+	}
+
+	if in.Autoscaling != nil {
+		out.Autoscaling = &generated.KubernetesClusterAutoscaling{
+			MinimumReplicas: in.Autoscaling.MinimumReplicas,
+			MaximumReplicas: in.Autoscaling.MaximumReplicas,
+		}
+	}
+
+	return out
+}
+
+// convert converts from a custom resource into the API definition.
+func convert(in *unikornv1.ClusterTemplate) *generated.KubernetesClusterTemplate {
+	out := &generated.KubernetesClusterTemplate{
+		Name:                  in.Name,
+		Description:           in.Spec.Description,
+		ApplicationBundleName: in.Spec.ApplicationBundle,
+		Features:              common.ConvertFeatures(in.Spec.Features),
+	}
+
+	if in.Spec.ControlPlane != nil {
+		out.ControlPlane = convertMachine(in.Spec.ControlPlane)
+	}
+
+	if len(in.Spec.WorkloadPools) > 0 {
+		workloadPools := make(generated.KubernetesClusterTemplateWorkloadPools, len(in.Spec.WorkloadPools))
+
+		for i := range in.Spec.WorkloadPools {
+			workloadPools[i] = convertWorkloadPool(&in.Spec.WorkloadPools[i])
+		}
+
+		out.WorkloadPools = &workloadPools
+	}
+
+	return out
+}
+
+// convertList converts from a custom resource list into the API definition.
+func convertList(in []unikornv1.ClusterTemplate) generated.KubernetesClusterTemplates {
+	out := make(generated.KubernetesClusterTemplates, len(in))
+
+	for i := range in {
+		out[i] = *convert(&in[i])
+	}
+
+	return out
+}
+
+// List returns all cluster templates.
+func (c *Client) List(ctx context.Context) (generated.KubernetesClusterTemplates, error) {
+	result := &unikornv1.ClusterTemplateList{}
+
+	if err := c.client.List(ctx, result); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list cluster templates").WithError(err)
+	}
+
+	slices.SortStableFunc(result.Items, func(a, b unikornv1.ClusterTemplate) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return convertList(result.Items), nil
+}
+
+// get returns a cluster template, as this is referenced by a request a missing
+// template is considered a client error.
+func (c *Client) get(ctx context.Context, name string) (*generated.KubernetesClusterTemplate, error) {
+	result := &unikornv1.ClusterTemplate{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Name: name}, result); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.OAuth2InvalidRequest("invalid cluster template").WithError(err)
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get cluster template").WithError(err)
+	}
+
+	return convert(result), nil
+}
+
+// applyMachine defaults any optional machine values from the template.  Flavors
+// and replicas are required by the API so are only used by clients to pre-populate
+// requests.
+func applyMachine(template *generated.KubernetesClusterTemplateMachine, machine *generated.OpenstackMachinePool) {
+	if template == nil {
+		return
+	}
+
+	if machine.Disk == nil {
+		machine.Disk = template.Disk
+	}
+}
+
+// applyFeatures defaults any features the user hasn't explicitly set from the template.
+func applyFeatures(template *generated.KubernetesClusterFeatures, cluster *generated.KubernetesCluster) {
+	if template == nil {
+		return
+	}
+
+	if cluster.Features == nil {
+		features := *template
+
+		cluster.Features = &features
+
+		return
+	}
+
+	features := cluster.Features
+
+	if features.Autoscaling == nil {
+		features.Autoscaling = template.Autoscaling
+	}
+
+	if features.AutoscalingConfiguration == nil {
+		features.AutoscalingConfiguration = template.AutoscalingConfiguration
+	}
+
+	if features.Ingress == nil {
+		features.Ingress = template.Ingress
+	}
+
+	if features.CertManager == nil {
+		features.CertManager = template.CertManager
+	}
+
+	if features.KubernetesDashboard == nil {
+		features.KubernetesDashboard = template.KubernetesDashboard
+	}
+
+	if features.FileStorage == nil {
+		features.FileStorage = template.FileStorage
+	}
+
+	if features.Prometheus == nil {
+		features.Prometheus = template.Prometheus
+	}
+
+	if features.MetricsFederation == nil {
+		features.MetricsFederation = template.MetricsFederation
+	}
+
+	if features.NvidiaOperator == nil {
+		features.NvidiaOperator = template.NvidiaOperator
+	}
+
+	if features.NodeFirewall == nil {
+		features.NodeFirewall = template.NodeFirewall
+	}
+
+	if features.Backup == nil {
+		features.Backup = template.Backup
+	}
+
+	if features.BackupConfiguration == nil {
+		features.BackupConfiguration = template.BackupConfiguration
+	}
+
+	if features.CostAllocation == nil {
+		features.CostAllocation = template.CostAllocation
+	}
+}
+
+// applyWorkloadPools defaults any optional workload pool values from the
+// template pool with the same name.
+func applyWorkloadPools(template *generated.KubernetesClusterTemplateWorkloadPools, cluster *generated.KubernetesCluster) {
+	if template == nil {
+		return
+	}
+
+	for i := range cluster.WorkloadPools {
+		pool := &cluster.WorkloadPools[i]
+
+		index := slices.IndexFunc(*template, func(p generated.KubernetesClusterTemplateWorkloadPool) bool {
+			return p.Name == pool.Name
+		})
+
+		if index < 0 {
+			continue
+		}
+
+		templatePool := &(*template)[index]
+
+		applyMachine(templatePool.Machine, &pool.Machine)
+
+		if pool.Labels == nil {
+			pool.Labels = templatePool.Labels
+		}
+
+		if pool.Autoscaling == nil {
+			pool.Autoscaling = templatePool.Autoscaling
+		}
+	}
+}
+
+// Apply defaults any optional values omitted from a cluster request from the
+// named template, anything explicitly set by the user takes precedence.
+func (c *Client) Apply(ctx context.Context, name string, cluster *generated.KubernetesCluster) error {
+	template, err := c.get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if cluster.ApplicationBundle.Name == "" && template.ApplicationBundleName != nil {
+		cluster.ApplicationBundle.Name = *template.ApplicationBundleName
+	}
+
+	applyMachine(template.ControlPlane, &cluster.ControlPlane)
+	applyWorkloadPools(template.WorkloadPools, cluster)
+	applyFeatures(template.Features, cluster)
+
+	return nil
+}
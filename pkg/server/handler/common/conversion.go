@@ -18,7 +18,10 @@ package common
 
 import (
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func convertAutoUpgradeTimeWindow(in *unikornv1.ApplicationBundleAutoUpgradeWindowSpec) *generated.TimeWindow {
@@ -57,11 +60,108 @@ func ConvertApplicationBundleAutoUpgrade(in *unikornv1.ApplicationBundleAutoUpgr
 
 	result := &generated.ApplicationBundleAutoUpgrade{
 		DaysOfWeek: convertAutoUpgradeWeekDay(in.WeekDay),
+		TimeZone:   in.TimeZone,
+	}
+
+	if in.Channel != nil {
+		channel := generated.ApplicationBundleChannel(*in.Channel)
+
+		result.Channel = &channel
+	}
+
+	return result
+}
+
+// ConvertApplicationBundleUpgrade converts a pending upgrade from a resource status.
+func ConvertApplicationBundleUpgrade(in *unikornv1.ApplicationBundleUpgradeStatus) *generated.ApplicationBundleUpgrade {
+	if in == nil {
+		return nil
+	}
+
+	result := &generated.ApplicationBundleUpgrade{
+		Id:                in.Target,
+		ApplicationBundle: in.Target,
+		NextUpgradeAt:     in.NextUpgradeAt.Time,
+	}
+
+	if in.ApprovalRequestedAt != nil {
+		result.ApprovalRequestedAt = &in.ApprovalRequestedAt.Time
 	}
 
 	return result
 }
 
+// convertDuration converts from a custom resource duration into the API definition.
+func convertDuration(in *metav1.Duration) *string {
+	if in == nil {
+		return nil
+	}
+
+	out := in.Duration.String()
+
+	return &out
+}
+
+// convertAutoscalingConfiguration converts from a custom resource into the API definition.
+func convertAutoscalingConfiguration(in *unikornv1.ClusterAutoscalerSpec) *generated.KubernetesClusterAutoscalingConfiguration {
+	if in == nil {
+		return nil
+	}
+
+	out := &generated.KubernetesClusterAutoscalingConfiguration{
+		ScaleDownDelayAfterAdd:        convertDuration(in.ScaleDownDelayAfterAdd),
+		ScaleDownUnneededTime:         convertDuration(in.ScaleDownUnneededTime),
+		ScaleDownUtilizationThreshold: in.ScaleDownUtilizationThreshold,
+	}
+
+	if in.Expander != nil {
+		expander := generated.KubernetesClusterAutoscalingConfigurationExpander(*in.Expander)
+
+		out.Expander = &expander
+	}
+
+	return out
+}
+
+// convertBackupConfiguration converts from a custom resource into the API definition.
+func convertBackupConfiguration(in *unikornv1.BackupSpec) *generated.KubernetesClusterBackupConfiguration {
+	if in == nil {
+		return nil
+	}
+
+	out := &generated.KubernetesClusterBackupConfiguration{
+		Schedule:  in.Schedule,
+		Retention: convertDuration(in.Retention),
+	}
+
+	return out
+}
+
+// ConvertFeatures converts cluster features from a custom resource into the API definition.
+func ConvertFeatures(in *unikornv1.KubernetesClusterFeaturesSpec) *generated.KubernetesClusterFeatures {
+	if in == nil {
+		return nil
+	}
+
+	features := &generated.KubernetesClusterFeatures{
+		Autoscaling:              in.Autoscaling,
+		AutoscalingConfiguration: convertAutoscalingConfiguration(in.AutoscalingConfiguration),
+		Ingress:                  in.Ingress,
+		CertManager:              in.CertManager,
+		KubernetesDashboard:      in.KubernetesDashboard,
+		FileStorage:              in.FileStorage,
+		Prometheus:               in.Prometheus,
+		MetricsFederation:        in.MetricsFederation,
+		NvidiaOperator:           in.NvidiaOperator,
+		NodeFirewall:             in.NodeFirewall,
+		Backup:                   in.Backup,
+		BackupConfiguration:      convertBackupConfiguration(in.BackupConfiguration),
+		CostAllocation:           in.CostAllocation,
+	}
+
+	return features
+}
+
 func createAutoUpgradeTimeWindow(in *generated.TimeWindow) *unikornv1.ApplicationBundleAutoUpgradeWindowSpec {
 	if in == nil {
 		return nil
@@ -91,14 +191,28 @@ func createAutoUpgradeWeekDay(in *generated.AutoUpgradeDaysOfWeek) *unikornv1.Ap
 	return result
 }
 
-func CreateApplicationBundleAutoUpgrade(in *generated.ApplicationBundleAutoUpgrade) *unikornv1.ApplicationBundleAutoUpgradeSpec {
+func CreateApplicationBundleAutoUpgrade(in *generated.ApplicationBundleAutoUpgrade) (*unikornv1.ApplicationBundleAutoUpgradeSpec, error) {
 	if in == nil {
-		return nil
+		//nolint:nilnil
+		return nil, nil
 	}
 
 	result := &unikornv1.ApplicationBundleAutoUpgradeSpec{
-		WeekDay: createAutoUpgradeWeekDay(in.DaysOfWeek),
+		WeekDay:  createAutoUpgradeWeekDay(in.DaysOfWeek),
+		TimeZone: in.TimeZone,
 	}
 
-	return result
+	if in.Channel != nil {
+		channel := unikornv1.ApplicationBundleChannel(*in.Channel)
+
+		result.Channel = &channel
+	}
+
+	// Validate the time zone up front, the monitor will fall back to UTC
+	// which is probably not what the user expected.
+	if _, err := result.Location(); err != nil {
+		return nil, errors.OAuth2InvalidRequest("auto upgrade time zone is invalid").WithError(err)
+	}
+
+	return result, nil
 }
@@ -0,0 +1,50 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+)
+
+// optionalString returns nil for an empty string, so unprovisioned namespaces
+// are omitted rather than reported as empty.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+// ConvertPlacement returns where a resource is provisioned in the management
+// cluster.  This exposes platform topology, so is only returned to administrators,
+// everyone else gets nil.
+func ConvertPlacement(ctx context.Context, projectNamespace, controlPlaneNamespace, clusterNamespace string) *generated.KubernetesResourcePlacement {
+	claims, err := oauth2.ClaimsFromContext(ctx)
+	if err != nil || !claims.Scope.Includes(oauth2.ScopeAdmin) {
+		return nil
+	}
+
+	return &generated.KubernetesResourcePlacement{
+		ProjectNamespace:      optionalString(projectNamespace),
+		ControlPlaneNamespace: optionalString(controlPlaneNamespace),
+		ClusterNamespace:      optionalString(clusterNamespace),
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+)
+
+// ProviderIdentity returns the credentials the cloud provider acts with on
+// behalf of the user, as issued when they logged in.
+func ProviderIdentity(ctx context.Context) (*providers.Identity, error) {
+	claims, err := oauth2.ClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed get token claims").WithError(err)
+	}
+
+	if claims.UnikornClaims == nil {
+		return nil, errors.OAuth2ServerError("failed get token claim")
+	}
+
+	identity := &providers.Identity{
+		Token:     claims.UnikornClaims.Token,
+		UserID:    claims.UnikornClaims.User,
+		ProjectID: claims.UnikornClaims.Project,
+	}
+
+	return identity, nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApproveUpgrade marks a pending upgrade as approved, the monitor will then
+// perform the upgrade during the next upgrade window.  The upgrade ID must match
+// the pending upgrade, so a stale approval cannot allow a different upgrade.
+func ApproveUpgrade(ctx context.Context, c client.Client, resource client.Object, upgrade *unikornv1.ApplicationBundleUpgradeStatus, upgradeID string) error {
+	if upgrade == nil || upgrade.Target != upgradeID {
+		return errors.HTTPNotFound()
+	}
+
+	temp, ok := resource.DeepCopyObject().(client.Object)
+	if !ok {
+		return errors.OAuth2ServerError("failed to copy resource")
+	}
+
+	annotations := temp.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[constants.UpgradeApprovalAnnotation] = upgradeID
+
+	temp.SetAnnotations(annotations)
+
+	if err := c.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
+		return errors.OAuth2ServerError("failed to patch resource").WithError(err)
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"slices"
 
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/applicationbundle"
@@ -72,15 +73,15 @@ type Meta struct {
 
 var (
 	// ErrResourceDeleting is raised when the resource is being deleted.
-	ErrResourceDeleting = goerrors.New("resource is being deleted")
+	ErrResourceDeleting = unikornerrors.Conflict("resource is being deleted")
 
 	// ErrNamespaceUnset is raised when the namespace hasn't been created
 	// yet.
-	ErrNamespaceUnset = goerrors.New("resource namespace is unset")
+	ErrNamespaceUnset = unikornerrors.Unavailable("resource namespace is unset")
 
 	// ErrApplicationBundle is raised when no suitable application
 	// bundle is found.
-	ErrApplicationBundle = goerrors.New("no application bundle found")
+	ErrApplicationBundle = unikornerrors.NotFound("no application bundle found")
 )
 
 // active returns true if the project is usable.
@@ -95,7 +96,7 @@ func active(c *unikornv1.ControlPlane) error {
 
 // provisionDefaultControlPlane is called when a cluster creation call is made and the
 // control plane does not exist.
-func (c *Client) provisionDefaultControlPlane(ctx context.Context, name string) error {
+func (c *Client) provisionDefaultControlPlane(ctx context.Context, name string, annotations map[string]string) error {
 	log := log.FromContext(ctx)
 
 	log.Info("creating implicit control plane", "name", name)
@@ -116,6 +117,11 @@ func (c *Client) provisionDefaultControlPlane(ctx context.Context, name string)
 			continue
 		}
 
+		// Implicit control planes are subscribed to the stable channel.
+		if bundle.Channel != nil && *bundle.Channel != generated.Stable {
+			continue
+		}
+
 		applicationBundle = bundle
 
 		break
@@ -134,13 +140,20 @@ func (c *Client) provisionDefaultControlPlane(ctx context.Context, name string)
 		ApplicationBundleAutoUpgrade: &generated.ApplicationBundleAutoUpgrade{},
 	}
 
-	if err := c.Create(ctx, defaultControlPlane); err != nil {
+	if err := c.create(ctx, defaultControlPlane, annotations); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// CreateDefault creates a control plane as it would be implicitly, with the
+// provided annotations.  Unlike implicit creation, it is a conflict for the
+// control plane to already exist.
+func (c *Client) CreateDefault(ctx context.Context, name string, annotations map[string]string) error {
+	return c.provisionDefaultControlPlane(ctx, name, annotations)
+}
+
 // GetMetadata retrieves the control plane metadata.
 func (c *Client) GetMetadata(ctx context.Context, name string) (*Meta, error) {
 	project, err := project.NewClient(c.client).GetMetadata(ctx)
@@ -175,7 +188,11 @@ func (c *Client) GetOrCreateMetadata(ctx context.Context, name string) (*Meta, e
 			return nil, err
 		}
 
-		if err := c.provisionDefaultControlPlane(ctx, name); err != nil {
+		if project.Offboarding {
+			return nil, errors.OAuth2InvalidRequest("project is being offboarded")
+		}
+
+		if err := c.provisionDefaultControlPlane(ctx, name, nil); err != nil {
 			return nil, err
 		}
 	}
@@ -220,6 +237,28 @@ func (c *Client) GetOrCreateMetadata(ctx context.Context, name string) (*Meta, e
 	return metadata, nil
 }
 
+// convertComponents converts from Kubernetes into OpenAPI types.
+func convertComponents(in []unikornv1.ControlPlaneComponentStatus) *generated.ControlPlaneComponents {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(generated.ControlPlaneComponents, len(in))
+
+	for i, component := range in {
+		out[i] = generated.ControlPlaneComponent{
+			Name:    component.Name,
+			Version: component.Version,
+		}
+
+		if component.DeployedVersion != "" {
+			out[i].DeployedVersion = &in[i].DeployedVersion
+		}
+	}
+
+	return &out
+}
+
 // convert converts from Kubernetes into OpenAPI types.
 func (c *Client) convert(ctx context.Context, in *unikornv1.ControlPlane) (*generated.ControlPlane, error) {
 	bundle, err := applicationbundle.NewClient(c.client).GetControlPlane(ctx, *in.Spec.ApplicationBundle)
@@ -236,6 +275,10 @@ func (c *Client) convert(ctx context.Context, in *unikornv1.ControlPlane) (*gene
 		Name:                         in.Name,
 		ApplicationBundle:            *bundle,
 		ApplicationBundleAutoUpgrade: common.ConvertApplicationBundleAutoUpgrade(in.Spec.ApplicationBundleAutoUpgrade),
+		Upgrade:                      common.ConvertApplicationBundleUpgrade(in.Status.Upgrade),
+		Components:                   convertComponents(in.Status.Components),
+		Resources:                    convertResources(in.Spec.Resources),
+		Placement:                    common.ConvertPlacement(ctx, in.Namespace, in.Status.Namespace, ""),
 	}
 
 	if in.DeletionTimestamp != nil {
@@ -331,7 +374,17 @@ func (c *Client) Get(ctx context.Context, name generated.ControlPlaneNameParamet
 }
 
 // createControlPlane is a common function to create a Kubernetes type from an API one.
-func createControlPlane(project *project.Meta, request *generated.ControlPlane) *unikornv1.ControlPlane {
+func createControlPlane(project *project.Meta, request *generated.ControlPlane) (*unikornv1.ControlPlane, error) {
+	autoUpgrade, err := common.CreateApplicationBundleAutoUpgrade(request.ApplicationBundleAutoUpgrade)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := createResources(request.Resources)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: common with CLI tools.
 	controlPlane := &unikornv1.ControlPlane{
 		ObjectMeta: metav1.ObjectMeta{
@@ -344,15 +397,21 @@ func createControlPlane(project *project.Meta, request *generated.ControlPlane)
 		},
 		Spec: unikornv1.ControlPlaneSpec{
 			ApplicationBundle:            &request.ApplicationBundle.Name,
-			ApplicationBundleAutoUpgrade: common.CreateApplicationBundleAutoUpgrade(request.ApplicationBundleAutoUpgrade),
+			ApplicationBundleAutoUpgrade: autoUpgrade,
+			Resources:                    resources,
 		},
 	}
 
-	return controlPlane
+	return controlPlane, nil
 }
 
 // Create creates a control plane.
 func (c *Client) Create(ctx context.Context, request *generated.ControlPlane) error {
+	return c.create(ctx, request, nil)
+}
+
+// create creates a control plane with optional annotations.
+func (c *Client) create(ctx context.Context, request *generated.ControlPlane, annotations map[string]string) error {
 	project, err := project.NewClient(c.client).GetOrCreateMetadata(ctx)
 	if err != nil {
 		return err
@@ -362,12 +421,25 @@ func (c *Client) Create(ctx context.Context, request *generated.ControlPlane) er
 		return errors.OAuth2InvalidRequest("project is being deleted")
 	}
 
-	controlPlane := createControlPlane(project, request)
+	if project.Offboarding {
+		return errors.OAuth2InvalidRequest("project is being offboarded")
+	}
+
+	controlPlane, err := createControlPlane(project, request)
+	if err != nil {
+		return err
+	}
+
+	controlPlane.Annotations = annotations
 
 	if err := c.client.Create(ctx, controlPlane); err != nil {
 		// TODO: we can do a cached lookup to save the API traffic.
 		if kerrors.IsAlreadyExists(err) {
-			return errors.HTTPConflict()
+			return errors.HTTPConflict().WithResource(&generated.ResourceReference{
+				Kind: generated.ResourceReferenceKindControlPlane,
+				Name: request.Name,
+				Href: "/api/v1/controlplanes/" + request.Name,
+			})
 		}
 
 		return errors.OAuth2ServerError("failed to create control plane").WithError(err)
@@ -421,7 +493,10 @@ func (c *Client) Update(ctx context.Context, name generated.ControlPlaneNamePara
 		return err
 	}
 
-	required := createControlPlane(project, request)
+	required, err := createControlPlane(project, request)
+	if err != nil {
+		return err
+	}
 
 	// Experience has taught me that modifying caches by accident is a bad thing
 	// so be extra safe and deep copy the existing resource.
@@ -434,3 +509,18 @@ func (c *Client) Update(ctx context.Context, name generated.ControlPlaneNamePara
 
 	return nil
 }
+
+// ApproveUpgrade approves a pending control plane upgrade.
+func (c *Client) ApproveUpgrade(ctx context.Context, name generated.ControlPlaneNameParameter, upgradeID generated.UpgradeIDParameter) error {
+	project, err := project.NewClient(c.client).GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	resource, err := c.get(ctx, project.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return common.ApproveUpgrade(ctx, c.client, resource, resource.Status.Upgrade, upgradeID)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplane
+
+import (
+	"context"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/project"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// convertQuantity converts from a resource quantity to a string.
+func convertQuantity(in *resource.Quantity) *string {
+	if in == nil {
+		return nil
+	}
+
+	out := in.String()
+
+	return &out
+}
+
+// convertResources converts from Kubernetes into OpenAPI types.
+func convertResources(in *unikornv1.ControlPlaneResourcesSpec) *generated.ControlPlaneResources {
+	if in == nil {
+		return nil
+	}
+
+	out := &generated.ControlPlaneResources{
+		Cpu:    convertQuantity(in.CPU),
+		Memory: convertQuantity(in.Memory),
+	}
+
+	if in.Class != nil {
+		class := generated.ControlPlaneResourcesClass(*in.Class)
+
+		out.Class = &class
+	}
+
+	return out
+}
+
+// createQuantity parses an optional resource quantity, rejecting anything
+// that isn't positive.
+func createQuantity(in *string) (*resource.Quantity, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	quantity, err := resource.ParseQuantity(*in)
+	if err != nil {
+		return nil, errors.OAuth2InvalidRequest("failed to parse control plane resource quantity").WithError(err)
+	}
+
+	if quantity.Sign() <= 0 {
+		return nil, errors.OAuth2InvalidRequest("control plane resource quantity must be positive")
+	}
+
+	return &quantity, nil
+}
+
+// createResources converts from OpenAPI into Kubernetes types.
+func createResources(in *generated.ControlPlaneResources) (*unikornv1.ControlPlaneResourcesSpec, error) {
+	if in == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	cpu, err := createQuantity(in.Cpu)
+	if err != nil {
+		return nil, err
+	}
+
+	memory, err := createQuantity(in.Memory)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &unikornv1.ControlPlaneResourcesSpec{
+		CPU:    cpu,
+		Memory: memory,
+	}
+
+	if in.Class != nil {
+		class := unikornv1.ControlPlaneResourceClass(*in.Class)
+
+		switch class {
+		case unikornv1.ControlPlaneResourceClassSmall, unikornv1.ControlPlaneResourceClassMedium, unikornv1.ControlPlaneResourceClassLarge:
+		default:
+			return nil, errors.OAuth2InvalidRequest("control plane resource class is invalid")
+		}
+
+		out.Class = &class
+	}
+
+	// An empty specification means use the defaults, so normalize it away.
+	if out.Class == nil && out.CPU == nil && out.Memory == nil {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	return out, nil
+}
+
+// Resize changes the resources allocated to the control plane, the controller
+// will perform a rolling update of the control plane.
+func (c *Client) Resize(ctx context.Context, name generated.ControlPlaneNameParameter, request *generated.ControlPlaneResources) error {
+	project, err := project.NewClient(c.client).GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	if project.Deleting {
+		return errors.OAuth2InvalidRequest("project is being deleted")
+	}
+
+	controlPlane, err := c.get(ctx, project.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	if controlPlane.DeletionTimestamp != nil {
+		return errors.OAuth2InvalidRequest("control plane is being deleted")
+	}
+
+	resources, err := createResources(request)
+	if err != nil {
+		return err
+	}
+
+	temp := controlPlane.DeepCopy()
+	temp.Spec.Resources = resources
+
+	if err := c.client.Patch(ctx, temp, client.MergeFrom(controlPlane)); err != nil {
+		return errors.OAuth2ServerError("failed to patch control plane").WithError(err)
+	}
+
+	return nil
+}
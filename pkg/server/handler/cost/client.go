@@ -0,0 +1,190 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"math"
+
+	"github.com/spf13/pflag"
+
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// PriceSheetKey is the config map key that contains the price sheet.
+	PriceSheetKey = "prices.yaml"
+
+	// HoursPerMonth is the average number of hours in a month.
+	HoursPerMonth = 730
+
+	// controlPlanePoolName is used to identify the control plane in estimates.
+	controlPlanePoolName = "control-plane"
+
+	// precision is used to round costs, avoiding floating point artifacts.
+	precision = 10000
+)
+
+var (
+	// ErrUnconfigured is raised when no price sheet has been provided.
+	ErrUnconfigured = goerrors.New("cost estimation is not configured")
+)
+
+// Options allow the price sheet to be configured.
+type Options struct {
+	// Namespace is the namespace the price sheet resides in.
+	Namespace string
+
+	// Name is the name of the price sheet config map.
+	Name string
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.StringVar(&o.Namespace, "cost-price-sheet-namespace", "", "Namespace of the config map containing the price sheet.")
+	f.StringVar(&o.Name, "cost-price-sheet-name", "", "Name of the config map containing the price sheet, cost estimation is disabled if not set.")
+}
+
+// PriceSheet defines how much resources cost, as supplied by the operator.
+type PriceSheet struct {
+	// Currency is the currency prices are expressed in.
+	Currency string `json:"currency"`
+
+	// Flavors maps from flavor name to hourly price.
+	Flavors map[string]float64 `json:"flavors"`
+}
+
+// Client wraps up cost estimation.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+
+	// options define where the price sheet lives.
+	options *Options
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, options *Options) *Client {
+	return &Client{
+		client:  client,
+		options: options,
+	}
+}
+
+// getPriceSheet reads the price sheet from Kubernetes, this is done on demand
+// so the operator can update prices without restarting the server.
+func (c *Client) getPriceSheet(ctx context.Context) (*PriceSheet, error) {
+	if c.options.Name == "" {
+		return nil, errors.HTTPNotFound().WithError(ErrUnconfigured)
+	}
+
+	configMap := &corev1.ConfigMap{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: c.options.Namespace, Name: c.options.Name}, configMap); err != nil {
+		return nil, errors.OAuth2ServerError("failed to get price sheet").WithError(err)
+	}
+
+	data, ok := configMap.Data[PriceSheetKey]
+	if !ok {
+		return nil, errors.OAuth2ServerError("price sheet missing " + PriceSheetKey)
+	}
+
+	priceSheet := &PriceSheet{}
+
+	if err := yaml.Unmarshal([]byte(data), priceSheet); err != nil {
+		return nil, errors.OAuth2ServerError("failed to parse price sheet").WithError(err)
+	}
+
+	return priceSheet, nil
+}
+
+// round removes floating point artifacts from prices.
+func round(f float64) float64 {
+	return math.Round(f*precision) / precision
+}
+
+// estimatePool returns the cost of a pool of machines.
+func estimatePool(priceSheet *PriceSheet, name string, pool *generated.OpenstackMachinePool) (*generated.KubernetesClusterPoolCost, error) {
+	price, ok := priceSheet.Flavors[pool.FlavorName]
+	if !ok {
+		return nil, errors.HTTPUnprocessableEntity(fmt.Sprintf("flavor %s has no price", pool.FlavorName))
+	}
+
+	hourly := price * float64(pool.Replicas)
+
+	out := &generated.KubernetesClusterPoolCost{
+		Name:       name,
+		FlavorName: pool.FlavorName,
+		Replicas:   pool.Replicas,
+		UnitHourly: round(price),
+		Hourly:     round(hourly),
+		Monthly:    round(hourly * HoursPerMonth),
+	}
+
+	return out, nil
+}
+
+// Estimate returns the cost of a cluster.
+func (c *Client) Estimate(ctx context.Context, cluster *generated.KubernetesCluster) (*generated.KubernetesClusterCost, error) {
+	priceSheet, err := c.getPriceSheet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	controlPlane, err := estimatePool(priceSheet, controlPlanePoolName, &cluster.ControlPlane)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := generated.KubernetesClusterPoolCosts{
+		*controlPlane,
+	}
+
+	for i := range cluster.WorkloadPools {
+		pool := &cluster.WorkloadPools[i]
+
+		workloadPool, err := estimatePool(priceSheet, pool.Name, &pool.Machine)
+		if err != nil {
+			return nil, err
+		}
+
+		pools = append(pools, *workloadPool)
+	}
+
+	var hourly float64
+
+	for _, pool := range pools {
+		hourly += pool.Hourly
+	}
+
+	out := &generated.KubernetesClusterCost{
+		Currency: priceSheet.Currency,
+		Hourly:   round(hourly),
+		Monthly:  round(hourly * HoursPerMonth),
+		Pools:    pools,
+	}
+
+	return out, nil
+}
@@ -0,0 +1,334 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environment
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/cluster"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/clustertemplate"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/project"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// record is stored in an annotation on the environment's control plane, and
+// records what was created so it can be deleted as a single operation.
+type record struct {
+	// OperationID identifies the operation that created the environment.
+	OperationID string `json:"operationId"`
+
+	// CreationTime is when the environment was created.
+	CreationTime time.Time `json:"creationTime"`
+
+	// Cluster is the name of the cluster.
+	Cluster string `json:"cluster"`
+
+	// ProjectCreated is set when the project was created by the environment.
+	ProjectCreated bool `json:"projectCreated,omitempty"`
+}
+
+// Client wraps up preview environment related management handling.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+
+	// authenticator provides access to authentication services.
+	authenticator *authorization.Authenticator
+
+	// provider provides access to the cloud.
+	provider providers.CloudProvider
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, authenticator *authorization.Authenticator, provider providers.CloudProvider) *Client {
+	return &Client{
+		client:        client,
+		authenticator: authenticator,
+		provider:      provider,
+	}
+}
+
+// get returns the environment record from its control plane.
+func (c *Client) get(ctx context.Context, name string) (*record, error) {
+	project, err := project.NewClient(c.client).GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	controlPlane := &unikornv1.ControlPlane{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: project.Namespace, Name: name}, controlPlane); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.HTTPNotFound().WithError(err)
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get control plane").WithError(err)
+	}
+
+	// Control planes that weren't created by an environment aren't one.
+	value, ok := controlPlane.Annotations[constants.EnvironmentAnnotation]
+	if !ok {
+		return nil, errors.HTTPNotFound()
+	}
+
+	r := &record{}
+
+	if err := json.Unmarshal([]byte(value), r); err != nil {
+		return nil, errors.OAuth2ServerError("failed to unmarshal environment").WithError(err)
+	}
+
+	return r, nil
+}
+
+// createProject creates the project if it doesn't exist, returning true if it
+// was created.
+func (c *Client) createProject(ctx context.Context) (bool, error) {
+	projectClient := project.NewClient(c.client)
+
+	if _, err := projectClient.GetMetadata(ctx); err == nil {
+		return false, nil
+	} else if !errors.IsHTTPNotFound(err) {
+		return false, err
+	}
+
+	if err := projectClient.Create(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// created records what a create request created.
+type created struct {
+	// project is set when the project was created.
+	project bool
+
+	// controlPlane is set when the control plane was created.
+	controlPlane bool
+}
+
+// rollback removes anything created by a failed create request.
+func (c *Client) rollback(ctx context.Context, name string, done *created) {
+	log := log.FromContext(ctx)
+
+	if done.controlPlane {
+		if err := controlplane.NewClient(c.client).Delete(ctx, name); err != nil && !errors.IsHTTPNotFound(err) {
+			log.Error(err, "failed to delete environment control plane", "name", name)
+		}
+	}
+
+	if done.project {
+		if err := project.NewClient(c.client).Delete(ctx); err != nil && !errors.IsHTTPNotFound(err) {
+			log.Error(err, "failed to delete environment project", "name", name)
+		}
+	}
+}
+
+// create performs the create operation, recording what was created so it can
+// be rolled back on error.
+func (c *Client) create(ctx context.Context, request *generated.Environment, r *record, done *created) error {
+	projectCreated, err := c.createProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	done.project = projectCreated
+
+	r.ProjectCreated = projectCreated
+
+	value, err := json.Marshal(r)
+	if err != nil {
+		return errors.OAuth2ServerError("failed to marshal environment").WithError(err)
+	}
+
+	annotations := map[string]string{
+		constants.EnvironmentAnnotation: string(value),
+	}
+
+	// The control plane must not already exist, otherwise it would be deleted
+	// with an environment that didn't create it.  Creation is atomic, so this
+	// also reserves the name against concurrent requests.
+	if err := controlplane.NewClient(c.client).CreateDefault(ctx, request.Name, annotations); err != nil {
+		return err
+	}
+
+	done.controlPlane = true
+
+	if err := cluster.NewClient(c.client, c.authenticator, c.provider).Create(ctx, request.Name, &request.Cluster); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Create creates a project, if required, a control plane and a cluster as a
+// single operation.  If any step fails, everything created is removed.
+func (c *Client) Create(ctx context.Context, request *generated.Environment) (*generated.EnvironmentStatus, error) {
+	if err := clustertemplate.NewClient(c.client).Apply(ctx, request.Template, &request.Cluster); err != nil {
+		return nil, err
+	}
+
+	r := &record{
+		OperationID:  uuid.New().String(),
+		CreationTime: time.Now(),
+		Cluster:      request.Cluster.Name,
+	}
+
+	done := &created{}
+
+	if err := c.create(ctx, request, r, done); err != nil {
+		c.rollback(ctx, request.Name, done)
+
+		return nil, err
+	}
+
+	return c.Get(ctx, request.Name)
+}
+
+// aggregate returns the status of the least progressed resource, or
+// provisioned if all resources are.
+func aggregate(statuses ...*generated.KubernetesResourceStatus) string {
+	reasons := []coreunikornv1.ConditionReason{
+		coreunikornv1.ConditionReasonErrored,
+		coreunikornv1.ConditionReasonDeprovisioning,
+		coreunikornv1.ConditionReasonProvisioning,
+	}
+
+	for _, reason := range reasons {
+		for _, status := range statuses {
+			if status != nil && status.Status == string(reason) {
+				return string(reason)
+			}
+		}
+	}
+
+	for _, status := range statuses {
+		if status == nil || status.Status != string(coreunikornv1.ConditionReasonProvisioned) {
+			return "Unknown"
+		}
+	}
+
+	return string(coreunikornv1.ConditionReasonProvisioned)
+}
+
+// Get returns the environment's status.
+func (c *Client) Get(ctx context.Context, name generated.EnvironmentNameParameter) (*generated.EnvironmentStatus, error) {
+	r, err := c.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &generated.EnvironmentStatus{
+		Name:         name,
+		OperationId:  r.OperationID,
+		CreationTime: r.CreationTime,
+	}
+
+	controlPlane, err := controlplane.NewClient(c.client).Get(ctx, name)
+	if err != nil && !errors.IsHTTPNotFound(err) {
+		return nil, err
+	}
+
+	if controlPlane != nil {
+		out.ControlPlane = controlPlane.Status
+	}
+
+	cluster, err := cluster.NewClient(c.client, c.authenticator, c.provider).Get(ctx, name, r.Cluster)
+	if err != nil && !errors.IsHTTPNotFound(err) {
+		return nil, err
+	}
+
+	if cluster != nil {
+		out.Cluster = cluster.Status
+	}
+
+	out.Status = aggregate(out.ControlPlane, out.Cluster)
+
+	return out, nil
+}
+
+// otherControlPlanes returns true if the project contains control planes that
+// aren't part of the environment.
+func (c *Client) otherControlPlanes(ctx context.Context, name string) (bool, error) {
+	project, err := project.NewClient(c.client).GetMetadata(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	controlPlanes := &unikornv1.ControlPlaneList{}
+
+	if err := c.client.List(ctx, controlPlanes, &client.ListOptions{Namespace: project.Namespace}); err != nil {
+		return false, errors.OAuth2ServerError("failed to list control planes").WithError(err)
+	}
+
+	for i := range controlPlanes.Items {
+		if controlPlanes.Items[i].Name != name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Delete deletes the environment's control plane, which cascades to the cluster,
+// and the project if the environment created it and nothing else is using it.
+func (c *Client) Delete(ctx context.Context, name generated.EnvironmentNameParameter) error {
+	r, err := c.get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if err := controlplane.NewClient(c.client).Delete(ctx, name); err != nil && !errors.IsHTTPNotFound(err) {
+		return err
+	}
+
+	if !r.ProjectCreated {
+		return nil
+	}
+
+	other, err := c.otherControlPlanes(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if other {
+		return nil
+	}
+
+	if err := project.NewClient(c.client).Delete(ctx); err != nil && !errors.IsHTTPNotFound(err) {
+		return err
+	}
+
+	return nil
+}
@@ -22,18 +22,39 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/eschercloudai/unikorn/pkg/logging"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization"
+	"github.com/eschercloudai/unikorn/pkg/server/debug"
+	"github.com/eschercloudai/unikorn/pkg/server/deprecation"
 	"github.com/eschercloudai/unikorn/pkg/server/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/activity"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/application"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/applicationbundle"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/backup"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/changelog"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/cluster"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/clustertemplate"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/common"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/cost"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/environment"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/idempotency"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/inventory"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/kubernetesversion"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/member"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/migration"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/monitor"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/offboarding"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/project"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/providers/openstack"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/rolebinding"
+	"github.com/eschercloudai/unikorn/pkg/server/state"
 	"github.com/eschercloudai/unikorn/pkg/server/util"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type Handler struct {
@@ -46,12 +67,33 @@ type Handler struct {
 	// options allows behaviour to be defined on the CLI.
 	options *Options
 
-	// openstack is the Openstack client.
-	openstack *openstack.Openstack
+	// provider is the cloud provider clusters are provisioned on.
+	provider providers.CloudProvider
+
+	// captures retains debug captures.
+	captures *debug.Store
+
+	// deprecations retains deprecated API usage.
+	deprecations *deprecation.Store
+
+	// state is shared between server replicas.
+	state state.Store
+
+	// redactor removes secrets from exported resources.
+	redactor *logging.Redactor
+
+	// metrics caches cluster metrics summaries.
+	metrics *cluster.MetricsCache
 }
 
-func New(client client.Client, authenticator *authorization.Authenticator, options *Options) (*Handler, error) {
-	o, err := openstack.New(&options.Openstack, authenticator)
+func New(client client.Client, authenticator *authorization.Authenticator, captures *debug.Store, deprecations *deprecation.Store, state state.Store, redactor *logging.Redactor, options *Options) (*Handler, error) {
+	registry := providers.NewRegistry()
+
+	registry.Register(generated.Openstack, func() (providers.CloudProvider, error) {
+		return openstack.New(&options.Openstack, authenticator)
+	})
+
+	provider, err := registry.New(generated.ProviderType(options.Provider))
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +102,12 @@ func New(client client.Client, authenticator *authorization.Authenticator, optio
 		client:        client,
 		authenticator: authenticator,
 		options:       options,
-		openstack:     o,
+		provider:      provider,
+		captures:      captures,
+		deprecations:  deprecations,
+		state:         state,
+		redactor:      redactor,
+		metrics:       cluster.NewMetricsCache(&options.Metrics),
 	}
 
 	return h, nil
@@ -75,6 +122,41 @@ func (h *Handler) setUncacheable(w http.ResponseWriter) {
 	w.Header().Add("Cache-Control", "no-cache")
 }
 
+// createIdempotent performs an asynchronous create at most once per idempotency
+// key, so declarative tooling can safely retry requests.
+func (h *Handler) createIdempotent(w http.ResponseWriter, r *http.Request, key *generated.IdempotencyKeyParameter, create func() error) {
+	idempotencyClient := idempotency.NewClient(h.state)
+
+	replayed, err := idempotencyClient.Reserve(r, key)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if replayed {
+		w.Header().Set(idempotency.ReplayedHeader, "true")
+	} else {
+		if err := create(); err != nil {
+			if err := idempotencyClient.Release(r, key); err != nil {
+				log.FromContext(r.Context()).Error(err, "failed to release idempotency key")
+			}
+
+			errors.HandleError(w, r, err)
+
+			return
+		}
+
+		// The resource exists at this point, so don't report an error, a retry
+		// will be told about the existing resource by the conflict response.
+		if err := idempotencyClient.Complete(r, key); err != nil {
+			log.FromContext(r.Context()).Error(err, "failed to record idempotency key")
+		}
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
 func (h *Handler) GetApiV1AuthOauth2Authorization(w http.ResponseWriter, r *http.Request) {
 	h.authenticator.OAuth2.Authorization(w, r)
 }
@@ -123,8 +205,14 @@ func (h *Handler) GetApiV1AuthJwks(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) PostApiV1Project(w http.ResponseWriter, r *http.Request) {
-	if err := project.NewClient(h.client).Create(r.Context()); err != nil {
+func (h *Handler) PostApiV1Project(w http.ResponseWriter, r *http.Request, params generated.PostApiV1ProjectParams) {
+	h.createIdempotent(w, r, params.IdempotencyKey, func() error {
+		return project.NewClient(h.client).Create(r.Context())
+	})
+}
+
+func (h *Handler) DeleteApiV1Project(w http.ResponseWriter, r *http.Request) {
+	if err := project.NewClient(h.client).Delete(r.Context()); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
@@ -133,36 +221,43 @@ func (h *Handler) PostApiV1Project(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusAccepted)
 }
 
-func (h *Handler) DeleteApiV1Project(w http.ResponseWriter, r *http.Request) {
-	if err := project.NewClient(h.client).Delete(r.Context()); err != nil {
+func (h *Handler) GetApiV1ProjectMembers(w http.ResponseWriter, r *http.Request) {
+	result, err := member.NewClient(h.authenticator, h.provider).List(r.Context())
+	if err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
 	h.setUncacheable(w)
-	w.WriteHeader(http.StatusAccepted)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1Controlplanes(w http.ResponseWriter, r *http.Request) {
-	result, err := controlplane.NewClient(h.client).List(r.Context())
-	if err != nil {
+func (h *Handler) PostApiV1ProjectMembers(w http.ResponseWriter, r *http.Request) {
+	request := &generated.ProjectMemberInvitation{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := member.NewClient(h.authenticator, h.provider).Invite(r.Context(), request); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
 	h.setUncacheable(w)
-	util.WriteJSONResponse(w, r, http.StatusOK, result)
+	w.WriteHeader(http.StatusAccepted)
 }
 
-func (h *Handler) PostApiV1Controlplanes(w http.ResponseWriter, r *http.Request) {
-	request := &generated.ControlPlane{}
+func (h *Handler) PutApiV1ProjectMembersUserID(w http.ResponseWriter, r *http.Request, userID generated.UserIDParameter) {
+	request := &generated.ProjectMemberRole{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
-	if err := controlplane.NewClient(h.client).Create(r.Context(), request); err != nil {
+	if err := member.NewClient(h.authenticator, h.provider).Update(r.Context(), userID, request); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
@@ -171,8 +266,8 @@ func (h *Handler) PostApiV1Controlplanes(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusAccepted)
 }
 
-func (h *Handler) DeleteApiV1ControlplanesControlPlaneName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
-	if err := controlplane.NewClient(h.client).Delete(r.Context(), controlPlaneName); err != nil {
+func (h *Handler) DeleteApiV1ProjectMembersUserID(w http.ResponseWriter, r *http.Request, userID generated.UserIDParameter) {
+	if err := member.NewClient(h.authenticator, h.provider).Remove(r.Context(), userID); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
@@ -181,8 +276,8 @@ func (h *Handler) DeleteApiV1ControlplanesControlPlaneName(w http.ResponseWriter
 	w.WriteHeader(http.StatusAccepted)
 }
 
-func (h *Handler) GetApiV1ControlplanesControlPlaneName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
-	result, err := controlplane.NewClient(h.client).Get(r.Context(), controlPlaneName)
+func (h *Handler) GetApiV1ProjectRolebindings(w http.ResponseWriter, r *http.Request) {
+	result, err := rolebinding.NewClient(h.client).List(r.Context())
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
@@ -192,15 +287,15 @@ func (h *Handler) GetApiV1ControlplanesControlPlaneName(w http.ResponseWriter, r
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) PutApiV1ControlplanesControlPlaneName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
-	request := &generated.ControlPlane{}
+func (h *Handler) PostApiV1ProjectRolebindings(w http.ResponseWriter, r *http.Request) {
+	request := &generated.ProjectRoleBinding{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
-	if err := controlplane.NewClient(h.client).Update(r.Context(), controlPlaneName, request); err != nil {
+	if err := rolebinding.NewClient(h.client).Create(r.Context(), request); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
@@ -209,8 +304,8 @@ func (h *Handler) PutApiV1ControlplanesControlPlaneName(w http.ResponseWriter, r
 	w.WriteHeader(http.StatusAccepted)
 }
 
-func (h *Handler) GetApiV1ControlplanesControlPlaneNameClusters(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
-	result, err := cluster.NewClient(h.client, r, h.authenticator, h.openstack).List(r.Context(), controlPlaneName)
+func (h *Handler) GetApiV1ProjectRolebindingsRoleBindingName(w http.ResponseWriter, r *http.Request, roleBindingName generated.RoleBindingNameParameter) {
+	result, err := rolebinding.NewClient(h.client).Get(r.Context(), roleBindingName)
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
@@ -220,15 +315,15 @@ func (h *Handler) GetApiV1ControlplanesControlPlaneNameClusters(w http.ResponseW
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) PostApiV1ControlplanesControlPlaneNameClusters(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
-	request := &generated.KubernetesCluster{}
+func (h *Handler) PutApiV1ProjectRolebindingsRoleBindingName(w http.ResponseWriter, r *http.Request, roleBindingName generated.RoleBindingNameParameter) {
+	request := &generated.ProjectRoleBinding{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
-	if err := cluster.NewClient(h.client, r, h.authenticator, h.openstack).Create(r.Context(), controlPlaneName, request); err != nil {
+	if err := rolebinding.NewClient(h.client).Update(r.Context(), roleBindingName, request); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
@@ -237,8 +332,8 @@ func (h *Handler) PostApiV1ControlplanesControlPlaneNameClusters(w http.Response
 	w.WriteHeader(http.StatusAccepted)
 }
 
-func (h *Handler) DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
-	if err := cluster.NewClient(h.client, r, h.authenticator, h.openstack).Delete(r.Context(), controlPlaneName, clusterName); err != nil {
+func (h *Handler) DeleteApiV1ProjectRolebindingsRoleBindingName(w http.ResponseWriter, r *http.Request, roleBindingName generated.RoleBindingNameParameter) {
+	if err := rolebinding.NewClient(h.client).Delete(r.Context(), roleBindingName); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
@@ -247,8 +342,8 @@ func (h *Handler) DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(w
 	w.WriteHeader(http.StatusAccepted)
 }
 
-func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
-	result, err := cluster.NewClient(h.client, r, h.authenticator, h.openstack).Get(r.Context(), controlPlaneName, clusterName)
+func (h *Handler) GetApiV1ProjectOffboarding(w http.ResponseWriter, r *http.Request) {
+	result, err := offboarding.NewClient(h.client, h.authenticator, h.provider).Get(r.Context())
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
@@ -258,36 +353,54 @@ func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterName(w htt
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) PutApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
-	request := &generated.KubernetesCluster{}
+func (h *Handler) PostApiV1ProjectOffboarding(w http.ResponseWriter, r *http.Request) {
+	if err := offboarding.NewClient(h.client, h.authenticator, h.provider).Start(r.Context()); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1ProjectOffboardingConfirm(w http.ResponseWriter, r *http.Request) {
+	request := &generated.ProjectOffboardingConfirmation{}
 
 	if err := util.ReadJSONBody(r, request); err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
-	if err := cluster.NewClient(h.client, r, h.authenticator, h.openstack).Update(r.Context(), controlPlaneName, clusterName, request); err != nil {
+	result, err := offboarding.NewClient(h.client, h.authenticator, h.provider).Confirm(r.Context(), request)
+	if err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
 	h.setUncacheable(w)
-	w.WriteHeader(http.StatusAccepted)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
-	result, err := cluster.NewClient(h.client, r, h.authenticator, h.openstack).GetKubeconfig(r.Context(), controlPlaneName, clusterName)
+func (h *Handler) GetApiV1Export(w http.ResponseWriter, r *http.Request) {
+	result, err := backup.NewClient(h.client, h.authenticator, h.provider).Export(r.Context())
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
 	h.setUncacheable(w)
-	util.WriteOctetStreamResponse(w, r, http.StatusOK, result)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1ApplicationbundlesControlPlane(w http.ResponseWriter, r *http.Request) {
-	result, err := applicationbundle.NewClient(h.client).ListControlPlane(r.Context())
+func (h *Handler) PostApiV1Import(w http.ResponseWriter, r *http.Request) {
+	request := &generated.ImportOptions{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := backup.NewClient(h.client, h.authenticator, h.provider).Import(r.Context(), request)
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
@@ -297,8 +410,8 @@ func (h *Handler) GetApiV1ApplicationbundlesControlPlane(w http.ResponseWriter,
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1ApplicationbundlesCluster(w http.ResponseWriter, r *http.Request) {
-	result, err := applicationbundle.NewClient(h.client).ListCluster(r.Context())
+func (h *Handler) GetApiV1Controlplanes(w http.ResponseWriter, r *http.Request) {
+	result, err := controlplane.NewClient(h.client).List(r.Context())
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
@@ -308,8 +421,31 @@ func (h *Handler) GetApiV1ApplicationbundlesCluster(w http.ResponseWriter, r *ht
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1Applications(w http.ResponseWriter, r *http.Request) {
-	result, err := application.NewClient(h.client).List(r.Context())
+func (h *Handler) PostApiV1Controlplanes(w http.ResponseWriter, r *http.Request, params generated.PostApiV1ControlplanesParams) {
+	request := &generated.ControlPlane{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.createIdempotent(w, r, params.IdempotencyKey, func() error {
+		return controlplane.NewClient(h.client).Create(r.Context(), request)
+	})
+}
+
+func (h *Handler) DeleteApiV1ControlplanesControlPlaneName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
+	if err := controlplane.NewClient(h.client).Delete(r.Context(), controlPlaneName); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1ControlplanesControlPlaneName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
+	result, err := controlplane.NewClient(h.client).Get(r.Context(), controlPlaneName)
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
@@ -319,63 +455,186 @@ func (h *Handler) GetApiV1Applications(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1ProvidersOpenstackAvailabilityZonesCompute(w http.ResponseWriter, r *http.Request) {
-	result, err := h.openstack.ListAvailabilityZonesCompute(r)
+func (h *Handler) PutApiV1ControlplanesControlPlaneName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
+	request := &generated.ControlPlane{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := controlplane.NewClient(h.client).Update(r.Context(), controlPlaneName, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameResize(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
+	request := &generated.ControlPlaneResources{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := controlplane.NewClient(h.client).Resize(r.Context(), controlPlaneName, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameMove(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
+	request := &generated.ControlPlaneMove{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := migration.NewClient(h.client, h.authenticator, h.provider).Move(r.Context(), controlPlaneName, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameUpgradesUpgradeIDApprove(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, upgradeID generated.UpgradeIDParameter) {
+	if err := controlplane.NewClient(h.client).ApproveUpgrade(r.Context(), controlPlaneName, upgradeID); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1ControlplanesControlPlaneNameClusters(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter) {
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).List(r.Context(), controlPlaneName)
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
-	h.setCacheable(w)
+	h.setUncacheable(w)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(w http.ResponseWriter, r *http.Request) {
-	result, err := h.openstack.ListAvailabilityZonesBlockStorage(r)
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameClusters(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, params generated.PostApiV1ControlplanesControlPlaneNameClustersParams) {
+	request := &generated.KubernetesCluster{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if params.Template != nil {
+		if err := clustertemplate.NewClient(h.client).Apply(r.Context(), *params.Template, request); err != nil {
+			errors.HandleError(w, r, err)
+			return
+		}
+	}
+
+	if params.DryRun != nil && *params.DryRun == generated.PostApiV1ControlplanesControlPlaneNameClustersParamsDryRunCost {
+		result, err := cost.NewClient(h.client, &h.options.Cost).Estimate(r.Context(), request)
+		if err != nil {
+			errors.HandleError(w, r, err)
+			return
+		}
+
+		h.setUncacheable(w)
+		util.WriteJSONResponse(w, r, http.StatusOK, result)
+
+		return
+	}
+
+	h.createIdempotent(w, r, params.IdempotencyKey, func() error {
+		return cluster.NewClient(h.client, h.authenticator, h.provider).Create(r.Context(), controlPlaneName, request)
+	})
+}
+
+func (h *Handler) DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).Delete(r.Context(), controlPlaneName, clusterName); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter, params generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams) {
+	if params.View != nil && *params.View == generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParamsViewRaw {
+		result, err := cluster.NewClient(h.client, h.authenticator, h.provider).GetRaw(r.Context(), controlPlaneName, clusterName)
+		if err != nil {
+			errors.HandleError(w, r, err)
+			return
+		}
+
+		h.setUncacheable(w)
+		util.WriteYAMLResponse(w, r, http.StatusOK, result)
+
+		return
+	}
+
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).Get(r.Context(), controlPlaneName, clusterName)
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
-	h.setCacheable(w)
+	h.setUncacheable(w)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1ProvidersOpenstackExternalNetworks(w http.ResponseWriter, r *http.Request) {
-	result, err := h.openstack.ListExternalNetworks(r)
+func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterNameCost(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	resource, err := cluster.NewClient(h.client, h.authenticator, h.provider).Get(r.Context(), controlPlaneName, clusterName)
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
-	h.setCacheable(w)
+	result, err := cost.NewClient(h.client, &h.options.Cost).Estimate(r.Context(), resource)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1ProvidersOpenstackFlavors(w http.ResponseWriter, r *http.Request) {
-	result, err := h.openstack.ListFlavors(r)
+func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummary(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).GetMetricsSummary(r.Context(), controlPlaneName, clusterName, h.metrics)
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
-	h.setCacheable(w)
+	h.setUncacheable(w)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1ProvidersOpenstackImages(w http.ResponseWriter, r *http.Request) {
-	result, err := h.openstack.ListImages(r)
+func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealth(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).GetHealth(r.Context(), controlPlaneName, clusterName)
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
 	}
 
-	h.setCacheable(w)
+	h.setUncacheable(w)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1ProvidersOpenstackKeyPairs(w http.ResponseWriter, r *http.Request) {
-	result, err := h.openstack.ListKeyPairs(r)
+func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimings(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).GetStageTimings(r.Context(), controlPlaneName, clusterName)
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
@@ -385,8 +644,8 @@ func (h *Handler) GetApiV1ProvidersOpenstackKeyPairs(w http.ResponseWriter, r *h
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
 
-func (h *Handler) GetApiV1ProvidersOpenstackProjects(w http.ResponseWriter, r *http.Request) {
-	result, err := h.openstack.ListAvailableProjects(r)
+func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrap(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter, workloadPoolName generated.WorkloadPoolNameParameter) {
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).GetWorkloadPoolBootstrap(r.Context(), controlPlaneName, clusterName, workloadPoolName)
 	if err != nil {
 		errors.HandleError(w, r, err)
 		return
@@ -395,3 +654,602 @@ func (h *Handler) GetApiV1ProvidersOpenstackProjects(w http.ResponseWriter, r *h
 	h.setUncacheable(w)
 	util.WriteJSONResponse(w, r, http.StatusOK, result)
 }
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpools(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	request := generated.KubernetesClusterWorkloadPoolOperations{}
+
+	if err := util.ReadJSONBody(r, &request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).MutateWorkloadPools(r.Context(), controlPlaneName, clusterName, request)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	status := http.StatusOK
+
+	if !result.Applied {
+		status = http.StatusUnprocessableEntity
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, status, result)
+}
+
+func (h *Handler) PutApiV1ControlplanesControlPlaneNameClustersClusterName(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	request := &generated.KubernetesCluster{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).Update(r.Context(), controlPlaneName, clusterName, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterNameKubeconfig(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).GetKubeconfig(r.Context(), controlPlaneName, clusterName)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteOctetStreamResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesUpgradeIDApprove(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter, upgradeID generated.UpgradeIDParameter) {
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).ApproveUpgrade(r.Context(), controlPlaneName, clusterName, upgradeID); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	request := &generated.UpgradeFreeze{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).FreezeUpgrades(r.Context(), controlPlaneName, clusterName, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).ThawUpgrades(r.Context(), controlPlaneName, clusterName); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).RotateCredentials(r.Context(), controlPlaneName, clusterName); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).GetCertificate(r.Context(), controlPlaneName, clusterName)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	request := &generated.KubernetesClusterCertificateOptions{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).UpdateCertificate(r.Context(), controlPlaneName, clusterName, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificate(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	request := &generated.SshPublicKey{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).IssueSSHCertificate(r.Context(), controlPlaneName, clusterName, request, &h.options.SSH)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	result, err := cluster.NewClient(h.client, h.authenticator, h.provider).GetNodeAllowList(r.Context(), controlPlaneName, clusterName)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	request := generated.NodeAllowList{}
+
+	if err := util.ReadJSONBody(r, &request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).SetNodeAllowList(r.Context(), controlPlaneName, clusterName, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).Hibernate(r.Context(), controlPlaneName, clusterName); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).Resume(r.Context(), controlPlaneName, clusterName); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade(w http.ResponseWriter, r *http.Request, controlPlaneName generated.ControlPlaneNameParameter, clusterName generated.ClusterNameParameter) {
+	request := &generated.KubernetesUpgrade{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := cluster.NewClient(h.client, h.authenticator, h.provider).UpgradeKubernetes(r.Context(), controlPlaneName, clusterName, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1ApplicationbundlesControlPlane(w http.ResponseWriter, r *http.Request) {
+	result, err := applicationbundle.NewClient(h.client).ListControlPlane(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1ApplicationbundlesCluster(w http.ResponseWriter, r *http.Request) {
+	result, err := applicationbundle.NewClient(h.client).ListCluster(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1Clustertemplates(w http.ResponseWriter, r *http.Request) {
+	result, err := clustertemplate.NewClient(h.client).List(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1Environments(w http.ResponseWriter, r *http.Request) {
+	request := &generated.Environment{}
+
+	if err := util.ReadJSONBody(r, request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := environment.NewClient(h.client, h.authenticator, h.provider).Create(r.Context(), request)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusAccepted, result)
+}
+
+func (h *Handler) GetApiV1EnvironmentsEnvironmentName(w http.ResponseWriter, r *http.Request, environmentName generated.EnvironmentNameParameter) {
+	result, err := environment.NewClient(h.client, h.authenticator, h.provider).Get(r.Context(), environmentName)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) DeleteApiV1EnvironmentsEnvironmentName(w http.ResponseWriter, r *http.Request, environmentName generated.EnvironmentNameParameter) {
+	if err := environment.NewClient(h.client, h.authenticator, h.provider).Delete(r.Context(), environmentName); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1Changelog(w http.ResponseWriter, r *http.Request) {
+	result, err := changelog.NewClient(h.client, h.state).List(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) PostApiV1ChangelogChangelogVersionAcknowledge(w http.ResponseWriter, r *http.Request, changelogVersion generated.ChangelogVersionParameter) {
+	if err := changelog.NewClient(h.client, h.state).Acknowledge(r.Context(), changelogVersion); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1Kubernetesversions(w http.ResponseWriter, r *http.Request) {
+	result, err := kubernetesversion.NewClient(h.client, h.provider, &h.options.KubernetesVersions).List(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1Activity(w http.ResponseWriter, r *http.Request, params generated.GetApiV1ActivityParams) {
+	result, err := activity.NewClient(h.client).List(r.Context(), &params)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1AdminDebugCapturesCaptureID(w http.ResponseWriter, r *http.Request, captureID generated.CaptureIDParameter) {
+	result, err := h.captures.Get(r.Context(), captureID)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1AdminDeprecations(w http.ResponseWriter, r *http.Request) {
+	result, err := h.deprecations.List(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1AdminMonitorShards(w http.ResponseWriter, r *http.Request) {
+	result, err := monitor.NewClient(h.client, &h.options.Monitor).Get(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1AdminExport(w http.ResponseWriter, r *http.Request, params generated.GetApiV1AdminExportParams) {
+	result, err := inventory.NewClient(h.client, h.redactor).Export(r.Context(), &params)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if result.Continue != nil {
+		w.Header().Set(inventory.ContinueHeader, *result.Continue)
+	}
+
+	h.setUncacheable(w)
+	util.WriteNDJSONResponse(w, r, http.StatusOK, result.Records)
+}
+
+func (h *Handler) PutApiV1AdminMonitorShards(w http.ResponseWriter, r *http.Request) {
+	request := generated.MonitorShardPins{}
+
+	if err := util.ReadJSONBody(r, &request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	if err := monitor.NewClient(h.client, &h.options.Monitor).Pin(r.Context(), request); err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) GetApiV1Applications(w http.ResponseWriter, r *http.Request) {
+	result, err := application.NewClient(h.client).List(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1Providers(w http.ResponseWriter, r *http.Request) {
+	result := &generated.Provider{
+		Type: h.provider.Type(),
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackAvailabilityZonesCompute(w http.ResponseWriter, r *http.Request) {
+	ctx, warnStale := providerContext(r)
+
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.provider.ListAvailabilityZonesCompute(ctx, identity)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	warnStale(w)
+	h.setCacheable(w)
+	util.WriteCacheableJSONResponse(w, r, http.StatusOK, convertAvailabilityZones(result))
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(w http.ResponseWriter, r *http.Request) {
+	ctx, warnStale := providerContext(r)
+
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.provider.ListAvailabilityZonesBlockStorage(ctx, identity)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	warnStale(w)
+	h.setCacheable(w)
+	util.WriteCacheableJSONResponse(w, r, http.StatusOK, convertAvailabilityZones(result))
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackExternalNetworks(w http.ResponseWriter, r *http.Request) {
+	identity, err := common.ProviderIdentity(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.provider.ListExternalNetworks(r.Context(), identity)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setCacheable(w)
+	util.WriteCacheableJSONResponse(w, r, http.StatusOK, convertExternalNetworks(result))
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackFlavors(w http.ResponseWriter, r *http.Request) {
+	ctx, warnStale := providerContext(r)
+
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.provider.ListFlavors(ctx, identity)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	warnStale(w)
+	h.setCacheable(w)
+	util.WriteCacheableJSONResponse(w, r, http.StatusOK, convertFlavors(result))
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImages(w http.ResponseWriter, r *http.Request, flavorName generated.FlavorNameParameter) {
+	ctx, warnStale := providerContext(r)
+
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.provider.ListCompatibleImages(ctx, identity, flavorName)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	warnStale(w)
+	h.setCacheable(w)
+	util.WriteCacheableJSONResponse(w, r, http.StatusOK, convertImages(result))
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackImages(w http.ResponseWriter, r *http.Request) {
+	ctx, warnStale := providerContext(r)
+
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.provider.ListImages(ctx, identity)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	warnStale(w)
+	h.setCacheable(w)
+	util.WriteCacheableJSONResponse(w, r, http.StatusOK, convertImages(result))
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackKeyPairs(w http.ResponseWriter, r *http.Request) {
+	identity, err := common.ProviderIdentity(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.provider.ListKeyPairs(r.Context(), identity)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, convertKeyPairs(result))
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackApplicationCredentialRoles(w http.ResponseWriter, r *http.Request) {
+	identity, err := common.ProviderIdentity(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.provider.GetApplicationCredentialRoles(r.Context(), identity)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, convertApplicationCredentialRoles(result))
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackQuotas(w http.ResponseWriter, r *http.Request) {
+	identity, err := common.ProviderIdentity(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.provider.GetQuotas(r.Context(), identity)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, convertQuotas(result))
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackHealth(w http.ResponseWriter, r *http.Request) {
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, convertHealth(h.provider.Health()))
+}
+
+func (h *Handler) GetApiV1ProvidersOpenstackProjects(w http.ResponseWriter, r *http.Request) {
+	identity, err := common.ProviderIdentity(r.Context())
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	result, err := h.provider.ListAvailableProjects(r.Context(), identity)
+	if err != nil {
+		errors.HandleError(w, r, err)
+		return
+	}
+
+	h.setUncacheable(w)
+	util.WriteJSONResponse(w, r, http.StatusOK, convertProjects(result))
+}
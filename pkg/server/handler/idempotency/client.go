@@ -0,0 +1,220 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idempotency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	goerrors "errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/state"
+)
+
+const (
+	// bucket is where requests are stored, keyed by user, project, operation
+	// and idempotency key.
+	bucket = "idempotency-keys"
+
+	// ttl is how long a completed request is remembered for.
+	ttl = 24 * time.Hour
+
+	// pendingTTL is how long a request is reserved for while it's being
+	// performed.  This bounds how long a key is unusable should the server
+	// exit before the request completes.
+	pendingTTL = time.Minute
+
+	// ReplayedHeader is set on responses to requests that were previously
+	// completed.
+	ReplayedHeader = "Idempotent-Replayed"
+)
+
+var (
+	// errReplayed aborts a reservation when the request has already completed.
+	errReplayed = goerrors.New("request replayed")
+)
+
+// record is stored for each request.
+type record struct {
+	// Fingerprint is a hash of the request body, and is used to detect
+	// key reuse across different requests.
+	Fingerprint string `json:"fingerprint"`
+
+	// Pending is set while the request is being performed.
+	Pending bool `json:"pending,omitempty"`
+}
+
+// Client wraps up idempotency key handling.
+type Client struct {
+	// state stores requests.
+	state state.Store
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(state state.Store) *Client {
+	return &Client{
+		state: state,
+	}
+}
+
+// stateKey returns a key scoped to the user, project and operation, so keys
+// generated by different clients cannot collide.
+func stateKey(r *http.Request, key generated.IdempotencyKeyParameter) (string, error) {
+	claims, err := oauth2.ClaimsFromContext(r.Context())
+	if err != nil {
+		return "", errors.OAuth2ServerError("failed get claims").WithError(err)
+	}
+
+	var project string
+
+	if claims.UnikornClaims != nil {
+		project = claims.UnikornClaims.Project
+	}
+
+	sum := sha256.Sum256([]byte(claims.Subject + "\x00" + project + "\x00" + r.URL.Path + "\x00" + key))
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// fingerprint returns a hash of the request body.  The body is restored so it
+// can be read by the handler.
+func fingerprint(r *http.Request) (string, error) {
+	if r.Body == nil {
+		return hex.EncodeToString(sha256.New().Sum(nil)), nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return "", errors.OAuth2ServerError("unable to read request body").WithError(err)
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	sum := sha256.Sum256(body)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Reserve atomically claims the key for this request, and must be followed by
+// either Complete or Release.  It returns true if a request with the same key
+// has already completed successfully, in which case the operation should not
+// be performed again.  If the key was used with a different request, or the
+// same request is still being performed, an error is returned.
+func (c *Client) Reserve(r *http.Request, key *generated.IdempotencyKeyParameter) (bool, error) {
+	if key == nil {
+		return false, nil
+	}
+
+	k, err := stateKey(r, *key)
+	if err != nil {
+		return false, err
+	}
+
+	f, err := fingerprint(r)
+	if err != nil {
+		return false, err
+	}
+
+	mutate := func(value []byte) ([]byte, error) {
+		if value == nil {
+			return json.Marshal(&record{Fingerprint: f, Pending: true})
+		}
+
+		existing := &record{}
+
+		if err := json.Unmarshal(value, existing); err != nil {
+			return nil, errors.OAuth2ServerError("failed to unmarshal idempotency key").WithError(err)
+		}
+
+		if existing.Fingerprint != f {
+			return nil, errors.HTTPUnprocessableEntity("idempotency key has already been used for a different request")
+		}
+
+		if existing.Pending {
+			return nil, errors.HTTPConflictWithDescription("a request with this idempotency key is in progress")
+		}
+
+		return nil, errReplayed
+	}
+
+	if err := c.state.Update(r.Context(), bucket, k, pendingTTL, mutate); err != nil {
+		if goerrors.Is(err, errReplayed) {
+			return true, nil
+		}
+
+		if errors.IsHTTPConflict(err) || errors.IsValidationError(err) {
+			return false, err
+		}
+
+		return false, errors.OAuth2ServerError("failed to reserve idempotency key").WithError(err)
+	}
+
+	return false, nil
+}
+
+// Complete records a reserved request as having completed successfully.
+func (c *Client) Complete(r *http.Request, key *generated.IdempotencyKeyParameter) error {
+	if key == nil {
+		return nil
+	}
+
+	k, err := stateKey(r, *key)
+	if err != nil {
+		return err
+	}
+
+	f, err := fingerprint(r)
+	if err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(&record{Fingerprint: f})
+	if err != nil {
+		return errors.OAuth2ServerError("failed to marshal idempotency key").WithError(err)
+	}
+
+	if err := c.state.Set(r.Context(), bucket, k, value, ttl); err != nil {
+		return errors.OAuth2ServerError("failed to set idempotency key").WithError(err)
+	}
+
+	return nil
+}
+
+// Release forgets a reserved request that failed, so it may be retried.
+func (c *Client) Release(r *http.Request, key *generated.IdempotencyKeyParameter) error {
+	if key == nil {
+		return nil
+	}
+
+	k, err := stateKey(r, *key)
+	if err != nil {
+		return err
+	}
+
+	if err := c.state.Delete(r.Context(), bucket, k); err != nil {
+		return errors.OAuth2ServerError("failed to release idempotency key").WithError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,486 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/logging"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/cluster"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+	coreconstants "github.com/eschercloudai/unikorn-core/pkg/constants"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ContinueHeader is returned when there are more records to export,
+	// and contains the cursor to request the next page with.
+	ContinueHeader = "X-Unikorn-Continue"
+
+	// defaultLimit is used when the client doesn't specify a page size.
+	defaultLimit = 100
+)
+
+// kinds defines the order records are exported in, this must not be
+// reordered as cursors encode the index.
+//
+//nolint:gochecknoglobals
+var kinds = []generated.InventoryRecordKind{
+	generated.InventoryRecordKindProject,
+	generated.InventoryRecordKindControlPlane,
+	generated.InventoryRecordKindKubernetesCluster,
+	generated.InventoryRecordKindApplicationCredential,
+	generated.InventoryRecordKindApplicationBundle,
+}
+
+// Client wraps up platform inventory export.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+
+	// redactor removes secrets from resource specifications.
+	redactor *logging.Redactor
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, redactor *logging.Redactor) *Client {
+	return &Client{
+		client:   client,
+		redactor: redactor,
+	}
+}
+
+// Page is a page of inventory records.
+type Page struct {
+	// Records are the inventory records.
+	Records generated.InventoryRecords
+
+	// Continue, if set, is the cursor to request the next page with.
+	Continue *string
+}
+
+// position uniquely identifies a record and defines the export order.
+type position struct {
+	// kind is the index of the record kind.
+	kind int
+
+	// key uniquely identifies the record within the kind.
+	key string
+}
+
+// compare orders records by kind, then key.
+func (p position) compare(o position) int {
+	if v := cmp.Compare(p.kind, o.kind); v != 0 {
+		return v
+	}
+
+	return cmp.Compare(p.key, o.key)
+}
+
+// encode returns an opaque cursor for the position.
+func (p position) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(p.kind) + "/" + p.key))
+}
+
+// decodePosition parses a cursor.
+func decodePosition(cursor string) (*position, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.OAuth2InvalidRequest("continue parameter invalid").WithError(err)
+	}
+
+	kind, key, ok := strings.Cut(string(data), "/")
+	if !ok {
+		return nil, errors.OAuth2InvalidRequest("continue parameter invalid")
+	}
+
+	k, err := strconv.Atoi(kind)
+	if err != nil || k < 0 || k >= len(kinds) {
+		return nil, errors.OAuth2InvalidRequest("continue parameter invalid")
+	}
+
+	p := &position{
+		kind: k,
+		key:  key,
+	}
+
+	return p, nil
+}
+
+// record is an inventory record and its position in the export.
+type record struct {
+	position position
+	record   generated.InventoryRecord
+}
+
+// newRecord returns a record of the given kind.
+func newRecord(kind generated.InventoryRecordKind, key string) *record {
+	return &record{
+		position: position{
+			kind: slices.Index(kinds, kind),
+			key:  key,
+		},
+		record: generated.InventoryRecord{
+			Kind: kind,
+		},
+	}
+}
+
+// resource is a project, control plane or cluster.
+type resource interface {
+	client.Object
+
+	StatusConditionRead(t coreunikornv1.ConditionType) (*coreunikornv1.Condition, error)
+}
+
+// convertStatus converts from a custom resource into the API definition.
+func convertStatus(in resource) generated.KubernetesResourceStatus {
+	out := generated.KubernetesResourceStatus{
+		Name:         in.GetName(),
+		CreationTime: in.GetCreationTimestamp().Time,
+		Status:       "Unknown",
+	}
+
+	if t := in.GetDeletionTimestamp(); t != nil {
+		out.DeletionTime = &t.Time
+	}
+
+	if condition, err := in.StatusConditionRead(coreunikornv1.ConditionAvailable); err == nil {
+		out.Status = string(condition.Reason)
+	}
+
+	return out
+}
+
+// labelValue returns a pointer to a label value, or nil if not set.
+func labelValue(in resource, label string) *string {
+	value, ok := in.GetLabels()[label]
+	if !ok {
+		return nil
+	}
+
+	return &value
+}
+
+// redact converts a resource specification into a generic object, removing
+// any secrets e.g. cloud configurations.
+func (c *Client) redact(spec interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to marshal specification").WithError(err)
+	}
+
+	out := map[string]interface{}{}
+
+	if err := json.Unmarshal(c.redactor.JSON(data), &out); err != nil {
+		return nil, errors.OAuth2ServerError("failed to unmarshal specification").WithError(err)
+	}
+
+	return out, nil
+}
+
+// newResourceRecord returns a record for a project, control plane or cluster.
+func (c *Client) newResourceRecord(kind generated.InventoryRecordKind, in resource, spec interface{}) (*record, error) {
+	redacted, err := c.redact(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newRecord(kind, client.ObjectKeyFromObject(in).String())
+
+	r.record.Resource = &generated.InventoryResource{
+		Status: convertStatus(in),
+		Spec:   redacted,
+	}
+
+	return r, nil
+}
+
+// projects returns all project records.
+func (c *Client) projects(ctx context.Context) ([]*record, error) {
+	projects := &unikornv1.ProjectList{}
+
+	if err := c.client.List(ctx, projects); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list projects").WithError(err)
+	}
+
+	records := make([]*record, len(projects.Items))
+
+	for i := range projects.Items {
+		project := &projects.Items[i]
+
+		r, err := c.newResourceRecord(generated.InventoryRecordKindProject, project, project.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		records[i] = r
+	}
+
+	return records, nil
+}
+
+// controlPlanes returns all control plane records.
+func (c *Client) controlPlanes(ctx context.Context, controlPlanes *unikornv1.ControlPlaneList) ([]*record, error) {
+	records := make([]*record, len(controlPlanes.Items))
+
+	for i := range controlPlanes.Items {
+		controlPlane := &controlPlanes.Items[i]
+
+		r, err := c.newResourceRecord(generated.InventoryRecordKindControlPlane, controlPlane, controlPlane.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		r.record.Resource.Project = labelValue(controlPlane, coreconstants.ProjectLabel)
+		r.record.Resource.ApplicationBundle = controlPlane.Spec.ApplicationBundle
+
+		records[i] = r
+	}
+
+	return records, nil
+}
+
+// clusters returns all cluster and application credential records.
+func (c *Client) clusters(clusters *unikornv1.KubernetesClusterList) ([]*record, error) {
+	var records []*record
+
+	for i := range clusters.Items {
+		kubernetesCluster := &clusters.Items[i]
+
+		r, err := c.newResourceRecord(generated.InventoryRecordKindKubernetesCluster, kubernetesCluster, kubernetesCluster.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		r.record.Resource.Project = labelValue(kubernetesCluster, coreconstants.ProjectLabel)
+		r.record.Resource.ControlPlane = labelValue(kubernetesCluster, coreconstants.ControlPlaneLabel)
+		r.record.Resource.ApplicationBundle = kubernetesCluster.Spec.ApplicationBundle
+
+		records = append(records, r)
+
+		id, err := cluster.ApplicationCredentialID(kubernetesCluster)
+		if err != nil {
+			return nil, err
+		}
+
+		if id == "" {
+			continue
+		}
+
+		r = newRecord(generated.InventoryRecordKindApplicationCredential, client.ObjectKeyFromObject(kubernetesCluster).String())
+
+		r.record.ApplicationCredential = &generated.InventoryApplicationCredential{
+			Id:                id,
+			Project:           kubernetesCluster.Labels[coreconstants.ProjectLabel],
+			ControlPlane:      kubernetesCluster.Labels[coreconstants.ControlPlaneLabel],
+			KubernetesCluster: kubernetesCluster.Name,
+		}
+
+		records = append(records, r)
+	}
+
+	return records, nil
+}
+
+// newApplicationBundleRecord returns a record for an application bundle.
+func newApplicationBundleRecord(name string, spec *unikornv1.ApplicationBundleSpec, resources int) *record {
+	r := newRecord(generated.InventoryRecordKindApplicationBundle, name)
+
+	r.record.ApplicationBundle = &generated.InventoryApplicationBundle{
+		Name:      name,
+		Resources: resources,
+	}
+
+	if spec.Version != nil {
+		r.record.ApplicationBundle.Version = *spec.Version
+	}
+
+	if spec.EndOfLife != nil {
+		r.record.ApplicationBundle.EndOfLife = &spec.EndOfLife.Time
+	}
+
+	return r
+}
+
+// applicationBundles returns records for all bundles that are in use.
+func (c *Client) applicationBundles(ctx context.Context, controlPlanes *unikornv1.ControlPlaneList, clusters *unikornv1.KubernetesClusterList) ([]*record, error) {
+	usage := map[string]int{}
+
+	for i := range controlPlanes.Items {
+		if bundle := controlPlanes.Items[i].Spec.ApplicationBundle; bundle != nil {
+			usage[*bundle]++
+		}
+	}
+
+	for i := range clusters.Items {
+		if bundle := clusters.Items[i].Spec.ApplicationBundle; bundle != nil {
+			usage[*bundle]++
+		}
+	}
+
+	controlPlaneBundles := &unikornv1.ControlPlaneApplicationBundleList{}
+
+	if err := c.client.List(ctx, controlPlaneBundles); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list control plane application bundles").WithError(err)
+	}
+
+	clusterBundles := &unikornv1.KubernetesClusterApplicationBundleList{}
+
+	if err := c.client.List(ctx, clusterBundles); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list cluster application bundles").WithError(err)
+	}
+
+	var records []*record
+
+	for i := range controlPlaneBundles.Items {
+		bundle := &controlPlaneBundles.Items[i]
+
+		if resources := usage[bundle.Name]; resources != 0 {
+			records = append(records, newApplicationBundleRecord(bundle.Name, &bundle.Spec, resources))
+		}
+	}
+
+	for i := range clusterBundles.Items {
+		bundle := &clusterBundles.Items[i]
+
+		if resources := usage[bundle.Name]; resources != 0 {
+			records = append(records, newApplicationBundleRecord(bundle.Name, &bundle.Spec.ApplicationBundleSpec, resources))
+		}
+	}
+
+	return records, nil
+}
+
+// records returns all inventory records in export order.
+func (c *Client) records(ctx context.Context) ([]*record, error) {
+	controlPlanes := &unikornv1.ControlPlaneList{}
+
+	if err := c.client.List(ctx, controlPlanes); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list control planes").WithError(err)
+	}
+
+	clusters := &unikornv1.KubernetesClusterList{}
+
+	if err := c.client.List(ctx, clusters); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list clusters").WithError(err)
+	}
+
+	projectRecords, err := c.projects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	controlPlaneRecords, err := c.controlPlanes(ctx, controlPlanes)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterRecords, err := c.clusters(clusters)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationBundleRecords, err := c.applicationBundles(ctx, controlPlanes, clusters)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*record
+
+	records = append(records, projectRecords...)
+	records = append(records, controlPlaneRecords...)
+	records = append(records, clusterRecords...)
+	records = append(records, applicationBundleRecords...)
+
+	slices.SortFunc(records, func(a, b *record) int {
+		return a.position.compare(b.position)
+	})
+
+	return records, nil
+}
+
+// Export returns a page of the platform inventory.  Pages are keyed on the
+// last record returned, rather than an offset, so resources being created or
+// deleted during an export don't cause records to be skipped or repeated.
+func (c *Client) Export(ctx context.Context, params *generated.GetApiV1AdminExportParams) (*Page, error) {
+	limit := defaultLimit
+
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var after *position
+
+	if params.Continue != nil {
+		p, err := decodePosition(*params.Continue)
+		if err != nil {
+			return nil, err
+		}
+
+		after = p
+	}
+
+	records, err := c.records(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := 0
+
+	if after != nil {
+		start, _ = slices.BinarySearchFunc(records, *after, func(r *record, p position) int {
+			// Treat an exact match as less than, so we start after it.
+			if v := r.position.compare(p); v != 0 {
+				return v
+			}
+
+			return -1
+		})
+	}
+
+	end := min(start+limit, len(records))
+
+	result := &Page{
+		Records: make(generated.InventoryRecords, end-start),
+	}
+
+	for i, r := range records[start:end] {
+		r.record.Cursor = r.position.encode()
+
+		result.Records[i] = r.record
+	}
+
+	if end < len(records) {
+		next := records[end-1].position.encode()
+
+		result.Continue = &next
+	}
+
+	return result, nil
+}
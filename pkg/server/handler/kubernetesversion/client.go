@@ -0,0 +1,255 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesversion
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/common"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// PolicyKey is the config map key that contains the version policy.
+	PolicyKey = "versions.yaml"
+)
+
+// Options allow the version policy to be configured.
+type Options struct {
+	// Namespace is the namespace the policy resides in.
+	Namespace string
+
+	// Name is the name of the config map containing the policy.
+	Name string
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.StringVar(&o.Namespace, "kubernetes-version-policy-namespace", "", "Namespace of the config map containing the Kubernetes version policy.")
+	f.StringVar(&o.Name, "kubernetes-version-policy-name", "", "Name of the config map containing the Kubernetes version policy, versions are only derived from images if not set.")
+}
+
+// Policy allows the operator to override the support phase of versions.
+// Versions are matched on whole components, so "v1.27" matches all v1.27
+// patch releases, but not v1.270.0.  Where a version matches both, the
+// removed soon phase takes precedence.
+type Policy struct {
+	// Deprecated versions should be upgraded from.
+	Deprecated []string `json:"deprecated,omitempty"`
+
+	// RemovedSoon versions will shortly no longer be available.
+	RemovedSoon []string `json:"removedSoon,omitempty"`
+}
+
+// Client wraps up Kubernetes version related management handling.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+
+	// provider allows images to be listed.
+	provider providers.CloudProvider
+
+	// options define where the policy lives.
+	options *Options
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, provider providers.CloudProvider, options *Options) *Client {
+	return &Client{
+		client:   client,
+		provider: provider,
+		options:  options,
+	}
+}
+
+// getPolicy reads the policy from Kubernetes, this is done on demand so it
+// can be updated without a restart.  A nil policy means no overrides.
+func (c *Client) getPolicy(ctx context.Context) (*Policy, error) {
+	if c.options.Name == "" {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: c.options.Namespace, Name: c.options.Name}, configMap); err != nil {
+		if kerrors.IsNotFound(err) {
+			//nolint:nilnil
+			return nil, nil
+		}
+
+		return nil, errors.OAuth2ServerError("failed to read kubernetes version policy").WithError(err)
+	}
+
+	data, ok := configMap.Data[PolicyKey]
+	if !ok {
+		return nil, errors.OAuth2ServerError("kubernetes version policy missing " + PolicyKey)
+	}
+
+	policy := &Policy{}
+
+	if err := yaml.Unmarshal([]byte(data), policy); err != nil {
+		return nil, errors.OAuth2ServerError("failed to parse kubernetes version policy").WithError(err)
+	}
+
+	return policy, nil
+}
+
+// version is a parsed semantic version.
+type version struct {
+	// name is the original version string.
+	name string
+
+	// components are the numerical version components.
+	components []int
+}
+
+// parseVersion converts a version e.g. v1.28.0 into its numerical components.
+func parseVersion(name string) (*version, bool) {
+	fields := strings.Split(strings.TrimPrefix(name, "v"), ".")
+
+	components := make([]int, len(fields))
+
+	for i, field := range fields {
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, false
+		}
+
+		components[i] = value
+	}
+
+	return &version{
+		name:       name,
+		components: components,
+	}, true
+}
+
+// sameMinor returns true if the versions share a major and minor version.
+func (v *version) sameMinor(o *version) bool {
+	if len(v.components) < 2 || len(o.components) < 2 {
+		return false
+	}
+
+	return slices.Equal(v.components[:2], o.components[:2])
+}
+
+// matches returns true if the version matches any of the patterns.
+func (v *version) matches(patterns []string) bool {
+	for _, pattern := range patterns {
+		if v.name == pattern || strings.HasPrefix(v.name, pattern+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compareVersions orders versions newest first.
+func compareVersions(a, b *version) int {
+	return slices.Compare(b.components, a.components)
+}
+
+// phase returns the support phase of a version.  Without any operator
+// overrides, only the newest patch release of a minor version is supported,
+// as older ones will lack bug and security fixes.
+func phase(v, newest *version, policy *Policy) generated.KubernetesVersionPhase {
+	if policy != nil {
+		if v.matches(policy.RemovedSoon) {
+			return generated.RemovedSoon
+		}
+
+		if v.matches(policy.Deprecated) {
+			return generated.Deprecated
+		}
+	}
+
+	if v != newest {
+		return generated.Deprecated
+	}
+
+	return generated.Supported
+}
+
+// List returns all Kubernetes versions that have images available.
+func (c *Client) List(ctx context.Context) (generated.KubernetesVersions, error) {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := c.provider.ListImages(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := c.getPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(images))
+
+	for i := range images {
+		names[i] = images[i].KubernetesVersion
+	}
+
+	slices.Sort(names)
+
+	var versions []*version
+
+	// Images without valid version metadata cannot be provisioned, so are ignored.
+	for _, name := range slices.Compact(names) {
+		if v, ok := parseVersion(name); ok {
+			versions = append(versions, v)
+		}
+	}
+
+	slices.SortStableFunc(versions, compareVersions)
+
+	out := make(generated.KubernetesVersions, len(versions))
+
+	// As versions are ordered newest first, the first of each minor version
+	// is the newest patch release.
+	var newest *version
+
+	for i, v := range versions {
+		if newest == nil || !newest.sameMinor(v) {
+			newest = v
+		}
+
+		out[i] = generated.KubernetesVersion{
+			Version: v.name,
+			Phase:   phase(v, newest, policy),
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/eschercloudai/unikorn/pkg/server/authorization"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/keystone"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/common"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+)
+
+// Client wraps up project member management handling.
+type Client struct {
+	// authenticator provides the mapping between Keystone and project roles.
+	authenticator *authorization.Authenticator
+
+	// provider provides access to project role assignments.
+	provider providers.CloudProvider
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(authenticator *authorization.Authenticator, provider providers.CloudProvider) *Client {
+	return &Client{
+		authenticator: authenticator,
+		provider:      provider,
+	}
+}
+
+// member is a user with one or more Keystone roles on the project that map
+// onto a project role.
+type member struct {
+	// id is the user ID.
+	id string
+
+	// name is the user name.
+	name string
+
+	// roles are the Keystone role IDs that map onto a project role.
+	roles []string
+
+	// role is the most privileged project role.
+	role keystone.ProjectRole
+}
+
+// members returns all project members keyed by user ID.
+func (c *Client) members(ctx context.Context, identity *providers.Identity) (map[string]*member, error) {
+	assignments, err := c.provider.ListProjectRoleAssignments(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	members := map[string]*member{}
+	roleNames := map[string][]string{}
+
+	for _, assignment := range assignments {
+		if !c.authenticator.Keystone.IsProjectRole(assignment.Role.Name) {
+			continue
+		}
+
+		m, ok := members[assignment.User.ID]
+		if !ok {
+			m = &member{
+				id:   assignment.User.ID,
+				name: assignment.User.Name,
+			}
+
+			members[assignment.User.ID] = m
+		}
+
+		m.roles = append(m.roles, assignment.Role.ID)
+		roleNames[m.id] = append(roleNames[m.id], assignment.Role.Name)
+	}
+
+	for id, m := range members {
+		m.role, _ = c.authenticator.Keystone.ProjectRole(roleNames[id])
+	}
+
+	return members, nil
+}
+
+// get returns a project member.
+func (c *Client) get(members map[string]*member, userID string) (*member, error) {
+	m, ok := members[userID]
+	if !ok {
+		return nil, errors.HTTPNotFound()
+	}
+
+	return m, nil
+}
+
+// checkAdministrators ensures that an operation doesn't leave the project
+// without an administrator, at which point no one could manage members.
+func checkAdministrators(members map[string]*member, userID string, role *keystone.ProjectRole) error {
+	for _, m := range members {
+		if m.id == userID {
+			if role != nil && *role == keystone.ProjectRoleAdmin {
+				return nil
+			}
+
+			continue
+		}
+
+		if m.role == keystone.ProjectRoleAdmin {
+			return nil
+		}
+	}
+
+	return errors.OAuth2InvalidRequest("project must retain an administrator")
+}
+
+// keystoneRoleID returns the Keystone role ID to assign for a project role.
+func (c *Client) keystoneRoleID(ctx context.Context, identity *providers.Identity, role generated.ProjectRole) (string, error) {
+	name, ok := c.authenticator.Keystone.KeystoneRole(keystone.ProjectRole(role))
+	if !ok {
+		return "", errors.OAuth2InvalidRequest("project role is not mapped to a keystone role")
+	}
+
+	keystoneRole, err := c.provider.GetRole(ctx, identity, name)
+	if err != nil {
+		return "", err
+	}
+
+	return keystoneRole.ID, nil
+}
+
+// List returns all project members.
+func (c *Client) List(ctx context.Context) (generated.ProjectMembers, error) {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := c.members(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(generated.ProjectMembers, 0, len(members))
+
+	for _, m := range members {
+		out = append(out, generated.ProjectMember{
+			UserId:   m.id,
+			UserName: m.name,
+			Role:     generated.ProjectRole(m.role),
+		})
+	}
+
+	slices.SortFunc(out, func(a, b generated.ProjectMember) int {
+		return cmp.Compare(a.UserName, b.UserName)
+	})
+
+	return out, nil
+}
+
+// Invite adds a user to the project.
+func (c *Client) Invite(ctx context.Context, request *generated.ProjectMemberInvitation) error {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := c.provider.FindUser(ctx, identity, request.UserName)
+	if err != nil {
+		return err
+	}
+
+	members, err := c.members(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := members[user.ID]; ok {
+		return errors.HTTPConflict()
+	}
+
+	roleID, err := c.keystoneRoleID(ctx, identity, request.Role)
+	if err != nil {
+		return err
+	}
+
+	return c.provider.AssignProjectRole(ctx, identity, user.ID, roleID)
+}
+
+// Update replaces a member's project role.
+func (c *Client) Update(ctx context.Context, userID generated.UserIDParameter, request *generated.ProjectMemberRole) error {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	members, err := c.members(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	m, err := c.get(members, userID)
+	if err != nil {
+		return err
+	}
+
+	role := keystone.ProjectRole(request.Role)
+
+	if err := checkAdministrators(members, userID, &role); err != nil {
+		return err
+	}
+
+	roleID, err := c.keystoneRoleID(ctx, identity, request.Role)
+	if err != nil {
+		return err
+	}
+
+	// Grant the new role first, so the user never loses access entirely.
+	if !slices.Contains(m.roles, roleID) {
+		if err := c.provider.AssignProjectRole(ctx, identity, userID, roleID); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range m.roles {
+		if id == roleID {
+			continue
+		}
+
+		if err := c.provider.UnassignProjectRole(ctx, identity, userID, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Remove removes a member from the project.  Only roles that map onto project
+// roles are revoked, any others are left alone.
+func (c *Client) Remove(ctx context.Context, userID generated.UserIDParameter) error {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	members, err := c.members(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	m, err := c.get(members, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkAdministrators(members, userID, nil); err != nil {
+		return err
+	}
+
+	for _, id := range m.roles {
+		if err := c.provider.UnassignProjectRole(ctx, identity, userID, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
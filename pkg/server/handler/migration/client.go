@@ -0,0 +1,417 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"slices"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/cluster"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/common"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/controlplane"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/project"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+
+	coreconstants "github.com/eschercloudai/unikorn-core/pkg/constants"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// argoCDNamespace is where continuous delivery applications live.
+	argoCDNamespace = "argocd"
+)
+
+// Client wraps up control plane migration handling.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+
+	// authenticator provides access to authentication services.
+	authenticator *authorization.Authenticator
+
+	// provider is required to check project membership and reissue credentials.
+	provider providers.CloudProvider
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, authenticator *authorization.Authenticator, provider providers.CloudProvider) *Client {
+	return &Client{
+		client:        client,
+		authenticator: authenticator,
+		provider:      provider,
+	}
+}
+
+// getTargetProject returns the project a control plane is being moved into.
+// The user must be a member of the Openstack project, and it must be able to
+// accept new control planes.
+func (c *Client) getTargetProject(ctx context.Context, projectID string) (*project.Meta, error) {
+	identity, err := common.ProviderIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := c.provider.ListAvailableProjects(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	member := func(p providers.Project) bool {
+		return p.ID == projectID
+	}
+
+	if !slices.ContainsFunc(projects, member) {
+		return nil, errors.HTTPForbidden("not a member of the target project")
+	}
+
+	name := project.Name(projectID)
+
+	result := &unikornv1.Project{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Name: name}, result); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.OAuth2InvalidRequest("target project does not exist")
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get project").WithError(err)
+	}
+
+	if result.DeletionTimestamp != nil {
+		return nil, errors.OAuth2InvalidRequest("target project is being deleted")
+	}
+
+	if result.Spec.Offboarding != nil {
+		return nil, errors.OAuth2InvalidRequest("target project is being offboarded")
+	}
+
+	if result.Status.Namespace == "" {
+		return nil, errors.OAuth2InvalidRequest("target project is not provisioned")
+	}
+
+	metadata := &project.Meta{
+		Name:      name,
+		Namespace: result.Status.Namespace,
+	}
+
+	return metadata, nil
+}
+
+// pause stops reconciliation of a resource while it's being moved.  Resources
+// that are already paused are left alone, so they aren't resumed by accident.
+func (c *Client) pause(ctx context.Context, object client.Object, pause *bool, target string) error {
+	if *pause && object.GetAnnotations()[constants.MigrationAnnotation] == "" {
+		return nil
+	}
+
+	original, ok := object.DeepCopyObject().(client.Object)
+	if !ok {
+		return errors.OAuth2ServerError("failed to copy resource")
+	}
+
+	*pause = true
+
+	annotations := object.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[constants.MigrationAnnotation] = target
+
+	object.SetAnnotations(annotations)
+
+	if err := c.client.Patch(ctx, object, client.MergeFrom(original)); err != nil {
+		return errors.OAuth2ServerError("failed to pause resource").WithError(err)
+	}
+
+	return nil
+}
+
+// resume restarts reconciliation of a resource once it's been moved.
+func (c *Client) resume(ctx context.Context, object client.Object, pause *bool) error {
+	if object.GetAnnotations()[constants.MigrationAnnotation] == "" {
+		return nil
+	}
+
+	original, ok := object.DeepCopyObject().(client.Object)
+	if !ok {
+		return errors.OAuth2ServerError("failed to copy resource")
+	}
+
+	*pause = false
+
+	annotations := object.GetAnnotations()
+	delete(annotations, constants.MigrationAnnotation)
+
+	object.SetAnnotations(annotations)
+
+	if err := c.client.Patch(ctx, object, client.MergeFrom(original)); err != nil {
+		return errors.OAuth2ServerError("failed to resume resource").WithError(err)
+	}
+
+	return nil
+}
+
+// relabel moves a resource into the target project.  Provisioners locate
+// namespaces, and continuous delivery applications, by label so these must
+// be updated before reconciliation is resumed.
+func (c *Client) relabel(ctx context.Context, object client.Object, target string) error {
+	if object.GetLabels()[coreconstants.ProjectLabel] == target {
+		return nil
+	}
+
+	original, ok := object.DeepCopyObject().(client.Object)
+	if !ok {
+		return errors.OAuth2ServerError("failed to copy resource")
+	}
+
+	labels := object.GetLabels()
+	labels[coreconstants.ProjectLabel] = target
+
+	object.SetLabels(labels)
+
+	if err := c.client.Patch(ctx, object, client.MergeFrom(original)); err != nil {
+		return errors.OAuth2ServerError("failed to relabel resource").WithError(err)
+	}
+
+	return nil
+}
+
+// relabelDescendents moves the control plane's namespaces, and those of its
+// clusters, plus any continuous delivery applications into the target project.
+func (c *Client) relabelDescendents(ctx context.Context, source, target, name string) error {
+	selector := labels.SelectorFromSet(labels.Set{
+		coreconstants.ProjectLabel:      source,
+		coreconstants.ControlPlaneLabel: name,
+	})
+
+	namespaces := &corev1.NamespaceList{}
+
+	if err := c.client.List(ctx, namespaces, &client.ListOptions{LabelSelector: selector}); err != nil {
+		return errors.OAuth2ServerError("failed to list namespaces").WithError(err)
+	}
+
+	for i := range namespaces.Items {
+		if err := c.relabel(ctx, &namespaces.Items[i], target); err != nil {
+			return err
+		}
+	}
+
+	applications := &unstructured.UnstructuredList{}
+	applications.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "argoproj.io",
+		Version: "v1alpha1",
+		Kind:    "ApplicationList",
+	})
+
+	if err := c.client.List(ctx, applications, &client.ListOptions{Namespace: argoCDNamespace, LabelSelector: selector}); err != nil {
+		return errors.OAuth2ServerError("failed to list applications").WithError(err)
+	}
+
+	for i := range applications.Items {
+		if err := c.relabel(ctx, &applications.Items[i], target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createControlPlane creates the control plane in the target project, inheriting
+// the specification, and therefore the existing namespace and clusters, of the
+// source.  This is tolerant of the control plane existing from an earlier attempt.
+func (c *Client) createControlPlane(ctx context.Context, in *unikornv1.ControlPlane, target *project.Meta) error {
+	controlPlane := &unikornv1.ControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      in.Name,
+			Namespace: target.Namespace,
+			Labels: map[string]string{
+				coreconstants.VersionLabel: coreconstants.Version,
+				coreconstants.ProjectLabel: target.Name,
+			},
+		},
+		Spec: *in.Spec.DeepCopy(),
+	}
+
+	// Only resume reconciliation if we paused it, the user may have done
+	// so for good reason.
+	if in.Annotations[constants.MigrationAnnotation] != "" {
+		controlPlane.Spec.Pause = false
+	}
+
+	if err := c.client.Create(ctx, controlPlane); err != nil && !kerrors.IsAlreadyExists(err) {
+		return errors.OAuth2ServerError("failed to create control plane").WithError(err)
+	}
+
+	return nil
+}
+
+// deleteControlPlane deletes the source control plane.  Finalizers are removed
+// first, as the resources it manages now belong to the target project and must
+// not be deprovisioned.
+func (c *Client) deleteControlPlane(ctx context.Context, in *unikornv1.ControlPlane) error {
+	temp := in.DeepCopy()
+
+	if controllerutil.RemoveFinalizer(temp, coreconstants.Finalizer) {
+		if err := c.client.Patch(ctx, temp, client.MergeFrom(in)); err != nil {
+			return errors.OAuth2ServerError("failed to patch control plane").WithError(err)
+		}
+	}
+
+	if err := c.client.Delete(ctx, temp); err != nil && !kerrors.IsNotFound(err) {
+		return errors.OAuth2ServerError("failed to delete control plane").WithError(err)
+	}
+
+	return nil
+}
+
+// Move moves a control plane, and all of its clusters, into another project.
+// Reconciliation is paused for the duration so provisioners don't act on
+// partially moved resources.  Every step is idempotent, so should the move
+// fail, the control plane is left paused and the request can be retried.
+func (c *Client) Move(ctx context.Context, name generated.ControlPlaneNameParameter, request *generated.ControlPlaneMove) error {
+	source, err := project.NewClient(c.client).GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	if source.Deleting {
+		return errors.OAuth2InvalidRequest("project is being deleted")
+	}
+
+	if source.Name == project.Name(request.Project) {
+		return errors.OAuth2InvalidRequest("control plane is already in the target project")
+	}
+
+	target, err := c.getTargetProject(ctx, request.Project)
+	if err != nil {
+		return err
+	}
+
+	controlPlane := &unikornv1.ControlPlane{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: source.Namespace, Name: name}, controlPlane); err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.HTTPNotFound().WithError(err)
+		}
+
+		return errors.OAuth2ServerError("failed to get control plane").WithError(err)
+	}
+
+	if controlPlane.DeletionTimestamp != nil {
+		return errors.OAuth2InvalidRequest("control plane is being deleted")
+	}
+
+	if controlPlane.Status.Namespace == "" {
+		return errors.OAuth2InvalidRequest("control plane is not provisioned")
+	}
+
+	// A control plane of the same name in the target is only expected if
+	// created by an earlier attempt to move this one.
+	if controlPlane.Annotations[constants.MigrationAnnotation] != target.Name {
+		existing := &unikornv1.ControlPlane{}
+
+		if err := c.client.Get(ctx, client.ObjectKey{Namespace: target.Namespace, Name: name}, existing); err == nil {
+			return errors.HTTPConflictWithDescription("control plane already exists in the target project")
+		} else if !kerrors.IsNotFound(err) {
+			return errors.OAuth2ServerError("failed to get control plane").WithError(err)
+		}
+	}
+
+	clusters := &unikornv1.KubernetesClusterList{}
+
+	if err := c.client.List(ctx, clusters, &client.ListOptions{Namespace: controlPlane.Status.Namespace}); err != nil {
+		return errors.OAuth2ServerError("failed to list clusters").WithError(err)
+	}
+
+	// Deprovisioning uses the cluster's credentials, which are about to be
+	// revoked, so wait for these to go away.
+	for i := range clusters.Items {
+		if clusters.Items[i].DeletionTimestamp != nil {
+			return errors.OAuth2InvalidRequest("clusters are being deleted")
+		}
+	}
+
+	if err := c.pause(ctx, controlPlane, &controlPlane.Spec.Pause, target.Name); err != nil {
+		return err
+	}
+
+	for i := range clusters.Items {
+		resource := &clusters.Items[i]
+
+		if err := c.pause(ctx, resource, &resource.Spec.Pause, target.Name); err != nil {
+			return err
+		}
+	}
+
+	if err := c.relabelDescendents(ctx, source.Name, target.Name, name); err != nil {
+		return err
+	}
+
+	for i := range clusters.Items {
+		if err := c.relabel(ctx, &clusters.Items[i], target.Name); err != nil {
+			return err
+		}
+	}
+
+	moved := &controlplane.Meta{
+		Project:   target,
+		Name:      name,
+		Namespace: controlPlane.Status.Namespace,
+	}
+
+	if err := cluster.NewClient(c.client, c.authenticator, c.provider).ReissueCredentials(ctx, moved, source.Name); err != nil {
+		return err
+	}
+
+	if err := c.createControlPlane(ctx, controlPlane, target); err != nil {
+		return err
+	}
+
+	if err := c.deleteControlPlane(ctx, controlPlane); err != nil {
+		return err
+	}
+
+	// Reissuing credentials will have updated the clusters, so get the
+	// latest versions.
+	if err := c.client.List(ctx, clusters, &client.ListOptions{Namespace: controlPlane.Status.Namespace}); err != nil {
+		return errors.OAuth2ServerError("failed to list clusters").WithError(err)
+	}
+
+	for i := range clusters.Items {
+		resource := &clusters.Items[i]
+
+		if err := c.resume(ctx, resource, &resource.Spec.Pause); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
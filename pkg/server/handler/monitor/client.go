@@ -0,0 +1,231 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitor
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/spf13/pflag"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/monitor/shard"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Options allow the monitor shards to be located.
+type Options struct {
+	// Namespace is the namespace monitor shard membership leases reside in.
+	Namespace string
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.StringVar(&o.Namespace, "monitor-shard-namespace", "", "Namespace of monitor shard membership leases, no replicas are reported if not set.")
+}
+
+// Client wraps up monitor shard related management handling.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+
+	// options define where the shards live.
+	options *Options
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, options *Options) *Client {
+	return &Client{
+		client:  client,
+		options: options,
+	}
+}
+
+// members returns all live monitor replicas.
+func (c *Client) members(ctx context.Context) ([]shard.Member, error) {
+	if c.options.Namespace == "" {
+		return []shard.Member{}, nil
+	}
+
+	members, err := shard.Members(ctx, c.client, c.options.Namespace)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed to list monitor shard members").WithError(err)
+	}
+
+	return members, nil
+}
+
+// convertAssignment returns the assignment of a resource to a monitor replica.
+func convertAssignment(kind generated.MonitorShardResourceKind, members []shard.Member, object client.Object) generated.MonitorShardAssignment {
+	replica, pinned := shard.Assign(members, object)
+
+	out := generated.MonitorShardAssignment{
+		Kind:      kind,
+		Namespace: object.GetNamespace(),
+		Name:      object.GetName(),
+		Pinned:    pinned,
+	}
+
+	if replica != "" {
+		out.Replica = &replica
+	}
+
+	return out
+}
+
+// compareAssignments orders assignments by kind, namespace then name.
+func compareAssignments(a, b generated.MonitorShardAssignment) int {
+	if v := cmp.Compare(a.Kind, b.Kind); v != 0 {
+		return v
+	}
+
+	if v := cmp.Compare(a.Namespace, b.Namespace); v != 0 {
+		return v
+	}
+
+	return cmp.Compare(a.Name, b.Name)
+}
+
+// Get returns the live monitor replicas and what resources are assigned to them.
+func (c *Client) Get(ctx context.Context) (*generated.MonitorShards, error) {
+	members, err := c.members(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	controlPlanes := &unikornv1.ControlPlaneList{}
+
+	if err := c.client.List(ctx, controlPlanes); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list control planes").WithError(err)
+	}
+
+	clusters := &unikornv1.KubernetesClusterList{}
+
+	if err := c.client.List(ctx, clusters); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list clusters").WithError(err)
+	}
+
+	result := &generated.MonitorShards{
+		Members:     make(generated.MonitorShardMembers, len(members)),
+		Assignments: generated.MonitorShardAssignments{},
+	}
+
+	for i, member := range members {
+		result.Members[i] = generated.MonitorShardMember{
+			Identity:  member.Identity,
+			RenewTime: member.RenewTime,
+		}
+	}
+
+	for i := range controlPlanes.Items {
+		result.Assignments = append(result.Assignments, convertAssignment(generated.MonitorShardResourceKindControlPlane, members, &controlPlanes.Items[i]))
+	}
+
+	for i := range clusters.Items {
+		result.Assignments = append(result.Assignments, convertAssignment(generated.MonitorShardResourceKindKubernetesCluster, members, &clusters.Items[i]))
+	}
+
+	slices.SortStableFunc(result.Assignments, compareAssignments)
+
+	return result, nil
+}
+
+// getResource looks up the resource referenced by a pin.
+func (c *Client) getResource(ctx context.Context, pin *generated.MonitorShardPin) (client.Object, error) {
+	var object client.Object
+
+	switch pin.Kind {
+	case generated.MonitorShardResourceKindControlPlane:
+		object = &unikornv1.ControlPlane{}
+	case generated.MonitorShardResourceKindKubernetesCluster:
+		object = &unikornv1.KubernetesCluster{}
+	default:
+		return nil, errors.OAuth2InvalidRequest("unsupported resource kind")
+	}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: pin.Namespace, Name: pin.Name}, object); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.HTTPNotFound().WithError(err)
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get resource").WithError(err)
+	}
+
+	return object, nil
+}
+
+// Pin pins resources to, or unpins them from, monitor replicas.  All pins are
+// validated before any are applied.
+func (c *Client) Pin(ctx context.Context, request generated.MonitorShardPins) error {
+	members, err := c.members(ctx)
+	if err != nil {
+		return err
+	}
+
+	objects := make([]client.Object, len(request))
+
+	for i := range request {
+		pin := &request[i]
+
+		if pin.Replica != nil && !slices.ContainsFunc(members, func(m shard.Member) bool { return m.Identity == *pin.Replica }) {
+			return errors.OAuth2InvalidRequest("replica " + *pin.Replica + " is not a live monitor replica")
+		}
+
+		object, err := c.getResource(ctx, pin)
+		if err != nil {
+			return err
+		}
+
+		objects[i] = object
+	}
+
+	for i, object := range objects {
+		pin := &request[i]
+
+		original, ok := object.DeepCopyObject().(client.Object)
+		if !ok {
+			return errors.OAuth2ServerError("failed to copy resource")
+		}
+
+		labels := object.GetLabels()
+
+		if pin.Replica == nil {
+			delete(labels, constants.MonitorShardLabel)
+		} else {
+			if labels == nil {
+				labels = map[string]string{}
+			}
+
+			labels[constants.MonitorShardLabel] = *pin.Replica
+		}
+
+		object.SetLabels(labels)
+
+		if err := c.client.Patch(ctx, object, client.MergeFrom(original)); err != nil {
+			return errors.OAuth2ServerError("failed to patch resource").WithError(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,523 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package offboarding
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/cluster"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/project"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// controlPlanePoolName is used to report control plane machine usage.
+	controlPlanePoolName = "control-plane"
+)
+
+// stages defines the order in which stages must be confirmed.
+//
+//nolint:gochecknoglobals
+var stages = []unikornv1.ProjectOffboardingStage{
+	unikornv1.ProjectOffboardingStageClusters,
+	unikornv1.ProjectOffboardingStageControlPlanes,
+	unikornv1.ProjectOffboardingStageProject,
+}
+
+// Client wraps up project offboarding handling.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+
+	// authenticator provides access to authentication services.
+	authenticator *authorization.Authenticator
+
+	// provider is required to revoke credentials.
+	provider providers.CloudProvider
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client, authenticator *authorization.Authenticator, provider providers.CloudProvider) *Client {
+	return &Client{
+		client:        client,
+		authenticator: authenticator,
+		provider:      provider,
+	}
+}
+
+// convertStage converts from Kubernetes into OpenAPI types.
+func convertStage(in unikornv1.ProjectOffboardingStage) generated.ProjectOffboardingStage {
+	switch in {
+	case unikornv1.ProjectOffboardingStageClusters:
+		return generated.ProjectOffboardingStageClusters
+	case unikornv1.ProjectOffboardingStageControlPlanes:
+		return generated.ProjectOffboardingStageControlPlanes
+	case unikornv1.ProjectOffboardingStageProject:
+		return generated.ProjectOffboardingStageProject
+	}
+
+	return ""
+}
+
+// generateStage converts from OpenAPI into Kubernetes types.
+func generateStage(in generated.ProjectOffboardingStage) (unikornv1.ProjectOffboardingStage, error) {
+	switch in {
+	case generated.ProjectOffboardingStageClusters:
+		return unikornv1.ProjectOffboardingStageClusters, nil
+	case generated.ProjectOffboardingStageControlPlanes:
+		return unikornv1.ProjectOffboardingStageControlPlanes, nil
+	case generated.ProjectOffboardingStageProject:
+		return unikornv1.ProjectOffboardingStageProject, nil
+	}
+
+	return "", errors.OAuth2InvalidRequest("unsupported offboarding stage")
+}
+
+// nextStage returns the stage that follows the one last confirmed, or nil
+// if all stages have been confirmed.
+func nextStage(in *unikornv1.ProjectOffboardingStage) *unikornv1.ProjectOffboardingStage {
+	if in == nil {
+		return &stages[0]
+	}
+
+	index := slices.Index(stages, *in)
+	if index < 0 || index+1 >= len(stages) {
+		return nil
+	}
+
+	return &stages[index+1]
+}
+
+// resources are the project's descendents that still exist.
+type resources struct {
+	controlPlanes []unikornv1.ControlPlane
+
+	// clusters are keyed by control plane name.
+	clusters map[string][]unikornv1.KubernetesCluster
+}
+
+// clusterCount returns the number of clusters in the project.
+func (r *resources) clusterCount() int {
+	var count int
+
+	for _, clusters := range r.clusters {
+		count += len(clusters)
+	}
+
+	return count
+}
+
+// get returns the implicit project identified by the JWT claims.
+func (c *Client) get(ctx context.Context) (*unikornv1.Project, error) {
+	name, err := project.NameFromContext(ctx)
+	if err != nil {
+		return nil, errors.OAuth2ServerError("failed get project name").WithError(err)
+	}
+
+	result := &unikornv1.Project{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Name: name}, result); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.HTTPNotFound().WithError(err)
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get project").WithError(err)
+	}
+
+	return result, nil
+}
+
+// getOffboarding returns the project, and raises an error if it's not being offboarded.
+func (c *Client) getOffboarding(ctx context.Context) (*unikornv1.Project, error) {
+	result, err := c.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Spec.Offboarding == nil {
+		return nil, errors.HTTPNotFound()
+	}
+
+	return result, nil
+}
+
+// resources lists all control planes and clusters in the project.
+func (c *Client) resources(ctx context.Context, in *unikornv1.Project) (*resources, error) {
+	out := &resources{
+		clusters: map[string][]unikornv1.KubernetesCluster{},
+	}
+
+	if in.Status.Namespace == "" {
+		return out, nil
+	}
+
+	controlPlanes := &unikornv1.ControlPlaneList{}
+
+	if err := c.client.List(ctx, controlPlanes, &client.ListOptions{Namespace: in.Status.Namespace}); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list control planes").WithError(err)
+	}
+
+	out.controlPlanes = controlPlanes.Items
+
+	for _, controlPlane := range controlPlanes.Items {
+		if controlPlane.Status.Namespace == "" {
+			continue
+		}
+
+		clusters := &unikornv1.KubernetesClusterList{}
+
+		if err := c.client.List(ctx, clusters, &client.ListOptions{Namespace: controlPlane.Status.Namespace}); err != nil {
+			return nil, errors.OAuth2ServerError("failed to list clusters").WithError(err)
+		}
+
+		out.clusters[controlPlane.Name] = clusters.Items
+	}
+
+	return out, nil
+}
+
+// machineUsage returns a record of a set of machines.
+func machineUsage(pool string, machine *unikornv1.MachineGeneric) unikornv1.ProjectOffboardingMachineUsage {
+	out := unikornv1.ProjectOffboardingMachineUsage{
+		Pool: pool,
+	}
+
+	if machine.Flavor != nil {
+		out.Flavor = *machine.Flavor
+	}
+
+	if machine.Replicas != nil {
+		out.Replicas = *machine.Replicas
+	}
+
+	return out
+}
+
+// clusterUsage returns a record of what a cluster is running, and for how long.
+func clusterUsage(controlPlaneName string, in *unikornv1.KubernetesCluster, end time.Time) unikornv1.ProjectOffboardingClusterUsage {
+	out := unikornv1.ProjectOffboardingClusterUsage{
+		ControlPlane: controlPlaneName,
+		Name:         in.Name,
+		Start:        in.CreationTimestamp,
+		End:          metav1.NewTime(end),
+	}
+
+	if in.Spec.ControlPlane != nil {
+		out.Machines = append(out.Machines, machineUsage(controlPlanePoolName, &in.Spec.ControlPlane.MachineGeneric))
+	}
+
+	if in.Spec.WorkloadPools != nil {
+		for i := range in.Spec.WorkloadPools.Pools {
+			pool := &in.Spec.WorkloadPools.Pools[i]
+
+			out.Machines = append(out.Machines, machineUsage(pool.Name, &pool.MachineGeneric))
+		}
+	}
+
+	return out
+}
+
+// recordUsage adds any clusters that are not already recorded to the usage.
+func recordUsage(usage []unikornv1.ProjectOffboardingClusterUsage, resources *resources, end time.Time) []unikornv1.ProjectOffboardingClusterUsage {
+	for controlPlaneName, clusters := range resources.clusters {
+		for i := range clusters {
+			cluster := &clusters[i]
+
+			recorded := func(u unikornv1.ProjectOffboardingClusterUsage) bool {
+				return u.ControlPlane == controlPlaneName && u.Name == cluster.Name
+			}
+
+			if slices.ContainsFunc(usage, recorded) {
+				continue
+			}
+
+			usage = append(usage, clusterUsage(controlPlaneName, cluster, end))
+		}
+	}
+
+	return usage
+}
+
+// convertUsage converts from Kubernetes into OpenAPI types, and aggregates
+// machine hours by flavor.
+func convertUsage(in []unikornv1.ProjectOffboardingClusterUsage) generated.ProjectUsageReport {
+	out := generated.ProjectUsageReport{
+		Clusters: generated.ProjectClusterUsages{},
+		Flavors:  generated.ProjectFlavorUsages{},
+	}
+
+	flavors := map[string]float64{}
+
+	for _, cluster := range in {
+		hours := cluster.End.Sub(cluster.Start.Time).Hours()
+
+		usage := generated.ProjectClusterUsage{
+			ControlPlaneName: cluster.ControlPlane,
+			Name:             cluster.Name,
+			StartTime:        cluster.Start.Time,
+			EndTime:          cluster.End.Time,
+			Hours:            hours,
+			Machines:         generated.ProjectMachineUsages{},
+		}
+
+		for _, machine := range cluster.Machines {
+			machineHours := hours * float64(machine.Replicas)
+
+			usage.Machines = append(usage.Machines, generated.ProjectMachineUsage{
+				PoolName:     machine.Pool,
+				FlavorName:   machine.Flavor,
+				Replicas:     machine.Replicas,
+				MachineHours: machineHours,
+			})
+
+			flavors[machine.Flavor] += machineHours
+		}
+
+		out.Clusters = append(out.Clusters, usage)
+	}
+
+	for flavor, machineHours := range flavors {
+		out.Flavors = append(out.Flavors, generated.ProjectFlavorUsage{
+			FlavorName:   flavor,
+			MachineHours: machineHours,
+		})
+	}
+
+	slices.SortFunc(out.Clusters, func(a, b generated.ProjectClusterUsage) int {
+		if v := cmp.Compare(a.ControlPlaneName, b.ControlPlaneName); v != 0 {
+			return v
+		}
+
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	slices.SortFunc(out.Flavors, func(a, b generated.ProjectFlavorUsage) int {
+		return cmp.Compare(a.FlavorName, b.FlavorName)
+	})
+
+	return out
+}
+
+// convert generates the offboarding status.  Usage for clusters that have
+// not yet been recorded is reported up to the current time.
+func convert(in *unikornv1.Project, resources *resources, now time.Time) *generated.ProjectOffboarding {
+	offboarding := in.Spec.Offboarding
+
+	out := &generated.ProjectOffboarding{
+		RequestedTime:          offboarding.RequestedAt.Time,
+		RemainingControlPlanes: len(resources.controlPlanes),
+		RemainingClusters:      resources.clusterCount(),
+		Usage:                  convertUsage(recordUsage(slices.Clone(offboarding.Usage), resources, now)),
+	}
+
+	if offboarding.Stage != nil {
+		stage := convertStage(*offboarding.Stage)
+		out.Stage = &stage
+	}
+
+	if next := nextStage(offboarding.Stage); next != nil {
+		stage := convertStage(*next)
+		out.NextStage = &stage
+	}
+
+	return out
+}
+
+// Get returns the offboarding status of the project.
+func (c *Client) Get(ctx context.Context) (*generated.ProjectOffboarding, error) {
+	result, err := c.getOffboarding(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := c.resources(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return convert(result, resources, time.Now()), nil
+}
+
+// Start begins offboarding, after which no new control planes or clusters
+// may be created.
+func (c *Client) Start(ctx context.Context) error {
+	result, err := c.get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if result.DeletionTimestamp != nil {
+		return errors.OAuth2InvalidRequest("project is being deleted")
+	}
+
+	if result.Spec.Offboarding != nil {
+		return errors.HTTPConflict()
+	}
+
+	updated := result.DeepCopy()
+	updated.Spec.Offboarding = &unikornv1.ProjectOffboardingSpec{
+		RequestedAt: metav1.Now(),
+	}
+
+	if err := c.client.Patch(ctx, updated, client.MergeFrom(result)); err != nil {
+		return errors.OAuth2ServerError("failed to patch project").WithError(err)
+	}
+
+	return nil
+}
+
+// deleteClusters deletes all clusters in the project.
+func (c *Client) deleteClusters(ctx context.Context, resources *resources) error {
+	for _, clusters := range resources.clusters {
+		for i := range clusters {
+			cluster := &clusters[i]
+
+			if cluster.DeletionTimestamp != nil {
+				continue
+			}
+
+			if err := c.client.Delete(ctx, cluster); err != nil && !kerrors.IsNotFound(err) {
+				return errors.OAuth2ServerError("failed to delete cluster").WithError(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteControlPlanes revokes any cluster credentials and deletes all control
+// planes in the project.
+func (c *Client) deleteControlPlanes(ctx context.Context, in *unikornv1.Project, resources *resources) error {
+	if count := resources.clusterCount(); count != 0 {
+		return errors.OAuth2InvalidRequest("clusters are still being deleted")
+	}
+
+	if err := cluster.NewClient(c.client, c.authenticator, c.provider).RevokeProjectCredentials(ctx, in.Name); err != nil {
+		return err
+	}
+
+	for i := range resources.controlPlanes {
+		controlPlane := &resources.controlPlanes[i]
+
+		if controlPlane.DeletionTimestamp != nil {
+			continue
+		}
+
+		if err := c.client.Delete(ctx, controlPlane); err != nil && !kerrors.IsNotFound(err) {
+			return errors.OAuth2ServerError("failed to delete control plane").WithError(err)
+		}
+	}
+
+	return nil
+}
+
+// deleteProject deletes the project.
+func (c *Client) deleteProject(ctx context.Context, in *unikornv1.Project, resources *resources) error {
+	if len(resources.controlPlanes) != 0 {
+		return errors.OAuth2InvalidRequest("control planes are still being deleted")
+	}
+
+	if in.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if err := c.client.Delete(ctx, in); err != nil && !kerrors.IsNotFound(err) {
+		return errors.OAuth2ServerError("failed to delete project").WithError(err)
+	}
+
+	return nil
+}
+
+// Confirm confirms an offboarding stage and deletes the associated resources.
+// Stages must be confirmed in order, however the last confirmed stage may be
+// confirmed again in order to retry any deletions that failed.
+func (c *Client) Confirm(ctx context.Context, request *generated.ProjectOffboardingConfirmation) (*generated.ProjectOffboarding, error) {
+	stage, err := generateStage(request.Stage)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.getOffboarding(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	offboarding := result.Spec.Offboarding
+
+	retry := offboarding.Stage != nil && *offboarding.Stage == stage
+
+	if next := nextStage(offboarding.Stage); !retry && (next == nil || *next != stage) {
+		return nil, errors.OAuth2InvalidRequest("offboarding stages must be confirmed in order")
+	}
+
+	resources, err := c.resources(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	updated := result.DeepCopy()
+	updated.Spec.Offboarding.Stage = &stage
+
+	switch stage {
+	case unikornv1.ProjectOffboardingStageClusters:
+		// Record usage before anything is deleted, so the final report
+		// includes everything the project ran.
+		updated.Spec.Offboarding.Usage = recordUsage(updated.Spec.Offboarding.Usage, resources, now)
+	case unikornv1.ProjectOffboardingStageControlPlanes:
+		if err := c.deleteControlPlanes(ctx, result, resources); err != nil {
+			return nil, err
+		}
+	case unikornv1.ProjectOffboardingStageProject:
+		if err := c.deleteProject(ctx, result, resources); err != nil {
+			return nil, err
+		}
+
+		return convert(updated, resources, now), nil
+	}
+
+	if err := c.client.Patch(ctx, updated, client.MergeFrom(result)); err != nil {
+		return nil, errors.OAuth2ServerError("failed to patch project").WithError(err)
+	}
+
+	if stage == unikornv1.ProjectOffboardingStageClusters {
+		if err := c.deleteClusters(ctx, resources); err != nil {
+			return nil, err
+		}
+	}
+
+	// Report what is left after deletion, rather than what was there before.
+	resources, err = c.resources(ctx, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return convert(updated, resources, now), nil
+}
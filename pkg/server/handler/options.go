@@ -21,6 +21,11 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/cluster"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/cost"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/kubernetesversion"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/monitor"
 	"github.com/eschercloudai/unikorn/pkg/server/handler/providers/openstack"
 )
 
@@ -30,12 +35,31 @@ type Options struct {
 	// flavors don't change all that often.
 	CacheMaxAge time.Duration
 
+	// Provider is the type of cloud provider clusters are provisioned on.
+	Provider string
+
 	Openstack openstack.Options
+
+	Cost cost.Options
+
+	SSH cluster.SSHOptions
+
+	Metrics cluster.MetricsOptions
+
+	KubernetesVersions kubernetesversion.Options
+
+	Monitor monitor.Options
 }
 
 // AddFlags adds the options flags to the given flag set.
 func (o *Options) AddFlags(f *pflag.FlagSet) {
 	f.DurationVar(&o.CacheMaxAge, "cache-max-age", 24*time.Hour, "How long to cache long-lived queries in the browser.")
+	f.StringVar(&o.Provider, "provider", string(generated.Openstack), "Type of cloud provider clusters are provisioned on.")
 
 	o.Openstack.AddFlags(f)
+	o.Cost.AddFlags(f)
+	o.SSH.AddFlags(f)
+	o.Metrics.AddFlags(f)
+	o.KubernetesVersions.AddFlags(f)
+	o.Monitor.AddFlags(f)
 }
@@ -22,8 +22,10 @@ import (
 	"fmt"
 
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
 	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
 
 	"github.com/eschercloudai/unikorn-core/pkg/constants"
 	"github.com/eschercloudai/unikorn-core/pkg/util/retry"
@@ -48,6 +50,11 @@ func NewClient(client client.Client) *Client {
 	}
 }
 
+// Name translates an Openstack project ID to one we can use.
+func Name(projectID string) string {
+	return fmt.Sprintf("unikorn-server-%s", projectID)
+}
+
 // NameFromContext translates an Openstack project ID to one we an use.
 func NameFromContext(ctx context.Context) (string, error) {
 	claims, err := oauth2.ClaimsFromContext(ctx)
@@ -55,7 +62,7 @@ func NameFromContext(ctx context.Context) (string, error) {
 		return "", err
 	}
 
-	return fmt.Sprintf("unikorn-server-%s", claims.UnikornClaims.Project), nil
+	return Name(claims.UnikornClaims.Project), nil
 }
 
 // Meta describes the project.
@@ -71,15 +78,19 @@ type Meta struct {
 	// Deleting tells us if we should allow new child objects to be created
 	// in this resource's namespace.
 	Deleting bool
+
+	// Offboarding tells us the project is being torn down, and no new
+	// child objects may be created.
+	Offboarding bool
 }
 
 var (
 	// ErrResourceDeleting is raised when the resource is being deleted.
-	ErrResourceDeleting = goerrors.New("resource is being deleted")
+	ErrResourceDeleting = unikornerrors.Conflict("resource is being deleted")
 
 	// ErrNamespaceUnset is raised when the namespace hasn't been created
 	// yet.
-	ErrNamespaceUnset = goerrors.New("resource namespace is unset")
+	ErrNamespaceUnset = unikornerrors.Unavailable("resource namespace is unset")
 )
 
 // active returns true if the project is usable.
@@ -107,9 +118,10 @@ func (c *Client) GetMetadata(ctx context.Context) (*Meta, error) {
 	}
 
 	metadata := &Meta{
-		Name:      name,
-		Namespace: result.Status.Namespace,
-		Deleting:  result.DeletionTimestamp != nil,
+		Name:        name,
+		Namespace:   result.Status.Namespace,
+		Deleting:    result.DeletionTimestamp != nil,
+		Offboarding: result.Spec.Offboarding != nil,
 	}
 
 	return metadata, nil
@@ -171,9 +183,10 @@ func (c *Client) GetOrCreateMetadata(ctx context.Context) (*Meta, error) {
 	}
 
 	metadata := &Meta{
-		Name:      name,
-		Namespace: result.Status.Namespace,
-		Deleting:  result.DeletionTimestamp != nil,
+		Name:        name,
+		Namespace:   result.Status.Namespace,
+		Deleting:    result.DeletionTimestamp != nil,
+		Offboarding: result.Spec.Offboarding != nil,
 	}
 
 	return metadata, nil
@@ -214,7 +227,11 @@ func (c *Client) Create(ctx context.Context) error {
 	if err := c.client.Create(ctx, project); err != nil {
 		// TODO: we can do a cached lookup to save the API traffic.
 		if kerrors.IsAlreadyExists(err) {
-			return errors.HTTPConflict()
+			return errors.HTTPConflict().WithResource(&generated.ResourceReference{
+				Kind: generated.ResourceReferenceKindProject,
+				Name: name,
+				Href: "/api/v1/project",
+			})
 		}
 
 		return errors.OAuth2ServerError("failed to create project").WithError(err)
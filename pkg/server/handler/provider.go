@@ -0,0 +1,268 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+)
+
+// StaleWarning is returned in the Warning header when a stale listing is
+// served because the cloud is unavailable.
+const StaleWarning = `110 - "Response is Stale"`
+
+// noCache returns true if the client has asked for fresh results.
+func noCache(r *http.Request) bool {
+	for _, value := range r.Header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			switch strings.ToLower(strings.TrimSpace(directive)) {
+			case "no-cache", "no-store", "max-age=0":
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// providerContext returns a context for cached provider listings, that asks for
+// fresh results if the client did, and records whether any stale results are
+// served.  The returned function sets the Warning header on the response if so,
+// and must be called before the response is written.
+func providerContext(r *http.Request) (context.Context, func(w http.ResponseWriter)) {
+	ctx := r.Context()
+
+	if noCache(r) {
+		ctx = providers.NewContextWithNoCache(ctx)
+	}
+
+	ctx, stale := providers.TrackStaleness(ctx)
+
+	warn := func(w http.ResponseWriter) {
+		if stale() {
+			w.Header().Set("Warning", StaleWarning)
+		}
+	}
+
+	return ctx, warn
+}
+
+// optionalString returns nil for an empty string, so unset values are omitted.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func convertAvailabilityZone(in *providers.AvailabilityZone) generated.OpenstackAvailabilityZone {
+	out := generated.OpenstackAvailabilityZone{
+		Name:      in.Name,
+		Available: in.Available,
+		Hosts:     in.Hosts,
+	}
+
+	if len(in.Annotations) != 0 {
+		out.Annotations = &in.Annotations
+	}
+
+	return out
+}
+
+func convertAvailabilityZones(in []providers.AvailabilityZone) generated.OpenstackAvailabilityZones {
+	out := make(generated.OpenstackAvailabilityZones, len(in))
+
+	for i := range in {
+		out[i] = convertAvailabilityZone(&in[i])
+	}
+
+	return out
+}
+
+func convertExternalNetworks(in []providers.ExternalNetwork) generated.OpenstackExternalNetworks {
+	out := make(generated.OpenstackExternalNetworks, len(in))
+
+	for i := range in {
+		out[i] = generated.OpenstackExternalNetwork{
+			Id:   in[i].ID,
+			Name: in[i].Name,
+		}
+	}
+
+	return out
+}
+
+func convertFlavor(in *providers.Flavor) generated.OpenstackFlavor {
+	out := generated.OpenstackFlavor{
+		Id:     in.ID,
+		Name:   in.Name,
+		Cpus:   in.CPUs,
+		Memory: in.Memory,
+		Disk:   in.Disk,
+	}
+
+	if in.GPU != nil {
+		out.Gpus = &in.GPU.Count
+		out.GpuMemory = in.GPU.Memory
+		out.GpuModel = optionalString(in.GPU.Model)
+		out.GpuProfile = optionalString(in.GPU.Profile)
+		out.MinimumNvidiaDriverVersion = optionalString(in.GPU.MinimumDriverVersion)
+	}
+
+	return out
+}
+
+func convertFlavors(in []providers.Flavor) generated.OpenstackFlavors {
+	out := make(generated.OpenstackFlavors, len(in))
+
+	for i := range in {
+		out[i] = convertFlavor(&in[i])
+	}
+
+	return out
+}
+
+func convertImage(in *providers.Image) generated.OpenstackImage {
+	out := generated.OpenstackImage{
+		Id:       in.ID,
+		Name:     in.Name,
+		Created:  in.Created,
+		Modified: in.Modified,
+	}
+
+	out.Versions.Kubernetes = in.KubernetesVersion
+	out.Versions.NvidiaDriver = in.NvidiaDriverVersion
+
+	return out
+}
+
+func convertImages(in []providers.Image) generated.OpenstackImages {
+	out := make(generated.OpenstackImages, len(in))
+
+	for i := range in {
+		out[i] = convertImage(&in[i])
+	}
+
+	return out
+}
+
+func convertKeyPairs(in []providers.KeyPair) generated.OpenstackKeyPairs {
+	out := make(generated.OpenstackKeyPairs, len(in))
+
+	for i := range in {
+		out[i] = generated.OpenstackKeyPair{
+			Name: in[i].Name,
+		}
+	}
+
+	return out
+}
+
+// convertApplicationCredentialRoles ensures lists are rendered as empty arrays
+// rather than null.
+func convertApplicationCredentialRoles(in *providers.ApplicationCredentialRoles) *generated.OpenstackApplicationCredentialRoles {
+	out := &generated.OpenstackApplicationCredentialRoles{
+		Required: in.Required,
+		Missing:  in.Missing,
+	}
+
+	if out.Required == nil {
+		out.Required = []string{}
+	}
+
+	if out.Missing == nil {
+		out.Missing = []string{}
+	}
+
+	return out
+}
+
+func convertQuota(in providers.Quota) generated.OpenstackQuota {
+	return generated.OpenstackQuota{
+		Limit: in.Limit,
+		Used:  in.Used,
+	}
+}
+
+func convertQuotas(in *providers.Quotas) *generated.OpenstackQuotas {
+	return &generated.OpenstackQuotas{
+		Compute: generated.OpenstackComputeQuotas{
+			Cores:     convertQuota(in.Compute.Cores),
+			Ram:       convertQuota(in.Compute.RAM),
+			Instances: convertQuota(in.Compute.Instances),
+		},
+		BlockStorage: generated.OpenstackBlockStorageQuotas{
+			Volumes:            convertQuota(in.BlockStorage.Volumes),
+			Gigabytes:          convertQuota(in.BlockStorage.Gigabytes),
+			PerVolumeGigabytes: in.BlockStorage.PerVolumeGigabytes,
+		},
+		Network: generated.OpenstackNetworkQuotas{
+			FloatingIPs:    convertQuota(in.Network.FloatingIPs),
+			Networks:       convertQuota(in.Network.Networks),
+			Ports:          convertQuota(in.Network.Ports),
+			Routers:        convertQuota(in.Network.Routers),
+			SecurityGroups: convertQuota(in.Network.SecurityGroups),
+		},
+	}
+}
+
+// convertHealth reports the cloud as healthy only if every service is
+// reachable, that is its circuit is closed.
+func convertHealth(in *providers.Health) *generated.OpenstackHealth {
+	out := &generated.OpenstackHealth{
+		Healthy:  true,
+		Services: make([]generated.OpenstackServiceHealth, len(in.Services)),
+	}
+
+	for i := range in.Services {
+		service := &in.Services[i]
+
+		out.Services[i] = generated.OpenstackServiceHealth{
+			Service:             generated.OpenstackServiceName(service.Service),
+			State:               generated.OpenstackCircuitState(service.State),
+			Reachable:           service.State == providers.CircuitClosed,
+			ConsecutiveFailures: service.ConsecutiveFailures,
+			LastSuccess:         service.LastSuccess,
+			LastFailure:         service.LastFailure,
+		}
+
+		if !out.Services[i].Reachable {
+			out.Healthy = false
+		}
+	}
+
+	return out
+}
+
+func convertProjects(in []providers.Project) generated.OpenstackProjects {
+	out := make(generated.OpenstackProjects, len(in))
+
+	for i := range in {
+		out[i] = generated.OpenstackProject{
+			Id:          in[i].ID,
+			Name:        in[i].Name,
+			Description: optionalString(in[i].Description),
+		}
+	}
+
+	return out
+}
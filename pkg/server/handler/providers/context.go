@@ -0,0 +1,63 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providers
+
+import (
+	"context"
+)
+
+type noCacheKey struct{}
+
+// NewContextWithNoCache returns a context that asks providers for fresh
+// results, rather than cached ones.
+func NewContextWithNoCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// NoCacheFromContext returns true if fresh results were asked for.
+func NoCacheFromContext(ctx context.Context) bool {
+	noCache, _ := ctx.Value(noCacheKey{}).(bool)
+
+	return noCache
+}
+
+type staleKey struct{}
+
+// staleness records whether a stale result was served.
+type staleness struct {
+	stale bool
+}
+
+// TrackStaleness returns a context that records whether any stale results are
+// served, because the cloud is unavailable, while using it.  The returned
+// function reports whether any were.
+func TrackStaleness(ctx context.Context) (context.Context, func() bool) {
+	s := &staleness{}
+
+	stale := func() bool {
+		return s.stale
+	}
+
+	return context.WithValue(ctx, staleKey{}, s), stale
+}
+
+// MarkStale records that a stale result was served, if tracked.
+func MarkStale(ctx context.Context) {
+	if s, ok := ctx.Value(staleKey{}).(*staleness); ok {
+		s.stale = true
+	}
+}
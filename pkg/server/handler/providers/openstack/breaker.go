@@ -0,0 +1,266 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+
+	"github.com/eschercloudai/unikorn-core/pkg/util"
+)
+
+var (
+	// ErrCircuitOpen is raised when a service has failed repeatedly, and
+	// requests are being short circuited.
+	ErrCircuitOpen = unikornerrors.Unavailable("provider service unavailable")
+
+	// ErrServiceUnreachable is raised when a request to a service fails
+	// to get a response.
+	ErrServiceUnreachable = unikornerrors.Unavailable("provider service unreachable")
+)
+
+const (
+	serviceIdentity     = "identity"
+	serviceCompute      = "compute"
+	serviceBlockStorage = "blockStorage"
+	serviceNetwork      = "network"
+	serviceImage        = "image"
+)
+
+// services are reported by the health endpoint in this order.
+//
+//nolint:gochecknoglobals
+var services = []string{
+	serviceIdentity,
+	serviceCompute,
+	serviceBlockStorage,
+	serviceNetwork,
+	serviceImage,
+}
+
+// breaker is a circuit breaker for an OpenStack service.  After a number of
+// consecutive failures, requests fail immediately without being made, which
+// avoids tying up clients, and the service, while it's down.  Once the cool
+// down period has elapsed a single probe request is allowed, and depending
+// on its outcome the circuit is either closed or reopened.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	lock sync.Mutex
+
+	// failures is the number of consecutive failures.
+	failures int
+
+	// opened is when the circuit was last opened.
+	opened time.Time
+
+	// probing is set while a probe request is in flight.
+	probing bool
+
+	lastSuccess time.Time
+	lastFailure time.Time
+}
+
+// open returns true if requests should be short circuited.
+func (b *breaker) open() bool {
+	return b.threshold > 0 && b.failures >= b.threshold
+}
+
+// allow returns an error if the request should be short circuited, otherwise
+// whether the request is the probe.  Only the probe may end probing, requests
+// admitted before the circuit opened may still complete while it's half open.
+func (b *breaker) allow(now time.Time) (bool, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.open() {
+		return false, nil
+	}
+
+	if now.Before(b.opened.Add(b.cooldown)) || b.probing {
+		return false, ErrCircuitOpen
+	}
+
+	b.probing = true
+
+	return true, nil
+}
+
+// record records the outcome of a request.
+func (b *breaker) record(now time.Time, probe, failed bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+
+	if !failed {
+		b.failures = 0
+		b.lastSuccess = now
+
+		return
+	}
+
+	b.failures++
+	b.lastFailure = now
+
+	if b.open() {
+		b.opened = now
+	}
+}
+
+// abandon is called when a request's outcome says nothing about the service
+// e.g. the client went away, so a probe can be retried.
+func (b *breaker) abandon(probe bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+}
+
+// health reports the breaker state.
+func (b *breaker) health(service string, now time.Time) providers.ServiceHealth {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	state := providers.CircuitClosed
+
+	if b.open() {
+		state = providers.CircuitOpen
+
+		if !now.Before(b.opened.Add(b.cooldown)) {
+			state = providers.CircuitHalfOpen
+		}
+	}
+
+	result := providers.ServiceHealth{
+		Service:             service,
+		State:               state,
+		ConsecutiveFailures: b.failures,
+	}
+
+	if !b.lastSuccess.IsZero() {
+		result.LastSuccess = util.ToPointer(b.lastSuccess)
+	}
+
+	if !b.lastFailure.IsZero() {
+		result.LastFailure = util.ToPointer(b.lastFailure)
+	}
+
+	return result
+}
+
+// newBreakers returns a circuit breaker for each service.
+func newBreakers(options *Options) map[string]*breaker {
+	breakers := map[string]*breaker{}
+
+	for _, service := range services {
+		breakers[service] = &breaker{
+			threshold: options.CircuitBreakerThreshold,
+			cooldown:  options.CircuitBreakerCooldown,
+		}
+	}
+
+	return breakers
+}
+
+// serviceFailed returns true if the status code indicates the service, rather
+// than the request, is at fault.
+func serviceFailed(status int) bool {
+	switch status {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// breakerTransport applies circuit breaking to requests made by a client.
+// Clients authenticate with Keystone before making requests to their service,
+// so those requests are attributed to the identity service.
+type breakerTransport struct {
+	base     http.RoundTripper
+	endpoint string
+	identity *breaker
+	service  *breaker
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *breakerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	b := t.service
+
+	if strings.HasPrefix(r.URL.String(), t.endpoint) {
+		b = t.identity
+	}
+
+	probe, err := b.allow(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := t.base.RoundTrip(r)
+	if err != nil {
+		if r.Context().Err() != nil {
+			b.abandon(probe)
+
+			return nil, err
+		}
+
+		b.record(time.Now(), probe, true)
+
+		return nil, fmt.Errorf("%w: %w", ErrServiceUnreachable, err)
+	}
+
+	b.record(time.Now(), probe, serviceFailed(response.StatusCode))
+
+	return response, nil
+}
+
+// transport returns a transport with circuit breaking for the service.
+func (o *Openstack) transport(base http.RoundTripper, service string) http.RoundTripper {
+	return &breakerTransport{
+		base:     base,
+		endpoint: o.endpoint,
+		identity: o.breakers[serviceIdentity],
+		service:  o.breakers[service],
+	}
+}
+
+// Health returns the reachability of each service.
+func (o *Openstack) Health() *providers.Health {
+	now := time.Now()
+
+	result := &providers.Health{
+		Services: make([]providers.ServiceHealth, len(services)),
+	}
+
+	for i, service := range services {
+		result.Services[i] = o.breakers[service].health(service, now)
+	}
+
+	return result
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers/openstack"
+)
+
+const (
+	threshold = 3
+	cooldown  = time.Minute
+)
+
+// mustOpen returns a breaker that was opened at the given time.
+func mustOpen(t *testing.T, now time.Time) *openstack.Breaker {
+	t.Helper()
+
+	b := openstack.NewBreaker(threshold, cooldown)
+
+	for i := 0; i < threshold; i++ {
+		probe, err := b.Allow(now)
+		assert.NoError(t, err)
+		assert.False(t, probe)
+
+		b.Record(now, probe, true)
+	}
+
+	return b
+}
+
+// TestBreakerThreshold tests the circuit only opens after the threshold of
+// consecutive failures, and a success resets the count.
+func TestBreakerThreshold(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	b := openstack.NewBreaker(threshold, cooldown)
+
+	for i := 0; i < threshold-1; i++ {
+		b.Record(now, false, true)
+	}
+
+	b.Record(now, false, false)
+
+	for i := 0; i < threshold-1; i++ {
+		b.Record(now, false, true)
+	}
+
+	_, err := b.Allow(now)
+	assert.NoError(t, err)
+
+	b.Record(now, false, true)
+
+	_, err = b.Allow(now)
+	assert.ErrorIs(t, err, openstack.ErrCircuitOpen)
+}
+
+// TestBreakerDisabled tests a zero threshold never opens the circuit.
+func TestBreakerDisabled(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	b := openstack.NewBreaker(0, cooldown)
+
+	for i := 0; i < 10; i++ {
+		b.Record(now, false, true)
+	}
+
+	_, err := b.Allow(now)
+	assert.NoError(t, err)
+}
+
+// TestBreakerCooldown tests requests are short circuited until the cool down
+// period has elapsed, then a probe is allowed, which closes the circuit on success.
+func TestBreakerCooldown(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	b := mustOpen(t, now)
+
+	_, err := b.Allow(now.Add(cooldown - time.Second))
+	assert.ErrorIs(t, err, openstack.ErrCircuitOpen)
+
+	now = now.Add(cooldown)
+
+	probe, err := b.Allow(now)
+	assert.NoError(t, err)
+	assert.True(t, probe)
+
+	b.Record(now, probe, false)
+
+	probe, err = b.Allow(now)
+	assert.NoError(t, err)
+	assert.False(t, probe)
+}
+
+// TestBreakerSingleProbe tests only one probe is allowed while half open, even
+// when requests admitted before the circuit opened complete in the meantime.
+func TestBreakerSingleProbe(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	b := openstack.NewBreaker(threshold, cooldown)
+
+	// Admitted while closed, and still in flight when the circuit opens.
+	stale, err := b.Allow(now)
+	assert.NoError(t, err)
+
+	for i := 0; i < threshold; i++ {
+		b.Record(now, false, true)
+	}
+
+	now = now.Add(cooldown)
+
+	probe, err := b.Allow(now)
+	assert.NoError(t, err)
+	assert.True(t, probe)
+
+	b.Abandon(stale)
+
+	_, err = b.Allow(now)
+	assert.ErrorIs(t, err, openstack.ErrCircuitOpen)
+}
+
+// TestBreakerAbandon tests an abandoned probe allows another to be made.
+func TestBreakerAbandon(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Add(cooldown)
+
+	b := mustOpen(t, now.Add(-cooldown))
+
+	probe, err := b.Allow(now)
+	assert.NoError(t, err)
+	assert.True(t, probe)
+
+	b.Abandon(probe)
+
+	probe, err = b.Allow(now)
+	assert.NoError(t, err)
+	assert.True(t, probe)
+}
+
+// TestBreakerReopen tests a failed probe reopens the circuit for another cool
+// down period.
+func TestBreakerReopen(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Add(cooldown)
+
+	b := mustOpen(t, now.Add(-cooldown))
+
+	probe, err := b.Allow(now)
+	assert.NoError(t, err)
+	assert.True(t, probe)
+
+	b.Record(now, probe, true)
+
+	_, err = b.Allow(now.Add(cooldown - time.Second))
+	assert.ErrorIs(t, err, openstack.ErrCircuitOpen)
+
+	probe, err = b.Allow(now.Add(cooldown))
+	assert.NoError(t, err)
+	assert.True(t, probe)
+}
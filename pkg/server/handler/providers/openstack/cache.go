@@ -0,0 +1,160 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+)
+
+// listCacheEntry is a cached list result.
+type listCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// listCache caches OpenStack list results.  Unlike clients, which are bound to a
+// token, most listings depend only on the project, so are shared between its
+// users.  Listings whose content depends on the caller's roles are cached per
+// user instead.  Listings are slow, and clients will typically poll them.
+// Expired entries are retained for a while longer so they can be served while
+// OpenStack is unavailable.
+type listCache struct {
+	ttl      time.Duration
+	staleTTL time.Duration
+	lock     sync.Mutex
+	entries  map[string]listCacheEntry
+}
+
+// newListCache returns a new list cache.
+func newListCache(ttl, staleTTL time.Duration) *listCache {
+	return &listCache{
+		ttl:      ttl,
+		staleTTL: staleTTL,
+		entries:  map[string]listCacheEntry{},
+	}
+}
+
+// lookup returns a cached result if one exists and has not expired, or if
+// stale, has not been expired for longer than the stale TTL.  Entries that
+// can no longer be served are removed so the cache doesn't grow as projects
+// come and go.
+func (c *listCache) lookup(key string, stale bool) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+
+	for k, entry := range c.entries {
+		if now.After(entry.expires.Add(c.staleTTL)) {
+			delete(c.entries, k)
+		}
+	}
+
+	entry, ok := c.entries[key]
+	if !ok || (!stale && now.After(entry.expires)) {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// get returns a cached result if one exists and has not expired.
+func (c *listCache) get(key string) (interface{}, bool) {
+	return c.lookup(key, false)
+}
+
+// getStale returns a cached result, even if it has expired, provided it's
+// within the stale TTL.
+func (c *listCache) getStale(key string) (interface{}, bool) {
+	return c.lookup(key, true)
+}
+
+// set caches a result.
+func (c *listCache) set(key string, value interface{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.entries[key] = listCacheEntry{
+		value:   value,
+		expires: time.Now().Add(c.ttl),
+	}
+}
+
+// cachedList returns a cached list result for the project, or populates the
+// cache with the result of the list function.  The result must be the same for
+// all users of the project.
+func cachedList[T any](ctx context.Context, o *Openstack, identity *providers.Identity, kind string, list func() ([]T, error)) ([]T, error) {
+	return cachedListWithKey(ctx, o, kind+"/"+identity.ProjectID, list)
+}
+
+// cachedUserList is like cachedList, but for results that depend on the caller's
+// roles e.g. those that include details only visible to administrators, so are
+// cached per user rather than shared across the project.
+func cachedUserList[T any](ctx context.Context, o *Openstack, identity *providers.Identity, kind string, list func() ([]T, error)) ([]T, error) {
+	return cachedListWithKey(ctx, o, kind+"/"+identity.ProjectID+"/"+identity.UserID, list)
+}
+
+// cachedListWithKey returns a cached list result for the key, or populates the
+// cache with the result of the list function.  The cache is bypassed when
+// disabled, or when debug capturing so provider requests are recorded, and is
+// refreshed when the client asks for fresh results.  When OpenStack is
+// unavailable, an expired result is served, if there is one, even if fresh
+// results were asked for.  A copy is returned, so callers are free to modify it.
+func cachedListWithKey[T any](ctx context.Context, o *Openstack, key string, list func() ([]T, error)) ([]T, error) {
+	if o.options.ListCacheTTL == 0 || capturing(ctx) {
+		return list()
+	}
+
+	if !providers.NoCacheFromContext(ctx) {
+		if value, ok := o.listCache.get(key); ok {
+			if result, ok := value.([]T); ok {
+				return slices.Clone(result), nil
+			}
+		}
+	}
+
+	result, err := list()
+	if err != nil {
+		if !errors.IsHTTPServiceUnavailable(err) {
+			return nil, err
+		}
+
+		value, ok := o.listCache.getStale(key)
+		if !ok {
+			return nil, err
+		}
+
+		stale, ok := value.([]T)
+		if !ok {
+			return nil, err
+		}
+
+		providers.MarkStale(ctx)
+
+		return slices.Clone(stale), nil
+	}
+
+	o.listCache.set(key, result)
+
+	return slices.Clone(result), nil
+}
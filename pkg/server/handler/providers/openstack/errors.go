@@ -0,0 +1,264 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+)
+
+// requestIDHeaders are where services report the request ID, used to correlate
+// errors with cloud side logs.  Nova reports its own, in addition to the
+// standard one, when called via older microversions.
+//
+//nolint:gochecknoglobals
+var requestIDHeaders = []string{
+	"X-Openstack-Request-Id",
+	"X-Compute-Request-Id",
+}
+
+// providerError is the common subset of error bodies returned by OpenStack
+// services.  Nova wraps this in a key describing the error class e.g.
+// "conflictingRequest", Neutron uses "NeutronError" and includes a type, and
+// Keystone uses "error".
+type providerError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+
+	// requestID is the upstream request ID, if reported.
+	requestID string
+}
+
+// parseProviderError extracts the error type, message and request ID from a
+// response.
+func parseProviderError(err gophercloud.ErrUnexpectedResponseCode) *providerError {
+	result := &providerError{}
+
+	for _, header := range requestIDHeaders {
+		if result.requestID = err.ResponseHeader.Get(header); result.requestID != "" {
+			break
+		}
+	}
+
+	var body map[string]json.RawMessage
+
+	if err := json.Unmarshal(err.Body, &body); err != nil {
+		return result
+	}
+
+	for _, value := range body {
+		var message providerError
+
+		if err := json.Unmarshal(value, &message); err != nil {
+			continue
+		}
+
+		if message.Message != "" {
+			result.Type = message.Type
+			result.Message = message.Message
+
+			break
+		}
+	}
+
+	return result
+}
+
+// describe returns a description of a provider error for the client, including
+// the provider's message, if requested, and request ID if there are any.
+func (e *providerError) describe(prefix string, message bool) string {
+	description := prefix
+
+	if message && e.Message != "" {
+		description += ": " + e.Message
+	}
+
+	if e.requestID != "" {
+		description += " (request ID " + e.requestID + ")"
+	}
+
+	return description
+}
+
+// quotaExceeded returns true if the error is due to a quota being exceeded.
+// Nova reports these as a 403, Cinder a 413, and Neutron a 409.
+func (e *providerError) quotaExceeded(status int) bool {
+	switch status {
+	case http.StatusForbidden, http.StatusConflict, http.StatusRequestEntityTooLarge:
+	default:
+		return false
+	}
+
+	if e.Type == "OverQuota" {
+		return true
+	}
+
+	message := strings.ToLower(e.Message)
+
+	if strings.Contains(message, "quota exceeded") || strings.Contains(message, "limit exceeded") {
+		return true
+	}
+
+	return strings.Contains(message, "maximum number") && strings.Contains(message, "exceeded")
+}
+
+// remediation maps a known provider error to a hint that tells the user how
+// they may resolve the problem.
+type remediation struct {
+	// types are Neutron error types that match.
+	types []string
+
+	// keywords must all appear in the lower cased message to match.
+	keywords []string
+
+	// hint is returned to the client.
+	hint string
+}
+
+// remediations are checked in order, so more specific matches must come first.
+//
+//nolint:gochecknoglobals
+var remediations = []remediation{
+	{
+		types:    []string{"PortInUse"},
+		keywords: []string{"port", "in use"},
+		hint:     "the port is attached to another device, detach it or choose a different port",
+	},
+	{
+		types:    []string{"IpAddressInUse", "IpAddressAlreadyAllocated"},
+		keywords: []string{"ip address", "in use"},
+		hint:     "the IP address is already allocated, choose a different address or release the existing allocation",
+	},
+	{
+		keywords: []string{"key pair", "already exists"},
+		hint:     "a key pair with this name already exists, choose a different name or delete the existing key pair",
+	},
+	{
+		keywords: []string{"server group", "already exists"},
+		hint:     "a server group with this name already exists, delete the duplicate server group",
+	},
+	{
+		keywords: []string{"duplicate entry"},
+		hint:     "a resource with this name already exists, choose a different name or delete the existing resource",
+	},
+}
+
+// match returns true if the provider error matches the remediation.
+func (r *remediation) match(err *providerError) bool {
+	for _, t := range r.types {
+		if err.Type == t {
+			return true
+		}
+	}
+
+	message := strings.ToLower(err.Message)
+
+	for _, keyword := range r.keywords {
+		if !strings.Contains(message, keyword) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// remediationHint returns a hint for the provider error, or the fallback if
+// the error is not recognised.
+func remediationHint(err *providerError, fallback string) string {
+	for i := range remediations {
+		if remediations[i].match(err) {
+			return remediations[i].hint
+		}
+	}
+
+	return fallback
+}
+
+// isError returns true if the error, or any it wraps, is of the given type.
+func isError[T error](err error) bool {
+	var target T
+
+	return goerrors.As(err, &target)
+}
+
+// ConvertError takes a generic gophercloud error and converts it into something
+// more useful to our clients.  401s are important because it reacts badly with
+// the UI if we return a 500, when a 401 would cause a reauthentication and make
+// the bad behaviour go away.  Everything else is mapped to the closest error
+// class, with the provider's message and request ID for correlation, and where
+// the cause is recognised, a hint as to how the user can fix it.
+//
+//nolint:cyclop
+func ConvertError(err error) error {
+	// Short circuited and failed requests are raised by the circuit breaker.
+	if goerrors.Is(err, unikornerrors.ErrUnavailable) {
+		return errors.OAuth2TemporarilyUnavailable("provider temporarily unavailable").WithError(err)
+	}
+
+	var response gophercloud.ErrUnexpectedResponseCode
+
+	if !goerrors.As(err, &response) {
+		return errors.OAuth2ServerError("provider request failed").WithError(err)
+	}
+
+	providerErr := parseProviderError(response)
+
+	// Quota errors are reported with differing status codes across services,
+	// so must be handled before the generic mappings.
+	if providerErr.quotaExceeded(response.Actual) {
+		return errors.HTTPUnprocessableEntity(providerErr.describe("provider quota exceeded", false)).WithValidationErrors([]string{providerErr.Message}).WithRemediation("free up existing resources, or request a quota increase from your cloud administrator").WithError(err)
+	}
+
+	switch {
+	case isError[gophercloud.ErrDefault400](err):
+		return errors.OAuth2InvalidRequest(providerErr.describe("provider request invalid", true)).WithRemediation(remediationHint(providerErr, "the provider rejected the request, check the request parameters are valid")).WithError(err)
+	case isError[gophercloud.ErrDefault401](err):
+		return errors.OAuth2AccessDenied(providerErr.describe("provider request denied", false)).WithError(err)
+	case isError[gophercloud.ErrDefault403](err):
+		return errors.HTTPForbidden(providerErr.describe("provider request forbidden, ensure you have the correct roles assigned to your user", false)).WithError(err)
+	case isError[gophercloud.ErrDefault404](err):
+		return errors.HTTPNotFound().WithError(err).WithValues("requestID", providerErr.requestID)
+	case isError[gophercloud.ErrDefault409](err):
+		return errors.HTTPConflictWithDescription(providerErr.describe("provider request conflict", true)).WithRemediation(remediationHint(providerErr, "the provider resource is in a conflicting state, retry the request later")).WithError(err)
+	case isError[gophercloud.ErrDefault429](err):
+		return errors.HTTPTooManyRequests(providerErr.describe("provider request rate limited", false)).WithRetryAfter(response.ResponseHeader.Get("Retry-After")).WithError(err)
+	case isError[gophercloud.ErrDefault408](err), isError[gophercloud.ErrDefault502](err), isError[gophercloud.ErrDefault503](err), isError[gophercloud.ErrDefault504](err):
+		return errors.OAuth2TemporarilyUnavailable(providerErr.describe("provider temporarily unavailable", false)).WithRetryAfter(response.ResponseHeader.Get("Retry-After")).WithError(err)
+	case isError[gophercloud.ErrDefault500](err):
+		return errors.OAuth2ServerError(providerErr.describe("provider internal error", false)).WithError(err)
+	}
+
+	return errors.OAuth2ServerError(providerErr.describe(fmt.Sprintf("provider returned unexpected status %d", response.Actual), false)).WithError(err)
+}
+
+// clientError is returned when a client cannot be created.  This involves
+// authentication, so may fail if the identity service is unavailable.
+func clientError(description string, err error) error {
+	if goerrors.Is(err, unikornerrors.ErrUnavailable) {
+		return errors.OAuth2TemporarilyUnavailable("provider temporarily unavailable").WithError(err)
+	}
+
+	return errors.OAuth2ServerError(description).WithError(err)
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack_test
+
+import (
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers/openstack"
+)
+
+const (
+	requestID = "req-4c9a2b1e-8f3d-4e6a-9b7c-1d2e3f4a5b6c"
+)
+
+// newResponseError returns an error as gophercloud would for the response.
+func newResponseError(status int, body string, header http.Header) gophercloud.ErrUnexpectedResponseCode {
+	if header == nil {
+		header = http.Header{}
+	}
+
+	header.Set("X-Openstack-Request-Id", requestID)
+
+	return gophercloud.ErrUnexpectedResponseCode{
+		Method:         http.MethodPost,
+		URL:            "https://openstack.example.com/compute/v2.1/servers",
+		Expected:       []int{http.StatusOK},
+		Actual:         status,
+		Body:           []byte(body),
+		ResponseHeader: header,
+	}
+}
+
+// mustConvert converts the error and renders it as the client would see it.
+func mustConvert(t *testing.T, err error) (*httptest.ResponseRecorder, *generated.Oauth2Error) {
+	t.Helper()
+
+	converted := openstack.ConvertError(err)
+
+	var httpErr *errors.HTTPError
+
+	if !goerrors.As(converted, &httpErr) {
+		t.Fatalf("expected HTTP error, got %v", converted)
+	}
+
+	w := httptest.NewRecorder()
+
+	httpErr.Write(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	result := &generated.Oauth2Error{}
+
+	if err := json.Unmarshal(w.Body.Bytes(), result); err != nil {
+		t.Fatal(err)
+	}
+
+	return w, result
+}
+
+// TestConvertErrorBadRequest tests 400s are reported with the provider's message.
+func TestConvertErrorBadRequest(t *testing.T) {
+	t.Parallel()
+
+	err := gophercloud.ErrDefault400{ErrUnexpectedResponseCode: newResponseError(http.StatusBadRequest, `{"badRequest":{"code":400,"message":"Invalid key_name provided."}}`, nil)}
+
+	w, result := mustConvert(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, generated.InvalidRequest, result.Error)
+	assert.Contains(t, result.ErrorDescription, "Invalid key_name provided.")
+	assert.Contains(t, result.ErrorDescription, requestID)
+	assert.NotNil(t, result.Remediation)
+}
+
+// TestConvertErrorUnauthorized tests 401s trigger reauthentication.
+func TestConvertErrorUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	err := gophercloud.ErrDefault401{ErrUnexpectedResponseCode: newResponseError(http.StatusUnauthorized, `{"error":{"code":401,"message":"The request you have made requires authentication.","title":"Unauthorized"}}`, nil)}
+
+	w, result := mustConvert(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, generated.AccessDenied, result.Error)
+	assert.Contains(t, result.ErrorDescription, requestID)
+}
+
+// TestConvertErrorForbidden tests 403s are reported as such.
+func TestConvertErrorForbidden(t *testing.T) {
+	t.Parallel()
+
+	err := gophercloud.ErrDefault403{ErrUnexpectedResponseCode: newResponseError(http.StatusForbidden, `{"error":{"code":403,"message":"You are not authorized to perform the requested action.","title":"Forbidden"}}`, nil)}
+
+	w, result := mustConvert(t, err)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, generated.Forbidden, result.Error)
+}
+
+// TestConvertErrorNotFound tests 404s are reported as such.
+func TestConvertErrorNotFound(t *testing.T) {
+	t.Parallel()
+
+	err := gophercloud.ErrDefault404{ErrUnexpectedResponseCode: newResponseError(http.StatusNotFound, `{"itemNotFound":{"code":404,"message":"Flavor foo could not be found."}}`, nil)}
+
+	w, result := mustConvert(t, err)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, generated.NotFound, result.Error)
+}
+
+// TestConvertErrorConflict tests 409s are reported with a remediation hint when
+// the cause is recognised.
+func TestConvertErrorConflict(t *testing.T) {
+	t.Parallel()
+
+	err := gophercloud.ErrDefault409{ErrUnexpectedResponseCode: newResponseError(http.StatusConflict, `{"NeutronError":{"type":"PortInUse","message":"Unable to complete operation on port 7a9b, it is in use.","detail":""}}`, nil)}
+
+	w, result := mustConvert(t, err)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, generated.Conflict, result.Error)
+	assert.Contains(t, result.ErrorDescription, "it is in use")
+	assert.NotNil(t, result.Remediation)
+	assert.Contains(t, *result.Remediation, "port")
+}
+
+// TestConvertErrorQuota tests quota errors are reported consistently regardless
+// of how the service reports them.
+func TestConvertErrorQuota(t *testing.T) {
+	t.Parallel()
+
+	errs := []error{
+		gophercloud.ErrDefault403{ErrUnexpectedResponseCode: newResponseError(http.StatusForbidden, `{"forbidden":{"code":403,"message":"Quota exceeded for cores: Requested 8, but already used 20 of 20 cores"}}`, nil)},
+		gophercloud.ErrDefault409{ErrUnexpectedResponseCode: newResponseError(http.StatusConflict, `{"NeutronError":{"type":"OverQuota","message":"Quota exceeded for resources: ['port'].","detail":""}}`, nil)},
+		newResponseError(http.StatusRequestEntityTooLarge, `{"overLimit":{"code":413,"message":"VolumeLimitExceeded: Maximum number of volumes allowed (10) exceeded for quota 'volumes'."}}`, nil),
+	}
+
+	for _, err := range errs {
+		w, result := mustConvert(t, err)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, generated.UnprocessableEntity, result.Error)
+		assert.NotNil(t, result.ValidationErrors)
+		assert.Len(t, *result.ValidationErrors, 1)
+	}
+}
+
+// TestConvertErrorTooManyRequests tests rate limiting is propagated along with
+// when to retry.
+func TestConvertErrorTooManyRequests(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{}
+	header.Set("Retry-After", "30")
+
+	err := gophercloud.ErrDefault429{ErrUnexpectedResponseCode: newResponseError(http.StatusTooManyRequests, "", header)}
+
+	w, result := mustConvert(t, err)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, generated.TooManyRequests, result.Error)
+	assert.Equal(t, "30", w.Header().Get("Retry-After"))
+}
+
+// TestConvertErrorUnavailable tests transient provider failures are reported
+// as such.
+func TestConvertErrorUnavailable(t *testing.T) {
+	t.Parallel()
+
+	errs := []error{
+		gophercloud.ErrDefault502{ErrUnexpectedResponseCode: newResponseError(http.StatusBadGateway, "", nil)},
+		gophercloud.ErrDefault503{ErrUnexpectedResponseCode: newResponseError(http.StatusServiceUnavailable, "", nil)},
+		gophercloud.ErrDefault504{ErrUnexpectedResponseCode: newResponseError(http.StatusGatewayTimeout, "", nil)},
+	}
+
+	for _, err := range errs {
+		w, result := mustConvert(t, err)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Equal(t, generated.TemporarilyUnavailable, result.Error)
+	}
+}
+
+// TestConvertErrorServerError tests provider faults, and unknown errors don't
+// leak implementation details.
+func TestConvertErrorServerError(t *testing.T) {
+	t.Parallel()
+
+	errs := []error{
+		gophercloud.ErrDefault500{ErrUnexpectedResponseCode: newResponseError(http.StatusInternalServerError, "", nil)},
+		newResponseError(http.StatusTeapot, "", nil),
+		fmt.Errorf("%w: connection refused", goerrors.New("dial tcp")),
+	}
+
+	for _, err := range errs {
+		w, result := mustConvert(t, err)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, generated.ServerError, result.Error)
+		assert.NotContains(t, result.ErrorDescription, "gophercloud")
+		assert.NotContains(t, result.ErrorDescription, "ErrDefault")
+	}
+}
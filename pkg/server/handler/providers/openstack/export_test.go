@@ -0,0 +1,44 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"time"
+)
+
+// Breaker exports the circuit breaker for testing.
+type Breaker = breaker
+
+// NewBreaker returns a circuit breaker for testing.
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	return &breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+func (b *breaker) Allow(now time.Time) (bool, error) {
+	return b.allow(now)
+}
+
+func (b *breaker) Record(now time.Time, probe, failed bool) {
+	b.record(now, probe, failed)
+}
+
+func (b *breaker) Abandon(probe bool) {
+	b.abandon(probe)
+}
@@ -17,52 +17,28 @@ limitations under the License.
 package openstack
 
 import (
-	goerrors "errors"
+	"context"
 	"fmt"
 	"net/http"
-	"reflect"
 	"slices"
 	"sort"
+	"strconv"
+	"strings"
 
-	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
 	lru "github.com/hashicorp/golang-lru/v2"
 
 	"github.com/eschercloudai/unikorn/pkg/providers/openstack"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization"
-	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/debug"
 	"github.com/eschercloudai/unikorn/pkg/server/errors"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
 )
 
-var (
-	ErrResourceNotFound = goerrors.New("resource not found")
-)
-
-// covertError takes a generic gophercloud error and converts it into something
-// more useful to our clients.
-// / NOTE: at present we're only concerned with 401s because it reacts badly with
-// the UI if we return a 500, when a 401 would cause a reauthentication and make
-// the bad behaviour go away.
-func covertError(err error) error {
-	var err401 gophercloud.ErrDefault401
-
-	if goerrors.As(err, &err401) {
-		return errors.OAuth2AccessDenied("provider request denied").WithError(err)
-	}
-
-	var err403 gophercloud.ErrDefault403
-
-	if goerrors.As(err, &err403) {
-		return errors.HTTPForbidden("provider request forbidden, ensure you have the correct roles assigned to your user")
-	}
-
-	v := reflect.ValueOf(err)
-
-	return errors.OAuth2ServerError("provider error unhandled: " + v.Type().Name()).WithError(err)
-}
-
 // Openstack provides an HTTP handler for Openstack resources.
 type Openstack struct {
 	options *Options
@@ -75,6 +51,12 @@ type Openstack struct {
 	blockStorageClientCache *lru.Cache[string, *openstack.BlockStorageClient]
 	networkClientCache      *lru.Cache[string, *openstack.NetworkClient]
 	imageClientCache        *lru.Cache[string, *openstack.ImageClient]
+
+	// listCache caches expensive listings per project.
+	listCache *listCache
+
+	// breakers protect each service from repeated requests when failing.
+	breakers map[string]*breaker
 }
 
 // New returns a new initialized Openstack handler.
@@ -112,208 +94,244 @@ func New(options *Options, authenticator *authorization.Authenticator) (*Opensta
 		blockStorageClientCache: blockStorageClientCache,
 		networkClientCache:      networkClientCache,
 		imageClientCache:        imageClientCache,
+		listCache:               newListCache(options.ListCacheTTL, options.ListCacheStaleTTL),
+		breakers:                newBreakers(options),
 	}
 
 	return o, nil
 }
 
-func (o *Openstack) ApplicationCredentialRoles() []string {
-	return o.options.ApplicationCredentialRoles
-}
-
-func getToken(r *http.Request) (string, error) {
-	claims, err := oauth2.ClaimsFromContext(r.Context())
-	if err != nil {
-		return "", errors.OAuth2ServerError("failed get token claims").WithError(err)
-	}
+// Ensure the providers.CloudProvider interface is implemented.
+var _ providers.CloudProvider = &Openstack{}
 
-	if claims.UnikornClaims == nil {
-		return "", errors.OAuth2ServerError("failed get token claim")
-	}
+// Type implements the providers.CloudProvider interface.
+func (o *Openstack) Type() generated.ProviderType {
+	return generated.Openstack
+}
 
-	return claims.UnikornClaims.Token, nil
+// capturing returns true if the request is being debug captured, in which case
+// clients are not cached so provider requests are recorded against the right
+// capture.
+func capturing(ctx context.Context) bool {
+	return debug.FromContext(ctx) != nil
 }
 
-func getUser(r *http.Request) (string, error) {
-	claims, err := oauth2.ClaimsFromContext(r.Context())
-	if err != nil {
-		return "", errors.OAuth2ServerError("failed get token claims").WithError(err)
-	}
+// tokenProvider returns a provider for the token, with circuit breaking for
+// the service, recording provider requests if the request is being debug
+// captured.
+func (o *Openstack) tokenProvider(ctx context.Context, token string, service string) *openstack.TokenProvider {
+	transport := http.DefaultTransport
 
-	if claims.UnikornClaims == nil {
-		return "", errors.OAuth2ServerError("failed get token claim")
+	if capture := debug.FromContext(ctx); capture != nil {
+		transport = capture.Transport(transport)
 	}
 
-	return claims.UnikornClaims.User, nil
+	return openstack.NewTokenProvider(o.endpoint, token).WithTransport(o.transport(transport, service))
 }
 
-func (o *Openstack) IdentityClient(r *http.Request) (*openstack.IdentityClient, error) {
-	token, err := getToken(r)
-	if err != nil {
-		return nil, err
-	}
+func (o *Openstack) IdentityClient(ctx context.Context, identity *providers.Identity) (*openstack.IdentityClient, error) {
+	token := identity.Token
 
-	if client, ok := o.identityClientCache.Get(token); ok {
+	if client, ok := o.identityClientCache.Get(token); ok && !capturing(ctx) {
 		return client, nil
 	}
 
-	client, err := openstack.NewIdentityClient(openstack.NewTokenProvider(o.endpoint, token))
+	client, err := openstack.NewIdentityClient(o.tokenProvider(ctx, token, serviceIdentity))
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get identity client").WithError(err)
+		return nil, clientError("failed get identity client", err)
 	}
 
-	o.identityClientCache.Add(token, client)
+	if !capturing(ctx) {
+		o.identityClientCache.Add(token, client)
+	}
 
 	return client, nil
 }
 
-func (o *Openstack) ComputeClient(r *http.Request) (*openstack.ComputeClient, error) {
-	token, err := getToken(r)
-	if err != nil {
-		return nil, err
-	}
+func (o *Openstack) ComputeClient(ctx context.Context, identity *providers.Identity) (*openstack.ComputeClient, error) {
+	token := identity.Token
 
-	if client, ok := o.computeClientCache.Get(token); ok {
+	if client, ok := o.computeClientCache.Get(token); ok && !capturing(ctx) {
 		return client, nil
 	}
 
-	client, err := openstack.NewComputeClient(&o.options.ComputeOptions, openstack.NewTokenProvider(o.endpoint, token))
+	client, err := openstack.NewComputeClient(&o.options.ComputeOptions, o.tokenProvider(ctx, token, serviceCompute))
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get compute client").WithError(err)
+		return nil, clientError("failed get compute client", err)
 	}
 
-	o.computeClientCache.Add(token, client)
+	if !capturing(ctx) {
+		o.computeClientCache.Add(token, client)
+	}
 
 	return client, nil
 }
 
-func (o *Openstack) BlockStorageClient(r *http.Request) (*openstack.BlockStorageClient, error) {
-	token, err := getToken(r)
-	if err != nil {
-		return nil, err
-	}
+func (o *Openstack) BlockStorageClient(ctx context.Context, identity *providers.Identity) (*openstack.BlockStorageClient, error) {
+	token := identity.Token
 
-	if client, ok := o.blockStorageClientCache.Get(token); ok {
+	if client, ok := o.blockStorageClientCache.Get(token); ok && !capturing(ctx) {
 		return client, nil
 	}
 
-	client, err := openstack.NewBlockStorageClient(openstack.NewTokenProvider(o.endpoint, token))
+	client, err := openstack.NewBlockStorageClient(o.tokenProvider(ctx, token, serviceBlockStorage))
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get block storage client").WithError(err)
+		return nil, clientError("failed get block storage client", err)
 	}
 
-	o.blockStorageClientCache.Add(token, client)
+	if !capturing(ctx) {
+		o.blockStorageClientCache.Add(token, client)
+	}
 
 	return client, nil
 }
 
-func (o *Openstack) NetworkClient(r *http.Request) (*openstack.NetworkClient, error) {
-	token, err := getToken(r)
-	if err != nil {
-		return nil, err
-	}
+func (o *Openstack) NetworkClient(ctx context.Context, identity *providers.Identity) (*openstack.NetworkClient, error) {
+	token := identity.Token
 
-	if client, ok := o.networkClientCache.Get(token); ok {
+	if client, ok := o.networkClientCache.Get(token); ok && !capturing(ctx) {
 		return client, nil
 	}
 
-	client, err := openstack.NewNetworkClient(openstack.NewTokenProvider(o.endpoint, token))
+	client, err := openstack.NewNetworkClient(o.tokenProvider(ctx, token, serviceNetwork))
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get network client").WithError(err)
+		return nil, clientError("failed get network client", err)
 	}
 
-	o.networkClientCache.Add(token, client)
+	if !capturing(ctx) {
+		o.networkClientCache.Add(token, client)
+	}
 
 	return client, nil
 }
 
-func (o *Openstack) ImageClient(r *http.Request) (*openstack.ImageClient, error) {
-	token, err := getToken(r)
-	if err != nil {
-		return nil, err
-	}
+func (o *Openstack) ImageClient(ctx context.Context, identity *providers.Identity) (*openstack.ImageClient, error) {
+	token := identity.Token
 
-	if client, ok := o.imageClientCache.Get(token); ok {
+	if client, ok := o.imageClientCache.Get(token); ok && !capturing(ctx) {
 		return client, nil
 	}
 
-	client, err := openstack.NewImageClient(openstack.NewTokenProvider(o.endpoint, token))
+	client, err := openstack.NewImageClient(o.tokenProvider(ctx, token, serviceImage))
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get image client").WithError(err)
+		return nil, clientError("failed get image client", err)
 	}
 
-	o.imageClientCache.Add(token, client)
+	if !capturing(ctx) {
+		o.imageClientCache.Add(token, client)
+	}
 
 	return client, nil
 }
 
-func (o *Openstack) ListAvailabilityZonesCompute(r *http.Request) (generated.OpenstackAvailabilityZones, error) {
-	client, err := o.ComputeClient(r)
+func (o *Openstack) ListAvailabilityZonesCompute(ctx context.Context, identity *providers.Identity) ([]providers.AvailabilityZone, error) {
+	// Host counts are only visible to administrators.
+	return cachedUserList(ctx, o, identity, "compute-availability-zones", func() ([]providers.AvailabilityZone, error) {
+		return o.listAvailabilityZonesCompute(ctx, identity)
+	})
+}
+
+// listAvailabilityZonesCompute lists compute availability zones from OpenStack.
+func (o *Openstack) listAvailabilityZonesCompute(ctx context.Context, identity *providers.Identity) ([]providers.AvailabilityZone, error) {
+	client, err := o.ComputeClient(ctx, identity)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get compute client").WithError(err)
+		return nil, err
 	}
 
-	result, err := client.AvailabilityZones(r.Context())
+	result, err := client.ListAvailabilityZones(ctx)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, ConvertError(err)
 	}
 
-	azs := make(generated.OpenstackAvailabilityZones, len(result))
+	azs := make([]providers.AvailabilityZone, len(result))
 
-	for i, az := range result {
-		azs[i].Name = az.ZoneName
+	for i := range result {
+		azs[i].Name = result[i].ZoneName
+		azs[i].Available = result[i].ZoneState.Available
+		azs[i].Hosts = openstack.AvailabilityZoneComputeHosts(&result[i])
+		azs[i].Annotations = o.options.ComputeAvailabilityZoneAnnotations.Get(result[i].ZoneName)
 	}
 
 	return azs, nil
 }
 
-func (o *Openstack) ListAvailabilityZonesBlockStorage(r *http.Request) (generated.OpenstackAvailabilityZones, error) {
-	client, err := o.BlockStorageClient(r)
+func (o *Openstack) ListAvailabilityZonesBlockStorage(ctx context.Context, identity *providers.Identity) ([]providers.AvailabilityZone, error) {
+	return cachedList(ctx, o, identity, "block-storage-availability-zones", func() ([]providers.AvailabilityZone, error) {
+		return o.listAvailabilityZonesBlockStorage(ctx, identity)
+	})
+}
+
+// listAvailabilityZonesBlockStorage lists block storage availability zones from OpenStack.
+func (o *Openstack) listAvailabilityZonesBlockStorage(ctx context.Context, identity *providers.Identity) ([]providers.AvailabilityZone, error) {
+	client, err := o.BlockStorageClient(ctx, identity)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get block storage client").WithError(err)
+		return nil, err
 	}
 
-	result, err := client.AvailabilityZones(r.Context())
+	result, err := client.ListAvailabilityZones(ctx)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, ConvertError(err)
 	}
 
-	azs := make(generated.OpenstackAvailabilityZones, len(result))
+	azs := make([]providers.AvailabilityZone, len(result))
 
 	for i, az := range result {
 		azs[i].Name = az.ZoneName
+		azs[i].Available = az.ZoneState.Available
+		azs[i].Annotations = o.options.BlockStorageAvailabilityZoneAnnotations.Get(az.ZoneName)
 	}
 
 	return azs, nil
 }
 
-func (o *Openstack) ListExternalNetworks(r *http.Request) (interface{}, error) {
-	client, err := o.NetworkClient(r)
+func (o *Openstack) ListExternalNetworks(ctx context.Context, identity *providers.Identity) ([]providers.ExternalNetwork, error) {
+	client, err := o.NetworkClient(ctx, identity)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get network client").WithError(err)
+		return nil, err
 	}
 
-	result, err := client.ExternalNetworks(r.Context())
+	result, err := client.ExternalNetworks(ctx)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, ConvertError(err)
 	}
 
-	externalNetworks := make(generated.OpenstackExternalNetworks, len(result))
+	externalNetworks := make([]providers.ExternalNetwork, len(result))
 
 	for i, externalNetwork := range result {
-		externalNetworks[i].Id = externalNetwork.ID
+		externalNetworks[i].ID = externalNetwork.ID
 		externalNetworks[i].Name = externalNetwork.Name
 	}
 
 	return externalNetworks, nil
 }
 
-// convertFlavor traslates from Openstack's mess into our API types.
-func convertFlavor(client *openstack.ComputeClient, flavor *openstack.Flavor) (*generated.OpenstackFlavor, error) {
-	f := &generated.OpenstackFlavor{
-		Id:     flavor.ID,
+// GetSubnet returns the subnet with the given ID.
+func (o *Openstack) GetSubnet(ctx context.Context, identity *providers.Identity, id string) (*providers.Subnet, error) {
+	client, err := o.NetworkClient(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.Subnet(ctx, id)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	subnet := &providers.Subnet{
+		ID:        result.ID,
+		NetworkID: result.NetworkID,
+		CIDR:      result.CIDR,
+	}
+
+	return subnet, nil
+}
+
+// convertFlavor traslates from Openstack's mess into our provider types.
+func convertFlavor(client *openstack.ComputeClient, flavor *openstack.Flavor) (*providers.Flavor, error) {
+	f := &providers.Flavor{
+		ID:     flavor.ID,
 		Name:   flavor.Name,
-		Cpus:   flavor.VCPUs,
+		CPUs:   flavor.VCPUs,
 		Memory: flavor.RAM >> 10, // Convert MiB to GiB
 		Disk:   flavor.Disk,
 	}
@@ -324,14 +342,20 @@ func convertFlavor(client *openstack.ComputeClient, flavor *openstack.Flavor) (*
 	}
 
 	if gpu != nil {
-		f.Gpus = &gpu.GPUs
+		f.GPU = &providers.GPU{
+			Count:                gpu.GPUs,
+			Memory:               gpu.Memory,
+			Model:                gpu.Model,
+			Profile:              gpu.Profile,
+			MinimumDriverVersion: gpu.MinimumDriverVersion,
+		}
 	}
 
 	return f, nil
 }
 
 type flavorSortWrapper struct {
-	f generated.OpenstackFlavors
+	f []providers.Flavor
 }
 
 func (w flavorSortWrapper) Len() int {
@@ -341,24 +365,24 @@ func (w flavorSortWrapper) Len() int {
 func (w flavorSortWrapper) Less(i, j int) bool {
 	// Sort by GPUs, we want these to have precedence, we are selling GPUs
 	// after all.
-	if w.f[i].Gpus != nil {
-		if w.f[j].Gpus == nil {
+	if w.f[i].GPU != nil {
+		if w.f[j].GPU == nil {
 			return true
 		}
 
 		// Those with the smallest number of GPUs go first, we want to
 		// prevent over provisioning.
-		if *w.f[i].Gpus < *w.f[j].Gpus {
+		if w.f[i].GPU.Count < w.f[j].GPU.Count {
 			return true
 		}
 	}
 
-	if w.f[j].Gpus != nil && w.f[i].Gpus == nil {
+	if w.f[j].GPU != nil && w.f[i].GPU == nil {
 		return false
 	}
 
 	// If the GPUs are the same, sort by CPUs.
-	if w.f[i].Cpus < w.f[j].Cpus {
+	if w.f[i].CPUs < w.f[j].CPUs {
 		return true
 	}
 
@@ -369,18 +393,25 @@ func (w flavorSortWrapper) Swap(i, j int) {
 	w.f[i], w.f[j] = w.f[j], w.f[i]
 }
 
-func (o *Openstack) ListFlavors(r *http.Request) (generated.OpenstackFlavors, error) {
-	client, err := o.ComputeClient(r)
+func (o *Openstack) ListFlavors(ctx context.Context, identity *providers.Identity) ([]providers.Flavor, error) {
+	return cachedList(ctx, o, identity, "flavors", func() ([]providers.Flavor, error) {
+		return o.listFlavors(ctx, identity)
+	})
+}
+
+// listFlavors lists flavors from OpenStack.
+func (o *Openstack) listFlavors(ctx context.Context, identity *providers.Identity) ([]providers.Flavor, error) {
+	client, err := o.ComputeClient(ctx, identity)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get compute client").WithError(err)
+		return nil, err
 	}
 
-	result, err := client.Flavors(r.Context())
+	result, err := client.Flavors(ctx)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, ConvertError(err)
 	}
 
-	flavors := make(generated.OpenstackFlavors, len(result))
+	flavors := make([]providers.Flavor, len(result))
 
 	for i := range result {
 		flavor, err := convertFlavor(client, &result[i])
@@ -401,8 +432,8 @@ func (o *Openstack) ListFlavors(r *http.Request) (generated.OpenstackFlavors, er
 }
 
 // GetFlavor does a list and find, while inefficient, it does do image filtering.
-func (o *Openstack) GetFlavor(r *http.Request, name string) (*generated.OpenstackFlavor, error) {
-	flavors, err := o.ListFlavors(r)
+func (o *Openstack) GetFlavor(ctx context.Context, identity *providers.Identity, name string) (*providers.Flavor, error) {
+	flavors, err := o.ListFlavors(ctx, identity)
 	if err != nil {
 		return nil, err
 	}
@@ -413,12 +444,12 @@ func (o *Openstack) GetFlavor(r *http.Request, name string) (*generated.Openstac
 		}
 	}
 
-	return nil, errors.HTTPNotFound().WithError(fmt.Errorf("%w: flavor %s", ErrResourceNotFound, name))
+	return nil, fmt.Errorf("%w: flavor %s", openstack.ErrResourceNotFound, name)
 }
 
 // imageSortWrapper sorts images by age.
 type imageSortWrapper struct {
-	images []generated.OpenstackImage
+	images []providers.Image
 }
 
 func (w imageSortWrapper) Len() int {
@@ -433,29 +464,36 @@ func (w imageSortWrapper) Swap(i, j int) {
 	w.images[i], w.images[j] = w.images[j], w.images[i]
 }
 
-func (o *Openstack) ListImages(r *http.Request) (generated.OpenstackImages, error) {
-	client, err := o.ImageClient(r)
+func (o *Openstack) ListImages(ctx context.Context, identity *providers.Identity) ([]providers.Image, error) {
+	return cachedList(ctx, o, identity, "images", func() ([]providers.Image, error) {
+		return o.listImages(ctx, identity)
+	})
+}
+
+// listImages lists images from OpenStack.
+func (o *Openstack) listImages(ctx context.Context, identity *providers.Identity) ([]providers.Image, error) {
+	client, err := o.ImageClient(ctx, identity)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get image client").WithError(err)
+		return nil, err
 	}
 
-	result, err := client.Images(r.Context(), o.options.Key.key, o.options.Properties)
+	result, err := client.Images(ctx, o.options.Key.key, o.options.Properties)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, ConvertError(err)
 	}
 
-	images := make(generated.OpenstackImages, len(result))
+	images := make([]providers.Image, len(result))
 
 	for i, image := range result {
 		kubernetesVersion, _ := image.Properties["k8s"].(string)
 		nvidiaDriverVersion, _ := image.Properties["gpu"].(string)
 
-		images[i].Id = image.ID
+		images[i].ID = image.ID
 		images[i].Name = image.Name
 		images[i].Created = image.CreatedAt
 		images[i].Modified = image.UpdatedAt
-		images[i].Versions.Kubernetes = "v" + kubernetesVersion
-		images[i].Versions.NvidiaDriver = nvidiaDriverVersion
+		images[i].KubernetesVersion = "v" + kubernetesVersion
+		images[i].NvidiaDriverVersion = nvidiaDriverVersion
 	}
 
 	w := imageSortWrapper{
@@ -468,8 +506,8 @@ func (o *Openstack) ListImages(r *http.Request) (generated.OpenstackImages, erro
 }
 
 // GetImage does a list and find, while inefficient, it does do image filtering.
-func (o *Openstack) GetImage(r *http.Request, name string) (*generated.OpenstackImage, error) {
-	images, err := o.ListImages(r)
+func (o *Openstack) GetImage(ctx context.Context, identity *providers.Identity, name string) (*providers.Image, error) {
+	images, err := o.ListImages(ctx, identity)
 	if err != nil {
 		return nil, err
 	}
@@ -480,47 +518,122 @@ func (o *Openstack) GetImage(r *http.Request, name string) (*generated.Openstack
 		}
 	}
 
-	return nil, errors.HTTPNotFound().WithError(fmt.Errorf("%w: image %s", ErrResourceNotFound, name))
+	return nil, fmt.Errorf("%w: image %s", openstack.ErrResourceNotFound, name)
+}
+
+// parseDriverVersion converts a dotted driver version e.g. 525.85.05 into
+// its numerical components.
+func parseDriverVersion(version string) ([]int, error) {
+	fields := strings.Split(version, ".")
+
+	result := make([]int, len(fields))
+
+	for i, field := range fields {
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = value
+	}
+
+	return result, nil
+}
+
+// driverVersionCompatible returns true if the version is at least as new as
+// the minimum version.  Versions that cannot be parsed are never compatible.
+func driverVersionCompatible(version, minimum string) bool {
+	v, err := parseDriverVersion(version)
+	if err != nil {
+		return false
+	}
+
+	m, err := parseDriverVersion(minimum)
+	if err != nil {
+		return false
+	}
+
+	for i := range m {
+		if i >= len(v) {
+			return false
+		}
+
+		if v[i] != m[i] {
+			return v[i] > m[i]
+		}
+	}
+
+	return true
+}
+
+// ListCompatibleImages returns images that can be used with the named flavor.
+// GPU flavors require an image with an nvidia driver, and if the flavor defines
+// a minimum driver version, that the image's driver is at least that version.
+func (o *Openstack) ListCompatibleImages(ctx context.Context, identity *providers.Identity, flavorName string) ([]providers.Image, error) {
+	flavor, err := o.GetFlavor(ctx, identity, flavorName)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := o.ListImages(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if flavor.GPU == nil {
+		return images, nil
+	}
+
+	images = slices.DeleteFunc(images, func(image providers.Image) bool {
+		if image.NvidiaDriverVersion == "" {
+			return true
+		}
+
+		if flavor.GPU.MinimumDriverVersion == "" {
+			return false
+		}
+
+		return !driverVersionCompatible(image.NvidiaDriverVersion, flavor.GPU.MinimumDriverVersion)
+	})
+
+	return images, nil
 }
 
 // ListAvailableProjects lists projects that the token has roles associated with.
-func (o *Openstack) ListAvailableProjects(r *http.Request) (generated.OpenstackProjects, error) {
-	client, err := o.IdentityClient(r)
+func (o *Openstack) ListAvailableProjects(ctx context.Context, identity *providers.Identity) ([]providers.Project, error) {
+	client, err := o.IdentityClient(ctx, identity)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get identity client").WithError(err)
+		return nil, err
 	}
 
-	result, err := client.ListAvailableProjects(r.Context())
+	result, err := client.ListAvailableProjects(ctx)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, ConvertError(err)
 	}
 
-	projects := make(generated.OpenstackProjects, len(result))
+	projects := make([]providers.Project, len(result))
 
 	for i, project := range result {
-		projects[i].Id = project.ID
+		projects[i].ID = project.ID
 		projects[i].Name = project.Name
-
-		if project.Description != "" {
-			projects[i].Description = &result[i].Description
-		}
+		projects[i].Description = project.Description
 	}
 
 	return projects, nil
 }
 
-func (o *Openstack) ListKeyPairs(r *http.Request) (generated.OpenstackKeyPairs, error) {
-	client, err := o.ComputeClient(r)
+func (o *Openstack) ListKeyPairs(ctx context.Context, identity *providers.Identity) ([]providers.KeyPair, error) {
+	client, err := o.ComputeClient(ctx, identity)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get compute client").WithError(err)
+		return nil, err
 	}
 
-	result, err := client.KeyPairs(r.Context())
+	result, err := client.KeyPairs(ctx)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, ConvertError(err)
 	}
 
-	keyPairs := generated.OpenstackKeyPairs{}
+	keyPairs := []providers.KeyPair{}
 
 	for _, keyPair := range result {
 		// Undocumented (what a shocker), but absence means SSH as that's
@@ -530,7 +643,7 @@ func (o *Openstack) ListKeyPairs(r *http.Request) (generated.OpenstackKeyPairs,
 			continue
 		}
 
-		k := generated.OpenstackKeyPair{
+		k := providers.KeyPair{
 			Name: keyPair.Name,
 		}
 
@@ -540,100 +653,309 @@ func (o *Openstack) ListKeyPairs(r *http.Request) (generated.OpenstackKeyPairs,
 	return keyPairs, nil
 }
 
-// findApplicationCredential, in the spirit of making the platform usable, allows
-// a client to use names, rather than IDs for lookups.
-func findApplicationCredential(in []applicationcredentials.ApplicationCredential, name string) (*applicationcredentials.ApplicationCredential, error) {
-	for i, c := range in {
-		if c.Name == name {
-			return &in[i], nil
+// GetKeyPair does a list and find, while inefficient, it does do type filtering.
+func (o *Openstack) GetKeyPair(ctx context.Context, identity *providers.Identity, name string) (*providers.KeyPair, error) {
+	keyPairs, err := o.ListKeyPairs(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range keyPairs {
+		if keyPairs[i].Name == name {
+			return &keyPairs[i], nil
 		}
 	}
 
-	return nil, errors.HTTPNotFound().WithError(fmt.Errorf("%w: application credential %s", ErrResourceNotFound, name))
+	return nil, fmt.Errorf("%w: key pair %s", openstack.ErrResourceNotFound, name)
+}
+
+// convertCredential translates from an application credential to the generic type.
+func convertCredential(in *applicationcredentials.ApplicationCredential) providers.Credential {
+	return providers.Credential{
+		ID:          in.ID,
+		Name:        in.Name,
+		Description: in.Description,
+		Secret:      in.Secret,
+	}
 }
 
-func (o *Openstack) GetApplicationCredential(r *http.Request, name string) (*applicationcredentials.ApplicationCredential, error) {
-	user, err := getUser(r)
+// ListCredentials returns all application credentials owned by the user.
+func (o *Openstack) ListCredentials(ctx context.Context, identity *providers.Identity) ([]providers.Credential, error) {
+	client, err := o.IdentityClient(ctx, identity)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := o.IdentityClient(r)
+	result, err := client.ListApplicationCredentials(ctx, identity.UserID)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get identity client").WithError(err)
+		return nil, ConvertError(err)
+	}
+
+	credentials := make([]providers.Credential, len(result))
+
+	for i := range result {
+		credentials[i] = convertCredential(&result[i])
 	}
 
-	result, err := client.ListApplicationCredentials(r.Context(), user)
+	return credentials, nil
+}
+
+// getRoles returns the names of the roles the user has on the project.
+func (o *Openstack) getRoles(ctx context.Context, identity *providers.Identity, client *openstack.IdentityClient) ([]string, error) {
+	roles, err := client.GetTokenRoles(ctx, identity.Token)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, ConvertError(err)
+	}
+
+	names := make([]string, len(roles))
+
+	for i := range roles {
+		names[i] = roles[i].Name
 	}
 
-	match, err := findApplicationCredential(result, name)
+	return names, nil
+}
+
+// missingRoles returns any of the requested roles that the user doesn't have,
+// and therefore cannot delegate to an application credential.
+func (o *Openstack) missingRoles(ctx context.Context, identity *providers.Identity, client *openstack.IdentityClient, roles []string) ([]string, error) {
+	userRoles, err := o.getRoles(ctx, identity, client)
 	if err != nil {
 		return nil, err
 	}
 
-	return match, nil
+	var missing []string
+
+	for _, role := range roles {
+		if !slices.Contains(userRoles, role) {
+			missing = append(missing, role)
+		}
+	}
+
+	return missing, nil
 }
 
-func (o *Openstack) CreateApplicationCredential(r *http.Request, name string, roles []string) (*applicationcredentials.ApplicationCredential, error) {
-	user, err := getUser(r)
+// GetApplicationCredentialRoles returns the roles the platform requires in order
+// to create application credentials, and any of those the user is missing.
+func (o *Openstack) GetApplicationCredentialRoles(ctx context.Context, identity *providers.Identity) (*providers.ApplicationCredentialRoles, error) {
+	client, err := o.IdentityClient(ctx, identity)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := o.IdentityClient(r)
+	missing, err := o.missingRoles(ctx, identity, client, o.options.ApplicationCredentialRoles)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get identity client").WithError(err)
+		return nil, err
+	}
+
+	result := &providers.ApplicationCredentialRoles{
+		Required: slices.Clone(o.options.ApplicationCredentialRoles),
+		Missing:  missing,
 	}
 
-	description := "Automatically generated by platform service [DO NOT DELETE]."
+	return result, nil
+}
 
-	result, err := client.CreateApplicationCredential(r.Context(), user, name, description, roles)
+// CreateCredential creates an application credential with the roles required
+// by the platform.
+func (o *Openstack) CreateCredential(ctx context.Context, identity *providers.Identity, name, description string) (*providers.Credential, error) {
+	client, err := o.IdentityClient(ctx, identity)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, err
 	}
 
-	return result, nil
+	// Keystone will reject the request if the user doesn't have all the roles,
+	// but not tell us which, so check first to give the user something actionable.
+	missing, err := o.missingRoles(ctx, identity, client, o.options.ApplicationCredentialRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(missing) != 0 {
+		return nil, errors.HTTPForbidden("missing roles required to create application credentials: " + strings.Join(missing, ", "))
+	}
+
+	result, err := client.CreateApplicationCredential(ctx, identity.UserID, name, description, o.options.ApplicationCredentialRoles)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	credential := convertCredential(result)
+
+	return &credential, nil
 }
 
-func (o *Openstack) DeleteApplicationCredential(r *http.Request, name string) error {
-	user, err := getUser(r)
+// DeleteCredential deletes an application credential.
+func (o *Openstack) DeleteCredential(ctx context.Context, identity *providers.Identity, id string) error {
+	client, err := o.IdentityClient(ctx, identity)
 	if err != nil {
 		return err
 	}
 
-	client, err := o.IdentityClient(r)
+	if err := client.DeleteApplicationCredential(ctx, identity.UserID, id); err != nil {
+		return errors.OAuth2ServerError("failed delete application credentials").WithError(err)
+	}
+
+	return nil
+}
+
+// GetUser returns the user the token was issued to.
+func (o *Openstack) GetUser(ctx context.Context, identity *providers.Identity) (*users.User, error) {
+	client, err := o.IdentityClient(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.GetUser(ctx, identity.UserID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	return result, nil
+}
+
+// FindUser looks up a user by name in the same domain as the user the token
+// was issued to.
+func (o *Openstack) FindUser(ctx context.Context, identity *providers.Identity, name string) (*providers.User, error) {
+	self, err := o.GetUser(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := o.IdentityClient(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.FindUser(ctx, self.DomainID, name)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	switch len(result) {
+	case 0:
+		return nil, fmt.Errorf("%w: user %s", openstack.ErrResourceNotFound, name)
+	case 1:
+		user := &providers.User{
+			ID:   result[0].ID,
+			Name: result[0].Name,
+		}
+
+		return user, nil
+	default:
+		return nil, errors.OAuth2ServerError("multiple users matched name")
+	}
+}
+
+// GetRole looks up a role by name.
+func (o *Openstack) GetRole(ctx context.Context, identity *providers.Identity, name string) (*providers.Role, error) {
+	client, err := o.IdentityClient(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.ListRoles(ctx)
 	if err != nil {
-		return errors.OAuth2ServerError("failed get identity client").WithError(err)
+		return nil, ConvertError(err)
+	}
+
+	index := slices.IndexFunc(result, func(role roles.Role) bool {
+		return role.Name == name
+	})
+
+	if index < 0 {
+		return nil, errors.OAuth2ServerError("role " + name + " does not exist")
 	}
 
-	result, err := client.ListApplicationCredentials(r.Context(), user)
+	role := &providers.Role{
+		ID:   result[index].ID,
+		Name: result[index].Name,
+	}
+
+	return role, nil
+}
+
+// ListProjectRoleAssignments lists all user role assignments on the scoped project.
+func (o *Openstack) ListProjectRoleAssignments(ctx context.Context, identity *providers.Identity) ([]providers.RoleAssignment, error) {
+	client, err := o.IdentityClient(ctx, identity)
 	if err != nil {
-		return covertError(err)
+		return nil, err
 	}
 
-	match, err := findApplicationCredential(result, name)
+	result, err := client.ListProjectRoleAssignments(ctx, identity.ProjectID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	assignments := make([]providers.RoleAssignment, 0, len(result))
+
+	for i := range result {
+		// Group assignments have no user.
+		if result[i].User.ID == "" {
+			continue
+		}
+
+		assignments = append(assignments, providers.RoleAssignment{
+			User: providers.User{
+				ID:   result[i].User.ID,
+				Name: result[i].User.Name,
+			},
+			Role: providers.Role{
+				ID:   result[i].Role.ID,
+				Name: result[i].Role.Name,
+			},
+		})
+	}
+
+	return assignments, nil
+}
+
+// AssignProjectRole grants a user a role on the scoped project.
+func (o *Openstack) AssignProjectRole(ctx context.Context, identity *providers.Identity, userID, roleID string) error {
+	client, err := o.IdentityClient(ctx, identity)
 	if err != nil {
 		return err
 	}
 
-	if err := client.DeleteApplicationCredential(r.Context(), user, match.ID); err != nil {
-		return errors.OAuth2ServerError("failed delete application credentials").WithError(err)
+	if err := client.AssignProjectRole(ctx, identity.ProjectID, userID, roleID); err != nil {
+		return ConvertError(err)
+	}
+
+	return nil
+}
+
+// UnassignProjectRole revokes a user's role on the scoped project.
+func (o *Openstack) UnassignProjectRole(ctx context.Context, identity *providers.Identity, userID, roleID string) error {
+	client, err := o.IdentityClient(ctx, identity)
+	if err != nil {
+		return err
+	}
+
+	if err := client.UnassignProjectRole(ctx, identity.ProjectID, userID, roleID); err != nil {
+		return ConvertError(err)
 	}
 
 	return nil
 }
 
-func (o *Openstack) GetServerGroup(r *http.Request, name string) (*servergroups.ServerGroup, error) {
-	client, err := o.ComputeClient(r)
+// convertServerGroup translates from a server group to the generic type.
+func convertServerGroup(in *servergroups.ServerGroup) *providers.ServerGroup {
+	return &providers.ServerGroup{
+		ID:   in.ID,
+		Name: in.Name,
+	}
+}
+
+// GetServerGroup returns the named server group, which must be unique.
+func (o *Openstack) GetServerGroup(ctx context.Context, identity *providers.Identity, name string) (*providers.ServerGroup, error) {
+	client, err := o.ComputeClient(ctx, identity)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get compute client").WithError(err)
+		return nil, err
 	}
 
-	result, err := client.ListServerGroups(r.Context())
+	result, err := client.ListServerGroups(ctx)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, ConvertError(err)
 	}
 
 	filtered := slices.DeleteFunc(result, func(group servergroups.ServerGroup) bool {
@@ -642,23 +964,96 @@ func (o *Openstack) GetServerGroup(r *http.Request, name string) (*servergroups.
 
 	switch len(filtered) {
 	case 0:
-		return nil, errors.HTTPNotFound().WithError(fmt.Errorf("%w: server group %s", ErrResourceNotFound, name))
+		return nil, fmt.Errorf("%w: server group %s", openstack.ErrResourceNotFound, name)
 	case 1:
-		return &filtered[0], nil
+		return convertServerGroup(&filtered[0]), nil
 	default:
-		return nil, errors.OAuth2ServerError("multiple server groups matched name")
+		return nil, errors.HTTPConflictWithDescription("multiple server groups matched name " + name).WithRemediation("server group names must be unique within a project, delete the duplicate server groups")
 	}
 }
 
-func (o *Openstack) CreateServerGroup(r *http.Request, name string) (*servergroups.ServerGroup, error) {
-	client, err := o.ComputeClient(r)
+// CreateServerGroup creates a server group with the requested policy, if the policy
+// is empty then the server group policy option is used as the default.
+func (o *Openstack) CreateServerGroup(ctx context.Context, identity *providers.Identity, name, policy string) (*providers.ServerGroup, error) {
+	client, err := o.ComputeClient(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == "" {
+		policy = o.options.ServerGroupPolicy
+	}
+
+	result, err := client.CreateServerGroup(ctx, name, policy)
 	if err != nil {
-		return nil, errors.OAuth2ServerError("failed get compute client").WithError(err)
+		return nil, ConvertError(err)
+	}
+
+	return convertServerGroup(result), nil
+}
+
+// convertQuota translates from an OpenStack limit and its usage into our provider
+// types.  Reserved resources are counted as used, as they are unavailable for use.
+func convertQuota(limit, inUse, reserved int) providers.Quota {
+	return providers.Quota{
+		Limit: limit,
+		Used:  inUse + reserved,
+	}
+}
+
+// GetQuotas returns quota limits and usage for the scoped project.
+func (o *Openstack) GetQuotas(ctx context.Context, identity *providers.Identity) (*providers.Quotas, error) {
+	projectID := identity.ProjectID
+
+	computeClient, err := o.ComputeClient(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	compute, err := computeClient.QuotaDetail(ctx, projectID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	blockStorageClient, err := o.BlockStorageClient(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	blockStorage, err := blockStorageClient.QuotaUsage(ctx, projectID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	networkClient, err := o.NetworkClient(ctx, identity)
+	if err != nil {
+		return nil, err
 	}
 
-	result, err := client.CreateServerGroup(r.Context(), name, o.options.ServerGroupPolicy)
+	network, err := networkClient.QuotaDetail(ctx, projectID)
 	if err != nil {
-		return nil, covertError(err)
+		return nil, ConvertError(err)
+	}
+
+	result := &providers.Quotas{
+		Compute: providers.ComputeQuotas{
+			Cores:     convertQuota(compute.Cores.Limit, compute.Cores.InUse, compute.Cores.Reserved),
+			RAM:       convertQuota(compute.RAM.Limit, compute.RAM.InUse, compute.RAM.Reserved),
+			Instances: convertQuota(compute.Instances.Limit, compute.Instances.InUse, compute.Instances.Reserved),
+		},
+		BlockStorage: providers.BlockStorageQuotas{
+			Volumes:   convertQuota(blockStorage.Volumes.Limit, blockStorage.Volumes.InUse, blockStorage.Volumes.Reserved),
+			Gigabytes: convertQuota(blockStorage.Gigabytes.Limit, blockStorage.Gigabytes.InUse, blockStorage.Gigabytes.Reserved),
+			// This is a limit on each volume, so has no usage.
+			PerVolumeGigabytes: blockStorage.PerVolumeGigabytes.Limit,
+		},
+		Network: providers.NetworkQuotas{
+			FloatingIPs:    convertQuota(network.FloatingIP.Limit, network.FloatingIP.Used, network.FloatingIP.Reserved),
+			Networks:       convertQuota(network.Network.Limit, network.Network.Used, network.Network.Reserved),
+			Ports:          convertQuota(network.Port.Limit, network.Port.Used, network.Port.Reserved),
+			Routers:        convertQuota(network.Router.Limit, network.Router.Used, network.Router.Reserved),
+			SecurityGroups: convertQuota(network.SecurityGroup.Limit, network.SecurityGroup.Used, network.SecurityGroup.Reserved),
+		},
 	}
 
 	return result, nil
@@ -23,6 +23,8 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 
@@ -38,6 +40,10 @@ var (
 
 	// ErrKeyType is raised when we encounter an unsupported key type.
 	ErrKeyType = errors.New("key type unsupported")
+
+	// ErrAnnotationFormat is raised when an availability zone annotation
+	// is malformed.
+	ErrAnnotationFormat = errors.New("annotation format error")
 )
 
 // PublicKeyVar contains a public key.
@@ -88,6 +94,42 @@ func (v *PublicKeyVar) Type() string {
 	return "publickey"
 }
 
+// AvailabilityZoneAnnotationsVar contains operator provided hints about
+// availability zones e.g. "gpu capacity constrained", keyed by zone name.
+type AvailabilityZoneAnnotationsVar struct {
+	annotations map[string][]string
+}
+
+// Set accepts an annotation in the form zone=annotation, and may be called
+// multiple times to add multiple annotations to a zone.
+func (v *AvailabilityZoneAnnotationsVar) Set(s string) error {
+	zone, annotation, ok := strings.Cut(s, "=")
+	if !ok || zone == "" || annotation == "" {
+		return fmt.Errorf("%w: %s", ErrAnnotationFormat, s)
+	}
+
+	if v.annotations == nil {
+		v.annotations = map[string][]string{}
+	}
+
+	v.annotations[zone] = append(v.annotations[zone], annotation)
+
+	return nil
+}
+
+func (v *AvailabilityZoneAnnotationsVar) String() string {
+	return ""
+}
+
+func (v *AvailabilityZoneAnnotationsVar) Type() string {
+	return "zone=annotation"
+}
+
+// Get returns any annotations for the named zone.
+func (v *AvailabilityZoneAnnotationsVar) Get(zone string) []string {
+	return v.annotations[zone]
+}
+
 type Options struct {
 	ComputeOptions    openstack.ComputeOptions
 	Key               PublicKeyVar
@@ -96,6 +138,24 @@ type Options struct {
 	// applicationCredentialRoles sets the roles an application credential
 	// is granted on creation.
 	ApplicationCredentialRoles []string
+	// ComputeAvailabilityZoneAnnotations are operator provided hints
+	// for compute availability zones.
+	ComputeAvailabilityZoneAnnotations AvailabilityZoneAnnotationsVar
+	// BlockStorageAvailabilityZoneAnnotations are operator provided hints
+	// for block storage availability zones.
+	BlockStorageAvailabilityZoneAnnotations AvailabilityZoneAnnotationsVar
+	// ListCacheTTL is how long flavor, image and availability zone
+	// listings are cached for, per project.
+	ListCacheTTL time.Duration
+	// ListCacheStaleTTL is how long expired listings are retained, and
+	// served, when OpenStack is unavailable.
+	ListCacheStaleTTL time.Duration
+	// CircuitBreakerThreshold is the number of consecutive failures
+	// before requests to an OpenStack service are short circuited.
+	CircuitBreakerThreshold int
+	// CircuitBreakerCooldown is how long requests are short circuited
+	// for before a probe request is allowed.
+	CircuitBreakerCooldown time.Duration
 }
 
 func (o *Options) AddFlags(f *pflag.FlagSet) {
@@ -104,4 +164,10 @@ func (o *Options) AddFlags(f *pflag.FlagSet) {
 	f.StringSliceVar(&o.Properties, "image-properties", nil, "Properties used to filter the list of images")
 	f.StringVar(&o.ServerGroupPolicy, "server-group-policy", "soft-anti-affinity", "Scheduling policy to use for server groups")
 	f.StringSliceVar(&o.ApplicationCredentialRoles, "application-credential-roles", nil, "A role to be added to application credentials on creation.  May be specified more than once.")
+	f.Var(&o.ComputeAvailabilityZoneAnnotations, "compute-availability-zone-annotation", "An operator provided hint for a compute availability zone.  May be specified more than once.")
+	f.Var(&o.BlockStorageAvailabilityZoneAnnotations, "block-storage-availability-zone-annotation", "An operator provided hint for a block storage availability zone.  May be specified more than once.")
+	f.DurationVar(&o.ListCacheTTL, "openstack-list-cache-ttl", 30*time.Second, "How long to cache flavor, image and availability zone listings for, per project.  Zero disables caching.")
+	f.DurationVar(&o.ListCacheStaleTTL, "openstack-list-cache-stale-ttl", 10*time.Minute, "How long to serve expired listings for when OpenStack is unavailable.  Zero disables serving stale listings.")
+	f.IntVar(&o.CircuitBreakerThreshold, "openstack-circuit-breaker-threshold", 5, "How many consecutive failures of an OpenStack service before requests are short circuited.  Zero disables the circuit breaker.")
+	f.DurationVar(&o.CircuitBreakerCooldown, "openstack-circuit-breaker-cooldown", 30*time.Second, "How long requests to a failing OpenStack service are short circuited for before it's probed again.")
 }
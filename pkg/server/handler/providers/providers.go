@@ -0,0 +1,483 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package providers abstracts the cloud that clusters are provisioned on,
+// so that handlers are written once, and additional clouds can be supported
+// by implementing CloudProvider and registering it at startup.
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eschercloudai/unikorn/pkg/collections"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+)
+
+var (
+	// ErrUnknownProvider is raised when a provider type has not been
+	// registered.
+	ErrUnknownProvider = errors.New("unknown provider")
+)
+
+// Identity is the credentials a provider acts with on behalf of a user.
+type Identity struct {
+	// Token is the user's token, scoped to the project.
+	Token string
+
+	// UserID is the user the token was issued to.
+	UserID string
+
+	// ProjectID is the project the token is scoped to.
+	ProjectID string
+}
+
+// GPU describes the GPUs attached to a flavor.
+type GPU struct {
+	// Count is the number of GPUs.
+	Count int
+
+	// Memory is the GPU memory in GiB, if known.
+	Memory *int
+
+	// Model is the GPU model, if known.
+	Model string
+
+	// Profile is the virtual GPU profile, if known.
+	Profile string
+
+	// MinimumDriverVersion is the oldest driver that supports the GPU, if known.
+	MinimumDriverVersion string
+}
+
+// Flavor is a machine type.
+type Flavor struct {
+	// ID uniquely identifies the flavor.
+	ID string
+
+	// Name is the flavor name.
+	Name string
+
+	// CPUs is the number of virtual CPUs.
+	CPUs int
+
+	// Memory is the memory in GiB.
+	Memory int
+
+	// Disk is the root disk size in GiB.
+	Disk int
+
+	// GPU is set if the flavor has GPUs.
+	GPU *GPU
+}
+
+// Image is a machine image.
+type Image struct {
+	// ID uniquely identifies the image.
+	ID string
+
+	// Name is the image name.
+	Name string
+
+	// Created is when the image was created.
+	Created time.Time
+
+	// Modified is when the image was last modified.
+	Modified time.Time
+
+	// KubernetesVersion is the version of Kubernetes installed on the image.
+	KubernetesVersion string
+
+	// NvidiaDriverVersion is the version of the nvidia driver installed on
+	// the image, if any.
+	NvidiaDriverVersion string
+}
+
+// ExternalNetwork is a network that provides external access.
+type ExternalNetwork struct {
+	// ID uniquely identifies the network.
+	ID string
+
+	// Name is the network name.
+	Name string
+}
+
+// Project is a cloud project.
+type Project struct {
+	// ID uniquely identifies the project.
+	ID string
+
+	// Name is the project name.
+	Name string
+
+	// Description is the project description, if any.
+	Description string
+}
+
+// AvailabilityZone is a failure domain.
+type AvailabilityZone struct {
+	// Name is the zone name.
+	Name string
+
+	// Available is true if resources can be placed in the zone.
+	Available bool
+
+	// Hosts is the number of hosts in the zone, if known.
+	Hosts *int
+
+	// Annotations are operator provided annotations for the zone.
+	Annotations []string
+}
+
+// KeyPair is an SSH key pair.
+type KeyPair struct {
+	// Name is the key pair name.
+	Name string
+}
+
+// ApplicationCredentialRoles are the roles the platform requires in order to
+// create credentials.
+type ApplicationCredentialRoles struct {
+	// Required are the roles the platform requires.
+	Required []string
+
+	// Missing are any required roles the user doesn't have.
+	Missing []string
+}
+
+// Quota is a resource limit and its usage.
+type Quota struct {
+	// Limit is the resource limit, negative if unlimited.
+	Limit int
+
+	// Used is the amount of the resource used.
+	Used int
+}
+
+// ComputeQuotas are compute resource limits.
+type ComputeQuotas struct {
+	// Cores is the number of virtual CPUs.
+	Cores Quota
+
+	// RAM is the memory in MiB.
+	RAM Quota
+
+	// Instances is the number of servers.
+	Instances Quota
+}
+
+// BlockStorageQuotas are block storage resource limits.
+type BlockStorageQuotas struct {
+	// Volumes is the number of volumes.
+	Volumes Quota
+
+	// Gigabytes is the total size of all volumes in GiB.
+	Gigabytes Quota
+
+	// PerVolumeGigabytes is the maximum size of a volume in GiB, negative
+	// if unlimited.
+	PerVolumeGigabytes int
+}
+
+// NetworkQuotas are network resource limits.
+type NetworkQuotas struct {
+	// FloatingIPs is the number of floating IPs.
+	FloatingIPs Quota
+
+	// Networks is the number of networks.
+	Networks Quota
+
+	// Ports is the number of ports.
+	Ports Quota
+
+	// Routers is the number of routers.
+	Routers Quota
+
+	// SecurityGroups is the number of security groups.
+	SecurityGroups Quota
+}
+
+// Quotas are resource limits and usage for a project.
+type Quotas struct {
+	// Compute are compute limits.
+	Compute ComputeQuotas
+
+	// BlockStorage are block storage limits.
+	BlockStorage BlockStorageQuotas
+
+	// Network are network limits.
+	Network NetworkQuotas
+}
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState string
+
+const (
+	// CircuitClosed means requests are made as normal.
+	CircuitClosed CircuitState = "closed"
+
+	// CircuitOpen means requests are rejected without being made.
+	CircuitOpen CircuitState = "open"
+
+	// CircuitHalfOpen means a single probe request is allowed to test if
+	// the service has recovered.
+	CircuitHalfOpen CircuitState = "halfOpen"
+)
+
+// ServiceHealth is the health of a cloud service.
+type ServiceHealth struct {
+	// Service is the service name.
+	Service string
+
+	// State is the circuit breaker state.
+	State CircuitState
+
+	// ConsecutiveFailures is the number of failures since the last success.
+	ConsecutiveFailures int
+
+	// LastSuccess is when a request last succeeded, if ever.
+	LastSuccess *time.Time
+
+	// LastFailure is when a request last failed, if ever.
+	LastFailure *time.Time
+}
+
+// Health is the health of a cloud's services.
+type Health struct {
+	// Services are the health of each service.
+	Services []ServiceHealth
+}
+
+// Subnet is a network subnet.
+type Subnet struct {
+	// ID uniquely identifies the subnet.
+	ID string
+
+	// NetworkID is the network the subnet is part of.
+	NetworkID string
+
+	// CIDR is the subnet's prefix.
+	CIDR string
+}
+
+// Credential is a credential, delegated by the user to the platform, that
+// allows a cluster to manage cloud resources.
+type Credential struct {
+	// ID uniquely identifies the credential.
+	ID string
+
+	// Name is the credential name.
+	Name string
+
+	// Description is the credential description, the platform uses this
+	// to record ownership.
+	Description string
+
+	// Secret is the credential secret, this is only populated on creation.
+	Secret string
+}
+
+// ServerGroup is a group of servers with a scheduling policy.
+type ServerGroup struct {
+	// ID uniquely identifies the server group.
+	ID string
+
+	// Name is the server group name.
+	Name string
+}
+
+// User is a cloud user.
+type User struct {
+	// ID uniquely identifies the user.
+	ID string
+
+	// Name is the user name.
+	Name string
+}
+
+// Role is a cloud role that can be granted to users on a project.
+type Role struct {
+	// ID uniquely identifies the role.
+	ID string
+
+	// Name is the role name.
+	Name string
+}
+
+// RoleAssignment grants a user a role on a project.
+type RoleAssignment struct {
+	// User is the user the role is granted to.
+	User User
+
+	// Role is the role granted.
+	Role Role
+}
+
+// Flavors provides access to machine types.
+type Flavors interface {
+	// ListFlavors returns all flavors usable by clusters.
+	ListFlavors(ctx context.Context, identity *Identity) ([]Flavor, error)
+
+	// GetFlavor returns the named flavor.
+	GetFlavor(ctx context.Context, identity *Identity, name string) (*Flavor, error)
+}
+
+// Images provides access to machine images.
+type Images interface {
+	// ListImages returns all images usable by clusters, oldest first.
+	ListImages(ctx context.Context, identity *Identity) ([]Image, error)
+
+	// GetImage returns the named image.
+	GetImage(ctx context.Context, identity *Identity, name string) (*Image, error)
+
+	// ListCompatibleImages returns images that can be used with the named flavor.
+	ListCompatibleImages(ctx context.Context, identity *Identity, flavorName string) ([]Image, error)
+}
+
+// Networks provides access to networking.
+type Networks interface {
+	// ListExternalNetworks returns networks that provide external access.
+	ListExternalNetworks(ctx context.Context, identity *Identity) ([]ExternalNetwork, error)
+
+	// GetSubnet returns the subnet with the given ID.
+	GetSubnet(ctx context.Context, identity *Identity, id string) (*Subnet, error)
+}
+
+// Projects provides access to the cloud projects the user is a member of.
+type Projects interface {
+	// ListAvailableProjects returns the projects the user has access to.
+	ListAvailableProjects(ctx context.Context, identity *Identity) ([]Project, error)
+}
+
+// Credentials manages credentials delegated to clusters.
+type Credentials interface {
+	// CreateCredential creates a credential with the roles the platform
+	// requires to provision clusters.
+	CreateCredential(ctx context.Context, identity *Identity, name, description string) (*Credential, error)
+
+	// ListCredentials returns all credentials owned by the user.
+	ListCredentials(ctx context.Context, identity *Identity) ([]Credential, error)
+
+	// DeleteCredential deletes a credential.
+	DeleteCredential(ctx context.Context, identity *Identity, id string) error
+
+	// GetApplicationCredentialRoles returns the roles the platform requires
+	// in order to create credentials, and any of those the user is missing.
+	GetApplicationCredentialRoles(ctx context.Context, identity *Identity) (*ApplicationCredentialRoles, error)
+}
+
+// Members manages users' roles on the scoped project.
+type Members interface {
+	// FindUser looks up a user by name.
+	FindUser(ctx context.Context, identity *Identity, name string) (*User, error)
+
+	// GetRole looks up a role by name.
+	GetRole(ctx context.Context, identity *Identity, name string) (*Role, error)
+
+	// ListProjectRoleAssignments returns all user role assignments on the
+	// scoped project.  Group assignments are managed outside of the platform
+	// so are omitted.
+	ListProjectRoleAssignments(ctx context.Context, identity *Identity) ([]RoleAssignment, error)
+
+	// AssignProjectRole grants a user a role on the scoped project.
+	AssignProjectRole(ctx context.Context, identity *Identity, userID, roleID string) error
+
+	// UnassignProjectRole revokes a user's role on the scoped project.
+	UnassignProjectRole(ctx context.Context, identity *Identity, userID, roleID string) error
+}
+
+// Machines provides the parameters used to provision machines.
+type Machines interface {
+	// ListAvailabilityZonesCompute returns zones machines can be placed in.
+	ListAvailabilityZonesCompute(ctx context.Context, identity *Identity) ([]AvailabilityZone, error)
+
+	// ListAvailabilityZonesBlockStorage returns zones volumes can be placed in.
+	ListAvailabilityZonesBlockStorage(ctx context.Context, identity *Identity) ([]AvailabilityZone, error)
+
+	// ListKeyPairs returns all SSH key pairs.
+	ListKeyPairs(ctx context.Context, identity *Identity) ([]KeyPair, error)
+
+	// GetKeyPair returns the named SSH key pair.
+	GetKeyPair(ctx context.Context, identity *Identity, name string) (*KeyPair, error)
+
+	// GetServerGroup returns the named server group.
+	GetServerGroup(ctx context.Context, identity *Identity, name string) (*ServerGroup, error)
+
+	// CreateServerGroup creates a server group, if the policy is empty then
+	// the provider's default is used.
+	CreateServerGroup(ctx context.Context, identity *Identity, name, policy string) (*ServerGroup, error)
+
+	// GetQuotas returns the resource limits and usage.
+	GetQuotas(ctx context.Context, identity *Identity) (*Quotas, error)
+}
+
+// CloudProvider is implemented by each supported cloud.  Providers are called
+// with the credentials of the user making the request, and return their own
+// types, which handlers convert into API types.  Providers return errors
+// classified with pkg/errors, or HTTP errors, so handlers need not know where
+// the error originated.
+type CloudProvider interface {
+	Flavors
+	Images
+	Networks
+	Projects
+	Credentials
+	Members
+	Machines
+
+	// Type returns the type of provider.
+	Type() generated.ProviderType
+
+	// Health returns the health of the cloud's services.
+	Health() *Health
+}
+
+// Factory creates a provider.
+type Factory func() (CloudProvider, error)
+
+// Registry maps provider types to their factories.
+type Registry struct {
+	factories map[generated.ProviderType]Factory
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: map[generated.ProviderType]Factory{},
+	}
+}
+
+// Register adds a provider type to the registry.
+func (r *Registry) Register(t generated.ProviderType, factory Factory) {
+	r.factories[t] = factory
+}
+
+// Types returns the registered provider types, sorted by name.
+func (r *Registry) Types() []generated.ProviderType {
+	return collections.SortedKeys(r.factories)
+}
+
+// New creates a provider of the requested type.
+func (r *Registry) New(t generated.ProviderType) (CloudProvider, error) {
+	factory, ok := r.factories[t]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s, expected one of %v", ErrUnknownProvider, t, r.Types())
+	}
+
+	return factory()
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/providers"
+)
+
+var errFactory = errors.New("factory failed")
+
+// TestRegistry tests providers are created by type, and that factory errors
+// are propagated.
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := providers.NewRegistry()
+
+	registry.Register(generated.Openstack, func() (providers.CloudProvider, error) {
+		return nil, errFactory
+	})
+
+	assert.Equal(t, []generated.ProviderType{generated.Openstack}, registry.Types())
+
+	_, err := registry.New(generated.Openstack)
+	assert.ErrorIs(t, err, errFactory)
+}
+
+// TestRegistryUnknown tests unregistered provider types are rejected.
+func TestRegistryUnknown(t *testing.T) {
+	t.Parallel()
+
+	registry := providers.NewRegistry()
+
+	_, err := registry.New(generated.ProviderType("vsphere"))
+	assert.ErrorIs(t, err, providers.ErrUnknownProvider)
+}
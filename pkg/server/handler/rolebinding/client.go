@@ -0,0 +1,247 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolebinding
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/project"
+
+	"github.com/eschercloudai/unikorn-core/pkg/constants"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Client wraps up project role binding management handling.
+type Client struct {
+	// client allows Kubernetes API access.
+	client client.Client
+}
+
+// NewClient returns a new client with required parameters.
+func NewClient(client client.Client) *Client {
+	return &Client{
+		client: client,
+	}
+}
+
+// convertSubjectKind translates from the Kubernetes to the API subject kind.
+func convertSubjectKind(in unikornv1.ProjectRoleBindingSubjectKind) generated.ProjectRoleBindingSubjectKind {
+	if in == unikornv1.ProjectRoleBindingSubjectKeystoneRole {
+		return generated.KeystoneRole
+	}
+
+	return generated.OidcGroup
+}
+
+// convert translates from the Kubernetes to the API type.
+func convert(in *unikornv1.ProjectRoleBinding) *generated.ProjectRoleBinding {
+	out := &generated.ProjectRoleBinding{
+		Name:     in.Name,
+		Role:     generated.ProjectRole(in.Spec.Role),
+		Subjects: make([]generated.ProjectRoleBindingSubject, len(in.Spec.Subjects)),
+	}
+
+	for i, subject := range in.Spec.Subjects {
+		out.Subjects[i] = generated.ProjectRoleBindingSubject{
+			Kind: convertSubjectKind(subject.Kind),
+			Name: subject.Name,
+		}
+	}
+
+	return out
+}
+
+// createSubjectKind translates from the API to the Kubernetes subject kind.
+func createSubjectKind(in generated.ProjectRoleBindingSubjectKind) unikornv1.ProjectRoleBindingSubjectKind {
+	if in == generated.KeystoneRole {
+		return unikornv1.ProjectRoleBindingSubjectKeystoneRole
+	}
+
+	return unikornv1.ProjectRoleBindingSubjectOIDCGroup
+}
+
+// createSpec translates from the API to the Kubernetes specification.
+func createSpec(request *generated.ProjectRoleBinding) unikornv1.ProjectRoleBindingSpec {
+	spec := unikornv1.ProjectRoleBindingSpec{
+		Role:     string(request.Role),
+		Subjects: make([]unikornv1.ProjectRoleBindingSubject, len(request.Subjects)),
+	}
+
+	for i, subject := range request.Subjects {
+		spec.Subjects[i] = unikornv1.ProjectRoleBindingSubject{
+			Kind: createSubjectKind(subject.Kind),
+			Name: subject.Name,
+		}
+	}
+
+	return spec
+}
+
+// List returns all role bindings in the project.
+func (c *Client) List(ctx context.Context) (generated.ProjectRoleBindings, error) {
+	project, err := project.NewClient(c.client).GetMetadata(ctx)
+	if err != nil {
+		// No project, no bindings.
+		if errors.IsHTTPNotFound(err) {
+			return generated.ProjectRoleBindings{}, nil
+		}
+
+		return nil, err
+	}
+
+	result := &unikornv1.ProjectRoleBindingList{}
+
+	if err := c.client.List(ctx, result, &client.ListOptions{Namespace: project.Namespace}); err != nil {
+		return nil, errors.OAuth2ServerError("failed to list project role bindings").WithError(err)
+	}
+
+	slices.SortFunc(result.Items, func(a, b unikornv1.ProjectRoleBinding) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	out := make(generated.ProjectRoleBindings, len(result.Items))
+
+	for i := range result.Items {
+		out[i] = *convert(&result.Items[i])
+	}
+
+	return out, nil
+}
+
+// get returns the role binding.
+func (c *Client) get(ctx context.Context, namespace, name string) (*unikornv1.ProjectRoleBinding, error) {
+	result := &unikornv1.ProjectRoleBinding{}
+
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, result); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.HTTPNotFound().WithError(err)
+		}
+
+		return nil, errors.OAuth2ServerError("failed to get project role binding").WithError(err)
+	}
+
+	return result, nil
+}
+
+// Get returns the role binding.
+func (c *Client) Get(ctx context.Context, name generated.RoleBindingNameParameter) (*generated.ProjectRoleBinding, error) {
+	project, err := project.NewClient(c.client).GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.get(ctx, project.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return convert(result), nil
+}
+
+// Create creates a role binding.
+func (c *Client) Create(ctx context.Context, request *generated.ProjectRoleBinding) error {
+	project, err := project.NewClient(c.client).GetOrCreateMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	if project.Deleting {
+		return errors.OAuth2InvalidRequest("project is being deleted")
+	}
+
+	binding := &unikornv1.ProjectRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      request.Name,
+			Namespace: project.Namespace,
+			Labels: map[string]string{
+				constants.VersionLabel: constants.Version,
+				constants.ProjectLabel: project.Name,
+			},
+		},
+		Spec: createSpec(request),
+	}
+
+	if err := c.client.Create(ctx, binding); err != nil {
+		if kerrors.IsAlreadyExists(err) {
+			return errors.HTTPConflict()
+		}
+
+		return errors.OAuth2ServerError("failed to create project role binding").WithError(err)
+	}
+
+	return nil
+}
+
+// Update replaces the role binding's role and subjects.
+func (c *Client) Update(ctx context.Context, name generated.RoleBindingNameParameter, request *generated.ProjectRoleBinding) error {
+	project, err := project.NewClient(c.client).GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	if project.Deleting {
+		return errors.OAuth2InvalidRequest("project is being deleted")
+	}
+
+	resource, err := c.get(ctx, project.Namespace, name)
+	if err != nil {
+		return err
+	}
+
+	temp := resource.DeepCopy()
+	temp.Spec = createSpec(request)
+
+	if err := c.client.Patch(ctx, temp, client.MergeFrom(resource)); err != nil {
+		return errors.OAuth2ServerError("failed to patch project role binding").WithError(err)
+	}
+
+	return nil
+}
+
+// Delete deletes the role binding.
+func (c *Client) Delete(ctx context.Context, name generated.RoleBindingNameParameter) error {
+	project, err := project.NewClient(c.client).GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	binding := &unikornv1.ProjectRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: project.Namespace,
+		},
+	}
+
+	if err := c.client.Delete(ctx, binding); err != nil {
+		if kerrors.IsNotFound(err) {
+			return errors.HTTPNotFound().WithError(err)
+		}
+
+		return errors.OAuth2ServerError("failed to delete project role binding").WithError(err)
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ package middleware
 
 import (
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -25,6 +26,7 @@ import (
 	"github.com/eschercloudai/unikorn/pkg/server/authorization"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization/jose"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/policy"
 	"github.com/eschercloudai/unikorn/pkg/server/errors"
 )
 
@@ -43,17 +45,31 @@ type authorizationContext struct {
 type Authorizer struct {
 	// issuer allows creation and validation of JWT bearer tokens.
 	issuer *jose.JWTIssuer
+
+	// policy allows operators to restrict operations by project role.
+	policy *policy.Engine
 }
 
 // NewAuthorizer returns a new authorizer with required parameters.
-func NewAuthorizer(issuer *jose.JWTIssuer) *Authorizer {
+func NewAuthorizer(issuer *jose.JWTIssuer, policy *policy.Engine) *Authorizer {
 	return &Authorizer{
 		issuer: issuer,
+		policy: policy,
 	}
 }
 
+// mutating returns true if the HTTP method modifies resources.
+func mutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	return true
+}
+
 // authorizeOAuth2 checks APIs that require and oauth2 bearer token.
-func (a *Authorizer) authorizeOAuth2(ctx *authorizationContext, r *http.Request, scopes []string) error {
+func (a *Authorizer) authorizeOAuth2(ctx *authorizationContext, r *http.Request, route string, scopes []string) error {
 	authorizationScheme, token, err := authorization.GetHTTPAuthenticationScheme(r)
 	if err != nil {
 		return err
@@ -76,6 +92,23 @@ func (a *Authorizer) authorizeOAuth2(ctx *authorizationContext, r *http.Request,
 		}
 	}
 
+	// Project scoped operations that modify resources additionally require
+	// a project role that allows it.
+	if mutating(r.Method) && slices.Contains(scopes, string(oauth2.ScopeProject)) && !claims.Scope.Includes(oauth2.ScopeProjectWrite) {
+		return errors.HTTPForbidden("project role does not allow resources to be modified")
+	}
+
+	// Finally check the operator defined policy allows the operation.
+	var role string
+
+	if claims.UnikornClaims != nil {
+		role = claims.UnikornClaims.Role
+	}
+
+	if err := a.policy.Authorize(r.Context(), r.Method, route, role); err != nil {
+		return err
+	}
+
 	// Set the claims in the context for use by the handlers.
 	ctx.claims = claims
 
@@ -83,9 +116,9 @@ func (a *Authorizer) authorizeOAuth2(ctx *authorizationContext, r *http.Request,
 }
 
 // authorizeScheme requires the individual scheme to match.
-func (a *Authorizer) authorizeScheme(ctx *authorizationContext, r *http.Request, scheme *openapi3.SecurityScheme, scopes []string) error {
+func (a *Authorizer) authorizeScheme(ctx *authorizationContext, r *http.Request, route string, scheme *openapi3.SecurityScheme, scopes []string) error {
 	if scheme.Type == "oauth2" {
-		return a.authorizeOAuth2(ctx, r, scopes)
+		return a.authorizeOAuth2(ctx, r, route, scopes)
 	}
 
 	return errors.OAuth2InvalidRequest("authorization scheme unsupported").WithValues("scheme", scheme.Type)
@@ -0,0 +1,112 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/debug"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DebugCapture records requests when asked to by an administrator.  This must
+// be run after the OpenAPI validator so authorization has been performed and
+// the token claims are available.
+type DebugCapture struct {
+	// next defines the next HTTP handler in the chain.
+	next http.Handler
+
+	// store retains captures for later retrieval.
+	store *debug.Store
+}
+
+// Ensure this implements the required interfaces.
+var _ http.Handler = &DebugCapture{}
+
+// NewDebugCapture returns an initialized debug capture middleware.
+func NewDebugCapture(next http.Handler, store *debug.Store) *DebugCapture {
+	return &DebugCapture{
+		next:  next,
+		store: store,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (d *DebugCapture) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get(debug.Header) == "" {
+		d.next.ServeHTTP(w, r)
+
+		return
+	}
+
+	claims, err := oauth2.ClaimsFromContext(r.Context())
+	if err != nil || !claims.Scope.Includes(oauth2.ScopeAdmin) {
+		errors.HandleError(w, r, errors.HTTPForbidden("debug capture requires the admin scope"))
+
+		return
+	}
+
+	var body []byte
+
+	if r.Body != nil {
+		if body, err = io.ReadAll(r.Body); err != nil {
+			errors.HandleError(w, r, errors.OAuth2ServerError("unable to read request body").WithError(err))
+
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	capture := d.store.New()
+	capture.RecordRequest(r, body)
+
+	w.Header().Set(debug.IDHeader, capture.ID())
+
+	// Override the writer so we can record the contents and status.
+	writer := &bufferingResponseWriter{
+		next: w,
+	}
+
+	d.next.ServeHTTP(writer, r.WithContext(debug.NewContext(r.Context(), capture)))
+
+	var responseBody []byte
+
+	// This is an in-memory buffer, so reads cannot fail.
+	if writer.body != nil {
+		responseBody, _ = io.ReadAll(writer.body)
+	}
+
+	capture.RecordResponse(writer.StatusCode(), responseBody)
+
+	if err := d.store.Add(r.Context(), capture); err != nil {
+		log.FromContext(r.Context()).Error(err, "failed to store debug capture")
+	}
+}
+
+// DebugCaptureMiddlewareFactory returns a function that generates per-request
+// middleware functions.
+func DebugCaptureMiddlewareFactory(store *debug.Store) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return NewDebugCapture(next, store)
+	}
+}
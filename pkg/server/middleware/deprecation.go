@@ -0,0 +1,104 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/deprecation"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Deprecation warns clients when they use deprecated API features, and records
+// that usage so clients can be found and updated before the features are
+// removed.  This must be run after the OpenAPI validator so the request is
+// known to be valid and the token claims are available.
+type Deprecation struct {
+	// next defines the next HTTP handler in the chain.
+	next http.Handler
+
+	// openapi caches the OpenAPI schema.
+	openapi *OpenAPI
+
+	// store retains deprecated feature usage.
+	store *deprecation.Store
+}
+
+// Ensure this implements the required interfaces.
+var _ http.Handler = &Deprecation{}
+
+// NewDeprecation returns an initialized deprecation middleware.
+func NewDeprecation(next http.Handler, openapi *OpenAPI, store *deprecation.Store) *Deprecation {
+	return &Deprecation{
+		next:    next,
+		openapi: openapi,
+		store:   store,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (d *Deprecation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, _, err := d.openapi.findRoute(r)
+	if err != nil {
+		errors.HandleError(w, r, err)
+
+		return
+	}
+
+	var body []byte
+
+	if r.Body != nil {
+		if body, err = io.ReadAll(r.Body); err != nil {
+			errors.HandleError(w, r, errors.OAuth2ServerError("unable to read request body").WithError(err))
+
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if usages := deprecation.Find(route, r, body); len(usages) != 0 {
+		for _, usage := range usages {
+			w.Header().Add(deprecation.Header, usage.Warning())
+		}
+
+		var subject string
+
+		if claims, err := oauth2.ClaimsFromContext(r.Context()); err == nil && claims != nil {
+			subject = claims.Subject
+		}
+
+		if err := d.store.Add(r.Context(), subject, r.UserAgent(), usages); err != nil {
+			log.FromContext(r.Context()).Error(err, "failed to record deprecated api usage")
+		}
+	}
+
+	d.next.ServeHTTP(w, r)
+}
+
+// DeprecationMiddlewareFactory returns a function that generates per-request
+// middleware functions.
+func DeprecationMiddlewareFactory(openapi *OpenAPI, store *deprecation.Store) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return NewDeprecation(next, openapi, store)
+	}
+}
@@ -19,9 +19,12 @@ package middleware
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	goerrors "errors"
 	"io"
 	"net/http"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -33,6 +36,34 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+//nolint:gochecknoinits
+func init() {
+	openapi3filter.RegisterBodyDecoder("application/x-ndjson", ndjsonBodyDecoder)
+}
+
+// ndjsonBodyDecoder decodes newline delimited JSON as an array of its lines, so
+// it can be validated against an array schema.
+func ndjsonBodyDecoder(body io.Reader, _ http.Header, _ *openapi3.SchemaRef, _ openapi3filter.EncodingFn) (interface{}, error) {
+	items := []interface{}{}
+
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+
+	for {
+		var item interface{}
+
+		if err := decoder.Decode(&item); err != nil {
+			if goerrors.Is(err, io.EOF) {
+				return items, nil
+			}
+
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+}
+
 // OpenAPIValidator provides OpenAPI validation of request and response codes,
 // media, and schema validation of payloads to ensure we are meeting the
 // specification.
@@ -123,7 +154,7 @@ func (v *OpenAPIValidator) validateRequest(r *http.Request, authContext *authori
 		_, span := tracer.Start(ctx, "authentication", trace.WithSpanKind(trace.SpanKindInternal))
 		defer span.End()
 
-		err := v.authorizer.authorizeScheme(authContext, input.RequestValidationInput.Request, input.SecurityScheme, input.Scopes)
+		err := v.authorizer.authorizeScheme(authContext, input.RequestValidationInput.Request, input.RequestValidationInput.Route.Path, input.SecurityScheme, input.Scopes)
 
 		authContext.err = err
 
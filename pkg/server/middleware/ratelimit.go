@@ -0,0 +1,140 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/ratelimit"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// RateLimit rejects requests from clients that exceed their request rate, so
+// a single misbehaving client cannot exhaust the OpenStack backend.
+type RateLimit struct {
+	// next defines the next HTTP handler in the chain.
+	next http.Handler
+
+	// limiter tracks per client request rates.
+	limiter *ratelimit.Limiter
+
+	// key returns the client identifier, or an empty string if the
+	// request should not be limited.
+	key func(r *http.Request) string
+}
+
+// Ensure this implements the required interfaces.
+var _ http.Handler = &RateLimit{}
+
+// ServeHTTP implements the http.Handler interface.
+func (l *RateLimit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if key := l.key(r); key != "" {
+		ok, delay, err := l.limiter.Allow(r.Context(), key, time.Now())
+		if err != nil {
+			// Fail open, an unavailable state store shouldn't take the
+			// API down with it.
+			log.FromContext(r.Context()).Error(err, "rate limit check failed")
+		} else if !ok {
+			limitErr := errors.HTTPTooManyRequests("request rate limit exceeded")
+
+			// A zero delay means the request can never be satisfied, so
+			// don't suggest a retry.
+			if delay > 0 {
+				limitErr = limitErr.WithRetryAfter(strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			}
+
+			errors.HandleError(w, r, limitErr)
+
+			return
+		}
+	}
+
+	l.next.ServeHTTP(w, r)
+}
+
+// sourceAddress returns the client IP address.  When trusted, the last
+// X-Forwarded-For entry is used, as this is the one added by our ingress
+// controller, all others may be forged by the client.
+func sourceAddress(r *http.Request, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if values := r.Header.Values("X-Forwarded-For"); len(values) != 0 {
+			addresses := strings.Split(values[len(values)-1], ",")
+
+			if address := strings.TrimSpace(addresses[len(addresses)-1]); address != "" {
+				return address
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// SourceRateLimit returns middleware that limits requests per source IP address.
+// This should be applied pre-routing so it protects all endpoints.
+func SourceRateLimit(limiter *ratelimit.Limiter, trustForwardedFor bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limiter == nil {
+			return next
+		}
+
+		return &RateLimit{
+			next:    next,
+			limiter: limiter,
+			key: func(r *http.Request) string {
+				return sourceAddress(r, trustForwardedFor)
+			},
+		}
+	}
+}
+
+// SubjectRateLimitMiddlewareFactory returns a function that generates per-request
+// middleware functions that limit requests per authenticated subject.  This must
+// be run after the OpenAPI validator so the token claims are available, requests
+// without claims e.g. token issue, are left to the source limit.
+func SubjectRateLimitMiddlewareFactory(limiter *ratelimit.Limiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limiter == nil {
+			return next
+		}
+
+		return &RateLimit{
+			next:    next,
+			limiter: limiter,
+			key: func(r *http.Request) string {
+				claims, err := oauth2.ClaimsFromContext(r.Context())
+				if err != nil || claims == nil {
+					return ""
+				}
+
+				return claims.Subject
+			},
+		}
+	}
+}
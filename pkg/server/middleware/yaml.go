@@ -0,0 +1,195 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/eschercloudai/unikorn/pkg/server/errors"
+	"github.com/eschercloudai/unikorn/pkg/server/util"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// mediaTypeJSON is what all handlers and schema validation understand.
+	mediaTypeJSON = "application/json"
+
+	// mediaTypeYAML is what we advertise back to clients.
+	mediaTypeYAML = "application/yaml"
+)
+
+// isYAML returns true if the media type is one of the many YAML aliases
+// in the wild.
+func isYAML(mediaType string) bool {
+	switch mediaType {
+	case mediaTypeYAML, "application/x-yaml", "text/yaml", "text/x-yaml":
+		return true
+	}
+
+	return false
+}
+
+// acceptsYAML parses the Accept header and returns true if the client prefers
+// YAML over JSON.  Where both have the same quality, the first listed wins.
+func acceptsYAML(accept string) bool {
+	var bestQuality float64
+
+	var best string
+
+	for _, entry := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(entry))
+		if err != nil {
+			continue
+		}
+
+		if mediaType != mediaTypeJSON && !isYAML(mediaType) {
+			continue
+		}
+
+		quality := 1.0
+
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+
+		if quality > bestQuality {
+			bestQuality = quality
+			best = mediaType
+		}
+	}
+
+	return isYAML(best)
+}
+
+// transcodeRequest converts a YAML request body into JSON so it can be
+// processed by schema validation and handlers.
+func transcodeRequest(r *http.Request) error {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || !isYAML(mediaType) {
+		return nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return errors.OAuth2ServerError("unable to read request body").WithError(err)
+	}
+
+	body, err = yaml.YAMLToJSON(body)
+	if err != nil {
+		return errors.OAuth2InvalidRequest("unable to transcode request body").WithError(err)
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.Header.Set("Content-Type", mediaTypeJSON)
+	r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return nil
+}
+
+// yamlResponseWriter buffers the response so a JSON body can be converted
+// into YAML before anything is sent to the client.
+type yamlResponseWriter struct {
+	// next is the parent handler.
+	next http.ResponseWriter
+
+	// code is the HTTP status code.
+	code int
+
+	// body is the buffered response body.
+	body bytes.Buffer
+}
+
+// Ensure the correct interfaces are implmeneted.
+var _ http.ResponseWriter = &yamlResponseWriter{}
+
+// Header returns the HTTP headers.
+func (w *yamlResponseWriter) Header() http.Header {
+	return w.next.Header()
+}
+
+// Write buffers the body.
+func (w *yamlResponseWriter) Write(body []byte) (int, error) {
+	return w.body.Write(body)
+}
+
+// WriteHeader records the status code.
+func (w *yamlResponseWriter) WriteHeader(statusCode int) {
+	w.code = statusCode
+}
+
+// flush transcodes any JSON body and writes the response to the client.
+func (w *yamlResponseWriter) flush(r *http.Request) {
+	body := w.body.Bytes()
+
+	if mediaType, _, err := mime.ParseMediaType(w.Header().Get("Content-Type")); err == nil && mediaType == mediaTypeJSON && len(body) != 0 {
+		transcoded, err := yaml.JSONToYAML(body)
+		if err != nil {
+			log.FromContext(r.Context()).Error(err, "unable to transcode response body")
+		} else {
+			body = transcoded
+
+			w.Header().Set("Content-Type", mediaTypeYAML)
+			w.Header().Del("Content-Length")
+		}
+	}
+
+	if w.code != 0 {
+		w.next.WriteHeader(w.code)
+	}
+
+	if _, err := w.next.Write(body); err != nil {
+		log.FromContext(r.Context()).Error(err, "failed to write response")
+	}
+}
+
+// YAML allows clients to send and receive YAML documents by transcoding to and
+// from JSON, which is what the schema and handlers are defined in terms of.
+func YAML() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := transcodeRequest(r); err != nil {
+				errors.HandleError(w, r, err)
+
+				return
+			}
+
+			if !acceptsYAML(r.Header.Get("Accept")) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			writer := &yamlResponseWriter{
+				next: w,
+			}
+
+			next.ServeHTTP(writer, util.WithContentType(r, mediaTypeYAML))
+
+			writer.flush(r)
+		})
+	}
+}
@@ -0,0 +1,444 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package parity checks that an OpenAPI schema and the custom resource it is
+// persisted as agree with one another.  Field presence is derived by
+// reflection over the Go types, while validation, e.g. enumerations and
+// bounds, is taken from the generated CRD schema, as kubebuilder markers are
+// not visible to reflection.
+package parity
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/eschercloudai/unikorn/pkg/collections"
+
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// ErrVersion is raised when the CRD doesn't define the requested version.
+	ErrVersion = errors.New("version not found")
+
+	// ErrPath is raised when a path doesn't exist in a type.
+	ErrPath = errors.New("path not found")
+)
+
+// Mapping relates a field in the API schema to a field in the custom resource.
+// Mappings are only required where the path differs, fields with the same name
+// in the same place are matched automatically.
+type Mapping struct {
+	// API is the dot separated path to the field in the API schema, with
+	// array items denoted by [] e.g. workloadPools[].machine.flavorName.
+	API string
+	// CRD is the dot separated path to the field in the custom resource
+	// e.g. spec.workloadPools.pools[].flavor.  When empty, the API field is
+	// not persisted e.g. it is read only and derived from status.
+	CRD string
+	// Opaque means the API field has a different representation to the
+	// custom resource field e.g. the API returns a full object where the
+	// custom resource only references it by name.  Only the existence of
+	// the custom resource field is checked.
+	Opaque bool
+}
+
+// Drift is a difference between the API schema and the custom resource.
+type Drift struct {
+	// API is the path to the field in the API schema.
+	API string
+	// CRD is the path to the field in the custom resource, if known.
+	CRD string
+	// Message describes the difference.
+	Message string
+}
+
+func (d Drift) String() string {
+	if d.CRD == "" {
+		return fmt.Sprintf("%s: %s", d.API, d.Message)
+	}
+
+	return fmt.Sprintf("%s (%s): %s", d.API, d.CRD, d.Message)
+}
+
+// checker accumulates drift for a single check.
+type checker struct {
+	// rootType is the custom resource type.
+	rootType reflect.Type
+	// rootSchema is the custom resource schema.
+	rootSchema *openapi3.Schema
+	// mappings are keyed by API path.
+	mappings map[string]*Mapping
+	// used records which mappings have been used.
+	used map[string]bool
+	// drift is the result.
+	drift []Drift
+}
+
+// Check compares an API schema against a custom resource's Go type and its
+// generated CRD schema, returning any drift, ordered by API path.  The root
+// is the path within the custom resource that the API schema corresponds to
+// e.g. spec, mapping paths are always relative to the custom resource.
+func Check(api *openapi3.Schema, t reflect.Type, crd *openapi3.Schema, root string, mappings []Mapping) []Drift {
+	c := &checker{
+		rootType:   t,
+		rootSchema: crd,
+		mappings:   map[string]*Mapping{},
+		used:       map[string]bool{},
+	}
+
+	for i := range mappings {
+		c.mappings[mappings[i].API] = &mappings[i]
+	}
+
+	rootType, rootSchema, err := c.resolve(root)
+	if err != nil {
+		c.report("", root, "custom resource field does not exist")
+
+		return c.drift
+	}
+
+	c.walk(api, "", rootType, rootSchema, root)
+
+	for _, mapping := range mappings {
+		if !c.used[mapping.API] {
+			c.report(mapping.API, mapping.CRD, "mapping does not match any API field")
+		}
+	}
+
+	return c.drift
+}
+
+// report records drift.
+func (c *checker) report(apiPath, crdPath, format string, args ...any) {
+	c.drift = append(c.drift, Drift{
+		API:     apiPath,
+		CRD:     crdPath,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// join appends a field to a path.
+func join(path, field string) string {
+	if path == "" {
+		return field
+	}
+
+	return path + "." + field
+}
+
+// indirect removes any pointers from a type.
+func indirect(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+// field looks up a struct field by its JSON name, descending into any
+// inlined structures.
+func field(t reflect.Type, name string) (reflect.Type, bool) {
+	t = indirect(t)
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+
+		if tag == "-" {
+			continue
+		}
+
+		if tag == "" && f.Anonymous {
+			if ft, ok := field(f.Type, name); ok {
+				return ft, true
+			}
+
+			continue
+		}
+
+		if tag == name {
+			return f.Type, true
+		}
+	}
+
+	return nil, false
+}
+
+// property looks up a property in a schema, which may be nil if the schema
+// doesn't describe its contents e.g. object metadata.
+func property(s *openapi3.Schema, name string) *openapi3.Schema {
+	if s == nil || s.Properties[name] == nil {
+		return nil
+	}
+
+	return s.Properties[name].Value
+}
+
+// items returns the item schema of an array schema, if defined.
+func items(s *openapi3.Schema) *openapi3.Schema {
+	if s == nil || s.Items == nil {
+		return nil
+	}
+
+	return s.Items.Value
+}
+
+// resolve finds the type and schema of a custom resource field from its path.
+func (c *checker) resolve(path string) (reflect.Type, *openapi3.Schema, error) {
+	t := c.rootType
+	s := c.rootSchema
+
+	if path == "" {
+		return t, s, nil
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		name, arrays := strings.CutSuffix(segment, "[]")
+
+		ft, ok := field(t, name)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: %s", ErrPath, path)
+		}
+
+		t = ft
+		s = property(s, name)
+
+		if arrays {
+			t = indirect(t)
+
+			if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+				return nil, nil, fmt.Errorf("%w: %s is not an array", ErrPath, path)
+			}
+
+			t = t.Elem()
+			s = items(s)
+		}
+	}
+
+	return t, s, nil
+}
+
+// walk checks all properties of an API object.
+func (c *checker) walk(api *openapi3.Schema, apiPath string, t reflect.Type, crd *openapi3.Schema, crdPath string) {
+	for _, name := range collections.SortedKeys(api.Properties) {
+		c.property(api.Properties[name].Value, join(apiPath, name), name, t, crd, crdPath)
+	}
+}
+
+// property checks an API property has a corresponding custom resource field,
+// either explicitly mapped or with the same name.
+func (c *checker) property(api *openapi3.Schema, apiPath, name string, parentType reflect.Type, parentSchema *openapi3.Schema, parentPath string) {
+	if mapping, ok := c.mappings[apiPath]; ok {
+		c.used[apiPath] = true
+
+		if mapping.CRD == "" {
+			return
+		}
+
+		t, s, err := c.resolve(mapping.CRD)
+		if err != nil {
+			c.report(apiPath, mapping.CRD, "custom resource field does not exist")
+			return
+		}
+
+		if !mapping.Opaque {
+			c.compare(api, apiPath, t, s, mapping.CRD)
+		}
+
+		return
+	}
+
+	t, ok := field(parentType, name)
+	if !ok {
+		c.report(apiPath, "", "API field has no corresponding custom resource field")
+		return
+	}
+
+	c.compare(api, apiPath, t, property(parentSchema, name), join(parentPath, name))
+}
+
+// kind returns the JSON schema type a Go type is serialized as, or an empty
+// string if it cannot be determined.
+func kind(t reflect.Type) string {
+	//nolint:exhaustive
+	switch indirect(t).Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map:
+		return "object"
+	}
+
+	return ""
+}
+
+// schemaType returns the JSON schema type of the custom resource field,
+// preferring the CRD schema as custom marshalers e.g. IP prefixes and
+// durations change the representation.
+func schemaType(t reflect.Type, crd *openapi3.Schema) string {
+	if crd != nil {
+		if _, ok := crd.Extensions["x-kubernetes-int-or-string"]; ok {
+			return ""
+		}
+
+		return crd.Type
+	}
+
+	// Custom marshalers are an unknown quantity without a schema.
+	if reflect.PointerTo(indirect(t)).Implements(reflect.TypeOf((*interface{ MarshalJSON() ([]byte, error) })(nil)).Elem()) {
+		return ""
+	}
+
+	if indirect(t).Kind() == reflect.Struct {
+		return "object"
+	}
+
+	return kind(t)
+}
+
+// compare checks an API field against its custom resource field, then
+// recurses into any children.
+func (c *checker) compare(api *openapi3.Schema, apiPath string, t reflect.Type, crd *openapi3.Schema, crdPath string) {
+	if actual := schemaType(t, crd); api.Type != "" && actual != "" && api.Type != actual {
+		c.report(apiPath, crdPath, "API type %s does not match custom resource type %s", api.Type, actual)
+		return
+	}
+
+	c.compareValidation(api, apiPath, crd, crdPath)
+
+	switch api.Type {
+	case "object":
+		if len(api.Properties) == 0 {
+			return
+		}
+
+		if indirect(t).Kind() != reflect.Struct {
+			c.report(apiPath, crdPath, "API object does not correspond to a custom resource structure")
+			return
+		}
+
+		c.walk(api, apiPath, t, crd, crdPath)
+	case "array":
+		elem := indirect(t)
+
+		if api.Items == nil || (elem.Kind() != reflect.Slice && elem.Kind() != reflect.Array) {
+			return
+		}
+
+		c.compare(api.Items.Value, apiPath+"[]", elem.Elem(), items(crd), crdPath+"[]")
+	}
+}
+
+// compareValidation checks the API is at least as strict as the custom
+// resource, otherwise requests will be accepted by the API, but rejected
+// when the resource is created.
+//
+//nolint:cyclop
+func (c *checker) compareValidation(api *openapi3.Schema, apiPath string, crd *openapi3.Schema, crdPath string) {
+	if crd == nil {
+		return
+	}
+
+	if len(crd.Enum) != 0 {
+		if len(api.Enum) == 0 {
+			c.report(apiPath, crdPath, "API allows any value, custom resource only allows %v", crd.Enum)
+		}
+
+		for _, value := range api.Enum {
+			if !slices.Contains(crd.Enum, value) {
+				c.report(apiPath, crdPath, "API allows %v, which the custom resource does not", value)
+			}
+		}
+	}
+
+	if crd.Min != nil && (api.Min == nil || *api.Min < *crd.Min) {
+		c.report(apiPath, crdPath, "API minimum is less than custom resource minimum %v", *crd.Min)
+	}
+
+	if crd.Max != nil && (api.Max == nil || *api.Max > *crd.Max) {
+		c.report(apiPath, crdPath, "API maximum is greater than custom resource maximum %v", *crd.Max)
+	}
+
+	if api.MinLength < crd.MinLength {
+		c.report(apiPath, crdPath, "API minimum length is less than custom resource minimum length %d", crd.MinLength)
+	}
+
+	if crd.MaxLength != nil && (api.MaxLength == nil || *api.MaxLength > *crd.MaxLength) {
+		c.report(apiPath, crdPath, "API maximum length is greater than custom resource maximum length %d", *crd.MaxLength)
+	}
+
+	if api.MinItems < crd.MinItems {
+		c.report(apiPath, crdPath, "API minimum items is less than custom resource minimum items %d", crd.MinItems)
+	}
+
+	if crd.MaxItems != nil && (api.MaxItems == nil || *api.MaxItems > *crd.MaxItems) {
+		c.report(apiPath, crdPath, "API maximum items is greater than custom resource maximum items %d", *crd.MaxItems)
+	}
+}
+
+// crd is the subset of a custom resource definition we care about.
+type crd struct {
+	Spec struct {
+		Versions []struct {
+			Name   string `json:"name"`
+			Schema struct {
+				OpenAPIV3Schema *openapi3.Schema `json:"openAPIV3Schema"`
+			} `json:"schema"`
+		} `json:"versions"`
+	} `json:"spec"`
+}
+
+// LoadCRDSchema reads a custom resource definition from a file and returns
+// the schema for the requested version.
+func LoadCRDSchema(path, version string) (*openapi3.Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition crd
+
+	if err := yaml.Unmarshal(data, &definition); err != nil {
+		return nil, err
+	}
+
+	for _, v := range definition.Spec.Versions {
+		if v.Name == version && v.Schema.OpenAPIV3Schema != nil {
+			return v.Schema.OpenAPIV3Schema, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s does not define %s", ErrVersion, path, version)
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parity_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eschercloudai/unikorn/pkg/server/openapi/parity"
+
+	"sigs.k8s.io/yaml"
+)
+
+type testMachine struct {
+	Image *string `json:"image"`
+}
+
+type testPool struct {
+	testMachine `json:",inline"`
+
+	Name string `json:"name"`
+}
+
+type testSpec struct {
+	Mode     *string    `json:"mode,omitempty"`
+	Replicas *int       `json:"replicas,omitempty"`
+	Pools    []testPool `json:"pools,omitempty"`
+}
+
+type testResource struct {
+	Spec testSpec `json:"spec"`
+}
+
+// crdSchema is what controller-gen would generate for testResource.
+const crdSchema = `
+type: object
+properties:
+  spec:
+    type: object
+    properties:
+      mode:
+        type: string
+        enum:
+        - fast
+        - slow
+      replicas:
+        type: integer
+        minimum: 1
+      pools:
+        type: array
+        items:
+          type: object
+          properties:
+            image:
+              type: string
+            name:
+              type: string
+`
+
+// apiSchema matches the custom resource, with pools renamed.
+const apiSchema = `
+type: object
+properties:
+  mode:
+    type: string
+    enum:
+    - fast
+  replicas:
+    type: integer
+    minimum: 1
+  workloadPools:
+    type: array
+    items:
+      type: object
+      properties:
+        name:
+          type: string
+        imageName:
+          type: string
+`
+
+//nolint:gochecknoglobals
+var mappings = []parity.Mapping{
+	{API: "workloadPools", CRD: "spec.pools"},
+	{API: "workloadPools[].imageName", CRD: "spec.pools[].image"},
+}
+
+func mustSchema(t *testing.T, data string) *openapi3.Schema {
+	t.Helper()
+
+	schema := &openapi3.Schema{}
+
+	assert.NoError(t, yaml.Unmarshal([]byte(data), schema))
+
+	return schema
+}
+
+func check(t *testing.T, api *openapi3.Schema, mappings []parity.Mapping) []parity.Drift {
+	t.Helper()
+
+	return parity.Check(api, reflect.TypeOf(testResource{}), mustSchema(t, crdSchema), "spec", mappings)
+}
+
+// TestCheck tests matching schemas, including renamed and inlined fields,
+// report no drift.
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, check(t, mustSchema(t, apiSchema), mappings))
+}
+
+// TestCheckMissingField tests API fields without a custom resource field are
+// reported.
+func TestCheckMissingField(t *testing.T) {
+	t.Parallel()
+
+	drift := check(t, mustSchema(t, apiSchema), mappings[:1])
+
+	assert.Len(t, drift, 1)
+	assert.Equal(t, "workloadPools[].imageName", drift[0].API)
+}
+
+// TestCheckIgnored tests API fields mapped to nothing aren't reported.
+func TestCheckIgnored(t *testing.T) {
+	t.Parallel()
+
+	api := mustSchema(t, apiSchema)
+	api.Properties["status"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+
+	assert.Empty(t, check(t, api, append([]parity.Mapping{{API: "status"}}, mappings...)))
+}
+
+// TestCheckUnusedMapping tests stale mappings are reported.
+func TestCheckUnusedMapping(t *testing.T) {
+	t.Parallel()
+
+	drift := check(t, mustSchema(t, apiSchema), append([]parity.Mapping{{API: "foo", CRD: "spec.mode"}}, mappings...))
+
+	assert.Len(t, drift, 1)
+	assert.Equal(t, "foo", drift[0].API)
+}
+
+// TestCheckMissingCRDField tests mappings to non-existent fields are reported.
+func TestCheckMissingCRDField(t *testing.T) {
+	t.Parallel()
+
+	drift := check(t, mustSchema(t, apiSchema), []parity.Mapping{
+		mappings[0],
+		{API: "workloadPools[].imageName", CRD: "spec.pools[].flavor"},
+	})
+
+	assert.Len(t, drift, 1)
+	assert.Equal(t, "spec.pools[].flavor", drift[0].CRD)
+}
+
+// TestCheckType tests type mismatches are reported.
+func TestCheckType(t *testing.T) {
+	t.Parallel()
+
+	api := mustSchema(t, apiSchema)
+	api.Properties["replicas"] = openapi3.NewSchemaRef("", openapi3.NewStringSchema())
+
+	drift := check(t, api, mappings)
+
+	assert.Len(t, drift, 1)
+	assert.Equal(t, "replicas", drift[0].API)
+}
+
+// TestCheckEnum tests the API may not allow values the custom resource rejects.
+func TestCheckEnum(t *testing.T) {
+	t.Parallel()
+
+	api := mustSchema(t, apiSchema)
+	api.Properties["mode"].Value.Enum = []any{"fast", "furious"}
+
+	drift := check(t, api, mappings)
+
+	assert.Len(t, drift, 1)
+	assert.Equal(t, "spec.mode", drift[0].CRD)
+
+	api.Properties["mode"].Value.Enum = nil
+
+	assert.Len(t, check(t, api, mappings), 1)
+}
+
+// TestCheckBounds tests the API must be at least as strict as the custom resource.
+func TestCheckBounds(t *testing.T) {
+	t.Parallel()
+
+	api := mustSchema(t, apiSchema)
+	api.Properties["replicas"].Value.Min = nil
+
+	drift := check(t, api, mappings)
+
+	assert.Len(t, drift, 1)
+	assert.Equal(t, "spec.replicas", drift[0].CRD)
+
+	api.Properties["replicas"].Value.Min = openapi3.Float64Ptr(2)
+
+	assert.Empty(t, check(t, api, mappings))
+}
+
+// TestLoadCRDSchema tests the schema is extracted from a custom resource definition.
+func TestLoadCRDSchema(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "crd.yaml")
+
+	definition := `
+spec:
+  versions:
+  - name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        type: object
+`
+
+	assert.NoError(t, os.WriteFile(path, []byte(definition), 0600))
+
+	schema, err := parity.LoadCRDSchema(path, "v1alpha1")
+	assert.NoError(t, err)
+	assert.Equal(t, "object", schema.Type)
+
+	_, err = parity.LoadCRDSchema(path, "v1")
+	assert.ErrorIs(t, err, parity.ErrVersion)
+}
@@ -29,6 +29,10 @@ type Options struct {
 	// should be modified to avoid clashes with other services e.g prometheus.
 	ListenAddress string
 
+	// MetricsListenAddress is where Prometheus metrics are served from,
+	// an empty string disables the metrics listener.
+	MetricsListenAddress string
+
 	// ReadTimeout defines how long before we give up on the client,
 	// this should be fairly short.
 	ReadTimeout time.Duration
@@ -50,14 +54,28 @@ type Options struct {
 
 	// RequestTimeout places a hard limit on all requests lengths.
 	RequestTimeout time.Duration
+
+	// DevIdP runs a mock OIDC identity provider in-process and uses it in
+	// place of the configured one.  This is for local development only.
+	DevIdP bool
+
+	// DevIdPListenAddress is where the development IdP listens.
+	DevIdPListenAddress string
+
+	// DevIdPEmail is the identity the development IdP reports for all logins.
+	DevIdPEmail string
 }
 
 // addFlags allows server options to be modified.
 func (o *Options) AddFlags(f *pflag.FlagSet) {
 	f.StringVar(&o.ListenAddress, "server-listen-address", ":6080", "API listener address.")
+	f.StringVar(&o.MetricsListenAddress, "server-metrics-listen-address", ":8080", "Prometheus metrics listener address, an empty string disables metrics.")
 	f.DurationVar(&o.ReadTimeout, "server-read-timeout", time.Second, "How long to wait for the client to send the request body.")
 	f.DurationVar(&o.ReadHeaderTimeout, "server-read-header-timeout", time.Second, "How long to wait for the client to send headers.")
 	f.DurationVar(&o.WriteTimeout, "server-write-timeout", 10*time.Second, "How long to wait for the API to respond to the client.")
 	f.DurationVar(&o.RequestTimeout, "server-request-timeout", 30*time.Second, "How long to wait of a request to be serviced.")
 	f.StringVar(&o.OTLPEndpoint, "otlp-endpoint", "", "An optional OTLP endpoint to ship spans to.")
+	f.BoolVar(&o.DevIdP, "dev-idp", false, "Run a mock OIDC identity provider in-process, for local development only.  Keystone must trust it for token exchange to succeed.")
+	f.StringVar(&o.DevIdPListenAddress, "dev-idp-listen-address", "127.0.0.1:6081", "Development IdP listener address.")
+	f.StringVar(&o.DevIdPEmail, "dev-idp-email", "developer@example.com", "Identity reported by the development IdP.")
 }
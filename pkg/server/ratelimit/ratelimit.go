@@ -0,0 +1,192 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/pflag"
+
+	"github.com/eschercloudai/unikorn/pkg/server/state"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Kind defines what requests are limited by.
+type Kind string
+
+const (
+	// KindSubject limits requests per authenticated subject.
+	KindSubject Kind = "subject"
+
+	// KindSource limits requests per source IP address.
+	KindSource Kind = "source"
+)
+
+var (
+	//nolint:gochecknoglobals
+	rateLimitedMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "unikorn_server_rate_limited_requests_total",
+		Help: "Number of API requests rejected by rate limiting",
+	}, []string{"limit"})
+
+	// errLimited aborts a bucket update when a request is rejected, so
+	// rejected requests don't consume tokens.
+	errLimited = errors.New("rate limited")
+)
+
+//nolint:gochecknoinits
+func init() {
+	metrics.Registry.MustRegister(rateLimitedMetric)
+}
+
+// Options defines configurable rate limiting options.
+type Options struct {
+	// SubjectRate is the sustained number of requests per second allowed
+	// for each authenticated subject, 0 disables subject rate limiting.
+	SubjectRate float64
+
+	// SubjectBurst is the number of requests a subject may make in excess
+	// of the sustained rate.
+	SubjectBurst int
+
+	// SourceRate is the sustained number of requests per second allowed
+	// for each source IP address, 0 disables source rate limiting.
+	SourceRate float64
+
+	// SourceBurst is the number of requests a source IP address may make
+	// in excess of the sustained rate.
+	SourceBurst int
+
+	// TrustForwardedFor uses the address appended to X-Forwarded-For by
+	// the ingress controller as the source IP address.  Only enable this
+	// when all traffic is via a trusted proxy, as the header may be forged.
+	TrustForwardedFor bool
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	f.Float64Var(&o.SubjectRate, "rate-limit-subject-rate", 0, "Sustained requests per second allowed per authenticated subject, 0 disables subject rate limiting.  Limits are shared between replicas with the redis state backend, otherwise they apply per replica.")
+	f.IntVar(&o.SubjectBurst, "rate-limit-subject-burst", 20, "Requests per authenticated subject allowed in excess of the sustained rate.")
+	f.Float64Var(&o.SourceRate, "rate-limit-source-rate", 0, "Sustained requests per second allowed per source IP address, 0 disables source rate limiting.  Limits are shared between replicas with the redis state backend, otherwise they apply per replica.")
+	f.IntVar(&o.SourceBurst, "rate-limit-source-burst", 50, "Requests per source IP address allowed in excess of the sustained rate.")
+	f.BoolVar(&o.TrustForwardedFor, "rate-limit-trust-forwarded-for", false, "Use the address appended to X-Forwarded-For by the ingress controller as the source IP address.")
+}
+
+// Limiter implements a token bucket per client.  Buckets are kept in a state
+// store, so are shared by all replicas using the same store.  A bucket is
+// recorded as the time it will next be full, and expires at that time, so an
+// expired bucket is indistinguishable from a full one.
+type Limiter struct {
+	// kind is what the limiter limits by.
+	kind Kind
+
+	// interval is the time taken to replenish a token.
+	interval time.Duration
+
+	// burst is the bucket size.
+	burst int
+
+	// store is where buckets are kept.
+	store state.Store
+}
+
+// New returns a new limiter, or nil if rate limiting is disabled.
+func New(kind Kind, limit float64, burst int, store state.Store) *Limiter {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &Limiter{
+		kind:     kind,
+		interval: time.Duration(float64(time.Second) / limit),
+		burst:    burst,
+		store:    store,
+	}
+}
+
+// NewSubject returns a new per subject limiter, or nil if disabled.
+func NewSubject(o *Options, store state.Store) *Limiter {
+	return New(KindSubject, o.SubjectRate, o.SubjectBurst, store)
+}
+
+// NewSource returns a new per source IP address limiter, or nil if disabled.
+func NewSource(o *Options, store state.Store) *Limiter {
+	return New(KindSource, o.SourceRate, o.SourceBurst, store)
+}
+
+// bucket returns the state store bucket for the limiter.
+func (l *Limiter) bucket() string {
+	return "rate-limit-" + string(l.kind)
+}
+
+// Allow consumes a token from the client's bucket at the given time.  If none
+// are available, it returns false and how long until one will be.
+func (l *Limiter) Allow(ctx context.Context, key string, now time.Time) (bool, time.Duration, error) {
+	// A bucket that can never be filled can never be satisfied.
+	if l.burst <= 0 {
+		rateLimitedMetric.WithLabelValues(string(l.kind)).Inc()
+
+		return false, 0, nil
+	}
+
+	// Buckets with no tokens are full this far in the future.
+	capacity := time.Duration(l.burst) * l.interval
+
+	var delay time.Duration
+
+	mutate := func(value []byte) ([]byte, error) {
+		full := now
+
+		if value != nil {
+			nanos, err := strconv.ParseInt(string(value), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			if t := time.Unix(0, nanos); t.After(now) {
+				full = t
+			}
+		}
+
+		full = full.Add(l.interval)
+
+		if excess := full.Sub(now) - capacity; excess > 0 {
+			delay = excess
+
+			return nil, errLimited
+		}
+
+		return []byte(strconv.FormatInt(full.UnixNano(), 10)), nil
+	}
+
+	if err := l.store.Update(ctx, l.bucket(), key, capacity, mutate); err != nil {
+		if errors.Is(err, errLimited) {
+			rateLimitedMetric.WithLabelValues(string(l.kind)).Inc()
+
+			return false, delay, nil
+		}
+
+		return false, 0, err
+	}
+
+	return true, 0, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eschercloudai/unikorn/pkg/server/ratelimit"
+	"github.com/eschercloudai/unikorn/pkg/server/state"
+)
+
+// allow checks a request against the limiter, which must not fail.
+func allow(t *testing.T, limiter *ratelimit.Limiter, key string, now time.Time) (bool, time.Duration) {
+	t.Helper()
+
+	ok, delay, err := limiter.Allow(context.Background(), key, now)
+	require.NoError(t, err)
+
+	return ok, delay
+}
+
+// TestDisabled checks a zero rate disables limiting.
+func TestDisabled(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, ratelimit.New(ratelimit.KindSubject, 0, 10, state.NewMemory()))
+}
+
+// TestBurst checks clients can burst, are then limited with a sensible retry
+// time, and recover once tokens are replenished.
+func TestBurst(t *testing.T) {
+	t.Parallel()
+
+	limiter := ratelimit.New(ratelimit.KindSubject, 2, 3, state.NewMemory())
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		ok, _ := allow(t, limiter, "foo", now)
+		assert.True(t, ok)
+	}
+
+	ok, delay := allow(t, limiter, "foo", now)
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, delay)
+
+	// Rejected requests must not consume tokens, or a client retrying
+	// too soon would be locked out indefinitely.
+	ok, delay = allow(t, limiter, "foo", now)
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, delay)
+
+	ok, _ = allow(t, limiter, "foo", now.Add(delay))
+	assert.True(t, ok)
+}
+
+// TestIsolation checks clients don't share buckets.
+func TestIsolation(t *testing.T) {
+	t.Parallel()
+
+	limiter := ratelimit.New(ratelimit.KindSource, 1, 1, state.NewMemory())
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	ok, _ := allow(t, limiter, "192.168.0.1", now)
+	assert.True(t, ok)
+
+	ok, _ = allow(t, limiter, "192.168.0.1", now)
+	assert.False(t, ok)
+
+	ok, _ = allow(t, limiter, "192.168.0.2", now)
+	assert.True(t, ok)
+}
+
+// TestShared checks limiters sharing a store, as replicas do, share buckets,
+// but subject and source limits remain separate.
+func TestShared(t *testing.T) {
+	t.Parallel()
+
+	store := state.NewMemory()
+
+	replica1 := ratelimit.New(ratelimit.KindSubject, 1, 1, store)
+	replica2 := ratelimit.New(ratelimit.KindSubject, 1, 1, store)
+	source := ratelimit.New(ratelimit.KindSource, 1, 1, store)
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	ok, _ := allow(t, replica1, "foo", now)
+	assert.True(t, ok)
+
+	ok, _ = allow(t, replica2, "foo", now)
+	assert.False(t, ok)
+
+	ok, _ = allow(t, source, "foo", now)
+	assert.True(t, ok)
+}
+
+// TestZeroBurst checks a bucket that can never be filled rejects requests
+// without suggesting a retry.
+func TestZeroBurst(t *testing.T) {
+	t.Parallel()
+
+	limiter := ratelimit.New(ratelimit.KindSubject, 1, 0, state.NewMemory())
+
+	ok, delay := allow(t, limiter, "foo", time.Now())
+	assert.False(t, ok)
+	assert.Zero(t, delay)
+}
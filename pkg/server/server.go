@@ -18,26 +18,38 @@ package server
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"net"
 	"net/http"
 
 	chi "github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/sdk/trace"
 
+	"github.com/eschercloudai/unikorn/pkg/logging"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization/jose"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization/keystone"
 	"github.com/eschercloudai/unikorn/pkg/server/authorization/oauth2"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/policy"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/rolebinding"
+	"github.com/eschercloudai/unikorn/pkg/server/debug"
+	"github.com/eschercloudai/unikorn/pkg/server/deprecation"
+	"github.com/eschercloudai/unikorn/pkg/server/devidp"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
 	"github.com/eschercloudai/unikorn/pkg/server/handler"
 	"github.com/eschercloudai/unikorn/pkg/server/middleware"
+	"github.com/eschercloudai/unikorn/pkg/server/ratelimit"
+	"github.com/eschercloudai/unikorn/pkg/server/state"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 type Server struct {
@@ -47,6 +59,9 @@ type Server struct {
 	// ZapOptions configure logging.
 	ZapOptions zap.Options
 
+	// RedactionOptions configure removal of sensitive values from logs.
+	RedactionOptions logging.Options
+
 	// HandlerOptions sets options for the HTTP handler.
 	HandlerOptions handler.Options
 
@@ -58,20 +73,41 @@ type Server struct {
 
 	// OAuth2Options sets options for the oauth2/oidc authenticator.
 	OAuth2Options oauth2.Options
+
+	// DebugOptions sets options for debug request capture.
+	DebugOptions debug.Options
+
+	// PolicyOptions sets options for authorization policy.
+	PolicyOptions policy.Options
+
+	// DeprecationOptions sets options for deprecated API usage reporting.
+	DeprecationOptions deprecation.Options
+
+	// StateOptions sets options for state shared between replicas.
+	StateOptions state.Options
+
+	// RateLimitOptions sets options for per client request rate limiting.
+	RateLimitOptions ratelimit.Options
 }
 
 func (s *Server) AddFlags(goflags *flag.FlagSet, flags *pflag.FlagSet) {
 	s.ZapOptions.BindFlags(goflags)
+	s.RedactionOptions.AddFlags(flags)
 
 	s.Options.AddFlags(flags)
 	s.HandlerOptions.AddFlags(flags)
 	s.JoseOptions.AddFlags(flags)
 	s.KeystoneOptions.AddFlags(flags)
 	s.OAuth2Options.AddFlags(flags)
+	s.DebugOptions.AddFlags(flags)
+	s.PolicyOptions.AddFlags(flags)
+	s.DeprecationOptions.AddFlags(flags)
+	s.StateOptions.AddFlags(flags)
+	s.RateLimitOptions.AddFlags(flags)
 }
 
 func (s *Server) SetupLogging() {
-	log.SetLogger(zap.New(zap.UseFlagOptions(&s.ZapOptions)))
+	log.SetLogger(logging.NewRedactor(&s.RedactionOptions).Logger(zap.New(zap.UseFlagOptions(&s.ZapOptions))))
 }
 
 // SetupOpenTelemetry adds a span processor that will print root spans to the
@@ -102,11 +138,79 @@ func (s *Server) SetupOpenTelemetry(ctx context.Context) error {
 	return nil
 }
 
-func (s *Server) GetServer(client client.Client) (*http.Server, error) {
+// startDevIdP runs a mock OIDC identity provider in-process and points the oauth2
+// authenticator at it, so the authorization code flow can be tested locally without
+// access to a real IdP.
+func (s *Server) startDevIdP() error {
+	listener, err := net.Listen("tcp", s.Options.DevIdPListenAddress)
+	if err != nil {
+		return err
+	}
+
+	provider, err := devidp.New("http://"+listener.Addr().String(), devidp.Options{
+		Subject: s.Options.DevIdPEmail,
+		Email:   s.Options.DevIdPEmail,
+	})
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		ReadTimeout:       s.Options.ReadTimeout,
+		ReadHeaderTimeout: s.Options.ReadHeaderTimeout,
+		WriteTimeout:      s.Options.WriteTimeout,
+		Handler:           provider,
+	}
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Log.Error(err, "development IdP failed")
+		}
+	}()
+
+	s.OAuth2Options.SetOIDCProvider(&oauth2.OIDCProvider{
+		Issuer:                provider.Issuer(),
+		AuthorizationEndpoint: provider.AuthorizationEndpoint(),
+		TokenEndpoint:         provider.TokenEndpoint(),
+		JWKSURL:               provider.JWKSURL(),
+	})
+
+	log.Log.Info("development IdP running, do not use in production", "issuer", provider.Issuer())
+
+	return nil
+}
+
+// GetServer returns the API server.  The reader is used where reads must not
+// be cached.
+func (s *Server) GetServer(client client.Client, reader client.Reader) (*http.Server, error) {
+	if s.Options.DevIdP {
+		if err := s.startDevIdP(); err != nil {
+			return nil, err
+		}
+	}
+
+	stateStore, err := state.New(client, reader, &s.StateOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rate limits are updated on every request, which is too much for the
+	// Kubernetes API, so these are kept per replica in memory instead.
+	rateLimitStore := stateStore
+
+	if s.StateOptions.Backend == state.BackendKubernetes {
+		rateLimitStore = state.NewMemory()
+	}
+
+	sourceLimiter := ratelimit.NewSource(&s.RateLimitOptions, rateLimitStore)
+	subjectLimiter := ratelimit.NewSubject(&s.RateLimitOptions, rateLimitStore)
+
 	// Middleware specified here is applied to all requests pre-routing.
 	router := chi.NewRouter()
 	router.Use(middleware.Logger())
+	router.Use(middleware.SourceRateLimit(sourceLimiter, s.RateLimitOptions.TrustForwardedFor))
 	router.Use(middleware.Timeout(s.Options.RequestTimeout))
+	router.Use(middleware.YAML())
 	router.NotFound(http.HandlerFunc(handler.NotFound))
 	router.MethodNotAllowed(http.HandlerFunc(handler.MethodNotAllowed))
 
@@ -114,27 +218,37 @@ func (s *Server) GetServer(client client.Client) (*http.Server, error) {
 	issuer := jose.NewJWTIssuer(&s.JoseOptions)
 	keystone := keystone.New(&s.KeystoneOptions)
 	oauth2 := oauth2.New(&s.OAuth2Options, issuer, keystone)
-	authenticator := authorization.NewAuthenticator(issuer, oauth2, keystone)
+	authenticator := authorization.NewAuthenticator(issuer, oauth2, keystone, rolebinding.New(client))
 
 	// Setup middleware.
-	authorizer := middleware.NewAuthorizer(issuer)
+	authorizer := middleware.NewAuthorizer(issuer, policy.New(client, &s.PolicyOptions))
 
 	openapi, err := middleware.NewOpenAPI()
 	if err != nil {
 		return nil, err
 	}
 
+	redactor := logging.NewRedactor(&s.RedactionOptions)
+
+	captures := debug.NewStore(&s.DebugOptions, redactor, stateStore)
+
+	deprecations := deprecation.NewStore(&s.DeprecationOptions, stateStore)
+
 	// Middleware specified here is applied to all requests post-routing.
 	// NOTE: these are applied in reverse order!!
 	chiServerOptions := generated.ChiServerOptions{
 		BaseRouter:       router,
 		ErrorHandlerFunc: handler.HandleError,
 		Middlewares: []generated.MiddlewareFunc{
+			middleware.DeprecationMiddlewareFactory(openapi, deprecations),
+			middleware.DebugCaptureMiddlewareFactory(captures),
+			middleware.SubjectRateLimitMiddlewareFactory(subjectLimiter),
 			middleware.OpenAPIValidatorMiddlewareFactory(authorizer, openapi),
 		},
 	}
 
-	handlerInterface, err := handler.New(client, authenticator, &s.HandlerOptions)
+	// Resource modifications are recorded for debug captures.
+	handlerInterface, err := handler.New(debug.NewClient(client), authenticator, captures, deprecations, stateStore, redactor, &s.HandlerOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -147,5 +261,35 @@ func (s *Server) GetServer(client client.Client) (*http.Server, error) {
 		Handler:           generated.HandlerWithOptions(handlerInterface, chiServerOptions),
 	}
 
+	// Remove expired state until the server is shut down.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	server.RegisterOnShutdown(cancel)
+
+	go state.Collect(ctx, stateStore, s.StateOptions.ExpiryInterval)
+
+	if rateLimitStore != stateStore {
+		go state.Collect(ctx, rateLimitStore, s.StateOptions.ExpiryInterval)
+	}
+
 	return server, nil
 }
+
+// GetMetricsServer returns a server that exposes Prometheus metrics, or nil if
+// disabled.  This is kept separate from the API so it isn't subject to
+// authentication or rate limiting.
+func (s *Server) GetMetricsServer() *http.Server {
+	if s.Options.MetricsListenAddress == "" {
+		return nil
+	}
+
+	server := &http.Server{
+		Addr:              s.Options.MetricsListenAddress,
+		ReadTimeout:       s.Options.ReadTimeout,
+		ReadHeaderTimeout: s.Options.ReadHeaderTimeout,
+		WriteTimeout:      s.Options.WriteTimeout,
+		Handler:           promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}),
+	}
+
+	return server
+}
@@ -19,18 +19,29 @@ package server_test
 import (
 	"context"
 	"net"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	unikornconstants "github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/policy"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/cost"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/kubernetesversion"
 
 	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn-core/pkg/constants"
 	"github.com/eschercloudai/unikorn-core/pkg/util"
 
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // mustCreateProjectFixture creates a project, and its randomly named namespace
@@ -73,6 +84,12 @@ func mustCreateProjectFixture(t *testing.T, tc *TestContext, projectID string) *
 	return project
 }
 
+const (
+	controlPlaneComponentName            = "cert-manager"
+	controlPlaneComponentVersion         = "v1.12.4"
+	controlPlaneComponentDeployedVersion = "v1.12.3"
+)
+
 // mustCreateControlPlaneFixture creates a control plane , and its randomly named namespace
 // just as if unikorn-controlplane-manager had picked up the create request, preformed it
 // and also updated the status.
@@ -99,6 +116,9 @@ func mustCreateControlPlaneFixture(t *testing.T, tc *TestContext, namespace, nam
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 			Name:      name,
+			Labels: map[string]string{
+				constants.ProjectLabel: projectNameFromID(projectID),
+			},
 		},
 		Spec: unikornv1.ControlPlaneSpec{
 			ApplicationBundle: &bundleVersion,
@@ -112,6 +132,13 @@ func mustCreateControlPlaneFixture(t *testing.T, tc *TestContext, namespace, nam
 					Reason: coreunikornv1.ConditionReasonProvisioned,
 				},
 			},
+			Components: []unikornv1.ControlPlaneComponentStatus{
+				{
+					Name:            controlPlaneComponentName,
+					Version:         controlPlaneComponentVersion,
+					DeployedVersion: controlPlaneComponentDeployedVersion,
+				},
+			},
 		},
 	}
 
@@ -152,10 +179,20 @@ func mustCreateKubernetesClusterFixture(t *testing.T, tc *TestContext, namespace
 
 	dnsNameserver := net.ParseIP(clusterDNSNameserver)
 
+	// Clusters inherit their project and control plane labels from the control
+	// plane namespace they are created in.
+	var ns corev1.Namespace
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Name: namespace}, &ns))
+
 	cluster := &unikornv1.KubernetesCluster{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 			Name:      name,
+			Labels: map[string]string{
+				constants.ProjectLabel:      ns.Labels[constants.ProjectLabel],
+				constants.ControlPlaneLabel: ns.Labels[constants.ControlPlaneLabel],
+			},
 		},
 		Spec: unikornv1.KubernetesClusterSpec{
 			ApplicationBundle: util.ToPointer(kubernetesClusterApplicationBundleName),
@@ -166,10 +203,10 @@ func mustCreateKubernetesClusterFixture(t *testing.T, tc *TestContext, namespace
 				ExternalNetworkID:   util.ToPointer(clusterExternalNetworkID),
 			},
 			Network: &unikornv1.KubernetesClusterNetworkSpec{
-				NodeNetwork:    &unikornv1.IPv4Prefix{IPNet: *nodenetwork},
-				ServiceNetwork: &unikornv1.IPv4Prefix{IPNet: *serviceNetwork},
-				PodNetwork:     &unikornv1.IPv4Prefix{IPNet: *podNetwork},
-				DNSNameservers: []unikornv1.IPv4Address{
+				NodeNetwork:    &unikornv1.IPPrefix{IPNet: *nodenetwork},
+				ServiceNetwork: &unikornv1.IPPrefix{IPNet: *serviceNetwork},
+				PodNetwork:     &unikornv1.IPPrefix{IPNet: *podNetwork},
+				DNSNameservers: []unikornv1.IPAddress{
 					{IP: dnsNameserver},
 				},
 			},
@@ -198,6 +235,7 @@ func mustCreateKubernetesClusterFixture(t *testing.T, tc *TestContext, namespace
 			},
 		},
 		Status: unikornv1.KubernetesClusterStatus{
+			Namespace: name,
 			Conditions: []coreunikornv1.Condition{
 				{
 					Type:   coreunikornv1.ConditionAvailable,
@@ -234,8 +272,14 @@ func mustCreateControlPlaneApplicationBundleFixture(t *testing.T, tc *TestContex
 }
 
 const (
-	kubernetesClusterApplicationBundleName    = "kubernetes-cluster-1.0.0"
-	kubernetesClusterApplicationBundleVersion = "2.0.0"
+	kubernetesClusterApplicationBundleName                 = "kubernetes-cluster-1.0.0"
+	kubernetesClusterApplicationBundleVersion              = "2.0.0"
+	kubernetesClusterApplicationBundleMinKubernetesVersion = "v1.27.0"
+	kubernetesClusterApplicationBundleMaxKubernetesVersion = "v1.28.5"
+	kubernetesClusterApplicationBundleDescription          = "Kubernetes with Cilium networking."
+	kubernetesClusterApplicationBundleCategory             = "kubernetes"
+	kubernetesClusterApplicationBundleIconURL              = "https://icons.my-app.io/kubernetes.svg"
+	kubernetesClusterApplicationBundleDocumentationURL     = "https://docs.my-app.io/bundles/kubernetes-cluster-1.0.0"
 )
 
 // mustCreateKubernetesClusterApplicationBundleFixture creates a basic application bundle
@@ -247,8 +291,68 @@ func mustCreateKubernetesClusterApplicationBundleFixture(t *testing.T, tc *TestC
 		ObjectMeta: metav1.ObjectMeta{
 			Name: kubernetesClusterApplicationBundleName,
 		},
-		Spec: unikornv1.ApplicationBundleSpec{
-			Version: util.ToPointer(kubernetesClusterApplicationBundleVersion),
+		Spec: unikornv1.KubernetesClusterApplicationBundleSpec{
+			ApplicationBundleSpec: unikornv1.ApplicationBundleSpec{
+				Version: util.ToPointer(kubernetesClusterApplicationBundleVersion),
+				Applications: []unikornv1.ApplicationNamedReference{
+					{
+						Name: util.ToPointer("cilium"),
+						Reference: &coreunikornv1.ApplicationReference{
+							Kind:    util.ToPointer(coreunikornv1.ApplicationReferenceKindHelm),
+							Name:    util.ToPointer("cilium"),
+							Version: util.ToPointer("1.14.4"),
+						},
+					},
+					{
+						Name: util.ToPointer("calico"),
+						Reference: &coreunikornv1.ApplicationReference{
+							Kind:    util.ToPointer(coreunikornv1.ApplicationReferenceKindHelm),
+							Name:    util.ToPointer("calico"),
+							Version: util.ToPointer("3.26.4"),
+						},
+					},
+				},
+			},
+			Kubernetes: &unikornv1.ApplicationBundleKubernetesSpec{
+				MinimumVersion: util.ToPointer(unikornv1.SemanticVersion(kubernetesClusterApplicationBundleMinKubernetesVersion)),
+				MaximumVersion: util.ToPointer(unikornv1.SemanticVersion(kubernetesClusterApplicationBundleMaxKubernetesVersion)),
+			},
+		},
+	}
+
+	bundle.Spec.Display = &unikornv1.ApplicationBundleDisplaySpec{
+		Description:      util.ToPointer(kubernetesClusterApplicationBundleDescription),
+		Category:         util.ToPointer(kubernetesClusterApplicationBundleCategory),
+		IconURL:          util.ToPointer(kubernetesClusterApplicationBundleIconURL),
+		DocumentationURL: util.ToPointer(kubernetesClusterApplicationBundleDocumentationURL),
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Create(context.TODO(), bundle))
+}
+
+const (
+	unsupportedKubernetesClusterApplicationBundleName                 = "kubernetes-cluster-3.0.0"
+	unsupportedKubernetesClusterApplicationBundleVersion              = "3.0.0"
+	unsupportedKubernetesClusterApplicationBundleMinKubernetesVersion = "v1.29.0"
+)
+
+// mustCreateUnsupportedKubernetesClusterApplicationBundleFixture creates an application
+// bundle for a Kubernetes cluster that doesn't support the Kubernetes version of our
+// images.
+func mustCreateUnsupportedKubernetesClusterApplicationBundleFixture(t *testing.T, tc *TestContext) {
+	t.Helper()
+
+	bundle := &unikornv1.KubernetesClusterApplicationBundle{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: unsupportedKubernetesClusterApplicationBundleName,
+		},
+		Spec: unikornv1.KubernetesClusterApplicationBundleSpec{
+			ApplicationBundleSpec: unikornv1.ApplicationBundleSpec{
+				Version: util.ToPointer(unsupportedKubernetesClusterApplicationBundleVersion),
+			},
+			Kubernetes: &unikornv1.ApplicationBundleKubernetesSpec{
+				MinimumVersion: util.ToPointer(unikornv1.SemanticVersion(unsupportedKubernetesClusterApplicationBundleMinKubernetesVersion)),
+			},
 		},
 	}
 
@@ -263,6 +367,8 @@ const (
 	applicationLicense           = "Apache License-2.0"
 	applicationIcon              = "<svg />"
 	applicationVersion           = "1.0.0"
+	applicationSummary           = "Does things."
+	applicationCategory          = "networking"
 )
 
 func mustCreateHelmApplicationFixture(t *testing.T, tc *TestContext) {
@@ -271,6 +377,10 @@ func mustCreateHelmApplicationFixture(t *testing.T, tc *TestContext) {
 	app := &coreunikornv1.HelmApplication{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: applicationName,
+			Annotations: map[string]string{
+				unikornconstants.ApplicationSummaryAnnotation:  applicationSummary,
+				unikornconstants.ApplicationCategoryAnnotation: applicationCategory,
+			},
 		},
 		Spec: coreunikornv1.HelmApplicationSpec{
 			Name:          util.ToPointer(applicationHumanReadableName),
@@ -289,3 +399,437 @@ func mustCreateHelmApplicationFixture(t *testing.T, tc *TestContext) {
 
 	assert.NoError(t, tc.KubernetesClient().Create(context.TODO(), app))
 }
+
+const (
+	priceSheetNamespace = "unikorn"
+	priceSheetName      = "prices"
+	priceSheetCurrency  = "GBP"
+	priceSheetFlavor    = 0.5
+)
+
+// mustCreatePriceSheetFixture creates a price sheet that covers the standard flavor.
+func mustCreatePriceSheetFixture(t *testing.T, tc *TestContext) {
+	t.Helper()
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: priceSheetNamespace,
+			Name:      priceSheetName,
+		},
+		Data: map[string]string{
+			cost.PriceSheetKey: "currency: " + priceSheetCurrency + "\nflavors:\n  " + flavorName + ": " + strconv.FormatFloat(priceSheetFlavor, 'f', -1, 64) + "\n",
+		},
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Create(context.TODO(), configMap))
+}
+
+// mustEnableNodeFirewall enables the node firewall feature on a cluster.
+func mustEnableNodeFirewall(t *testing.T, tc *TestContext, namespace, name string) {
+	t.Helper()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, &resource))
+
+	nodeFirewall := true
+
+	resource.Spec.Features = &unikornv1.KubernetesClusterFeaturesSpec{
+		NodeFirewall: &nodeFirewall,
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Update(context.TODO(), &resource))
+}
+
+// mustCreateArgoCDApplicationFixture creates a continuous delivery application for
+// a cluster add-on, with the given health status.
+func mustCreateArgoCDApplicationFixture(t *testing.T, tc *TestContext, namespace, name, application, health string) {
+	t.Helper()
+
+	cluster := &unikornv1.KubernetesCluster{}
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, cluster))
+
+	labels, err := cluster.ResourceLabels()
+	assert.NoError(t, err)
+
+	labels[constants.ApplicationLabel] = application
+
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion("argoproj.io/v1alpha1")
+	object.SetKind("Application")
+	object.SetNamespace("argocd")
+	object.SetGenerateName("application-")
+	object.SetLabels(labels)
+
+	assert.NoError(t, unstructured.SetNestedField(object.Object, health, "status", "health", "status"))
+	assert.NoError(t, tc.KubernetesClient().Create(context.TODO(), object))
+}
+
+// mustUpdateKubernetesClusterUpgradingFixture records the control plane as deployed
+// at an older Kubernetes version, as if it were being upgraded.
+func mustUpdateKubernetesClusterUpgradingFixture(t *testing.T, tc *TestContext, namespace, name string) {
+	t.Helper()
+
+	cluster := &unikornv1.KubernetesCluster{}
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, cluster))
+
+	cluster.Status.ControlPlane = &unikornv1.MachineVersionStatus{
+		Version: unikornv1.SemanticVersion(kubernetesClusterApplicationBundleMinKubernetesVersion),
+		Image:   imageName2,
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Update(context.TODO(), cluster))
+}
+
+// mustUpdateKubernetesClusterStageTimingsFixture records a completed cluster stage,
+// an in progress bootstrap stage, and an add-ons stage for a different generation.
+func mustUpdateKubernetesClusterStageTimingsFixture(t *testing.T, tc *TestContext, namespace, name string) {
+	t.Helper()
+
+	cluster := &unikornv1.KubernetesCluster{}
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, cluster))
+
+	start := metav1.NewTime(time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC))
+	completion := metav1.NewTime(start.Add(450 * time.Second))
+
+	cluster.Status.StageTimings = []unikornv1.ProvisioningStageTiming{
+		{
+			Name:           "cluster",
+			Generation:     cluster.Generation,
+			StartTime:      start,
+			CompletionTime: &completion,
+		},
+		{
+			Name:       "bootstrap",
+			Generation: cluster.Generation,
+			StartTime:  start,
+		},
+		{
+			Name:           "addons",
+			Generation:     cluster.Generation + 1,
+			StartTime:      start,
+			CompletionTime: &completion,
+		},
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Update(context.TODO(), cluster))
+}
+
+const (
+	policyNamespace = "unikorn"
+	policyName      = "policy"
+)
+
+// mustCreateDefaultPolicyFixture creates an authorization policy that allows
+// everything, as the test server is configured to enforce one, and fails closed
+// if it's missing.
+func mustCreateDefaultPolicyFixture(t *testing.T, tc *TestContext) {
+	t.Helper()
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: policyNamespace,
+			Name:      policyName,
+		},
+		Data: map[string]string{
+			policy.PolicyKey: "rules: []\n",
+		},
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Create(context.TODO(), configMap))
+}
+
+// mustCreatePolicyFixture replaces the authorization policy with one that only
+// allows project admins to create control planes.
+func mustCreatePolicyFixture(t *testing.T, tc *TestContext) {
+	t.Helper()
+
+	configMap := &corev1.ConfigMap{}
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: policyNamespace, Name: policyName}, configMap))
+
+	configMap.Data[policy.PolicyKey] = "rules:\n- methods:\n  - POST\n  paths:\n  - /api/v1/controlplanes\n  roles:\n  - admin\n"
+
+	assert.NoError(t, tc.KubernetesClient().Update(context.TODO(), configMap))
+}
+
+const (
+	kubernetesVersionPolicyNamespace = "unikorn"
+	kubernetesVersionPolicyName      = "kubernetes-versions"
+)
+
+// mustCreateKubernetesVersionPolicyFixture creates a version policy that marks
+// the image's minor version as being removed soon.
+func mustCreateKubernetesVersionPolicyFixture(t *testing.T, tc *TestContext) {
+	t.Helper()
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: kubernetesVersionPolicyNamespace,
+			Name:      kubernetesVersionPolicyName,
+		},
+		Data: map[string]string{
+			kubernetesversion.PolicyKey: "deprecated:\n- v1\nremovedSoon:\n- v1.28\n",
+		},
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Create(context.TODO(), configMap))
+}
+
+const (
+	monitorShardNamespace = "unikorn"
+	monitorShardReplica   = "unikorn-monitor-0"
+)
+
+// mustCreateMonitorShardMemberFixture creates a live monitor replica.
+func mustCreateMonitorShardMemberFixture(t *testing.T, tc *TestContext) {
+	t.Helper()
+
+	now := metav1.NewMicroTime(time.Now())
+
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: monitorShardNamespace,
+			Name:      "unikorn-monitor-" + monitorShardReplica,
+			Labels: map[string]string{
+				unikornconstants.MonitorMemberLabel: "true",
+			},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       util.ToPointer(monitorShardReplica),
+			LeaseDurationSeconds: util.ToPointer(int32(180)),
+			RenewTime:            &now,
+		},
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Create(context.TODO(), lease))
+}
+
+const (
+	clusterTemplateName        = "gpu-training-small"
+	clusterTemplateDescription = "A small cluster for GPU accelerated machine learning."
+	clusterTemplateDiskSize    = 100
+	clusterTemplateLabelKey    = "workload"
+	clusterTemplateLabelValue  = "training"
+)
+
+// mustCreateClusterTemplateFixture creates a cluster template that provides
+// defaults for the "foo" workload pool.
+func mustCreateClusterTemplateFixture(t *testing.T, tc *TestContext) {
+	t.Helper()
+
+	template := &unikornv1.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterTemplateName,
+		},
+		Spec: unikornv1.ClusterTemplateSpec{
+			Description:       util.ToPointer(clusterTemplateDescription),
+			ApplicationBundle: util.ToPointer(kubernetesClusterApplicationBundleName),
+			ControlPlane: &unikornv1.ClusterTemplateMachineSpec{
+				Flavor:   util.ToPointer(flavorName),
+				Replicas: util.ToPointer(clusterControlPlaneReplicas),
+			},
+			WorkloadPools: []unikornv1.ClusterTemplateWorkloadPoolSpec{
+				{
+					ClusterTemplateMachineSpec: unikornv1.ClusterTemplateMachineSpec{
+						Flavor:   util.ToPointer(flavorName),
+						Replicas: util.ToPointer(clusterWorkloadPoolReplicas),
+						DiskSize: resource.NewQuantity(clusterTemplateDiskSize<<30, resource.BinarySI),
+					},
+					Name: "foo",
+					Labels: map[string]string{
+						clusterTemplateLabelKey: clusterTemplateLabelValue,
+					},
+				},
+			},
+			Features: &unikornv1.KubernetesClusterFeaturesSpec{
+				Ingress: util.ToPointer(true),
+			},
+		},
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Create(context.TODO(), template))
+}
+
+const (
+	platformChangelogVersion         = "v0.3.61"
+	platformChangelogPreviousVersion = "v0.3.60"
+	platformChangelogHighlight       = "Project role bindings for OIDC groups."
+)
+
+//nolint:gochecknoglobals
+var (
+	// platformChangelogDate is when the newest changelog fixture was released.
+	platformChangelogDate = time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+)
+
+// mustCreatePlatformChangelogFixture creates a changelog entry for a platform release.
+func mustCreatePlatformChangelogFixture(t *testing.T, tc *TestContext, version string, date time.Time) {
+	t.Helper()
+
+	changelog := &unikornv1.PlatformChangelog{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: version,
+		},
+		Spec: unikornv1.PlatformChangelogSpec{
+			Version: version,
+			Date:    metav1.NewTime(date),
+			Highlights: []string{
+				platformChangelogHighlight,
+			},
+		},
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Create(context.TODO(), changelog))
+}
+
+//nolint:gochecknoglobals
+var (
+	// activityTime is when the cluster fixture last changed status.
+	activityTime = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// activityApprovalTime is when the cluster fixture's upgrade was
+	// submitted for approval.
+	activityApprovalTime = activityTime.Add(time.Hour)
+)
+
+// mustUpdateKubernetesClusterActivityFixture records a status change and an upgrade
+// awaiting approval against a cluster.
+func mustUpdateKubernetesClusterActivityFixture(t *testing.T, tc *TestContext, namespace, name string) {
+	t.Helper()
+
+	cluster := &unikornv1.KubernetesCluster{}
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, cluster))
+
+	cluster.Status.Conditions[0].LastTransitionTime = metav1.NewTime(activityTime)
+	cluster.Status.Upgrade = &unikornv1.ApplicationBundleUpgradeStatus{
+		Target:              kubernetesClusterApplicationBundleName,
+		NextUpgradeAt:       metav1.NewTime(activityApprovalTime.Add(time.Hour)),
+		ApprovalRequestedAt: util.ToPointer(metav1.NewTime(activityApprovalTime)),
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Update(context.TODO(), cluster))
+}
+
+const (
+	projectRoleBindingName = "platform-engineers"
+	projectRoleBindingRole = "auditor"
+)
+
+// mustCreateProjectRoleBindingFixture creates a role binding that grants the
+// editor role to the subject.
+func mustCreateProjectRoleBindingFixture(t *testing.T, tc *TestContext, namespace string, kind unikornv1.ProjectRoleBindingSubjectKind, subject string) {
+	t.Helper()
+
+	binding := &unikornv1.ProjectRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      projectRoleBindingName,
+		},
+		Spec: unikornv1.ProjectRoleBindingSpec{
+			Role: "editor",
+			Subjects: []unikornv1.ProjectRoleBindingSubject{
+				{
+					Kind: kind,
+					Name: subject,
+				},
+			},
+		},
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Create(context.TODO(), binding))
+}
+
+// provisioned returns the conditions of a provisioned resource.
+func provisioned() []coreunikornv1.Condition {
+	return []coreunikornv1.Condition{
+		{
+			Type:   coreunikornv1.ConditionAvailable,
+			Status: corev1.ConditionTrue,
+			Reason: coreunikornv1.ConditionReasonProvisioned,
+		},
+	}
+}
+
+// provision allocates a namespace for a project or control plane and marks it
+// as provisioned, just as unikorn-project-manager or unikorn-controlplane-manager
+// would.  This is done synchronously, as handlers only allow a short grace period
+// for resources to become active.
+func provision(ctx context.Context, c client.WithWatch, object client.Object) error {
+	var labels map[string]string
+
+	// Fixtures are already provisioned.
+	switch t := object.(type) {
+	case *unikornv1.Project:
+		if t.Status.Namespace != "" {
+			return nil
+		}
+
+		labels = map[string]string{
+			constants.ProjectLabel: t.Name,
+		}
+	case *unikornv1.ControlPlane:
+		if t.Status.Namespace != "" {
+			return nil
+		}
+
+		labels = map[string]string{
+			constants.ProjectLabel:      t.Labels[constants.ProjectLabel],
+			constants.ControlPlaneLabel: t.Name,
+		}
+	default:
+		return nil
+	}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "emulated-",
+			Labels:       labels,
+		},
+	}
+
+	if err := c.Create(ctx, namespace); err != nil {
+		return err
+	}
+
+	switch t := object.DeepCopyObject().(type) {
+	case *unikornv1.Project:
+		t.Status.Namespace = namespace.Name
+		t.Status.Conditions = provisioned()
+
+		return c.Update(ctx, t)
+	case *unikornv1.ControlPlane:
+		t.Status.Namespace = namespace.Name
+		t.Status.Conditions = provisioned()
+
+		return c.Update(ctx, t)
+	}
+
+	return nil
+}
+
+// create intercepts creates by the server, and provisions resources if the test
+// is emulating managers.
+func (t *TestContext) create(ctx context.Context, c client.WithWatch, object client.Object, opts ...client.CreateOption) error {
+	if err := c.Create(ctx, object, opts...); err != nil {
+		return err
+	}
+
+	if !t.emulateManagers.Load() {
+		return nil
+	}
+
+	return provision(ctx, c, object)
+}
+
+// mustEmulateManagers provisions projects and control planes as they are created,
+// so implicitly created resources become active.
+func mustEmulateManagers(t *testing.T, tc *TestContext) {
+	t.Helper()
+
+	tc.emulateManagers.Store(true)
+}
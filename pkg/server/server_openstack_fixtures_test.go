@@ -0,0 +1,407 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server_test
+
+import (
+	"time"
+
+	"github.com/eschercloudai/unikorn/pkg/testutil/openstackmock"
+)
+
+const userID = "5e6bb9d8-03a1-4d26-919c-6884ff574a31"
+const userName = "foo"
+const userEmail = "foo@bar.com"
+const adminRole = "admin"
+
+const projectAdminRoleID = "3c2f3b3c-9a1c-4e1e-8f0b-1d7f1d1c6b7a"
+const projectAdminRole = "manager"
+const projectEditorRoleID = "9b3b3e0c-5e36-4f0b-8d67-6b3a2cfb8f11"
+const projectEditorRole = "member"
+const projectReaderRoleID = "5f6a0e8e-0e2b-4d5c-9d1a-2e0a9e3c7d44"
+const projectReaderRole = "reader"
+
+// loadBalancerRole is required, along with the project editor role, to create
+// application credentials.
+const loadBalancerRole = "load-balancer_member"
+
+const memberUserID = "0a1d5b38-7b62-4f4e-9a0b-4ad1c6f2d1e9"
+const memberUserName = "bar"
+
+const projectID = "63051c2c-4d9e-40c0-bf57-93907a61b738"
+const projectName = "foo"
+
+const imageID = "aa21abae-5743-442c-bb69-39a6411558a7"
+const imageName = "ubuntu-22.04-lts"
+const imageK8sVersion = "1.28.0"
+const imageGpuVersion = "525.85.05"
+const imageName2 = "ubuntu-24.04-lts"
+const imageGpuVersion2 = "470.182.03"
+const imageTimestamp = "2019-01-01T00:00:00Z"
+const upgradeImageName = "ubuntu-22.04-lts-1.28.5"
+const upgradeImageK8sVersion = "1.28.5"
+
+const flavorID = "f547e5e4-5d9e-4434-bb78-d43cabcce79c"
+const flavorName = "blueberry"
+const flavorCpus = 2
+const flavorMemory = 8 << 10
+const flavorDisk = 20
+
+const flavorGPUModel = "A100"
+const flavorGPUMemory = 40
+const flavorGPUProfile = "A100D-3-40C"
+const flavorGPUDriverVersion = "525.60.13"
+
+const flavorName2 = "strawberry"
+const flavorName3 = "raspberry"
+
+const keyPairName = "chubb"
+
+const computeAvailabilityZoneName = "danger_nova"
+const computeAvailabilityZoneAnnotation = "gpu capacity constrained"
+const computeAvailabilityZoneHosts = 2
+
+const blockStorageAvailabilityZone = "ceph"
+
+const externalNetworkID = "605eddb9-39e1-4309-972f-c62ced50f40f"
+
+const computeQuotaCoresLimit = 256
+const computeQuotaCoresInUse = 48
+const computeQuotaCoresReserved = 2
+
+const blockStorageQuotaGigabytesLimit = 10000
+const blockStorageQuotaGigabytesInUse = 1200
+const blockStorageQuotaPerVolumeGigabytes = 500
+
+const networkQuotaFloatingIPLimit = -1
+const networkQuotaFloatingIPUsed = 2
+
+// mustParseTime parses an RFC3339 time or dies.
+func mustParseTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+// setupOpenstackAdminFixtures grants the user administrative privileges.
+func setupOpenstackAdminFixtures(m *openstackmock.Mock) {
+	m.SetUser(openstackmock.User{
+		ID:    userID,
+		Name:  userName,
+		Email: userEmail,
+		Roles: []string{adminRole, projectEditorRole, loadBalancerRole},
+	})
+}
+
+// setupOpenstackNoLoadBalancerRoleFixtures removes the load balancer role from
+// the user, so they are unable to create application credentials.
+func setupOpenstackNoLoadBalancerRoleFixtures(m *openstackmock.Mock) {
+	m.SetUser(openstackmock.User{
+		ID:    userID,
+		Name:  userName,
+		Email: userEmail,
+		Roles: []string{projectEditorRole},
+	})
+}
+
+// setupOpenstackProjectRoleFixtures replaces the user's project role.
+func setupOpenstackProjectRoleFixtures(m *openstackmock.Mock, roleID, role string) {
+	m.SetUser(openstackmock.User{
+		ID:    userID,
+		Name:  userName,
+		Email: userEmail,
+		Roles: []string{role},
+	})
+
+	for _, assignment := range m.RoleAssignments() {
+		if assignment.UserID == userID {
+			m.RemoveRoleAssignment(assignment)
+		}
+	}
+
+	m.AddRoleAssignment(openstackmock.RoleAssignment{
+		ProjectID: projectID,
+		UserID:    userID,
+		RoleID:    roleID,
+	})
+}
+
+// setupOpenstackMemberFixtures adds another user to the project.
+func setupOpenstackMemberFixtures(m *openstackmock.Mock, roleID string) {
+	m.AddUser(openstackmock.User{
+		ID:   memberUserID,
+		Name: memberUserName,
+	})
+
+	m.AddRoleAssignment(openstackmock.RoleAssignment{
+		ProjectID: projectID,
+		UserID:    memberUserID,
+		RoleID:    roleID,
+	})
+}
+
+// setupOpenstackFixtures populates the mock OpenStack with the resources tests
+// expect to see.  Handlers are registered by individual tests.
+func setupOpenstackFixtures(m *openstackmock.Mock) {
+	m.SetUser(openstackmock.User{
+		ID:    userID,
+		Name:  userName,
+		Email: userEmail,
+		Roles: []string{projectEditorRole, loadBalancerRole},
+	})
+
+	m.AddProject(openstackmock.Project{
+		ID:   projectID,
+		Name: projectName,
+	})
+
+	m.AddRole(openstackmock.Role{
+		ID:   projectAdminRoleID,
+		Name: projectAdminRole,
+	})
+
+	m.AddRole(openstackmock.Role{
+		ID:   projectEditorRoleID,
+		Name: projectEditorRole,
+	})
+
+	m.AddRole(openstackmock.Role{
+		ID:   projectReaderRoleID,
+		Name: projectReaderRole,
+	})
+
+	m.AddRoleAssignment(openstackmock.RoleAssignment{
+		ProjectID: projectID,
+		UserID:    userID,
+		RoleID:    projectEditorRoleID,
+	})
+
+	// Start with one as that flexes more code.
+	m.AddApplicationCredential(openstackmock.ApplicationCredential{
+		ID:   "75f56f78-18e0-4f60-83c4-7109cafe3fd1",
+		Name: "foo-foo",
+	})
+
+	setupOpenstackImageFixtures(m)
+	setupOpenstackFlavorFixtures(m)
+
+	m.AddKeyPair(keyPairName)
+
+	m.AddComputeAvailabilityZone(openstackmock.ComputeAvailabilityZone{
+		Name: "internal",
+		Hosts: map[string][]string{
+			"controller-1": {"nova-scheduler"},
+		},
+	})
+
+	m.AddComputeAvailabilityZone(openstackmock.ComputeAvailabilityZone{
+		Name: computeAvailabilityZoneName,
+		Hosts: map[string][]string{
+			"compute-1": {"nova-compute"},
+			"compute-2": {"nova-compute"},
+		},
+	})
+
+	m.AddBlockStorageAvailabilityZone(blockStorageAvailabilityZone)
+
+	m.AddNetwork(openstackmock.Network{
+		ID:       externalNetworkID,
+		External: true,
+	})
+
+	m.SetComputeQuota(openstackmock.ComputeQuota{
+		Cores: openstackmock.QuotaDetail{
+			InUse:    computeQuotaCoresInUse,
+			Limit:    computeQuotaCoresLimit,
+			Reserved: computeQuotaCoresReserved,
+		},
+		RAM: openstackmock.QuotaDetail{
+			InUse: 196608,
+			Limit: 1048576,
+		},
+		Instances: openstackmock.QuotaDetail{
+			InUse: 12,
+			Limit: 64,
+		},
+	})
+
+	m.SetBlockStorageQuota(openstackmock.BlockStorageQuota{
+		Volumes: openstackmock.QuotaDetail{
+			InUse: 12,
+			Limit: 100,
+		},
+		Gigabytes: openstackmock.QuotaDetail{
+			InUse: blockStorageQuotaGigabytesInUse,
+			Limit: blockStorageQuotaGigabytesLimit,
+		},
+		PerVolumeGigabytes: blockStorageQuotaPerVolumeGigabytes,
+	})
+
+	m.SetNetworkQuota(openstackmock.NetworkQuota{
+		FloatingIP: openstackmock.NetworkQuotaDetail{
+			Used:  networkQuotaFloatingIPUsed,
+			Limit: networkQuotaFloatingIPLimit,
+		},
+		Network: openstackmock.NetworkQuotaDetail{
+			Used:  2,
+			Limit: 10,
+		},
+		Port: openstackmock.NetworkQuotaDetail{
+			Used:  31,
+			Limit: 500,
+		},
+		Router: openstackmock.NetworkQuotaDetail{
+			Used:  2,
+			Limit: 10,
+		},
+		SecurityGroup: openstackmock.NetworkQuotaDetail{
+			Used:  8,
+			Limit: 20,
+		},
+	})
+}
+
+// setupOpenstackImageFixtures adds images.  Note the first entry should be
+// filtered out due to lack of a digest, then we should be presented with the
+// third image first then the second as they are time ordered.  The second
+// image has an older nvidia driver than that required by the A100 flavors.
+func setupOpenstackImageFixtures(m *openstackmock.Mock) {
+	m.AddImage(openstackmock.Image{
+		ID:   "6876460a-64be-40d1-8520-a3dad947cfba",
+		Name: "foo",
+	})
+
+	m.AddImage(openstackmock.Image{
+		ID:        "6daa3bee-63b8-48a3-a082-52ad680dd3c0",
+		Name:      imageName2,
+		Status:    "active",
+		CreatedAt: mustParseTime("2020-01-01T00:00:00Z"),
+		UpdatedAt: mustParseTime("2020-01-01T00:00:00Z"),
+		Properties: map[string]string{
+			"k8s":    "1.28.0",
+			"gpu":    imageGpuVersion2,
+			"digest": "MGYCMQD9kCkukyFePyvNbKe8/DLC4BZAyNJb6e5EvEqf1guR63qBr7E55/GKTVFoWBPS/v0CMQD9AK4aLdRhzWNoAC/IPT7lKQ6k20A/l/CN3cH9x8Qq9y7kfzPUOP1C15nJZsinpzk=",
+		},
+	})
+
+	m.AddImage(openstackmock.Image{
+		ID:        imageID,
+		Name:      imageName,
+		Status:    "active",
+		CreatedAt: mustParseTime(imageTimestamp),
+		UpdatedAt: mustParseTime(imageTimestamp),
+		Properties: map[string]string{
+			"k8s":    imageK8sVersion,
+			"gpu":    imageGpuVersion,
+			"digest": "MGYCMQDTPrcsaQJvsbc+hAFSuU6keI5Cf+jjGWPHs3qRkPegMAtjfABvrZNFl3ZMWkR76ygCMQCyLm2+xhAr92DgKs7IEOcG3rbax5Ye/C2MfKPGSiUFQYBD4kMT9XQZ+GMz/jpLUYw=",
+		},
+	})
+}
+
+// setupOpenstackUpgradeImageFixtures adds a newer image with a newer Kubernetes
+// version to upgrade to.  Signatures are over the image ID, so this shares
+// one with an existing image.
+func setupOpenstackUpgradeImageFixtures(m *openstackmock.Mock) {
+	m.AddImage(openstackmock.Image{
+		ID:        imageID,
+		Name:      upgradeImageName,
+		Status:    "active",
+		CreatedAt: mustParseTime("2021-01-01T00:00:00Z"),
+		UpdatedAt: mustParseTime("2021-01-01T00:00:00Z"),
+		Properties: map[string]string{
+			"k8s":    upgradeImageK8sVersion,
+			"gpu":    imageGpuVersion,
+			"digest": "MGYCMQDTPrcsaQJvsbc+hAFSuU6keI5Cf+jjGWPHs3qRkPegMAtjfABvrZNFl3ZMWkR76ygCMQCyLm2+xhAr92DgKs7IEOcG3rbax5Ye/C2MfKPGSiUFQYBD4kMT9XQZ+GMz/jpLUYw=",
+		},
+	})
+}
+
+// setupOpenstackFlavorFixtures adds a load of different flavors, we expect these
+// to be sorted by the provider so things with a GPU come first, and then CPU
+// only flavors.  Those buckets are then sorted by the number of GPUs and
+// CPUs respectively, low to high.  Flavors with <1 CPU and <2GiB RAM, or with
+// swap, should not be returned, nor should baremetal flavors.
+func setupOpenstackFlavorFixtures(m *openstackmock.Mock) {
+	m.AddFlavor(openstackmock.Flavor{
+		ID:    "6b1cede8-a814-4cf6-94c1-16ca5f51b1ec",
+		Name:  flavorName3,
+		VCPUs: 2,
+		RAM:   8192,
+		Disk:  20,
+	})
+
+	m.AddFlavor(openstackmock.Flavor{
+		ID:    "77bf7ac4-429e-45db-b101-5736ef1b8d3c",
+		Name:  flavorName2,
+		VCPUs: 8,
+		RAM:   32768,
+		Disk:  20,
+		ExtraSpecs: map[string]string{
+			"pci_passthrough:alias": "a100:2",
+		},
+	})
+
+	m.AddFlavor(openstackmock.Flavor{
+		ID:    "2b037c3a-24c5-49b3-820a-c72c232d26a0",
+		Name:  "filtered1",
+		VCPUs: 1,
+		RAM:   4096,
+		Disk:  20,
+	})
+
+	m.AddFlavor(openstackmock.Flavor{
+		ID:    "2b037c3a-24c5-49b3-820a-c72c232d26a0",
+		Name:  "filtered2",
+		VCPUs: 2,
+		RAM:   512,
+		Disk:  20,
+	})
+
+	m.AddFlavor(openstackmock.Flavor{
+		ID:    "2b037c3a-24c5-49b3-820a-c72c232d26a0",
+		Name:  "filtered3",
+		VCPUs: 2,
+		RAM:   4096,
+		Disk:  20,
+		Swap:  1024,
+	})
+
+	m.AddFlavor(openstackmock.Flavor{
+		ID:    "d7f75b0f-888c-4fed-a807-2cbbee7d5afe",
+		Name:  "g.baremetal",
+		VCPUs: 64,
+		RAM:   524228,
+		Disk:  12000,
+		ExtraSpecs: map[string]string{
+			"resources:CUSTOM_BAREMETAL": "1",
+		},
+	})
+
+	m.AddFlavor(openstackmock.Flavor{
+		ID:    flavorID,
+		Name:  flavorName,
+		VCPUs: flavorCpus,
+		RAM:   flavorMemory,
+		Disk:  flavorDisk,
+		ExtraSpecs: map[string]string{
+			"resources:VGPU":           "1",
+			"trait:CUSTOM_A100D_3_40C": "required",
+		},
+	})
+}
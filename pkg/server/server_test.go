@@ -19,6 +19,9 @@ package server_test
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -26,28 +29,50 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	chi "github.com/go-chi/chi/v5"
+	"github.com/gophercloud/utils/openstack/clientconfig"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
 	"golang.org/x/oauth2"
 
 	unikornscheme "github.com/eschercloudai/unikorn/generated/clientset/unikorn/scheme"
 	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
 	"github.com/eschercloudai/unikorn/pkg/constants"
+	"github.com/eschercloudai/unikorn/pkg/logging"
 	"github.com/eschercloudai/unikorn/pkg/server"
+	"github.com/eschercloudai/unikorn/pkg/server/authorization/policy"
+	serverdebug "github.com/eschercloudai/unikorn/pkg/server/debug"
+	serverdeprecation "github.com/eschercloudai/unikorn/pkg/server/deprecation"
+	"github.com/eschercloudai/unikorn/pkg/server/devidp"
 	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/handler"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/backup"
+	"github.com/eschercloudai/unikorn/pkg/server/handler/cost"
+	"github.com/eschercloudai/unikorn/pkg/testutil/openstackmock"
 
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
 	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
+	"github.com/eschercloudai/unikorn-core/pkg/util"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -81,12 +106,31 @@ KjAL9BBqzrnOrLYodEk=
 
 	// pubKeyFile is where the verification key will live.
 	pubKeyFile = "/tmp/unikorn-pub-key.pem"
+
+	// oauth2ClientID is the client ID clients use to talk to the server.
+	oauth2ClientID = "9a719e1e-aa85-4a21-a221-324e787efd78"
+
+	// oauth2RedirectURI is where the server redirects clients to after authorization.
+	oauth2RedirectURI = "https://kubernetes.eschercloud.com/oauth2/callback"
+
+	// oidcClientID is the client ID the server uses to talk to the IdP.
+	oidcClientID = "unikorn-server"
+
+	// oidcEmail is the identity the IdP reports.
+	oidcEmail = "barry@foo.com"
+
+	// oidcGroup is a group the IdP reports the user is a member of.
+	oidcGroup = "platform-engineers"
 )
 
 var (
 	// debug turns on test debugging.
 	//nolint:gochecknoglobals
 	debug bool
+
+	// idp is a mock OIDC identity provider shared by all tests.
+	//nolint:gochecknoglobals
+	idp *devidp.Provider
 )
 
 func projectNameFromID(projectID string) string {
@@ -117,10 +161,10 @@ type TestContext struct {
 	// openstackServer is the mock openstack server instance.
 	openstackServer *http.Server
 
-	// openstackRouter is the router used by the openstack server instance.
-	// This allows you to chop and change handlers based on what responses
-	// the test expects.
-	openstackRouter chi.Router
+	// openstack is the mock openstack served by the openstack server
+	// instance.  This allows you to chop and change handlers and fixtures
+	// based on what responses the test expects.
+	openstack *openstackmock.Mock
 
 	// unikornEndpoint records the TCP address of the unikorn server.
 	unikornEndpoint net.Addr
@@ -131,6 +175,10 @@ type TestContext struct {
 	// kubernetesClient allows fake resources to be tested or mutated to
 	// trigger various testing scenarios.
 	kubernetesClient client.WithWatch
+
+	// emulateManagers, when set, provisions projects and control planes
+	// as they are created.
+	emulateManagers atomic.Bool
 }
 
 func MustNewTestContext(t *testing.T) (*TestContext, func()) {
@@ -141,18 +189,21 @@ func MustNewTestContext(t *testing.T) (*TestContext, func()) {
 		t.Fatal(err)
 	}
 
-	kubernetesClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	tc := &TestContext{}
+
+	kubernetesClient := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{Create: tc.create}).Build()
 	openstackEndpoint, openstackServer, openstackRouter := mustSetupOpenstackServer(t)
 	unikornEndpoint, unikornServer := mustSetupUnikornServer(t, openstackEndpoint, kubernetesClient)
 
-	tc := &TestContext{
-		openstackEndpoint: openstackEndpoint,
-		openstackServer:   openstackServer,
-		openstackRouter:   openstackRouter,
-		unikornEndpoint:   unikornEndpoint,
-		unikornServer:     unikornServer,
-		kubernetesClient:  kubernetesClient,
-	}
+	tc.openstackEndpoint = openstackEndpoint
+	tc.openstackServer = openstackServer
+	tc.openstack = openstackmock.New(openstackRouter, openstackEndpoint.String())
+	tc.unikornEndpoint = unikornEndpoint
+	tc.unikornServer = unikornServer
+	tc.kubernetesClient = kubernetesClient
+
+	setupOpenstackFixtures(tc.openstack)
+	mustCreateDefaultPolicyFixture(t, tc)
 
 	shutdown := func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -180,8 +231,8 @@ func (t *TestContext) UnikornServerEndpoint() string {
 	return t.unikornEndpoint.String()
 }
 
-func (t *TestContext) OpenstackRouter() chi.Router {
-	return t.openstackRouter
+func (t *TestContext) Openstack() *openstackmock.Mock {
+	return t.openstack
 }
 
 func (t *TestContext) KubernetesClient() client.WithWatch {
@@ -245,19 +296,38 @@ func mustSetupUnikornServer(t *testing.T, openstack net.Addr, client client.With
 		"--jose-tls-cert=" + pubKeyFile,
 		"--jose-tls-key=" + privKeyFile,
 		"--keystone-endpoint=http://" + openstack.String() + "/identity",
+		"--keystone-federation-token-endpoint=http://" + openstack.String() + "/identity/v3/OS-FEDERATION/identity_providers/mock/protocols/openid/auth",
+		"--oidc-client-id=" + oidcClientID,
+		"--oidc-issuer=" + idp.Issuer(),
+		"--oidc-autorization-endpoint=" + idp.AuthorizationEndpoint(),
+		"--oidc-token-endpoint=" + idp.TokenEndpoint(),
+		"--oidc-jwks-url=" + idp.JWKSURL(),
+		"--oauth2-client-id=" + oauth2ClientID,
+		"--oauth2-redirect-uri=" + oauth2RedirectURI,
 		"--image-signing-key=LS0tLS1CRUdJTiBQVUJMSUMgS0VZLS0tLS0KTUhZd0VBWUhLb1pJemowQ0FRWUZLNEVFQUNJRFlnQUVmOGs4RVY1TUg4M1BncThYd0JGUTd5YkU2NTEzRlh0awpHaG1jalp4WmYzbU5QOE0vb3VBbE0vZHdYWGpFeXZTNlJhVHdoT3A0aTdHL3VvbE5ZL0RJSCt1elc2VXNxR3VHClFpSW11Tm9BdzFSS1NQcEtyNWlJVXU2eEc1cDR3U3E5Ci0tLS0tRU5EIFBVQkxJQyBLRVktLS0tLQo=",
 		"--flavors-exclude-property=resources:CUSTOM_BAREMETAL",
-		"--flavors-gpu-descriptor=property=resources:VGPU,expression=^(\\d+)$",
-		"--flavors-gpu-descriptor=property=pci_passthrough:alias,expression=^a100:(\\d+)$",
-		"--application-credential-roles=_member_,member,load-balancer_member",
+		"--flavors-gpu-descriptor=property=resources:VGPU,expression=^(\\d+)$,model=" + flavorGPUModel + ",memory=" + strconv.Itoa(flavorGPUMemory) + ",profile=" + flavorGPUProfile + ",driver=" + flavorGPUDriverVersion,
+		"--flavors-gpu-descriptor=property=pci_passthrough:alias,expression=^a100:(\\d+)$,model=A100,memory=80,driver=" + flavorGPUDriverVersion,
+		"--application-credential-roles=" + projectEditorRole + "," + loadBalancerRole,
+		"--compute-availability-zone-annotation=" + computeAvailabilityZoneName + "=" + computeAvailabilityZoneAnnotation,
+		"--cost-price-sheet-namespace=" + priceSheetNamespace,
+		"--cost-price-sheet-name=" + priceSheetName,
+		"--keystone-admin-roles=" + adminRole,
+		"--policy-namespace=" + policyNamespace,
+		"--policy-name=" + policyName,
+		"--kubernetes-version-policy-namespace=" + kubernetesVersionPolicyNamespace,
+		"--kubernetes-version-policy-name=" + kubernetesVersionPolicyName,
+		"--monitor-shard-namespace=" + monitorShardNamespace,
 	}
 
 	if err := flagSet.Parse(flags); err != nil {
 		t.Fatal(err)
 	}
 
-	// Override any flag defaults we need to.
-	s.Options.RequestTimeout = 0
+	// Override any flag defaults we need to.  The request timeout needs to be
+	// long enough for the OIDC exchange with the IdP, but short enough that
+	// tests that wait for resources to provision fail quickly.
+	s.Options.RequestTimeout = time.Second
 
 	if debug {
 		s.SetupLogging()
@@ -267,7 +337,7 @@ func mustSetupUnikornServer(t *testing.T, openstack net.Addr, client client.With
 		}
 	}
 
-	server, err := s.GetServer(client)
+	server, err := s.GetServer(client, client)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -306,8 +376,29 @@ func TestMain(m *testing.M) {
 		os.Exit(1)
 	}
 
+	// The IdP needs to know its URL before it can handle any requests.
+	idpServer := httptest.NewServer(nil)
+
+	provider, err := devidp.New(idpServer.URL, devidp.Options{
+		ClientID: oidcClientID,
+		Subject:  oidcEmail,
+		Email:    oidcEmail,
+		Groups:   []string{oidcGroup},
+	})
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	idp = provider
+	idpServer.Config.Handler = provider
+
 	// Test!
-	os.Exit(m.Run())
+	status := m.Run()
+
+	idpServer.Close()
+
+	os.Exit(status)
 }
 
 // JSONReader implments io.Reader that does lazy JSON marshaling.
@@ -385,13 +476,21 @@ func MustNewUnscopedClient(t *testing.T, tc *TestContext) *generated.ClientWithR
 func MustNewScopedClient(t *testing.T, tc *TestContext) *generated.ClientWithResponses {
 	t.Helper()
 
+	return MustNewScopedClientFromToken(t, tc, MustNewUnscopedToken(t, tc))
+}
+
+// MustNewScopedClientFromToken creates a new client, or dies on error, upgrading
+// the unscoped token to be scoped to a project.
+func MustNewScopedClientFromToken(t *testing.T, tc *TestContext, token string) *generated.ClientWithResponses {
+	t.Helper()
+
 	scope := &generated.TokenScope{
 		Project: generated.TokenScopeProject{
 			Id: projectID,
 		},
 	}
 
-	response, err := MustNewUnscopedClient(t, tc).PostApiV1AuthTokensTokenWithBodyWithResponse(context.TODO(), "application/json", NewJSONReader(scope))
+	response, err := MustNewClient(t, tc, token).PostApiV1AuthTokensTokenWithBodyWithResponse(context.TODO(), "application/json", NewJSONReader(scope))
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusCreated, response.HTTPResponse.StatusCode)
 
@@ -442,7 +541,7 @@ func TestApiV1AuthOAuth2TokensPassword(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	_ = MustNewUnscopedClient(t, tc)
 }
@@ -455,7 +554,8 @@ func TestApiV1AuthOAuth2TokensPasswordUnauthorized(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityV3AuthTokensPostUnauthorizedHandler(tc)
+	tc.Openstack().RegisterIdentityV3AuthTokens()
+	tc.Openstack().Fail(openstackmock.IdentityV3AuthTokensPost, http.StatusUnauthorized)
 
 	endpoint := "http://" + tc.UnikornServerEndpoint() + "/api/v1/auth/oauth2/tokens"
 
@@ -493,6 +593,156 @@ func TestApiV1AuthOAuth2TokensPasswordInvalid(t *testing.T) {
 	AssertOauth2Error(t, response, generated.InvalidRequest)
 }
 
+// mustGetRedirect issues a GET request and returns where it redirects to, rather
+// than following it.
+func mustGetRedirect(t *testing.T, location string) *url.URL {
+	t.Helper()
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	request, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, location, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusFound {
+		t.Fatal("expected a redirect", response.StatusCode)
+	}
+
+	redirect, err := response.Location()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return redirect
+}
+
+// mustNewAuthorizationCodeToken does the full oauth2 authorization code flow,
+// including the delegated OIDC exchange with the IdP, and returns the token.
+func mustNewAuthorizationCodeToken(t *testing.T, tc *TestContext) *generated.Token {
+	t.Helper()
+
+	codeVerifier := oauth2.GenerateVerifier()
+
+	query := url.Values{}
+	query.Set("client_id", oauth2ClientID)
+	query.Set("redirect_uri", oauth2RedirectURI)
+	query.Set("response_type", "code")
+	query.Set("code_challenge_method", "S256")
+	query.Set("code_challenge", oauth2.S256ChallengeFromVerifier(codeVerifier))
+	query.Set("scope", "openid email")
+	query.Set("state", "foo")
+
+	// The server redirects to the IdP, which redirects back to the server.
+	idpAuthorization := mustGetRedirect(t, "http://"+tc.UnikornServerEndpoint()+"/api/v1/auth/oauth2/authorization?"+query.Encode())
+	assert.Equal(t, idp.AuthorizationEndpoint(), idpAuthorization.Scheme+"://"+idpAuthorization.Host+idpAuthorization.Path)
+
+	callback := mustGetRedirect(t, idpAuthorization.String())
+	assert.Equal(t, "/api/v1/auth/oidc/callback", callback.Path)
+	assert.True(t, callback.Query().Has("code"))
+
+	// The server assumes it's behind a TLS terminating ingress.
+	callback.Scheme = "http"
+
+	// And finally back to the client with an authorization code.
+	clientCallback := mustGetRedirect(t, callback.String())
+	assert.Equal(t, oauth2RedirectURI, clientCallback.Scheme+"://"+clientCallback.Host+clientCallback.Path)
+	assert.False(t, clientCallback.Query().Has("error"), clientCallback.Query().Get("description"))
+	assert.Equal(t, "foo", clientCallback.Query().Get("state"))
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("client_id", oauth2ClientID)
+	form.Set("redirect_uri", oauth2RedirectURI)
+	form.Set("code", clientCallback.Query().Get("code"))
+	form.Set("code_verifier", codeVerifier)
+
+	response := MustDoRequestWithForm(t, http.MethodPost, "http://"+tc.UnikornServerEndpoint()+"/api/v1/auth/oauth2/tokens", form)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var token generated.Token
+
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&token))
+	assert.NotEmpty(t, token.AccessToken)
+	assert.NotNil(t, token.IdToken)
+
+	return &token
+}
+
+// TestApiV1AuthOAuth2AuthorizationCode tests the full oauth2 authorization code flow,
+// including the delegated OIDC exchange with the IdP.
+func TestApiV1AuthOAuth2AuthorizationCode(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterIdentityV3OIDCTokenExchange()
+
+	token := mustNewAuthorizationCodeToken(t, tc)
+
+	// And the token should be usable.
+	unikornClient := MustNewClient(t, tc, token.AccessToken)
+
+	projects, err := unikornClient.GetApiV1ProvidersOpenstackProjectsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, projects.HTTPResponse.StatusCode)
+}
+
+// TestApiV1AuthOAuth2AuthorizationCodeBadVerifier tests the authorization code flow
+// is rejected when the client cannot prove it made the authorization request.
+func TestApiV1AuthOAuth2AuthorizationCodeBadVerifier(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterIdentityV3OIDCTokenExchange()
+
+	query := url.Values{}
+	query.Set("client_id", oauth2ClientID)
+	query.Set("redirect_uri", oauth2RedirectURI)
+	query.Set("response_type", "code")
+	query.Set("code_challenge_method", "S256")
+	query.Set("code_challenge", oauth2.S256ChallengeFromVerifier(oauth2.GenerateVerifier()))
+
+	idpAuthorization := mustGetRedirect(t, "http://"+tc.UnikornServerEndpoint()+"/api/v1/auth/oauth2/authorization?"+query.Encode())
+
+	callback := mustGetRedirect(t, idpAuthorization.String())
+	callback.Scheme = "http"
+
+	clientCallback := mustGetRedirect(t, callback.String())
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("client_id", oauth2ClientID)
+	form.Set("redirect_uri", oauth2RedirectURI)
+	form.Set("code", clientCallback.Query().Get("code"))
+	form.Set("code_verifier", oauth2.GenerateVerifier())
+
+	response := MustDoRequestWithForm(t, http.MethodPost, "http://"+tc.UnikornServerEndpoint()+"/api/v1/auth/oauth2/tokens", form)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidClient)
+}
+
 // TestApiV1AuthTokensToken tests an unscoped token can be scoped to a project.
 func TestApiV1AuthTokensToken(t *testing.T) {
 	t.Parallel()
@@ -500,7 +750,7 @@ func TestApiV1AuthTokensToken(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	_ = MustNewScopedClient(t, tc)
 }
@@ -555,11 +805,11 @@ func TestApiV1ProjectCreateAndDelete(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	createResponse, err := unikornClient.PostApiV1Project(context.TODO())
+	createResponse, err := unikornClient.PostApiV1Project(context.TODO(), &generated.PostApiV1ProjectParams{})
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusAccepted, createResponse.StatusCode)
 
@@ -590,13 +840,13 @@ func TestApiV1ProjectCreateExisting(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	mustCreateProjectFixture(t, tc, projectID)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.PostApiV1ProjectWithResponse(context.TODO())
+	response, err := unikornClient.PostApiV1ProjectWithResponse(context.TODO(), &generated.PostApiV1ProjectParams{})
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusConflict, response.HTTPResponse.StatusCode)
 	assert.NotNil(t, response.JSON409)
@@ -614,7 +864,7 @@ func TestApiV1ProjectDeleteNotFound(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	unikornClient := MustNewScopedClient(t, tc)
 
@@ -636,7 +886,7 @@ func TestApiV1ControlPlanesCreate(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 
@@ -649,7 +899,7 @@ func TestApiV1ControlPlanesCreate(t *testing.T) {
 		},
 	}
 
-	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), "application/json", NewJSONReader(request))
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/json", NewJSONReader(request))
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusAccepted, response.StatusCode)
 
@@ -662,6 +912,45 @@ func TestApiV1ControlPlanesCreate(t *testing.T) {
 	assert.Contains(t, resource.Labels, constants.ProjectLabel)
 }
 
+// TestApiV1ControlPlanesCreateAutoUpgradeChannel tests control planes can subscribe
+// to an application bundle release channel.
+func TestApiV1ControlPlanesCreateAutoUpgradeChannel(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	channel := generated.Rapid
+
+	request := &generated.ControlPlane{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: "foo",
+		},
+		ApplicationBundleAutoUpgrade: &generated.ApplicationBundleAutoUpgrade{
+			Channel: &channel,
+		},
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.ApplicationBundleAutoUpgrade)
+	assert.Equal(t, unikornv1.ApplicationBundleChannelRapid, resource.Spec.ApplicationBundleAutoUpgrade.GetChannel())
+}
+
 // TestApiV1ControlPlanesCreateExisting tests control plane creation when another
 // already exists with the same name.
 func TestApiV1ControlPlanesCreateExisting(t *testing.T) {
@@ -670,7 +959,7 @@ func TestApiV1ControlPlanesCreateExisting(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
@@ -684,7 +973,7 @@ func TestApiV1ControlPlanesCreateExisting(t *testing.T) {
 		},
 	}
 
-	response, err := unikornClient.PostApiV1ControlplanesWithBodyWithResponse(context.TODO(), "application/json", NewJSONReader(request))
+	response, err := unikornClient.PostApiV1ControlplanesWithBodyWithResponse(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/json", NewJSONReader(request))
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusConflict, response.HTTPResponse.StatusCode)
 	assert.NotNil(t, response.JSON409)
@@ -692,6 +981,139 @@ func TestApiV1ControlPlanesCreateExisting(t *testing.T) {
 	serverErr := *response.JSON409
 
 	assert.Equal(t, serverErr.Error, generated.Conflict)
+	assert.NotNil(t, serverErr.Resource)
+	assert.Equal(t, generated.ResourceReferenceKindControlPlane, serverErr.Resource.Kind)
+	assert.Equal(t, "foo", serverErr.Resource.Name)
+	assert.Equal(t, "/api/v1/controlplanes/foo", serverErr.Resource.Href)
+}
+
+// TestApiV1ControlPlanesCreateIdempotent tests retrying a control plane creation
+// with the same idempotency key is successful and doesn't conflict.
+func TestApiV1ControlPlanesCreateIdempotent(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := &generated.ControlPlane{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: "foo",
+		},
+	}
+
+	params := &generated.PostApiV1ControlplanesParams{
+		IdempotencyKey: util.ToPointer("d7c2a7a4-33c4-4a0e-9a56-0c1f0c4b1c52"),
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), params, "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+	assert.Empty(t, response.Header.Get("Idempotent-Replayed"))
+
+	defer response.Body.Close()
+
+	retryResponse, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), params, "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, retryResponse.StatusCode)
+	assert.Equal(t, "true", retryResponse.Header.Get("Idempotent-Replayed"))
+
+	defer retryResponse.Body.Close()
+
+	var resource unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
+}
+
+// TestApiV1ControlPlanesCreateIdempotentKeyReused tests an idempotency key cannot
+// be reused for a different request.
+func TestApiV1ControlPlanesCreateIdempotentKeyReused(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustCreateProjectFixture(t, tc, projectID)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := &generated.ControlPlane{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: "foo",
+		},
+	}
+
+	params := &generated.PostApiV1ControlplanesParams{
+		IdempotencyKey: util.ToPointer("d7c2a7a4-33c4-4a0e-9a56-0c1f0c4b1c52"),
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), params, "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	request.Name = "bar"
+
+	reusedResponse, err := unikornClient.PostApiV1ControlplanesWithBodyWithResponse(context.TODO(), params, "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, reusedResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, reusedResponse.JSON422)
+
+	serverErr := *reusedResponse.JSON422
+
+	assert.Equal(t, generated.UnprocessableEntity, serverErr.Error)
+}
+
+// TestApiV1ControlPlanesCreateIdempotentRetryAfterFailure tests a failed request
+// doesn't consume the idempotency key, so it can be retried.
+func TestApiV1ControlPlanesCreateIdempotentRetryAfterFailure(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := &generated.ControlPlane{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: "foo",
+		},
+	}
+
+	params := &generated.PostApiV1ControlplanesParams{
+		IdempotencyKey: util.ToPointer("d7c2a7a4-33c4-4a0e-9a56-0c1f0c4b1c52"),
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), params, "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	defer response.Body.Close()
+
+	assert.NoError(t, tc.KubernetesClient().Delete(context.TODO(), controlPlane))
+
+	retryResponse, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), params, "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, retryResponse.StatusCode)
+	assert.Empty(t, retryResponse.Header.Get("Idempotent-Replayed"))
+
+	defer retryResponse.Body.Close()
 }
 
 // TestApiV1ControlPlaneCreateImplicitProject tests that a control plane can be created
@@ -702,7 +1124,7 @@ func TestApiV1ControlPlanesCreateImplicitProject(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	unikornClient := MustNewScopedClient(t, tc)
 
@@ -713,7 +1135,7 @@ func TestApiV1ControlPlanesCreateImplicitProject(t *testing.T) {
 		},
 	}
 
-	response, err := unikornClient.PostApiV1ControlplanesWithBodyWithResponse(context.TODO(), "application/json", NewJSONReader(request))
+	response, err := unikornClient.PostApiV1ControlplanesWithBodyWithResponse(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/json", NewJSONReader(request))
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusInternalServerError, response.HTTPResponse.StatusCode)
 	assert.NotNil(t, response.JSON500)
@@ -739,7 +1161,7 @@ func TestApiV1ControlPlanesNotCreateImplicitProject(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	unikornClient := MustNewScopedClient(t, tc)
 
@@ -766,7 +1188,7 @@ func TestApiV1ControlPlanesGet(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
@@ -786,6 +1208,47 @@ func TestApiV1ControlPlanesGet(t *testing.T) {
 	assert.Equal(t, "Provisioned", result.Status.Status)
 	assert.Equal(t, controlPlaneApplicationBundleName, result.ApplicationBundle.Name)
 	assert.Equal(t, controlPlaneApplicationBundleVersion, result.ApplicationBundle.Version)
+	assert.NotNil(t, result.Components)
+	assert.Len(t, *result.Components, 1)
+
+	component := (*result.Components)[0]
+
+	assert.Equal(t, controlPlaneComponentName, component.Name)
+	assert.Equal(t, controlPlaneComponentVersion, component.Version)
+	assert.NotNil(t, component.DeployedVersion)
+	assert.Equal(t, controlPlaneComponentDeployedVersion, *component.DeployedVersion)
+	assert.Nil(t, result.Placement)
+}
+
+// TestApiV1ControlPlanesGetPlacement tests administrators are told which
+// namespaces a control plane is provisioned in.
+func TestApiV1ControlPlanesGetPlacement(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateControlPlaneApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameWithResponse(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	assert.NotNil(t, result.Placement)
+	assert.Equal(t, util.ToPointer(project.Status.Namespace), result.Placement.ProjectNamespace)
+	assert.Equal(t, util.ToPointer(controlPlane.Status.Namespace), result.Placement.ControlPlaneNamespace)
+	assert.Nil(t, result.Placement.ClusterNamespace)
 }
 
 // TestApiV1ControlPlanesGetNotFound tests control planes behave correctly when
@@ -796,7 +1259,7 @@ func TestApiV1ControlPlanesGetNotFound(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	mustCreateProjectFixture(t, tc, projectID)
 
@@ -821,7 +1284,7 @@ func TestApiV1ControlPlanesList(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
@@ -842,28 +1305,27 @@ func TestApiV1ControlPlanesList(t *testing.T) {
 	assert.Equal(t, controlPlaneApplicationBundleVersion, results[0].ApplicationBundle.Version)
 }
 
-// TestApiV1ControlPlanesUpdate tests control planes can be updated.
-func TestApiV1ControlPlanesUpdate(t *testing.T) {
+// TestApiV1ControlPlanesCreateYAML tests control planes can be created with
+// a YAML request body.
+func TestApiV1ControlPlanesCreateYAML(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
-	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
-
-	request := &generated.ControlPlane{
-		Name: "foo",
-		ApplicationBundle: generated.ApplicationBundle{
-			Name: "foo",
-		},
-	}
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameWithBody(context.TODO(), "foo", "application/json", NewJSONReader(request))
+	request := `name: foo
+applicationBundle:
+  name: foo
+  version: 1.0.0
+`
+
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/yaml", bytes.NewBufferString(request))
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusAccepted, response.StatusCode)
 
@@ -872,25 +1334,97 @@ func TestApiV1ControlPlanesUpdate(t *testing.T) {
 	var resource unikornv1.ControlPlane
 
 	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
-	assert.NotNil(t, resource.Spec.ApplicationBundle)
-	assert.Equal(t, *resource.Spec.ApplicationBundle, "foo")
+	assert.Equal(t, "foo", *resource.Spec.ApplicationBundle)
 }
 
-// TestApiV1ControlPlanesUpdateNotFound tests control planes behave correctly when
-// an update request is made for a non-existent resource.
-func TestApiV1ControlPlanesUpdateNotFound(t *testing.T) {
+// TestApiV1ControlPlanesListYAML tests control planes can be listed as YAML
+// when requested by the client.
+func TestApiV1ControlPlanesListYAML(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
-	mustCreateProjectFixture(t, tc, projectID)
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateControlPlaneApplicationBundleFixture(t, tc)
 
-	request := &generated.ControlPlane{
-		Name: "foo",
-		ApplicationBundle: generated.ApplicationBundle{
+	unikornClient := MustNewScopedClient(t, tc)
+
+	acceptYAML := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept", "application/json;q=0.5, application/yaml")
+
+		return nil
+	}
+
+	response, err := unikornClient.GetApiV1Controlplanes(context.TODO(), acceptYAML)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "application/yaml", response.Header.Get("Content-Type"))
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+
+	var results generated.ControlPlanes
+
+	assert.NoError(t, yaml.UnmarshalStrict(body, &results))
+	assert.Len(t, results, 1)
+	assert.Equal(t, "foo", results[0].Name)
+}
+
+// TestApiV1ControlPlanesUpdate tests control planes can be updated.
+func TestApiV1ControlPlanesUpdate(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	request := &generated.ControlPlane{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: "foo",
+		},
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameWithBody(context.TODO(), "foo", "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.ApplicationBundle)
+	assert.Equal(t, *resource.Spec.ApplicationBundle, "foo")
+}
+
+// TestApiV1ControlPlanesUpdateNotFound tests control planes behave correctly when
+// an update request is made for a non-existent resource.
+func TestApiV1ControlPlanesUpdateNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustCreateProjectFixture(t, tc, projectID)
+
+	request := &generated.ControlPlane{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
 			Name: "foo",
 		},
 	}
@@ -907,6 +1441,239 @@ func TestApiV1ControlPlanesUpdateNotFound(t *testing.T) {
 	assert.Equal(t, serverErr.Error, generated.NotFound)
 }
 
+// TestApiV1ControlPlanesResize tests control planes can be resized, and that
+// the resources are reported.
+func TestApiV1ControlPlanesResize(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateControlPlaneApplicationBundleFixture(t, tc)
+
+	class := generated.Medium
+
+	request := generated.ControlPlaneResources{
+		Class:  &class,
+		Memory: util.ToPointer("2Gi"),
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameResize(context.TODO(), "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.Resources)
+	assert.NotNil(t, resource.Spec.Resources.Class)
+	assert.Equal(t, unikornv1.ControlPlaneResourceClassMedium, *resource.Spec.Resources.Class)
+	assert.Nil(t, resource.Spec.Resources.CPU)
+	assert.NotNil(t, resource.Spec.Resources.Memory)
+	assert.Equal(t, "2Gi", resource.Spec.Resources.Memory.String())
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameWithResponse(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.NotNil(t, getResponse.JSON200.Resources)
+	assert.Equal(t, class, *getResponse.JSON200.Resources.Class)
+	assert.Equal(t, "2Gi", *getResponse.JSON200.Resources.Memory)
+}
+
+// TestApiV1ControlPlanesResizeInvalid tests invalid resource quantities are rejected.
+func TestApiV1ControlPlanesResizeInvalid(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	request := generated.ControlPlaneResources{
+		Cpu: util.ToPointer("-1"),
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameResize(context.TODO(), "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidRequest)
+}
+
+const (
+	targetProjectID   = "c5b0a3d1-7f0e-4b8e-9d6a-2e1f3c4b5a69"
+	targetProjectName = "bar"
+)
+
+// TestApiV1ControlPlanesMove tests a control plane, and its clusters, can be moved
+// into another project.
+func TestApiV1ControlPlanesMove(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().AddProject(openstackmock.Project{
+		ID:   targetProjectID,
+		Name: targetProjectName,
+	})
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	target := mustCreateProjectFixture(t, tc, targetProjectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateArgoCDApplicationFixture(t, tc, controlPlane.Status.Namespace, "foo", "cilium", "Healthy")
+
+	tc.Openstack().AddApplicationCredential(openstackmock.ApplicationCredential{
+		ID:          "moved",
+		Name:        "foo-foo-01234567",
+		Description: "Automatically generated by platform service [DO NOT DELETE]. cluster=" + project.Name + "/" + controlPlane.Name + "/foo",
+	})
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := generated.ControlPlaneMove{
+		Project: targetProjectID,
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameMove(context.TODO(), controlPlane.Name, request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var source unikornv1.ControlPlane
+
+	err = tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: controlPlane.Name}, &source)
+	assert.True(t, kerrors.IsNotFound(err))
+
+	var moved unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: target.Status.Namespace, Name: controlPlane.Name}, &moved))
+	assert.Equal(t, target.Name, moved.Labels[constants.ProjectLabel])
+	assert.False(t, moved.Spec.Pause)
+	assert.NotNil(t, moved.Spec.ApplicationBundle)
+	assert.Equal(t, *controlPlane.Spec.ApplicationBundle, *moved.Spec.ApplicationBundle)
+
+	var namespace corev1.Namespace
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Name: controlPlane.Status.Namespace}, &namespace))
+	assert.Equal(t, target.Name, namespace.Labels[constants.ProjectLabel])
+
+	var cluster unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &cluster))
+	assert.Equal(t, target.Name, cluster.Labels[constants.ProjectLabel])
+	assert.False(t, cluster.Spec.Pause)
+	assert.NotContains(t, cluster.Annotations, constants.MigrationAnnotation)
+	assert.NotNil(t, cluster.Spec.Openstack.CloudConfig)
+
+	applications := &unstructured.UnstructuredList{}
+	applications.SetAPIVersion("argoproj.io/v1alpha1")
+	applications.SetKind("ApplicationList")
+
+	assert.NoError(t, tc.KubernetesClient().List(context.TODO(), applications, &client.ListOptions{Namespace: "argocd"}))
+	assert.Len(t, applications.Items, 1)
+	assert.Equal(t, target.Name, applications.Items[0].GetLabels()[constants.ProjectLabel])
+
+	// The credential for the source project should be revoked, and the cluster's
+	// replacement owned by the target.
+	var reissued int
+
+	for _, credential := range tc.Openstack().ApplicationCredentials() {
+		assert.NotEqual(t, "moved", credential.ID)
+
+		if strings.HasSuffix(credential.Description, "cluster="+target.Name+"/"+controlPlane.Name+"/foo") {
+			reissued++
+		}
+	}
+
+	assert.Equal(t, 1, reissued)
+}
+
+// TestApiV1ControlPlanesMoveForbidden tests a control plane cannot be moved into a
+// project the user isn't a member of.
+func TestApiV1ControlPlanesMoveForbidden(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateProjectFixture(t, tc, targetProjectID)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := generated.ControlPlaneMove{
+		Project: targetProjectID,
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameMove(context.TODO(), "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
+	assert.False(t, resource.Spec.Pause)
+}
+
+// TestApiV1ControlPlanesMoveConflict tests a control plane cannot be moved into a
+// project that already has one of the same name.
+func TestApiV1ControlPlanesMoveConflict(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().AddProject(openstackmock.Project{
+		ID:   targetProjectID,
+		Name: targetProjectName,
+	})
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	target := mustCreateProjectFixture(t, tc, targetProjectID)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateControlPlaneFixture(t, tc, target.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := generated.ControlPlaneMove{
+		Project: targetProjectID,
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameMove(context.TODO(), "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.Conflict)
+}
+
 // TestApiV1ControlPlanesDelete tests a control plane can be deleted.
 func TestApiV1ControlPlanesDelete(t *testing.T) {
 	t.Parallel()
@@ -914,7 +1681,7 @@ func TestApiV1ControlPlanesDelete(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
@@ -943,7 +1710,7 @@ func TestApiV1ControlPlanesDeleteNotFound(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	mustCreateProjectFixture(t, tc, projectID)
 
@@ -968,7 +1735,12 @@ func TestApiV1ControlPlanesDeleteNotFound(t *testing.T) {
 var createClusterRequest = &generated.KubernetesCluster{
 	Name: "foo",
 	ApplicationBundle: generated.ApplicationBundle{
-		Name: "foo",
+		Name: kubernetesClusterApplicationBundleName,
+	},
+	Openstack: generated.KubernetesClusterOpenStack{
+		ComputeAvailabilityZone: computeAvailabilityZoneName,
+		VolumeAvailabilityZone:  blockStorageAvailabilityZone,
+		ExternalNetworkID:       externalNetworkID,
 	},
 	Network: generated.KubernetesClusterNetwork{
 		DnsNameservers: []string{
@@ -1005,17 +1777,21 @@ func TestApiV1ClustersCreate(t *testing.T) {
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2Images(tc)
-	RegisterComputeV2FlavorsDetail(tc)
-	RegisterComputeV2ServerGroups(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, "application/json", NewJSONReader(createClusterRequest))
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusAccepted, response.StatusCode)
 
@@ -1029,889 +1805,7127 @@ func TestApiV1ClustersCreate(t *testing.T) {
 	assert.Contains(t, resource.Labels, constants.ControlPlaneLabel)
 }
 
-// TestApiV1ClustersCreateUnauthorized tests a keystone token expiring during a
-// request errors in the right way.
-// NOTE: this assumes other implicit calls such as those to images, server groups
-// and application credentials all use the same error processing.
-func TestApiV1ClustersCreateUnauthorized(t *testing.T) {
+// TestApiV1ClustersCreateUnsupportedVersion tests a cluster cannot be created with
+// a Kubernetes version that isn't supported by the application bundle.
+func TestApiV1ClustersCreateUnsupportedVersion(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2Images(tc)
-	RegisterComputeV2FlavorsDetailUnauthorized(tc)
-	RegisterComputeV2ServerGroups(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
 
+	mustCreateUnsupportedKubernetesClusterApplicationBundleFixture(t, tc)
+
+	request := *createClusterRequest
+	request.ApplicationBundle.Name = unsupportedKubernetesClusterApplicationBundleName
+
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, "application/json", NewJSONReader(createClusterRequest))
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON401)
+	assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON400)
 
-	serverErr := *response.JSON401
+	serverErr := *response.JSON400
 
-	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+	assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.Error(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
 }
 
-// TestApiV1ClustersCreateForbidden tests the use of an API that, while authenticated,
-// is not allowed by current user role assignments.  We typically see this with
-// federation where you cannot create an application credential at all.
-func TestApiV1ClustersCreateForbidden(t *testing.T) {
+// TestApiV1ClustersCreateNodeConfiguration tests that node tuning options
+// are accepted and persisted.
+func TestApiV1ClustersCreateNodeConfiguration(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterIdentityV3UserApplicationCredentialsForbidden(tc)
-	RegisterImageV2Images(tc)
-	RegisterComputeV2FlavorsDetail(tc)
-	RegisterComputeV2ServerGroups(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	maxPods := 200
+	cpu := "500m"
+	memory := "1Gi"
+	kubeProxyMode := generated.Ipvs
+
+	request := *createClusterRequest
+	request.Network.KubeProxyMode = &kubeProxyMode
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[0].NodeConfiguration = &generated.KubernetesClusterNodeConfiguration{
+		MaxPods: &maxPods,
+		SystemReserved: &generated.KubernetesClusterReservedResources{
+			Cpu:    &cpu,
+			Memory: &memory,
+		},
+	}
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, "application/json", NewJSONReader(createClusterRequest))
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusForbidden, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON403)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
 
-	serverErr := *response.JSON403
+	defer response.Body.Close()
 
-	assert.Equal(t, generated.Forbidden, serverErr.Error)
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.Network.KubeProxyMode)
+	assert.Equal(t, unikornv1.KubeProxyModeIPVS, *resource.Spec.Network.KubeProxyMode)
+
+	nodeConfiguration := resource.Spec.WorkloadPools.Pools[0].NodeConfiguration
+	assert.NotNil(t, nodeConfiguration)
+	assert.Equal(t, maxPods, *nodeConfiguration.MaxPods)
+	assert.NotNil(t, nodeConfiguration.SystemReserved)
+	assert.Equal(t, cpu, nodeConfiguration.SystemReserved.CPU.String())
+	assert.Equal(t, memory, nodeConfiguration.SystemReserved.Memory.String())
+	assert.Nil(t, nodeConfiguration.SystemReserved.EphemeralStorage)
+	assert.Nil(t, nodeConfiguration.KubeReserved)
 }
 
-// TestApiV1ClustersCreateExisting tests creating a cluster when one exists
-// errors in the right way.
-func TestApiV1ClustersCreateExisting(t *testing.T) {
+// TestApiV1ClustersCreateNodeConfigurationInvalid tests that malformed node tuning
+// options are rejected.
+func TestApiV1ClustersCreateNodeConfigurationInvalid(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2Images(tc)
-	RegisterComputeV2FlavorsDetail(tc)
-	RegisterComputeV2ServerGroups(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
-	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	memory := "lots"
+
+	request := *createClusterRequest
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[0].NodeConfiguration = &generated.KubernetesClusterNodeConfiguration{
+		KubeReserved: &generated.KubernetesClusterReservedResources{
+			Memory: &memory,
+		},
+	}
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, "application/json", NewJSONReader(createClusterRequest))
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusConflict, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON409)
+	assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON400)
 
-	serverErr := *response.JSON409
+	serverErr := *response.JSON400
 
-	assert.Equal(t, serverErr.Error, generated.Conflict)
+	assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.Error(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
 }
 
-// TestApiV1ClustersCreateImplicitControlPlane tests that a cluster can be created
-// in a control plane that doesn't exist yet.
-func TestApiV1ClustersCreateImplicitControlPlane(t *testing.T) {
+// TestApiV1ClustersCreateTaints tests workload pool taints are persisted in the
+// cluster resource and reported by the API.
+func TestApiV1ClustersCreateTaints(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2Images(tc)
-	RegisterComputeV2FlavorsDetail(tc)
-	RegisterComputeV2ServerGroups(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	request := *createClusterRequest
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[0].Taints = &generated.KubernetesClusterTaints{
+		{
+			Key:    "nvidia.com/gpu",
+			Value:  util.ToPointer("present"),
+			Effect: generated.NoSchedule,
+		},
+		{
+			Key:    "dedicated",
+			Effect: generated.NoExecute,
+		},
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+
+	expected := []unikornv1.Taint{
+		{
+			Key:    "nvidia.com/gpu",
+			Value:  "present",
+			Effect: unikornv1.TaintEffectNoSchedule,
+		},
+		{
+			Key:    "dedicated",
+			Effect: unikornv1.TaintEffectNoExecute,
+		},
+	}
+
+	assert.Equal(t, expected, resource.Spec.WorkloadPools.Pools[0].Taints)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	cluster := *getResponse.JSON200
+
+	assert.Len(t, cluster.WorkloadPools, 1)
+	assert.Equal(t, request.WorkloadPools[0].Taints, cluster.WorkloadPools[0].Taints)
+}
+
+// TestApiV1ClustersCreateTaintsInvalid tests that malformed taints are rejected.
+func TestApiV1ClustersCreateTaintsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		taints generated.KubernetesClusterTaints
+	}{
+		{
+			name: "InvalidKey",
+			taints: generated.KubernetesClusterTaints{
+				{
+					Key:    "not a key",
+					Effect: generated.NoSchedule,
+				},
+			},
+		},
+		{
+			name: "InvalidValue",
+			taints: generated.KubernetesClusterTaints{
+				{
+					Key:    "dedicated",
+					Value:  util.ToPointer("not a value"),
+					Effect: generated.NoSchedule,
+				},
+			},
+		},
+		{
+			name: "Duplicate",
+			taints: generated.KubernetesClusterTaints{
+				{
+					Key:    "dedicated",
+					Value:  util.ToPointer("foo"),
+					Effect: generated.NoSchedule,
+				},
+				{
+					Key:    "dedicated",
+					Value:  util.ToPointer("bar"),
+					Effect: generated.NoSchedule,
+				},
+			},
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc, cleanup := MustNewTestContext(t)
+			defer cleanup()
+
+			tc.Openstack().RegisterIdentityHandlers()
+			tc.Openstack().RegisterImageV2Images()
+			tc.Openstack().RegisterComputeV2FlavorsDetail()
+			tc.Openstack().RegisterComputeV2ServerGroups()
+			tc.Openstack().RegisterComputeV2AvailabilityZone()
+			tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+			tc.Openstack().RegisterQuotaHandlers()
+
+			project := mustCreateProjectFixture(t, tc, projectID)
+			controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+			mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+			request := *createClusterRequest
+			request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+				createClusterRequest.WorkloadPools[0],
+			}
+			request.WorkloadPools[0].Taints = &test.taints
+
+			unikornClient := MustNewScopedClient(t, tc)
+
+			response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+			assert.NotNil(t, response.JSON400)
+
+			serverErr := *response.JSON400
+
+			assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+		})
+	}
+}
+
+// TestApiV1ClustersCreateKubeadmCommands tests workload pool kubeadm commands
+// are persisted in the cluster resource and reported by the API.
+func TestApiV1ClustersCreateKubeadmCommands(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	preKubeadmCommands := generated.KubernetesClusterKubeadmCommands{
+		"sysctl -w vm.max_map_count=262144",
+	}
+
+	postKubeadmCommands := generated.KubernetesClusterKubeadmCommands{
+		"echo joined > /var/log/joined",
+	}
+
+	request := *createClusterRequest
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[0].PreKubeadmCommands = &preKubeadmCommands
+	request.WorkloadPools[0].PostKubeadmCommands = &postKubeadmCommands
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Equal(t, preKubeadmCommands, resource.Spec.WorkloadPools.Pools[0].PreKubeadmCommands)
+	assert.Equal(t, postKubeadmCommands, resource.Spec.WorkloadPools.Pools[0].PostKubeadmCommands)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	cluster := *getResponse.JSON200
+
+	assert.Len(t, cluster.WorkloadPools, 1)
+	assert.Equal(t, &preKubeadmCommands, cluster.WorkloadPools[0].PreKubeadmCommands)
+	assert.Equal(t, &postKubeadmCommands, cluster.WorkloadPools[0].PostKubeadmCommands)
+}
+
+// TestApiV1ClustersCreateKubeadmCommandsInvalid tests that empty or oversized
+// kubeadm commands are rejected.
+func TestApiV1ClustersCreateKubeadmCommandsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		commands generated.KubernetesClusterKubeadmCommands
+	}{
+		{
+			name: "Blank",
+			commands: generated.KubernetesClusterKubeadmCommands{
+				"  ",
+			},
+		},
+		{
+			name: "TooLarge",
+			commands: generated.KubernetesClusterKubeadmCommands{
+				"echo " + strings.Repeat("a", 16*1024),
+			},
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc, cleanup := MustNewTestContext(t)
+			defer cleanup()
+
+			tc.Openstack().RegisterIdentityHandlers()
+			tc.Openstack().RegisterImageV2Images()
+			tc.Openstack().RegisterComputeV2FlavorsDetail()
+			tc.Openstack().RegisterComputeV2ServerGroups()
+			tc.Openstack().RegisterComputeV2AvailabilityZone()
+			tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+			tc.Openstack().RegisterQuotaHandlers()
+
+			project := mustCreateProjectFixture(t, tc, projectID)
+			controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+			mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+			request := *createClusterRequest
+			request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+				createClusterRequest.WorkloadPools[0],
+			}
+			request.WorkloadPools[0].PreKubeadmCommands = &test.commands
+
+			unikornClient := MustNewScopedClient(t, tc)
+
+			response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+			assert.NotNil(t, response.JSON400)
+
+			serverErr := *response.JSON400
+
+			assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+		})
+	}
+}
+
+// TestApiV1ClustersCreatePrePullImages tests workload pool pre-pull images are
+// persisted in the cluster resource and reported by the API.
+func TestApiV1ClustersCreatePrePullImages(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	prePullImages := []string{
+		"nvcr.io/nvidia/pytorch:24.01-py3",
+		"localhost:5000/models/llama@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		"busybox",
+	}
+
+	request := *createClusterRequest
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[0].PrePullImages = &prePullImages
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Equal(t, prePullImages, resource.Spec.WorkloadPools.Pools[0].PrePullImages)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	cluster := *getResponse.JSON200
+
+	assert.Len(t, cluster.WorkloadPools, 1)
+	assert.Equal(t, &prePullImages, cluster.WorkloadPools[0].PrePullImages)
+}
+
+// TestApiV1ClustersCreateWarmMachines tests workload pool warm machines are
+// persisted in the cluster resource, along with the scheduler hints required to
+// size placeholders, and reported by the API.
+func TestApiV1ClustersCreateWarmMachines(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	autoscaling := true
+	warmMachines := 2
+
+	request := *createClusterRequest
+	request.Features = &generated.KubernetesClusterFeatures{
+		Autoscaling: &autoscaling,
+	}
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[0].Autoscaling = &generated.KubernetesClusterAutoscaling{
+		MinimumReplicas: 1,
+		MaximumReplicas: 5,
+		WarmMachines:    &warmMachines,
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.True(t, resource.WarmPoolsEnabled())
+
+	pool := resource.Spec.WorkloadPools.Pools[0]
+	assert.Equal(t, warmMachines, pool.WarmMachines())
+	assert.NotNil(t, pool.Autoscaling.Scheduler)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	cluster := *getResponse.JSON200
+
+	assert.Len(t, cluster.WorkloadPools, 1)
+	assert.NotNil(t, cluster.WorkloadPools[0].Autoscaling)
+	assert.Equal(t, &warmMachines, cluster.WorkloadPools[0].Autoscaling.WarmMachines)
+}
+
+// TestApiV1ClustersCreatePrePullImagesInvalid tests that malformed image references
+// are rejected, in particular those that could be used for shell injection.
+func TestApiV1ClustersCreatePrePullImagesInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		image string
+	}{
+		{
+			name:  "Empty",
+			image: "",
+		},
+		{
+			name:  "UpperCase",
+			image: "Library/BusyBox",
+		},
+		{
+			name:  "ShellInjection",
+			image: "busybox; rm -rf /",
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc, cleanup := MustNewTestContext(t)
+			defer cleanup()
+
+			tc.Openstack().RegisterIdentityHandlers()
+			tc.Openstack().RegisterImageV2Images()
+			tc.Openstack().RegisterComputeV2FlavorsDetail()
+			tc.Openstack().RegisterComputeV2ServerGroups()
+			tc.Openstack().RegisterComputeV2AvailabilityZone()
+			tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+			tc.Openstack().RegisterQuotaHandlers()
+
+			project := mustCreateProjectFixture(t, tc, projectID)
+			controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+			mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+			request := *createClusterRequest
+			request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+				createClusterRequest.WorkloadPools[0],
+			}
+			request.WorkloadPools[0].PrePullImages = &[]string{
+				test.image,
+			}
+
+			unikornClient := MustNewScopedClient(t, tc)
+
+			response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+			assert.NotNil(t, response.JSON400)
+
+			serverErr := *response.JSON400
+
+			assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+		})
+	}
+}
+
+// TestApiV1ClustersCreateNetworkPlugin tests the network plugin is persisted in
+// the cluster resource.
+func TestApiV1ClustersCreateNetworkPlugin(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	plugin := generated.Calico
+
+	request := *createClusterRequest
+	request.Network.Plugin = &plugin
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.Network.Plugin)
+	assert.Equal(t, unikornv1.NetworkPluginCalico, *resource.Spec.Network.Plugin)
+	assert.True(t, resource.CalicoEnabled())
+	assert.False(t, resource.CiliumEnabled())
+}
+
+// TestApiV1ClustersCreateNetworkPluginInvalid tests network prefixes that are
+// incompatible with the requested network plugin are rejected.
+func TestApiV1ClustersCreateNetworkPluginInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		plugin        generated.KubernetesClusterNetworkPlugin
+		servicePrefix string
+		podPrefix     string
+	}{
+		{
+			name:          "CalicoPodPrefixTooSmall",
+			plugin:        generated.Calico,
+			servicePrefix: "172.16.0.0/12",
+			podPrefix:     "10.0.0.0/27",
+		},
+		{
+			name:          "CiliumPodPrefixTooSmall",
+			plugin:        generated.Cilium,
+			servicePrefix: "172.16.0.0/12",
+			podPrefix:     "10.0.0.0/25",
+		},
+		{
+			name:          "Overlapping",
+			plugin:        generated.None,
+			servicePrefix: "10.96.0.0/12",
+			podPrefix:     "10.0.0.0/8",
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc, cleanup := MustNewTestContext(t)
+			defer cleanup()
+
+			tc.Openstack().RegisterIdentityHandlers()
+			tc.Openstack().RegisterImageV2Images()
+			tc.Openstack().RegisterComputeV2FlavorsDetail()
+			tc.Openstack().RegisterComputeV2ServerGroups()
+			tc.Openstack().RegisterComputeV2AvailabilityZone()
+			tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+			tc.Openstack().RegisterQuotaHandlers()
+
+			project := mustCreateProjectFixture(t, tc, projectID)
+			controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+			mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+			request := *createClusterRequest
+			request.Network.Plugin = &test.plugin
+			request.Network.ServicePrefix = test.servicePrefix
+			request.Network.PodPrefix = test.podPrefix
+
+			unikornClient := MustNewScopedClient(t, tc)
+
+			response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+			assert.NotNil(t, response.JSON400)
+
+			serverErr := *response.JSON400
+
+			assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+		})
+	}
+}
+
+// TestApiV1ClustersCreateDualStack tests secondary service and pod prefixes
+// are persisted in the cluster resource and reported by the API.
+func TestApiV1ClustersCreateDualStack(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	secondaryServicePrefix := "fd00:2::/108"
+	secondaryPodPrefix := "fd00:3::/64"
+
+	request := *createClusterRequest
+	request.Network.SecondaryServicePrefix = &secondaryServicePrefix
+	request.Network.SecondaryPodPrefix = &secondaryPodPrefix
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.Network.SecondaryServiceNetwork)
+	assert.Equal(t, secondaryServicePrefix, resource.Spec.Network.SecondaryServiceNetwork.IPNet.String())
+	assert.NotNil(t, resource.Spec.Network.SecondaryPodNetwork)
+	assert.Equal(t, secondaryPodPrefix, resource.Spec.Network.SecondaryPodNetwork.IPNet.String())
+	assert.True(t, resource.DualStack())
+	assert.True(t, resource.IPv4Enabled())
+	assert.True(t, resource.IPv6Enabled())
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	cluster := *getResponse.JSON200
+
+	assert.NotNil(t, cluster.Network.SecondaryServicePrefix)
+	assert.Equal(t, secondaryServicePrefix, *cluster.Network.SecondaryServicePrefix)
+	assert.NotNil(t, cluster.Network.SecondaryPodPrefix)
+	assert.Equal(t, secondaryPodPrefix, *cluster.Network.SecondaryPodPrefix)
+}
+
+// TestApiV1ClustersCreateIPv6 tests single-stack IPv6 clusters can be created.
+func TestApiV1ClustersCreateIPv6(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	request := *createClusterRequest
+	request.Network.NodePrefix = "fd00:1::/64"
+	request.Network.ServicePrefix = "fd00:2::/108"
+	request.Network.PodPrefix = "fd00:3::/64"
+	request.Network.DnsNameservers = []string{"2001:4860:4860::8888"}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Equal(t, "fd00:3::/64", resource.Spec.Network.PodNetwork.IPNet.String())
+	assert.Len(t, resource.Spec.Network.DNSNameservers, 1)
+	assert.Equal(t, "2001:4860:4860::8888", resource.Spec.Network.DNSNameservers[0].IP.String())
+	assert.False(t, resource.DualStack())
+	assert.False(t, resource.IPv4Enabled())
+	assert.True(t, resource.IPv6Enabled())
+}
+
+// TestApiV1ClustersCreateDualStackInvalid tests invalid dual-stack network
+// configurations are rejected.
+func TestApiV1ClustersCreateDualStackInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                   string
+		servicePrefix          string
+		secondaryServicePrefix *string
+		secondaryPodPrefix     *string
+	}{
+		{
+			name:                   "SameAddressFamily",
+			servicePrefix:          "172.16.0.0/12",
+			secondaryServicePrefix: util.ToPointer("192.168.128.0/20"),
+			secondaryPodPrefix:     util.ToPointer("100.64.0.0/10"),
+		},
+		{
+			name:                   "MissingSecondaryServicePrefix",
+			servicePrefix:          "172.16.0.0/12",
+			secondaryServicePrefix: nil,
+			secondaryPodPrefix:     util.ToPointer("fd00:3::/64"),
+		},
+		{
+			name:                   "MixedSecondaryAddressFamilies",
+			servicePrefix:          "172.16.0.0/12",
+			secondaryServicePrefix: util.ToPointer("100.64.0.0/10"),
+			secondaryPodPrefix:     util.ToPointer("fd00:3::/64"),
+		},
+		{
+			name:          "MixedPrimaryAddressFamilies",
+			servicePrefix: "fd00:2::/108",
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc, cleanup := MustNewTestContext(t)
+			defer cleanup()
+
+			tc.Openstack().RegisterIdentityHandlers()
+			tc.Openstack().RegisterImageV2Images()
+			tc.Openstack().RegisterComputeV2FlavorsDetail()
+			tc.Openstack().RegisterComputeV2ServerGroups()
+			tc.Openstack().RegisterComputeV2AvailabilityZone()
+			tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+			tc.Openstack().RegisterQuotaHandlers()
+
+			project := mustCreateProjectFixture(t, tc, projectID)
+			controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+			mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+			request := *createClusterRequest
+			request.Network.ServicePrefix = test.servicePrefix
+			request.Network.SecondaryServicePrefix = test.secondaryServicePrefix
+			request.Network.SecondaryPodPrefix = test.secondaryPodPrefix
+
+			unikornClient := MustNewScopedClient(t, tc)
+
+			response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+			assert.NotNil(t, response.JSON400)
+
+			serverErr := *response.JSON400
+
+			assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+		})
+	}
+}
+
+// TestApiV1ClustersCreatePrivate tests private clusters are persisted in the
+// cluster resource and reported by the API.
+func TestApiV1ClustersCreatePrivate(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	private := true
+
+	request := *createClusterRequest
+	request.Api = &generated.KubernetesClusterAPI{
+		Private: &private,
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.True(t, resource.PrivateAPIEnabled())
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	cluster := *getResponse.JSON200
+
+	assert.NotNil(t, cluster.Api)
+	assert.NotNil(t, cluster.Api.Private)
+	assert.True(t, *cluster.Api.Private)
+}
+
+// TestApiV1ClustersCreatePrivateAllowedPrefixes tests private clusters cannot
+// also have an API allow list, as there is no public address to apply it to.
+func TestApiV1ClustersCreatePrivateAllowedPrefixes(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	private := true
+
+	request := *createClusterRequest
+	request.Api = &generated.KubernetesClusterAPI{
+		AllowedPrefixes: &[]string{
+			"192.168.0.0/16",
+		},
+		Private: &private,
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON400)
+
+	serverErr := *response.JSON400
+
+	assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.Error(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+}
+
+// TestApiV1ClustersUpdatePrivate tests a cluster cannot be made private after
+// it has been created.
+func TestApiV1ClustersUpdatePrivate(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	private := true
+
+	request := *createClusterRequest
+	request.Api = &generated.KubernetesClusterAPI{
+		Private: &private,
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBodyWithResponse(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON400)
+
+	serverErr := *response.JSON400
+
+	assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+	assert.Equal(t, "private api cannot be changed", serverErr.ErrorDescription)
+}
+
+// TestApiV1ClustersCreateAutoscalingConfiguration tests autoscaler tuning is
+// persisted in the cluster resource and reported by the API.
+func TestApiV1ClustersCreateAutoscalingConfiguration(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	autoscaling := true
+	scaleDownDelayAfterAdd := "30s"
+	threshold := 30
+	expander := generated.LeastWaste
+
+	request := *createClusterRequest
+	request.Features = &generated.KubernetesClusterFeatures{
+		Autoscaling: &autoscaling,
+		AutoscalingConfiguration: &generated.KubernetesClusterAutoscalingConfiguration{
+			ScaleDownDelayAfterAdd:        &scaleDownDelayAfterAdd,
+			ScaleDownUtilizationThreshold: &threshold,
+			Expander:                      &expander,
+		},
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.Features)
+
+	config := resource.Spec.Features.AutoscalingConfiguration
+	assert.NotNil(t, config)
+	assert.NotNil(t, config.ScaleDownDelayAfterAdd)
+	assert.Equal(t, 30*time.Second, config.ScaleDownDelayAfterAdd.Duration)
+	assert.Nil(t, config.ScaleDownUnneededTime)
+	assert.Equal(t, threshold, *config.ScaleDownUtilizationThreshold)
+	assert.Equal(t, unikornv1.ClusterAutoscalerExpanderLeastWaste, *config.Expander)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	result := *getResponse.JSON200
+
+	assert.NotNil(t, result.Features)
+	assert.NotNil(t, result.Features.AutoscalingConfiguration)
+	assert.Equal(t, "30s", *result.Features.AutoscalingConfiguration.ScaleDownDelayAfterAdd)
+	assert.Equal(t, expander, *result.Features.AutoscalingConfiguration.Expander)
+}
+
+// TestApiV1ClustersCreateAutoscalingConfigurationInvalid tests autoscaler tuning
+// is rejected when malformed, or autoscaling is not enabled.
+func TestApiV1ClustersCreateAutoscalingConfigurationInvalid(t *testing.T) {
+	t.Parallel()
+
+	autoscaling := true
+	scaleDownUnneededTime := "soon"
+
+	malformed := &generated.KubernetesClusterFeatures{
+		Autoscaling: &autoscaling,
+		AutoscalingConfiguration: &generated.KubernetesClusterAutoscalingConfiguration{
+			ScaleDownUnneededTime: &scaleDownUnneededTime,
+		},
+	}
+
+	threshold := 50
+
+	disabled := &generated.KubernetesClusterFeatures{
+		AutoscalingConfiguration: &generated.KubernetesClusterAutoscalingConfiguration{
+			ScaleDownUtilizationThreshold: &threshold,
+		},
+	}
+
+	for _, features := range []*generated.KubernetesClusterFeatures{malformed, disabled} {
+		tc, cleanup := MustNewTestContext(t)
+		defer cleanup()
+
+		tc.Openstack().RegisterIdentityHandlers()
+		tc.Openstack().RegisterImageV2Images()
+		tc.Openstack().RegisterComputeV2FlavorsDetail()
+		tc.Openstack().RegisterComputeV2ServerGroups()
+		tc.Openstack().RegisterComputeV2AvailabilityZone()
+		tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+		tc.Openstack().RegisterQuotaHandlers()
+
+		project := mustCreateProjectFixture(t, tc, projectID)
+		controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+		mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+		request := *createClusterRequest
+		request.Features = features
+
+		unikornClient := MustNewScopedClient(t, tc)
+
+		response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+		assert.NotNil(t, response.JSON400)
+
+		serverErr := *response.JSON400
+
+		assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+	}
+}
+
+// TestApiV1ClustersCreateBackupConfiguration tests the backup schedule is
+// persisted in the cluster resource and reported by the API.
+func TestApiV1ClustersCreateBackupConfiguration(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	backup := true
+	schedule := "0 3 * * 0"
+	retention := "168h"
+
+	request := *createClusterRequest
+	request.Features = &generated.KubernetesClusterFeatures{
+		Backup: &backup,
+		BackupConfiguration: &generated.KubernetesClusterBackupConfiguration{
+			Schedule:  &schedule,
+			Retention: &retention,
+		},
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.True(t, resource.BackupEnabled())
+
+	config := resource.Spec.Features.BackupConfiguration
+	assert.NotNil(t, config)
+	assert.Equal(t, schedule, *config.Schedule)
+	assert.NotNil(t, config.Retention)
+	assert.Equal(t, 168*time.Hour, config.Retention.Duration)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	result := *getResponse.JSON200
+
+	assert.NotNil(t, result.Features)
+	assert.NotNil(t, result.Features.Backup)
+	assert.True(t, *result.Features.Backup)
+	assert.NotNil(t, result.Features.BackupConfiguration)
+	assert.Equal(t, schedule, *result.Features.BackupConfiguration.Schedule)
+	assert.Equal(t, "168h0m0s", *result.Features.BackupConfiguration.Retention)
+}
+
+// TestApiV1ClustersCreateBackupConfigurationInvalid tests the backup schedule
+// is rejected when malformed, or backup is not enabled.
+func TestApiV1ClustersCreateBackupConfigurationInvalid(t *testing.T) {
+	t.Parallel()
+
+	backup := true
+	schedule := "daily"
+
+	malformed := &generated.KubernetesClusterFeatures{
+		Backup: &backup,
+		BackupConfiguration: &generated.KubernetesClusterBackupConfiguration{
+			Schedule: &schedule,
+		},
+	}
+
+	retention := "24h"
+
+	disabled := &generated.KubernetesClusterFeatures{
+		BackupConfiguration: &generated.KubernetesClusterBackupConfiguration{
+			Retention: &retention,
+		},
+	}
+
+	for _, features := range []*generated.KubernetesClusterFeatures{malformed, disabled} {
+		tc, cleanup := MustNewTestContext(t)
+		defer cleanup()
+
+		tc.Openstack().RegisterIdentityHandlers()
+		tc.Openstack().RegisterImageV2Images()
+		tc.Openstack().RegisterComputeV2FlavorsDetail()
+		tc.Openstack().RegisterComputeV2ServerGroups()
+		tc.Openstack().RegisterComputeV2AvailabilityZone()
+		tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+		tc.Openstack().RegisterQuotaHandlers()
+
+		project := mustCreateProjectFixture(t, tc, projectID)
+		controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+		mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+		request := *createClusterRequest
+		request.Features = features
+
+		unikornClient := MustNewScopedClient(t, tc)
+
+		response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+		assert.NotNil(t, response.JSON400)
+
+		serverErr := *response.JSON400
+
+		assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+	}
+}
+
+// TestApiV1ClustersCreateMetricsFederation tests metrics federation is persisted
+// in the cluster resource and reported by the API.
+func TestApiV1ClustersCreateMetricsFederation(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	enabled := true
+
+	request := *createClusterRequest
+	request.Features = &generated.KubernetesClusterFeatures{
+		Prometheus:        &enabled,
+		MetricsFederation: &enabled,
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.True(t, resource.MetricsFederationEnabled())
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	result := *getResponse.JSON200
+
+	assert.NotNil(t, result.Features)
+	assert.NotNil(t, result.Features.MetricsFederation)
+	assert.True(t, *result.Features.MetricsFederation)
+}
+
+// TestApiV1ClustersCreateMetricsFederationInvalid tests metrics federation is
+// rejected when Prometheus is not enabled.
+func TestApiV1ClustersCreateMetricsFederationInvalid(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	enabled := true
+
+	request := *createClusterRequest
+	request.Features = &generated.KubernetesClusterFeatures{
+		MetricsFederation: &enabled,
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON400)
+
+	serverErr := *response.JSON400
+
+	assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+}
+
+// TestApiV1ClustersCreateCostAllocation tests the cost allocation feature is
+// persisted and reported.
+func TestApiV1ClustersCreateCostAllocation(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	enabled := true
+
+	request := *createClusterRequest
+	request.Features = &generated.KubernetesClusterFeatures{
+		CostAllocation: &enabled,
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.True(t, resource.CostAllocationEnabled())
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	result := *getResponse.JSON200
+
+	assert.NotNil(t, result.Features)
+	assert.NotNil(t, result.Features.CostAllocation)
+	assert.True(t, *result.Features.CostAllocation)
+}
+
+// findServerGroup looks up a mock server group by ID.
+func findServerGroup(t *testing.T, tc *TestContext, id string) openstackmock.ServerGroup {
+	t.Helper()
+
+	for _, serverGroup := range tc.Openstack().ServerGroups() {
+		if serverGroup.ID == id {
+			return serverGroup
+		}
+	}
+
+	t.Fatalf("server group %s not found", id)
+
+	return openstackmock.ServerGroup{}
+}
+
+// TestApiV1ClustersCreateServerGroupPolicy tests the control plane and each workload
+// pool get their own server group with the requested policy.
+func TestApiV1ClustersCreateServerGroupPolicy(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	antiAffinity := generated.AntiAffinity
+	affinity := generated.Affinity
+
+	request := *createClusterRequest
+	request.ControlPlane.ServerGroupPolicy = &antiAffinity
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[1].Name = "bar"
+	request.WorkloadPools[1].Machine.ServerGroupPolicy = &affinity
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.ControlPlane.ServerGroupID)
+	assert.Len(t, resource.Spec.WorkloadPools.Pools, 2)
+
+	controlPlaneServerGroup := findServerGroup(t, tc, *resource.Spec.ControlPlane.ServerGroupID)
+	assert.Equal(t, "anti-affinity", controlPlaneServerGroup.Policy)
+
+	pools := resource.Spec.WorkloadPools.Pools
+
+	assert.NotNil(t, pools[0].ServerGroupID)
+	assert.NotNil(t, pools[1].ServerGroupID)
+	assert.NotEqual(t, *pools[0].ServerGroupID, *pools[1].ServerGroupID)
+	assert.NotEqual(t, *resource.Spec.ControlPlane.ServerGroupID, *pools[0].ServerGroupID)
+	assert.Equal(t, "affinity", findServerGroup(t, tc, *pools[1].ServerGroupID).Policy)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+
+	result := *getResponse.JSON200
+
+	assert.Equal(t, &antiAffinity, result.ControlPlane.ServerGroupPolicy)
+	assert.Nil(t, result.WorkloadPools[0].Machine.ServerGroupPolicy)
+	assert.Equal(t, &affinity, result.WorkloadPools[1].Machine.ServerGroupPolicy)
+}
+
+// TestApiV1ClustersUpdateServerGroupPolicy tests server groups are preserved while
+// the policy is unchanged, and replaced when it is.
+func TestApiV1ClustersUpdateServerGroupPolicy(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var original unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &original))
+
+	softAffinity := generated.SoftAffinity
+
+	request := *createClusterRequest
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[0].Machine.ServerGroupPolicy = &softAffinity
+
+	updateResponse, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBody(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, updateResponse.StatusCode)
+
+	defer updateResponse.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Equal(t, original.Spec.ControlPlane.ServerGroupID, resource.Spec.ControlPlane.ServerGroupID)
+	assert.NotEqual(t, original.Spec.WorkloadPools.Pools[0].ServerGroupID, resource.Spec.WorkloadPools.Pools[0].ServerGroupID)
+	assert.Equal(t, "soft-affinity", findServerGroup(t, tc, *resource.Spec.WorkloadPools.Pools[0].ServerGroupID).Policy)
+}
+
+// TestApiV1ClustersCreateWorkloadPoolSSHKey tests workload pools can override
+// the cluster SSH key, or disable it entirely.
+func TestApiV1ClustersCreateWorkloadPoolSSHKey(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+	tc.Openstack().RegisterComputeV2Keypairs()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	sshKeyName := keyPairName
+	noSSHKeyName := ""
+
+	request := *createClusterRequest
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[0].SshKeyName = &sshKeyName
+	request.WorkloadPools[1].Name = "bar"
+	request.WorkloadPools[1].SshKeyName = &noSSHKeyName
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Len(t, resource.Spec.WorkloadPools.Pools, 2)
+	assert.NotNil(t, resource.Spec.WorkloadPools.Pools[0].SSHKeyName)
+	assert.Equal(t, sshKeyName, *resource.Spec.WorkloadPools.Pools[0].SSHKeyName)
+	assert.NotNil(t, resource.Spec.WorkloadPools.Pools[1].SSHKeyName)
+	assert.Equal(t, noSSHKeyName, *resource.Spec.WorkloadPools.Pools[1].SSHKeyName)
+}
+
+// TestApiV1ClustersCreateWorkloadPoolSSHKeyInvalid tests workload pools cannot
+// reference SSH keys that don't exist.
+func TestApiV1ClustersCreateWorkloadPoolSSHKeyInvalid(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+	tc.Openstack().RegisterComputeV2Keypairs()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	sshKeyName := "missing"
+
+	request := *createClusterRequest
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[0].SshKeyName = &sshKeyName
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON400)
+
+	serverErr := *response.JSON400
+
+	assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.Error(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+}
+
+// TestApiV1ClustersCreatePreflightFailed tests a cluster that cannot be provisioned
+// is rejected up front with a list of reasons.
+func TestApiV1ClustersCreatePreflightFailed(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	request := *createClusterRequest
+	request.Openstack.ComputeAvailabilityZone = "missing"
+	request.WorkloadPools = generated.KubernetesClusterWorkloadPools{
+		createClusterRequest.WorkloadPools[0],
+	}
+	request.WorkloadPools[0].Machine.Replicas = computeQuotaCoresLimit
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON422)
+
+	serverErr := *response.JSON422
+
+	assert.Equal(t, generated.UnprocessableEntity, serverErr.Error)
+	assert.NotNil(t, serverErr.ValidationErrors)
+	// Availability zone, cores, RAM and instances.
+	assert.Len(t, *serverErr.ValidationErrors, 4)
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.Error(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+}
+
+const (
+	existingNetworkID = "f6d2c3bb-0d0b-4a5e-9a3f-3b8f1c0d2e4a"
+	existingSubnetID  = "0c4e1f8a-5b6d-4e2f-8a7b-9c1d2e3f4a5b"
+)
+
+// TestApiV1ClustersCreateExistingNetwork tests a cluster can be provisioned on an
+// existing network, and that the network cannot be changed afterwards.
+func TestApiV1ClustersCreateExistingNetwork(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterNetworkV2Subnet()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	tc.Openstack().AddSubnet(openstackmock.Subnet{
+		ID:        existingSubnetID,
+		NetworkID: existingNetworkID,
+		CIDR:      createClusterRequest.Network.NodePrefix,
+	})
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	request := *createClusterRequest
+	request.Openstack.Network = &generated.KubernetesClusterOpenStackNetwork{
+		NetworkID: existingNetworkID,
+		SubnetID:  existingSubnetID,
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.Openstack.Network)
+	assert.Equal(t, existingNetworkID, *resource.Spec.Openstack.Network.NetworkID)
+	assert.Equal(t, existingSubnetID, *resource.Spec.Openstack.Network.SubnetID)
+	assert.Nil(t, resource.Spec.Openstack.Network.RouterID)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.Equal(t, request.Openstack.Network, getResponse.JSON200.Openstack.Network)
+
+	updateResponse, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBody(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, updateResponse.StatusCode)
+
+	defer updateResponse.Body.Close()
+
+	AssertOauth2Error(t, updateResponse, generated.InvalidRequest)
+}
+
+// TestApiV1ClustersCreateExistingNetworkInvalid tests existing networks that cannot
+// be used by the cluster are rejected up front.
+func TestApiV1ClustersCreateExistingNetworkInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		networkID  string
+		subnetID   string
+		subnetCIDR string
+		errors     int
+	}{
+		{
+			name:       "MissingSubnet",
+			networkID:  existingNetworkID,
+			subnetID:   "missing",
+			subnetCIDR: createClusterRequest.Network.NodePrefix,
+			errors:     1,
+		},
+		{
+			name:       "WrongNetwork",
+			networkID:  "wrong",
+			subnetID:   existingSubnetID,
+			subnetCIDR: createClusterRequest.Network.NodePrefix,
+			errors:     1,
+		},
+		{
+			name:       "NodePrefixMismatch",
+			networkID:  existingNetworkID,
+			subnetID:   existingSubnetID,
+			subnetCIDR: "192.168.1.0/24",
+			errors:     1,
+		},
+		{
+			name:       "PodPrefixOverlap",
+			networkID:  existingNetworkID,
+			subnetID:   existingSubnetID,
+			subnetCIDR: "10.0.0.0/24",
+			errors:     2,
+		},
+	}
+
+	for i := range tests {
+		test := &tests[i]
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc, cleanup := MustNewTestContext(t)
+			defer cleanup()
+
+			tc.Openstack().RegisterIdentityHandlers()
+			tc.Openstack().RegisterImageV2Images()
+			tc.Openstack().RegisterComputeV2FlavorsDetail()
+			tc.Openstack().RegisterComputeV2ServerGroups()
+			tc.Openstack().RegisterComputeV2AvailabilityZone()
+			tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+			tc.Openstack().RegisterNetworkV2Subnet()
+			tc.Openstack().RegisterQuotaHandlers()
+
+			tc.Openstack().AddSubnet(openstackmock.Subnet{
+				ID:        existingSubnetID,
+				NetworkID: existingNetworkID,
+				CIDR:      test.subnetCIDR,
+			})
+
+			project := mustCreateProjectFixture(t, tc, projectID)
+			controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+			mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+			request := *createClusterRequest
+			request.Openstack.Network = &generated.KubernetesClusterOpenStackNetwork{
+				NetworkID: test.networkID,
+				SubnetID:  test.subnetID,
+			}
+
+			unikornClient := MustNewScopedClient(t, tc)
+
+			response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusUnprocessableEntity, response.HTTPResponse.StatusCode)
+			assert.NotNil(t, response.JSON422)
+
+			serverErr := *response.JSON422
+
+			assert.Equal(t, generated.UnprocessableEntity, serverErr.Error)
+			assert.NotNil(t, serverErr.ValidationErrors)
+			assert.Len(t, *serverErr.ValidationErrors, test.errors)
+
+			var resource unikornv1.KubernetesCluster
+
+			assert.Error(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+		})
+	}
+}
+
+// TestApiV1ClustersCreateLoadBalancer tests load balancer providers and flavors
+// are persisted and reported, and that the API load balancer cannot be changed.
+func TestApiV1ClustersCreateLoadBalancer(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	amphora := generated.Amphora
+	ovn := generated.Ovn
+	flavorID := "e1c3cd3f-a61c-4e3a-9c4b-3d0e2b4f8a51"
+
+	request := *createClusterRequest
+	request.Api = &generated.KubernetesClusterAPI{
+		LoadBalancer: &generated.OpenstackLoadBalancer{
+			Provider: &ovn,
+		},
+	}
+	request.Openstack.LoadBalancer = &generated.OpenstackLoadBalancer{
+		Provider: &amphora,
+		FlavorID: &flavorID,
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.APILoadBalancer())
+	assert.Equal(t, unikornv1.LoadBalancerProviderOVN, *resource.APILoadBalancer().Provider)
+	assert.Nil(t, resource.APILoadBalancer().FlavorID)
+	assert.NotNil(t, resource.Spec.Openstack.LoadBalancer)
+	assert.Equal(t, unikornv1.LoadBalancerProviderAmphora, *resource.Spec.Openstack.LoadBalancer.Provider)
+	assert.Equal(t, flavorID, *resource.Spec.Openstack.LoadBalancer.FlavorID)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.NotNil(t, getResponse.JSON200.Api)
+	assert.Equal(t, request.Api.LoadBalancer, getResponse.JSON200.Api.LoadBalancer)
+	assert.Equal(t, request.Openstack.LoadBalancer, getResponse.JSON200.Openstack.LoadBalancer)
+
+	// The service load balancer default only affects new services.
+	update := request
+	update.Openstack.LoadBalancer = &generated.OpenstackLoadBalancer{
+		Provider: &ovn,
+	}
+
+	updateResponse, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBody(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(&update))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, updateResponse.StatusCode)
+
+	defer updateResponse.Body.Close()
+
+	update.Api = &generated.KubernetesClusterAPI{
+		LoadBalancer: &generated.OpenstackLoadBalancer{
+			Provider: &amphora,
+		},
+	}
+
+	invalidResponse, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBody(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(&update))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, invalidResponse.StatusCode)
+
+	defer invalidResponse.Body.Close()
+
+	AssertOauth2Error(t, invalidResponse, generated.InvalidRequest)
+}
+
+// TestApiV1ClustersCreateUnauthorized tests a keystone token expiring during a
+// request errors in the right way.
+// NOTE: this assumes other implicit calls such as those to images, server groups
+// and application credentials all use the same error processing.
+func TestApiV1ClustersCreateUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().Fail(openstackmock.ComputeV2FlavorsDetail, http.StatusUnauthorized)
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON401)
+
+	serverErr := *response.JSON401
+
+	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+}
+
+// TestApiV1ClustersCreateForbidden tests the use of an API that, while authenticated,
+// is not allowed by current user role assignments.  We typically see this with
+// federation where you cannot create an application credential at all.
+func TestApiV1ClustersCreateForbidden(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterIdentityV3UserApplicationCredentials()
+	tc.Openstack().Fail(openstackmock.IdentityV3UserApplicationCredentialCreate, http.StatusForbidden)
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON403)
+
+	serverErr := *response.JSON403
+
+	assert.Equal(t, generated.Forbidden, serverErr.Error)
+}
+
+// TestApiV1ClustersCreateMissingRoles tests users are told which roles they are
+// missing when they are unable to delegate them to an application credential.
+func TestApiV1ClustersCreateMissingRoles(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackNoLoadBalancerRoleFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	credentials := tc.Openstack().ApplicationCredentials()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON403)
+
+	serverErr := *response.JSON403
+
+	assert.Equal(t, generated.Forbidden, serverErr.Error)
+	assert.Contains(t, serverErr.ErrorDescription, loadBalancerRole)
+	assert.Equal(t, credentials, tc.Openstack().ApplicationCredentials())
+}
+
+// TestApiV1ClustersCreateServerGroupConflict tests provider conflicts are surfaced
+// as such with a hint as to how to resolve them.
+func TestApiV1ClustersCreateServerGroupConflict(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().Fail(openstackmock.ComputeV2ServerGroupCreate, http.StatusConflict)
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	credentials := tc.Openstack().ApplicationCredentials()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON409)
+
+	serverErr := *response.JSON409
+
+	assert.Equal(t, generated.Conflict, serverErr.Error)
+	assert.Contains(t, serverErr.ErrorDescription, "Duplicate entry")
+	assert.NotNil(t, serverErr.Remediation)
+	assert.Equal(t, credentials, tc.Openstack().ApplicationCredentials())
+}
+
+// TestApiV1ClustersCreateServerGroupNameClash tests ambiguous server groups are
+// reported as a conflict the user can resolve, rather than a server error.
+func TestApiV1ClustersCreateServerGroupNameClash(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	for _, id := range []string{"0c1f4c4e-7d1b-4a5e-9a8b-3f7e2c6d5b4a", "8e2d9f1a-6c3b-4d7e-b5a4-1f0e9d8c7b6a"} {
+		tc.Openstack().AddServerGroup(openstackmock.ServerGroup{
+			ID:   id,
+			Name: controlPlane.Name + "-foo-control-plane",
+		})
+	}
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON409)
+
+	serverErr := *response.JSON409
+
+	assert.Equal(t, generated.Conflict, serverErr.Error)
+	assert.NotNil(t, serverErr.Remediation)
+}
+
+// TestApiV1ClustersCreateApplicationCredentialRename tests application credential
+// name clashes are retried with a new name.
+func TestApiV1ClustersCreateApplicationCredentialRename(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().FailTimes(openstackmock.IdentityV3UserApplicationCredentialCreate, http.StatusConflict, 1)
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	credentials := tc.Openstack().ApplicationCredentials()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	assert.Len(t, tc.Openstack().ApplicationCredentials(), len(credentials)+1)
+}
+
+// TestApiV1ClustersCreateApplicationCredentialConflict tests persistent application
+// credential name clashes eventually give up and report a conflict.
+func TestApiV1ClustersCreateApplicationCredentialConflict(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().Fail(openstackmock.IdentityV3UserApplicationCredentialCreate, http.StatusConflict)
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON409)
+
+	serverErr := *response.JSON409
+
+	assert.Equal(t, generated.Conflict, serverErr.Error)
+	assert.NotNil(t, serverErr.Remediation)
+}
+
+// TestApiV1ClustersCreateExisting tests creating a cluster when one exists
+// errors in the right way.
+func TestApiV1ClustersCreateExisting(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON409)
+
+	serverErr := *response.JSON409
+
+	assert.Equal(t, serverErr.Error, generated.Conflict)
+}
+
+// TestApiV1ClustersCreateImplicitControlPlane tests that a cluster can be created
+// in a control plane that doesn't exist yet.
+func TestApiV1ClustersCreateImplicitControlPlane(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateControlPlaneApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), "foo", &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON500)
+
+	serverErr := *response.JSON500
+
+	assert.Equal(t, generated.ServerError, serverErr.Error)
+
+	var resource unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
+	assert.Contains(t, resource.Labels, constants.VersionLabel)
+	assert.Contains(t, resource.Labels, constants.ProjectLabel)
+}
+
+// TestApiV1ClustersNotCreateImplicitControlPlane tests a control plane is not created
+// when the request is not a create.
+func TestApiV1ClustersNotCreateImplicitControlPlane(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateControlPlaneApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), "foo", "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON404)
+
+	serverErr := *response.JSON404
+
+	assert.Equal(t, generated.NotFound, serverErr.Error)
+
+	var resource unikornv1.ControlPlane
+
+	assert.Error(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
+}
+
+// TestApiV1ClustersGet tests a cluster can be referenced by name.
+func TestApiV1ClustersGet(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	assert.Equal(t, "foo", result.Name)
+	assert.NotNil(t, result.Status)
+	assert.Equal(t, "Provisioned", result.Status.Status)
+	assert.Equal(t, kubernetesClusterApplicationBundleName, result.ApplicationBundle.Name)
+	assert.Equal(t, kubernetesClusterApplicationBundleVersion, result.ApplicationBundle.Version)
+	assert.Equal(t, clusterComputeFailureDomain, result.Openstack.ComputeAvailabilityZone)
+	assert.Equal(t, clusterStorageFailureDomain, result.Openstack.VolumeAvailabilityZone)
+	assert.Equal(t, clusterExternalNetworkID, result.Openstack.ExternalNetworkID)
+	assert.NotNil(t, result.Openstack.SshKeyName)
+	assert.Equal(t, clusterSSHKeyName, *result.Openstack.SshKeyName)
+	assert.Equal(t, clusterNodeNetwork, result.Network.NodePrefix)
+	assert.Equal(t, clusterServiceNetwork, result.Network.ServicePrefix)
+	assert.Equal(t, clusterPodNetwork, result.Network.PodPrefix)
+	assert.Len(t, result.Network.DnsNameservers, 1)
+	assert.Equal(t, clusterDNSNameserver, result.Network.DnsNameservers[0])
+	assert.Equal(t, "v"+imageK8sVersion, result.ControlPlane.Version)
+	assert.Equal(t, imageName, result.ControlPlane.ImageName)
+	assert.Equal(t, flavorName, result.ControlPlane.FlavorName)
+	assert.Equal(t, clusterControlPlaneReplicas, result.ControlPlane.Replicas)
+	assert.Len(t, result.WorkloadPools, 1)
+	assert.Equal(t, clusterWorkloadPoolName, result.WorkloadPools[0].Name)
+	assert.Equal(t, "v"+imageK8sVersion, result.WorkloadPools[0].Machine.Version)
+	assert.Equal(t, imageName, result.WorkloadPools[0].Machine.ImageName)
+	assert.Equal(t, flavorName, result.WorkloadPools[0].Machine.FlavorName)
+	assert.Equal(t, clusterWorkloadPoolReplicas, result.WorkloadPools[0].Machine.Replicas)
+	assert.Nil(t, result.Placement)
+	assert.Nil(t, result.KubernetesUpgrade)
+}
+
+// TestApiV1ClustersGetPlacement tests administrators are told which namespaces
+// a cluster is provisioned in.
+func TestApiV1ClustersGetPlacement(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	assert.NotNil(t, result.Placement)
+	assert.Equal(t, util.ToPointer(project.Status.Namespace), result.Placement.ProjectNamespace)
+	assert.Equal(t, util.ToPointer(controlPlane.Status.Namespace), result.Placement.ControlPlaneNamespace)
+	assert.Equal(t, util.ToPointer("foo"), result.Placement.ClusterNamespace)
+}
+
+// TestApiV1ClustersGetRaw tests administrators can get the underlying custom
+// resource, and that secrets are redacted.
+func TestApiV1ClustersGetRaw(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	var cluster unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &cluster))
+
+	secret := []byte("hunter2")
+
+	cluster.Spec.Openstack.CloudConfig = &secret
+	cluster.Spec.WorkloadPools.Pools[0].Files = []unikornv1.File{
+		{
+			Path:    util.ToPointer("/etc/password"),
+			Content: secret,
+		},
+	}
+	cluster.Spec.WorkloadPools.Pools[0].PreKubeadmCommands = []string{"echo " + string(secret)}
+	cluster.Spec.WorkloadPools.Pools[0].PostKubeadmCommands = []string{"echo " + string(secret)}
+
+	assert.NoError(t, tc.KubernetesClient().Update(context.TODO(), &cluster))
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	params := &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{
+		View: util.ToPointer(generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParamsViewRaw),
+	}
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", params)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.Equal(t, "application/yaml", response.HTTPResponse.Header.Get("Content-Type"))
+	assert.NotContains(t, string(response.Body), base64.StdEncoding.EncodeToString(secret))
+	assert.NotContains(t, string(response.Body), string(secret))
+
+	raw := map[string]interface{}{}
+
+	assert.NoError(t, yaml.Unmarshal(response.Body, &raw))
+	assert.Equal(t, unikornv1.SchemeGroupVersion.String(), raw["apiVersion"])
+	assert.Equal(t, "KubernetesCluster", raw["kind"])
+
+	metadata, ok := raw["metadata"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "foo", metadata["name"])
+	assert.Nil(t, metadata["managedFields"])
+
+	spec, ok := raw["spec"].(map[string]interface{})
+	assert.True(t, ok)
+
+	openstack, ok := spec["openstack"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "<redacted>", openstack["cloudConfig"])
+	assert.Equal(t, clusterExternalNetworkID, openstack["externalNetworkId"])
+
+	workloadPools, ok := spec["workloadPools"].(map[string]interface{})
+	assert.True(t, ok)
+
+	pools, ok := workloadPools["pools"].([]interface{})
+	assert.True(t, ok)
+	assert.Len(t, pools, 1)
+
+	pool, ok := pools[0].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{"<redacted>"}, pool["preKubeadmCommands"])
+	assert.Equal(t, []interface{}{"<redacted>"}, pool["postKubeadmCommands"])
+}
+
+// TestApiV1ClustersGetRawForbidden tests the raw view is only available to
+// administrators.
+func TestApiV1ClustersGetRawForbidden(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	params := &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{
+		View: util.ToPointer(generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParamsViewRaw),
+	}
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", params)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON403)
+}
+
+// TestApiV1ClustersGetNotFound tests a request for a non-existent cluster returns the
+// correct error.
+func TestApiV1ClustersGetNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON404)
+
+	result := *response.JSON404
+
+	assert.Equal(t, generated.NotFound, result.Error)
+}
+
+// TestApiV1ClustersCost tests a cluster's running cost can be estimated.
+func TestApiV1ClustersCost(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+	mustCreatePriceSheetFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	hourly := priceSheetFlavor * float64(clusterControlPlaneReplicas+clusterWorkloadPoolReplicas)
+
+	assert.Equal(t, priceSheetCurrency, result.Currency)
+	assert.InDelta(t, hourly, result.Hourly, 0.0001)
+	assert.InDelta(t, hourly*cost.HoursPerMonth, result.Monthly, 0.0001)
+	assert.Len(t, result.Pools, 2)
+	assert.Equal(t, flavorName, result.Pools[0].FlavorName)
+	assert.Equal(t, clusterControlPlaneReplicas, result.Pools[0].Replicas)
+	assert.Equal(t, clusterWorkloadPoolReplicas, result.Pools[1].Replicas)
+}
+
+// TestApiV1ClustersCostUnconfigured tests cost estimation fails when the
+// price sheet is missing.
+func TestApiV1ClustersCostUnconfigured(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCostWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON500)
+}
+
+// TestApiV1ClustersMetricsSummaryNotFound tests a metrics summary cannot be read
+// for a cluster that doesn't exist.
+func TestApiV1ClustersMetricsSummaryNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameMetricsSummaryWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON404)
+}
+
+// TestApiV1ClustersHealthNotFound tests health cannot be read for a cluster that
+// doesn't exist.
+func TestApiV1ClustersHealthNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON404)
+}
+
+// TestApiV1ClustersHealthUnreachable tests an unreachable cluster is reported as
+// such, rather than as an error, and that add-on health is still reported.
+func TestApiV1ClustersHealthUnreachable(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateArgoCDApplicationFixture(t, tc, controlPlane.Status.Namespace, "foo", "cilium", "Healthy")
+	mustCreateArgoCDApplicationFixture(t, tc, controlPlane.Status.Namespace, "foo", "cluster-openstack", "Progressing")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameHealthWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	assert.False(t, result.ApiServer.Reachable)
+	assert.Nil(t, result.Nodes)
+	assert.Nil(t, result.PendingCertificateSigningRequests)
+	assert.Len(t, result.AddOns, 2)
+	assert.Equal(t, "cilium", result.AddOns[0].Name)
+	assert.Equal(t, generated.Healthy, result.AddOns[0].Status)
+	assert.Equal(t, "cluster-openstack", result.AddOns[1].Name)
+	assert.Equal(t, generated.Progressing, result.AddOns[1].Status)
+}
+
+// TestApiV1ClustersTimingsNotFound tests stage timings cannot be read for a cluster
+// that doesn't exist.
+func TestApiV1ClustersTimingsNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON404)
+}
+
+// TestApiV1ClustersTimings tests stage timings are reported for the current
+// generation of a cluster only, with durations for completed stages.
+func TestApiV1ClustersTimings(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustUpdateKubernetesClusterStageTimingsFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameTimingsWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, "cluster", result[0].Name)
+	assert.NotNil(t, result[0].CompletionTime)
+	assert.NotNil(t, result[0].Duration)
+	assert.InDelta(t, 450, *result[0].Duration, 0.001)
+	assert.Equal(t, "bootstrap", result[1].Name)
+	assert.Nil(t, result[1].CompletionTime)
+	assert.Nil(t, result[1].Duration)
+}
+
+// TestApiV1ClustersCreateDryRun tests a cluster's cost can be estimated before
+// creation, and that nothing is actually created.
+func TestApiV1ClustersCreateDryRun(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreatePriceSheetFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	params := &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{
+		DryRun: util.ToPointer(generated.PostApiV1ControlplanesControlPlaneNameClustersParamsDryRunCost),
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, params, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	assert.Equal(t, priceSheetCurrency, result.Currency)
+	assert.InDelta(t, priceSheetFlavor*6, result.Hourly, 0.0001)
+	assert.Len(t, result.Pools, 2)
+
+	var resources unikornv1.KubernetesClusterList
+
+	assert.NoError(t, tc.KubernetesClient().List(context.TODO(), &resources))
+	assert.Empty(t, resources.Items)
+}
+
+// TestApiV1ClustersCreateDryRunUnpriced tests cost estimation fails when a
+// flavor has no price.
+func TestApiV1ClustersCreateDryRunUnpriced(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreatePriceSheetFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	params := &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{
+		DryRun: util.ToPointer(generated.PostApiV1ControlplanesControlPlaneNameClustersParamsDryRunCost),
+	}
+
+	request := *createClusterRequest
+	request.ControlPlane.FlavorName = "durian"
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), controlPlane.Name, params, "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON422)
+}
+
+// TestApiV1ClustersCreateTemplate tests that optional values omitted from a
+// cluster creation request are defaulted from a template.
+func TestApiV1ClustersCreateTemplate(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+	mustCreateClusterTemplateFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	params := &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{
+		Template: util.ToPointer(clusterTemplateName),
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, params, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.Features)
+	assert.NotNil(t, resource.Spec.Features.Ingress)
+	assert.True(t, *resource.Spec.Features.Ingress)
+	assert.Len(t, resource.Spec.WorkloadPools.Pools, 1)
+
+	pool := resource.Spec.WorkloadPools.Pools[0]
+
+	// User provided values take precedence, everything else is defaulted.
+	assert.Equal(t, createClusterRequest.WorkloadPools[0].Machine.Replicas, *pool.Replicas)
+	assert.Equal(t, map[string]string{clusterTemplateLabelKey: clusterTemplateLabelValue}, pool.Labels)
+	assert.NotNil(t, pool.DiskSize)
+	assert.Equal(t, int64(clusterTemplateDiskSize<<30), pool.DiskSize.Value())
+}
+
+// TestApiV1ClustersCreateTemplateNotFound tests that a cluster cannot be created
+// from a template that doesn't exist.
+func TestApiV1ClustersCreateTemplateNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	params := &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{
+		Template: util.ToPointer(clusterTemplateName),
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, params, "application/json", NewJSONReader(createClusterRequest))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidRequest)
+}
+
+// createEnvironmentRequest returns a basic environment request.
+func createEnvironmentRequest() *generated.Environment {
+	return &generated.Environment{
+		Name:     "foo",
+		Template: clusterTemplateName,
+		Cluster:  *createClusterRequest,
+	}
+}
+
+// mustCreateEnvironmentFixtures registers all the resources required to create
+// an environment.
+func mustCreateEnvironmentFixtures(t *testing.T, tc *TestContext) {
+	t.Helper()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	mustCreateControlPlaneApplicationBundleFixture(t, tc)
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+	mustCreateClusterTemplateFixture(t, tc)
+	mustEmulateManagers(t, tc)
+}
+
+// TestApiV1EnvironmentsCreate tests an environment's control plane and cluster
+// are created, and deleted, as a single operation.
+func TestApiV1EnvironmentsCreate(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	mustCreateEnvironmentFixtures(t, tc)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1EnvironmentsWithBodyWithResponse(context.TODO(), "application/json", NewJSONReader(createEnvironmentRequest()))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON202)
+
+	environment := *response.JSON202
+
+	assert.Equal(t, "foo", environment.Name)
+	assert.NotEmpty(t, environment.OperationId)
+	assert.NotNil(t, environment.ControlPlane)
+	assert.NotNil(t, environment.Cluster)
+
+	var controlPlane unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &controlPlane))
+
+	var cluster unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &cluster))
+
+	getResponse, err := unikornClient.GetApiV1EnvironmentsEnvironmentNameWithResponse(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.Equal(t, environment.OperationId, getResponse.JSON200.OperationId)
+
+	deleteResponse, err := unikornClient.DeleteApiV1EnvironmentsEnvironmentNameWithResponse(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, deleteResponse.HTTPResponse.StatusCode)
+
+	// The project existed before the environment, so must be retained.
+	assert.True(t, kerrors.IsNotFound(tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &controlPlane)))
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Name: project.Name}, project))
+
+	getResponse, err = unikornClient.GetApiV1EnvironmentsEnvironmentNameWithResponse(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, getResponse.HTTPResponse.StatusCode)
+}
+
+// TestApiV1EnvironmentsCreateImplicitProject tests a project created by an
+// environment is deleted with it.
+func TestApiV1EnvironmentsCreateImplicitProject(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	mustCreateEnvironmentFixtures(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1EnvironmentsWithBodyWithResponse(context.TODO(), "application/json", NewJSONReader(createEnvironmentRequest()))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.HTTPResponse.StatusCode)
+
+	var project unikornv1.Project
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Name: projectNameFromID(projectID)}, &project))
+
+	deleteResponse, err := unikornClient.DeleteApiV1EnvironmentsEnvironmentNameWithResponse(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, deleteResponse.HTTPResponse.StatusCode)
+
+	assert.True(t, kerrors.IsNotFound(tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Name: projectNameFromID(projectID)}, &project)))
+}
+
+// TestApiV1EnvironmentsCreateConflict tests an environment cannot adopt an
+// existing control plane, as it would be deleted with the environment.
+func TestApiV1EnvironmentsCreateConflict(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	mustCreateEnvironmentFixtures(t, tc)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1EnvironmentsWithBodyWithResponse(context.TODO(), "application/json", NewJSONReader(createEnvironmentRequest()))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON409)
+
+	var controlPlane unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &controlPlane))
+
+	getResponse, err := unikornClient.GetApiV1EnvironmentsEnvironmentNameWithResponse(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, getResponse.HTTPResponse.StatusCode)
+}
+
+// TestApiV1EnvironmentsCreateRollback tests everything created by a failed
+// request is removed.
+func TestApiV1EnvironmentsCreateRollback(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	mustCreateEnvironmentFixtures(t, tc)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+
+	request := createEnvironmentRequest()
+	request.Cluster.ApplicationBundle.Name = "missing"
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1EnvironmentsWithBodyWithResponse(context.TODO(), "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.NotEqual(t, http.StatusAccepted, response.HTTPResponse.StatusCode)
+
+	var controlPlane unikornv1.ControlPlane
+
+	assert.True(t, kerrors.IsNotFound(tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &controlPlane)))
+
+	getResponse, err := unikornClient.GetApiV1EnvironmentsEnvironmentNameWithResponse(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, getResponse.HTTPResponse.StatusCode)
+}
+
+// TestApiV1ClustersList tests clusters can be listed.
+func TestApiV1ClustersList(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersWithResponse(context.TODO(), controlPlane.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, "foo", results[0].Name)
+	assert.NotNil(t, results[0].Status)
+	assert.Equal(t, "Provisioned", results[0].Status.Status)
+	assert.Equal(t, kubernetesClusterApplicationBundleName, results[0].ApplicationBundle.Name)
+	assert.Equal(t, kubernetesClusterApplicationBundleVersion, results[0].ApplicationBundle.Version)
+	assert.Equal(t, clusterComputeFailureDomain, results[0].Openstack.ComputeAvailabilityZone)
+	assert.Equal(t, clusterStorageFailureDomain, results[0].Openstack.VolumeAvailabilityZone)
+	assert.Equal(t, clusterExternalNetworkID, results[0].Openstack.ExternalNetworkID)
+	assert.NotNil(t, results[0].Openstack.SshKeyName)
+	assert.Equal(t, clusterSSHKeyName, *results[0].Openstack.SshKeyName)
+	assert.Equal(t, clusterNodeNetwork, results[0].Network.NodePrefix)
+	assert.Equal(t, clusterServiceNetwork, results[0].Network.ServicePrefix)
+	assert.Equal(t, clusterPodNetwork, results[0].Network.PodPrefix)
+	assert.Len(t, results[0].Network.DnsNameservers, 1)
+	assert.Equal(t, clusterDNSNameserver, results[0].Network.DnsNameservers[0])
+	assert.Equal(t, "v"+imageK8sVersion, results[0].ControlPlane.Version)
+	assert.Equal(t, imageName, results[0].ControlPlane.ImageName)
+	assert.Equal(t, flavorName, results[0].ControlPlane.FlavorName)
+	assert.Equal(t, clusterControlPlaneReplicas, results[0].ControlPlane.Replicas)
+	assert.Len(t, results[0].WorkloadPools, 1)
+	assert.Equal(t, clusterWorkloadPoolName, results[0].WorkloadPools[0].Name)
+	assert.Equal(t, "v"+imageK8sVersion, results[0].WorkloadPools[0].Machine.Version)
+	assert.Equal(t, imageName, results[0].WorkloadPools[0].Machine.ImageName)
+	assert.Equal(t, flavorName, results[0].WorkloadPools[0].Machine.FlavorName)
+	assert.Equal(t, clusterWorkloadPoolReplicas, results[0].WorkloadPools[0].Machine.Replicas)
+}
+
+// TestApiV1ClustersUpdate tests clusters can be updated.
+func TestApiV1ClustersUpdate(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := &generated.KubernetesCluster{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: kubernetesClusterApplicationBundleName,
+		},
+		Network: generated.KubernetesClusterNetwork{
+			DnsNameservers: []string{
+				"8.8.8.8",
+			},
+			NodePrefix:    "192.168.0.0/24",
+			ServicePrefix: "172.16.0.0/12",
+			PodPrefix:     "10.0.0.0/8",
+		},
+		ControlPlane: generated.OpenstackMachinePool{
+			Version:    "v1.28.0",
+			Replicas:   3,
+			ImageName:  "ubuntu-24.04-lts",
+			FlavorName: flavorName,
+		},
+		WorkloadPools: generated.KubernetesClusterWorkloadPools{
+			{
+				Name: "foo",
+				Machine: generated.OpenstackMachinePool{
+					Version:    "v1.28.0",
+					Replicas:   3,
+					ImageName:  "ubuntu-24.04-lts",
+					FlavorName: flavorName,
+				},
+			},
+		},
+	}
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBody(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Equal(t, *resource.Spec.ApplicationBundle, kubernetesClusterApplicationBundleName)
+}
+
+// TestApiV1ClustersWorkloadPoolsMutate tests several workload pools can be mutated
+// in a single atomic update.
+func TestApiV1ClustersWorkloadPoolsMutate(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	machine := generated.OpenstackMachinePool{
+		Version:    "v" + imageK8sVersion,
+		Replicas:   5,
+		ImageName:  imageName,
+		FlavorName: flavorName,
+	}
+
+	request := generated.KubernetesClusterWorkloadPoolOperations{
+		{
+			Operation: generated.Replace,
+			Name:      clusterWorkloadPoolName,
+			Pool: &generated.KubernetesClusterWorkloadPool{
+				Name:    clusterWorkloadPoolName,
+				Machine: machine,
+			},
+		},
+		{
+			Operation: generated.Add,
+			Name:      "bar",
+			Pool: &generated.KubernetesClusterWorkloadPool{
+				Name:    "bar",
+				Machine: machine,
+			},
+		},
+		{
+			Operation: generated.Add,
+			Name:      "baz",
+			Pool: &generated.KubernetesClusterWorkloadPool{
+				Name:    "baz",
+				Machine: machine,
+			},
+		},
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithBodyWithResponse(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	assert.True(t, result.Applied)
+	assert.Len(t, result.Results, 3)
+
+	for _, r := range result.Results {
+		assert.Nil(t, r.Errors)
+	}
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Len(t, resource.Spec.WorkloadPools.Pools, 3)
+	assert.Equal(t, clusterWorkloadPoolName, resource.Spec.WorkloadPools.Pools[0].Name)
+	assert.Equal(t, 5, *resource.Spec.WorkloadPools.Pools[0].Replicas)
+	assert.Equal(t, "bar", resource.Spec.WorkloadPools.Pools[1].Name)
+	assert.Equal(t, "baz", resource.Spec.WorkloadPools.Pools[2].Name)
+}
+
+// TestApiV1ClustersWorkloadPoolsMutateInvalid tests nothing is applied when any
+// mutation is invalid, and the failures are reported per pool.
+func TestApiV1ClustersWorkloadPoolsMutateInvalid(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	machine := generated.OpenstackMachinePool{
+		Version:    "v" + imageK8sVersion,
+		Replicas:   3,
+		ImageName:  imageName,
+		FlavorName: flavorName,
+	}
+
+	invalidMachine := machine
+	invalidMachine.FlavorName = "does-not-exist"
+
+	request := generated.KubernetesClusterWorkloadPoolOperations{
+		{
+			Operation: generated.Add,
+			Name:      "bar",
+			Pool: &generated.KubernetesClusterWorkloadPool{
+				Name:    "bar",
+				Machine: machine,
+			},
+		},
+		{
+			Operation: generated.Add,
+			Name:      clusterWorkloadPoolName,
+			Pool: &generated.KubernetesClusterWorkloadPool{
+				Name:    clusterWorkloadPoolName,
+				Machine: machine,
+			},
+		},
+		{
+			Operation: generated.Remove,
+			Name:      "missing",
+		},
+		{
+			Operation: generated.Add,
+			Name:      "baz",
+			Pool: &generated.KubernetesClusterWorkloadPool{
+				Name:    "baz",
+				Machine: invalidMachine,
+			},
+		},
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithBodyWithResponse(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON422)
+
+	result := *response.JSON422
+
+	assert.False(t, result.Applied)
+	assert.Len(t, result.Results, 4)
+	assert.Nil(t, result.Results[0].Errors)
+	assert.NotNil(t, result.Results[1].Errors)
+	assert.NotNil(t, result.Results[2].Errors)
+	assert.NotNil(t, result.Results[3].Errors)
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Len(t, resource.Spec.WorkloadPools.Pools, 1)
+}
+
+// TestApiV1ClustersWorkloadPoolsMutateRemoveAll tests a cluster cannot be left
+// without any workload pools.
+func TestApiV1ClustersWorkloadPoolsMutateRemoveAll(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := generated.KubernetesClusterWorkloadPoolOperations{
+		{
+			Operation: generated.Remove,
+			Name:      clusterWorkloadPoolName,
+		},
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWithBodyWithResponse(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON422)
+	assert.False(t, response.JSON422.Applied)
+	assert.NotNil(t, response.JSON422.Results[0].Errors)
+}
+
+// TestApiV1ClustersWorkloadPoolBootstrapNotFound tests a missing workload pool
+// is reported correctly.
+func TestApiV1ClustersWorkloadPoolBootstrapNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapWithResponse(context.TODO(), controlPlane.Name, "foo", "missing")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON404)
+}
+
+// TestApiV1ClustersWorkloadPoolBootstrapReader tests readers cannot view bootstrap
+// data.
+func TestApiV1ClustersWorkloadPoolBootstrapReader(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	setupOpenstackProjectRoleFixtures(tc.Openstack(), projectReaderRoleID, projectReaderRole)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWorkloadpoolsWorkloadPoolNameBootstrapWithResponse(context.TODO(), controlPlane.Name, "foo", clusterWorkloadPoolName)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON401)
+	assert.Equal(t, generated.InvalidScope, response.JSON401.Error)
+}
+
+// TestApiV1ClustersUpdateNetworkPlugin tests the network plugin cannot be changed.
+func TestApiV1ClustersUpdateNetworkPlugin(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	plugin := generated.Calico
+
+	request := &generated.KubernetesCluster{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: kubernetesClusterApplicationBundleName,
+		},
+		Network: generated.KubernetesClusterNetwork{
+			DnsNameservers: []string{
+				"8.8.8.8",
+			},
+			NodePrefix:    "192.168.0.0/24",
+			ServicePrefix: "172.16.0.0/12",
+			PodPrefix:     "10.0.0.0/8",
+			Plugin:        &plugin,
+		},
+		ControlPlane: generated.OpenstackMachinePool{
+			Version:    "v1.28.0",
+			Replicas:   3,
+			ImageName:  "ubuntu-24.04-lts",
+			FlavorName: flavorName,
+		},
+		WorkloadPools: generated.KubernetesClusterWorkloadPools{
+			{
+				Name: "foo",
+				Machine: generated.OpenstackMachinePool{
+					Version:    "v1.28.0",
+					Replicas:   3,
+					ImageName:  "ubuntu-24.04-lts",
+					FlavorName: flavorName,
+				},
+			},
+		},
+	}
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBodyWithResponse(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON400)
+
+	serverErr := *response.JSON400
+
+	assert.Equal(t, generated.InvalidRequest, serverErr.Error)
+}
+
+// TestApiV1ClustersUpdateNotFound tests clusters return the correct error if they don't
+// exist on update.
+func TestApiV1ClustersUpdateNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := &generated.KubernetesCluster{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: kubernetesClusterApplicationBundleName,
+		},
+		Network: generated.KubernetesClusterNetwork{
+			DnsNameservers: []string{
+				"8.8.8.8",
+			},
+			NodePrefix:    "192.168.0.0/24",
+			ServicePrefix: "172.16.0.0/12",
+			PodPrefix:     "10.0.0.0/8",
+		},
+		ControlPlane: generated.OpenstackMachinePool{
+			Version:    "v1.28.0",
+			Replicas:   3,
+			ImageName:  "ubuntu-24.04-lts",
+			FlavorName: flavorName,
+		},
+		WorkloadPools: generated.KubernetesClusterWorkloadPools{
+			{
+				Name: "foo",
+				Machine: generated.OpenstackMachinePool{
+					Version:    "v1.28.0",
+					Replicas:   3,
+					ImageName:  "ubuntu-24.04-lts",
+					FlavorName: flavorName,
+				},
+			},
+		},
+	}
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBodyWithResponse(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON404)
+
+	serverErr := *response.JSON404
+
+	assert.Equal(t, serverErr.Error, generated.NotFound)
+}
+
+// TestApiV1ClustersDelete tests clusters can be deleted.
+func TestApiV1ClustersDelete(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	var statusErr *kerrors.StatusError
+
+	assert.ErrorAs(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource), &statusErr)
+	assert.Equal(t, http.StatusNotFound, int(statusErr.ErrStatus.Code))
+}
+
+// TestApiV1ClustersDeleteNotFound tests that the deletion of a non-existent cluster
+// results in the correct error.
+func TestApiV1ClustersDeleteNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON404)
+
+	serverErr := *response.JSON404
+
+	assert.Equal(t, serverErr.Error, generated.NotFound)
+}
+
+// TestApiV1ClustersUpgradeFreeze tests cluster upgrades can be frozen and thawed.
+func TestApiV1ClustersUpgradeFreeze(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	expiry := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	reason := "change embargo"
+
+	request := generated.UpgradeFreeze{
+		Expiry: expiry,
+		Reason: &reason,
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(context.TODO(), controlPlane.Name, "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.UpgradeFreeze)
+	assert.True(t, resource.Spec.UpgradeFreeze.Active(time.Now()))
+	assert.True(t, expiry.Equal(resource.Spec.UpgradeFreeze.Until.Time))
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.NotNil(t, getResponse.JSON200.UpgradeFreeze)
+	assert.True(t, expiry.Equal(getResponse.JSON200.UpgradeFreeze.Expiry))
+	assert.Equal(t, reason, *getResponse.JSON200.UpgradeFreeze.Reason)
+
+	deleteResponse, err := unikornClient.DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, deleteResponse.StatusCode)
+
+	defer deleteResponse.Body.Close()
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Nil(t, resource.Spec.UpgradeFreeze)
+}
+
+// TestApiV1ClustersCertificate tests API certificate SANs can be updated, triggering
+// a reissue whose progress is reported from the cluster status.
+func TestApiV1ClustersCertificate(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.Empty(t, getResponse.JSON200.SubjectAlternativeNames)
+	assert.Nil(t, getResponse.JSON200.Reissue)
+
+	names := generated.KubernetesClusterSubjectAlternativeNames{"api.example.com", "*.apps.example.com", "192.0.2.1"}
+
+	request := generated.KubernetesClusterCertificateOptions{
+		SubjectAlternativeNames: names,
+	}
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(context.TODO(), controlPlane.Name, "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.API)
+	assert.Equal(t, []string(names), resource.Spec.API.SubjectAlternativeNames)
+	assert.NotNil(t, resource.Spec.API.CertificateReissueTime)
+
+	reissueTime := *resource.Spec.API.CertificateReissueTime
+
+	getResponse, err = unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.Equal(t, names, getResponse.JSON200.SubjectAlternativeNames)
+	assert.NotNil(t, getResponse.JSON200.Reissue)
+	assert.Equal(t, generated.Pending, getResponse.JSON200.Reissue.Status)
+	assert.True(t, reissueTime.Time.Equal(getResponse.JSON200.Reissue.RequestedTime))
+
+	// Setting the same SANs again is a no-op.
+	response, err = unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificate(context.TODO(), controlPlane.Name, "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.True(t, reissueTime.Equal(resource.Spec.API.CertificateReissueTime))
+
+	// Simulate the provisioner completing the reissue.
+	completionTime := metav1.NewTime(reissueTime.Add(10 * time.Minute))
+
+	resource.Status.APICertificate = &unikornv1.APICertificateStatus{
+		ReissueTime:     reissueTime,
+		Replicas:        3,
+		UpdatedReplicas: 3,
+		CompletionTime:  &completionTime,
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Update(context.TODO(), &resource))
+
+	getResponse, err = unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.NotNil(t, getResponse.JSON200.Reissue)
+	assert.Equal(t, generated.Complete, getResponse.JSON200.Reissue.Status)
+	assert.Equal(t, 3, *getResponse.JSON200.Reissue.UpdatedReplicas)
+	assert.True(t, completionTime.Time.Equal(*getResponse.JSON200.Reissue.CompletionTime))
+}
+
+// TestApiV1ClustersCertificateInvalid tests malformed SANs are rejected.
+func TestApiV1ClustersCertificateInvalid(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := generated.KubernetesClusterCertificateOptions{
+		SubjectAlternativeNames: generated.KubernetesClusterSubjectAlternativeNames{"not_a_hostname"},
+	}
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameCertificateWithResponse(context.TODO(), controlPlane.Name, "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON400)
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Nil(t, resource.Spec.API)
+}
+
+// TestApiV1ClustersRotateCredentials tests a cluster's credentials can be rotated
+// and the old credential is cleaned up.
+func TestApiV1ClustersRotateCredentials(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	for i := 0; i < 2; i++ {
+		response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate(context.TODO(), controlPlane.Name, "foo")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+		response.Body.Close()
+	}
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.Openstack.Cloud)
+	assert.NotNil(t, resource.Spec.Openstack.CloudConfig)
+
+	var clientConfig clientconfig.Clouds
+
+	assert.NoError(t, yaml.Unmarshal(*resource.Spec.Openstack.CloudConfig, &clientConfig))
+
+	cloud, ok := clientConfig.Clouds[*resource.Spec.Openstack.Cloud]
+	assert.True(t, ok)
+	assert.NotNil(t, cloud.AuthInfo)
+
+	// Only the latest managed credential should remain.
+	var managed []string
+
+	for _, credential := range tc.Openstack().ApplicationCredentials() {
+		if strings.HasPrefix(credential.Description, "Automatically generated by platform service") {
+			managed = append(managed, credential.ID)
+		}
+	}
+
+	assert.Equal(t, []string{cloud.AuthInfo.ApplicationCredentialID}, managed)
+}
+
+// TestApiV1ClustersRotateCredentialsOrphans tests credentials belonging to clusters
+// that no longer exist are garbage collected, and others are left alone.
+func TestApiV1ClustersRotateCredentialsOrphans(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	tc.Openstack().AddApplicationCredential(openstackmock.ApplicationCredential{
+		ID:          "orphan",
+		Name:        "foo-bar-01234567",
+		Description: "Automatically generated by platform service [DO NOT DELETE]. cluster=" + project.Name + "/" + controlPlane.Name + "/bar",
+	})
+
+	tc.Openstack().AddApplicationCredential(openstackmock.ApplicationCredential{
+		ID:   "unmanaged",
+		Name: "bar",
+	})
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameCredentialsRotate(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var ids []string
+
+	for _, credential := range tc.Openstack().ApplicationCredentials() {
+		ids = append(ids, credential.ID)
+	}
+
+	assert.Contains(t, ids, "unmanaged")
+	assert.NotContains(t, ids, "orphan")
+}
+
+// TestApiV1ClustersUpgradeFreezeExpired tests a freeze must expire in the future.
+func TestApiV1ClustersUpgradeFreezeExpired(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := generated.UpgradeFreeze{
+		Expiry: time.Now().Add(-time.Hour),
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradesFreeze(context.TODO(), controlPlane.Name, "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidRequest)
+}
+
+// TestApiV1ClustersHibernate tests clusters can be hibernated, and their workload
+// pools are scaled to zero.
+func TestApiV1ClustersHibernate(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.True(t, resource.Hibernated())
+	assert.Equal(t, 0, *resource.Spec.WorkloadPools.Pools[0].Replicas)
+	assert.Equal(t, clusterControlPlaneReplicas, *resource.Spec.ControlPlane.Replicas)
+}
+
+// TestApiV1ClustersHibernateHibernated tests hibernating an already hibernated cluster
+// results in the correct error.
+func TestApiV1ClustersHibernateHibernated(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	conflictResponse, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernateWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, conflictResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, conflictResponse.JSON409)
+
+	serverErr := *conflictResponse.JSON409
+
+	assert.Equal(t, serverErr.Error, generated.Conflict)
+}
+
+// TestApiV1ClustersUpgradeKubernetes tests the control plane and workload pools are
+// upgraded to the newest image with the requested version.
+func TestApiV1ClustersUpgradeKubernetes(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackUpgradeImageFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := &generated.KubernetesUpgrade{
+		Version: "v" + upgradeImageK8sVersion,
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgrade(context.TODO(), controlPlane.Name, "foo", *request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Equal(t, unikornv1.SemanticVersion(request.Version), *resource.Spec.ControlPlane.Version)
+	assert.Equal(t, upgradeImageName, *resource.Spec.ControlPlane.Image)
+	assert.Equal(t, unikornv1.SemanticVersion(request.Version), *resource.Spec.WorkloadPools.Pools[0].Version)
+	assert.Equal(t, upgradeImageName, *resource.Spec.WorkloadPools.Pools[0].Image)
+}
+
+// TestApiV1ClustersUpgradeKubernetesInvalid tests downgrades, upgrades skipping a
+// minor version, and versions without an image are rejected.
+func TestApiV1ClustersUpgradeKubernetesInvalid(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackUpgradeImageFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	versions := []string{
+		"invalid",
+		"v" + imageK8sVersion,
+		kubernetesClusterApplicationBundleMinKubernetesVersion,
+		"v1.30.0",
+		"v1.28.4",
+	}
+
+	for _, version := range versions {
+		request := &generated.KubernetesUpgrade{
+			Version: version,
+		}
+
+		response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithResponse(context.TODO(), controlPlane.Name, "foo", *request)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode, version)
+		assert.NotNil(t, response.JSON400)
+	}
+}
+
+// TestApiV1ClustersUpgradeKubernetesInProgress tests upgrade progress is reported
+// and another upgrade cannot be started until it has completed.
+func TestApiV1ClustersUpgradeKubernetesInProgress(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackUpgradeImageFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustUpdateKubernetesClusterUpgradingFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+	assert.NotNil(t, response.JSON200.KubernetesUpgrade)
+
+	kubernetesUpgrade := *response.JSON200.KubernetesUpgrade
+
+	assert.Equal(t, "v"+imageK8sVersion, kubernetesUpgrade.Version)
+	assert.Equal(t, generated.KubernetesUpgradePhaseControlPlane, kubernetesUpgrade.Phase)
+
+	request := &generated.KubernetesUpgrade{
+		Version: "v" + upgradeImageK8sVersion,
+	}
+
+	conflictResponse, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameUpgradeWithResponse(context.TODO(), controlPlane.Name, "foo", *request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, conflictResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, conflictResponse.JSON409)
+
+	serverErr := *conflictResponse.JSON409
+
+	assert.Equal(t, serverErr.Error, generated.Conflict)
+}
+
+// TestApiV1ClustersResume tests hibernated clusters can be resumed, and their
+// workload pools are restored.
+func TestApiV1ClustersResume(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameHibernate(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo", &generated.GetApiV1ControlplanesControlPlaneNameClustersClusterNameParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.True(t, *getResponse.JSON200.Hibernated)
+
+	resumeResponse, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameResume(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resumeResponse.StatusCode)
+
+	defer resumeResponse.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.False(t, resource.Hibernated())
+	assert.Equal(t, clusterWorkloadPoolReplicas, *resource.Spec.WorkloadPools.Pools[0].Replicas)
+}
+
+// TestApiV1ClustersResumeNotHibernated tests resuming a cluster that isn't hibernated
+// results in the correct error.
+func TestApiV1ClustersResumeNotHibernated(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameResumeWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON409)
+
+	serverErr := *response.JSON409
+
+	assert.Equal(t, serverErr.Error, generated.Conflict)
+}
+
+// TestApiV1ClustersSSHCertificate tests a cluster can be created with an SSH CA
+// and certificates issued by it.
+func TestApiV1ClustersSSHCertificate(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	sshCertificateAuthority := true
+
+	request := *createClusterRequest
+	request.SshCertificateAuthority = &sshCertificateAuthority
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(&request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.NotNil(t, resource.Spec.SSHCertificateAuthority)
+
+	caPublicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(resource.Spec.SSHCertificateAuthority.PublicKey))
+	assert.NoError(t, err)
+
+	var secret corev1.Secret
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo-ssh-ca"}, &secret))
+
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	assert.NoError(t, err)
+
+	certificateRequest := generated.SshPublicKey{
+		PublicKey: string(ssh.MarshalAuthorizedKey(sshPublicKey)),
+	}
+
+	certificateResponse, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithResponse(context.TODO(), controlPlane.Name, "foo", certificateRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, certificateResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, certificateResponse.JSON200)
+
+	result := *certificateResponse.JSON200
+
+	assert.Equal(t, []string{"ubuntu"}, result.Principals)
+	assert.True(t, result.ValidBefore.Before(time.Now().Add(time.Hour+time.Minute)))
+
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(result.Certificate))
+	assert.NoError(t, err)
+
+	certificate, ok := parsed.(*ssh.Certificate)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(ssh.UserCert), certificate.CertType)
+	assert.NotEmpty(t, certificate.KeyId)
+	assert.Equal(t, result.Principals, certificate.ValidPrincipals)
+	assert.Equal(t, sshPublicKey.Marshal(), certificate.Key.Marshal())
+	assert.Equal(t, caPublicKey.Marshal(), certificate.SignatureKey.Marshal())
+	assert.Equal(t, uint64(result.ValidBefore.Unix()), certificate.ValidBefore)
+
+	checker := &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return bytes.Equal(auth.Marshal(), caPublicKey.Marshal())
+		},
+	}
+
+	assert.NoError(t, checker.CheckCert("ubuntu", certificate))
+	assert.Error(t, checker.CheckCert("root", certificate))
+}
+
+// TestApiV1ClustersSSHCertificateNotEnabled tests certificates cannot be issued
+// for clusters without an SSH CA.
+func TestApiV1ClustersSSHCertificateNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	assert.NoError(t, err)
+
+	request := generated.SshPublicKey{
+		PublicKey: string(ssh.MarshalAuthorizedKey(sshPublicKey)),
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersClusterNameSshCertificateWithResponse(context.TODO(), controlPlane.Name, "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON400)
+
+	serverErr := *response.JSON400
+
+	assert.Equal(t, serverErr.Error, generated.InvalidRequest)
+}
+
+// TestApiV1ClustersNodeAllowList tests a cluster's node allow list can be set
+// and retrieved.
+func TestApiV1ClustersNodeAllowList(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustEnableNodeFirewall(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	portMax := 30100
+
+	request := generated.NodeAllowList{
+		{
+			Protocol: generated.Tcp,
+			Port:     30080,
+			Prefixes: []string{"192.168.0.0/24"},
+		},
+		{
+			Protocol: generated.Udp,
+			Port:     30000,
+			PortMax:  &portMax,
+			Prefixes: []string{"10.0.0.0/8", "172.16.0.0/12"},
+		},
+	}
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(context.TODO(), controlPlane.Name, "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Len(t, resource.Spec.NodeAllowList, 2)
+	assert.Equal(t, unikornv1.NodeAllowListProtocolUDP, resource.Spec.NodeAllowList[1].Protocol)
+	assert.Len(t, resource.Spec.NodeAllowList[1].Prefixes, 2)
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.Equal(t, request, *getResponse.JSON200)
+}
+
+// TestApiV1ClustersNodeAllowListEgress tests a cluster's node allow list can
+// contain egress rules alongside ingress ones.
+func TestApiV1ClustersNodeAllowListEgress(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustEnableNodeFirewall(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	ingress := generated.Ingress
+	egress := generated.Egress
+
+	request := generated.NodeAllowList{
+		{
+			Direction: &ingress,
+			Protocol:  generated.Tcp,
+			Port:      30080,
+			Prefixes:  []string{"192.168.0.0/24"},
+		},
+		{
+			Direction: &egress,
+			Protocol:  generated.Tcp,
+			Port:      443,
+			Prefixes:  []string{"0.0.0.0/0"},
+		},
+	}
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(context.TODO(), controlPlane.Name, "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
+	assert.Len(t, resource.Spec.NodeAllowList, 2)
+	assert.Equal(t, unikornv1.NodeAllowListDirectionIngress, resource.Spec.NodeAllowList[0].EffectiveDirection())
+	assert.Equal(t, unikornv1.NodeAllowListDirectionEgress, resource.Spec.NodeAllowList[1].EffectiveDirection())
+
+	getResponse, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlistWithResponse(context.TODO(), controlPlane.Name, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, getResponse.JSON200)
+	assert.Equal(t, request, *getResponse.JSON200)
+}
+
+// TestApiV1ClustersNodeAllowListDisabled tests the node allow list cannot be set
+// unless the node firewall is enabled.
+func TestApiV1ClustersNodeAllowListDisabled(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	request := generated.NodeAllowList{
+		{
+			Protocol: generated.Tcp,
+			Port:     30080,
+			Prefixes: []string{"192.168.0.0/24"},
+		},
+	}
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(context.TODO(), controlPlane.Name, "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidRequest)
+}
+
+// TestApiV1ClustersNodeAllowListInvalid tests invalid port ranges are rejected.
+func TestApiV1ClustersNodeAllowListInvalid(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustEnableNodeFirewall(t, tc, controlPlane.Status.Namespace, "foo")
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	portMax := 30000
+
+	request := generated.NodeAllowList{
+		{
+			Protocol: generated.Tcp,
+			Port:     30080,
+			PortMax:  &portMax,
+			Prefixes: []string{"192.168.0.0/24"},
+		},
+	}
+
+	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameNodesAllowlist(context.TODO(), controlPlane.Name, "foo", request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidRequest)
+}
+
+// TestApiV1ApplicationBundlesListControlPlane tests control plane application bundles can
+// be listed.
+func TestApiV1ApplicationBundlesListControlPlane(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
 	mustCreateControlPlaneApplicationBundleFixture(t, tc)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBodyWithResponse(context.TODO(), "foo", "application/json", NewJSONReader(createClusterRequest))
+	response, err := unikornClient.GetApiV1ApplicationbundlesControlPlaneWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, controlPlaneApplicationBundleName, results[0].Name)
+	assert.Equal(t, controlPlaneApplicationBundleVersion, results[0].Version)
+	assert.Nil(t, results[0].Display)
+}
+
+// TestApiV1ApplicationBundlesListCluster tests cluster application bundles can be listed.
+func TestApiV1ApplicationBundlesListCluster(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ApplicationbundlesClusterWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, kubernetesClusterApplicationBundleName, results[0].Name)
+	assert.Equal(t, kubernetesClusterApplicationBundleVersion, results[0].Version)
+	assert.NotNil(t, results[0].KubernetesVersions)
+	assert.Equal(t, kubernetesClusterApplicationBundleMinKubernetesVersion, *results[0].KubernetesVersions.Minimum)
+	assert.Equal(t, kubernetesClusterApplicationBundleMaxKubernetesVersion, *results[0].KubernetesVersions.Maximum)
+	assert.NotNil(t, results[0].Display)
+	assert.Equal(t, kubernetesClusterApplicationBundleDescription, *results[0].Display.Description)
+	assert.Equal(t, kubernetesClusterApplicationBundleCategory, *results[0].Display.Category)
+	assert.Equal(t, kubernetesClusterApplicationBundleIconURL, *results[0].Display.IconUrl)
+	assert.Equal(t, kubernetesClusterApplicationBundleDocumentationURL, *results[0].Display.DocumentationUrl)
+}
+
+// TestApiV1KubernetesVersions tests Kubernetes versions are derived from images,
+// without a policy all images share the same version, so it's supported.
+func TestApiV1KubernetesVersions(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1KubernetesversionsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, "v"+imageK8sVersion, results[0].Version)
+	assert.Equal(t, generated.Supported, results[0].Phase)
+}
+
+// TestApiV1KubernetesVersionsPolicy tests the operator can override the support
+// phase of versions, and the most severe phase is reported.
+func TestApiV1KubernetesVersionsPolicy(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+
+	mustCreateKubernetesVersionPolicyFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1KubernetesversionsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, "v"+imageK8sVersion, results[0].Version)
+	assert.Equal(t, generated.RemovedSoon, results[0].Phase)
+}
+
+// TestApiV1ClusterTemplatesList tests cluster templates can be listed.
+func TestApiV1ClusterTemplatesList(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustCreateClusterTemplateFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ClustertemplatesWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, clusterTemplateName, results[0].Name)
+	assert.NotNil(t, results[0].Description)
+	assert.Equal(t, clusterTemplateDescription, *results[0].Description)
+	assert.NotNil(t, results[0].ApplicationBundleName)
+	assert.Equal(t, kubernetesClusterApplicationBundleName, *results[0].ApplicationBundleName)
+	assert.NotNil(t, results[0].ControlPlane)
+	assert.NotNil(t, results[0].ControlPlane.FlavorName)
+	assert.Equal(t, flavorName, *results[0].ControlPlane.FlavorName)
+	assert.NotNil(t, results[0].WorkloadPools)
+	assert.Len(t, *results[0].WorkloadPools, 1)
+
+	pool := (*results[0].WorkloadPools)[0]
+
+	assert.Equal(t, "foo", pool.Name)
+	assert.NotNil(t, pool.Machine)
+	assert.NotNil(t, pool.Machine.Disk)
+	assert.Equal(t, clusterTemplateDiskSize, pool.Machine.Disk.Size)
+	assert.NotNil(t, results[0].Features)
+	assert.NotNil(t, results[0].Features.Ingress)
+	assert.True(t, *results[0].Features.Ingress)
+}
+
+// TestApiV1Changelog tests the platform changelog is listed newest first, and
+// acknowledgements are tracked per user.
+func TestApiV1Changelog(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustCreatePlatformChangelogFixture(t, tc, platformChangelogPreviousVersion, platformChangelogDate.AddDate(0, -1, 0))
+	mustCreatePlatformChangelogFixture(t, tc, platformChangelogVersion, platformChangelogDate)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ChangelogWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 2)
+	assert.Equal(t, platformChangelogVersion, results[0].Version)
+	assert.True(t, platformChangelogDate.Equal(results[0].Date))
+	assert.NotNil(t, results[0].Highlights)
+	assert.Equal(t, []string{platformChangelogHighlight}, *results[0].Highlights)
+	assert.Nil(t, results[0].BreakingChanges)
+	assert.False(t, results[0].Acknowledged)
+	assert.Equal(t, platformChangelogPreviousVersion, results[1].Version)
+	assert.False(t, results[1].Acknowledged)
+
+	acknowledgeResponse, err := unikornClient.PostApiV1ChangelogChangelogVersionAcknowledge(context.TODO(), platformChangelogVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, acknowledgeResponse.StatusCode)
+
+	defer acknowledgeResponse.Body.Close()
+
+	// Acknowledgement is idempotent.
+	acknowledgeResponse, err = unikornClient.PostApiV1ChangelogChangelogVersionAcknowledge(context.TODO(), platformChangelogVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, acknowledgeResponse.StatusCode)
+
+	defer acknowledgeResponse.Body.Close()
+
+	response, err = unikornClient.GetApiV1ChangelogWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+	assert.NotNil(t, response.JSON200)
+
+	results = *response.JSON200
+
+	assert.Len(t, results, 2)
+	assert.True(t, results[0].Acknowledged)
+	assert.False(t, results[1].Acknowledged)
+}
+
+// TestApiV1ChangelogAcknowledgeNotFound tests acknowledging a version without
+// a changelog entry is rejected.
+func TestApiV1ChangelogAcknowledgeNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ChangelogChangelogVersionAcknowledge(context.TODO(), platformChangelogVersion)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	defer response.Body.Close()
+}
+
+// TestApiV1ApplicationsList tests applications can be listed.
+func TestApiV1ApplicationsList(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustCreateHelmApplicationFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ApplicationsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, applicationName, results[0].Name)
+	assert.Equal(t, applicationHumanReadableName, results[0].HumanReadableName)
+	assert.Equal(t, applicationDescription, results[0].Description)
+	assert.Equal(t, applicationDocumentation, results[0].Documentation)
+	assert.Equal(t, applicationLicense, results[0].License)
+	assert.Equal(t, []byte(applicationIcon), results[0].Icon)
+	assert.Equal(t, applicationSummary, *results[0].Summary)
+	assert.Equal(t, applicationCategory, *results[0].Category)
+	assert.Len(t, results[0].Versions, 1)
+	assert.Equal(t, applicationVersion, results[0].Versions[0].Version)
+}
+
+// TestApiV1ProvidersOpenstackProjects tests OpenStack projects can be listed.
+func TestApiV1ProvidersOpenstackProjects(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackProjectsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, projectID, results[0].Id)
+	assert.Equal(t, projectName, results[0].Name)
+}
+
+// TestApiV1ProvidersOpenstackProjectsUnauthorized tests an unauthorized response
+// from a request is propagated to the client correctly.
+func TestApiV1ProvidersOpenstackProjectsUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	// Override...
+	tc.Openstack().RegisterIdentityV3AuthProjects()
+	tc.Openstack().Fail(openstackmock.IdentityV3AuthProjects, http.StatusUnauthorized)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackProjectsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON401)
+
+	serverErr := *response.JSON401
+
+	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+}
+
+// TestApiV1ProvidersOpenstackFlavors tests OpenStack flavors can be listed.
+func TestApiV1ProvidersOpenstackFlavors(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	// NOTE: server converts from MiB to GiB of memory.
+	assert.Len(t, results, 3)
+	assert.Equal(t, flavorID, results[0].Id)
+	assert.Equal(t, flavorName, results[0].Name)
+	assert.Equal(t, flavorCpus, results[0].Cpus)
+	assert.Equal(t, flavorMemory>>10, results[0].Memory)
+	assert.Equal(t, flavorDisk, results[0].Disk)
+	assert.NotNil(t, results[0].GpuModel)
+	assert.Equal(t, flavorGPUModel, *results[0].GpuModel)
+	assert.NotNil(t, results[0].GpuMemory)
+	assert.Equal(t, flavorGPUMemory, *results[0].GpuMemory)
+	assert.NotNil(t, results[0].GpuProfile)
+	assert.Equal(t, flavorGPUProfile, *results[0].GpuProfile)
+	assert.NotNil(t, results[0].MinimumNvidiaDriverVersion)
+	assert.Equal(t, flavorGPUDriverVersion, *results[0].MinimumNvidiaDriverVersion)
+	assert.Equal(t, flavorName2, results[1].Name)
+	assert.Nil(t, results[1].GpuProfile)
+	assert.Equal(t, flavorName3, results[2].Name)
+	assert.Nil(t, results[2].GpuModel)
+	assert.Nil(t, results[2].MinimumNvidiaDriverVersion)
+}
+
+// TestApiV1ProvidersOpenstackFlavorsUnauthorized tests an unauthorized response
+// from a request is propagated to the client correctly.
+func TestApiV1ProvidersOpenstackFlavorsUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().Fail(openstackmock.ComputeV2FlavorsDetail, http.StatusUnauthorized)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON401)
+
+	serverErr := *response.JSON401
+
+	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+}
+
+// TestApiV1ProvidersOpenstackFlavorsCached tests flavor listings are cached, and
+// that clients can ask for fresh results.
+func TestApiV1ProvidersOpenstackFlavorsCached(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+
+	// OpenStack isn't consulted while the result is cached.
+	tc.Openstack().Fail(openstackmock.ComputeV2FlavorsDetail, http.StatusInternalServerError)
+
+	cachedResponse, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, cachedResponse.HTTPResponse.StatusCode)
+	assert.Equal(t, response.JSON200, cachedResponse.JSON200)
+
+	noCache := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Cache-Control", "no-cache")
+
+		return nil
+	}
+
+	freshResponse, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO(), noCache)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, freshResponse.HTTPResponse.StatusCode)
+}
+
+// TestApiV1ProvidersOpenstackFlavorsNotModified tests clients can make conditional
+// requests and are told when their copy is still current.
+func TestApiV1ProvidersOpenstackFlavorsNotModified(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+
+	eTag := response.HTTPResponse.Header.Get("ETag")
+	assert.NotEmpty(t, eTag)
+
+	ifNoneMatch := func(eTag string) generated.RequestEditorFn {
+		return func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("If-None-Match", eTag)
+
+			return nil
+		}
+	}
+
+	notModifiedResponse, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO(), ifNoneMatch(eTag))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotModified, notModifiedResponse.HTTPResponse.StatusCode)
+	assert.Equal(t, eTag, notModifiedResponse.HTTPResponse.Header.Get("ETag"))
+	assert.Empty(t, notModifiedResponse.Body)
+
+	modifiedResponse, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO(), ifNoneMatch(`"stale"`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, modifiedResponse.HTTPResponse.StatusCode)
+	assert.NotNil(t, modifiedResponse.JSON200)
+}
+
+// TestApiV1ProvidersOpenstackFlavorsNotModifiedYAML tests JSON and YAML
+// representations are tagged differently, so a cached JSON response isn't
+// served to a client that asked for YAML.
+func TestApiV1ProvidersOpenstackFlavorsNotModifiedYAML(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.Equal(t, "Accept", response.HTTPResponse.Header.Get("Vary"))
+
+	eTag := response.HTTPResponse.Header.Get("ETag")
+	assert.NotEmpty(t, eTag)
+
+	yaml := func(eTag string) generated.RequestEditorFn {
+		return func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Accept", "application/yaml")
+			req.Header.Set("If-None-Match", eTag)
+
+			return nil
+		}
+	}
+
+	yamlResponse, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO(), yaml(eTag))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, yamlResponse.HTTPResponse.StatusCode)
+	assert.Equal(t, "Accept", yamlResponse.HTTPResponse.Header.Get("Vary"))
+	assert.NotEmpty(t, yamlResponse.Body)
+
+	yamlETag := yamlResponse.HTTPResponse.Header.Get("ETag")
+	assert.NotEmpty(t, yamlETag)
+	assert.NotEqual(t, eTag, yamlETag)
+
+	notModifiedResponse, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO(), yaml(yamlETag))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotModified, notModifiedResponse.HTTPResponse.StatusCode)
+	assert.Equal(t, yamlETag, notModifiedResponse.HTTPResponse.Header.Get("ETag"))
+}
+
+// noCacheEditor asks the server for fresh results.
+func noCacheEditor(ctx context.Context, req *http.Request) error {
+	req.Header.Set("Cache-Control", "no-cache")
+
+	return nil
+}
+
+// TestApiV1ProvidersOpenstackFlavorsStale tests cached results are served,
+// with a warning, when OpenStack is unavailable.
+func TestApiV1ProvidersOpenstackFlavorsStale(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.Empty(t, response.HTTPResponse.Header.Get("Warning"))
+
+	tc.Openstack().Fail(openstackmock.ComputeV2FlavorsDetail, http.StatusServiceUnavailable)
+
+	staleResponse, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO(), noCacheEditor)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, staleResponse.HTTPResponse.StatusCode)
+	assert.Equal(t, handler.StaleWarning, staleResponse.HTTPResponse.Header.Get("Warning"))
+	assert.Equal(t, response.JSON200, staleResponse.JSON200)
+
+	// Other errors are not masked.
+	tc.Openstack().Fail(openstackmock.ComputeV2FlavorsDetail, http.StatusInternalServerError)
+
+	failedResponse, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO(), noCacheEditor)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, failedResponse.HTTPResponse.StatusCode)
+}
+
+// TestApiV1ProvidersOpenstackFlavorsUnavailable tests an unavailable service is
+// reported as such when there's nothing cached.
+func TestApiV1ProvidersOpenstackFlavorsUnavailable(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().Fail(openstackmock.ComputeV2FlavorsDetail, http.StatusServiceUnavailable)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON503)
+	assert.Equal(t, generated.TemporarilyUnavailable, response.JSON503.Error)
+}
+
+// TestApiV1ProvidersOpenstackHealth tests services are reported as reachable
+// until they fail repeatedly, after which requests are short circuited.
+func TestApiV1ProvidersOpenstackHealth(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	health := func() *generated.OpenstackHealth {
+		response, err := unikornClient.GetApiV1ProvidersOpenstackHealthWithResponse(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+		assert.NotNil(t, response.JSON200)
+
+		return response.JSON200
+	}
+
+	service := func(health *generated.OpenstackHealth, name generated.OpenstackServiceName) generated.OpenstackServiceHealth {
+		for _, service := range health.Services {
+			if service.Service == name {
+				return service
+			}
+		}
+
+		t.Fatal("service not reported", name)
+
+		return generated.OpenstackServiceHealth{}
+	}
+
+	result := health()
+	assert.True(t, result.Healthy)
+	assert.Len(t, result.Services, 5)
+
+	tc.Openstack().Fail(openstackmock.ComputeV2FlavorsDetail, http.StatusServiceUnavailable)
+
+	for i := 0; i < 5; i++ {
+		response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO(), noCacheEditor)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, response.HTTPResponse.StatusCode)
+	}
+
+	result = health()
+	assert.False(t, result.Healthy)
+
+	compute := service(result, generated.Compute)
+	assert.False(t, compute.Reachable)
+	assert.Equal(t, generated.Open, compute.State)
+	assert.Equal(t, 5, compute.ConsecutiveFailures)
+	assert.NotNil(t, compute.LastFailure)
+
+	identity := service(result, generated.Identity)
+	assert.True(t, identity.Reachable)
+	assert.Equal(t, generated.Closed, identity.State)
+	assert.NotNil(t, identity.LastSuccess)
+
+	// Requests are short circuited, even though the service has recovered.
+	tc.Openstack().ClearFailure(openstackmock.ComputeV2FlavorsDetail)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO(), noCacheEditor)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, response.HTTPResponse.StatusCode)
+}
+
+// TestApiV1Providers tests the provider type is reported without a scoped token.
+func TestApiV1Providers(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewUnscopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+	assert.Equal(t, generated.Openstack, response.JSON200.Type)
+}
+
+// TestApiV1ProvidersOpenstackFlavorCompatibleImages tests only images with a
+// new enough driver are returned for a GPU flavor.
+func TestApiV1ProvidersOpenstackFlavorCompatibleImages(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterImageV2Images()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesWithResponse(context.TODO(), flavorName)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, imageName, results[0].Name)
+}
+
+// TestApiV1ProvidersOpenstackFlavorCompatibleImagesNoGPU tests all images are
+// compatible with a flavor without a GPU.
+func TestApiV1ProvidersOpenstackFlavorCompatibleImagesNoGPU(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterImageV2Images()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesWithResponse(context.TODO(), flavorName3)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 2)
+	assert.Equal(t, imageName, results[0].Name)
+	assert.Equal(t, imageName2, results[1].Name)
+}
+
+// TestApiV1ProvidersOpenstackFlavorCompatibleImagesNotFound tests a missing
+// flavor is reported as not found.
+func TestApiV1ProvidersOpenstackFlavorCompatibleImagesNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterImageV2Images()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsFlavorNameCompatibleImagesWithResponse(context.TODO(), "durian")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON404)
+
+	serverErr := *response.JSON404
+
+	assert.Equal(t, generated.NotFound, serverErr.Error)
+}
+
+// TestApiV1ProvidersOpenstackImages tests OpenStack images can be listed.
+func TestApiV1ProvidersOpenstackImages(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackImagesWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	ts, err := time.Parse(time.RFC3339, imageTimestamp)
+	assert.NoError(t, err)
+
+	// NOTE: server converts kubernetes version to a proper semver so it's compatible
+	// with the CAPI kubeadm controller.
+	assert.Len(t, results, 2)
+	assert.Equal(t, imageID, results[0].Id)
+	assert.Equal(t, imageName, results[0].Name)
+	assert.Equal(t, ts, results[0].Created)
+	assert.Equal(t, ts, results[0].Modified)
+	assert.Equal(t, "v"+imageK8sVersion, results[0].Versions.Kubernetes)
+	assert.Equal(t, imageGpuVersion, results[0].Versions.NvidiaDriver)
+}
+
+// TestApiV1ProvidersOpenstackImagesUnauthorized tests an unauthorized response
+// from a request is propagated to the client correctly.
+func TestApiV1ProvidersOpenstackImagesUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().Fail(openstackmock.ImageV2Images, http.StatusUnauthorized)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackImagesWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON401)
+
+	serverErr := *response.JSON401
+
+	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+}
+
+// TestApiV1ProvidersOpenstackAvailabilityZonesCompute tests OpenStack compute AZscan be listed.
+func TestApiV1ProvidersOpenstackAvailabilityZonesCompute(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, computeAvailabilityZoneName, results[0].Name)
+	assert.True(t, results[0].Available)
+	assert.Nil(t, results[0].Hosts)
+	assert.NotNil(t, results[0].Annotations)
+	assert.Equal(t, []string{computeAvailabilityZoneAnnotation}, *results[0].Annotations)
+}
+
+// TestApiV1ProvidersOpenstackAvailabilityZonesComputeDetail tests OpenStack compute
+// availability zones report host counts when visible to the user.
+func TestApiV1ProvidersOpenstackAvailabilityZonesComputeDetail(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2AvailabilityZoneDetail()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, computeAvailabilityZoneName, results[0].Name)
+	assert.NotNil(t, results[0].Hosts)
+	assert.Equal(t, computeAvailabilityZoneHosts, *results[0].Hosts)
+}
+
+// TestApiV1ProvidersOpenstackAvailabilityZonesComputeUnauthorized tests an unauthorized response
+// from a request is propagated to the client correctly.
+func TestApiV1ProvidersOpenstackAvailabilityZonesComputeUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().Fail(openstackmock.ComputeV2AvailabilityZone, http.StatusUnauthorized)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON401)
+
+	serverErr := *response.JSON401
+
+	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+}
+
+// TestApiV1ProvidersOpenstackAvailabilityZonesBlockStorage tests OpenStack block storage AZscan be listed.
+func TestApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, blockStorageAvailabilityZone, results[0].Name)
+}
+
+// TestApiV1ProvidersOpenstackAvailabilityZonesBlockStorageUnauthorized tests an unauthorized response
+// from a request is propagated to the client correctly.
+func TestApiV1ProvidersOpenstackAvailabilityZonesBlockStorageUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().Fail(openstackmock.BlockStorageV3AvailabilityZone, http.StatusUnauthorized)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON401)
+
+	serverErr := *response.JSON401
+
+	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+}
+
+// TestApiV1ProvidersOpenstackExternalNetworks tests OpenStack external networks can be listed.
+func TestApiV1ProvidersOpenstackExternalNetworks(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterNetworkV2Networks()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackExternalNetworksWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, externalNetworkID, results[0].Id)
+}
+
+// TestApiV1ProvidersOpenstackExternalNetworksUnauthorized tests an unauthorized response
+// from a request is propagated to the client correctly.
+func TestApiV1ProvidersOpenstackExternalNetworksUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterNetworkV2Networks()
+	tc.Openstack().Fail(openstackmock.NetworkV2Networks, http.StatusUnauthorized)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackExternalNetworksWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON401)
+
+	serverErr := *response.JSON401
+
+	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+}
+
+// TestApiV1ProvidersOpenstackQuotas tests OpenStack quotas can be read.
+func TestApiV1ProvidersOpenstackQuotas(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2QuotaSetsDetail()
+	tc.Openstack().RegisterBlockStorageV3QuotaSets()
+	tc.Openstack().RegisterNetworkV2QuotasDetails()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackQuotasWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Equal(t, computeQuotaCoresLimit, results.Compute.Cores.Limit)
+	assert.Equal(t, computeQuotaCoresInUse+computeQuotaCoresReserved, results.Compute.Cores.Used)
+	assert.Equal(t, blockStorageQuotaGigabytesLimit, results.BlockStorage.Gigabytes.Limit)
+	assert.Equal(t, blockStorageQuotaGigabytesInUse, results.BlockStorage.Gigabytes.Used)
+	assert.Equal(t, blockStorageQuotaPerVolumeGigabytes, results.BlockStorage.PerVolumeGigabytes)
+	assert.Equal(t, networkQuotaFloatingIPLimit, results.Network.FloatingIPs.Limit)
+	assert.Equal(t, networkQuotaFloatingIPUsed, results.Network.FloatingIPs.Used)
+}
+
+// TestApiV1ProvidersOpenstackQuotasUnauthorized tests an unauthorized response
+// from a request is propagated to the client correctly.
+func TestApiV1ProvidersOpenstackQuotasUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2QuotaSetsDetail()
+	tc.Openstack().Fail(openstackmock.ComputeV2QuotaSetsDetail, http.StatusUnauthorized)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackQuotasWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON401)
+
+	serverErr := *response.JSON401
+
+	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+}
+
+// TestApiV1ProvidersOpenstackKeyPairs tests OpenStack key pairs can be listed.
+func TestApiV1ProvidersOpenstackKeyPairs(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2Keypairs()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackKeyPairsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	results := *response.JSON200
+
+	assert.Len(t, results, 1)
+	assert.Equal(t, keyPairName, results[0].Name)
+}
+
+// TestApiV1ProvidersOpenstackKeyPairsUnauthorized tests an unauthorized response
+// from a request is propagated to the client correctly.
+func TestApiV1ProvidersOpenstackKeyPairsUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterComputeV2Keypairs()
+	tc.Openstack().Fail(openstackmock.ComputeV2Keypairs, http.StatusUnauthorized)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackKeyPairsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON401)
+
+	serverErr := *response.JSON401
+
+	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+}
+
+// TestApiV1ProvidersOpenstackApplicationCredentialRoles tests the roles required
+// to create application credentials are reported.
+func TestApiV1ProvidersOpenstackApplicationCredentialRoles(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackApplicationCredentialRolesWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	assert.Equal(t, []string{projectEditorRole, loadBalancerRole}, result.Required)
+	assert.Empty(t, result.Missing)
+}
+
+// TestApiV1ProvidersOpenstackApplicationCredentialRolesMissing tests roles the
+// user doesn't have are reported.
+func TestApiV1ProvidersOpenstackApplicationCredentialRolesMissing(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackNoLoadBalancerRoleFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ProvidersOpenstackApplicationCredentialRolesWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	assert.Equal(t, []string{projectEditorRole, loadBalancerRole}, result.Required)
+	assert.Equal(t, []string{loadBalancerRole}, result.Missing)
+}
+
+// debugCaptureRequester sets the debug capture header on a request.
+func debugCaptureRequester(_ context.Context, req *http.Request) error {
+	req.Header.Set(serverdebug.Header, "true")
+
+	return nil
+}
+
+// TestApiV1DebugCapture tests an administrator can capture a request, and that
+// the request, provider requests and resource modifications are recorded without
+// leaking any secrets.
+func TestApiV1DebugCapture(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.PostApiV1ControlplanesControlPlaneNameClustersWithBody(context.TODO(), controlPlane.Name, &generated.PostApiV1ControlplanesControlPlaneNameClustersParams{}, "application/json", NewJSONReader(createClusterRequest), debugCaptureRequester)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	defer response.Body.Close()
+
+	captureID := response.Header.Get(serverdebug.IDHeader)
+	assert.NotEmpty(t, captureID)
+
+	captureResponse, err := unikornClient.GetApiV1AdminDebugCapturesCaptureIDWithResponse(context.TODO(), captureID)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, captureResponse.StatusCode())
+
+	capture := captureResponse.JSON200
+
+	assert.Equal(t, captureID, capture.Id)
+	assert.Equal(t, http.MethodPost, capture.Request.Method)
+	assert.Equal(t, http.StatusAccepted, capture.Request.Status)
+	assert.NotNil(t, capture.Request.RequestHeaders)
+	assert.Equal(t, logging.Redacted, (*capture.Request.RequestHeaders)["Authorization"])
+	assert.NotNil(t, capture.Request.RequestBody)
+	assert.NotEmpty(t, capture.ProviderRequests)
+
+	var created bool
+
+	for _, mutation := range capture.Mutations {
+		if mutation.Operation == generated.Create && mutation.Kind == unikornv1.KubernetesClusterKind && mutation.Name == "foo" {
+			created = true
+		}
+	}
+
+	assert.True(t, created)
+
+	// Nothing sensitive should be returned, the keystone token is the most
+	// obvious thing to leak as it's in every provider request.
+	assert.NotContains(t, string(captureResponse.Body), openstackmock.Token)
+}
+
+// TestApiV1DebugCaptureForbidden tests a user without administrative privileges
+// cannot capture requests.
+func TestApiV1DebugCaptureForbidden(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1Clustertemplates(context.TODO(), debugCaptureRequester)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
+	assert.Empty(t, response.Header.Get(serverdebug.IDHeader))
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.Forbidden)
+}
+
+// TestApiV1DebugCaptureUnauthorized tests a user without administrative privileges
+// cannot read captures.
+func TestApiV1DebugCaptureUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1AdminDebugCapturesCaptureID(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidScope)
+}
+
+// TestApiV1DebugCaptureNotFound tests unknown captures are reported as such.
+func TestApiV1DebugCaptureNotFound(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1AdminDebugCapturesCaptureID(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.NotFound)
+}
+
+// TestApiV1AdminDeprecations tests administrators can read the deprecated API
+// usage report.
+func TestApiV1AdminDeprecations(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1AdminDeprecationsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+	assert.Empty(t, response.HTTPResponse.Header.Values(serverdeprecation.Header))
+	assert.NotNil(t, response.JSON200)
+	assert.Empty(t, *response.JSON200)
+}
+
+// TestApiV1AdminDeprecationsUnauthorized tests a user without administrative
+// privileges cannot read the deprecated API usage report.
+func TestApiV1AdminDeprecationsUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1AdminDeprecations(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidScope)
+}
+
+// TestApiV1AdminMonitorShards tests administrators can see which monitor replica
+// each resource is assigned to.
+func TestApiV1AdminMonitorShards(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateMonitorShardMemberFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1AdminMonitorShardsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+	assert.NotNil(t, response.JSON200)
+
+	result := *response.JSON200
+
+	assert.Len(t, result.Members, 1)
+	assert.Equal(t, monitorShardReplica, result.Members[0].Identity)
+	assert.Len(t, result.Assignments, 2)
+	assert.Equal(t, generated.MonitorShardResourceKindControlPlane, result.Assignments[0].Kind)
+	assert.Equal(t, generated.MonitorShardResourceKindKubernetesCluster, result.Assignments[1].Kind)
+
+	for _, assignment := range result.Assignments {
+		assert.NotNil(t, assignment.Replica)
+		assert.Equal(t, monitorShardReplica, *assignment.Replica)
+		assert.False(t, assignment.Pinned)
+	}
+}
+
+// TestApiV1AdminMonitorShardsPin tests administrators can pin a resource to
+// a monitor replica, and unpin it again.
+func TestApiV1AdminMonitorShardsPin(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateMonitorShardMemberFixture(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	pin := generated.MonitorShardPin{
+		Kind:      generated.MonitorShardResourceKindKubernetesCluster,
+		Namespace: controlPlane.Status.Namespace,
+		Name:      "foo",
+		Replica:   util.ToPointer(monitorShardReplica),
+	}
+
+	response, err := unikornClient.PutApiV1AdminMonitorShardsWithResponse(context.TODO(), generated.MonitorShardPins{pin})
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusInternalServerError, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON500)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode())
 
-	serverErr := *response.JSON500
+	cluster := &unikornv1.KubernetesCluster{}
 
-	assert.Equal(t, generated.ServerError, serverErr.Error)
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, cluster))
+	assert.Equal(t, monitorShardReplica, cluster.Labels[constants.MonitorShardLabel])
 
-	var resource unikornv1.ControlPlane
+	getResponse, err := unikornClient.GetApiV1AdminMonitorShardsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, getResponse.StatusCode())
+	assert.NotNil(t, getResponse.JSON200)
+	assert.True(t, getResponse.JSON200.Assignments[1].Pinned)
 
-	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
-	assert.Contains(t, resource.Labels, constants.VersionLabel)
-	assert.Contains(t, resource.Labels, constants.ProjectLabel)
+	pin.Replica = nil
+
+	response, err = unikornClient.PutApiV1AdminMonitorShardsWithResponse(context.TODO(), generated.MonitorShardPins{pin})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode())
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, cluster))
+	assert.NotContains(t, cluster.Labels, constants.MonitorShardLabel)
 }
 
-// TestApiV1ClustersNotCreateImplicitControlPlane tests a control plane is not created
-// when the request is not a create.
-func TestApiV1ClustersNotCreateImplicitControlPlane(t *testing.T) {
+// TestApiV1AdminMonitorShardsPinInvalid tests resources cannot be pinned to a
+// replica that isn't live, or that doesn't exist.
+func TestApiV1AdminMonitorShardsPinInvalid(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2Images(tc)
-	RegisterComputeV2FlavorsDetail(tc)
-	RegisterComputeV2ServerGroups(tc)
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
-	mustCreateControlPlaneApplicationBundleFixture(t, tc)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateMonitorShardMemberFixture(t, tc)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), "foo", "foo")
+	pin := generated.MonitorShardPin{
+		Kind:      generated.MonitorShardResourceKindKubernetesCluster,
+		Namespace: controlPlane.Status.Namespace,
+		Name:      "foo",
+		Replica:   util.ToPointer("unikorn-monitor-1"),
+	}
+
+	response, err := unikornClient.PutApiV1AdminMonitorShardsWithResponse(context.TODO(), generated.MonitorShardPins{pin})
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode())
+	assert.NotNil(t, response.JSON400)
+
+	pin.Name = "bar"
+	pin.Replica = util.ToPointer(monitorShardReplica)
+
+	response, err = unikornClient.PutApiV1AdminMonitorShardsWithResponse(context.TODO(), generated.MonitorShardPins{pin})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode())
 	assert.NotNil(t, response.JSON404)
+}
 
-	serverErr := *response.JSON404
+// TestApiV1AdminMonitorShardsUnauthorized tests a user without administrative
+// privileges cannot view monitor shards.
+func TestApiV1AdminMonitorShardsUnauthorized(t *testing.T) {
+	t.Parallel()
 
-	assert.Equal(t, generated.NotFound, serverErr.Error)
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
 
-	var resource unikornv1.ControlPlane
+	tc.Openstack().RegisterIdentityHandlers()
 
-	assert.Error(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1AdminMonitorShards(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidScope)
 }
 
-// TestApiV1ClustersGet tests a cluster can be referenced by name.
-func TestApiV1ClustersGet(t *testing.T) {
+const (
+	inventoryApplicationCredentialID = "a3c0a9e1b5d34f6e8c1f2d7b9e0a4c6d"
+)
+
+// mustSetupInventoryFixtures creates a project, control plane and cluster, with
+// the cluster using an application credential.
+func mustSetupInventoryFixtures(t *testing.T, tc *TestContext) {
+	t.Helper()
+
+	mustCreateControlPlaneApplicationBundleFixture(t, tc)
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	var cluster unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &cluster))
+
+	clientConfig := &clientconfig.Clouds{
+		Clouds: map[string]clientconfig.Cloud{
+			"cloud": {
+				AuthType: clientconfig.AuthV3ApplicationCredential,
+				AuthInfo: &clientconfig.AuthInfo{
+					ApplicationCredentialID:     inventoryApplicationCredentialID,
+					ApplicationCredentialSecret: "hunter2",
+				},
+			},
+		},
+	}
+
+	cloudConfig, err := yaml.Marshal(clientConfig)
+	assert.NoError(t, err)
+
+	cluster.Spec.Openstack.Cloud = util.ToPointer("cloud")
+	cluster.Spec.Openstack.CloudConfig = &cloudConfig
+
+	assert.NoError(t, tc.KubernetesClient().Update(context.TODO(), &cluster))
+}
+
+// mustExportInventory returns a page of inventory records, and the cursor to get
+// the next page with, if any.
+func mustExportInventory(t *testing.T, unikornClient *generated.ClientWithResponses, params *generated.GetApiV1AdminExportParams) ([]generated.InventoryRecord, string) {
+	t.Helper()
+
+	response, err := unikornClient.GetApiV1AdminExportWithResponse(context.TODO(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+	assert.Equal(t, "application/x-ndjson", response.HTTPResponse.Header.Get("Content-Type"))
+
+	var records []generated.InventoryRecord
+
+	decoder := json.NewDecoder(bytes.NewReader(response.Body))
+
+	for decoder.More() {
+		var record generated.InventoryRecord
+
+		assert.NoError(t, decoder.Decode(&record))
+
+		records = append(records, record)
+	}
+
+	return records, response.HTTPResponse.Header.Get("X-Unikorn-Continue")
+}
+
+// TestApiV1AdminExport tests administrators can export the platform inventory,
+// and that secrets are redacted.
+func TestApiV1AdminExport(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustSetupInventoryFixtures(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	records, next := mustExportInventory(t, unikornClient, &generated.GetApiV1AdminExportParams{})
+	assert.Empty(t, next)
+	assert.Len(t, records, 6)
+
+	kinds := make([]generated.InventoryRecordKind, len(records))
+
+	for i := range records {
+		kinds[i] = records[i].Kind
+
+		assert.NotEmpty(t, records[i].Cursor)
+	}
+
+	expected := []generated.InventoryRecordKind{
+		generated.InventoryRecordKindProject,
+		generated.InventoryRecordKindControlPlane,
+		generated.InventoryRecordKindKubernetesCluster,
+		generated.InventoryRecordKindApplicationCredential,
+		generated.InventoryRecordKindApplicationBundle,
+		generated.InventoryRecordKindApplicationBundle,
+	}
+
+	assert.Equal(t, expected, kinds)
+
+	controlPlane := records[1].Resource
+	assert.NotNil(t, controlPlane)
+	assert.Equal(t, "foo", controlPlane.Status.Name)
+	assert.NotNil(t, controlPlane.Project)
+	assert.Equal(t, projectNameFromID(projectID), *controlPlane.Project)
+	assert.NotNil(t, controlPlane.ApplicationBundle)
+	assert.Equal(t, controlPlaneApplicationBundleName, *controlPlane.ApplicationBundle)
+
+	cluster := records[2].Resource
+	assert.NotNil(t, cluster)
+	assert.Equal(t, "foo", cluster.Status.Name)
+	assert.Equal(t, "Provisioned", cluster.Status.Status)
+	assert.NotNil(t, cluster.ControlPlane)
+	assert.Equal(t, "foo", *cluster.ControlPlane)
+
+	openstack, ok := cluster.Spec["openstack"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, logging.Redacted, openstack["cloudConfig"])
+
+	credential := records[3].ApplicationCredential
+	assert.NotNil(t, credential)
+	assert.Equal(t, inventoryApplicationCredentialID, credential.Id)
+	assert.Equal(t, projectNameFromID(projectID), credential.Project)
+	assert.Equal(t, "foo", credential.ControlPlane)
+	assert.Equal(t, "foo", credential.KubernetesCluster)
+
+	for _, record := range records[4:] {
+		assert.NotNil(t, record.ApplicationBundle)
+		assert.Equal(t, 1, record.ApplicationBundle.Resources)
+	}
+}
+
+// TestApiV1AdminExportPaginated tests the inventory can be exported in pages, and
+// resumed from any record.
+func TestApiV1AdminExportPaginated(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustSetupInventoryFixtures(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	all, _ := mustExportInventory(t, unikornClient, &generated.GetApiV1AdminExportParams{})
+
+	var records []generated.InventoryRecord
+
+	params := &generated.GetApiV1AdminExportParams{
+		Limit: util.ToPointer(4),
+	}
+
+	for {
+		page, next := mustExportInventory(t, unikornClient, params)
+		assert.LessOrEqual(t, len(page), 4)
+
+		records = append(records, page...)
+
+		if next == "" {
+			break
+		}
+
+		params.Continue = util.ToPointer(next)
+	}
+
+	assert.Equal(t, all, records)
+
+	// An interrupted export can resume from the last record received.
+	resumed, next := mustExportInventory(t, unikornClient, &generated.GetApiV1AdminExportParams{
+		Continue: util.ToPointer(all[2].Cursor),
+	})
+	assert.Empty(t, next)
+	assert.Equal(t, all[3:], resumed)
+}
+
+// TestApiV1AdminExportInvalidContinue tests a malformed cursor is rejected.
+func TestApiV1AdminExportInvalidContinue(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	setupOpenstackAdminFixtures(tc.Openstack())
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1AdminExport(context.TODO(), &generated.GetApiV1AdminExportParams{
+		Continue: util.ToPointer("!invalid"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidRequest)
+}
+
+// TestApiV1AdminExportUnauthorized tests a user without administrative
+// privileges cannot export the platform inventory.
+func TestApiV1AdminExportUnauthorized(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2Images(tc)
-	RegisterComputeV2FlavorsDetail(tc)
-	RegisterComputeV2ServerGroups(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1AdminExport(context.TODO(), &generated.GetApiV1AdminExportParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidScope)
+}
+
+// mustSetupActivityFixtures creates a project, control plane and cluster with
+// some history.
+func mustSetupActivityFixtures(t *testing.T, tc *TestContext) {
+	t.Helper()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
 	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
-	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+	mustUpdateKubernetesClusterActivityFixture(t, tc, controlPlane.Status.Namespace, "foo")
+}
+
+// TestApiV1Activity tests project activity is reported, most recent first.
+func TestApiV1Activity(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustSetupActivityFixtures(t, tc)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo")
+	response, err := unikornClient.GetApiV1ActivityWithResponse(context.TODO(), &generated.GetApiV1ActivityParams{})
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+
+	feed := response.JSON200
+
+	// Each of the project, control plane and cluster is created and has a
+	// status, plus the cluster has an upgrade pending approval.
+	assert.Len(t, feed.Items, 7)
+	assert.Nil(t, feed.Continue)
+
+	upgrade := feed.Items[0]
+	assert.Equal(t, generated.UpgradeApprovalRequested, upgrade.Type)
+	assert.Equal(t, generated.ActivityResourceKindKubernetesCluster, upgrade.Kind)
+	assert.Equal(t, "foo", upgrade.Name)
+	assert.Equal(t, util.ToPointer("foo"), upgrade.ControlPlaneName)
+	assert.True(t, activityApprovalTime.Equal(upgrade.Time))
+
+	status := feed.Items[1]
+	assert.Equal(t, generated.StatusChanged, status.Type)
+	assert.Equal(t, generated.ActivityResourceKindKubernetesCluster, status.Kind)
+	assert.Equal(t, util.ToPointer(string(coreunikornv1.ConditionReasonProvisioned)), status.Reason)
+	assert.True(t, activityTime.Equal(status.Time))
+}
 
-	result := *response.JSON200
+// TestApiV1ActivityFilter tests activity can be filtered by type and time.
+func TestApiV1ActivityFilter(t *testing.T) {
+	t.Parallel()
 
-	assert.Equal(t, "foo", result.Name)
-	assert.NotNil(t, result.Status)
-	assert.Equal(t, "Provisioned", result.Status.Status)
-	assert.Equal(t, kubernetesClusterApplicationBundleName, result.ApplicationBundle.Name)
-	assert.Equal(t, kubernetesClusterApplicationBundleVersion, result.ApplicationBundle.Version)
-	assert.Equal(t, clusterComputeFailureDomain, result.Openstack.ComputeAvailabilityZone)
-	assert.Equal(t, clusterStorageFailureDomain, result.Openstack.VolumeAvailabilityZone)
-	assert.Equal(t, clusterExternalNetworkID, result.Openstack.ExternalNetworkID)
-	assert.NotNil(t, result.Openstack.SshKeyName)
-	assert.Equal(t, clusterSSHKeyName, *result.Openstack.SshKeyName)
-	assert.Equal(t, clusterNodeNetwork, result.Network.NodePrefix)
-	assert.Equal(t, clusterServiceNetwork, result.Network.ServicePrefix)
-	assert.Equal(t, clusterPodNetwork, result.Network.PodPrefix)
-	assert.Len(t, result.Network.DnsNameservers, 1)
-	assert.Equal(t, clusterDNSNameserver, result.Network.DnsNameservers[0])
-	assert.Equal(t, "v"+imageK8sVersion, result.ControlPlane.Version)
-	assert.Equal(t, imageName, result.ControlPlane.ImageName)
-	assert.Equal(t, flavorName, result.ControlPlane.FlavorName)
-	assert.Equal(t, clusterControlPlaneReplicas, result.ControlPlane.Replicas)
-	assert.Len(t, result.WorkloadPools, 1)
-	assert.Equal(t, clusterWorkloadPoolName, result.WorkloadPools[0].Name)
-	assert.Equal(t, "v"+imageK8sVersion, result.WorkloadPools[0].Machine.Version)
-	assert.Equal(t, imageName, result.WorkloadPools[0].Machine.ImageName)
-	assert.Equal(t, flavorName, result.WorkloadPools[0].Machine.FlavorName)
-	assert.Equal(t, clusterWorkloadPoolReplicas, result.WorkloadPools[0].Machine.Replicas)
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustSetupActivityFixtures(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	params := &generated.GetApiV1ActivityParams{
+		Type:  &generated.ActivityTypeParameter{generated.StatusChanged, generated.UpgradeApprovalRequested},
+		Since: util.ToPointer(activityTime),
+		Until: util.ToPointer(activityApprovalTime),
+	}
+
+	response, err := unikornClient.GetApiV1ActivityWithResponse(context.TODO(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+
+	feed := response.JSON200
+
+	assert.Len(t, feed.Items, 1)
+	assert.Equal(t, generated.StatusChanged, feed.Items[0].Type)
+	assert.Equal(t, generated.ActivityResourceKindKubernetesCluster, feed.Items[0].Kind)
 }
 
-// TestApiV1ClustersGetNotFound tests a request for a non-existent cluster returns the
-// correct error.
-func TestApiV1ClustersGetNotFound(t *testing.T) {
+// TestApiV1ActivityPagination tests activity can be paged through.
+func TestApiV1ActivityPagination(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	mustSetupActivityFixtures(t, tc)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	params := &generated.GetApiV1ActivityParams{
+		Limit: util.ToPointer(4),
+	}
+
+	response, err := unikornClient.GetApiV1ActivityWithResponse(context.TODO(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+	assert.Len(t, response.JSON200.Items, 4)
+	assert.NotNil(t, response.JSON200.Continue)
+
+	params.Continue = response.JSON200.Continue
+
+	response, err = unikornClient.GetApiV1ActivityWithResponse(context.TODO(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+	assert.Len(t, response.JSON200.Items, 3)
+	assert.Nil(t, response.JSON200.Continue)
+}
+
+// TestApiV1ActivityInvalidContinue tests a bad continue parameter is rejected.
+func TestApiV1ActivityInvalidContinue(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	params := &generated.GetApiV1ActivityParams{
+		Continue: util.ToPointer("foo"),
+	}
+
+	response, err := unikornClient.GetApiV1Activity(context.TODO(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidRequest)
+}
+
+// TestApiV1ActivityNoProject tests there is no activity before the project is created.
+func TestApiV1ActivityNoProject(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.GetApiV1ActivityWithResponse(context.TODO(), &generated.GetApiV1ActivityParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+	assert.Empty(t, response.JSON200.Items)
+}
+
+// TestApiV1ProjectOffboarding tests a project can be torn down in stages, and
+// that a usage report is generated.
+func TestApiV1ProjectOffboarding(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
 	project := mustCreateProjectFixture(t, tc, projectID)
 	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+
+	tc.Openstack().AddApplicationCredential(openstackmock.ApplicationCredential{
+		ID:          "managed",
+		Name:        "foo-foo-01234567",
+		Description: "Automatically generated by platform service [DO NOT DELETE]. cluster=" + project.Name + "/" + controlPlane.Name + "/foo",
+	})
+
+	tc.Openstack().AddApplicationCredential(openstackmock.ApplicationCredential{
+		ID:   "unmanaged",
+		Name: "bar",
+	})
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo")
+	startResponse, err := unikornClient.PostApiV1ProjectOffboardingWithResponse(context.TODO())
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON404)
+	assert.Equal(t, http.StatusAccepted, startResponse.StatusCode())
 
-	result := *response.JSON404
+	response, err := unikornClient.GetApiV1ProjectOffboardingWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
+
+	status := response.JSON200
+	assert.Nil(t, status.Stage)
+	assert.Equal(t, util.ToPointer(generated.ProjectOffboardingStageClusters), status.NextStage)
+	assert.Equal(t, 1, status.RemainingControlPlanes)
+	assert.Equal(t, 1, status.RemainingClusters)
+	assert.Len(t, status.Usage.Clusters, 1)
+
+	// Stages must be confirmed in order.
+	confirm := func(stage generated.ProjectOffboardingStage) *generated.PostApiV1ProjectOffboardingConfirmResponse {
+		request := generated.ProjectOffboardingConfirmation{
+			Stage: stage,
+		}
 
-	assert.Equal(t, generated.NotFound, result.Error)
+		response, err := unikornClient.PostApiV1ProjectOffboardingConfirmWithResponse(context.TODO(), request)
+		assert.NoError(t, err)
+
+		return response
+	}
+
+	confirmResponse := confirm(generated.ProjectOffboardingStageControlPlanes)
+	assert.Equal(t, http.StatusBadRequest, confirmResponse.StatusCode())
+
+	confirmResponse = confirm(generated.ProjectOffboardingStageClusters)
+	assert.Equal(t, http.StatusOK, confirmResponse.StatusCode())
+	assert.Equal(t, 0, confirmResponse.JSON200.RemainingClusters)
+	assert.Len(t, confirmResponse.JSON200.Usage.Clusters, 1)
+
+	// Retrying the current stage is allowed.
+	confirmResponse = confirm(generated.ProjectOffboardingStageClusters)
+	assert.Equal(t, http.StatusOK, confirmResponse.StatusCode())
+
+	confirmResponse = confirm(generated.ProjectOffboardingStageControlPlanes)
+	assert.Equal(t, http.StatusOK, confirmResponse.StatusCode())
+	assert.Equal(t, 0, confirmResponse.JSON200.RemainingControlPlanes)
+
+	var ids []string
+
+	for _, credential := range tc.Openstack().ApplicationCredentials() {
+		ids = append(ids, credential.ID)
+	}
+
+	assert.Contains(t, ids, "unmanaged")
+	assert.NotContains(t, ids, "managed")
+
+	confirmResponse = confirm(generated.ProjectOffboardingStageProject)
+	assert.Equal(t, http.StatusOK, confirmResponse.StatusCode())
+	assert.Equal(t, util.ToPointer(generated.ProjectOffboardingStageProject), confirmResponse.JSON200.Stage)
+	assert.Nil(t, confirmResponse.JSON200.NextStage)
+
+	// The final report is retained after the resources are gone.
+	usage := confirmResponse.JSON200.Usage
+	assert.Len(t, usage.Clusters, 1)
+	assert.Equal(t, controlPlane.Name, usage.Clusters[0].ControlPlaneName)
+	assert.Equal(t, "foo", usage.Clusters[0].Name)
+	assert.Len(t, usage.Clusters[0].Machines, 2)
+	assert.Equal(t, "control-plane", usage.Clusters[0].Machines[0].PoolName)
+	assert.Equal(t, clusterControlPlaneReplicas, usage.Clusters[0].Machines[0].Replicas)
+	assert.Equal(t, clusterWorkloadPoolName, usage.Clusters[0].Machines[1].PoolName)
+	assert.Equal(t, clusterWorkloadPoolReplicas, usage.Clusters[0].Machines[1].Replicas)
+	assert.Len(t, usage.Flavors, 1)
+	assert.Equal(t, flavorName, usage.Flavors[0].FlavorName)
+	assert.InDelta(t, usage.Clusters[0].Hours*(clusterControlPlaneReplicas+clusterWorkloadPoolReplicas), usage.Flavors[0].MachineHours, 0.001)
+
+	response, err = unikornClient.GetApiV1ProjectOffboardingWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode())
 }
 
-// TestApiV1ClustersList tests clusters can be listed.
-func TestApiV1ClustersList(t *testing.T) {
+// TestApiV1ProjectOffboardingConflict tests offboarding cannot be started twice.
+func TestApiV1ProjectOffboardingConflict(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2Images(tc)
-	RegisterComputeV2FlavorsDetail(tc)
-	RegisterComputeV2ServerGroups(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
-	project := mustCreateProjectFixture(t, tc, projectID)
-	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
-	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
-	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+	mustCreateProjectFixture(t, tc, projectID)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ControlplanesControlPlaneNameClustersWithResponse(context.TODO(), controlPlane.Name)
+	response, err := unikornClient.PostApiV1ProjectOffboardingWithResponse(context.TODO())
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
-
-	results := *response.JSON200
+	assert.Equal(t, http.StatusAccepted, response.StatusCode())
 
-	assert.Len(t, results, 1)
-	assert.Equal(t, "foo", results[0].Name)
-	assert.NotNil(t, results[0].Status)
-	assert.Equal(t, "Provisioned", results[0].Status.Status)
-	assert.Equal(t, kubernetesClusterApplicationBundleName, results[0].ApplicationBundle.Name)
-	assert.Equal(t, kubernetesClusterApplicationBundleVersion, results[0].ApplicationBundle.Version)
-	assert.Equal(t, clusterComputeFailureDomain, results[0].Openstack.ComputeAvailabilityZone)
-	assert.Equal(t, clusterStorageFailureDomain, results[0].Openstack.VolumeAvailabilityZone)
-	assert.Equal(t, clusterExternalNetworkID, results[0].Openstack.ExternalNetworkID)
-	assert.NotNil(t, results[0].Openstack.SshKeyName)
-	assert.Equal(t, clusterSSHKeyName, *results[0].Openstack.SshKeyName)
-	assert.Equal(t, clusterNodeNetwork, results[0].Network.NodePrefix)
-	assert.Equal(t, clusterServiceNetwork, results[0].Network.ServicePrefix)
-	assert.Equal(t, clusterPodNetwork, results[0].Network.PodPrefix)
-	assert.Len(t, results[0].Network.DnsNameservers, 1)
-	assert.Equal(t, clusterDNSNameserver, results[0].Network.DnsNameservers[0])
-	assert.Equal(t, "v"+imageK8sVersion, results[0].ControlPlane.Version)
-	assert.Equal(t, imageName, results[0].ControlPlane.ImageName)
-	assert.Equal(t, flavorName, results[0].ControlPlane.FlavorName)
-	assert.Equal(t, clusterControlPlaneReplicas, results[0].ControlPlane.Replicas)
-	assert.Len(t, results[0].WorkloadPools, 1)
-	assert.Equal(t, clusterWorkloadPoolName, results[0].WorkloadPools[0].Name)
-	assert.Equal(t, "v"+imageK8sVersion, results[0].WorkloadPools[0].Machine.Version)
-	assert.Equal(t, imageName, results[0].WorkloadPools[0].Machine.ImageName)
-	assert.Equal(t, flavorName, results[0].WorkloadPools[0].Machine.FlavorName)
-	assert.Equal(t, clusterWorkloadPoolReplicas, results[0].WorkloadPools[0].Machine.Replicas)
+	response, err = unikornClient.PostApiV1ProjectOffboardingWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode())
 }
 
-// TestApiV1ClustersUpdate tests clusters can be updated.
-func TestApiV1ClustersUpdate(t *testing.T) {
+// TestApiV1ProjectOffboardingBlocksCreation tests no new control planes can be
+// created once offboarding has started.
+func TestApiV1ProjectOffboardingBlocksCreation(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2Images(tc)
-	RegisterComputeV2FlavorsDetail(tc)
-	RegisterComputeV2ServerGroups(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
-	project := mustCreateProjectFixture(t, tc, projectID)
-	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
-	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
+	mustCreateProjectFixture(t, tc, projectID)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	request := &generated.KubernetesCluster{
+	startResponse, err := unikornClient.PostApiV1ProjectOffboardingWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, startResponse.StatusCode())
+
+	request := &generated.ControlPlane{
 		Name: "foo",
 		ApplicationBundle: generated.ApplicationBundle{
 			Name: "foo",
 		},
-		Network: generated.KubernetesClusterNetwork{
-			DnsNameservers: []string{
-				"8.8.8.8",
-			},
-			NodePrefix:    "192.168.0.0/24",
-			ServicePrefix: "172.16.0.0/12",
-			PodPrefix:     "10.0.0.0/8",
-		},
-		ControlPlane: generated.OpenstackMachinePool{
-			Version:    "v1.28.0",
-			Replicas:   3,
-			ImageName:  "ubuntu-24.04-lts",
-			FlavorName: flavorName,
-		},
-		WorkloadPools: generated.KubernetesClusterWorkloadPools{
-			{
-				Name: "foo",
-				Machine: generated.OpenstackMachinePool{
-					Version:    "v1.28.0",
-					Replicas:   3,
-					ImageName:  "ubuntu-24.04-lts",
-					FlavorName: flavorName,
-				},
-			},
-		},
 	}
 
-	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBody(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(request))
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/json", NewJSONReader(request))
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
 
 	defer response.Body.Close()
 
-	var resource unikornv1.KubernetesCluster
-
-	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource))
-	assert.Equal(t, *resource.Spec.ApplicationBundle, "foo")
+	AssertOauth2Error(t, response, generated.InvalidRequest)
 }
 
-// TestApiV1ClustersUpdateNotFound tests clusters return the correct error if they don't
-// exist on update.
-func TestApiV1ClustersUpdateNotFound(t *testing.T) {
+// TestApiV1ProjectOffboardingNotStarted tests there is no status until
+// offboarding has been started.
+func TestApiV1ProjectOffboardingNotStarted(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2Images(tc)
-	RegisterComputeV2FlavorsDetail(tc)
-	RegisterComputeV2ServerGroups(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
-	project := mustCreateProjectFixture(t, tc, projectID)
-	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateProjectFixture(t, tc, projectID)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	request := &generated.KubernetesCluster{
-		Name: "foo",
-		ApplicationBundle: generated.ApplicationBundle{
-			Name: "foo",
-		},
-		Network: generated.KubernetesClusterNetwork{
-			DnsNameservers: []string{
-				"8.8.8.8",
-			},
-			NodePrefix:    "192.168.0.0/24",
-			ServicePrefix: "172.16.0.0/12",
-			PodPrefix:     "10.0.0.0/8",
-		},
-		ControlPlane: generated.OpenstackMachinePool{
-			Version:    "v1.28.0",
-			Replicas:   3,
-			ImageName:  "ubuntu-24.04-lts",
-			FlavorName: flavorName,
-		},
-		WorkloadPools: generated.KubernetesClusterWorkloadPools{
-			{
-				Name: "foo",
-				Machine: generated.OpenstackMachinePool{
-					Version:    "v1.28.0",
-					Replicas:   3,
-					ImageName:  "ubuntu-24.04-lts",
-					FlavorName: flavorName,
-				},
-			},
-		},
-	}
-
-	response, err := unikornClient.PutApiV1ControlplanesControlPlaneNameClustersClusterNameWithBodyWithResponse(context.TODO(), controlPlane.Name, "foo", "application/json", NewJSONReader(request))
+	response, err := unikornClient.GetApiV1ProjectOffboardingWithResponse(context.TODO())
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON404)
-
-	serverErr := *response.JSON404
-
-	assert.Equal(t, serverErr.Error, generated.NotFound)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode())
 }
 
-// TestApiV1ClustersDelete tests clusters can be deleted.
-func TestApiV1ClustersDelete(t *testing.T) {
+// TestApiV1ProjectReaderForbidden tests readers can view but not modify resources.
+func TestApiV1ProjectReaderForbidden(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	setupOpenstackProjectRoleFixtures(tc.Openstack(), projectReaderRoleID, projectReaderRole)
 
 	project := mustCreateProjectFixture(t, tc, projectID)
-	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
-	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "foo")
-	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.DeleteApiV1ControlplanesControlPlaneNameClustersClusterName(context.TODO(), controlPlane.Name, "foo")
+	listResponse, err := unikornClient.GetApiV1ActivityWithResponse(context.TODO(), &generated.GetApiV1ActivityParams{})
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+	assert.Equal(t, http.StatusOK, listResponse.StatusCode())
+
+	response, err := unikornClient.DeleteApiV1ControlplanesControlPlaneName(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
 
 	defer response.Body.Close()
 
-	var resource unikornv1.KubernetesCluster
+	AssertOauth2Error(t, response, generated.Forbidden)
 
-	var statusErr *kerrors.StatusError
+	var resource unikornv1.ControlPlane
 
-	assert.ErrorAs(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: "foo"}, &resource), &statusErr)
-	assert.Equal(t, http.StatusNotFound, int(statusErr.ErrStatus.Code))
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
 }
 
-// TestApiV1ClustersDeleteNotFound tests that the deletion of a non-existent cluster
-// results in the correct error.
-func TestApiV1ClustersDeleteNotFound(t *testing.T) {
+// TestApiV1ProjectMembers tests project members can be listed.
+func TestApiV1ProjectMembers(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
-	project := mustCreateProjectFixture(t, tc, projectID)
-	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	setupOpenstackMemberFixtures(tc.Openstack(), projectReaderRoleID)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.DeleteApiV1ControlplanesControlPlaneNameClustersClusterNameWithResponse(context.TODO(), controlPlane.Name, "foo")
+	response, err := unikornClient.GetApiV1ProjectMembersWithResponse(context.TODO())
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusNotFound, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON404)
+	assert.Equal(t, http.StatusOK, response.StatusCode())
 
-	serverErr := *response.JSON404
+	members := *response.JSON200
 
-	assert.Equal(t, serverErr.Error, generated.NotFound)
+	assert.Len(t, members, 2)
+	assert.Equal(t, memberUserID, members[0].UserId)
+	assert.Equal(t, memberUserName, members[0].UserName)
+	assert.Equal(t, generated.Reader, members[0].Role)
+	assert.Equal(t, userID, members[1].UserId)
+	assert.Equal(t, generated.Editor, members[1].Role)
 }
 
-// TestApiV1ApplicationBundlesListControlPlane tests control plane application bundles can
-// be listed.
-func TestApiV1ApplicationBundlesListControlPlane(t *testing.T) {
+// TestApiV1ProjectMembersInvite tests a project admin can add users to the project.
+func TestApiV1ProjectMembersInvite(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
-	mustCreateControlPlaneApplicationBundleFixture(t, tc)
+	setupOpenstackProjectRoleFixtures(tc.Openstack(), projectAdminRoleID, projectAdminRole)
+
+	tc.Openstack().AddUser(openstackmock.User{
+		ID:   memberUserID,
+		Name: memberUserName,
+	})
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ApplicationbundlesControlPlaneWithResponse(context.TODO())
+	request := generated.ProjectMemberInvitation{
+		UserName: memberUserName,
+		Role:     generated.Editor,
+	}
+
+	response, err := unikornClient.PostApiV1ProjectMembersWithResponse(context.TODO(), request)
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode())
 
-	results := *response.JSON200
+	assert.Contains(t, tc.Openstack().RoleAssignments(), openstackmock.RoleAssignment{
+		ProjectID: projectID,
+		UserID:    memberUserID,
+		RoleID:    projectEditorRoleID,
+	})
 
-	assert.Len(t, results, 1)
-	assert.Equal(t, controlPlaneApplicationBundleName, results[0].Name)
-	assert.Equal(t, controlPlaneApplicationBundleVersion, results[0].Version)
+	response, err = unikornClient.PostApiV1ProjectMembersWithResponse(context.TODO(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode())
 }
 
-// TestApiV1ApplicationBundlesListCluster tests cluster application bundles can be listed.
-func TestApiV1ApplicationBundlesListCluster(t *testing.T) {
+// TestApiV1ProjectMembersInviteNotFound tests users must exist to be added.
+func TestApiV1ProjectMembersInviteNotFound(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
-	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+	setupOpenstackProjectRoleFixtures(tc.Openstack(), projectAdminRoleID, projectAdminRole)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ApplicationbundlesClusterWithResponse(context.TODO())
-	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
-
-	results := *response.JSON200
+	request := generated.ProjectMemberInvitation{
+		UserName: memberUserName,
+		Role:     generated.Editor,
+	}
 
-	assert.Len(t, results, 1)
-	assert.Equal(t, kubernetesClusterApplicationBundleName, results[0].Name)
-	assert.Equal(t, kubernetesClusterApplicationBundleVersion, results[0].Version)
+	response, err := unikornClient.PostApiV1ProjectMembersWithResponse(context.TODO(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode())
 }
 
-// TestApiV1ApplicationsList tests applications can be listed.
-func TestApiV1ApplicationsList(t *testing.T) {
+// TestApiV1ProjectMembersInviteUnauthorized tests editors cannot manage members.
+func TestApiV1ProjectMembersInviteUnauthorized(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
-	mustCreateHelmApplicationFixture(t, tc)
+	tc.Openstack().AddUser(openstackmock.User{
+		ID:   memberUserID,
+		Name: memberUserName,
+	})
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ApplicationsWithResponse(context.TODO())
+	request := generated.ProjectMemberInvitation{
+		UserName: memberUserName,
+		Role:     generated.Admin,
+	}
+
+	response, err := unikornClient.PostApiV1ProjectMembers(context.TODO(), request)
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
 
-	results := *response.JSON200
+	defer response.Body.Close()
 
-	assert.Len(t, results, 1)
-	assert.Equal(t, applicationName, results[0].Name)
-	assert.Equal(t, applicationHumanReadableName, results[0].HumanReadableName)
-	assert.Equal(t, applicationDescription, results[0].Description)
-	assert.Equal(t, applicationDocumentation, results[0].Documentation)
-	assert.Equal(t, applicationLicense, results[0].License)
-	assert.Equal(t, []byte(applicationIcon), results[0].Icon)
-	assert.Len(t, results[0].Versions, 1)
-	assert.Equal(t, applicationVersion, results[0].Versions[0].Version)
+	AssertOauth2Error(t, response, generated.InvalidScope)
+
+	assert.Len(t, tc.Openstack().RoleAssignments(), 1)
 }
 
-// TestApiV1ProvidersOpenstackProjects tests OpenStack projects can be listed.
-func TestApiV1ProvidersOpenstackProjects(t *testing.T) {
+// TestApiV1ProjectMembersUpdate tests a member's role can be replaced.
+func TestApiV1ProjectMembersUpdate(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	setupOpenstackProjectRoleFixtures(tc.Openstack(), projectAdminRoleID, projectAdminRole)
+	setupOpenstackMemberFixtures(tc.Openstack(), projectReaderRoleID)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackProjectsWithResponse(context.TODO())
+	request := generated.ProjectMemberRole{
+		Role: generated.Editor,
+	}
+
+	response, err := unikornClient.PutApiV1ProjectMembersUserIDWithResponse(context.TODO(), memberUserID, request)
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode())
 
-	results := *response.JSON200
+	assignments := tc.Openstack().RoleAssignments()
 
-	assert.Len(t, results, 1)
-	assert.Equal(t, projectID, results[0].Id)
-	assert.Equal(t, projectName, results[0].Name)
+	assert.Contains(t, assignments, openstackmock.RoleAssignment{
+		ProjectID: projectID,
+		UserID:    memberUserID,
+		RoleID:    projectEditorRoleID,
+	})
+
+	assert.NotContains(t, assignments, openstackmock.RoleAssignment{
+		ProjectID: projectID,
+		UserID:    memberUserID,
+		RoleID:    projectReaderRoleID,
+	})
 }
 
-// TestApiV1ProvidersOpenstackProjectsUnauthorized tests an unauthorized response
-// from a request is propagated to the client correctly.
-func TestApiV1ProvidersOpenstackProjectsUnauthorized(t *testing.T) {
+// TestApiV1ProjectMembersRemove tests members can be removed from the project.
+func TestApiV1ProjectMembersRemove(t *testing.T) {
+	t.Parallel()
+
+	tc, cleanup := MustNewTestContext(t)
+	defer cleanup()
+
+	tc.Openstack().RegisterIdentityHandlers()
+
+	setupOpenstackProjectRoleFixtures(tc.Openstack(), projectAdminRoleID, projectAdminRole)
+	setupOpenstackMemberFixtures(tc.Openstack(), projectEditorRoleID)
+
+	unikornClient := MustNewScopedClient(t, tc)
+
+	response, err := unikornClient.DeleteApiV1ProjectMembersUserIDWithResponse(context.TODO(), memberUserID)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode())
+
+	for _, assignment := range tc.Openstack().RoleAssignments() {
+		assert.NotEqual(t, memberUserID, assignment.UserID)
+	}
+
+	response, err = unikornClient.DeleteApiV1ProjectMembersUserIDWithResponse(context.TODO(), memberUserID)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode())
+}
+
+// TestApiV1ProjectMembersRemoveLastAdmin tests the project cannot be left without
+// an administrator.
+func TestApiV1ProjectMembersRemoveLastAdmin(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
-	unikornClient := MustNewScopedClient(t, tc)
+	setupOpenstackProjectRoleFixtures(tc.Openstack(), projectAdminRoleID, projectAdminRole)
 
-	// Override...
-	RegisterIdentityV3AuthProjectsUnauthorized(tc)
+	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackProjectsWithResponse(context.TODO())
+	response, err := unikornClient.DeleteApiV1ProjectMembersUserID(context.TODO(), userID)
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON401)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
 
-	serverErr := *response.JSON401
+	defer response.Body.Close()
 
-	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+	AssertOauth2Error(t, response, generated.InvalidRequest)
 }
 
-// TestApiV1ProvidersOpenstackFlavors tests OpenStack flavors can be listed.
-func TestApiV1ProvidersOpenstackFlavors(t *testing.T) {
+// TestApiV1ProjectRoleBindings tests a project admin can manage role bindings.
+func TestApiV1ProjectRoleBindings(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterComputeV2FlavorsDetail(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	setupOpenstackProjectRoleFixtures(tc.Openstack(), projectAdminRoleID, projectAdminRole)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO())
+	request := generated.ProjectRoleBinding{
+		Name: projectRoleBindingName,
+		Role: generated.Editor,
+		Subjects: []generated.ProjectRoleBindingSubject{
+			{
+				Kind: generated.OidcGroup,
+				Name: oidcGroup,
+			},
+		},
+	}
+
+	createResponse, err := unikornClient.PostApiV1ProjectRolebindingsWithResponse(context.TODO(), request)
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
+	assert.Equal(t, http.StatusAccepted, createResponse.StatusCode())
 
-	results := *response.JSON200
+	createResponse, err = unikornClient.PostApiV1ProjectRolebindingsWithResponse(context.TODO(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, createResponse.StatusCode())
 
-	// NOTE: server converts from MiB to GiB of memory.
-	assert.Len(t, results, 3)
-	assert.Equal(t, flavorID, results[0].Id)
-	assert.Equal(t, flavorName, results[0].Name)
-	assert.Equal(t, flavorCpus, results[0].Cpus)
-	assert.Equal(t, flavorMemory>>10, results[0].Memory)
-	assert.Equal(t, flavorDisk, results[0].Disk)
-	assert.Equal(t, flavorName2, results[1].Name)
-	assert.Equal(t, flavorName3, results[2].Name)
-}
+	var binding unikornv1.ProjectRoleBinding
 
-// TestApiV1ProvidersOpenstackFlavorsUnauthorized tests an unauthorized response
-// from a request is propagated to the client correctly.
-func TestApiV1ProvidersOpenstackFlavorsUnauthorized(t *testing.T) {
-	t.Parallel()
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: projectRoleBindingName}, &binding))
+	assert.Equal(t, "editor", binding.Spec.Role)
+	assert.Equal(t, []unikornv1.ProjectRoleBindingSubject{{Kind: unikornv1.ProjectRoleBindingSubjectOIDCGroup, Name: oidcGroup}}, binding.Spec.Subjects)
 
-	tc, cleanup := MustNewTestContext(t)
-	defer cleanup()
+	request.Role = generated.Reader
+	request.Subjects[0].Kind = generated.KeystoneRole
+	request.Subjects[0].Name = projectRoleBindingRole
 
-	RegisterIdentityHandlers(tc)
-	RegisterComputeV2FlavorsDetailUnauthorized(tc)
+	updateResponse, err := unikornClient.PutApiV1ProjectRolebindingsRoleBindingNameWithResponse(context.TODO(), projectRoleBindingName, request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, updateResponse.StatusCode())
 
-	unikornClient := MustNewScopedClient(t, tc)
+	listResponse, err := unikornClient.GetApiV1ProjectRolebindingsWithResponse(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, listResponse.StatusCode())
+	assert.Equal(t, generated.ProjectRoleBindings{request}, *listResponse.JSON200)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackFlavorsWithResponse(context.TODO())
+	getResponse, err := unikornClient.GetApiV1ProjectRolebindingsRoleBindingNameWithResponse(context.TODO(), projectRoleBindingName)
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON401)
+	assert.Equal(t, http.StatusOK, getResponse.StatusCode())
+	assert.Equal(t, request, *getResponse.JSON200)
 
-	serverErr := *response.JSON401
+	deleteResponse, err := unikornClient.DeleteApiV1ProjectRolebindingsRoleBindingNameWithResponse(context.TODO(), projectRoleBindingName)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, deleteResponse.StatusCode())
 
-	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+	getResponse, err = unikornClient.GetApiV1ProjectRolebindingsRoleBindingNameWithResponse(context.TODO(), projectRoleBindingName)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, getResponse.StatusCode())
 }
 
-// TestApiV1ProvidersOpenstackImages tests OpenStack images can be listed.
-func TestApiV1ProvidersOpenstackImages(t *testing.T) {
+// TestApiV1ProjectRoleBindingsUnauthorized tests editors can view but not
+// manage role bindings.
+func TestApiV1ProjectRoleBindingsUnauthorized(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2Images(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateProjectRoleBindingFixture(t, tc, project.Status.Namespace, unikornv1.ProjectRoleBindingSubjectOIDCGroup, oidcGroup)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackImagesWithResponse(context.TODO())
+	listResponse, err := unikornClient.GetApiV1ProjectRolebindingsWithResponse(context.TODO())
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
-
-	results := *response.JSON200
+	assert.Equal(t, http.StatusOK, listResponse.StatusCode())
+	assert.Len(t, *listResponse.JSON200, 1)
 
-	ts, err := time.Parse(time.RFC3339, imageTimestamp)
+	response, err := unikornClient.DeleteApiV1ProjectRolebindingsRoleBindingName(context.TODO(), projectRoleBindingName)
 	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
 
-	// NOTE: server converts kubernetes version to a proper semver so it's compatible
-	// with the CAPI kubeadm controller.
-	assert.Len(t, results, 2)
-	assert.Equal(t, imageID, results[0].Id)
-	assert.Equal(t, imageName, results[0].Name)
-	assert.Equal(t, ts, results[0].Created)
-	assert.Equal(t, ts, results[0].Modified)
-	assert.Equal(t, "v"+imageK8sVersion, results[0].Versions.Kubernetes)
-	assert.Equal(t, imageGpuVersion, results[0].Versions.NvidiaDriver)
+	defer response.Body.Close()
+
+	AssertOauth2Error(t, response, generated.InvalidScope)
 }
 
-// TestApiV1ProvidersOpenstackImagesUnauthorized tests an unauthorized response
-// from a request is propagated to the client correctly.
-func TestApiV1ProvidersOpenstackImagesUnauthorized(t *testing.T) {
+// TestApiV1ProjectRoleBindingKeystoneRole tests a role binding grants a project
+// role to users with a Keystone role.
+func TestApiV1ProjectRoleBindingKeystoneRole(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterImageV2ImagesUnauthorized(tc)
+	tc.Openstack().RegisterIdentityHandlers()
 
-	unikornClient := MustNewScopedClient(t, tc)
+	tc.Openstack().SetUser(openstackmock.User{
+		ID:    userID,
+		Name:  userName,
+		Email: userEmail,
+		Roles: []string{projectReaderRole, projectRoleBindingRole},
+	})
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackImagesWithResponse(context.TODO())
-	assert.NoError(t, err)
-	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON401)
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateProjectRoleBindingFixture(t, tc, project.Status.Namespace, unikornv1.ProjectRoleBindingSubjectKeystoneRole, projectRoleBindingRole)
 
-	serverErr := *response.JSON401
+	unikornClient := MustNewScopedClient(t, tc)
 
-	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+	response, err := unikornClient.DeleteApiV1ControlplanesControlPlaneNameWithResponse(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode())
 }
 
-// TestApiV1ProvidersOpenstackAvailabilityZonesCompute tests OpenStack compute AZscan be listed.
-func TestApiV1ProvidersOpenstackAvailabilityZonesCompute(t *testing.T) {
+// TestApiV1ProjectRoleBindingOIDCGroup tests a role binding grants a project
+// role to members of an OIDC group.
+func TestApiV1ProjectRoleBindingOIDCGroup(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterComputeV2AvailabilityZone(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterIdentityV3OIDCTokenExchange()
 
-	unikornClient := MustNewScopedClient(t, tc)
+	setupOpenstackProjectRoleFixtures(tc.Openstack(), projectReaderRoleID, projectReaderRole)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse(context.TODO())
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+
+	// Without the binding the reader may not modify resources.
+	unikornClient := MustNewScopedClientFromToken(t, tc, mustNewAuthorizationCodeToken(t, tc).AccessToken)
+
+	response, err := unikornClient.DeleteApiV1ControlplanesControlPlaneNameWithResponse(context.TODO(), "foo")
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
+	assert.Equal(t, http.StatusForbidden, response.StatusCode())
 
-	results := *response.JSON200
+	// Bindings are evaluated when the token is scoped.
+	mustCreateProjectRoleBindingFixture(t, tc, project.Status.Namespace, unikornv1.ProjectRoleBindingSubjectOIDCGroup, oidcGroup)
 
-	assert.Len(t, results, 1)
-	assert.Equal(t, computeAvailabilityZoneName, results[0].Name)
+	unikornClient = MustNewScopedClientFromToken(t, tc, mustNewAuthorizationCodeToken(t, tc).AccessToken)
+
+	response, err = unikornClient.DeleteApiV1ControlplanesControlPlaneNameWithResponse(context.TODO(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode())
 }
 
-// TestApiV1ProvidersOpenstackAvailabilityZonesComputeUnauthorized tests an unauthorized response
-// from a request is propagated to the client correctly.
-func TestApiV1ProvidersOpenstackAvailabilityZonesComputeUnauthorized(t *testing.T) {
+// TestApiV1PolicyForbidden tests operations denied by policy are forbidden, and
+// those not covered by policy are unaffected.
+func TestApiV1PolicyForbidden(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterComputeV2AvailabilityZoneUnauthorized(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "bar")
+	mustCreatePolicyFixture(t, tc)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackAvailabilityZonesComputeWithResponse(context.TODO())
+	request := &generated.ControlPlane{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: "foo",
+		},
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/json", NewJSONReader(request))
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON401)
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
 
-	serverErr := *response.JSON401
+	defer response.Body.Close()
 
-	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+	AssertOauth2Error(t, response, generated.Forbidden)
+
+	var resource unikornv1.ControlPlane
+
+	assert.True(t, kerrors.IsNotFound(tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource)))
+
+	deleteResponse, err := unikornClient.DeleteApiV1ControlplanesControlPlaneName(context.TODO(), "bar")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, deleteResponse.StatusCode)
+
+	defer deleteResponse.Body.Close()
 }
 
-// TestApiV1ProvidersOpenstackAvailabilityZonesBlockStorage tests OpenStack block storage AZscan be listed.
-func TestApiV1ProvidersOpenstackAvailabilityZonesBlockStorage(t *testing.T) {
+// TestApiV1PolicyAllowed tests operations allowed by policy succeed.
+func TestApiV1PolicyAllowed(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterBlockStorageV3AvailabilityZone(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	setupOpenstackProjectRoleFixtures(tc.Openstack(), projectAdminRoleID, projectAdminRole)
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreatePolicyFixture(t, tc)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse(context.TODO())
+	request := &generated.ControlPlane{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: "foo",
+		},
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/json", NewJSONReader(request))
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
 
-	results := *response.JSON200
+	defer response.Body.Close()
 
-	assert.Len(t, results, 1)
-	assert.Equal(t, blockStorageAvailabilityZone, results[0].Name)
+	var resource unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
 }
 
-// TestApiV1ProvidersOpenstackAvailabilityZonesBlockStorageUnauthorized tests an unauthorized response
-// from a request is propagated to the client correctly.
-func TestApiV1ProvidersOpenstackAvailabilityZonesBlockStorageUnauthorized(t *testing.T) {
+// TestApiV1PolicyUpdated tests policy changes take effect without a restart.
+func TestApiV1PolicyUpdated(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterBlockStorageV3AvailabilityZoneUnauthorized(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	mustCreatePolicyFixture(t, tc)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackAvailabilityZonesBlockStorageWithResponse(context.TODO())
+	request := &generated.ControlPlane{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: "foo",
+		},
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/json", NewJSONReader(request))
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON401)
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
 
-	serverErr := *response.JSON401
+	defer response.Body.Close()
 
-	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+	var configMap corev1.ConfigMap
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: policyNamespace, Name: policyName}, &configMap))
+
+	configMap.Data[policy.PolicyKey] = "rules: []\n"
+
+	assert.NoError(t, tc.KubernetesClient().Update(context.TODO(), &configMap))
+
+	allowedResponse, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/json", NewJSONReader(request))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, allowedResponse.StatusCode)
+
+	defer allowedResponse.Body.Close()
+
+	var resource unikornv1.ControlPlane
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource))
 }
 
-// TestApiV1ProvidersOpenstackExternalNetworks tests OpenStack external networks can be listed.
-func TestApiV1ProvidersOpenstackExternalNetworks(t *testing.T) {
+// TestApiV1PolicyMissing tests a configured policy that doesn't exist fails
+// closed, rather than allowing everything.
+func TestApiV1PolicyMissing(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterNetworkV2Networks(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackExternalNetworksWithResponse(context.TODO())
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: policyNamespace,
+			Name:      policyName,
+		},
+	}
+
+	assert.NoError(t, tc.KubernetesClient().Delete(context.TODO(), configMap))
+
+	request := &generated.ControlPlane{
+		Name: "foo",
+		ApplicationBundle: generated.ApplicationBundle{
+			Name: "foo",
+		},
+	}
+
+	response, err := unikornClient.PostApiV1ControlplanesWithBody(context.TODO(), &generated.PostApiV1ControlplanesParams{}, "application/json", NewJSONReader(request))
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON200)
+	assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
 
-	results := *response.JSON200
+	defer response.Body.Close()
 
-	assert.Len(t, results, 1)
-	assert.Equal(t, externalNetworkID, results[0].Id)
+	var resource unikornv1.ControlPlane
+
+	assert.True(t, kerrors.IsNotFound(tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: project.Status.Namespace, Name: "foo"}, &resource)))
 }
 
-// TestApiV1ProvidersOpenstackExternalNetworksUnauthorized tests an unauthorized response
-// from a request is propagated to the client correctly.
-func TestApiV1ProvidersOpenstackExternalNetworksUnauthorized(t *testing.T) {
+// TestApiV1Export tests resources are exported without any read only or installation
+// specific fields.
+func TestApiV1Export(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterNetworkV2NetworksUnauthorized(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateKubernetesClusterFixture(t, tc, controlPlane.Status.Namespace, "bar")
+	mustCreateControlPlaneApplicationBundleFixture(t, tc)
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackExternalNetworksWithResponse(context.TODO())
+	response, err := unikornClient.GetApiV1ExportWithResponse(context.TODO())
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON401)
+	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
+	assert.NotNil(t, response.JSON200)
 
-	serverErr := *response.JSON401
+	results := *response.JSON200
 
-	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+	assert.Equal(t, backup.Version, results.Version)
+	assert.Len(t, results.ControlPlanes, 1)
+	assert.Equal(t, "foo", results.ControlPlanes[0].ControlPlane.Name)
+	assert.Nil(t, results.ControlPlanes[0].ControlPlane.Status)
+	assert.Len(t, results.ControlPlanes[0].Clusters, 1)
+	assert.Equal(t, "bar", results.ControlPlanes[0].Clusters[0].Name)
+	assert.Nil(t, results.ControlPlanes[0].Clusters[0].Status)
+	assert.Equal(t, backup.ExternalNetworkIDPlaceholder, results.ControlPlanes[0].Clusters[0].Openstack.ExternalNetworkID)
 }
 
-// TestApiV1ProvidersOpenstackKeyPairs tests OpenStack key pairs can be listed.
-func TestApiV1ProvidersOpenstackKeyPairs(t *testing.T) {
+// TestApiV1Import tests a bundle can be imported, skipping existing resources.
+func TestApiV1Import(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterComputeV2Keypairs(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+	tc.Openstack().RegisterImageV2Images()
+	tc.Openstack().RegisterComputeV2FlavorsDetail()
+	tc.Openstack().RegisterComputeV2ServerGroups()
+	tc.Openstack().RegisterComputeV2AvailabilityZone()
+	tc.Openstack().RegisterBlockStorageV3AvailabilityZone()
+	tc.Openstack().RegisterQuotaHandlers()
+
+	project := mustCreateProjectFixture(t, tc, projectID)
+	controlPlane := mustCreateControlPlaneFixture(t, tc, project.Status.Namespace, "foo")
+	mustCreateControlPlaneApplicationBundleFixture(t, tc)
+	mustCreateKubernetesClusterApplicationBundleFixture(t, tc)
+
+	cluster := *createClusterRequest
+	cluster.Openstack.ExternalNetworkID = backup.ExternalNetworkIDPlaceholder
+
+	request := &generated.ImportOptions{
+		Bundle: generated.ExportBundle{
+			Version: backup.Version,
+			ControlPlanes: generated.ExportedControlPlanes{
+				{
+					ControlPlane: generated.ControlPlane{
+						Name: controlPlane.Name,
+						ApplicationBundle: generated.ApplicationBundle{
+							Name: controlPlaneApplicationBundleName,
+						},
+					},
+					Clusters: generated.KubernetesClusters{
+						cluster,
+					},
+				},
+			},
+		},
+		ExternalNetworkID: util.ToPointer(externalNetworkID),
+	}
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackKeyPairsWithResponse(context.TODO())
+	response, err := unikornClient.PostApiV1ImportWithResponse(context.TODO(), *request)
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, response.HTTPResponse.StatusCode)
 	assert.NotNil(t, response.JSON200)
 
 	results := *response.JSON200
 
-	assert.Len(t, results, 1)
-	assert.Equal(t, keyPairName, results[0].Name)
+	assert.Len(t, results, 2)
+	assert.False(t, results[0].Created)
+	assert.True(t, results[1].Created)
+
+	var resource unikornv1.KubernetesCluster
+
+	assert.NoError(t, tc.KubernetesClient().Get(context.TODO(), client.ObjectKey{Namespace: controlPlane.Status.Namespace, Name: cluster.Name}, &resource))
+	assert.Equal(t, externalNetworkID, *resource.Spec.Openstack.ExternalNetworkID)
 }
 
-// TestApiV1ProvidersOpenstackKeyPairsUnauthorized tests an unauthorized response
-// from a request is propagated to the client correctly.
-func TestApiV1ProvidersOpenstackKeyPairsUnauthorized(t *testing.T) {
+// TestApiV1ImportMissingReference tests an import fails when placeholders cannot
+// be substituted.
+func TestApiV1ImportMissingReference(t *testing.T) {
 	t.Parallel()
 
 	tc, cleanup := MustNewTestContext(t)
 	defer cleanup()
 
-	RegisterIdentityHandlers(tc)
-	RegisterComputeV2KeypairsUnauthorized(tc)
+	tc.Openstack().RegisterIdentityHandlers()
+
+	cluster := *createClusterRequest
+	cluster.Openstack.ExternalNetworkID = backup.ExternalNetworkIDPlaceholder
+
+	request := &generated.ImportOptions{
+		Bundle: generated.ExportBundle{
+			Version: backup.Version,
+			ControlPlanes: generated.ExportedControlPlanes{
+				{
+					ControlPlane: generated.ControlPlane{
+						Name: "foo",
+						ApplicationBundle: generated.ApplicationBundle{
+							Name: controlPlaneApplicationBundleName,
+						},
+					},
+					Clusters: generated.KubernetesClusters{
+						cluster,
+					},
+				},
+			},
+		},
+	}
 
 	unikornClient := MustNewScopedClient(t, tc)
 
-	response, err := unikornClient.GetApiV1ProvidersOpenstackKeyPairsWithResponse(context.TODO())
+	response, err := unikornClient.PostApiV1ImportWithResponse(context.TODO(), *request)
 	assert.NoError(t, err)
-	assert.Equal(t, http.StatusUnauthorized, response.HTTPResponse.StatusCode)
-	assert.NotNil(t, response.JSON401)
+	assert.Equal(t, http.StatusBadRequest, response.HTTPResponse.StatusCode)
 
-	serverErr := *response.JSON401
+	var resource unikornv1.ControlPlaneList
 
-	assert.Equal(t, generated.AccessDenied, serverErr.Error)
+	assert.NoError(t, tc.KubernetesClient().List(context.TODO(), &resource))
+	assert.Empty(t, resource.Items)
 }
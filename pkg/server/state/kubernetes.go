@@ -0,0 +1,269 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/constants"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Kubernetes stores state as ServerState custom resources.  This needs no
+// additional infrastructure, but is only suitable for small amounts of
+// infrequently updated state.
+type Kubernetes struct {
+	// client allows Kubernetes API access.
+	client client.Client
+
+	// reader allows uncached Kubernetes API reads.
+	reader client.Reader
+
+	// namespace is where state is stored.
+	namespace string
+}
+
+// Ensure the interface is implemented.
+var _ Store = &Kubernetes{}
+
+// NewKubernetes returns a new Kubernetes store.  State is updated by all server
+// replicas, so individual keys are read with the reader, which should not be
+// cached, otherwise an update may see a stale value and fail.
+func NewKubernetes(client client.Client, reader client.Reader, namespace string) *Kubernetes {
+	return &Kubernetes{
+		client:    client,
+		reader:    reader,
+		namespace: namespace,
+	}
+}
+
+// name returns the resource name for a key, keys are arbitrary strings so
+// cannot be used directly.
+func name(bucket, key string) string {
+	sum := sha256.Sum256([]byte(bucket + "\x00" + key))
+
+	return "state-" + hex.EncodeToString(sum[:16])
+}
+
+// expired tells us whether the state has expired.
+func expired(state *unikornv1.ServerState, now time.Time) bool {
+	return state.Spec.ExpiryTime != nil && now.After(state.Spec.ExpiryTime.Time)
+}
+
+// expiryTime returns the expiry time for a TTL, if set.
+func expiryTime(ttl time.Duration) *metav1.Time {
+	t := expiry(ttl)
+	if t == nil {
+		return nil
+	}
+
+	return &metav1.Time{Time: *t}
+}
+
+// get returns the state resource, expired state is reported as not found.
+func (k *Kubernetes) get(ctx context.Context, bucket, key string) (*unikornv1.ServerState, error) {
+	state := &unikornv1.ServerState{}
+
+	if err := k.reader.Get(ctx, client.ObjectKey{Namespace: k.namespace, Name: name(bucket, key)}, state); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	// Paranoia, but a hash collision would be very confusing.
+	if state.Spec.Bucket != bucket || state.Spec.Key != key {
+		return nil, ErrNotFound
+	}
+
+	if expired(state, time.Now()) {
+		return nil, ErrNotFound
+	}
+
+	return state, nil
+}
+
+// Get implements the Store interface.
+func (k *Kubernetes) Get(ctx context.Context, bucket, key string) ([]byte, error) {
+	state, err := k.get(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.Spec.Value, nil
+}
+
+// Set implements the Store interface.
+func (k *Kubernetes) Set(ctx context.Context, bucket, key string, value []byte, ttl time.Duration) error {
+	return k.Update(ctx, bucket, key, ttl, func([]byte) ([]byte, error) {
+		return value, nil
+	})
+}
+
+// conflict tells us whether an update raced with another replica.
+func conflict(err error) bool {
+	return kerrors.IsConflict(err) || kerrors.IsAlreadyExists(err)
+}
+
+// Update implements the Store interface.
+func (k *Kubernetes) Update(ctx context.Context, bucket, key string, ttl time.Duration, mutate func([]byte) ([]byte, error)) error {
+	return retry.OnError(retry.DefaultRetry, conflict, func() error {
+		state := &unikornv1.ServerState{}
+
+		if err := k.reader.Get(ctx, client.ObjectKey{Namespace: k.namespace, Name: name(bucket, key)}, state); err != nil {
+			if !kerrors.IsNotFound(err) {
+				return err
+			}
+
+			value, err := mutate(nil)
+			if err != nil {
+				return err
+			}
+
+			state = &unikornv1.ServerState{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: k.namespace,
+					Name:      name(bucket, key),
+					Labels: map[string]string{
+						constants.ServerStateBucketLabel: bucket,
+					},
+				},
+				Spec: unikornv1.ServerStateSpec{
+					Bucket:     bucket,
+					Key:        key,
+					Value:      value,
+					ExpiryTime: expiryTime(ttl),
+				},
+			}
+
+			return k.client.Create(ctx, state)
+		}
+
+		var existing []byte
+
+		if !expired(state, time.Now()) {
+			existing = state.Spec.Value
+		}
+
+		value, err := mutate(existing)
+		if err != nil {
+			return err
+		}
+
+		state.Spec.Value = value
+		state.Spec.ExpiryTime = expiryTime(ttl)
+
+		return k.client.Update(ctx, state)
+	})
+}
+
+// Delete implements the Store interface.
+func (k *Kubernetes) Delete(ctx context.Context, bucket, key string) error {
+	state := &unikornv1.ServerState{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: k.namespace,
+			Name:      name(bucket, key),
+		},
+	}
+
+	if err := k.client.Delete(ctx, state); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// List implements the Store interface.  Kubernetes has no native expiry,
+// so any expired state in the bucket is garbage collected here too.
+func (k *Kubernetes) List(ctx context.Context, bucket string) (map[string][]byte, error) {
+	log := log.FromContext(ctx)
+
+	states := &unikornv1.ServerStateList{}
+
+	options := &client.ListOptions{
+		Namespace: k.namespace,
+		LabelSelector: labels.SelectorFromSet(map[string]string{
+			constants.ServerStateBucketLabel: bucket,
+		}),
+	}
+
+	if err := k.client.List(ctx, states, options); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	result := map[string][]byte{}
+
+	for i := range states.Items {
+		state := &states.Items[i]
+
+		if expired(state, now) {
+			if err := k.client.Delete(ctx, state); err != nil && !kerrors.IsNotFound(err) {
+				log.Error(err, "failed to delete expired state", "bucket", bucket, "key", state.Spec.Key)
+			}
+
+			continue
+		}
+
+		result[state.Spec.Key] = state.Spec.Value
+	}
+
+	return result, nil
+}
+
+// Expire implements the Store interface.
+func (k *Kubernetes) Expire(ctx context.Context) error {
+	states := &unikornv1.ServerStateList{}
+
+	if err := k.client.List(ctx, states, &client.ListOptions{Namespace: k.namespace}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for i := range states.Items {
+		state := &states.Items[i]
+
+		if !expired(state, now) {
+			continue
+		}
+
+		// The state may have been updated since it was listed.
+		preconditions := client.Preconditions{
+			ResourceVersion: &state.ResourceVersion,
+		}
+
+		if err := k.client.Delete(ctx, state, preconditions); err != nil && !kerrors.IsNotFound(err) && !kerrors.IsConflict(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryEntry is a value stored in memory.
+type memoryEntry struct {
+	// value is the stored value.
+	value []byte
+
+	// expiry is when the value expires, if set.
+	expiry *time.Time
+}
+
+// expired tells us whether the entry has expired.
+func (e *memoryEntry) expired(now time.Time) bool {
+	return e.expiry != nil && now.After(*e.expiry)
+}
+
+// Memory stores state in memory.
+type Memory struct {
+	// lock protects the buckets.
+	lock sync.Mutex
+
+	// buckets are indexed by bucket name then key.
+	buckets map[string]map[string]*memoryEntry
+}
+
+// Ensure the interface is implemented.
+var _ Store = &Memory{}
+
+// NewMemory returns a new in-memory store.
+func NewMemory() *Memory {
+	return &Memory{
+		buckets: map[string]map[string]*memoryEntry{},
+	}
+}
+
+// get returns an entry, removing it if expired.  The lock must be held.
+func (m *Memory) get(bucket, key string) ([]byte, error) {
+	entries, ok := m.buckets[bucket]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	entry, ok := entries[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	if entry.expired(time.Now()) {
+		delete(entries, key)
+
+		return nil, ErrNotFound
+	}
+
+	return entry.value, nil
+}
+
+// set stores an entry.  The lock must be held.
+func (m *Memory) set(bucket, key string, value []byte, ttl time.Duration) {
+	entries, ok := m.buckets[bucket]
+	if !ok {
+		entries = map[string]*memoryEntry{}
+
+		m.buckets[bucket] = entries
+	}
+
+	entries[key] = &memoryEntry{
+		value:  value,
+		expiry: expiry(ttl),
+	}
+}
+
+// Get implements the Store interface.
+func (m *Memory) Get(ctx context.Context, bucket, key string) ([]byte, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.get(bucket, key)
+}
+
+// Set implements the Store interface.
+func (m *Memory) Set(ctx context.Context, bucket, key string, value []byte, ttl time.Duration) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.set(bucket, key, value, ttl)
+
+	return nil
+}
+
+// Update implements the Store interface.
+func (m *Memory) Update(ctx context.Context, bucket, key string, ttl time.Duration, mutate func([]byte) ([]byte, error)) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// Not found just means the mutate function gets a nil value.
+	//nolint:errcheck
+	value, _ := m.get(bucket, key)
+
+	value, err := mutate(value)
+	if err != nil {
+		return err
+	}
+
+	m.set(bucket, key, value, ttl)
+
+	return nil
+}
+
+// Delete implements the Store interface.
+func (m *Memory) Delete(ctx context.Context, bucket, key string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.buckets[bucket], key)
+
+	return nil
+}
+
+// List implements the Store interface.
+func (m *Memory) List(ctx context.Context, bucket string) (map[string][]byte, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+
+	result := map[string][]byte{}
+
+	for key, entry := range m.buckets[bucket] {
+		if entry.expired(now) {
+			delete(m.buckets[bucket], key)
+
+			continue
+		}
+
+		result[key] = entry.value
+	}
+
+	return result, nil
+}
+
+// Expire implements the Store interface.
+func (m *Memory) Expire(ctx context.Context) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+
+	for _, entries := range m.buckets {
+		for key, entry := range entries {
+			if entry.expired(now) {
+				delete(entries, key)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/pflag"
+)
+
+// RedisOptions defines Redis backend options.
+type RedisOptions struct {
+	// Address is the host:port of the Redis server.
+	Address string
+
+	// PasswordFile is a file containing the Redis password, if required.
+	PasswordFile string
+
+	// DB is the database to use.
+	DB int
+
+	// TLS enables TLS to the Redis server.
+	TLS bool
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *RedisOptions) AddFlags(f *pflag.FlagSet) {
+	f.StringVar(&o.Address, "state-redis-address", "", "Redis server address when using the redis backend.")
+	f.StringVar(&o.PasswordFile, "state-redis-password-file", "", "File containing the Redis password when using the redis backend.")
+	f.IntVar(&o.DB, "state-redis-db", 0, "Redis database when using the redis backend.")
+	f.BoolVar(&o.TLS, "state-redis-tls", false, "Use TLS to connect to Redis when using the redis backend.")
+}
+
+// redisKeyPrefix namespaces our keys, so the server can be pointed at a
+// shared Redis instance.
+const redisKeyPrefix = "unikorn:state:"
+
+// Redis stores state in Redis.
+type Redis struct {
+	// client is the Redis client.
+	client *redis.Client
+}
+
+// Ensure the interface is implemented.
+var _ Store = &Redis{}
+
+// NewRedis returns a new Redis store.
+func NewRedis(o *RedisOptions) (*Redis, error) {
+	if o.Address == "" {
+		return nil, fmt.Errorf("%w: state redis address must be specified for the redis backend", ErrFlag)
+	}
+
+	options := &redis.Options{
+		Addr: o.Address,
+		DB:   o.DB,
+	}
+
+	if o.PasswordFile != "" {
+		password, err := os.ReadFile(o.PasswordFile)
+		if err != nil {
+			return nil, err
+		}
+
+		options.Password = strings.TrimSpace(string(password))
+	}
+
+	if o.TLS {
+		options.TLSConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return &Redis{
+		client: redis.NewClient(options),
+	}, nil
+}
+
+// bucketPrefix returns the key prefix for a bucket.
+func bucketPrefix(bucket string) string {
+	return redisKeyPrefix + bucket + ":"
+}
+
+// redisKey returns the Redis key for a bucket and key.
+func redisKey(bucket, key string) string {
+	return bucketPrefix(bucket) + key
+}
+
+// Get implements the Store interface.
+func (r *Redis) Get(ctx context.Context, bucket, key string) ([]byte, error) {
+	value, err := r.client.Get(ctx, redisKey(bucket, key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Set implements the Store interface.
+func (r *Redis) Set(ctx context.Context, bucket, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, redisKey(bucket, key), value, ttl).Err()
+}
+
+// redisUpdateRetries is how many times an optimistic update is attempted.
+const redisUpdateRetries = 10
+
+// Update implements the Store interface.
+func (r *Redis) Update(ctx context.Context, bucket, key string, ttl time.Duration, mutate func([]byte) ([]byte, error)) error {
+	k := redisKey(bucket, key)
+
+	update := func(tx *redis.Tx) error {
+		value, err := tx.Get(ctx, k).Bytes()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+
+		value, err = mutate(value)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, k, value, ttl)
+
+			return nil
+		})
+
+		return err
+	}
+
+	for i := 0; i < redisUpdateRetries; i++ {
+		err := r.client.Watch(ctx, update, k)
+		if errors.Is(err, redis.TxFailedErr) {
+			continue
+		}
+
+		return err
+	}
+
+	return ErrConflict
+}
+
+// Delete implements the Store interface.
+func (r *Redis) Delete(ctx context.Context, bucket, key string) error {
+	return r.client.Del(ctx, redisKey(bucket, key)).Err()
+}
+
+// List implements the Store interface.
+func (r *Redis) List(ctx context.Context, bucket string) (map[string][]byte, error) {
+	prefix := bucketPrefix(bucket)
+
+	var keys []string
+
+	iter := r.client.Scan(ctx, 0, prefix+"*", 0).Iterator()
+
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	result := map[string][]byte{}
+
+	if len(keys) == 0 {
+		return result, nil
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, value := range values {
+		// Keys may have expired between the scan and the get.
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		result[strings.TrimPrefix(keys[i], prefix)] = []byte(s)
+	}
+
+	return result, nil
+}
+
+// Expire implements the Store interface.  Redis expires keys natively.
+func (r *Redis) Expire(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package state provides storage of server side state, e.g. debug captures,
+// that must be shared between server replicas so the server can be scaled
+// horizontally.
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	unikornerrors "github.com/eschercloudai/unikorn/pkg/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	// ErrNotFound is raised when a key does not exist, or has expired.
+	ErrNotFound = unikornerrors.NotFound("state not found")
+
+	// ErrFlag is raised when a flag is invalid.
+	ErrFlag = errors.New("flag error")
+
+	// ErrConflict is raised when an update cannot be applied due to
+	// persistent concurrent modification.
+	ErrConflict = unikornerrors.Conflict("state update conflict")
+)
+
+// Store is a key/value store.  Keys are grouped into buckets, so subsystems
+// don't need to worry about colliding with one another.  Bucket names must be
+// valid Kubernetes label values.
+type Store interface {
+	// Get returns the value of a key, or ErrNotFound.
+	Get(ctx context.Context, bucket, key string) ([]byte, error)
+
+	// Set creates or replaces a key.  If the TTL is non-zero the key will
+	// expire after that duration.
+	Set(ctx context.Context, bucket, key string, value []byte, ttl time.Duration) error
+
+	// Update atomically modifies a key.  The mutate function is passed the
+	// existing value, or nil if it doesn't exist, and returns the new value.
+	// The function may be called multiple times under contention.  If the TTL
+	// is non-zero the key will expire after that duration.
+	Update(ctx context.Context, bucket, key string, ttl time.Duration, mutate func([]byte) ([]byte, error)) error
+
+	// Delete removes a key, it is not an error if it doesn't exist.
+	Delete(ctx context.Context, bucket, key string) error
+
+	// List returns all unexpired keys and values in a bucket.
+	List(ctx context.Context, bucket string) (map[string][]byte, error)
+
+	// Expire removes all expired keys, in all buckets.  Expired keys are
+	// never visible, but would otherwise only be removed when accessed.
+	Expire(ctx context.Context) error
+}
+
+// Backend defines where state is stored.
+type Backend string
+
+const (
+	// BackendMemory stores state in memory, it is only suitable for a
+	// single replica.
+	BackendMemory Backend = "memory"
+
+	// BackendKubernetes stores state as custom resources.
+	BackendKubernetes Backend = "kubernetes"
+
+	// BackendRedis stores state in Redis.
+	BackendRedis Backend = "redis"
+)
+
+// backends is the set of valid backends.
+//
+//nolint:gochecknoglobals
+var backends = []Backend{
+	BackendMemory,
+	BackendKubernetes,
+	BackendRedis,
+}
+
+func (b Backend) String() string {
+	return string(b)
+}
+
+func (b *Backend) Set(s string) error {
+	if !slices.Contains(backends, Backend(s)) {
+		return fmt.Errorf("%w: state backend must be one of %v", ErrFlag, backends)
+	}
+
+	*b = Backend(s)
+
+	return nil
+}
+
+func (b Backend) Type() string {
+	return "string"
+}
+
+// Options defines configurable state storage options.
+type Options struct {
+	// Backend defines where state is stored.
+	Backend Backend
+
+	// Namespace is where state is stored by the Kubernetes backend.
+	Namespace string
+
+	// ExpiryInterval is how often expired state is removed.
+	ExpiryInterval time.Duration
+
+	// Redis defines Redis backend options.
+	Redis RedisOptions
+}
+
+// AddFlags adds the options flags to the given flag set.
+func (o *Options) AddFlags(f *pflag.FlagSet) {
+	o.Backend = BackendMemory
+
+	f.Var(&o.Backend, "state-backend", fmt.Sprintf("Where to store state shared between server replicas, one of %v.  Memory is only suitable for a single replica.", backends))
+	f.StringVar(&o.Namespace, "state-namespace", "", "Namespace to store state in when using the kubernetes backend.")
+	f.DurationVar(&o.ExpiryInterval, "state-expiry-interval", 5*time.Minute, "How often expired state is removed.")
+
+	o.Redis.AddFlags(f)
+}
+
+// New returns a store for the configured backend.  The reader should not be
+// cached, see NewKubernetes.
+func New(c client.Client, reader client.Reader, o *Options) (Store, error) {
+	switch o.Backend {
+	case BackendKubernetes:
+		if o.Namespace == "" {
+			return nil, fmt.Errorf("%w: state namespace must be specified for the kubernetes backend", ErrFlag)
+		}
+
+		return NewKubernetes(c, reader, o.Namespace), nil
+	case BackendRedis:
+		return NewRedis(&o.Redis)
+	}
+
+	return NewMemory(), nil
+}
+
+// Collect periodically removes expired state until the context is cancelled.
+func Collect(ctx context.Context, store Store, interval time.Duration) {
+	log := log.FromContext(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := store.Expire(ctx); err != nil {
+				log.Error(err, "failed to remove expired state")
+			}
+		}
+	}
+}
+
+// expiry returns the absolute expiry time for a TTL, or nil if the TTL is zero.
+func expiry(ttl time.Duration) *time.Time {
+	if ttl == 0 {
+		return nil
+	}
+
+	t := time.Now().Add(ttl)
+
+	return &t
+}
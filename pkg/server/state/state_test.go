@@ -0,0 +1,230 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	unikornscheme "github.com/eschercloudai/unikorn/generated/clientset/unikorn/scheme"
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/server/state"
+
+	coreclient "github.com/eschercloudai/unikorn-core/pkg/client"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// mustNewFakeClient returns a fake client that knows about state resources.
+func mustNewFakeClient(t *testing.T) client.Client {
+	t.Helper()
+
+	scheme, err := coreclient.NewScheme(unikornscheme.AddToScheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return fake.NewClientBuilder().WithScheme(scheme).Build()
+}
+
+// mustNewKubernetes returns a Kubernetes store backed by a fake client.
+func mustNewKubernetes(t *testing.T) state.Store {
+	t.Helper()
+
+	client := mustNewFakeClient(t)
+
+	return state.NewKubernetes(client, client, "default")
+}
+
+// testStore checks a store conforms to the expected behaviour.
+func testStore(t *testing.T, store state.Store) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	_, err := store.Get(ctx, "foo", "a")
+	assert.ErrorIs(t, err, state.ErrNotFound)
+
+	assert.NoError(t, store.Set(ctx, "foo", "a", []byte("1"), 0))
+	assert.NoError(t, store.Set(ctx, "foo", "b", []byte("2"), 0))
+	assert.NoError(t, store.Set(ctx, "bar", "a", []byte("3"), 0))
+
+	value, err := store.Get(ctx, "foo", "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), value)
+
+	// Buckets are isolated from one another.
+	values, err := store.List(ctx, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"a": []byte("1"), "b": []byte("2")}, values)
+
+	// Updates see the existing value, or nil if there is none.
+	appendX := func(value []byte) ([]byte, error) {
+		return append(value, 'x'), nil
+	}
+
+	assert.NoError(t, store.Update(ctx, "foo", "a", 0, appendX))
+	assert.NoError(t, store.Update(ctx, "foo", "c", 0, appendX))
+
+	values, err = store.List(ctx, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"a": []byte("1x"), "b": []byte("2"), "c": []byte("x")}, values)
+
+	assert.NoError(t, store.Delete(ctx, "foo", "a"))
+	assert.NoError(t, store.Delete(ctx, "foo", "a"))
+
+	_, err = store.Get(ctx, "foo", "a")
+	assert.ErrorIs(t, err, state.ErrNotFound)
+
+	// Expired keys are not visible.
+	assert.NoError(t, store.Set(ctx, "bar", "b", []byte("4"), time.Millisecond))
+
+	time.Sleep(10 * time.Millisecond)
+
+	_, err = store.Get(ctx, "bar", "b")
+	assert.ErrorIs(t, err, state.ErrNotFound)
+
+	values, err = store.List(ctx, "bar")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"a": []byte("3")}, values)
+
+	// Expired keys are treated as absent by updates.
+	assert.NoError(t, store.Update(ctx, "bar", "b", 0, appendX))
+
+	value, err = store.Get(ctx, "bar", "b")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("x"), value)
+}
+
+// TestMemory tests the memory backend.
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	testStore(t, state.NewMemory())
+}
+
+// TestKubernetes tests the Kubernetes backend.
+func TestKubernetes(t *testing.T) {
+	t.Parallel()
+
+	testStore(t, mustNewKubernetes(t))
+}
+
+// TestMemoryExpire tests expired keys are removed from all buckets.
+func TestMemoryExpire(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	store := state.NewMemory()
+
+	assert.NoError(t, store.Set(ctx, "foo", "a", []byte("1"), 0))
+	assert.NoError(t, store.Set(ctx, "foo", "b", []byte("2"), time.Millisecond))
+	assert.NoError(t, store.Set(ctx, "bar", "a", []byte("3"), time.Millisecond))
+
+	time.Sleep(10 * time.Millisecond)
+
+	assert.NoError(t, store.Expire(ctx))
+
+	// Updates see nil for keys that have been removed.
+	assert.NoError(t, store.Update(ctx, "bar", "a", 0, func(value []byte) ([]byte, error) {
+		assert.Nil(t, value)
+
+		return value, nil
+	}))
+
+	values, err := store.List(ctx, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"a": []byte("1")}, values)
+}
+
+// TestKubernetesExpire tests expired state resources are deleted from all
+// buckets.
+func TestKubernetesExpire(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	client := mustNewFakeClient(t)
+
+	store := state.NewKubernetes(client, client, "default")
+
+	assert.NoError(t, store.Set(ctx, "foo", "a", []byte("1"), 0))
+	assert.NoError(t, store.Set(ctx, "foo", "b", []byte("2"), time.Millisecond))
+	assert.NoError(t, store.Set(ctx, "bar", "a", []byte("3"), time.Millisecond))
+
+	time.Sleep(10 * time.Millisecond)
+
+	assert.NoError(t, store.Expire(ctx))
+
+	states := &unikornv1.ServerStateList{}
+
+	assert.NoError(t, client.List(ctx, states))
+	assert.Len(t, states.Items, 1)
+	assert.Equal(t, "a", states.Items[0].Spec.Key)
+	assert.Equal(t, "foo", states.Items[0].Spec.Bucket)
+}
+
+// TestKubernetesStaleCache tests updates read through the reader, so a stale
+// cache doesn't cause them to fail.
+func TestKubernetesStaleCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	reader := mustNewFakeClient(t)
+
+	// The cache never sees anything.
+	stale := interceptor.NewClient(reader.(client.WithWatch), interceptor.Funcs{
+		Get: func(ctx context.Context, _ client.WithWatch, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+			return kerrors.NewNotFound(unikornv1.SchemeGroupVersion.WithResource("serverstates").GroupResource(), key.Name)
+		},
+	})
+
+	store := state.NewKubernetes(stale, reader, "default")
+
+	assert.NoError(t, store.Set(ctx, "foo", "a", []byte("1"), 0))
+	assert.NoError(t, store.Set(ctx, "foo", "a", []byte("2"), 0))
+
+	value, err := store.Get(ctx, "foo", "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("2"), value)
+}
+
+// TestNew tests backend selection.
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := state.New(nil, nil, &state.Options{Backend: state.BackendKubernetes})
+	assert.ErrorIs(t, err, state.ErrFlag)
+
+	_, err = state.New(nil, nil, &state.Options{Backend: state.BackendRedis})
+	assert.ErrorIs(t, err, state.ErrFlag)
+
+	var backend state.Backend
+
+	assert.ErrorIs(t, backend.Set("etcd"), state.ErrFlag)
+	assert.NoError(t, backend.Set("redis"))
+	assert.Equal(t, state.BackendRedis, backend)
+}
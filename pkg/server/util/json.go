@@ -17,9 +17,14 @@ limitations under the License.
 package util
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/eschercloudai/unikorn/pkg/server/errors"
 
@@ -46,13 +51,89 @@ func WriteJSONResponse(w http.ResponseWriter, r *http.Request, code int, respons
 	}
 }
 
-// ReadJSONBody is a generic request reader to unmarshal JSON bodies.
+type contentTypeKey struct{}
+
+// WithContentType records the media type the response body will be sent to the
+// client as, when middleware transcodes it from JSON.
+func WithContentType(r *http.Request, mediaType string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), contentTypeKey{}, mediaType))
+}
+
+// contentType returns the media type the response body will be sent as.
+func contentType(r *http.Request) string {
+	if mediaType, ok := r.Context().Value(contentTypeKey{}).(string); ok {
+		return mediaType
+	}
+
+	return "application/json"
+}
+
+// eTagMatches returns true if the If-None-Match header matches the entity tag.
+// Weak comparison is used, as recommended for GET requests.
+func eTagMatches(r *http.Request, eTag string) bool {
+	for _, value := range r.Header.Values("If-None-Match") {
+		for _, candidate := range strings.Split(value, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+
+			if candidate == "*" || candidate == eTag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// WriteCacheableJSONResponse is like WriteJSONResponse, but additionally tags
+// the response with an ETag derived from the body.  If the client already has
+// this version, as indicated by the If-None-Match header, then a 304 Not Modified
+// response is returned without the body.  The body may be transcoded, depending
+// on the Accept header, so the ETag includes the media type it's sent as, and
+// caches are told the response varies.
+func WriteCacheableJSONResponse(w http.ResponseWriter, r *http.Request, code int, response interface{}) {
+	log := log.FromContext(r.Context())
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		log.Error(err, "unable to marshal body")
+
+		return
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(contentType(r) + "\n"))
+	hash.Write(body)
+
+	eTag := `"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+
+	w.Header().Set("ETag", eTag)
+	w.Header().Add("Vary", "Accept")
+
+	if eTagMatches(r, eTag) {
+		w.WriteHeader(http.StatusNotModified)
+
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+
+	w.WriteHeader(code)
+
+	if _, err := w.Write(body); err != nil {
+		log.Error(err, "failed to write response")
+	}
+}
+
+// ReadJSONBody is a generic request reader to unmarshal JSON bodies.  The body
+// is restored so it can be read again, e.g. to fingerprint the request.
 func ReadJSONBody(r *http.Request, v interface{}) error {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
 		return errors.OAuth2ServerError("unable to read request body").WithError(err)
 	}
 
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
 	if err := json.Unmarshal(body, v); err != nil {
 		return errors.OAuth2ServerError("unable to unmarshal request body").WithError(err)
 	}
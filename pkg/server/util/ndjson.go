@@ -0,0 +1,52 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// WriteNDJSONResponse is a generic wrapper for returning newline delimited JSON
+// to the client, one item per line.
+func WriteNDJSONResponse[T any](w http.ResponseWriter, r *http.Request, code int, items []T) {
+	log := log.FromContext(r.Context())
+
+	var body bytes.Buffer
+
+	// The encoder terminates each item with a newline.
+	encoder := json.NewEncoder(&body)
+
+	for i := range items {
+		if err := encoder.Encode(items[i]); err != nil {
+			log.Error(err, "unable to marshal body")
+
+			return
+		}
+	}
+
+	w.Header().Add("Content-Type", "application/x-ndjson")
+
+	w.WriteHeader(code)
+
+	if _, err := w.Write(body.Bytes()); err != nil {
+		log.Error(err, "failed to write response")
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"net/http"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+// WriteYAMLResponse is a generic wrapper for returning a YAML payload to the client.
+func WriteYAMLResponse(w http.ResponseWriter, r *http.Request, code int, response interface{}) {
+	log := log.FromContext(r.Context())
+
+	body, err := yaml.Marshal(response)
+	if err != nil {
+		log.Error(err, "unable to marshal body")
+
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/yaml")
+
+	w.WriteHeader(code)
+
+	if _, err := w.Write(body); err != nil {
+		log.Error(err, "failed to write response")
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstackmock
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// BlockStorageQuota is the block storage quota of a project.
+type BlockStorageQuota struct {
+	Volumes   QuotaDetail
+	Gigabytes QuotaDetail
+	// PerVolumeGigabytes is the volume size limit, usage is not reported.
+	PerVolumeGigabytes int
+}
+
+// AddBlockStorageAvailabilityZone adds a block storage availability zone.
+func (m *Mock) AddBlockStorageAvailabilityZone(name string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.blockStorageAvailabilityZones = append(m.blockStorageAvailabilityZones, name)
+}
+
+// SetBlockStorageQuota sets the block storage quota for all projects.
+func (m *Mock) SetBlockStorageQuota(quota BlockStorageQuota) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.blockStorageQuota = quota
+}
+
+// RegisterBlockStorageV3AvailabilityZone allows block storage availability zones
+// to be listed.
+func (m *Mock) RegisterBlockStorageV3AvailabilityZone() {
+	m.router.Get("/blockstorage/os-availability-zone", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, BlockStorageV3AvailabilityZone) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		writeJSON(w, http.StatusOK, availabilityZones(m.blockStorageAvailabilityZones, nil))
+	})
+}
+
+// RegisterBlockStorageV3QuotaSets allows block storage quotas and usage to be read.
+func (m *Mock) RegisterBlockStorageV3QuotaSets() {
+	m.router.Get("/blockstorage/os-quota-sets/{project_id}", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, BlockStorageV3QuotaSets) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		detail := func(q QuotaDetail) interface{} {
+			return map[string]interface{}{
+				"in_use":    q.InUse,
+				"allocated": 0,
+				"reserved":  q.Reserved,
+				"limit":     q.Limit,
+			}
+		}
+
+		body := map[string]interface{}{
+			"quota_set": map[string]interface{}{
+				"id":        chi.URLParam(r, "project_id"),
+				"volumes":   detail(m.blockStorageQuota.Volumes),
+				"gigabytes": detail(m.blockStorageQuota.Gigabytes),
+				"per_volume_gigabytes": detail(QuotaDetail{
+					Limit: m.blockStorageQuota.PerVolumeGigabytes,
+				}),
+			},
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
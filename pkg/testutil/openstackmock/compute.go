@@ -0,0 +1,319 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstackmock
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// Flavor is a Nova flavor.  Extra specs are reported as available in
+// microversion 2.61 onward.
+type Flavor struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	VCPUs      int               `json:"vcpus"`
+	RAM        int               `json:"ram"`
+	Disk       int               `json:"disk"`
+	Swap       int               `json:"swap,omitempty"`
+	ExtraSpecs map[string]string `json:"extra_specs,omitempty"`
+}
+
+// ServerGroup is a Nova server group.
+type ServerGroup struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Policies []string `json:"policies"`
+	Policy   string   `json:"policy,omitempty"`
+}
+
+// ComputeAvailabilityZone is a Nova availability zone.  The zone named
+// "internal" is only reported by the detailed listing, as per the Nova API.
+type ComputeAvailabilityZone struct {
+	Name string
+
+	// Hosts maps from host name to the services running on that host.
+	Hosts map[string][]string
+}
+
+// QuotaDetail is the quota and usage of a compute or block storage resource.
+type QuotaDetail struct {
+	InUse    int `json:"in_use"`
+	Limit    int `json:"limit"`
+	Reserved int `json:"reserved"`
+}
+
+// ComputeQuota is the compute quota of a project.
+type ComputeQuota struct {
+	Cores     QuotaDetail `json:"cores"`
+	RAM       QuotaDetail `json:"ram"`
+	Instances QuotaDetail `json:"instances"`
+}
+
+// internalAvailabilityZone is the availability zone that hosts control plane
+// services.
+const internalAvailabilityZone = "internal"
+
+// AddFlavor adds a flavor.
+func (m *Mock) AddFlavor(flavor Flavor) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.flavors = append(m.flavors, flavor)
+}
+
+// AddServerGroup adds a server group.
+func (m *Mock) AddServerGroup(serverGroup ServerGroup) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.serverGroups = append(m.serverGroups, serverGroup)
+}
+
+// ServerGroups returns all server groups.
+func (m *Mock) ServerGroups() []ServerGroup {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return slices.Clone(m.serverGroups)
+}
+
+// AddKeyPair adds a key pair.
+func (m *Mock) AddKeyPair(name string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.keyPairs = append(m.keyPairs, name)
+}
+
+// AddComputeAvailabilityZone adds a compute availability zone.
+func (m *Mock) AddComputeAvailabilityZone(zone ComputeAvailabilityZone) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.computeAvailabilityZones = append(m.computeAvailabilityZones, zone)
+}
+
+// SetComputeQuota sets the compute quota for all projects.
+func (m *Mock) SetComputeQuota(quota ComputeQuota) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.computeQuota = quota
+}
+
+// RegisterComputeV2FlavorsDetail allows flavors to be listed.
+func (m *Mock) RegisterComputeV2FlavorsDetail() {
+	m.router.Get("/compute/flavors/detail", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, ComputeV2FlavorsDetail) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		body := map[string]interface{}{
+			"first":   "/flavors/detail",
+			"flavors": append([]Flavor{}, m.flavors...),
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// RegisterComputeV2ServerGroups allows server groups to be listed and created.
+func (m *Mock) RegisterComputeV2ServerGroups() {
+	m.router.Get("/compute/os-server-groups", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, ComputeV2ServerGroupsList) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		body := map[string]interface{}{
+			"first":         "/os-server-groups",
+			"server_groups": append([]ServerGroup{}, m.serverGroups...),
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+
+	m.router.Post("/compute/os-server-groups", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, ComputeV2ServerGroupCreate) {
+			return
+		}
+
+		var request struct {
+			ServerGroup ServerGroup `json:"server_group"`
+		}
+
+		if !readJSON(w, r, &request) {
+			return
+		}
+
+		serverGroup := request.ServerGroup
+		serverGroup.ID = uuid.New().String()
+
+		m.AddServerGroup(serverGroup)
+
+		body := map[string]interface{}{
+			"server_group": serverGroup,
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// RegisterComputeV2Keypairs allows key pairs to be listed.
+func (m *Mock) RegisterComputeV2Keypairs() {
+	m.router.Get("/compute/os-keypairs", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, ComputeV2Keypairs) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		keyPairs := make([]interface{}, len(m.keyPairs))
+
+		for i, name := range m.keyPairs {
+			keyPairs[i] = map[string]interface{}{
+				"keypair": map[string]interface{}{
+					"name": name,
+				},
+			}
+		}
+
+		body := map[string]interface{}{
+			"keypairs": keyPairs,
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// availabilityZones renders availability zones, with optional host information.
+func availabilityZones(zones []string, hosts map[string]map[string][]string) interface{} {
+	info := make([]interface{}, len(zones))
+
+	for i, zone := range zones {
+		entry := map[string]interface{}{
+			"zoneName": zone,
+			"zoneState": map[string]interface{}{
+				"available": true,
+			},
+		}
+
+		if hosts != nil {
+			zoneHosts := map[string]interface{}{}
+
+			for host, services := range hosts[zone] {
+				hostServices := map[string]interface{}{}
+
+				for _, service := range services {
+					hostServices[service] = map[string]interface{}{
+						"available":  true,
+						"active":     true,
+						"updated_at": nil,
+					}
+				}
+
+				zoneHosts[host] = hostServices
+			}
+
+			entry["hosts"] = zoneHosts
+		}
+
+		info[i] = entry
+	}
+
+	return map[string]interface{}{
+		"availabilityZoneInfo": info,
+	}
+}
+
+// RegisterComputeV2AvailabilityZone allows compute availability zones to be listed.
+func (m *Mock) RegisterComputeV2AvailabilityZone() {
+	m.router.Get("/compute/os-availability-zone", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, ComputeV2AvailabilityZone) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		var zones []string
+
+		for _, zone := range m.computeAvailabilityZones {
+			if zone.Name != internalAvailabilityZone {
+				zones = append(zones, zone.Name)
+			}
+		}
+
+		writeJSON(w, http.StatusOK, availabilityZones(zones, nil))
+	})
+}
+
+// RegisterComputeV2AvailabilityZoneDetail allows compute availability zones to be
+// listed with host information.
+func (m *Mock) RegisterComputeV2AvailabilityZoneDetail() {
+	m.router.Get("/compute/os-availability-zone/detail", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, ComputeV2AvailabilityZoneDetail) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		zones := make([]string, len(m.computeAvailabilityZones))
+		hosts := map[string]map[string][]string{}
+
+		for i, zone := range m.computeAvailabilityZones {
+			zones[i] = zone.Name
+			hosts[zone.Name] = zone.Hosts
+		}
+
+		writeJSON(w, http.StatusOK, availabilityZones(zones, hosts))
+	})
+}
+
+// RegisterComputeV2QuotaSetsDetail allows compute quotas and usage to be read.
+func (m *Mock) RegisterComputeV2QuotaSetsDetail() {
+	m.router.Get("/compute/os-quota-sets/{project_id}/detail", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, ComputeV2QuotaSetsDetail) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		body := map[string]interface{}{
+			"quota_set": map[string]interface{}{
+				"id":        chi.URLParam(r, "project_id"),
+				"cores":     m.computeQuota.Cores,
+				"ram":       m.computeQuota.RAM,
+				"instances": m.computeQuota.Instances,
+			},
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
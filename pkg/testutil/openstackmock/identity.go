@@ -0,0 +1,632 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstackmock
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	// Token is the token returned for all authentication requests.
+	Token = "ImAToken"
+
+	// tokenLifetime is how long issued tokens are valid for.
+	tokenLifetime = time.Hour
+
+	// domainID is the domain all resources belong to.
+	domainID = "default"
+
+	// domainName is the name of the domain all resources belong to.
+	domainName = "Default"
+
+	// region is the region all services are advertised in.
+	region = "RegionOne"
+)
+
+// User is the user that all tokens are issued to.
+type User struct {
+	ID    string
+	Name  string
+	Email string
+	// Roles are the role names the user has on all projects.
+	Roles []string
+}
+
+// Project is a project the user is a member of.
+type Project struct {
+	ID   string
+	Name string
+}
+
+// Role is a role that may be assigned to users.
+type Role struct {
+	ID   string
+	Name string
+}
+
+// RoleAssignment grants a user a role on a project.
+type RoleAssignment struct {
+	ProjectID string
+	UserID    string
+	RoleID    string
+}
+
+// ApplicationCredential is an application credential owned by the user.
+type ApplicationCredential struct {
+	ID          string
+	Name        string
+	Description string
+}
+
+// SetUser sets the user that tokens are issued to.
+func (m *Mock) SetUser(user User) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.user = user
+}
+
+// AddUser adds a user that may be looked up, this does not affect the user
+// that tokens are issued to.
+func (m *Mock) AddUser(user User) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.users = append(m.users, user)
+}
+
+// AddRole adds a role that may be assigned to users.
+func (m *Mock) AddRole(role Role) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.roles = append(m.roles, role)
+}
+
+// AddRoleAssignment grants a user a role on a project.
+func (m *Mock) AddRoleAssignment(assignment RoleAssignment) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !slices.Contains(m.roleAssignments, assignment) {
+		m.roleAssignments = append(m.roleAssignments, assignment)
+	}
+}
+
+// RemoveRoleAssignment revokes a user's role on a project.
+func (m *Mock) RemoveRoleAssignment(assignment RoleAssignment) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.roleAssignments = slices.DeleteFunc(m.roleAssignments, func(a RoleAssignment) bool {
+		return a == assignment
+	})
+}
+
+// RoleAssignments returns all role assignments.
+func (m *Mock) RoleAssignments() []RoleAssignment {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return slices.Clone(m.roleAssignments)
+}
+
+// AddProject adds a project the user is a member of.
+func (m *Mock) AddProject(project Project) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.projects = append(m.projects, project)
+}
+
+// AddApplicationCredential adds an application credential to the user.
+func (m *Mock) AddApplicationCredential(credential ApplicationCredential) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.applicationCredentials = append(m.applicationCredentials, credential)
+}
+
+// ApplicationCredentials returns the application credentials owned by the user.
+func (m *Mock) ApplicationCredentials() []ApplicationCredential {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return slices.Clone(m.applicationCredentials)
+}
+
+// serviceURL returns the URL of a service.
+func (m *Mock) serviceURL(service string) string {
+	return "http://" + m.endpoint + "/" + service
+}
+
+// tokenResponse defines how we mock the OpenStack API.  Important parts to pay
+// attention to (in the context of gophercloud):
+// * token.catalog.type is used to look for the service.
+// * token.catalog.endpoints.interface is used to look a service endpoint, "public" is the default.
+// * token.user.id is used by Unikorn for identity information in its access token.
+func (m *Mock) tokenResponse() interface{} {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	type catalogEntry struct {
+		name    string
+		kind    string
+		service string
+	}
+
+	entries := []catalogEntry{
+		{name: "keystone", kind: "identity", service: "identity"},
+		{name: "nova", kind: "compute", service: "compute"},
+		{name: "glance", kind: "image", service: "image"},
+		{name: "neutron", kind: "network", service: "network"},
+		{name: "cinder", kind: "volumev3", service: "blockstorage"},
+	}
+
+	catalog := make([]interface{}, len(entries))
+
+	for i, entry := range entries {
+		catalog[i] = map[string]interface{}{
+			"name": entry.name,
+			"type": entry.kind,
+			"endpoints": []interface{}{
+				map[string]interface{}{
+					"interface": "public",
+					"region":    region,
+					"region_id": region,
+					"url":       m.serviceURL(entry.service),
+				},
+			},
+		}
+	}
+
+	domain := map[string]interface{}{
+		"id":   domainID,
+		"name": domainName,
+	}
+
+	roles := make([]interface{}, len(m.user.Roles))
+
+	for i, role := range m.user.Roles {
+		roles[i] = map[string]interface{}{
+			"id":   role,
+			"name": role,
+		}
+	}
+
+	return map[string]interface{}{
+		"token": map[string]interface{}{
+			"catalog":    catalog,
+			"roles":      roles,
+			"domain":     domain,
+			"methods":    []string{"password"},
+			"expires_at": time.Now().Add(tokenLifetime).Format(time.RFC3339),
+			"user": map[string]interface{}{
+				"domain": domain,
+				"id":     m.user.ID,
+				"name":   m.user.Name,
+			},
+		},
+	}
+}
+
+// writeToken writes out a token response.
+func (m *Mock) writeToken(w http.ResponseWriter, status int) {
+	w.Header().Set("X-Subject-Token", Token)
+
+	writeJSON(w, status, m.tokenResponse())
+}
+
+// RegisterIdentity allows gophercloud to derive the correct base path to
+// use for identity operations.  Gophercloud will check the links and
+// preferentially select v3 over v2_0.
+func (m *Mock) RegisterIdentity() {
+	m.router.Get("/identity/", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{
+			"versions": map[string]interface{}{
+				"values": []interface{}{
+					map[string]interface{}{
+						"id":     "v3.14",
+						"status": "stable",
+						"links": []interface{}{
+							map[string]interface{}{
+								"rel":  "self",
+								"href": m.serviceURL("identity/v3"),
+							},
+						},
+						"media-types": []interface{}{
+							map[string]interface{}{
+								"base": "application/json",
+								"type": "application/vnd.openstack.identity-v3+json",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// RegisterIdentityV3AuthTokens is called when we want to login, or do a
+// token exchange/rescoping, and by gophercloud to validate a token and to
+// get the service catalog.
+func (m *Mock) RegisterIdentityV3AuthTokens() {
+	m.router.Post("/identity/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3AuthTokensPost) {
+			return
+		}
+
+		m.writeToken(w, http.StatusCreated)
+	})
+
+	m.router.Get("/identity/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3AuthTokensGet) {
+			return
+		}
+
+		m.writeToken(w, http.StatusOK)
+	})
+}
+
+// RegisterIdentityV3OIDCTokenExchange is called to exchange an ID token issued
+// by an OIDC identity provider for a token, for any identity provider and protocol.
+func (m *Mock) RegisterIdentityV3OIDCTokenExchange() {
+	m.router.Get("/identity/v3/OS-FEDERATION/identity_providers/{idp}/protocols/{protocol}/auth", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3OIDCTokenExchange) {
+			return
+		}
+
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		m.writeToken(w, http.StatusCreated)
+	})
+}
+
+// RegisterIdentityV3User allows a user to be looked up.
+func (m *Mock) RegisterIdentityV3User() {
+	m.router.Get("/identity/v3/users/{user_id}", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3User) {
+			return
+		}
+
+		m.lock.Lock()
+		user := m.user
+		m.lock.Unlock()
+
+		body := map[string]interface{}{
+			"user": map[string]interface{}{
+				"domain_id": domainID,
+				"enabled":   true,
+				"id":        user.ID,
+				"name":      user.Name,
+				"email":     user.Email,
+			},
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// RegisterIdentityV3UserApplicationCredentials allows application credentials
+// to be listed, created and deleted.  Deletion is idempotent.
+func (m *Mock) RegisterIdentityV3UserApplicationCredentials() {
+	m.router.Get("/identity/v3/users/{user_id}/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3UserApplicationCredentialsList) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		credentials := make([]interface{}, len(m.applicationCredentials))
+
+		for i, credential := range m.applicationCredentials {
+			credentials[i] = map[string]interface{}{
+				"id":          credential.ID,
+				"name":        credential.Name,
+				"description": credential.Description,
+			}
+		}
+
+		body := map[string]interface{}{
+			"links":                   map[string]interface{}{},
+			"application_credentials": credentials,
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+
+	m.router.Post("/identity/v3/users/{user_id}/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3UserApplicationCredentialCreate) {
+			return
+		}
+
+		var request struct {
+			ApplicationCredential struct {
+				Name        string `json:"name"`
+				Description string `json:"description"`
+			} `json:"application_credential"`
+		}
+
+		if !readJSON(w, r, &request) {
+			return
+		}
+
+		credential := ApplicationCredential{
+			ID:          uuid.New().String(),
+			Name:        request.ApplicationCredential.Name,
+			Description: request.ApplicationCredential.Description,
+		}
+
+		m.AddApplicationCredential(credential)
+
+		// Please note this is the ONLY time the secret is returned.
+		body := map[string]interface{}{
+			"application_credential": map[string]interface{}{
+				"id":          credential.ID,
+				"name":        credential.Name,
+				"description": credential.Description,
+				"secret":      uuid.New().String(),
+			},
+		}
+
+		writeJSON(w, http.StatusCreated, body)
+	})
+
+	m.router.Delete("/identity/v3/users/{user_id}/application_credentials/{credential_id}", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3UserApplicationCredentialDelete) {
+			return
+		}
+
+		id := chi.URLParam(r, "credential_id")
+
+		m.lock.Lock()
+		m.applicationCredentials = slices.DeleteFunc(m.applicationCredentials, func(credential ApplicationCredential) bool {
+			return credential.ID == id
+		})
+		m.lock.Unlock()
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// RegisterIdentityV3AuthProjects allows the projects a user is a member of to
+// be listed.
+func (m *Mock) RegisterIdentityV3AuthProjects() {
+	m.router.Get("/identity/v3/auth/projects", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3AuthProjects) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		projects := make([]interface{}, len(m.projects))
+
+		for i, project := range m.projects {
+			projects[i] = map[string]interface{}{
+				"id":        project.ID,
+				"name":      project.Name,
+				"domain_id": domainID,
+				"enabled":   true,
+			}
+		}
+
+		body := map[string]interface{}{
+			"links":    map[string]interface{}{},
+			"projects": projects,
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// lookupUser returns the user with the given ID, the caller must hold the lock.
+func (m *Mock) lookupUser(id string) (User, bool) {
+	if m.user.ID == id {
+		return m.user, true
+	}
+
+	index := slices.IndexFunc(m.users, func(user User) bool {
+		return user.ID == id
+	})
+
+	if index < 0 {
+		return User{}, false
+	}
+
+	return m.users[index], true
+}
+
+// lookupRole returns the role with the given ID, the caller must hold the lock.
+func (m *Mock) lookupRole(id string) (Role, bool) {
+	index := slices.IndexFunc(m.roles, func(role Role) bool {
+		return role.ID == id
+	})
+
+	if index < 0 {
+		return Role{}, false
+	}
+
+	return m.roles[index], true
+}
+
+// RegisterIdentityV3Users allows users to be listed, filtered by name.
+func (m *Mock) RegisterIdentityV3Users() {
+	m.router.Get("/identity/v3/users", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3Users) {
+			return
+		}
+
+		name := r.URL.Query().Get("name")
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		users := []interface{}{}
+
+		for _, user := range append([]User{m.user}, m.users...) {
+			if name != "" && user.Name != name {
+				continue
+			}
+
+			users = append(users, map[string]interface{}{
+				"domain_id": domainID,
+				"enabled":   true,
+				"id":        user.ID,
+				"name":      user.Name,
+				"email":     user.Email,
+			})
+		}
+
+		body := map[string]interface{}{
+			"links": map[string]interface{}{},
+			"users": users,
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// RegisterIdentityV3Roles allows roles to be listed.
+func (m *Mock) RegisterIdentityV3Roles() {
+	m.router.Get("/identity/v3/roles", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3Roles) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		roles := make([]interface{}, len(m.roles))
+
+		for i, role := range m.roles {
+			roles[i] = map[string]interface{}{
+				"id":   role.ID,
+				"name": role.Name,
+			}
+		}
+
+		body := map[string]interface{}{
+			"links": map[string]interface{}{},
+			"roles": roles,
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// RegisterIdentityV3RoleAssignments allows project role assignments to be
+// listed, and users to be granted and revoked roles on projects.
+func (m *Mock) RegisterIdentityV3RoleAssignments() {
+	m.router.Get("/identity/v3/role_assignments", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3RoleAssignments) {
+			return
+		}
+
+		projectID := r.URL.Query().Get("scope.project.id")
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		assignments := []interface{}{}
+
+		for _, assignment := range m.roleAssignments {
+			if projectID != "" && assignment.ProjectID != projectID {
+				continue
+			}
+
+			user, _ := m.lookupUser(assignment.UserID)
+			role, _ := m.lookupRole(assignment.RoleID)
+
+			assignments = append(assignments, map[string]interface{}{
+				"role": map[string]interface{}{
+					"id":   role.ID,
+					"name": role.Name,
+				},
+				"scope": map[string]interface{}{
+					"project": map[string]interface{}{
+						"id": assignment.ProjectID,
+					},
+				},
+				"user": map[string]interface{}{
+					"id":   user.ID,
+					"name": user.Name,
+				},
+			})
+		}
+
+		body := map[string]interface{}{
+			"links":            map[string]interface{}{},
+			"role_assignments": assignments,
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+
+	m.router.Put("/identity/v3/projects/{project_id}/users/{user_id}/roles/{role_id}", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3ProjectUserRoleAssign) {
+			return
+		}
+
+		m.AddRoleAssignment(RoleAssignment{
+			ProjectID: chi.URLParam(r, "project_id"),
+			UserID:    chi.URLParam(r, "user_id"),
+			RoleID:    chi.URLParam(r, "role_id"),
+		})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	m.router.Delete("/identity/v3/projects/{project_id}/users/{user_id}/roles/{role_id}", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, IdentityV3ProjectUserRoleUnassign) {
+			return
+		}
+
+		m.RemoveRoleAssignment(RoleAssignment{
+			ProjectID: chi.URLParam(r, "project_id"),
+			UserID:    chi.URLParam(r, "user_id"),
+			RoleID:    chi.URLParam(r, "role_id"),
+		})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// RegisterIdentityHandlers adds all the basic handlers required for token
+// acquisition.
+func (m *Mock) RegisterIdentityHandlers() {
+	m.RegisterIdentity()
+	m.RegisterIdentityV3AuthTokens()
+	m.RegisterIdentityV3User()
+	m.RegisterIdentityV3UserApplicationCredentials()
+	m.RegisterIdentityV3AuthProjects()
+	m.RegisterIdentityV3Users()
+	m.RegisterIdentityV3Roles()
+	m.RegisterIdentityV3RoleAssignments()
+}
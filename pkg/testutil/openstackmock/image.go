@@ -0,0 +1,95 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstackmock
+
+import (
+	"net/http"
+	"time"
+)
+
+// Image is a Glance image.
+type Image struct {
+	ID        string
+	Name      string
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Properties are arbitrary image properties, these are rendered
+	// as top-level fields of the image as per the Glance API.
+	Properties map[string]string
+}
+
+// AddImage adds an image.
+func (m *Mock) AddImage(image Image) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.images = append(m.images, image)
+}
+
+// render converts the image into its API representation, optional fields are
+// omitted when not set.
+func (i *Image) render() map[string]interface{} {
+	out := map[string]interface{}{}
+
+	for k, v := range i.Properties {
+		out[k] = v
+	}
+
+	out["id"] = i.ID
+	out["name"] = i.Name
+
+	if i.Status != "" {
+		out["status"] = i.Status
+	}
+
+	if !i.CreatedAt.IsZero() {
+		out["created_at"] = i.CreatedAt.Format(time.RFC3339)
+	}
+
+	if !i.UpdatedAt.IsZero() {
+		out["updated_at"] = i.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return out
+}
+
+// RegisterImageV2Images allows images to be listed.
+func (m *Mock) RegisterImageV2Images() {
+	m.router.Get("/image/v2/images", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, ImageV2Images) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		images := make([]interface{}, len(m.images))
+
+		for i := range m.images {
+			images[i] = m.images[i].render()
+		}
+
+		body := map[string]interface{}{
+			"first":  "/images/v2/images",
+			"images": images,
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
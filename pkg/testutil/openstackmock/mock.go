@@ -0,0 +1,280 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package openstackmock provides a mock OpenStack cloud that can be embedded
+// in tests.  All services are multiplexed through a single endpoint, and the
+// catalog returned on token issue points back at it.  Tests register the APIs
+// they expect to be called, populate the mock with fixtures, and can toggle
+// individual operations into failure modes to exercise error handling.
+package openstackmock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Operation identifies a single API call, and is used to inject failures.
+type Operation string
+
+const (
+	IdentityV3AuthTokensPost                  Operation = "identity.v3.auth.tokens.post"
+	IdentityV3AuthTokensGet                   Operation = "identity.v3.auth.tokens.get"
+	IdentityV3OIDCTokenExchange               Operation = "identity.v3.oidc.token.exchange"
+	IdentityV3User                            Operation = "identity.v3.user"
+	IdentityV3UserApplicationCredentialsList  Operation = "identity.v3.user.applicationcredentials.list"
+	IdentityV3UserApplicationCredentialCreate Operation = "identity.v3.user.applicationcredentials.create"
+	IdentityV3UserApplicationCredentialDelete Operation = "identity.v3.user.applicationcredentials.delete"
+	IdentityV3AuthProjects                    Operation = "identity.v3.auth.projects"
+	IdentityV3Users                           Operation = "identity.v3.users"
+	IdentityV3Roles                           Operation = "identity.v3.roles"
+	IdentityV3RoleAssignments                 Operation = "identity.v3.roleassignments"
+	IdentityV3ProjectUserRoleAssign           Operation = "identity.v3.project.user.role.assign"
+	IdentityV3ProjectUserRoleUnassign         Operation = "identity.v3.project.user.role.unassign"
+	ImageV2Images                             Operation = "image.v2.images"
+	ComputeV2FlavorsDetail                    Operation = "compute.v2.flavors.detail"
+	ComputeV2ServerGroupsList                 Operation = "compute.v2.servergroups.list"
+	ComputeV2ServerGroupCreate                Operation = "compute.v2.servergroups.create"
+	ComputeV2Keypairs                         Operation = "compute.v2.keypairs"
+	ComputeV2AvailabilityZone                 Operation = "compute.v2.availabilityzone"
+	ComputeV2AvailabilityZoneDetail           Operation = "compute.v2.availabilityzone.detail"
+	ComputeV2QuotaSetsDetail                  Operation = "compute.v2.quotasets.detail"
+	BlockStorageV3AvailabilityZone            Operation = "blockstorage.v3.availabilityzone"
+	BlockStorageV3QuotaSets                   Operation = "blockstorage.v3.quotasets"
+	NetworkV2Networks                         Operation = "network.v2.networks"
+	NetworkV2Subnet                           Operation = "network.v2.subnet"
+	NetworkV2QuotasDetails                    Operation = "network.v2.quotas.details"
+)
+
+// Mock is a mock OpenStack cloud.  Handlers are registered on demand, and
+// render their responses from the fixtures at the time of the request, so
+// fixtures may be modified at any time.
+type Mock struct {
+	// router is where API handlers are registered.
+	router chi.Router
+
+	// endpoint is the host and port the router is served on, used to
+	// generate service catalog entries.
+	endpoint string
+
+	// lock serializes access to fixtures as handlers are called
+	// concurrently.
+	lock sync.Mutex
+
+	// failures records operations that should fail, and with what
+	// HTTP status code.
+	failures map[Operation]int
+
+	// failureCounts records how many more times an operation should fail
+	// before succeeding, operations not present fail until cleared.
+	failureCounts map[Operation]int
+
+	user                          User
+	users                         []User
+	roles                         []Role
+	roleAssignments               []RoleAssignment
+	projects                      []Project
+	applicationCredentials        []ApplicationCredential
+	images                        []Image
+	flavors                       []Flavor
+	serverGroups                  []ServerGroup
+	keyPairs                      []string
+	computeAvailabilityZones      []ComputeAvailabilityZone
+	blockStorageAvailabilityZones []string
+	networks                      []Network
+	subnets                       []Subnet
+	computeQuota                  ComputeQuota
+	blockStorageQuota             BlockStorageQuota
+	networkQuota                  NetworkQuota
+}
+
+// New returns a mock that registers handlers on the provided router, which must
+// be served at the given endpoint (host and port).
+func New(router chi.Router, endpoint string) *Mock {
+	return &Mock{
+		router:        router,
+		endpoint:      endpoint,
+		failures:      map[Operation]int{},
+		failureCounts: map[Operation]int{},
+	}
+}
+
+// Endpoint returns the host and port the mock is served on.
+func (m *Mock) Endpoint() string {
+	return m.endpoint
+}
+
+// Router returns the underlying router, allowing custom handlers to be
+// registered for scenarios the mock doesn't cater for.
+func (m *Mock) Router() chi.Router {
+	return m.router
+}
+
+// Fail causes the operation to return an error with the provided status code
+// until cleared.
+func (m *Mock) Fail(operation Operation, status int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.failures[operation] = status
+	delete(m.failureCounts, operation)
+}
+
+// FailTimes causes the operation to return an error with the provided status
+// code the given number of times, after which it will succeed again.
+func (m *Mock) FailTimes(operation Operation, status, times int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.failures[operation] = status
+	m.failureCounts[operation] = times
+}
+
+// ClearFailure allows an operation to succeed again.
+func (m *Mock) ClearFailure(operation Operation) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.failures, operation)
+	delete(m.failureCounts, operation)
+}
+
+// RegisterHandlers registers every API the mock implements.
+func (m *Mock) RegisterHandlers() {
+	m.RegisterIdentityHandlers()
+	m.RegisterIdentityV3OIDCTokenExchange()
+	m.RegisterImageV2Images()
+	m.RegisterComputeV2FlavorsDetail()
+	m.RegisterComputeV2ServerGroups()
+	m.RegisterComputeV2Keypairs()
+	m.RegisterComputeV2AvailabilityZone()
+	m.RegisterComputeV2AvailabilityZoneDetail()
+	m.RegisterBlockStorageV3AvailabilityZone()
+	m.RegisterNetworkV2Networks()
+	m.RegisterNetworkV2Subnet()
+	m.RegisterQuotaHandlers()
+}
+
+// RegisterQuotaHandlers registers all quota handlers.
+func (m *Mock) RegisterQuotaHandlers() {
+	m.RegisterComputeV2QuotaSetsDetail()
+	m.RegisterBlockStorageV3QuotaSets()
+	m.RegisterNetworkV2QuotasDetails()
+}
+
+// openstackError is the generic error format returned by OpenStack APIs.
+type openstackError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Title   string `json:"title"`
+}
+
+// errorMessages are the canonical messages for common errors.
+//
+//nolint:gochecknoglobals
+var errorMessages = map[int]string{
+	http.StatusUnauthorized: "The request you have made requires authentication.",
+	http.StatusForbidden:    "You are not authorized to perform the requested action.",
+	http.StatusConflict:     "Conflict occurred attempting to store resource - Duplicate entry.",
+}
+
+// failed writes an error response if a failure has been injected for the
+// operation, and reports whether it did so.
+func (m *Mock) failed(w http.ResponseWriter, operation Operation) bool {
+	m.lock.Lock()
+	status, ok := m.failures[operation]
+
+	if count, counted := m.failureCounts[operation]; ok && counted {
+		if count--; count == 0 {
+			delete(m.failures, operation)
+			delete(m.failureCounts, operation)
+		} else {
+			m.failureCounts[operation] = count
+		}
+	}
+
+	m.lock.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	message, ok := errorMessages[status]
+	if !ok {
+		message = http.StatusText(status)
+	}
+
+	body := map[string]openstackError{
+		"error": {
+			Code:    status,
+			Message: message,
+			Title:   http.StatusText(status),
+		},
+	}
+
+	writeJSON(w, status, body)
+
+	return true
+}
+
+// writeJSON writes out a JSON response body.  Errors are ignored as they
+// can only be caused by the client going away.
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// readJSON decodes a request body, responding with an error on failure.
+func readJSON(w http.ResponseWriter, r *http.Request, body interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return false
+	}
+
+	return true
+}
+
+// Server is a stand alone mock OpenStack server.
+type Server struct {
+	*Mock
+
+	// server is the underlying HTTP server.
+	server *httptest.Server
+}
+
+// NewServer starts a mock OpenStack server listening on a random local port.
+// Call Close when done.
+func NewServer() *Server {
+	router := chi.NewRouter()
+
+	server := httptest.NewServer(router)
+
+	return &Server{
+		Mock:   New(router, server.Listener.Addr().String()),
+		server: server,
+	}
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
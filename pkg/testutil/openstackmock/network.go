@@ -0,0 +1,153 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstackmock
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Network is a Neutron network.
+type Network struct {
+	ID       string `json:"id"`
+	External bool   `json:"router:external"`
+}
+
+// Subnet is a Neutron subnet.
+type Subnet struct {
+	ID        string `json:"id"`
+	NetworkID string `json:"network_id"`
+	CIDR      string `json:"cidr"`
+}
+
+// NetworkQuotaDetail is the quota and usage of a network resource.
+type NetworkQuotaDetail struct {
+	Used     int `json:"used"`
+	Limit    int `json:"limit"`
+	Reserved int `json:"reserved"`
+}
+
+// NetworkQuota is the network quota of a project.
+type NetworkQuota struct {
+	FloatingIP    NetworkQuotaDetail `json:"floatingip"`
+	Network       NetworkQuotaDetail `json:"network"`
+	Port          NetworkQuotaDetail `json:"port"`
+	Router        NetworkQuotaDetail `json:"router"`
+	SecurityGroup NetworkQuotaDetail `json:"security_group"`
+}
+
+// AddNetwork adds a network.
+func (m *Mock) AddNetwork(network Network) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.networks = append(m.networks, network)
+}
+
+// AddSubnet adds a subnet.
+func (m *Mock) AddSubnet(subnet Subnet) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.subnets = append(m.subnets, subnet)
+}
+
+// SetNetworkQuota sets the network quota for all projects.
+func (m *Mock) SetNetworkQuota(quota NetworkQuota) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.networkQuota = quota
+}
+
+// RegisterNetworkV2Networks allows networks to be listed, optionally filtered
+// by whether they are external.
+func (m *Mock) RegisterNetworkV2Networks() {
+	m.router.Get("/network/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, NetworkV2Networks) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		external := r.URL.Query().Get("router:external")
+
+		networks := []Network{}
+
+		for _, network := range m.networks {
+			if external == "true" && !network.External || external == "false" && network.External {
+				continue
+			}
+
+			networks = append(networks, network)
+		}
+
+		body := map[string]interface{}{
+			"networks": networks,
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// RegisterNetworkV2Subnet allows a subnet to be read.
+func (m *Mock) RegisterNetworkV2Subnet() {
+	m.router.Get("/network/v2.0/subnets/{subnet_id}", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, NetworkV2Subnet) {
+			return
+		}
+
+		id := chi.URLParam(r, "subnet_id")
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		for _, subnet := range m.subnets {
+			if subnet.ID == id {
+				body := map[string]interface{}{
+					"subnet": subnet,
+				}
+
+				writeJSON(w, http.StatusOK, body)
+
+				return
+			}
+		}
+
+		http.NotFound(w, r)
+	})
+}
+
+// RegisterNetworkV2QuotasDetails allows network quotas and usage to be read.
+func (m *Mock) RegisterNetworkV2QuotasDetails() {
+	m.router.Get("/network/v2.0/quotas/{project_id}/details.json", func(w http.ResponseWriter, r *http.Request) {
+		if m.failed(w, NetworkV2QuotasDetails) {
+			return
+		}
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		body := map[string]interface{}{
+			"quota": m.networkQuota,
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	})
+}
@@ -0,0 +1,1155 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package docs renders the server API documentation, which is checked in and
+// published as a release asset.  It is a fork of unikorn-core's hack/docs (as
+// of v0.1.1), whose output changes between runs as it iterates over maps.
+// Rendering is deterministic here, so CI can check the committed docs are up to
+// date.  Unlike library code, hack/docs is a main package that cannot be
+// imported or extended, so it is forked rather than fixed upstream first; this
+// fork should be dropped once unikorn-core renders deterministically.
+package docs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+	"github.com/eschercloudai/unikorn/pkg/collections"
+	"github.com/eschercloudai/unikorn/pkg/docs/document"
+)
+
+var (
+	// ErrDocument is raised when the OpenAPI document doesn't meet standards.
+	// TODO: push me down into the validation.
+	ErrDocument = errors.New("document error")
+
+	// ErrFlag is raised when a flag is invalid.
+	ErrFlag = errors.New("flag error")
+
+	// ErrOutOfDate is raised in check mode when the rendered output differs
+	// from what is on disk.
+	ErrOutOfDate = errors.New("documentation is out of date")
+)
+
+// FormatterVar defines a type that represents a formatter.
+type FormatterVar string
+
+const (
+	// HTMLFormatter emits raw HTML code, without the need of a full documentation
+	// engine checkout.
+	HTMLFormatter FormatterVar = "html"
+
+	// MarkdownFormatter emits markdown based code, suitable for things like
+	// Docusaurus.
+	MarkdownFormatter FormatterVar = "markdown"
+)
+
+func (f FormatterVar) String() string {
+	return string(f)
+}
+
+func (f *FormatterVar) Set(s string) error {
+	allowed := []FormatterVar{
+		HTMLFormatter,
+		MarkdownFormatter,
+	}
+
+	ok := false
+
+	for _, a := range allowed {
+		if a == FormatterVar(s) {
+			ok = true
+			break
+		}
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: formatter flag must be one of %v", ErrFlag, allowed)
+	}
+
+	*f = FormatterVar(s)
+
+	return nil
+}
+
+func (f FormatterVar) Type() string {
+	return "string"
+}
+
+// extension returns the file extension for the formatter's output.
+func (f FormatterVar) extension() string {
+	if f == HTMLFormatter {
+		return ".html"
+	}
+
+	return ".md"
+}
+
+// Options define documentation rendering options.
+type Options struct {
+	// SpellCheck does a spell checking run.
+	SpellCheck bool
+
+	// OpenAPISchemas defines where the openapi schemas live, may be relative or
+	// absolute paths.  Each schema documents a single API version.
+	OpenAPISchemas []string
+
+	// Dictionaries is a list of dictionaries, with one word per line.
+	Dictionaries []string
+
+	// Formatter defines the formatter backend to use.
+	Formatter FormatterVar
+
+	// Output defines where to send the rendered output.
+	Output string
+
+	// OutputDir defines a directory to send versioned output to, with one
+	// subdirectory per API version and an index page.
+	OutputDir string
+
+	// DryRun defines whether to write anything, useful for CI.
+	DryRun bool
+
+	// Check renders the document and compares it with the existing output,
+	// failing if they differ.
+	Check bool
+
+	// SpellCheckStrict fails the run if any misspellings are found.
+	SpellCheckStrict bool
+
+	// SpellCheckReport defines where to write a JSON report of misspellings.
+	SpellCheckReport string
+
+	// runtime provides consistent output and error handling.
+	runtime *runtime.Runtime
+}
+
+// NewOptions returns options with defaults suitable for this repository.
+func NewOptions(r *runtime.Runtime) *Options {
+	return &Options{
+		SpellCheck:     true,
+		OpenAPISchemas: []string{"pkg/server/openapi/server.spec.yaml"},
+		Dictionaries:   []string{"/usr/share/dict/british-english", "pkg/tools/docs/custom.dict"},
+		Formatter:      MarkdownFormatter,
+		runtime:        r,
+	}
+}
+
+// AddFlags adds options to the flag set.
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.SpellCheck, "spell-check", o.SpellCheck, "Enable spell checking preprocessor")
+	flags.BoolVar(&o.SpellCheckStrict, "spell-check-strict", o.SpellCheckStrict, "Fail if any words are not found in the dictionary")
+	flags.StringVar(&o.SpellCheckReport, "spell-check-report", o.SpellCheckReport, "Write a JSON report of misspellings to the given file")
+	flags.StringArrayVar(&o.OpenAPISchemas, "openapi-schema", o.OpenAPISchemas, "Path to the openapi schema, may be specified multiple times")
+	flags.StringArrayVarP(&o.Dictionaries, "dictionary", "d", o.Dictionaries, "Path to the dictionary file, may be specified multiple times")
+	flags.VarP(&o.Formatter, "formatter", "f", "Output formatter type")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Output file, only valid with a single schema")
+	flags.StringVar(&o.OutputDir, "output-dir", o.OutputDir, "Output directory for versioned documentation")
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Whether to run with no side effects")
+	flags.BoolVar(&o.Check, "check", o.Check, "Fail if the output file differs from a fresh render, implies no side effects")
+}
+
+// convertParameter does spellchecking and returns the internal representation.
+func (o *Options) convertParameter(parameter *openapi3.Parameter, spellchecker *spellChecker, path string) (*document.Parameter, error) {
+	description, err := o.spellCheckParagraph(spellchecker, jsonPathKey(path, "description"), parameter.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &document.Parameter{
+		Name:        parameter.Name,
+		Description: description,
+	}
+
+	return p, nil
+}
+
+// selectContent picks the content type to document.  Iterating over the map
+// directly would yield a different result on each run when multiple content
+// types are defined, so prefer JSON, then the first type in lexical order.
+func selectContent(content openapi3.Content) (string, *openapi3.MediaType) {
+	if len(content) == 0 {
+		return "", nil
+	}
+
+	if media, ok := content["application/json"]; ok {
+		return "application/json", media
+	}
+
+	types := collections.SortedKeys(content)
+
+	return types[0], content[types[0]]
+}
+
+// convertContent does spellchecking and returns the internal representation.
+func (o *Options) convertContent(content string, media *openapi3.MediaType) *document.Content {
+	c := &document.Content{
+		Type:    content,
+		Example: media.Example,
+	}
+
+	if media.Schema != nil {
+		c.Schema = media.Schema.Value
+	}
+
+	return c
+}
+
+// cconvertRequestBod does spellchecking and returns the internal representation.
+func (o *Options) convertRequestBody(requestBody *openapi3.RequestBody, spellchecker *spellChecker, path string) (*document.RequestBody, error) {
+	description, err := o.spellCheckParagraph(spellchecker, jsonPathKey(path, "description"), requestBody.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &document.RequestBody{
+		Required:    requestBody.Required,
+		Description: description,
+	}
+
+	if content, media := selectContent(requestBody.Content); media != nil {
+		b.Content = o.convertContent(content, media)
+	}
+
+	return b, nil
+}
+
+// convertResponse does spellchecking and returns the internal representation.
+func (o *Options) convertResponse(status int, response *openapi3.Response, spellchecker *spellChecker, path string) (*document.Response, error) {
+	description, err := o.spellCheckParagraph(spellchecker, jsonPathKey(path, "description"), *response.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &document.Response{
+		Status:      strconv.Itoa(status),
+		Description: description,
+	}
+
+	if content, media := selectContent(response.Content); media != nil {
+		r.Content = o.convertContent(content, media)
+	}
+
+	return r, nil
+}
+
+// convertSecurityRequirements returns the security requirements for an operation,
+// which are inherited from the document if not overridden.
+func convertSecurityRequirements(doc *openapi3.T, operation *openapi3.Operation) document.SecurityRequirementList {
+	requirements := doc.Security
+
+	if operation.Security != nil {
+		requirements = *operation.Security
+	}
+
+	out := make(document.SecurityRequirementList, 0, len(requirements))
+
+	for _, requirement := range requirements {
+		r := make(document.SecurityRequirement, 0, len(requirement))
+
+		for _, scheme := range collections.SortedKeys(requirement) {
+			r = append(r, &document.SchemeRequirement{
+				Scheme: scheme,
+				Scopes: requirement[scheme],
+			})
+		}
+
+		out = append(out, r)
+	}
+
+	return out
+}
+
+// convertSecurityScheme does spellchecking and returns the internal representation.
+func (o *Options) convertSecurityScheme(name string, scheme *openapi3.SecurityScheme, spellchecker *spellChecker) (*document.SecurityScheme, error) {
+	path := jsonPathKey("$.components.securitySchemes", name)
+
+	description, err := o.spellCheckParagraph(spellchecker, jsonPathKey(path, "description"), scheme.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &document.SecurityScheme{
+		Name:        name,
+		Type:        scheme.Type,
+		Description: description,
+	}
+
+	if scheme.Flows == nil {
+		return s, nil
+	}
+
+	flows := map[string]*openapi3.OAuthFlow{
+		"authorizationCode": scheme.Flows.AuthorizationCode,
+		"clientCredentials": scheme.Flows.ClientCredentials,
+		"implicit":          scheme.Flows.Implicit,
+		"password":          scheme.Flows.Password,
+	}
+
+	// Flows generally share the same scopes, so merge them together rather
+	// than repeat them for each flow.  Keep track of where the description
+	// came from for error reporting.
+	scopes := map[string]string{}
+	scopePaths := map[string]string{}
+
+	for _, flowName := range collections.SortedKeys(flows) {
+		flow := flows[flowName]
+		if flow == nil {
+			continue
+		}
+
+		s.Flows = append(s.Flows, &document.OAuth2Flow{
+			Name:             flowName,
+			AuthorizationURL: flow.AuthorizationURL,
+			TokenURL:         flow.TokenURL,
+		})
+
+		for scope, description := range flow.Scopes {
+			scopes[scope] = description
+			scopePaths[scope] = jsonPathKey(jsonPathKey(jsonPathKey(path, "flows"), flowName), "scopes")
+		}
+	}
+
+	for _, scope := range collections.SortedKeys(scopes) {
+		description, err := o.spellCheckParagraph(spellchecker, jsonPathKey(scopePaths[scope], scope), scopes[scope])
+		if err != nil {
+			return nil, err
+		}
+
+		s.Scopes = append(s.Scopes, &document.Scope{
+			Name:        scope,
+			Description: description,
+		})
+	}
+
+	return s, nil
+}
+
+// convertSecuritySchemes returns all the security schemes in name order.
+func (o *Options) convertSecuritySchemes(doc *openapi3.T, spellchecker *spellChecker) (document.SecuritySchemeList, error) {
+	if doc.Components == nil {
+		return nil, nil
+	}
+
+	var out document.SecuritySchemeList
+
+	for _, name := range collections.SortedKeys(doc.Components.SecuritySchemes) {
+		scheme := doc.Components.SecuritySchemes[name]
+
+		s, err := o.convertSecurityScheme(name, scheme.Value, spellchecker)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, s)
+	}
+
+	return out, nil
+}
+
+// convertOperation does spellchecking and returns the internal representation.
+func (o *Options) convertOperation(doc *openapi3.T, method string, operation *openapi3.Operation, spellchecker *spellChecker, path string) (*document.Operation, error) {
+	description, err := o.spellCheckParagraph(spellchecker, jsonPathKey(path, "description"), operation.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &document.Operation{
+		Method:      method,
+		Description: description,
+		Security:    convertSecurityRequirements(doc, operation),
+	}
+
+	if operation.RequestBody != nil {
+		b, err := o.convertRequestBody(operation.RequestBody.Value, spellchecker, jsonPathKey(path, "requestBody"))
+		if err != nil {
+			return nil, err
+		}
+
+		op.RequestBody = b
+	}
+
+	for status := 100; status < 600; status++ {
+		response := operation.Responses.Status(status)
+		if response == nil {
+			continue
+		}
+
+		r, err := o.convertResponse(status, response.Value, spellchecker, jsonPathKey(jsonPathKey(path, "responses"), strconv.Itoa(status)))
+		if err != nil {
+			return nil, err
+		}
+
+		op.Responses = append(op.Responses, r)
+	}
+
+	return op, nil
+}
+
+// convertPath does spellchecking and returns the internal representation.
+// Additionally it checks that the path is given an API group.
+func (o *Options) convertPath(doc *openapi3.T, path string, pathItem *openapi3.PathItem, spellchecker *spellChecker) (*document.Path, error) {
+	location := jsonPathKey("$.paths", path)
+
+	description, err := o.spellCheckParagraph(spellchecker, jsonPathKey(location, "description"), pathItem.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID, ok := pathItem.Extensions["x-documentation-group"].(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: path %s must have API group defined", ErrDocument, path)
+	}
+
+	p := &document.Path{
+		GroupID:     groupID,
+		Path:        path,
+		Description: description,
+	}
+
+	for i, parameter := range pathItem.Parameters {
+		pr, err := o.convertParameter(parameter.Value, spellchecker, jsonPathKey(jsonPathKey(location, "parameters"), i))
+		if err != nil {
+			return nil, err
+		}
+
+		p.Parameters = append(p.Parameters, pr)
+	}
+
+	sort.Stable(p.Parameters)
+
+	operations := pathItem.Operations()
+
+	methods := collections.SortedKeys(operations)
+
+	for _, method := range methods {
+		op, err := o.convertOperation(doc, method, operations[method], spellchecker, jsonPathKey(location, strings.ToLower(method)))
+		if err != nil {
+			return nil, err
+		}
+
+		p.Operations = append(p.Operations, op)
+	}
+
+	sort.Stable(p.Operations)
+
+	return p, nil
+}
+
+// convertGroups ensures the groups extension is implemented and spelling is fine.
+func (o *Options) convertGroups(doc *openapi3.T, spellchecker *spellChecker) (document.GroupList, error) {
+	data, ok := doc.Extensions["x-documentation-groups"]
+	if !ok {
+		return nil, fmt.Errorf("%w: document must have API groups defined", ErrDocument)
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups document.GroupList
+
+	if err := json.Unmarshal(jsonData, &groups); err != nil {
+		return nil, err
+	}
+
+	for i, group := range groups {
+		path := jsonPathKey(jsonPathKey("$", "x-documentation-groups"), i)
+
+		name, err := o.spellCheckParagraph(spellchecker, jsonPathKey(path, "name"), group.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		description, err := o.spellCheckParagraph(spellchecker, jsonPathKey(path, "description"), group.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		group.Name = name
+		group.Description = description
+	}
+
+	return groups, nil
+}
+
+// convertDocument takes the raw OpenAPI document and converts it into
+// an internal representation that does things like grouping and ordering
+// of content.
+func (o *Options) convertDocument(doc *openapi3.T, spellchecker *spellChecker) (*document.Document, error) {
+	description, err := o.spellCheckParagraph(spellchecker, "$.info.description", doc.Info.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := o.convertGroups(doc, spellchecker)
+	if err != nil {
+		return nil, err
+	}
+
+	securitySchemes, err := o.convertSecuritySchemes(doc, spellchecker)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &document.Document{
+		Name:            doc.Info.Title,
+		Description:     description,
+		Version:         doc.Info.Version,
+		SecuritySchemes: securitySchemes,
+		Groups:          groups,
+	}
+
+	for _, path := range doc.Paths.InMatchingOrder() {
+		pathItem := doc.Paths.Find(path)
+
+		p, err := o.convertPath(doc, path, pathItem, spellchecker)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := d.Groups.AddPath(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+// formatSchema recursively does a depth first traversal of the schema, formatting
+// each JSON path into a nice table that explains the field.
+func formatSchema(schema *openapi3.Schema, f formatter, required bool, jsonPath string) {
+	// The root element is special and needs defaulting.
+	printPath := "."
+
+	if jsonPath != "" {
+		printPath = jsonPath
+	}
+
+	requiredSymbol := "✘"
+
+	if required {
+		requiredSymbol = "✔"
+	}
+
+	description := strings.ReplaceAll(schema.Description, "\n", " ")
+
+	f.TD(printPath, schema.Type, requiredSymbol, description)
+
+	switch schema.Type {
+	case "object":
+		properties := collections.SortedKeys(schema.Properties)
+
+		for _, name := range properties {
+			property := schema.Properties[name]
+
+			formatSchema(property.Value, f, slices.Contains(schema.Required, name), fmt.Sprintf("%s.%s", jsonPath, name))
+		}
+	case "array":
+		arrayItemRequired := false
+
+		if schema.MinItems > 0 {
+			arrayItemRequired = true
+		}
+
+		formatSchema(schema.Items.Value, f, arrayItemRequired, fmt.Sprintf("%s.0", jsonPath))
+	}
+}
+
+// formatParameters emits parameters, if definedi, as a table.
+func formatParameters(parameters document.ParameterList, f formatter) {
+	if len(parameters) == 0 {
+		return
+	}
+
+	f.Details("Request Parameters", func() {
+		f.Table()
+		f.TH("Name", "Description")
+
+		for _, p := range parameters {
+			f.TD(p.Name, p.Description)
+		}
+
+		f.TableEnd()
+	})
+}
+
+// formatRequestBody emits a details section (hidden by default)
+// that includes a description, schema and example.
+func formatRequestBody(requestBody *document.RequestBody, f formatter) {
+	if requestBody == nil {
+		return
+	}
+
+	f.Details("Request Body", func() {
+		f.P(requestBody.Description)
+
+		if requestBody.Content == nil {
+			return
+		}
+
+		f.P("The content type is \"" + requestBody.Content.Type + "\".")
+
+		if requestBody.Content.Schema != nil {
+			f.H5("", "Fields")
+			f.P("This describes the request body object in terms of the JSON path specification that will be familiar to Kubernetes users. Where a child element is an array, it has been substituted with a \"0\", representing the first element indexed from zero.")
+
+			f.Table()
+			f.TH("JSON Path", "Type", "Required", "Description")
+			formatSchema(requestBody.Content.Schema, f, true, "")
+			f.TableEnd()
+		}
+
+		if requestBody.Content.Example != nil {
+			example, err := json.MarshalIndent(requestBody.Content.Example, "", "  ")
+			if err != nil {
+				f.P("Unable to generate example.")
+				return
+			}
+
+			f.H5("", "Example")
+			f.Code("json", "Example", string(example))
+		}
+	})
+}
+
+// formatRequestBody emits a details section (hidden by default)
+// that includes a description, schema and example.
+func formatResponse(r *document.Response, f formatter) {
+	f.Details("HTTP "+r.Status, func() {
+		f.P(r.Description)
+
+		if r.Content == nil {
+			return
+		}
+
+		f.P("The content type is \"" + r.Content.Type + "\".")
+
+		if r.Content.Schema != nil {
+			f.H5("", "Fields")
+			f.P("This describes the returned object in terms of the JSON path specification that will be familiar to Kubernetes users. Where a child element is an array, it has been substituted with a \"0\", representing the first element indexed from zero.")
+
+			f.Table()
+			f.TH("JSON Path", "Type", "Required", "Description")
+			formatSchema(r.Content.Schema, f, true, "")
+			f.TableEnd()
+		}
+
+		if r.Content.Example != nil {
+			example, err := json.MarshalIndent(r.Content.Example, "", "  ")
+			if err != nil {
+				f.P("Unable to generate example.")
+				return
+			}
+
+			f.H5("", "Example")
+			f.Code("json", "Example", string(example))
+		}
+	})
+}
+
+// anchorInvalidChars matches anything that cannot appear in an anchor ID.
+var anchorInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// anchor generates a stable anchor ID from the provided components.  These are
+// derived solely from API identifiers, so links remain valid as long as the
+// API itself doesn't change, regardless of edits to descriptions.
+func anchor(parts ...string) string {
+	for i := range parts {
+		parts[i] = strings.Trim(anchorInvalidChars.ReplaceAllString(strings.ToLower(parts[i]), "-"), "-")
+	}
+
+	return strings.Join(parts, "-")
+}
+
+// groupAnchor returns the anchor for an API group.
+func groupAnchor(g *document.Group) string {
+	return anchor("group", g.ID)
+}
+
+// pathAnchor returns the anchor for an API path.
+func pathAnchor(p *document.Path) string {
+	return anchor("path", p.Path)
+}
+
+// operationAnchor returns the anchor for an operation on an API path.
+func operationAnchor(p *document.Path, o *document.Operation) string {
+	return anchor("path", p.Path, o.Method)
+}
+
+// securitySchemeAnchor returns the anchor for a security scheme.
+func securitySchemeAnchor(name string) string {
+	return anchor("authentication", name)
+}
+
+// checkAnchors ensures all anchors are unique, otherwise links would be ambiguous.
+func checkAnchors(d *document.Document) error {
+	anchors := map[string]bool{}
+
+	add := func(id string) error {
+		if anchors[id] {
+			return fmt.Errorf("%w: duplicate anchor %s", ErrDocument, id)
+		}
+
+		anchors[id] = true
+
+		return nil
+	}
+
+	if len(d.SecuritySchemes) != 0 {
+		if err := add("authentication"); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range d.SecuritySchemes {
+		if err := add(securitySchemeAnchor(s.Name)); err != nil {
+			return err
+		}
+	}
+
+	for _, g := range d.Groups {
+		if err := add(groupAnchor(g)); err != nil {
+			return err
+		}
+
+		for _, p := range g.Paths {
+			if err := add(pathAnchor(p)); err != nil {
+				return err
+			}
+
+			for _, o := range p.Operations {
+				if err := add(operationAnchor(p, o)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatSecurity describes how an operation is authenticated.  Scheme names
+// link to the scheme's description in the authentication section.
+func formatSecurity(security document.SecurityRequirementList, f formatter) {
+	if len(security) == 0 {
+		f.P("No authentication is required.")
+
+		return
+	}
+
+	alternatives := make([]string, len(security))
+
+	for i, requirement := range security {
+		schemes := make([]string, len(requirement))
+
+		for j, scheme := range requirement {
+			schemes[j] = f.Link("#"+securitySchemeAnchor(scheme.Scheme), scheme.Scheme)
+
+			switch len(scheme.Scopes) {
+			case 0:
+			case 1:
+				schemes[j] += " with scope " + scheme.Scopes[0]
+			default:
+				schemes[j] += " with scopes " + strings.Join(scheme.Scopes, ", ")
+			}
+		}
+
+		alternatives[i] = strings.Join(schemes, " and ")
+	}
+
+	f.P("Requires " + strings.Join(alternatives, " or ") + ".")
+}
+
+// formatSecuritySchemes emits a section that describes how clients can
+// authenticate with the API, and what scopes tokens may be granted.
+func formatSecuritySchemes(schemes document.SecuritySchemeList, f formatter) {
+	if len(schemes) == 0 {
+		return
+	}
+
+	f.H2("authentication", "Authentication")
+
+	for _, s := range schemes {
+		f.H3(securitySchemeAnchor(s.Name), s.Name)
+		f.P(s.Description)
+		f.P("The scheme type is \"" + s.Type + "\".")
+
+		if len(s.Flows) != 0 {
+			f.P("Tokens may be acquired with the following flows.")
+
+			f.Table()
+			f.TH("Flow", "Authorization URL", "Token URL")
+
+			for _, flow := range s.Flows {
+				f.TD(flow.Name, flow.AuthorizationURL, flow.TokenURL)
+			}
+
+			f.TableEnd()
+		}
+
+		if len(s.Scopes) != 0 {
+			f.P("Tokens may be granted the following scopes.")
+
+			f.Table()
+			f.TH("Scope", "Description")
+
+			for _, scope := range s.Scopes {
+				f.TD(scope.Name, scope.Description)
+			}
+
+			f.TableEnd()
+		}
+	}
+}
+
+// formatOperations emits a set of operations and descriptions, further details
+// such as request bodies and responses are hidden away by default as details
+// sections.
+func formatOperations(p *document.Path, f formatter) {
+	for _, o := range p.Operations {
+		f.H4(operationAnchor(p, o), o.Method)
+		f.P(o.Description)
+
+		formatSecurity(o.Security, f)
+
+		formatRequestBody(o.RequestBody, f)
+
+		f.Details("Responses", func() {
+			for _, r := range o.Responses {
+				formatResponse(r, f)
+			}
+		})
+	}
+}
+
+// formatPath emits a path, its parameters, and any operations associated with it.
+func formatPath(p *document.Path, f formatter) {
+	f.H3(pathAnchor(p), p.Path)
+	f.P(p.Description)
+
+	formatParameters(p.Parameters, f)
+	formatOperations(p, f)
+}
+
+// formatGroup emits a proprietary grouping that allows paths to be group together with
+// an explainatory description.
+func formatGroup(g *document.Group, f formatter) {
+	f.H2(groupAnchor(g), g.Name)
+	f.P(g.Description)
+
+	for _, p := range g.Paths {
+		formatPath(p, f)
+	}
+}
+
+// formatDocument describes the entire API, then all groups of API paths as children.
+func formatDocument(d *document.Document, f formatter) {
+	f.TableOfContentsLevel(2, 3)
+
+	f.H1("", d.Name)
+	f.P(d.Description)
+
+	f.Warning("This API is currently in beta, and is subject to change without notification.  It is recommended that you use an official client, for example the web console, until general availability.")
+
+	formatSecuritySchemes(d.SecuritySchemes, f)
+
+	for _, g := range d.Groups {
+		formatGroup(g, f)
+	}
+}
+
+// apiVersionRegexp extracts the API version from a path.
+var apiVersionRegexp = regexp.MustCompile(`^/api/(v[0-9]+[a-z0-9]*)/`)
+
+// apiVersion derives the API version from the document's paths, every path
+// must agree on the version.
+func apiVersion(doc *openapi3.T) (string, error) {
+	var version string
+
+	for _, path := range doc.Paths.InMatchingOrder() {
+		matches := apiVersionRegexp.FindStringSubmatch(path)
+		if matches == nil {
+			return "", fmt.Errorf("%w: path %s is not versioned", ErrDocument, path)
+		}
+
+		if version != "" && matches[1] != version {
+			return "", fmt.Errorf("%w: path %s is not version %s", ErrDocument, path, version)
+		}
+
+		version = matches[1]
+	}
+
+	if version == "" {
+		return "", fmt.Errorf("%w: document has no paths", ErrDocument)
+	}
+
+	return version, nil
+}
+
+// rendered is a rendered API document.
+type rendered struct {
+	// version is the API version documented.
+	version string
+
+	// document is the internal representation of the API.
+	document *document.Document
+
+	// data is the rendered output.
+	data []byte
+}
+
+// newFormatter creates the correct formatter.
+func (o *Options) newFormatter(output *bytes.Buffer) formatter {
+	if o.Formatter == HTMLFormatter {
+		return newHTMLFormatter(output)
+	}
+
+	return newMarkdownFormatter(output)
+}
+
+// render parses the OpenAPI specification, converts it into an internal representation
+// and renders the result.
+func (o *Options) render(path string, spellchecker *spellChecker) (*rendered, error) {
+	// Load in the OpenAPI schema.
+	loader := openapi3.NewLoader()
+
+	doc, err := loader.LoadFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := apiVersion(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := o.convertDocument(doc, spellchecker.withFile(path))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAnchors(d); err != nil {
+		return nil, err
+	}
+
+	output := &bytes.Buffer{}
+
+	// Let's get ready to rumble!
+	formatDocument(d, o.newFormatter(output))
+
+	r := &rendered{
+		version:  version,
+		document: d,
+		data:     output.Bytes(),
+	}
+
+	return r, nil
+}
+
+// renderAll renders every API document, ordered by version.
+func (o *Options) renderAll() ([]*rendered, error) {
+	// Load in our dictionaries.
+	spellchecker, err := createSpellChecker(o)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*rendered, 0, len(o.OpenAPISchemas))
+
+	for _, path := range o.OpenAPISchemas {
+		r, err := o.render(path, spellchecker)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		for _, existing := range result {
+			if existing.version == r.version {
+				return nil, fmt.Errorf("%w: %s documents duplicate version %s", ErrDocument, path, r.version)
+			}
+		}
+
+		result = append(result, r)
+	}
+
+	if err := o.spellCheckResult(spellchecker); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].version < result[j].version
+	})
+
+	return result, nil
+}
+
+// formatIndex emits a landing page that links to each API version.
+func formatIndex(documents []*rendered, f formatter, extension string) {
+	f.H1("", "API Documentation")
+	f.P("Select the API version to view the documentation for.")
+
+	f.Table()
+	f.TH("Version", "API", "Release")
+
+	for _, r := range documents {
+		f.TD(f.Link(r.version+"/server-api"+extension, r.version), r.document.Name, r.document.Version)
+	}
+
+	f.TableEnd()
+}
+
+// outputs returns the files to write, keyed by path.
+func (o *Options) outputs(documents []*rendered) map[string][]byte {
+	if o.OutputDir == "" {
+		return map[string][]byte{
+			o.Output: documents[0].data,
+		}
+	}
+
+	extension := o.Formatter.extension()
+
+	files := map[string][]byte{}
+
+	for _, r := range documents {
+		files[filepath.Join(o.OutputDir, r.version, "server-api"+extension)] = r.data
+	}
+
+	index := &bytes.Buffer{}
+
+	formatIndex(documents, o.newFormatter(index), extension)
+
+	files[filepath.Join(o.OutputDir, "index"+extension)] = index.Bytes()
+
+	return files
+}
+
+// validate checks the options are sane.
+func (o *Options) validate() error {
+	if len(o.OpenAPISchemas) == 0 {
+		return runtime.UsageError(fmt.Errorf("%w: at least one schema must be specified", ErrFlag))
+	}
+
+	if o.Output != "" && o.OutputDir != "" {
+		return runtime.UsageError(fmt.Errorf("%w: output file and directory are mutually exclusive", ErrFlag))
+	}
+
+	if o.Output != "" && len(o.OpenAPISchemas) > 1 {
+		return runtime.UsageError(fmt.Errorf("%w: output directory must be specified for multiple schemas", ErrFlag))
+	}
+
+	if (o.Check || !o.DryRun) && o.Output == "" && o.OutputDir == "" {
+		return runtime.UsageError(fmt.Errorf("%w: output file or directory must be specified", ErrFlag))
+	}
+
+	return nil
+}
+
+// write either writes the file out, or compares it with what's already there.
+func (o *Options) write(path string, data []byte) error {
+	existing, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if o.Check {
+		if err != nil || !bytes.Equal(existing, data) {
+			return fmt.Errorf("%w: %s does not match a fresh render", ErrOutOfDate, path)
+		}
+
+		return nil
+	}
+
+	// Leave the file untouched if nothing has changed, so repeated runs
+	// are side effect free.
+	if err == nil && bytes.Equal(existing, data) {
+		return nil
+	}
+
+	//nolint:gosec
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	//nolint:gosec
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return err
+	}
+
+	o.runtime.Info("documentation updated", "path", path)
+
+	return nil
+}
+
+// Run does the main meat, rendering the documents then either writing them out,
+// or comparing them with what's already there.
+func (o *Options) Run() error {
+	if err := o.validate(); err != nil {
+		return err
+	}
+
+	documents, err := o.renderAll()
+	if err != nil {
+		return err
+	}
+
+	if o.DryRun && !o.Check {
+		return nil
+	}
+
+	files := o.outputs(documents)
+
+	for _, path := range collections.SortedKeys(files) {
+		if err := o.write(path, files[path]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewCommand returns a command that renders the server API documentation.
+func NewCommand(r *runtime.Runtime) *cobra.Command {
+	o := NewOptions(r)
+
+	cmd := &cobra.Command{
+		Use:   "docs",
+		Short: "Render the server API documentation.",
+		Long:  "Render the server API documentation from the OpenAPI specification, spell checking it as it goes.",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return o.Run()
+		},
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	return cmd
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// formatter abstracts away the output format.  Headings accept an optional
+// anchor ID, which should be stable across renders so that links to sections
+// of the document remain valid.
+//
+//nolint:interfacebloat
+type formatter interface {
+	// These are standard HTML types of markup.
+	H1(id string, a ...any)
+	H2(id string, a ...any)
+	H3(id string, a ...any)
+	H4(id string, a ...any)
+	H5(id string, a ...any)
+	P(string)
+	Details(string, func())
+	Code(string, string, string)
+	Table()
+	TableEnd()
+	TH(...string)
+	TD(...string)
+
+	// Link returns a hyperlink for embedding in other mark up.
+	Link(href, text string) string
+
+	// These are more specialised mark up types e.g.
+	// admonitions.
+	TableOfContentsLevel(int, int)
+	Warning(description string)
+}
+
+// injectSpaces separates heading components with spaces.
+func injectSpaces(a []any) []any {
+	b := []any{a[0]}
+
+	for i := 1; i < len(a); i++ {
+		b = append(b, " ", a[i])
+	}
+
+	return b
+}
+
+// markdownFormatter emits Docusaurus flavoured markdown.
+type markdownFormatter struct {
+	output io.Writer
+}
+
+func newMarkdownFormatter(output io.Writer) *markdownFormatter {
+	return &markdownFormatter{
+		output: output,
+	}
+}
+
+// heading emits a heading with an explicit ID, rather than relying on the
+// rendering engine to derive one from the heading text.
+func (f markdownFormatter) heading(level int, id string, a []any) {
+	fmt.Fprint(f.output, strings.Repeat("#", level), " ")
+	fmt.Fprint(f.output, injectSpaces(a)...)
+
+	if id != "" {
+		fmt.Fprintf(f.output, " {#%s}", id)
+	}
+
+	fmt.Fprintln(f.output)
+	fmt.Fprintln(f.output)
+}
+
+func (f markdownFormatter) H1(id string, a ...any) {
+	f.heading(1, id, a)
+}
+
+func (f markdownFormatter) H2(id string, a ...any) {
+	f.heading(2, id, a)
+}
+
+func (f markdownFormatter) H3(id string, a ...any) {
+	f.heading(3, id, a)
+}
+
+func (f markdownFormatter) H4(id string, a ...any) {
+	f.heading(4, id, a)
+}
+
+func (f markdownFormatter) H5(id string, a ...any) {
+	f.heading(5, id, a)
+}
+
+func (f markdownFormatter) P(a string) {
+	fmt.Fprintln(f.output, a)
+	fmt.Fprintln(f.output)
+}
+
+func (f markdownFormatter) Details(summary string, callback func()) {
+	fmt.Fprintln(f.output, "<details>")
+	fmt.Fprintln(f.output, "<summary>", summary, "</summary>")
+	fmt.Fprintln(f.output)
+	callback()
+	fmt.Fprintln(f.output, "</details>")
+	fmt.Fprintln(f.output)
+}
+
+func (f markdownFormatter) Code(lang string, title string, code string) {
+	fmt.Fprintln(f.output, "```", lang, fmt.Sprintf("title=%s", title))
+	fmt.Fprintln(f.output, code)
+	fmt.Fprintln(f.output, "```")
+}
+
+func (f markdownFormatter) Table() {
+}
+
+// TableEnd terminates the table with a blank line, otherwise whatever follows
+// is considered part of it.
+func (f markdownFormatter) TableEnd() {
+	fmt.Fprintln(f.output)
+}
+
+func (f markdownFormatter) TH(a ...string) {
+	fmt.Fprint(f.output, "|")
+
+	for _, s := range a {
+		fmt.Fprint(f.output, strings.ReplaceAll(s, "\n", " "), "|")
+	}
+
+	fmt.Fprintln(f.output)
+	fmt.Fprint(f.output, "|")
+
+	for range a {
+		fmt.Fprint(f.output, "---|")
+	}
+
+	fmt.Fprintln(f.output)
+}
+
+func (f markdownFormatter) TD(a ...string) {
+	fmt.Fprint(f.output, "|")
+
+	for _, s := range a {
+		fmt.Fprint(f.output, strings.ReplaceAll(s, "\n", " "), "|")
+	}
+
+	fmt.Fprintln(f.output)
+}
+
+func (f markdownFormatter) Link(href, text string) string {
+	return fmt.Sprintf("[%s](%s)", text, href)
+}
+
+func (f markdownFormatter) TableOfContentsLevel(min int, max int) {
+	fmt.Fprintln(f.output, "---")
+	fmt.Fprintln(f.output, "toc_min_heading_level:", min)
+	fmt.Fprintln(f.output, "toc_max_heading_level:", max)
+	fmt.Fprintln(f.output, "---")
+	fmt.Fprintln(f.output)
+}
+
+func (f markdownFormatter) Warning(description string) {
+	fmt.Fprintln(f.output, ":::caution")
+	fmt.Fprintln(f.output)
+	fmt.Fprintln(f.output, description)
+	fmt.Fprintln(f.output)
+	fmt.Fprintln(f.output, ":::")
+	fmt.Fprintln(f.output)
+}
+
+// htmlFormatter emits raw HTML.
+type htmlFormatter struct {
+	output io.Writer
+}
+
+func newHTMLFormatter(output io.Writer) *htmlFormatter {
+	return &htmlFormatter{
+		output: output,
+	}
+}
+
+// heading emits a heading with an explicit ID.
+func (f htmlFormatter) heading(level int, id string, a []any) {
+	if id != "" {
+		fmt.Fprintf(f.output, `<h%d id="%s">`, level, id)
+	} else {
+		fmt.Fprintf(f.output, "<h%d>", level)
+	}
+
+	fmt.Fprint(f.output, injectSpaces(a)...)
+	fmt.Fprintf(f.output, "</h%d>\n", level)
+	fmt.Fprintln(f.output)
+}
+
+func (f htmlFormatter) H1(id string, a ...any) {
+	f.heading(1, id, a)
+}
+
+func (f htmlFormatter) H2(id string, a ...any) {
+	f.heading(2, id, a)
+}
+
+func (f htmlFormatter) H3(id string, a ...any) {
+	f.heading(3, id, a)
+}
+
+func (f htmlFormatter) H4(id string, a ...any) {
+	f.heading(4, id, a)
+}
+
+func (f htmlFormatter) H5(id string, a ...any) {
+	f.heading(5, id, a)
+}
+
+func (f htmlFormatter) P(a string) {
+	fmt.Fprintln(f.output, "<p>")
+	fmt.Fprintln(f.output, a)
+	fmt.Fprintln(f.output, "</p>")
+	fmt.Fprintln(f.output)
+}
+
+func (f htmlFormatter) Details(summary string, callback func()) {
+	fmt.Fprintln(f.output, "<details>")
+	fmt.Fprintln(f.output, "<summary>", summary, "</summary>")
+	callback()
+	fmt.Fprintln(f.output, "</details>")
+	fmt.Fprintln(f.output)
+}
+
+func (f htmlFormatter) Code(_ string, title string, code string) {
+	fmt.Fprintln(f.output, "<b>", title, "</b>")
+	fmt.Fprintln(f.output, "<code>")
+	fmt.Fprintln(f.output, code)
+	fmt.Fprintln(f.output, "</code>")
+}
+
+func (f htmlFormatter) Table() {
+	fmt.Fprintln(f.output, "<table>")
+}
+
+func (f htmlFormatter) TableEnd() {
+	fmt.Fprintln(f.output, "</table>")
+}
+
+func (f htmlFormatter) TH(a ...string) {
+	fmt.Fprintln(f.output, "<tr>")
+
+	for _, s := range a {
+		fmt.Fprintln(f.output, "<th>", s, "</th>")
+	}
+
+	fmt.Fprintln(f.output, "</tr>")
+}
+
+func (f htmlFormatter) TD(a ...string) {
+	fmt.Fprintln(f.output, "<tr>")
+
+	for _, s := range a {
+		fmt.Fprintln(f.output, "<td>", s, "</td>")
+	}
+
+	fmt.Fprintln(f.output, "</tr>")
+}
+
+func (f htmlFormatter) Link(href, text string) string {
+	return fmt.Sprintf(`<a href="%s">%s</a>`, href, text)
+}
+
+func (f htmlFormatter) TableOfContentsLevel(int, int) {
+}
+
+func (f htmlFormatter) Warning(description string) {
+	fmt.Fprintln(f.output, `<div class="admonition warning">`)
+	f.H3("", "Warning")
+	fmt.Fprintln(f.output)
+	fmt.Fprintln(f.output, description)
+	fmt.Fprintln(f.output)
+	fmt.Fprintln(f.output, "</div>")
+	fmt.Fprintln(f.output)
+}
@@ -0,0 +1,416 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/eschercloudai/unikorn-core/pkg/util/trie"
+)
+
+const (
+	// maxSuggestionDistance is the maximum edit distance a dictionary word
+	// may be from a misspelling to be offered as a suggestion.
+	maxSuggestionDistance = 2
+
+	// maxSuggestions limits the number of suggestions per misspelling.
+	maxSuggestions = 3
+)
+
+var (
+	// ErrSpelling is raised in strict mode when misspellings are found.
+	ErrSpelling = errors.New("spelling error")
+
+	// ignoreMarkerRegexp matches inline markers that exempt a description from
+	// spell checking e.g. <!-- spell-check-ignore: kubeconfig nodepool -->.
+	// When no words are listed the whole description is ignored.  Markers are
+	// removed from the rendered output.
+	ignoreMarkerRegexp = regexp.MustCompile(`[ \t]*<!--\s*spell-check-ignore(:[^>]*?)?\s*-->`)
+
+	// identifierRegexp matches keys that can be used verbatim in a JSON path.
+	identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// misspelling records a word that wasn't found in any dictionary.
+type misspelling struct {
+	// File is the OpenAPI schema the word was found in.
+	File string `json:"file"`
+	// Path is the JSON path of the description within the schema.
+	Path string `json:"path"`
+	// Word is the word as it appears in the description.
+	Word string `json:"word"`
+	// Suggestions are dictionary words with a small edit distance,
+	// closest first.
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+// spellCheckReport is the machine readable spell checking output.
+type spellCheckReport struct {
+	// Misspellings are ordered as they are found.
+	Misspellings []*misspelling `json:"misspellings"`
+}
+
+// spellChecker checks words against a dictionary and accumulates any
+// misspellings into a report.
+type spellChecker struct {
+	// trie provides fast lookups.
+	trie *trie.Trie
+	// words indexes dictionary words by length in runes, as suggestions
+	// only consider words of a similar length.
+	words map[int][]string
+	// file is the schema currently being checked.
+	file string
+	// report is shared between all schemas.
+	report *spellCheckReport
+}
+
+// createSpellChecker initialises our spell checker with the selected
+// dictionaries.  If spell checking is disabled this returns nil.
+func createSpellChecker(o *Options) (*spellChecker, error) {
+	if !o.SpellCheck {
+		//nolint:nilnil
+		return nil, nil
+	}
+
+	s := &spellChecker{
+		trie:   trie.New(),
+		words:  map[int][]string{},
+		report: &spellCheckReport{},
+	}
+
+	for _, dictionary := range o.Dictionaries {
+		if err := s.addDictionary(dictionary); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// addDictionary reads in a dictionary with one word per line.
+func (s *spellChecker) addDictionary(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Split(bufio.ScanWords)
+
+	for scanner.Scan() {
+		word := scanner.Text()
+
+		s.trie.AddWord(word)
+
+		length := utf8.RuneCountInString(word)
+
+		s.words[length] = append(s.words[length], word)
+	}
+
+	return scanner.Err()
+}
+
+// withFile returns a spell checker that attributes misspellings to the
+// given schema file.
+func (s *spellChecker) withFile(file string) *spellChecker {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	c.file = file
+
+	return &c
+}
+
+// trimPunctuation removes any leading or trailing punctuation or symbols
+// e.g. "'()., from a word.
+func trimPunctuation(word string) string {
+	for first, firstWidth := utf8.DecodeRuneInString(word); unicode.IsPunct(first) || unicode.IsSymbol(first); first, firstWidth = utf8.DecodeRuneInString(word) {
+		word = word[firstWidth:]
+	}
+
+	for last, lastWidth := utf8.DecodeLastRuneInString(word); unicode.IsPunct(last) || unicode.IsSymbol(last); last, lastWidth = utf8.DecodeLastRuneInString(word) {
+		word = word[:len(word)-lastWidth]
+	}
+
+	return word
+}
+
+// checkWord checks a single word against the dictionary.
+// TODO: We may need to match uris, dates and times.
+func (s *spellChecker) checkWord(word string) bool {
+	// Direct match, do this before stripping punctuation as things
+	// like e.g. or i.e. will match here.  To be honest, you shouldn't
+	// use latin in technical documentation anyway.
+	if s.trie.CheckWord(word) {
+		return true
+	}
+
+	if _, err := strconv.Atoi(word); err == nil {
+		return true
+	}
+
+	word = trimPunctuation(word)
+
+	if s.trie.CheckWord(word) {
+		return true
+	}
+
+	first, firstWidth := utf8.DecodeRuneInString(word)
+
+	// If it's capitalised, then make it lower case.
+	if unicode.IsUpper(first) {
+		if s.trie.CheckWord(string(unicode.ToLower(first)) + word[firstWidth:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// editDistance returns the Levenshtein distance between two words.  As an
+// optimisation, it gives up and returns limit + 1 once every possible
+// alignment exceeds the limit.
+func editDistance(a, b []rune, limit int) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		rowMinimum := current[0]
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+
+			rowMinimum = min(rowMinimum, current[j])
+		}
+
+		if rowMinimum > limit {
+			return limit + 1
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+// suggest returns the dictionary words closest to the misspelling.
+func (s *spellChecker) suggest(word string) []string {
+	word = strings.ToLower(trimPunctuation(word))
+	if word == "" {
+		return nil
+	}
+
+	type candidate struct {
+		word     string
+		distance int
+	}
+
+	var candidates []candidate
+
+	target := []rune(word)
+
+	for length := len(target) - maxSuggestionDistance; length <= len(target)+maxSuggestionDistance; length++ {
+		for _, w := range s.words[length] {
+			distance := editDistance(target, []rune(strings.ToLower(w)), maxSuggestionDistance)
+			if distance > maxSuggestionDistance {
+				continue
+			}
+
+			candidates = append(candidates, candidate{word: w, distance: distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+
+		return candidates[i].word < candidates[j].word
+	})
+
+	var suggestions []string
+
+	for _, c := range candidates {
+		// Dictionaries may contain the same word in multiple files.
+		if len(suggestions) > 0 && suggestions[len(suggestions)-1] == c.word {
+			continue
+		}
+
+		suggestions = append(suggestions, c.word)
+
+		if len(suggestions) == maxSuggestions {
+			break
+		}
+	}
+
+	return suggestions
+}
+
+// ignoredWords returns the set of words exempted by inline markers, and
+// whether the paragraph should be ignored entirely.
+func ignoredWords(paragraph string) (map[string]bool, bool) {
+	ignored := map[string]bool{}
+
+	for _, match := range ignoreMarkerRegexp.FindAllStringSubmatch(paragraph, -1) {
+		if match[1] == "" {
+			return nil, true
+		}
+
+		for _, word := range strings.Fields(strings.TrimPrefix(match[1], ":")) {
+			ignored[word] = true
+		}
+	}
+
+	return ignored, false
+}
+
+// stripIgnoreMarkers removes any inline spell checking markers so they
+// don't appear in the rendered output.
+func stripIgnoreMarkers(paragraph string) string {
+	if !ignoreMarkerRegexp.MatchString(paragraph) {
+		return paragraph
+	}
+
+	return strings.TrimSpace(ignoreMarkerRegexp.ReplaceAllString(paragraph, ""))
+}
+
+// checkParagraph takes a blob of text and splits it into tokens based on
+// white space, then spell checks the individual words, recording any that
+// aren't in the dictionary against the JSON path.
+func (s *spellChecker) checkParagraph(path, paragraph string) ([]*misspelling, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	ignored, skip := ignoredWords(paragraph)
+	if skip {
+		return nil, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewBufferString(ignoreMarkerRegexp.ReplaceAllString(paragraph, " ")))
+	scanner.Split(bufio.ScanWords)
+
+	var result []*misspelling
+
+	for scanner.Scan() {
+		word := scanner.Text()
+
+		if ignored[word] || ignored[trimPunctuation(word)] || s.checkWord(word) {
+			continue
+		}
+
+		m := &misspelling{
+			File:        s.file,
+			Path:        path,
+			Word:        word,
+			Suggestions: s.suggest(word),
+		}
+
+		s.report.Misspellings = append(s.report.Misspellings, m)
+
+		result = append(result, m)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// jsonPathKey appends a key to a JSON path, quoting it if necessary.
+func jsonPathKey(parent string, key any) string {
+	if i, ok := key.(int); ok {
+		return fmt.Sprintf("%s[%d]", parent, i)
+	}
+
+	k := fmt.Sprint(key)
+
+	if identifierRegexp.MatchString(k) {
+		return parent + "." + k
+	}
+
+	return parent + "['" + k + "']"
+}
+
+// spellCheckParagraph spell checks a description, warning about any
+// misspellings, and returns it with any inline markers removed.
+func (o *Options) spellCheckParagraph(spellchecker *spellChecker, path, paragraph string) (string, error) {
+	misspellings, err := spellchecker.checkParagraph(path, paragraph)
+	if err != nil {
+		return "", err
+	}
+
+	for _, m := range misspellings {
+		o.runtime.Warning("word not found in dictionary", "file", m.File, "path", m.Path, "word", m.Word, "suggestions", strings.Join(m.Suggestions, ","))
+	}
+
+	return stripIgnoreMarkers(paragraph), nil
+}
+
+// spellCheckResult writes out the report, if requested, and in strict mode
+// fails if there were any misspellings.
+func (o *Options) spellCheckResult(spellchecker *spellChecker) error {
+	if spellchecker == nil {
+		return nil
+	}
+
+	if o.SpellCheckReport != "" {
+		data, err := json.MarshalIndent(spellchecker.report, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		//nolint:gosec
+		if err := os.WriteFile(o.SpellCheckReport, append(data, '\n'), 0644); err != nil {
+			return err
+		}
+	}
+
+	if o.SpellCheckStrict && len(spellchecker.report.Misspellings) > 0 {
+		return fmt.Errorf("%w: %d words not found in dictionary", ErrSpelling, len(spellchecker.report.Misspellings))
+	}
+
+	return nil
+}
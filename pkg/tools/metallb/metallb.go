@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package main
+package metallb
 
 import (
 	"context"
@@ -27,8 +27,11 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+
 	"github.com/eschercloudai/unikorn-core/pkg/util/retry"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -85,10 +88,13 @@ var (
 	ErrConditionStatus = errors.New("status condition incorrect status")
 
 	ErrDaemonSetUnready = errors.New("daemonset readiness doesn't match desired")
+
+	// ErrNetworkConfig means the docker network configuration isn't as expected.
+	ErrNetworkConfig = errors.New("docker network configuration error")
 )
 
 // waitCondition waits for a condtion to be true on a generic resource.
-func waitCondition(ctx context.Context, client dynamic.Interface, group, version, resource, namespace, name, conditionType string) {
+func waitCondition(ctx context.Context, client dynamic.Interface, group, version, resource, namespace, name, conditionType string) error {
 	gvr := schema.GroupVersionResource{
 		Group:    group,
 		Version:  version,
@@ -137,13 +143,15 @@ func waitCondition(ctx context.Context, client dynamic.Interface, group, version
 	}
 
 	if err := retry.Forever().DoWithContext(ctx, callback); err != nil {
-		panic(err)
+		return fmt.Errorf("%s/%s condition %s not met: %w", resource, name, conditionType, err)
 	}
+
+	return nil
 }
 
 // waitDaemonSetReady performs a type specific wait function until the desired and actual
 // number of rready processes match.
-func waitDaemonSetReady(ctx context.Context, client kubernetes.Interface, namespace, name string) {
+func waitDaemonSetReady(ctx context.Context, client kubernetes.Interface, namespace, name string) error {
 	callback := func() error {
 		daemonset, err := client.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
@@ -158,8 +166,10 @@ func waitDaemonSetReady(ctx context.Context, client kubernetes.Interface, namesp
 	}
 
 	if err := retry.Forever().DoWithContext(ctx, callback); err != nil {
-		panic(err)
+		return fmt.Errorf("daemonset %s not ready: %w", name, err)
 	}
+
+	return nil
 }
 
 func provision(config *genericclioptions.ConfigFlags, path string) error {
@@ -177,57 +187,50 @@ func provision(config *genericclioptions.ConfigFlags, path string) error {
 	args = append(args, "apply", "-f", path)
 
 	if err := exec.Command("kubectl", args...).Run(); err != nil {
-		return err
+		return fmt.Errorf("failed to apply manifest %s: %w", path, err)
 	}
 
 	return nil
 }
 
-// applyManifest does exactly that.
-func applyManifest(config *genericclioptions.ConfigFlags, path string) {
-	if err := provision(config, path); err != nil {
-		panic(err)
-	}
-}
-
 // getDockerNetwork is a utility function to derive the IPv4 network from the
 // specified Kind cluster.  Anything in this prefix will be routable from the
 // host.
-func getDockerNetwork(name string) *net.IPNet {
+func getDockerNetwork(name string) (*net.IPNet, error) {
 	out, err := exec.Command("docker", "network", "inspect", name).Output()
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("failed to inspect docker network %s: %w", name, err)
 	}
 
 	var dockerNetConfigs []map[string]interface{}
 
 	if err := json.Unmarshal(out, &dockerNetConfigs); err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	if len(dockerNetConfigs) != 1 {
-		panic("wrong net config length")
+		return nil, fmt.Errorf("%w: expected one network, got %d", ErrNetworkConfig, len(dockerNetConfigs))
 	}
 
 	ipamConfigs, _, err := unstructured.NestedSlice(dockerNetConfigs[0], "IPAM", "Config")
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("%w: IPAM config lookup error: %s", ErrNetworkConfig, err.Error())
 	}
 
 	for i := range ipamConfigs {
 		ipamConfig, ok := ipamConfigs[i].(map[string]interface{})
 		if !ok {
-			panic("config format fail")
+			return nil, fmt.Errorf("%w: IPAM config type assertion error", ErrNetworkConfig)
 		}
 
 		prefix, _, err := unstructured.NestedString(ipamConfig, "Subnet")
 		if err != nil {
-			panic("subnet fail")
+			return nil, fmt.Errorf("%w: subnet lookup error: %s", ErrNetworkConfig, err.Error())
 		}
 
 		_, network, err := net.ParseCIDR(prefix)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
 
 		v4 := network.IP.To4()
@@ -235,10 +238,10 @@ func getDockerNetwork(name string) *net.IPNet {
 			continue
 		}
 
-		return network
+		return network, nil
 	}
 
-	panic("no IPv4 subnet found")
+	return nil, fmt.Errorf("%w: no IPv4 subnet found", ErrNetworkConfig)
 }
 
 // getVIPRange is, quite frankly, a hack that allocates an address range from a CIDR
@@ -270,16 +273,16 @@ func getVIPRange(network *net.IPNet, rangeStart, rangeEnd uint) (net.IP, net.IP)
 // applyMetalLBAddressPools creates a couple MetalLB custom resources that define an address
 // pool for external connectivity and L2 shizzle that will respond to ARP whohas requests
 // and take ownership.
-func applyMetalLBAddressPools(config *genericclioptions.ConfigFlags, start, end net.IP) {
+func applyMetalLBAddressPools(config *genericclioptions.ConfigFlags, start, end net.IP) error {
 	tmpl := template.New("foo")
 
 	if _, err := tmpl.Parse(matallbAddressPoolTemplate); err != nil {
-		panic(err)
+		return err
 	}
 
 	tf, err := os.CreateTemp("", "")
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	defer os.Remove(tf.Name())
@@ -290,63 +293,126 @@ func applyMetalLBAddressPools(config *genericclioptions.ConfigFlags, start, end
 	}
 
 	if err := tmpl.Execute(tf, ctx); err != nil {
-		panic(err)
+		return err
 	}
 
 	tf.Close()
 
-	applyManifest(config, tf.Name())
+	return provision(config, tf.Name())
 }
 
-// main is the main entry point, shock!
-// It will install (idempotently) MetalLB, figure out an IP address range to provision
-// load balancer VIPs from, and make that live.  For a real cloud this is a non-event,
-// this is more for local testing with Kind and other provisioners of that ilk.
-func main() {
-	// Parse flags.
-	var clusterName string
+// Options define MetalLB installation options.
+type Options struct {
+	// ClusterName is the Kind cluster name to probe for a routable prefix.
+	ClusterName string
+
+	// Timeout is the global timeout to complete installation.
+	Timeout time.Duration
+
+	// ConfigFlags define how to connect to the Kubernetes cluster.
+	ConfigFlags *genericclioptions.ConfigFlags
+
+	// runtime provides consistent output and error handling.
+	runtime *runtime.Runtime
+}
+
+// NewOptions returns options with defaults suitable for a local Kind cluster.
+func NewOptions(r *runtime.Runtime) *Options {
+	return &Options{
+		ClusterName: "kind",
+		Timeout:     5 * time.Minute,
+		ConfigFlags: genericclioptions.NewConfigFlags(true),
+		runtime:     r,
+	}
+}
 
-	var timeout time.Duration
+// AddFlags adds options to the flag set.
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.ClusterName, "cluster-name", o.ClusterName, "Kind cluster name to probe.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Global timeout to complete installation.")
 
-	pflag.StringVar(&clusterName, "cluster-name", "kind", "Kind cluster name to probe.")
-	pflag.DurationVar(&timeout, "timeout", 5*time.Minute, "Global timeout to complete installation.")
+	o.ConfigFlags.AddFlags(flags)
+}
 
-	configFlags := genericclioptions.NewConfigFlags(true)
-	configFlags.AddFlags(pflag.CommandLine)
+// Run will install (idempotently) MetalLB, figure out an IP address range to provision
+// load balancer VIPs from, and make that live.  For a real cloud this is a non-event,
+// this is more for local testing with Kind and other provisioners of that ilk.
+func (o *Options) Run(ctx context.Context) error {
+	// Set up our global timeout.
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
 
-	pflag.Parse()
+	return install(ctx, o.runtime, o.ConfigFlags, o.ClusterName)
+}
 
-	// Perform Kubernetes configuration.
+// install does the actual work of installing and configuring MetalLB.
+func install(ctx context.Context, r *runtime.Runtime, configFlags *genericclioptions.ConfigFlags, clusterName string) error {
 	config, err := configFlags.ToRESTConfig()
 	if err != nil {
-		panic(err)
+		return runtime.UsageError(err)
 	}
 
-	kubernetesClient := kubernetes.NewForConfigOrDie(config)
-	dynamicClient := dynamic.NewForConfigOrDie(config)
+	kubernetesClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
 
-	// Set up our global timeout.
-	c, cancel := context.WithTimeout(context.TODO(), timeout)
-	defer cancel()
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	r.Info("applying MetalLB manifest", "version", metalLBVersion)
+
+	if err := provision(configFlags, metalLBManifest); err != nil {
+		return err
+	}
+
+	r.Info("waiting for MetalLB controller to be ready")
+
+	if err := waitCondition(ctx, dynamicClient, "apps", "v1", "deployments", metalLBNamespace, "controller", "Available"); err != nil {
+		return err
+	}
 
-	// And finally do the install.
-	fmt.Println("🦄 Applying MetalLB manifest ...")
-	applyManifest(configFlags, metalLBManifest)
+	r.Info("waiting for MetalLB daemonset to be ready")
 
-	fmt.Println("🦄 Waiting for MetalLB controller to be ready ...")
-	waitCondition(c, dynamicClient, "apps", "v1", "deployments", metalLBNamespace, "controller", "Available")
+	if err := waitDaemonSetReady(ctx, kubernetesClient, metalLBNamespace, "speaker"); err != nil {
+		return err
+	}
 
-	fmt.Println("🦄 Waiting for MetalLB daemonset to be ready ...")
-	waitDaemonSetReady(c, kubernetesClient, metalLBNamespace, "speaker")
+	r.Info("getting network configuration")
 
-	fmt.Println("🦄 Getting network configuration ...")
+	network, err := getDockerNetwork(clusterName)
+	if err != nil {
+		return err
+	}
 
-	network := getDockerNetwork(clusterName)
-	fmt.Println("💡 Using routable prefix", network)
+	r.Info("using routable prefix", "prefix", network)
 
 	start, end := getVIPRange(network, 200, 250)
-	fmt.Println("💡 Using address range", start, "-", end)
 
-	fmt.Println("🦄 Applying MetalLB network configuration ...")
-	applyMetalLBAddressPools(configFlags, start, end)
+	r.Info("using address range", "start", start, "end", end)
+
+	r.Info("applying MetalLB network configuration")
+
+	return applyMetalLBAddressPools(configFlags, start, end)
+}
+
+// NewCommand returns a command that installs MetalLB into a Kind cluster.
+func NewCommand(r *runtime.Runtime) *cobra.Command {
+	o := NewOptions(r)
+
+	cmd := &cobra.Command{
+		Use:   "install-metallb",
+		Short: "Install MetalLB into a Kind cluster.",
+		Long:  "Install MetalLB into a Kind cluster, allocating load balancer addresses from the Kind network, so services are routable from the host.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return o.Run(cmd.Context())
+		},
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	return cmd
 }
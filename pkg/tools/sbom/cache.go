@@ -0,0 +1,186 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// ErrCacheMiss is raised in offline mode when chart information isn't
+	// cached.
+	ErrCacheMiss = errors.New("chart not cached")
+)
+
+// chartInfoGetter looks up Helm chart information.  Lookups are slow, so
+// results are cached on disk, and the number of concurrent lookups bounded
+// so as not to upset the repositories.
+type chartInfoGetter struct {
+	// dir is where cached chart information lives.
+	dir string
+
+	// ttl is how long cached chart information is valid for.
+	ttl time.Duration
+
+	// offline only uses cached chart information.
+	offline bool
+
+	// workers limits the number of concurrent lookups.
+	workers chan struct{}
+
+	// client is used to download charts hosted in git repositories.
+	client *http.Client
+}
+
+func newChartInfoGetter(o *Options) (*chartInfoGetter, error) {
+	if err := os.MkdirAll(o.CacheDir, 0755); err != nil {
+		return nil, err
+	}
+
+	g := &chartInfoGetter{
+		dir:     o.CacheDir,
+		ttl:     o.CacheTTL,
+		offline: o.Offline,
+		workers: make(chan struct{}, o.Concurrency),
+		client: &http.Client{
+			Timeout: time.Minute,
+		},
+	}
+
+	return g, nil
+}
+
+// path returns where information identified by the key is cached.
+func (g *chartInfoGetter) path(key ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(key, "\x00")))
+
+	return filepath.Join(g.dir, hex.EncodeToString(sum[:])+".yaml")
+}
+
+// cached returns cached chart information, if present and valid.  In offline
+// mode expired chart information is better than nothing.
+func (g *chartInfoGetter) cached(path string) ([]byte, bool) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, false
+	}
+
+	if !g.offline && time.Since(stat.ModTime()) >= g.ttl {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	return data, true
+}
+
+// fetch gets chart information from the repository.
+func (g *chartInfoGetter) fetch(repo, chart, version string) ([]byte, error) {
+	g.workers <- struct{}{}
+	defer func() { <-g.workers }()
+
+	command := exec.Command("helm", "show", "chart", chart,
+		"--repo", repo,
+		"--version", version)
+
+	return command.Output()
+}
+
+// store caches chart information, this is done via a rename so concurrent
+// readers never see partial data.
+func (g *chartInfoGetter) store(path string, data []byte) error {
+	file, err := os.CreateTemp(g.dir, "tmp-")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// lookup returns cached data for the key, falling back to the fetch function
+// and caching the result.
+func (g *chartInfoGetter) lookup(key []string, fetch func() ([]byte, error)) ([]byte, error) {
+	path := g.path(key...)
+
+	if data, ok := g.cached(path); ok {
+		return data, nil
+	}
+
+	if g.offline {
+		return nil, fmt.Errorf("%w: %s", ErrCacheMiss, strings.Join(key, " "))
+	}
+
+	data, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := g.store(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// get grabs information about the given application's helm chart.
+func (g *chartInfoGetter) get(repo, chart, version string) (*helmChartInfoStruct, error) {
+	data, err := g.lookup([]string{repo, chart, version}, func() ([]byte, error) {
+		return g.fetch(repo, chart, version)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseChartInfo(data)
+}
+
+// parseChartInfo unmarshals a Chart.yaml file.
+func parseChartInfo(data []byte) (*helmChartInfoStruct, error) {
+	info := &helmChartInfoStruct{}
+
+	if err := yaml.Unmarshal(data, &info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
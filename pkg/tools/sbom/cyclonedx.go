@@ -0,0 +1,163 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// See: https://cyclonedx.org/docs/1.5/json/
+type cycloneDXOrganization struct {
+	Name string `json:"name"`
+}
+
+// See: https://cyclonedx.org/docs/1.5/json/
+type cycloneDXLicense struct {
+	Expression string `json:"expression"`
+}
+
+// See: https://cyclonedx.org/docs/1.5/json/
+type cycloneDXExternalReference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// See: https://cyclonedx.org/docs/1.5/json/
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// See: https://cyclonedx.org/docs/1.5/json/
+type cycloneDXComponent struct {
+	Type               string                       `json:"type"`
+	BOMRef             string                       `json:"bom-ref,omitempty"`
+	Supplier           *cycloneDXOrganization       `json:"supplier,omitempty"`
+	Name               string                       `json:"name"`
+	Version            string                       `json:"version,omitempty"`
+	Licenses           []cycloneDXLicense           `json:"licenses,omitempty"`
+	ExternalReferences []cycloneDXExternalReference `json:"externalReferences,omitempty"`
+	Properties         []cycloneDXProperty          `json:"properties,omitempty"`
+}
+
+// See: https://cyclonedx.org/docs/1.5/json/
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+// See: https://cyclonedx.org/docs/1.5/json/
+type cycloneDXMetadata struct {
+	Timestamp string              `json:"timestamp"`
+	Tools     *cycloneDXTools     `json:"tools"`
+	Component *cycloneDXComponent `json:"component"`
+}
+
+// See: https://cyclonedx.org/docs/1.5/json/
+type cycloneDXDocument struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     *cycloneDXMetadata   `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+// cycloneDXEncoder emits CycloneDX 1.5 JSON.
+type cycloneDXEncoder struct{}
+
+// Ensure the encoder interface is implemented.
+var _ encoder = &cycloneDXEncoder{}
+
+// Extension implements the encoder interface.
+func (*cycloneDXEncoder) Extension() string {
+	return ".cdx.json"
+}
+
+// Encode implements the encoder interface.
+func (*cycloneDXEncoder) Encode(bom *BOM) ([]byte, error) {
+	document := &cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + uuid.New().String(),
+		Version:      1,
+		Metadata: &cycloneDXMetadata{
+			Timestamp: bom.Created.Format(time.RFC3339),
+			Tools: &cycloneDXTools{
+				Components: []cycloneDXComponent{
+					{
+						Type: "application",
+						Name: "unikorn",
+					},
+				},
+			},
+			Component: &cycloneDXComponent{
+				Type: "application",
+				Supplier: &cycloneDXOrganization{
+					Name: "EscherCloud AI",
+				},
+				Name: bom.Name,
+			},
+		},
+		Components: []cycloneDXComponent{},
+	}
+
+	for _, in := range bom.Packages {
+		c := cycloneDXComponent{
+			Type:    "application",
+			BOMRef:  in.ID,
+			Name:    in.Name,
+			Version: in.Version,
+			ExternalReferences: []cycloneDXExternalReference{
+				{
+					Type: "distribution",
+					URL:  in.Repository,
+				},
+			},
+		}
+
+		if in.HomePage != "" {
+			c.ExternalReferences = append(c.ExternalReferences, cycloneDXExternalReference{
+				Type: "website",
+				URL:  in.HomePage,
+			})
+		}
+
+		if in.AppVersion != "" {
+			c.Properties = []cycloneDXProperty{
+				{
+					Name:  "unikorn:helm:appVersion",
+					Value: in.AppVersion,
+				},
+			}
+		}
+
+		if in.License != "" {
+			c.Licenses = []cycloneDXLicense{
+				{
+					Expression: in.License,
+				},
+			}
+		}
+
+		document.Components = append(document.Components, c)
+	}
+
+	return json.Marshal(document)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// BOM is a format agnostic bill of materials for an application bundle.
+type BOM struct {
+	// Name is the application bundle name.
+	Name string
+
+	// Created is when the bill of materials was generated.
+	Created time.Time
+
+	// Packages are all the Helm charts, including dependencies, that
+	// make up the application bundle.
+	Packages []*Package
+}
+
+// Package is a format agnostic description of a Helm chart.
+type Package struct {
+	// ID is a unique identifier for the package.
+	ID string
+
+	// Name is the chart name.
+	Name string
+
+	// Version is the chart version.
+	Version string
+
+	// Repository is where the chart was downloaded from.
+	Repository string
+
+	// HomePage is the optional project home page.
+	HomePage string
+
+	// AppVersion is the optional version of the application packaged
+	// by the chart.
+	AppVersion string
+
+	// License is the optional SPDX license expression.
+	License string
+}
+
+// encoder abstracts away the output format.
+type encoder interface {
+	// Extension is the file extension to use for encoded output.
+	Extension() string
+
+	// Encode renders the bill of materials.
+	Encode(bom *BOM) ([]byte, error)
+}
+
+// FormatVar defines a type that represents an SBOM format.
+type FormatVar string
+
+const (
+	// SPDXFormat emits SPDX 2.3 JSON.
+	SPDXFormat FormatVar = "spdx"
+
+	// CycloneDXFormat emits CycloneDX 1.5 JSON.
+	CycloneDXFormat FormatVar = "cyclonedx"
+)
+
+func (f FormatVar) String() string {
+	return string(f)
+}
+
+func (f *FormatVar) Set(s string) error {
+	allowed := []FormatVar{
+		SPDXFormat,
+		CycloneDXFormat,
+	}
+
+	if !slices.Contains(allowed, FormatVar(s)) {
+		return fmt.Errorf("%w: format flag must be one of %v", ErrFlag, allowed)
+	}
+
+	*f = FormatVar(s)
+
+	return nil
+}
+
+func (f FormatVar) Type() string {
+	return "string"
+}
+
+// encoder returns the encoder for the format.
+func (f FormatVar) encoder() encoder {
+	if f == CycloneDXFormat {
+		return &cycloneDXEncoder{}
+	}
+
+	return &spdxEncoder{}
+}
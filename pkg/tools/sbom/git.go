@@ -0,0 +1,217 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrUnsupportedRepository is raised when a chart is hosted in a git
+	// repository we cannot read raw content from.
+	ErrUnsupportedRepository = errors.New("unsupported git repository")
+
+	// ErrReferenceNotFound is raised when a tag or branch doesn't exist.
+	ErrReferenceNotFound = errors.New("git reference not found")
+
+	// ErrHTTPStatus is raised when a download fails.
+	ErrHTTPStatus = errors.New("unexpected HTTP status")
+
+	// commitRegexp matches a full commit hash, that needs no resolution.
+	commitRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+)
+
+// gitHubRepository is a chart repository hosted on GitHub.
+type gitHubRepository struct {
+	owner string
+	name  string
+}
+
+// parseGitHubRepository checks the repository is on GitHub, as that is
+// what raw content retrieval works against, and extracts the owner and name.
+func parseGitHubRepository(repo string) (*gitHubRepository, error) {
+	repoURL, err := url.Parse(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if repoURL.Scheme != "https" || repoURL.Host != "github.com" {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedRepository, repo)
+	}
+
+	parts := strings.Split(strings.TrimSuffix(strings.Trim(repoURL.Path, "/"), ".git"), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedRepository, repo)
+	}
+
+	r := &gitHubRepository{
+		owner: parts[0],
+		name:  parts[1],
+	}
+
+	return r, nil
+}
+
+// rawURL returns where to download a file from at a specific commit.
+func (r *gitHubRepository) rawURL(commit, file string) string {
+	return "https://raw.githubusercontent.com/" + path.Join(r.owner, r.name, commit, file)
+}
+
+// lsRemote lists references in a remote repository.  Tags and branches
+// are both considered, as that's what Argo CD does, with annotated tags
+// being peeled to the commit they point at.
+func (g *chartInfoGetter) lsRemote(repo, ref string) ([]byte, error) {
+	g.workers <- struct{}{}
+	defer func() { <-g.workers }()
+
+	patterns := []string{
+		ref,
+	}
+
+	if ref != "HEAD" {
+		patterns = []string{
+			"refs/tags/" + ref,
+			"refs/tags/" + ref + "^{}",
+			"refs/heads/" + ref,
+		}
+	}
+
+	command := exec.Command("git", append([]string{"ls-remote", repo}, patterns...)...)
+	command.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	return command.Output()
+}
+
+// resolve turns a tag or branch into a commit, so chart information is
+// retrieved for exactly what would be deployed.
+func (g *chartInfoGetter) resolve(repo, ref string) (string, error) {
+	if commitRegexp.MatchString(ref) {
+		return ref, nil
+	}
+
+	data, err := g.lookup([]string{"git", repo, ref}, func() ([]byte, error) {
+		return g.lsRemote(repo, ref)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	refs := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		refs[fields[1]] = fields[0]
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	// Order is important here, a peeled tag is the commit, and tags
+	// take precedence over branches.
+	candidates := []string{
+		ref,
+		"refs/tags/" + ref + "^{}",
+		"refs/tags/" + ref,
+		"refs/heads/" + ref,
+	}
+
+	for _, candidate := range candidates {
+		if commit, ok := refs[candidate]; ok {
+			return commit, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s %s", ErrReferenceNotFound, repo, ref)
+}
+
+// download gets a file over HTTP.
+func (g *chartInfoGetter) download(u string) ([]byte, error) {
+	g.workers <- struct{}{}
+	defer func() { <-g.workers }()
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Raw content is rate limited for anonymous users.
+	if token, ok := os.LookupEnv("GITHUB_TOKEN"); ok {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	response, err := g.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s returned %s", ErrHTTPStatus, u, response.Status)
+	}
+
+	return io.ReadAll(response.Body)
+}
+
+// getGit grabs information about a helm chart hosted in a git repository,
+// returning the commit the reference resolved to.
+func (g *chartInfoGetter) getGit(repo, chartPath, ref string) (*helmChartInfoStruct, string, error) {
+	r, err := parseGitHubRepository(repo)
+	if err != nil {
+		return nil, "", err
+	}
+
+	commit, err := g.resolve(repo, ref)
+	if err != nil {
+		return nil, "", err
+	}
+
+	u := r.rawURL(commit, path.Join(chartPath, "Chart.yaml"))
+
+	data, err := g.lookup([]string{u}, func() ([]byte, error) {
+		return g.download(u)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	info, err := parseChartInfo(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return info, commit, nil
+}
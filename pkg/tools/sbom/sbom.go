@@ -0,0 +1,476 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+
+	coreunikornv1 "github.com/eschercloudai/unikorn-core/pkg/apis/unikorn/v1alpha1"
+
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// ErrApplicationNotFound is raised when an application bundle references
+	// an application that doesn't exist.
+	ErrApplicationNotFound = errors.New("unable to locate application")
+
+	// ErrFlag is raised when a flag is invalid.
+	ErrFlag = errors.New("flag error")
+
+	// packageIDRegexp matches characters that aren't allowed in package IDs.
+	// See: https://spdx.dev/spdx-specification-20-web-version/ section 3.2.4.
+	packageIDRegexp = regexp.MustCompile(`[^a-z0-9.-]`)
+)
+
+// Options define SBOM generation options.
+type Options struct {
+	// Format defines the SBOM format to emit.
+	Format FormatVar
+
+	// CacheDir defines where Helm chart information is cached.
+	CacheDir string
+
+	// CacheTTL defines how long cached Helm chart information is valid for.
+	CacheTTL time.Duration
+
+	// Offline only uses cached Helm chart information.
+	Offline bool
+
+	// Concurrency defines how many Helm chart lookups may happen at once.
+	Concurrency int
+
+	// runtime provides consistent output and error handling.
+	runtime *runtime.Runtime
+}
+
+// NewOptions returns options with defaults suitable for this repository.
+func NewOptions(r *runtime.Runtime) *Options {
+	cacheDir := ".cache"
+
+	if dir, err := os.UserCacheDir(); err == nil {
+		cacheDir = dir
+	}
+
+	return &Options{
+		Format:      SPDXFormat,
+		CacheDir:    filepath.Join(cacheDir, "unikorn", "helm-charts"),
+		CacheTTL:    24 * time.Hour,
+		Concurrency: 8,
+		runtime:     r,
+	}
+}
+
+// AddFlags adds options to the flag set.
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.Var(&o.Format, "format", "SBOM format to emit, one of spdx or cyclonedx")
+	flags.StringVar(&o.CacheDir, "cache-dir", o.CacheDir, "Where to cache Helm chart information")
+	flags.DurationVar(&o.CacheTTL, "cache-ttl", o.CacheTTL, "How long cached Helm chart information is valid for, zero forces a refresh")
+	flags.BoolVar(&o.Offline, "offline", o.Offline, "Only use cached Helm chart information, regardless of age")
+	flags.IntVar(&o.Concurrency, "concurrency", o.Concurrency, "Maximum number of concurrent Helm chart lookups")
+}
+
+// validate checks the options are sane.
+func (o *Options) validate() error {
+	if o.Concurrency < 1 {
+		return fmt.Errorf("%w: concurrency must be at least 1", ErrFlag)
+	}
+
+	return nil
+}
+
+// parseResourceFile loads the YAML manifest from the path, and unmarshals it into
+// a list of the provided template type.
+func parseResourceFile[T any](path string) ([]T, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(string(data), "\n---\n")
+
+	result := make([]T, len(parts))
+
+	for i, part := range parts {
+		if err := yaml.Unmarshal([]byte(part), &result[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// parseResources loads all the manifest files, and returns list types of application bundles
+// and helm applications.
+func parseResources() (*unikornv1.ControlPlaneApplicationBundleList, *unikornv1.KubernetesClusterApplicationBundleList, *coreunikornv1.HelmApplicationList, error) {
+	controlPlaneApplicationBundles, err := parseResourceFile[unikornv1.ControlPlaneApplicationBundle]("charts/unikorn/templates/controlplaneapplicationbundles.yaml")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	kubernetesClusterApplicationBundles, err := parseResourceFile[unikornv1.KubernetesClusterApplicationBundle]("charts/unikorn/templates/kubernetesclusterapplicationbundles.yaml")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	applications, err := parseResourceFile[coreunikornv1.HelmApplication]("charts/unikorn/templates/applications.yaml")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return &unikornv1.ControlPlaneApplicationBundleList{Items: controlPlaneApplicationBundles}, &unikornv1.KubernetesClusterApplicationBundleList{Items: kubernetesClusterApplicationBundles}, &coreunikornv1.HelmApplicationList{Items: applications}, nil
+}
+
+// getApplication looks up an application by name.
+func getApplication(name string, applications *coreunikornv1.HelmApplicationList) (*coreunikornv1.HelmApplication, error) {
+	for i, application := range applications.Items {
+		if application.Name == name {
+			return &applications.Items[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrApplicationNotFound, name)
+}
+
+// See: https://helm.sh/docs/topics/charts/
+type helmChartInfoMaintainer struct {
+	Name  string  `json:"name"`
+	Email *string `json:"email"`
+	URL   *string `json:"url"`
+}
+
+// See: https://helm.sh/docs/topics/charts/
+type helmChartInfoDependency struct {
+	Name       string   `json:"name"`
+	Version    string   `json:"version"`
+	Repository *string  `json:"repository"`
+	Condition  *string  `json:"condition"`
+	Tags       []string `json:"tags"`
+	//nolint: tagliatelle
+	ImportValues []string `json:"import-values"`
+	Alias        *string  `json:"alias"`
+}
+
+// See: https://helm.sh/docs/topics/charts/
+type helmChartInfoStruct struct {
+	APIVersion   string                    `json:"apiVersion"`
+	Name         string                    `json:"name"`
+	Version      string                    `json:"version"`
+	KubeVersion  *string                   `json:"kubeVersion"`
+	Description  *string                   `json:"description"`
+	Type         *string                   `json:"type"`
+	Keywords     []string                  `json:"keywords"`
+	Home         *string                   `json:"home"`
+	Sources      []string                  `json:"sources"`
+	Dependencies []helmChartInfoDependency `json:"dependencies"`
+	Maintainers  []helmChartInfoMaintainer `json:"maintainers"`
+	Icon         *string                   `json:"icon"`
+	AppVersion   *string                   `json:"appVersion"`
+	Deprecated   *bool                     `json:"deprecated"`
+	Annotations  map[string]string         `json:"annotations"`
+}
+
+// pruneEmptyStrings helper to remove empty strings from a string slice.
+func pruneEmptyStrings(s []string) []string {
+	var r []string
+
+	for _, v := range s {
+		if v != "" {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
+// generatePackage does some helm wizardry to lookup application package details.
+func generatePackage(g *chartInfoGetter, repo, chart, version string) ([]*Package, error) {
+	repoURL, err := url.Parse(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ignore things that don't look like real repos, e.g. file://
+	if repoURL.Scheme != "https" {
+		return nil, nil
+	}
+
+	// Sloooooooow..... zzzz
+	info, err := g.get(repo, chart, version)
+	if err != nil {
+		return nil, err
+	}
+
+	id := []string{
+		"HelmChart",
+		packageIDRegexp.ReplaceAllString(repoURL.Host, "-"),
+		packageIDRegexp.ReplaceAllString(strings.TrimPrefix(repoURL.Path, "/"), "-"),
+		chart,
+		version,
+	}
+
+	return generatePackages(g, id, chart, repo, info)
+}
+
+// generateGitPackage looks up application package details for charts hosted
+// in git repositories.
+func generateGitPackage(g *chartInfoGetter, repo, chartPath, ref string) ([]*Package, error) {
+	repoURL, err := url.Parse(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	info, commit, err := g.getGit(repo, chartPath, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	id := []string{
+		"GitChart",
+		packageIDRegexp.ReplaceAllString(repoURL.Host, "-"),
+		packageIDRegexp.ReplaceAllString(strings.TrimSuffix(strings.TrimPrefix(repoURL.Path, "/"), ".git"), "-"),
+		packageIDRegexp.ReplaceAllString(chartPath, "-"),
+		packageIDRegexp.ReplaceAllString(ref, "-"),
+	}
+
+	// See: https://spdx.github.io/spdx-spec/v2.3/package-information/#77-package-download-location-field
+	location := "git+" + repo + "@" + commit + "#" + chartPath
+
+	return generatePackages(g, id, info.Name, location, info)
+}
+
+// generatePackages fills in package details from chart information then
+// recursively walks the dependency tree.
+//
+//nolint:cyclop
+func generatePackages(g *chartInfoGetter, id []string, name, location string, info *helmChartInfoStruct) ([]*Package, error) {
+	// Fill in all the basics we know are mandatory.
+	// NOTE: some charts will use a wildcaed version, so it's only as
+	// up to date as when the SBOM was created.
+	p := &Package{
+		ID:         strings.Join(pruneEmptyStrings(id), "-"),
+		Name:       name,
+		Version:    info.Version,
+		Repository: location,
+	}
+
+	// Add in any optional fields.
+	if info.Home != nil {
+		p.HomePage = *info.Home
+	}
+
+	if info.AppVersion != nil {
+		p.AppVersion = *info.AppVersion
+	}
+
+	if info.Annotations != nil {
+		if license, ok := info.Annotations["artifacthub.io/license"]; ok {
+			p.License = license
+		}
+	}
+
+	result := []*Package{
+		p,
+	}
+
+	// Recursively walk down the dependency tree...
+	for _, dependency := range info.Dependencies {
+		if dependency.Repository == nil {
+			continue
+		}
+
+		d, err := generatePackage(g, *dependency.Repository, dependency.Name, dependency.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, d...)
+	}
+
+	return result, nil
+}
+
+// generateBOM collects all the packages that make up an application bundle.
+// Applications are looked up in parallel, but packages are reported in the
+// order they are defined.
+func generateBOM(g *chartInfoGetter, name string, spec *unikornv1.ApplicationBundleSpec, applications *coreunikornv1.HelmApplicationList) (*BOM, error) {
+	var versions []coreunikornv1.HelmApplicationVersion
+
+	for _, applicationRef := range spec.Applications {
+		application, err := getApplication(*applicationRef.Reference.Name, applications)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, application.Spec.Versions...)
+	}
+
+	packages := make([][]*Package, len(versions))
+	errs := make([]error, len(versions))
+
+	var wg sync.WaitGroup
+
+	for i := range versions {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			version := &versions[i]
+
+			// Things pulled directly from git have a path rather than a chart.
+			if version.Path != nil {
+				packages[i], errs[i] = generateGitPackage(g, *version.Repo, *version.Path, *version.Version)
+
+				return
+			}
+
+			packages[i], errs[i] = generatePackage(g, *version.Repo, *version.Chart, *version.Version)
+		}(i)
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	bom := &BOM{
+		Name:    name,
+		Created: time.Now().UTC(),
+	}
+
+	// TODO: we should probably deduplicate this, just in case.
+	for _, p := range packages {
+		bom.Packages = append(bom.Packages, p...)
+	}
+
+	return bom, nil
+}
+
+// generateSBOM does the actual meat!
+func generateSBOM(g *chartInfoGetter, name string, spec *unikornv1.ApplicationBundleSpec, applications *coreunikornv1.HelmApplicationList, e encoder) error {
+	bom, err := generateBOM(g, name, spec, applications)
+	if err != nil {
+		return err
+	}
+
+	data, err := e.Encode(bom)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create("sboms/" + name + e.Extension())
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run gets the necessary helm template definitions then generates an SBOM for each
+// application bundle.
+func (o *Options) Run() error {
+	if err := o.validate(); err != nil {
+		return err
+	}
+
+	e := o.Format.encoder()
+
+	g, err := newChartInfoGetter(o)
+	if err != nil {
+		return err
+	}
+
+	controlPlaneApplicationBundles, kubernetesClusterApplicationBundles, applications, err := parseResources()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll("sboms", 0775); err != nil {
+		return err
+	}
+
+	for i := range controlPlaneApplicationBundles.Items {
+		bundle := &controlPlaneApplicationBundles.Items[i]
+
+		o.runtime.Info("generating SBOM", "bundle", bundle.Name, "format", o.Format)
+
+		if err := generateSBOM(g, bundle.Name, &bundle.Spec, applications, e); err != nil {
+			return err
+		}
+	}
+
+	for i := range kubernetesClusterApplicationBundles.Items {
+		bundle := &kubernetesClusterApplicationBundles.Items[i]
+
+		o.runtime.Info("generating SBOM", "bundle", bundle.Name, "format", o.Format)
+
+		if err := generateSBOM(g, bundle.Name, &bundle.Spec.ApplicationBundleSpec, applications, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewCommand returns a command that generates SBOMs for application bundles.
+func NewCommand(r *runtime.Runtime) *cobra.Command {
+	o := NewOptions(r)
+
+	cmd := &cobra.Command{
+		Use:   "sbom",
+		Short: "Generate application bundle SBOMs.",
+		Long:  "Generate a software bill of materials for each application bundle, describing the Helm charts it deploys.",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return o.Run()
+		},
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	return cmd
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"encoding/json"
+	"time"
+
+	spdx_common "github.com/spdx/tools-golang/spdx/v2/common"
+	spdx "github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// spdxEncoder emits SPDX 2.3 JSON.
+type spdxEncoder struct{}
+
+// Ensure the encoder interface is implemented.
+var _ encoder = &spdxEncoder{}
+
+// Extension implements the encoder interface.
+func (*spdxEncoder) Extension() string {
+	return ".spdx"
+}
+
+// Encode implements the encoder interface.
+func (*spdxEncoder) Encode(bom *BOM) ([]byte, error) {
+	document := &spdx.Document{
+		SPDXVersion:       spdx.Version,
+		DataLicense:       spdx.DataLicense,
+		SPDXIdentifier:    "DOCUMENT",
+		DocumentName:      bom.Name,
+		DocumentNamespace: "unikorn.eschercloud.ai",
+		CreationInfo: &spdx.CreationInfo{
+			Creators: []spdx_common.Creator{
+				{
+					Creator:     "EscherCloud AI",
+					CreatorType: "Organization",
+				},
+				{
+					Creator:     "unikorn",
+					CreatorType: "Tool",
+				},
+			},
+			Created: bom.Created.Format(time.RFC3339),
+		},
+	}
+
+	for _, in := range bom.Packages {
+		p := &spdx.Package{
+			PackageSPDXIdentifier:   spdx_common.ElementID(in.ID),
+			PackageName:             in.Name,
+			PackageVersion:          in.Version,
+			PackageDownloadLocation: in.Repository,
+			PackageLicenseDeclared:  "NONE",
+			PrimaryPackagePurpose:   "INSTALL",
+			PackageHomePage:         in.HomePage,
+		}
+
+		if in.AppVersion != "" {
+			p.PackageComment = "Application Version: " + in.AppVersion
+		}
+
+		if in.License != "" {
+			p.PackageLicenseDeclared = in.License
+		}
+
+		document.Packages = append(document.Packages, p)
+	}
+
+	return json.Marshal(document)
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrSyntax is raised when a source file isn't a single YAML document.
+	ErrSyntax = errors.New("syntax error")
+
+	// ErrReference is raised when a file reference cannot be resolved.
+	ErrReference = errors.New("reference error")
+)
+
+// header is prepended to the bundled specification so nobody is tempted
+// to edit it directly.
+const header = "# Code generated by unikorn-tools spec. DO NOT EDIT.\n"
+
+// fileReader abstracts away where source files come from, so we can bundle from
+// disk, or from an in-memory split when validating round trips.
+type fileReader func(name string) ([]byte, error)
+
+// bundler resolves a tree of YAML files linked by file $refs into a single
+// document.
+type bundler struct {
+	// readFile loads a source file.
+	readFile fileReader
+
+	// stack is the chain of files currently being resolved, used to detect
+	// cycles.
+	stack []string
+
+	// files records every file that was inlined, so we can detect orphans.
+	files map[string]bool
+}
+
+// newBundler returns a new bundler that reads files with the provided function.
+func newBundler(readFile fileReader) *bundler {
+	return &bundler{
+		readFile: readFile,
+		files:    map[string]bool{},
+	}
+}
+
+// decode reads a single YAML document, returning its content node.
+func decode(name string, data []byte) (*yaml.Node, error) {
+	var document yaml.Node
+
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", ErrSyntax, name, err.Error())
+	}
+
+	if document.Kind != yaml.DocumentNode || len(document.Content) != 1 {
+		return nil, fmt.Errorf("%w: %s: expected a single document", ErrSyntax, name)
+	}
+
+	return document.Content[0], nil
+}
+
+// encode renders a YAML node with the repository's indentation.
+func encode(node *yaml.Node) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+
+	encoder := yaml.NewEncoder(buffer)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(node); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// fileRef returns the target of a reference object that points to another file.
+// Local references e.g. "#/components/schemas/foo" are left alone as they are
+// relative to the bundled document.
+func fileRef(node *yaml.Node) (string, bool) {
+	if node.Kind != yaml.MappingNode || len(node.Content) != 2 {
+		return "", false
+	}
+
+	if node.Content[0].Value != "$ref" || strings.HasPrefix(node.Content[1].Value, "#") {
+		return "", false
+	}
+
+	return node.Content[1].Value, true
+}
+
+// load reads and decodes a source file, then recursively resolves any file
+// references within it.
+func (b *bundler) load(name string) (*yaml.Node, error) {
+	if slices.Contains(b.stack, name) {
+		return nil, fmt.Errorf("%w: cycle detected: %s -> %s", ErrReference, strings.Join(b.stack, " -> "), name)
+	}
+
+	data, err := b.readFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrReference, err.Error())
+	}
+
+	node, err := decode(name, data)
+	if err != nil {
+		return nil, err
+	}
+
+	b.stack = append(b.stack, name)
+	b.files[name] = true
+
+	if err := b.resolve(node, filepath.Dir(name)); err != nil {
+		return nil, err
+	}
+
+	b.stack = b.stack[:len(b.stack)-1]
+
+	return node, nil
+}
+
+// resolve walks the node tree replacing file references with the content
+// of the referenced file.  References are relative to the directory of the
+// file containing them.
+func (b *bundler) resolve(node *yaml.Node, dir string) error {
+	if ref, ok := fileRef(node); ok {
+		if strings.Contains(ref, "#") {
+			return fmt.Errorf("%w: %s: fragments are not supported in file references", ErrReference, ref)
+		}
+
+		content, err := b.load(filepath.Join(dir, filepath.FromSlash(ref)))
+		if err != nil {
+			return err
+		}
+
+		*node = *content
+
+		return nil
+	}
+
+	for _, child := range node.Content {
+		if err := b.resolve(child, dir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bundle loads the root source file and returns the bundled specification.
+func (b *bundler) bundle(root string) ([]byte, error) {
+	node, err := b.load(filepath.Clean(root))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := encode(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(header), data...), nil
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spec
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+)
+
+var (
+	// ErrOrphan is raised when a source file isn't referenced by the bundle.
+	ErrOrphan = errors.New("source file not referenced")
+
+	// ErrRoundTrip is raised when splitting and rebundling the specification
+	// doesn't yield the same result.
+	ErrRoundTrip = errors.New("specification does not round trip")
+
+	// ErrOutOfDate is raised in check mode when the bundled specification
+	// differs from a fresh bundle.
+	ErrOutOfDate = errors.New("specification is out of date")
+)
+
+// Options define bundling options.
+type Options struct {
+	// Source is the root source file, all file references are resolved
+	// relative to the file they appear in.
+	Source string
+
+	// Output is the bundled specification consumed by code generation.
+	Output string
+
+	// Check bundles the specification and compares it with the existing
+	// output, failing if they differ.
+	Check bool
+
+	// Split does the reverse operation, generating the sources from the
+	// bundled output.
+	Split bool
+
+	// runtime provides consistent output and error handling.
+	runtime *runtime.Runtime
+}
+
+// NewOptions returns options with defaults suitable for this repository.
+func NewOptions(r *runtime.Runtime) *Options {
+	return &Options{
+		Source:  "pkg/server/openapi/src/server.yaml",
+		Output:  "pkg/server/openapi/server.spec.yaml",
+		runtime: r,
+	}
+}
+
+// AddFlags adds options to the flag set.
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Source, "source", o.Source, "Path to the root specification source file")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Path to the bundled specification")
+	flags.BoolVar(&o.Check, "check", o.Check, "Fail if the output file differs from a fresh bundle, implies no side effects")
+	flags.BoolVar(&o.Split, "split", o.Split, "Generate the sources from the bundled specification")
+}
+
+// checkOrphans ensures every YAML file in the source tree has been used, otherwise
+// edits to it will silently have no effect.
+func (o *Options) checkOrphans(b *bundler) error {
+	return filepath.WalkDir(filepath.Dir(o.Source), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || filepath.Ext(path) != ".yaml" {
+			return nil
+		}
+
+		if !b.files[path] {
+			return fmt.Errorf("%w: %s", ErrOrphan, path)
+		}
+
+		return nil
+	})
+}
+
+// validate checks the bundled specification is valid OpenAPI, and that all the local
+// references resolve.
+func validate(data []byte) error {
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		return err
+	}
+
+	return doc.Validate(context.Background())
+}
+
+// roundTrip splits the bundled specification and bundles it again, ensuring that
+// the result is identical, and thus no information is lost in either direction.
+func (o *Options) roundTrip(data []byte) error {
+	files, err := split(data, o.Source)
+	if err != nil {
+		return err
+	}
+
+	b := newBundler(func(name string) ([]byte, error) {
+		data, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("%s: %w", name, os.ErrNotExist)
+		}
+
+		return data, nil
+	})
+
+	rebundled, err := b.bundle(o.Source)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(data, rebundled) {
+		return fmt.Errorf("%w: split and rebundle yields different output", ErrRoundTrip)
+	}
+
+	return nil
+}
+
+// bundle generates the bundled specification from the sources and validates it.
+func (o *Options) bundle() ([]byte, error) {
+	b := newBundler(os.ReadFile)
+
+	data, err := b.bundle(o.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.checkOrphans(b); err != nil {
+		return nil, err
+	}
+
+	if err := validate(data); err != nil {
+		return nil, err
+	}
+
+	if err := o.roundTrip(data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// runSplit writes out the sources from the bundled specification.  This will not
+// remove existing sources, so any that are no longer required will be flagged as
+// orphans during the next bundle.
+func (o *Options) runSplit() error {
+	data, err := os.ReadFile(o.Output)
+	if err != nil {
+		return err
+	}
+
+	files, err := split(data, o.Source)
+	if err != nil {
+		return err
+	}
+
+	for name, content := range files {
+		//nolint:gosec
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			return err
+		}
+
+		//nolint:gosec
+		if err := os.WriteFile(name, content, 0644); err != nil {
+			return err
+		}
+	}
+
+	o.runtime.Info("sources updated", "path", filepath.Dir(o.Source), "files", len(files))
+
+	return nil
+}
+
+// Run does the main meat, bundling the specification then either writing it out,
+// or comparing it with what's already there.
+func (o *Options) Run() error {
+	if o.Split {
+		return o.runSplit()
+	}
+
+	data, err := o.bundle()
+	if err != nil {
+		return err
+	}
+
+	existing, err := os.ReadFile(o.Output)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if o.Check {
+		if err != nil || !bytes.Equal(existing, data) {
+			return fmt.Errorf("%w: %s does not match a fresh bundle", ErrOutOfDate, o.Output)
+		}
+
+		return nil
+	}
+
+	// Leave the file untouched if nothing has changed, so repeated runs
+	// are side effect free and don't trigger code generation.
+	if err == nil && bytes.Equal(existing, data) {
+		return nil
+	}
+
+	//nolint:gosec
+	if err := os.WriteFile(o.Output, data, 0644); err != nil {
+		return err
+	}
+
+	o.runtime.Info("specification updated", "path", o.Output)
+
+	return nil
+}
+
+// NewCommand returns a command that bundles the OpenAPI specification.
+func NewCommand(r *runtime.Runtime) *cobra.Command {
+	o := NewOptions(r)
+
+	cmd := &cobra.Command{
+		Use:   "spec",
+		Short: "Bundle the OpenAPI specification.",
+		Long:  "Bundle the split OpenAPI specification sources into the single file consumed by code generation, or split it back into sources.",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return o.Run()
+		},
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	return cmd
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrSplit is raised when the specification cannot be split into files.
+	ErrSplit = errors.New("split error")
+)
+
+// componentKinds are the component types that are split out into their own
+// directory, one file per component.
+//
+//nolint:gochecknoglobals
+var componentKinds = []string{
+	"parameters",
+	"schemas",
+	"requestBodies",
+	"responses",
+	"securitySchemes",
+}
+
+// mappingValue returns the value associated with a key in a mapping node.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// refNode creates a reference object pointing at another file.
+func refNode(ref string) *yaml.Node {
+	return &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  "!!map",
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: "$ref"},
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: ref},
+		},
+	}
+}
+
+// pathFileName generates a file name from an API path e.g. /api/v1/foo/{bar}
+// becomes api_v1_foo_bar.yaml.
+func pathFileName(p string) string {
+	name := strings.NewReplacer("{", "", "}", "").Replace(strings.TrimPrefix(p, "/"))
+
+	return strings.ReplaceAll(name, "/", "_") + ".yaml"
+}
+
+// splitter breaks a specification into a root document that references one file
+// per path and one file per component.
+type splitter struct {
+	// files maps from a file name relative to the root document to its content.
+	files map[string]*yaml.Node
+}
+
+// extract replaces all values in the mapping node with a reference to a file in
+// the named directory, and records the file contents.
+func (s *splitter) extract(node *yaml.Node, dir string, fileName func(string) string) error {
+	for i := 0; i < len(node.Content); i += 2 {
+		name := path.Join(dir, fileName(node.Content[i].Value))
+
+		if _, ok := s.files[name]; ok {
+			return fmt.Errorf("%w: %s is generated by more than one key", ErrSplit, name)
+		}
+
+		s.files[name] = node.Content[i+1]
+
+		node.Content[i+1] = refNode(name)
+	}
+
+	return nil
+}
+
+// split takes a bundled specification and returns a set of encoded source files
+// keyed by path, that when bundled from root, yield the same specification.
+func split(data []byte, root string) (map[string][]byte, error) {
+	// The header only applies to the bundled output, so don't propagate it
+	// into the sources.
+	node, err := decode("bundle", bytes.TrimPrefix(data, []byte(header)))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &splitter{
+		files: map[string]*yaml.Node{},
+	}
+
+	if paths := mappingValue(node, "paths"); paths != nil {
+		if err := s.extract(paths, "paths", pathFileName); err != nil {
+			return nil, err
+		}
+	}
+
+	if components := mappingValue(node, "components"); components != nil {
+		for _, kind := range componentKinds {
+			if value := mappingValue(components, kind); value != nil {
+				if err := s.extract(value, kind, func(name string) string { return name + ".yaml" }); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	dir := filepath.Dir(root)
+
+	result := map[string][]byte{}
+
+	for name, value := range s.files {
+		data, err := encode(value)
+		if err != nil {
+			return nil, err
+		}
+
+		result[filepath.Join(dir, filepath.FromSlash(name))] = data
+	}
+
+	data, err = encode(node)
+	if err != nil {
+		return nil, err
+	}
+
+	result[filepath.Clean(root)] = data
+
+	return result, nil
+}
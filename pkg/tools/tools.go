@@ -0,0 +1,51 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tools
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+	"github.com/eschercloudai/unikorn/pkg/tools/docs"
+	"github.com/eschercloudai/unikorn/pkg/tools/metallb"
+	"github.com/eschercloudai/unikorn/pkg/tools/sbom"
+	"github.com/eschercloudai/unikorn/pkg/tools/spec"
+	"github.com/eschercloudai/unikorn/pkg/tools/validate"
+)
+
+// Generate creates a hierarchy of cobra commands for the development and release
+// tooling.  Each subcommand is backed by an importable package, so the same
+// functionality may be used programmatically.
+func Generate(r *runtime.Runtime) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unikorn-tools",
+		Short: "Unikorn development and release tooling.",
+		Long:  "Unikorn development and release tooling.",
+	}
+
+	commands := []*cobra.Command{
+		docs.NewCommand(r),
+		metallb.NewCommand(r),
+		sbom.NewCommand(r),
+		spec.NewCommand(r),
+		validate.NewCommand(r),
+	}
+
+	cmd.AddCommand(commands...)
+
+	return cmd
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2022-2024 EscherCloud.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"reflect"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	unikornv1 "github.com/eschercloudai/unikorn/pkg/apis/unikorn/v1alpha1"
+	"github.com/eschercloudai/unikorn/pkg/cmd/runtime"
+	"github.com/eschercloudai/unikorn/pkg/server/generated"
+	"github.com/eschercloudai/unikorn/pkg/server/openapi/parity"
+)
+
+var (
+	// ErrValidation is raised when the specification doesn't follow the rules.
+	ErrValidation = errors.New("specification validation failed")
+)
+
+// validator accumulates specification problems.
+type validator struct {
+	runtime *runtime.Runtime
+	// crdDirectory is where custom resource definitions live.
+	crdDirectory string
+	// failures is the number of problems found.
+	failures int
+}
+
+// report records a problem with the specification.
+func (v *validator) report(message string, method, path string, keysAndValues ...any) {
+	v.runtime.Warning(message, append([]any{"method", method, "path", path}, keysAndValues...)...)
+
+	v.failures++
+}
+
+// kubernetesClusterMappings relate the kubernetes cluster API to the custom
+// resource where the fields don't line up.
+//
+//nolint:gochecknoglobals
+var kubernetesClusterMappings = []parity.Mapping{
+	{API: "name", CRD: "metadata.name"},
+	{API: "applicationBundle", CRD: "spec.applicationBundle", Opaque: true},
+	{API: "applicationBundleAutoUpgrade.daysOfWeek", CRD: "spec.applicationBundleAutoUpgrade.weekday", Opaque: true},
+	{API: "upgrade"},
+	{API: "upgradeFreeze.expiry", CRD: "spec.upgradeFreeze.until"},
+	{API: "kubernetesUpgrade"},
+	{API: "openstack.computeAvailabilityZone", CRD: "spec.openstack.failureDomain"},
+	{API: "openstack.volumeAvailabilityZone", CRD: "spec.openstack.volumeFailureDomain"},
+	{API: "openstack.externalNetworkID", CRD: "spec.openstack.externalNetworkId"},
+	{API: "openstack.network.networkID", CRD: "spec.openstack.network.networkId"},
+	{API: "openstack.network.subnetID", CRD: "spec.openstack.network.subnetId"},
+	{API: "openstack.network.routerID", CRD: "spec.openstack.network.routerId"},
+	{API: "openstack.loadBalancer.flavorID", CRD: "spec.openstack.loadBalancer.flavorId"},
+	{API: "api.loadBalancer.flavorID", CRD: "spec.api.loadBalancer.flavorId"},
+	{API: "network.nodePrefix", CRD: "spec.network.nodeNetwork"},
+	{API: "network.podPrefix", CRD: "spec.network.podNetwork"},
+	{API: "network.servicePrefix", CRD: "spec.network.serviceNetwork"},
+	{API: "network.secondaryPodPrefix", CRD: "spec.network.secondaryPodNetwork"},
+	{API: "network.secondaryServicePrefix", CRD: "spec.network.secondaryServiceNetwork"},
+	{API: "controlPlane.imageName", CRD: "spec.controlPlane.image"},
+	{API: "controlPlane.flavorName", CRD: "spec.controlPlane.flavor"},
+	{API: "controlPlane.disk", CRD: "spec.controlPlane"},
+	{API: "controlPlane.disk.size", CRD: "spec.controlPlane.diskSize", Opaque: true},
+	{API: "controlPlane.disk.availabilityZone", CRD: "spec.controlPlane.volumeFailureDomain"},
+	{API: "workloadPools", CRD: "spec.workloadPools.pools"},
+	{API: "workloadPools[].availabilityZone", CRD: "spec.workloadPools.pools[].failureDomain"},
+	{API: "workloadPools[].machine", CRD: "spec.workloadPools.pools[]"},
+	{API: "workloadPools[].machine.imageName", CRD: "spec.workloadPools.pools[].image"},
+	{API: "workloadPools[].machine.flavorName", CRD: "spec.workloadPools.pools[].flavor"},
+	{API: "workloadPools[].machine.disk", CRD: "spec.workloadPools.pools[]"},
+	{API: "workloadPools[].machine.disk.size", CRD: "spec.workloadPools.pools[].diskSize", Opaque: true},
+	{API: "workloadPools[].machine.disk.availabilityZone", CRD: "spec.workloadPools.pools[].volumeFailureDomain"},
+	{API: "sshCertificateAuthority", CRD: "spec.sshCertificateAuthority", Opaque: true},
+	{API: "hibernated"},
+	{API: "status"},
+	{API: "placement"},
+}
+
+// parityCheck defines an API schema that is persisted as a custom resource.
+type parityCheck struct {
+	// schema is the API schema name.
+	schema string
+	// crd is the custom resource definition file name.
+	crd string
+	// t is the custom resource type.
+	t reflect.Type
+	// root is the path within the custom resource the schema corresponds to.
+	root string
+	// mappings relate fields that don't line up.
+	mappings []parity.Mapping
+}
+
+// parityChecks lists all API schemas that must agree with their custom resources.
+//
+//nolint:gochecknoglobals
+var parityChecks = []parityCheck{
+	{
+		schema:   "kubernetesCluster",
+		crd:      "unikorn.eschercloud.ai_kubernetesclusters.yaml",
+		t:        reflect.TypeOf(unikornv1.KubernetesCluster{}),
+		root:     "spec",
+		mappings: kubernetesClusterMappings,
+	},
+}
+
+// validateParity checks API schemas against the custom resources they are
+// persisted as, so fields aren't silently dropped, and requests that pass API
+// validation aren't rejected by the custom resource's.
+func (v *validator) validateParity(spec *openapi3.T) error {
+	for _, check := range parityChecks {
+		schema, ok := spec.Components.Schemas[check.schema]
+		if !ok {
+			return fmt.Errorf("%w: schema %s not defined", ErrValidation, check.schema)
+		}
+
+		crd, err := parity.LoadCRDSchema(filepath.Join(v.crdDirectory, check.crd), unikornv1.GroupVersion)
+		if err != nil {
+			return err
+		}
+
+		for _, drift := range parity.Check(schema.Value, check.t, crd, check.root, check.mappings) {
+			v.runtime.Warning("schema differs from custom resource", "schema", check.schema, "field", drift.API, "resourceField", drift.CRD, "reason", drift.Message)
+
+			v.failures++
+		}
+	}
+
+	return nil
+}
+
+//nolint:gocognit,cyclop
+func (v *validator) validate() error {
+	spec, err := generated.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("failed to load spec: %w", err)
+	}
+
+	if err := spec.Validate(context.Background()); err != nil {
+		return fmt.Errorf("%w: %s", ErrValidation, err.Error())
+	}
+
+	for _, pathName := range spec.Paths.InMatchingOrder() {
+		path := spec.Paths.Find(pathName)
+
+		for method, operation := range path.Operations() {
+			// Everything needs security defining.
+			_, noSecurityAllowed := operation.Extensions["x-no-security-requirements"]
+
+			if operation.Security == nil && !noSecurityAllowed {
+				v.report("no security requirements set", method, pathName)
+			}
+
+			// If you have multiple, then the errors become ambiguous to handle.
+			if operation.Security != nil && len(*operation.Security) != 1 {
+				v.report("exactly one security requirement required", method, pathName)
+			}
+
+			//nolint:nestif
+			if method == http.MethodGet {
+				// Where there are responses, they must have a schema.
+				for code := 100; code < 600; code++ {
+					responseRef := operation.Responses.Status(code)
+					if responseRef == nil {
+						continue
+					}
+
+					if code != http.StatusOK {
+						continue
+					}
+
+					response := responseRef.Value
+					if response == nil {
+						response = spec.Components.Responses[responseRef.Ref].Value
+					}
+
+					if response.Content == nil {
+						v.report("no content type set for response", method, pathName, "code", code)
+					}
+
+					for mimeType, mediaType := range response.Content {
+						if mimeType == "application/json" && mediaType.Schema == nil {
+							v.report("no schema set for response", method, pathName, "code", code, "mimeType", mimeType)
+						}
+					}
+				}
+			}
+
+			//nolint:nestif
+			if method == http.MethodPost || method == http.MethodPut {
+				// You have to explicitly opt out from following the rules.
+				_, noBodyAllowed := operation.Extensions["x-no-body"]
+
+				// POST/PUT calls will have something to validate.
+				if operation.RequestBody == nil {
+					if noBodyAllowed {
+						continue
+					}
+
+					v.report("no request body set", method, pathName)
+
+					continue
+				}
+
+				body := operation.RequestBody.Value
+				if body == nil {
+					body = spec.Components.RequestBodies[operation.RequestBody.Ref].Value
+				}
+
+				// Request bodies will have a schema.
+				for mimeType, mediaType := range body.Content {
+					if mediaType.Schema == nil {
+						v.report("no schema set for request body", method, pathName, "mimeType", mimeType)
+					}
+				}
+			}
+		}
+	}
+
+	if err := v.validateParity(spec); err != nil {
+		return err
+	}
+
+	if v.failures != 0 {
+		return fmt.Errorf("%w: %d problems found", ErrValidation, v.failures)
+	}
+
+	return nil
+}
+
+// Options define validation options.
+type Options struct {
+	// CRDDirectory is where custom resource definitions live.
+	CRDDirectory string
+
+	// runtime provides consistent output and error handling.
+	runtime *runtime.Runtime
+}
+
+// NewOptions returns options with defaults suitable for this repository.
+func NewOptions(r *runtime.Runtime) *Options {
+	return &Options{
+		CRDDirectory: "charts/unikorn/crds",
+		runtime:      r,
+	}
+}
+
+// AddFlags adds options to the flag set.
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.CRDDirectory, "crd-dir", o.CRDDirectory, "Directory containing custom resource definitions")
+}
+
+// Run validates the compiled in OpenAPI specification follows the rules, and
+// agrees with the custom resources it's persisted as.
+func (o *Options) Run() error {
+	v := &validator{
+		runtime:      o.runtime,
+		crdDirectory: o.CRDDirectory,
+	}
+
+	return v.validate()
+}
+
+// NewCommand returns a command that validates the OpenAPI specification.
+func NewCommand(r *runtime.Runtime) *cobra.Command {
+	o := NewOptions(r)
+
+	cmd := &cobra.Command{
+		Use:   "validate-openapi",
+		Short: "Validate the OpenAPI specification.",
+		Long:  "Validate the OpenAPI specification follows the rules, and agrees with the custom resources it's persisted as.",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return o.Run()
+		},
+	}
+
+	o.AddFlags(cmd.Flags())
+
+	return cmd
+}